@@ -271,8 +271,6 @@ const ProfileScreen: React.FC = () => {
         user={user}
         visible={isFollowModalVisible}
         onClose={() => closeFollowModal()}
-        follows={user.follows}
-        followers={user.followers}
         selectedTab={selectedFollowTab}
         setSelectedTab={setSelectedFollowTab}
       />
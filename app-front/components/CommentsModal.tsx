@@ -16,13 +16,13 @@ import {
 import { z } from 'zod';
 import CommentInput from '@/components/CommentInput';
 import { commentSchema } from '@/features/comment/schema';
+import usePostComments from '@/features/post/usePostComments';
 
 export type Comment = z.infer<typeof commentSchema>;
 
 type CommentsModalProps = {
   postId: string;
   visible: boolean;
-  comments: Comment[];
   onClose: () => void;
   slideAnim: Animated.Value;
   queryKey: unknown[];
@@ -32,7 +32,6 @@ type CommentsModalProps = {
 const CommentsModal: React.FC<CommentsModalProps> = ({
   postId,
   visible,
-  comments,
   onClose,
   slideAnim,
   queryKey,
@@ -41,6 +40,11 @@ const CommentsModal: React.FC<CommentsModalProps> = ({
   const windowHeight = Dimensions.get('window').height;
   const modalHeight = windowHeight * 0.6;
 
+  const { comments, fetchNextPage, hasNextPage, refetch } = usePostComments({
+    postId,
+    enabled: visible,
+  });
+
   const panResponder = useRef(
     PanResponder.create({
       onStartShouldSetPanResponder: () => true,
@@ -121,6 +125,9 @@ const CommentsModal: React.FC<CommentsModalProps> = ({
                     </View>
                   </View>
                 )}
+                onEndReached={() => {
+                  if (hasNextPage) fetchNextPage();
+                }}
                 keyboardShouldPersistTaps="always"
                 contentContainerStyle={{ paddingBottom: 20 }}
               />
@@ -130,7 +137,10 @@ const CommentsModal: React.FC<CommentsModalProps> = ({
               <CommentInput
                 postId={postId}
                 queryKey={queryKey}
-                onNewComment={onNewComment}
+                onNewComment={(comment) => {
+                  refetch();
+                  onNewComment(comment);
+                }}
               />
             </View>
           </View>
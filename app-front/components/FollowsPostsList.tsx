@@ -65,10 +65,7 @@ export default function FollowsPostsList({ scrollY, listRef }: Props) {
       });
     },
     initialPageParam: null,
-    getNextPageParam: (lastPage) => {
-      if (lastPage.posts.length < 10) return undefined;
-      return lastPage.posts[lastPage.posts.length - 1]?.id;
-    },
+    getNextPageParam: (lastPage) => lastPage.nextCursor || undefined,
     enabled: !!currentUser?.id,
   });
 
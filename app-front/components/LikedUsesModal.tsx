@@ -14,14 +14,14 @@ import { useColorScheme } from 'react-native';
 import { Colors } from '@/constants/Colors';
 import UserProfileModal from './UserProfileModal';
 import { useModalStack } from '@/providers/ModalStackContext';
-import { Like } from '@/features/like/schema';
+import usePostLikes from '@/features/post/usePostLikes';
 
 const { width } = Dimensions.get('window');
 
 type Props = {
   visible: boolean;
   onClose: () => void;
-  likes: Like[];
+  postId: string;
   slideAnim: Animated.Value;
   prevModalIdx: number;
 };
@@ -29,19 +29,23 @@ type Props = {
 const LikedUserModal: React.FC<Props> = ({
   visible,
   onClose,
-  likes,
+  postId,
   slideAnim,
   prevModalIdx,
 }) => {
+  const { likes, fetchNextPage, hasNextPage } = usePostLikes({
+    postId,
+    enabled: visible,
+  });
   const [isProfileModalVisible, setIsProfileModalVisible] = useState(false);
-  const [selectedUserEmail, setSelectedUserEmail] = useState<string | null>(
+  const [selectedUserId, setSelectedUserId] = useState<string | null>(
     null
   );
   const slideAnimProfile = useRef(new Animated.Value(width)).current;
   const { push, pop } = useModalStack();
 
-  const openUserProfile = (email: string) => {
-    setSelectedUserEmail(email);
+  const openUserProfile = (userId: string) => {
+    setSelectedUserId(userId);
     setIsProfileModalVisible(true);
     push(`${prevModalIdx + 2}`);
     Animated.timing(slideAnimProfile, {
@@ -82,6 +86,9 @@ const LikedUserModal: React.FC<Props> = ({
         <FlatList
           data={likes}
           keyExtractor={(item) => item.id}
+          onEndReached={() => {
+            if (hasNextPage) fetchNextPage();
+          }}
           contentContainerStyle={{
             paddingTop: 80,
             backgroundColor: colors.middleBackground,
@@ -89,7 +96,7 @@ const LikedUserModal: React.FC<Props> = ({
           renderItem={({ item }) => (
             <TouchableOpacity
               style={styles.userItem}
-              onPress={() => openUserProfile(item.user.email)}
+              onPress={() => openUserProfile(item.user.id)}
             >
               <Image
                 source={
@@ -107,11 +114,11 @@ const LikedUserModal: React.FC<Props> = ({
         />
       </View>
 
-      {selectedUserEmail && (
+      {selectedUserId && (
         <UserProfileModal
           prevModalIdx={prevModalIdx + 1}
-          key={selectedUserEmail}
-          email={selectedUserEmail}
+          key={selectedUserId}
+          userId={selectedUserId}
           visible={isProfileModalVisible}
           onClose={closeUserProfile}
           slideAnim={slideAnimProfile}
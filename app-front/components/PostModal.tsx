@@ -37,7 +37,7 @@ const PostModal: React.FC<Props> = ({ post, visible, onClose }) => {
 
   const { push, pop } = useModalStack();
 
-  const { isMyPost, comments, onNewComment, handleDelete } = usePostModal({
+  const { isMyPost, handleDelete } = usePostModal({
     post,
     onClose,
   });
@@ -167,7 +167,7 @@ const PostModal: React.FC<Props> = ({ post, visible, onClose }) => {
               >
                 <Ionicons name="heart" size={20} color={colors.text} />
                 <Text style={[styles.reactionText, { color: colors.text }]}>
-                  {post.likes.length}
+                  {post.likesCount}
                 </Text>
               </TouchableOpacity>
 
@@ -181,7 +181,7 @@ const PostModal: React.FC<Props> = ({ post, visible, onClose }) => {
                   color={colors.text}
                 />
                 <Text style={[styles.reactionText, { color: colors.text }]}>
-                  {post.comments.length}
+                  {post.commentsCount}
                 </Text>
               </TouchableOpacity>
             </View>
@@ -194,20 +194,19 @@ const PostModal: React.FC<Props> = ({ post, visible, onClose }) => {
       </View>
       {isCommentModalVisible && (
         <CommentsModal
-          comments={comments}
           slideAnim={slideAnimComment}
           visible={isCommentModalVisible}
           postId={post.id}
           onClose={onCloseCommentModal}
-          queryKey={['userProfile', post.user.email]}
-          onNewComment={onNewComment}
+          queryKey={['userProfile', post.user.id]}
+          onNewComment={() => {}}
         />
       )}
 
       <LikedUserModal
         visible={isLikedUserModalVisible}
         onClose={onCloseLikeModal}
-        likes={post.likes}
+        postId={post.id}
         slideAnim={slideAnimLike}
         prevModalIdx={0}
       />
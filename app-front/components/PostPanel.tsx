@@ -256,7 +256,6 @@ export const PostPanel = ({ post }: Props) => {
         slideAnim={slideAnim}
         postId={post.id}
         visible={isModalVisible}
-        comments={post.comments}
         onClose={closeModal}
         queryKey={['posts']}
         onNewComment={() => {}}
@@ -264,7 +263,7 @@ export const PostPanel = ({ post }: Props) => {
       <UserProfileModal
         prevModalIdx={0}
         key={post.user.id}
-        email={post.user.email}
+        userId={post.user.id}
         visible={isUserModalVisible}
         onClose={closeUserProfile}
         slideAnim={slideAnimUser}
@@ -69,10 +69,7 @@ export default function RecommendedPostsList({ scrollY, listRef }: Props) {
       });
     },
     initialPageParam: null,
-    getNextPageParam: (lastPage) => {
-      if (lastPage.posts.length < 10) return undefined;
-      return lastPage.posts[lastPage.posts.length - 1]?.id;
-    },
+    getNextPageParam: (lastPage) => lastPage.nextCursor || undefined,
     enabled: !!currentUser?.id,
   });
 
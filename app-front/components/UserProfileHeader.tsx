@@ -31,7 +31,6 @@ type UserProfileHeaderProps = {
   onOpenFollowModal: () => void;
   setSelectedTab: (tab: 'follows' | 'followers') => void;
   isFollowing: boolean;
-  isBlocked: boolean;
   isBlocking: boolean;
   blockMutation: UseMutationResult<void, Error, void>;
   unBlockMutation: UseMutationResult<void, Error, void>;
@@ -44,7 +43,6 @@ const UserProfileHeader: React.FC<UserProfileHeaderProps> = ({
   onOpenFollowModal,
   setSelectedTab,
   isFollowing,
-  isBlocked,
   isBlocking,
   blockMutation,
   unBlockMutation,
@@ -187,7 +185,6 @@ const UserProfileHeader: React.FC<UserProfileHeaderProps> = ({
               <TouchableOpacity
                 style={styles.followBox}
                 onPress={() => handleOpenFollowModal('follows')}
-                disabled={isBlocked}
               >
                 <Text style={[styles.followCount, { color: colors.tint }]}>
                   {user.followsCount}
@@ -199,7 +196,6 @@ const UserProfileHeader: React.FC<UserProfileHeaderProps> = ({
               <TouchableOpacity
                 style={styles.followBox}
                 onPress={() => handleOpenFollowModal('followers')}
-                disabled={isBlocked}
               >
                 <Text style={[styles.followCount, { color: colors.tint }]}>
                   {user.followersCount}
@@ -208,7 +204,7 @@ const UserProfileHeader: React.FC<UserProfileHeaderProps> = ({
                   フォロワー
                 </Text>
               </TouchableOpacity>
-              {!isMe && !isBlocked && !isBlocking && (
+              {!isMe && !isBlocking && (
                 <TouchableOpacity
                   style={[styles.followButton, { borderColor: colors.tint }]}
                   onPress={onPressFollow}
@@ -24,12 +24,11 @@ import { useModalStack } from '@/providers/ModalStackContext';
 import { FontAwesome5 } from '@expo/vector-icons';
 import * as Haptics from 'expo-haptics';
 import useUserProfileModal from '@/features/user/useUserProfileModal';
-import { useAuth } from '@/providers/AuthContext';
 
 const { width } = Dimensions.get('window');
 
 type Props = {
-  email: string;
+  userId: string;
   visible: boolean;
   onClose: () => void;
   slideAnim: Animated.Value;
@@ -40,13 +39,12 @@ const windowWidth = Dimensions.get('window').width;
 const windowHeight = Dimensions.get('window').height;
 
 const UserProfileModal: React.FC<Props> = ({
-  email,
+  userId,
   visible,
   onClose,
   slideAnim,
   prevModalIdx,
 }) => {
-  const { user: currentUser } = useAuth();
   const [selectedFollowTab, setSelectedFollowTab] = useState<
     'follows' | 'followers'
   >('follows');
@@ -74,10 +72,11 @@ const UserProfileModal: React.FC<Props> = ({
     handlePressFollowButton,
     isMe,
     isFollowing,
+    isBlocking,
     blockMutation,
     unBlockMutation,
   } = useUserProfileModal({
-    email,
+    userId,
     visible,
   });
 
@@ -187,18 +186,6 @@ const UserProfileModal: React.FC<Props> = ({
     extrapolate: 'clamp',
   });
 
-  const isBlocked = useMemo(() => {
-    return (
-      currentUser?.blockedByUsers?.some((user) => user.email === email) ?? false
-    );
-  }, [currentUser?.blockedByUsers, email]);
-
-  const isBlocking = useMemo(() => {
-    return (
-      currentUser?.blockingUsers?.some((user) => user.email === email) ?? false
-    );
-  }, [currentUser?.blockingUsers, email]);
-
   if (!user || isLoading) {
     return (
       <Modal visible={visible} animationType="none" transparent>
@@ -279,7 +266,6 @@ const UserProfileModal: React.FC<Props> = ({
               onOpenFollowModal={onOpenFollowModal}
               setSelectedTab={setSelectedFollowTab}
               isFollowing={isFollowing}
-              isBlocked={isBlocked}
               isBlocking={isBlocking}
               blockMutation={blockMutation}
               unBlockMutation={unBlockMutation}
@@ -318,13 +304,13 @@ const UserProfileModal: React.FC<Props> = ({
                   }}
                 >
                   <UserPostList
-                    posts={!isBlocked ? user.posts : []}
+                    posts={user.posts}
                     colorScheme={colorScheme}
                   />
                 </View>
                 <View style={{ width: windowWidth }}>
                   <UserPetList
-                    pets={!isBlocked ? user.pets : []}
+                    pets={user.pets}
                     colorScheme={colorScheme}
                   />
                 </View>
@@ -341,8 +327,6 @@ const UserProfileModal: React.FC<Props> = ({
           visible={isFollowModalVisible}
           user={user}
           onClose={onCloseFollowModal}
-          follows={user.follows}
-          followers={user.followers}
           selectedTab={selectedFollowTab}
           setSelectedTab={setSelectedFollowTab}
           slideAnim={slideAnimFollow}
@@ -2,6 +2,7 @@ import { Colors } from '@/constants/Colors';
 import { UserBase } from '@/features/user/schema';
 import React from 'react';
 import {
+  ActivityIndicator,
   FlatList,
   Text,
   TouchableOpacity,
@@ -15,14 +16,21 @@ const { width } = Dimensions.get('window');
 
 type Props = {
   users: UserBase[];
-  onSelectUser: (email: string) => void;
+  onSelectUser: (userId: string) => void;
   backgroundColor: string;
+  // 一覧はページングされているので、続きがあれば末尾に読み込みボタンを出す
+  hasMore?: boolean;
+  isLoadingMore?: boolean;
+  onLoadMore?: () => void;
 };
 
 export default function UsersList({
   users,
   onSelectUser,
   backgroundColor,
+  hasMore = false,
+  isLoadingMore = false,
+  onLoadMore,
 }: Props) {
   const colorScheme = useColorScheme();
   const colors = Colors[colorScheme ?? 'light'];
@@ -35,7 +43,7 @@ export default function UsersList({
       renderItem={({ item }) => (
         <TouchableOpacity
           style={styles.userItem}
-          onPress={() => onSelectUser(item.email)}
+          onPress={() => onSelectUser(item.id)}
         >
           <Image
             source={
@@ -50,6 +58,17 @@ export default function UsersList({
           </Text>
         </TouchableOpacity>
       )}
+      ListFooterComponent={
+        isLoadingMore ? (
+          <ActivityIndicator style={styles.loadMore} color="#999" />
+        ) : hasMore ? (
+          <TouchableOpacity style={styles.loadMore} onPress={onLoadMore}>
+            <Text style={[styles.loadMoreText, { color: colors.tint }]}>
+              もっと見る
+            </Text>
+          </TouchableOpacity>
+        ) : null
+      }
     />
   );
 }
@@ -71,4 +90,11 @@ const styles = StyleSheet.create({
     fontSize: 16,
     fontWeight: 'bold',
   },
+  loadMore: {
+    padding: 16,
+    alignItems: 'center',
+  },
+  loadMoreText: {
+    fontSize: 14,
+  },
 });
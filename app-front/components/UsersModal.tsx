@@ -17,6 +17,7 @@ import UserProfileModal from './UserProfileModal';
 import { useModalStack } from '@/providers/ModalStackContext';
 import UsersList from './UsersList';
 import { UserBase } from '@/features/user/schema';
+import useUserList from '@/features/user/useUserList';
 
 const { width, height } = Dimensions.get('window');
 
@@ -24,8 +25,6 @@ type Props = {
   user: UserBase;
   visible: boolean;
   onClose: () => void;
-  follows: UserBase[];
-  followers: UserBase[];
   selectedTab: 'follows' | 'followers';
   setSelectedTab: (tab: 'follows' | 'followers') => void;
   slideAnim: Animated.Value;
@@ -36,20 +35,28 @@ const UsersModal: React.FC<Props> = ({
   user,
   visible,
   onClose,
-  follows,
-  followers,
   selectedTab,
   setSelectedTab,
   slideAnim,
   prevModalIdx,
 }) => {
   const [isProfileModalVisible, setIsProfileModalVisible] = useState(false);
-  const [selectedUserEmail, setSelectedUserEmail] = useState<string | null>(
+  const [selectedUserId, setSelectedUserId] = useState<string | null>(
     null
   );
   const slideAnimProfile = useRef(new Animated.Value(width)).current;
   const { push, pop, isTop } = useModalStack();
   const modalKey = `${prevModalIdx + 1}`;
+  const follows = useUserList({
+    userId: user.id,
+    kind: 'following',
+    enabled: visible,
+  });
+  const followers = useUserList({
+    userId: user.id,
+    kind: 'followers',
+    enabled: visible,
+  });
 
   useEffect(() => {
     if (visible) {
@@ -61,8 +68,8 @@ const UsersModal: React.FC<Props> = ({
     }
     // eslint-disable-next-line react-hooks/exhaustive-deps
   }, [visible]);
-  const openUserProfile = (email: string) => {
-    setSelectedUserEmail(email);
+  const openUserProfile = (userId: string) => {
+    setSelectedUserId(userId);
     setIsProfileModalVisible(true);
     push(`${prevModalIdx + 2}`);
     Animated.timing(slideAnimProfile, {
@@ -221,14 +228,20 @@ const UsersModal: React.FC<Props> = ({
                 scrollEventThrottle={16}
               >
                 <UsersList
-                  users={follows}
+                  users={follows.users}
                   onSelectUser={openUserProfile}
                   backgroundColor={colors.middleBackground}
+                  hasMore={!!follows.hasNextPage}
+                  isLoadingMore={follows.isFetchingNextPage}
+                  onLoadMore={follows.fetchNextPage}
                 />
                 <UsersList
-                  users={followers}
+                  users={followers.users}
                   onSelectUser={openUserProfile}
                   backgroundColor={colors.middleBackground}
+                  hasMore={!!followers.hasNextPage}
+                  isLoadingMore={followers.isFetchingNextPage}
+                  onLoadMore={followers.fetchNextPage}
                 />
               </ScrollView>
             </Pressable>
@@ -238,8 +251,8 @@ const UsersModal: React.FC<Props> = ({
       <UserProfileModal
         prevModalIdx={prevModalIdx + 1}
         key={prevModalIdx + 1}
-        email={selectedUserEmail ?? ''}
-        visible={isProfileModalVisible && !!selectedUserEmail}
+        userId={selectedUserId ?? ''}
+        visible={isProfileModalVisible && !!selectedUserId}
         onClose={closeUserProfile}
         slideAnim={slideAnimProfile}
       />
@@ -1,11 +1,27 @@
 import { z } from 'zod';
-import { userBaseSchema } from '../user/schema';
+import { mentionSchema, userBaseSchema } from '../user/schema';
 
 export const commentSchema = z.object({
   id: z.string(),
+  // 返信のときのみ、返信先のコメント
+  parentId: z.string().nullable(),
   user: userBaseSchema,
   content: z.string(),
+  mentions: z.array(mentionSchema),
   createdAt: z.string().datetime(),
+  edited: z.boolean(),
+  editedAt: z.string().datetime().nullable(),
+  repliesCount: z.number(),
+  likesCount: z.number(),
+  likedByMe: z.boolean(),
 });
 
 export type Comment = z.infer<typeof commentSchema>;
+
+// 古い順。続きは nextCursor を cursor に渡して取得する
+export const commentRepliesResponseSchema = z.object({
+  replies: z.array(commentSchema),
+  nextCursor: z.string(),
+});
+
+export type CommentRepliesResponse = z.infer<typeof commentRepliesResponseSchema>;
@@ -1,9 +1,14 @@
 import { z } from 'zod';
 import { userBaseSchema } from '../user/schema';
 
+export const reactionTypeSchema = z.enum(['paw', 'heart', 'laugh', 'wow']);
+
+export type ReactionType = z.infer<typeof reactionTypeSchema>;
+
 export const likeSchema = z.object({
   id: z.string(),
   user: userBaseSchema,
+  reaction: reactionTypeSchema,
   createdAt: z.string().datetime(),
 });
 
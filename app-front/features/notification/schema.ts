@@ -0,0 +1,26 @@
+import { z } from 'zod';
+import { userBaseSchema } from '../user/schema';
+
+export const notificationSchema = z.object({
+  id: z.string().uuid(),
+  type: z.enum(['mention']),
+  actor: userBaseSchema,
+  // メンションされた投稿。コメントでのメンションは commentId も入る
+  postId: z.string().uuid().nullable(),
+  commentId: z.string().uuid().nullable(),
+  read: z.boolean(),
+  createdAt: z.string().datetime(),
+});
+
+export type Notification = z.infer<typeof notificationSchema>;
+
+export const notificationsResponseSchema = z.object({
+  notifications: z.array(notificationSchema),
+  nextCursor: z.string(),
+});
+
+export type NotificationsResponse = z.infer<typeof notificationsResponseSchema>;
+
+export const unreadCountResponseSchema = z.object({
+  count: z.number(),
+});
@@ -1,3 +1,4 @@
+import { userBaseSchema } from '@/features/user/schema';
 import { isValidDate } from '@/utils/date';
 import { z } from 'zod';
 
@@ -12,6 +13,24 @@ export const petSchema = z.object({
 
 export type Pet = z.infer<typeof petSchema>;
 
+// GET /pets/:id。投稿数と最新の写真は閲覧者に見える投稿のみ
+export const petProfileSchema = petSchema.extend({
+  owner: userBaseSchema,
+  postsCount: z.number(),
+  latestPhotos: z.array(
+    z.object({
+      postId: z.string().uuid(),
+      imageUrl: z.string().min(1),
+    })
+  ),
+});
+
+export type PetProfile = z.infer<typeof petProfileSchema>;
+
+export const petProfileResponseSchema = z.object({
+  pet: petProfileSchema,
+});
+
 export const petFormSchema = z.object({
   name: z.string().min(1, { message: '名前は必須です' }),
   petType: z.enum(['dog', 'cat'], { required_error: '種類は必須です' }),
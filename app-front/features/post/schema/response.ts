@@ -1,19 +1,60 @@
 import { commentSchema } from '@/features/comment/schema';
 import { dailyTaskBaseSchema } from '@/features/dailytask/schema';
-import { likeSchema } from '@/features/like/schema';
-import { userBaseSchema } from '@/features/user/schema';
+import { likeSchema, reactionTypeSchema } from '@/features/like/schema';
+import { mentionSchema, userBaseSchema } from '@/features/user/schema';
 import { z } from 'zod';
 
+export const mediaTypeSchema = z.enum(['image', 'video']);
+
+export const postMediaSchema = z.object({
+  type: mediaTypeSchema,
+  url: z.string().min(1),
+  // 読み取れなかった場合は null
+  width: z.number().nullable(),
+  height: z.number().nullable(),
+  altText: z.string(),
+  // 動画のみ。duration は秒
+  duration: z.number().nullable(),
+  posterUrl: z.string().nullable(),
+});
+
+export type PostMedia = z.infer<typeof postMediaSchema>;
+
+// 投稿にタグ付けされた投稿者のペット
+export const petTagSchema = z.object({
+  id: z.string().uuid(),
+  name: z.string().min(1),
+  type: z.enum(['dog', 'cat']),
+  species: z.string().min(1),
+  imageUrl: z.string().min(1),
+});
+
+export type PetTag = z.infer<typeof petTagSchema>;
+
 export const postResponseSchema = z.object({
   id: z.string().uuid(),
   caption: z.string().min(0),
+  // 1枚目の画像か動画のポスター画像。全画像は media に表示順で入る
   imageUrl: z.string().min(1),
+  mediaType: mediaTypeSchema,
+  media: z.array(postMediaSchema),
+  pets: z.array(petTagSchema),
+  mentions: z.array(mentionSchema),
+  duration: z.number().nullable(),
+  posterUrl: z.string().nullable(),
   user: userBaseSchema,
-  comments: z.array(commentSchema),
+  // 新しい順に最大3件。全件は posts/:id/comments で取得する
+  recentComments: z.array(commentSchema),
   commentsCount: z.number(),
-  likes: z.array(likeSchema),
+  // likesCount は reactions の合計
   likesCount: z.number(),
+  likedByMe: z.boolean(),
+  reactions: z.record(reactionTypeSchema, z.number()),
+  myReaction: reactionTypeSchema.nullable(),
   createdAt: z.string().datetime(),
+  // キャプションが編集された投稿のみ editedAt が入る
+  edited: z.boolean(),
+  editedAt: z.string().datetime().nullable(),
   dailyTask: dailyTaskBaseSchema.optional().nullable(),
 });
 
@@ -21,6 +62,68 @@ export type PostResponse = z.infer<typeof postResponseSchema>;
 
 export const getPostsResponseSchema = z.object({
   posts: z.array(postResponseSchema),
+  // 最後のページでは空文字。タイムライン (posts/timeline) には含まれない
+  nextCursor: z.string().optional(),
+  // タイムラインのみ。アルゴリズムが使えないときは fallback になる
+  source: z.enum(['algorithm', 'fallback', 'cache']).optional(),
 });
 
 export type GetPostsResponse = z.infer<typeof getPostsResponseSchema>;
+
+export const getPostResponseSchema = z.object({
+  post: postResponseSchema,
+});
+
+export const postCommentsResponseSchema = z.object({
+  comments: z.array(commentSchema),
+  nextCursor: z.string(),
+});
+
+export type PostCommentsResponse = z.infer<typeof postCommentsResponseSchema>;
+
+export const postLikesResponseSchema = z.object({
+  likes: z.array(likeSchema),
+  nextCursor: z.string(),
+});
+
+export type PostLikesResponse = z.infer<typeof postLikesResponseSchema>;
+
+export const tagPostsResponseSchema = z.object({
+  // name は正規化済み (小文字、# なし)
+  tag: z.object({
+    name: z.string().min(1),
+    postsCount: z.number(),
+  }),
+  posts: z.array(postResponseSchema),
+  nextCursor: z.string(),
+});
+
+export type TagPostsResponse = z.infer<typeof tagPostsResponseSchema>;
+
+export const trendingTagSchema = z.object({
+  name: z.string().min(1),
+  // 直近24時間とその前の24時間にタグ付けされた投稿数
+  postsCount: z.number(),
+  previousCount: z.number(),
+  score: z.number(),
+});
+
+export type TrendingTag = z.infer<typeof trendingTagSchema>;
+
+export const trendingTagsResponseSchema = z.object({
+  tags: z.array(trendingTagSchema),
+});
+
+export const postRevisionSchema = z.object({
+  id: z.string().uuid(),
+  // 編集前のキャプションとタグ付けされていたペット
+  caption: z.string(),
+  petIds: z.array(z.string().uuid()),
+  createdAt: z.string().datetime(),
+});
+
+export type PostRevision = z.infer<typeof postRevisionSchema>;
+
+export const postRevisionsResponseSchema = z.object({
+  revisions: z.array(postRevisionSchema),
+});
@@ -69,7 +69,8 @@ export const useCreatePostModal = ({
     }
 
     const fd = new FormData();
-    fd.append('image', {
+    // images は表示順に最大10枚。altTexts を同じ順番で付けられる
+    fd.append('images', {
       uri: formData.imageUri,
       name: 'photo.jpg',
       type: 'image/jpeg',
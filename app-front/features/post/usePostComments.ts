@@ -0,0 +1,58 @@
+import { useAuth } from '@/providers/AuthContext';
+import { InfiniteData, useInfiniteQuery } from '@tanstack/react-query';
+import { fetchApi } from '@/utils/api';
+import {
+  PostCommentsResponse,
+  postCommentsResponseSchema,
+} from './schema/response';
+
+const PAGE_SIZE = 20;
+
+type Props = {
+  postId: string;
+  enabled: boolean;
+};
+
+export default function usePostComments({ postId, enabled }: Props) {
+  const { token } = useAuth();
+
+  const {
+    data,
+    fetchNextPage,
+    hasNextPage,
+    isFetchingNextPage,
+    isLoading,
+    refetch,
+  } = useInfiniteQuery<
+    PostCommentsResponse,
+    Error,
+    InfiniteData<PostCommentsResponse>,
+    [string, string],
+    string | null
+  >({
+    queryKey: ['postComments', postId],
+    queryFn: async ({ pageParam = null }) => {
+      return await fetchApi({
+        method: 'GET',
+        path: `posts/${postId}/comments?limit=${PAGE_SIZE}${
+          pageParam ? `&cursor=${pageParam}` : ''
+        }`,
+        schema: postCommentsResponseSchema,
+        options: {},
+        token,
+      });
+    },
+    initialPageParam: null,
+    getNextPageParam: (lastPage) => lastPage.nextCursor || undefined,
+    enabled: !!postId && enabled,
+  });
+
+  return {
+    comments: data?.pages.flatMap((page) => page.comments) ?? [],
+    fetchNextPage,
+    hasNextPage,
+    isFetchingNextPage,
+    isLoading,
+    refetch,
+  };
+}
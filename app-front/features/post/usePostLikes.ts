@@ -0,0 +1,58 @@
+import { useAuth } from '@/providers/AuthContext';
+import { InfiniteData, useInfiniteQuery } from '@tanstack/react-query';
+import { fetchApi } from '@/utils/api';
+import {
+  PostLikesResponse,
+  postLikesResponseSchema,
+} from './schema/response';
+
+const PAGE_SIZE = 20;
+
+type Props = {
+  postId: string;
+  enabled: boolean;
+};
+
+export default function usePostLikes({ postId, enabled }: Props) {
+  const { token } = useAuth();
+
+  const {
+    data,
+    fetchNextPage,
+    hasNextPage,
+    isFetchingNextPage,
+    isLoading,
+    refetch,
+  } = useInfiniteQuery<
+    PostLikesResponse,
+    Error,
+    InfiniteData<PostLikesResponse>,
+    [string, string],
+    string | null
+  >({
+    queryKey: ['postLikes', postId],
+    queryFn: async ({ pageParam = null }) => {
+      return await fetchApi({
+        method: 'GET',
+        path: `posts/${postId}/likes?limit=${PAGE_SIZE}${
+          pageParam ? `&cursor=${pageParam}` : ''
+        }`,
+        schema: postLikesResponseSchema,
+        options: {},
+        token,
+      });
+    },
+    initialPageParam: null,
+    getNextPageParam: (lastPage) => lastPage.nextCursor || undefined,
+    enabled: !!postId && enabled,
+  });
+
+  return {
+    likes: data?.pages.flatMap((page) => page.likes) ?? [],
+    fetchNextPage,
+    hasNextPage,
+    isFetchingNextPage,
+    isLoading,
+    refetch,
+  };
+}
@@ -1,9 +1,8 @@
 import { useAuth } from '@/providers/AuthContext';
 import { fetchApi } from '@/utils/api';
-import { useCallback, useMemo, useState } from 'react';
+import { useCallback, useMemo } from 'react';
 import { Alert } from 'react-native';
 import { z } from 'zod';
-import { Comment } from '../comment/schema';
 import { PostResponse } from './schema/response';
 type Props = {
   post: PostResponse;
@@ -13,17 +12,11 @@ type Props = {
 export default function usePostModal({ post, onClose }: Props) {
   const { user, refetch, token } = useAuth();
 
-  const [comments, setComments] = useState(post.comments);
-
   const isMyPost = useMemo(
     () => (post.user.id === user?.id ? true : false),
     [post.user.id, user?.id]
   );
 
-  const onNewComment = (comment: Comment) => {
-    setComments((prev) => [...prev, comment]);
-  };
-
   const handleDelete = useCallback(async () => {
     try {
       await fetchApi({
@@ -42,5 +35,5 @@ export default function usePostModal({ post, onClose }: Props) {
     }
   }, [onClose, post.id, refetch, token]);
 
-  return { isMyPost, comments, onNewComment, handleDelete };
+  return { isMyPost, handleDelete };
 }
@@ -11,7 +11,7 @@ export default function usePostsScreen({ post }: Props) {
   const { user: currentUser } = useAuth();
 
   const [likedByCurrentUser, setLikedByCurrentUser] = useState<boolean>(
-    post.likes?.some((like) => like.user.id === currentUser?.id) ?? false
+    post.likedByMe
   );
 
   const { setLiked, isLoading: isLoadingLike } = useToggleLike(
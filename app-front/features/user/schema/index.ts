@@ -2,7 +2,7 @@ import { z } from 'zod';
 
 export const userBaseSchema = z.object({
   id: z.string().uuid(),
-  email: z.string(),
+  handle: z.string(),
   name: z.string(),
   bio: z.string(),
   iconImageUrl: z.string().nullable(),
@@ -10,6 +10,16 @@ export const userBaseSchema = z.object({
 
 export type UserBase = z.infer<typeof userBaseSchema>;
 
+// キャプションやコメント中のメンション。start / end は JavaScript の文字列と同じ UTF-16 の位置で、"@" を含む
+export const mentionSchema = z.object({
+  userId: z.string().uuid(),
+  handle: z.string(),
+  start: z.number(),
+  end: z.number(),
+});
+
+export type Mention = z.infer<typeof mentionSchema>;
+
 export const profileEditSchema = z.object({
   imageUri: z.string().nullable(),
   name: z.string().min(1, { message: '名前は必須です' }),
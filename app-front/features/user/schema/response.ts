@@ -5,16 +5,34 @@ import { dailyTaskSchema } from '@/features/dailytask/schema';
 import { postResponseSchema } from '@/features/post/schema/response';
 
 export const userResponseSchema = userBaseSchema.extend({
-  followers: z.array(userBaseSchema),
-  follows: z.array(userBaseSchema),
+  // 自分のプロフィール (/auth/me) にだけ含まれる
+  email: z.string().optional(),
   followersCount: z.number(),
   followsCount: z.number(),
+  postsCount: z.number(),
   posts: z.array(postResponseSchema),
+  // 続きの投稿は users/:id/posts?cursor= で取得する
+  postsNextCursor: z.string(),
   pets: z.array(petSchema),
   dailyTask: dailyTaskSchema,
   streakCount: z.number(),
-  blockingUsers: z.array(userBaseSchema),
-  blockedByUsers: z.array(userBaseSchema),
+  isPrivate: z.boolean(),
+  // 自分のプロフィールには含まれない
+  followStatus: z.enum(['none', 'requested', 'following']).optional(),
+  isBlocking: z.boolean(),
 });
 
 export type UserResponse = z.infer<typeof userResponseSchema>;
+
+export const userListItemSchema = userBaseSchema.extend({
+  followedByMe: z.boolean(),
+});
+
+export type UserListItem = z.infer<typeof userListItemSchema>;
+
+export const userListResponseSchema = z.object({
+  users: z.array(userListItemSchema),
+  nextCursor: z.string(),
+});
+
+export type UserListResponse = z.infer<typeof userListResponseSchema>;
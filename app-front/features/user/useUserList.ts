@@ -0,0 +1,51 @@
+import { useAuth } from '@/providers/AuthContext';
+import { InfiniteData, useInfiniteQuery } from '@tanstack/react-query';
+import { fetchApi } from '@/utils/api';
+import { UserListResponse, userListResponseSchema } from './schema/response';
+
+export type UserListKind = 'followers' | 'following' | 'blocking';
+
+const PAGE_SIZE = 20;
+
+type Props = {
+  userId: string;
+  kind: UserListKind;
+  enabled: boolean;
+};
+
+export default function useUserList({ userId, kind, enabled }: Props) {
+  const { token } = useAuth();
+
+  const { data, fetchNextPage, hasNextPage, isFetchingNextPage, isLoading } =
+    useInfiniteQuery<
+      UserListResponse,
+      Error,
+      InfiniteData<UserListResponse>,
+      [string, string, UserListKind],
+      string | null
+    >({
+      queryKey: ['userList', userId, kind],
+      queryFn: async ({ pageParam = null }) => {
+        return await fetchApi({
+          method: 'GET',
+          path: `users/${userId}/${kind}?limit=${PAGE_SIZE}${
+            pageParam ? `&cursor=${pageParam}` : ''
+          }`,
+          schema: userListResponseSchema,
+          options: {},
+          token,
+        });
+      },
+      initialPageParam: null,
+      getNextPageParam: (lastPage) => lastPage.nextCursor || undefined,
+      enabled: !!userId && enabled,
+    });
+
+  return {
+    users: data?.pages.flatMap((page) => page.users) ?? [],
+    fetchNextPage,
+    hasNextPage,
+    isFetchingNextPage,
+    isLoading,
+  };
+}
@@ -7,11 +7,11 @@ import { UserResponse, userResponseSchema } from './schema/response';
 import { Alert } from 'react-native';
 
 type Props = {
-  email: string;
+  userId: string;
   visible: boolean;
 };
 
-export default function useUserProfileModal({ email, visible }: Props) {
+export default function useUserProfileModal({ userId, visible }: Props) {
   const queryClient = useQueryClient();
   const { user: currentUser, token, refetch: refetchUser } = useAuth();
 
@@ -21,54 +21,56 @@ export default function useUserProfileModal({ email, visible }: Props) {
     refetch,
     isRefetching,
   } = useQuery<UserResponse>({
-    queryKey: ['userProfile', email],
+    queryKey: ['userProfile', userId],
     queryFn: async () => {
       const res = await fetchApi({
         method: 'GET',
-        path: `users?email=${email}`,
+        path: `users/${userId}`,
         schema: z.object({ user: userResponseSchema }),
         options: {},
         token,
       });
       return res.user;
     },
-    enabled: !!email && visible,
+    enabled: !!userId && visible,
   });
 
   const isMe = useMemo(() => {
     return !!user?.id && user.id === currentUser?.id;
   }, [currentUser?.id, user?.id]);
 
-  const isFollowing = useMemo(() => {
-    if (!user) {
-      return false;
-    }
-    return user.followers.some((f) => f.id === currentUser?.id);
-  }, [user, currentUser?.id]);
+  const isFollowing = user?.followStatus === 'following';
+  const isBlocking = user?.isBlocking ?? false;
 
   const followMutation = useMutation({
     mutationFn: () =>
       fetchApi({
         method: 'POST',
         path: `users/follow?toId=${user?.id}&fromId=${currentUser?.id}`,
-        schema: z.any(),
+        schema: z.object({
+          status: z.enum(['none', 'requested', 'following']),
+        }),
         options: {},
         token,
       }),
-    onSuccess: () => {
+    onSuccess: ({ status }) => {
       queryClient.setQueryData(
-        ['userProfile', email],
+        ['userProfile', userId],
         (prev: UserResponse | undefined) => {
           if (!prev) {
             return prev;
           }
           return {
             ...prev,
-            followers: [...prev.followers, currentUser],
-            followersCount: prev.followersCount + 1,
+            followStatus: status,
+            followersCount:
+              status === 'following'
+                ? prev.followersCount + 1
+                : prev.followersCount,
           };
         }
       );
+      queryClient.invalidateQueries({ queryKey: ['userList', userId] });
     },
   });
 
@@ -83,18 +85,19 @@ export default function useUserProfileModal({ email, visible }: Props) {
       }),
     onSuccess: () => {
       queryClient.setQueryData(
-        ['userProfile', email],
+        ['userProfile', userId],
         (prev: UserResponse | undefined) => {
           if (!prev) {
             return prev;
           }
           return {
             ...prev,
-            followers: prev.followers.filter((f) => f.id !== currentUser?.id),
+            followStatus: 'none' as const,
             followersCount: prev.followersCount - 1,
           };
         }
       );
+      queryClient.invalidateQueries({ queryKey: ['userList', userId] });
     },
   });
 
@@ -113,10 +116,7 @@ export default function useUserProfileModal({ email, visible }: Props) {
       });
     },
     onSuccess: async () => {
-      queryClient.setQueryData(
-        ['userProfile', email],
-        (prev: UserResponse | undefined) => prev
-      );
+      await refetch();
       await refetchUser();
     },
     onError: (error) => {
@@ -140,10 +140,7 @@ export default function useUserProfileModal({ email, visible }: Props) {
       });
     },
     onSuccess: async () => {
-      queryClient.setQueryData(
-        ['userProfile', email],
-        (prev: UserResponse | undefined) => prev
-      );
+      await refetch();
       await refetchUser();
     },
     onError: (error) => {
@@ -167,6 +164,7 @@ export default function useUserProfileModal({ email, visible }: Props) {
     isRefetching,
     isMe,
     isFollowing,
+    isBlocking,
     handlePressFollowButton,
     blockMutation,
     unBlockMutation,
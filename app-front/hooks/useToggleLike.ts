@@ -26,7 +26,7 @@ const useToggleLike = (postId: string, userId: string) => {
       // 対象のpostsクエリをキャンセルしてスナップショットを取る
       await queryClient.cancelQueries({ queryKey: ['posts'] });
       const previousPosts = queryClient.getQueryData<any[]>(['posts']);
-      // 楽観的更新：対象の投稿の likesCount を +1、かつ likedByCurrentUser を true に更新
+      // 楽観的更新：対象の投稿の likesCount を +1、かつ likedByMe を true に更新
       queryClient.setQueryData(
         ['posts'],
         (oldData: InfiniteData<GetPostsResponse> | undefined) => {
@@ -42,7 +42,7 @@ const useToggleLike = (postId: string, userId: string) => {
                 p.id === postId
                   ? {
                       ...p,
-                      likedByCurrentUser: true,
+                      likedByMe: true,
                       likesCount: p.likesCount + 1,
                     }
                   : p
@@ -90,7 +90,7 @@ const useToggleLike = (postId: string, userId: string) => {
                 p.id === postId
                   ? {
                       ...p,
-                      likedByCurrentUser: true,
+                      likedByMe: false,
                       likesCount: p.likesCount - 1,
                     }
                   : p
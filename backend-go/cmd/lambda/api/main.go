@@ -99,11 +99,16 @@ func init() {
 	routes.SetupAuthRoutes(app)
 	routes.SetupPetRoutes(app)
 	routes.SetupPostRoutes(app)
+	routes.SetupTagRoutes(app)
+	routes.SetupNotificationRoutes(app)
 	routes.SetupUserRoutes(app)
+	routes.SetupFollowRequestRoutes(app)
+	routes.SetupMuteRoutes(app)
 	routes.SetupLikeRoutes(app)
 	routes.SetupCommentRoutes(app)
 	routes.SetupDeviceTokenRoutes(app)
 	routes.SetupReportRoutes(app)
+	routes.SetupAdminRoutes(app)
 	log.Println("API routes setup completed")
 
 	// Initialize the Lambda adapter
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/aws/aws-lambda-go/lambda"
+	_ "github.com/lib/pq" // PostgreSQLドライバー
+)
+
+func Handler(ctx context.Context) error {
+	if os.Getenv("DATABASE_URL") == "" {
+		log.Fatal("DATABASE_URL environment variable is not set")
+		return errors.New("DATABASE_URL environment variable is not set")
+	}
+
+	// InjectDB が接続とマイグレーションを行う
+	lambdaHandler := injector.InjectLambdaHandler()
+	if err := lambdaHandler.HandleExploreRanking(); err != nil {
+		log.Printf("failed to rank explore posts: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func main() {
+	lambda.Start(Handler)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/infra"
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+)
+
+// manage はサポート・運用向けのコマンドをまとめた CLI
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	rootCmd := &cobra.Command{
+		Use:   "manage",
+		Short: "Maintenance commands for the Animalia backend",
+	}
+	rootCmd.AddCommand(newSetRoleCmd())
+	rootCmd.AddCommand(newBackfillHandlesCmd())
+	rootCmd.AddCommand(newPurgeTimelineCacheCmd())
+	rootCmd.AddCommand(newRankExploreCmd())
+	rootCmd.AddCommand(newMigratePostMediaCmd())
+	rootCmd.AddCommand(newBackfillTagsCmd())
+	rootCmd.AddCommand(newReconcileCountersCmd())
+	rootCmd.AddCommand(newPurgeDeletedPostsCmd())
+
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newSetRoleCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-role <email> <user|moderator|admin>",
+		Short: "Change the role of a user (use this to create the first admin)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			email, role := args[0], enum.Role(args[1])
+			if !role.Valid() {
+				return fmt.Errorf("invalid role: %s", role)
+			}
+
+			userRepository := injector.InjectUserRepository()
+			user, err := userRepository.GetByEmail(email)
+			if err != nil {
+				return fmt.Errorf("failed to get user %s: %w", email, err)
+			}
+			if err := userRepository.UpdateRole(user.ID, role); err != nil {
+				return fmt.Errorf("failed to update role: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s is now %s\n", email, role)
+			return nil
+		},
+	}
+}
+
+func newBackfillHandlesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "backfill-handles",
+		Short: "Give a handle to every user created before handles were introduced",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			userUsecase := injector.InjectUserUsecase()
+			count, err := userUsecase.BackfillHandles()
+			if err != nil {
+				return fmt.Errorf("failed after %d users: %w", count, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d users got a handle\n", count)
+			return nil
+		},
+	}
+}
+
+func newPurgeTimelineCacheCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge-timeline-cache",
+		Short: "Delete expired timelines cached in PostgreSQL (TIMELINE_CACHE=postgres)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			count, err := infra.NewTimelineCacheRepository(injector.InjectDB()).DeleteExpired()
+			if err != nil {
+				return fmt.Errorf("failed to delete expired timelines: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d expired timelines deleted\n", count)
+			return nil
+		},
+	}
+}
+
+func newRankExploreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rank-explore",
+		Short: "Recompute the explore feed now instead of waiting for the scheduled job",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exploreUsecase := injector.InjectExploreUsecase()
+			count, err := exploreUsecase.RefreshRanking()
+			if err != nil {
+				return fmt.Errorf("failed to rank explore posts: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d posts ranked\n", count)
+			return nil
+		},
+	}
+}
+
+func newMigratePostMediaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-post-media",
+		Short: "Turn every post created before carousels into a one-item carousel",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			count, err := injector.InjectPostRepository().MigrateLegacyMedia()
+			if err != nil {
+				return fmt.Errorf("failed after %d posts: %w", count, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d posts migrated\n", count)
+			return nil
+		},
+	}
+}
+
+func newBackfillTagsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "backfill-tags",
+		Short: "Store the hashtags of every post created before hashtags were parsed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tagUsecase := injector.InjectTagUsecase()
+			count, err := tagUsecase.BackfillTags()
+			if err != nil {
+				return fmt.Errorf("failed after %d posts: %w", count, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d posts tagged\n", count)
+			return nil
+		},
+	}
+}
+
+func newReconcileCountersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reconcile-counters",
+		Short: "Recount likes, comments, followers and posts and fix the counter columns that drifted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			posts, users, err := infra.NewCounterRepository(injector.InjectDB()).Reconcile()
+			if err != nil {
+				return fmt.Errorf("failed after fixing %d posts and %d users: %w", posts, users, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d posts and %d users fixed\n", posts, users)
+			return nil
+		},
+	}
+}
+
+func newPurgeDeletedPostsCmd() *cobra.Command {
+	var olderThan time.Duration
+	cmd := &cobra.Command{
+		Use:   "purge-deleted-posts",
+		Short: "Permanently delete posts deleted by their authors long ago, with their media files",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			postUsecase := injector.InjectPostUsecase()
+			count, err := postUsecase.PurgeDeletedPosts(olderThan)
+			if err != nil {
+				return fmt.Errorf("failed after %d posts: %w", count, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%d deleted posts purged\n", count)
+			return nil
+		},
+	}
+	cmd.Flags().DurationVar(&olderThan, "older-than", 30*24*time.Hour, "purge posts deleted longer ago than this")
+	return cmd
+}
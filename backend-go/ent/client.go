@@ -18,13 +18,30 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
 	"github.com/aki-13627/animalia/backend-go/ent/devicetoken"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
 )
 
 // Client is the client that holds all ent builders.
@@ -36,20 +53,54 @@ type Client struct {
 	BlockRelation *BlockRelationClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentLike is the client for interacting with the CommentLike builders.
+	CommentLike *CommentLikeClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
 	// DailyTask is the client for interacting with the DailyTask builders.
 	DailyTask *DailyTaskClient
+	// DataMigration is the client for interacting with the DataMigration builders.
+	DataMigration *DataMigrationClient
 	// DeviceToken is the client for interacting with the DeviceToken builders.
 	DeviceToken *DeviceTokenClient
+	// ExploreRanking is the client for interacting with the ExploreRanking builders.
+	ExploreRanking *ExploreRankingClient
 	// FollowRelation is the client for interacting with the FollowRelation builders.
 	FollowRelation *FollowRelationClient
+	// FollowRequest is the client for interacting with the FollowRequest builders.
+	FollowRequest *FollowRequestClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
+	// MuteRelation is the client for interacting with the MuteRelation builders.
+	MuteRelation *MuteRelationClient
+	// MutedKeyword is the client for interacting with the MutedKeyword builders.
+	MutedKeyword *MutedKeywordClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostMedia is the client for interacting with the PostMedia builders.
+	PostMedia *PostMediaClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostTag is the client for interacting with the PostTag builders.
+	PostTag *PostTagClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// Suspension is the client for interacting with the Suspension builders.
+	Suspension *SuspensionClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TimelineCache is the client for interacting with the TimelineCache builders.
+	TimelineCache *TimelineCacheClient
+	// TokenRevocation is the client for interacting with the TokenRevocation builders.
+	TokenRevocation *TokenRevocationClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VerificationCode is the client for interacting with the VerificationCode builders.
+	VerificationCode *VerificationCodeClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -63,13 +114,30 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.BlockRelation = NewBlockRelationClient(c.config)
 	c.Comment = NewCommentClient(c.config)
+	c.CommentLike = NewCommentLikeClient(c.config)
+	c.Credential = NewCredentialClient(c.config)
 	c.DailyTask = NewDailyTaskClient(c.config)
+	c.DataMigration = NewDataMigrationClient(c.config)
 	c.DeviceToken = NewDeviceTokenClient(c.config)
+	c.ExploreRanking = NewExploreRankingClient(c.config)
 	c.FollowRelation = NewFollowRelationClient(c.config)
+	c.FollowRequest = NewFollowRequestClient(c.config)
 	c.Like = NewLikeClient(c.config)
+	c.MuteRelation = NewMuteRelationClient(c.config)
+	c.MutedKeyword = NewMutedKeywordClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
 	c.Pet = NewPetClient(c.config)
 	c.Post = NewPostClient(c.config)
+	c.PostMedia = NewPostMediaClient(c.config)
+	c.PostRevision = NewPostRevisionClient(c.config)
+	c.PostTag = NewPostTagClient(c.config)
+	c.Report = NewReportClient(c.config)
+	c.Suspension = NewSuspensionClient(c.config)
+	c.Tag = NewTagClient(c.config)
+	c.TimelineCache = NewTimelineCacheClient(c.config)
+	c.TokenRevocation = NewTokenRevocationClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.VerificationCode = NewVerificationCodeClient(c.config)
 }
 
 type (
@@ -160,17 +228,34 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		BlockRelation:  NewBlockRelationClient(cfg),
-		Comment:        NewCommentClient(cfg),
-		DailyTask:      NewDailyTaskClient(cfg),
-		DeviceToken:    NewDeviceTokenClient(cfg),
-		FollowRelation: NewFollowRelationClient(cfg),
-		Like:           NewLikeClient(cfg),
-		Pet:            NewPetClient(cfg),
-		Post:           NewPostClient(cfg),
-		User:           NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		BlockRelation:    NewBlockRelationClient(cfg),
+		Comment:          NewCommentClient(cfg),
+		CommentLike:      NewCommentLikeClient(cfg),
+		Credential:       NewCredentialClient(cfg),
+		DailyTask:        NewDailyTaskClient(cfg),
+		DataMigration:    NewDataMigrationClient(cfg),
+		DeviceToken:      NewDeviceTokenClient(cfg),
+		ExploreRanking:   NewExploreRankingClient(cfg),
+		FollowRelation:   NewFollowRelationClient(cfg),
+		FollowRequest:    NewFollowRequestClient(cfg),
+		Like:             NewLikeClient(cfg),
+		MuteRelation:     NewMuteRelationClient(cfg),
+		MutedKeyword:     NewMutedKeywordClient(cfg),
+		Notification:     NewNotificationClient(cfg),
+		Pet:              NewPetClient(cfg),
+		Post:             NewPostClient(cfg),
+		PostMedia:        NewPostMediaClient(cfg),
+		PostRevision:     NewPostRevisionClient(cfg),
+		PostTag:          NewPostTagClient(cfg),
+		Report:           NewReportClient(cfg),
+		Suspension:       NewSuspensionClient(cfg),
+		Tag:              NewTagClient(cfg),
+		TimelineCache:    NewTimelineCacheClient(cfg),
+		TokenRevocation:  NewTokenRevocationClient(cfg),
+		User:             NewUserClient(cfg),
+		VerificationCode: NewVerificationCodeClient(cfg),
 	}, nil
 }
 
@@ -188,17 +273,34 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		BlockRelation:  NewBlockRelationClient(cfg),
-		Comment:        NewCommentClient(cfg),
-		DailyTask:      NewDailyTaskClient(cfg),
-		DeviceToken:    NewDeviceTokenClient(cfg),
-		FollowRelation: NewFollowRelationClient(cfg),
-		Like:           NewLikeClient(cfg),
-		Pet:            NewPetClient(cfg),
-		Post:           NewPostClient(cfg),
-		User:           NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		BlockRelation:    NewBlockRelationClient(cfg),
+		Comment:          NewCommentClient(cfg),
+		CommentLike:      NewCommentLikeClient(cfg),
+		Credential:       NewCredentialClient(cfg),
+		DailyTask:        NewDailyTaskClient(cfg),
+		DataMigration:    NewDataMigrationClient(cfg),
+		DeviceToken:      NewDeviceTokenClient(cfg),
+		ExploreRanking:   NewExploreRankingClient(cfg),
+		FollowRelation:   NewFollowRelationClient(cfg),
+		FollowRequest:    NewFollowRequestClient(cfg),
+		Like:             NewLikeClient(cfg),
+		MuteRelation:     NewMuteRelationClient(cfg),
+		MutedKeyword:     NewMutedKeywordClient(cfg),
+		Notification:     NewNotificationClient(cfg),
+		Pet:              NewPetClient(cfg),
+		Post:             NewPostClient(cfg),
+		PostMedia:        NewPostMediaClient(cfg),
+		PostRevision:     NewPostRevisionClient(cfg),
+		PostTag:          NewPostTagClient(cfg),
+		Report:           NewReportClient(cfg),
+		Suspension:       NewSuspensionClient(cfg),
+		Tag:              NewTagClient(cfg),
+		TimelineCache:    NewTimelineCacheClient(cfg),
+		TokenRevocation:  NewTokenRevocationClient(cfg),
+		User:             NewUserClient(cfg),
+		VerificationCode: NewVerificationCodeClient(cfg),
 	}, nil
 }
 
@@ -228,8 +330,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.BlockRelation, c.Comment, c.DailyTask, c.DeviceToken, c.FollowRelation,
-		c.Like, c.Pet, c.Post, c.User,
+		c.BlockRelation, c.Comment, c.CommentLike, c.Credential, c.DailyTask,
+		c.DataMigration, c.DeviceToken, c.ExploreRanking, c.FollowRelation,
+		c.FollowRequest, c.Like, c.MuteRelation, c.MutedKeyword, c.Notification, c.Pet,
+		c.Post, c.PostMedia, c.PostRevision, c.PostTag, c.Report, c.Suspension, c.Tag,
+		c.TimelineCache, c.TokenRevocation, c.User, c.VerificationCode,
 	} {
 		n.Use(hooks...)
 	}
@@ -239,8 +344,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.BlockRelation, c.Comment, c.DailyTask, c.DeviceToken, c.FollowRelation,
-		c.Like, c.Pet, c.Post, c.User,
+		c.BlockRelation, c.Comment, c.CommentLike, c.Credential, c.DailyTask,
+		c.DataMigration, c.DeviceToken, c.ExploreRanking, c.FollowRelation,
+		c.FollowRequest, c.Like, c.MuteRelation, c.MutedKeyword, c.Notification, c.Pet,
+		c.Post, c.PostMedia, c.PostRevision, c.PostTag, c.Report, c.Suspension, c.Tag,
+		c.TimelineCache, c.TokenRevocation, c.User, c.VerificationCode,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -253,20 +361,54 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.BlockRelation.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
+	case *CommentLikeMutation:
+		return c.CommentLike.mutate(ctx, m)
+	case *CredentialMutation:
+		return c.Credential.mutate(ctx, m)
 	case *DailyTaskMutation:
 		return c.DailyTask.mutate(ctx, m)
+	case *DataMigrationMutation:
+		return c.DataMigration.mutate(ctx, m)
 	case *DeviceTokenMutation:
 		return c.DeviceToken.mutate(ctx, m)
+	case *ExploreRankingMutation:
+		return c.ExploreRanking.mutate(ctx, m)
 	case *FollowRelationMutation:
 		return c.FollowRelation.mutate(ctx, m)
+	case *FollowRequestMutation:
+		return c.FollowRequest.mutate(ctx, m)
 	case *LikeMutation:
 		return c.Like.mutate(ctx, m)
+	case *MuteRelationMutation:
+		return c.MuteRelation.mutate(ctx, m)
+	case *MutedKeywordMutation:
+		return c.MutedKeyword.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
 	case *PetMutation:
 		return c.Pet.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
+	case *PostMediaMutation:
+		return c.PostMedia.mutate(ctx, m)
+	case *PostRevisionMutation:
+		return c.PostRevision.mutate(ctx, m)
+	case *PostTagMutation:
+		return c.PostTag.mutate(ctx, m)
+	case *ReportMutation:
+		return c.Report.mutate(ctx, m)
+	case *SuspensionMutation:
+		return c.Suspension.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
+	case *TimelineCacheMutation:
+		return c.TimelineCache.mutate(ctx, m)
+	case *TokenRevocationMutation:
+		return c.TokenRevocation.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *VerificationCodeMutation:
+		return c.VerificationCode.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -577,6 +719,102 @@ func (c *CommentClient) QueryUser(co *Comment) *UserQuery {
 	return query
 }
 
+// QueryReports queries the reports edge of a Comment.
+func (c *CommentClient) QueryReports(co *Comment) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.ReportsTable, comment.ReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a Comment.
+func (c *CommentClient) QueryMentions(co *Comment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, comment.MentionsTable, comment.MentionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotifications queries the notifications edge of a Comment.
+func (c *CommentClient) QueryNotifications(co *Comment) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.NotificationsTable, comment.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Comment.
+func (c *CommentClient) QueryParent(co *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ParentTable, comment.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a Comment.
+func (c *CommentClient) QueryReplies(co *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.RepliesTable, comment.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLikes queries the likes edge of a Comment.
+func (c *CommentClient) QueryLikes(co *Comment) *CommentLikeQuery {
+	query := (&CommentLikeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(commentlike.Table, commentlike.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.LikesTable, comment.LikesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
@@ -602,6 +840,304 @@ func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value,
 	}
 }
 
+// CommentLikeClient is a client for the CommentLike schema.
+type CommentLikeClient struct {
+	config
+}
+
+// NewCommentLikeClient returns a client for the CommentLike from the given config.
+func NewCommentLikeClient(c config) *CommentLikeClient {
+	return &CommentLikeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commentlike.Hooks(f(g(h())))`.
+func (c *CommentLikeClient) Use(hooks ...Hook) {
+	c.hooks.CommentLike = append(c.hooks.CommentLike, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commentlike.Intercept(f(g(h())))`.
+func (c *CommentLikeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentLike = append(c.inters.CommentLike, interceptors...)
+}
+
+// Create returns a builder for creating a CommentLike entity.
+func (c *CommentLikeClient) Create() *CommentLikeCreate {
+	mutation := newCommentLikeMutation(c.config, OpCreate)
+	return &CommentLikeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommentLike entities.
+func (c *CommentLikeClient) CreateBulk(builders ...*CommentLikeCreate) *CommentLikeCreateBulk {
+	return &CommentLikeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentLikeClient) MapCreateBulk(slice any, setFunc func(*CommentLikeCreate, int)) *CommentLikeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentLikeCreateBulk{err: fmt.Errorf("calling to CommentLikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentLikeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentLikeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommentLike.
+func (c *CommentLikeClient) Update() *CommentLikeUpdate {
+	mutation := newCommentLikeMutation(c.config, OpUpdate)
+	return &CommentLikeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentLikeClient) UpdateOne(cl *CommentLike) *CommentLikeUpdateOne {
+	mutation := newCommentLikeMutation(c.config, OpUpdateOne, withCommentLike(cl))
+	return &CommentLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentLikeClient) UpdateOneID(id uuid.UUID) *CommentLikeUpdateOne {
+	mutation := newCommentLikeMutation(c.config, OpUpdateOne, withCommentLikeID(id))
+	return &CommentLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommentLike.
+func (c *CommentLikeClient) Delete() *CommentLikeDelete {
+	mutation := newCommentLikeMutation(c.config, OpDelete)
+	return &CommentLikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentLikeClient) DeleteOne(cl *CommentLike) *CommentLikeDeleteOne {
+	return c.DeleteOneID(cl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentLikeClient) DeleteOneID(id uuid.UUID) *CommentLikeDeleteOne {
+	builder := c.Delete().Where(commentlike.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentLikeDeleteOne{builder}
+}
+
+// Query returns a query builder for CommentLike.
+func (c *CommentLikeClient) Query() *CommentLikeQuery {
+	return &CommentLikeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommentLike},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommentLike entity by its id.
+func (c *CommentLikeClient) Get(ctx context.Context, id uuid.UUID) (*CommentLike, error) {
+	return c.Query().Where(commentlike.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentLikeClient) GetX(ctx context.Context, id uuid.UUID) *CommentLike {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryComment queries the comment edge of a CommentLike.
+func (c *CommentLikeClient) QueryComment(cl *CommentLike) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentlike.Table, commentlike.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, commentlike.CommentTable, commentlike.CommentColumn),
+		)
+		fromV = sqlgraph.Neighbors(cl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a CommentLike.
+func (c *CommentLikeClient) QueryUser(cl *CommentLike) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentlike.Table, commentlike.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, commentlike.UserTable, commentlike.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CommentLikeClient) Hooks() []Hook {
+	return c.hooks.CommentLike
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentLikeClient) Interceptors() []Interceptor {
+	return c.inters.CommentLike
+}
+
+func (c *CommentLikeClient) mutate(ctx context.Context, m *CommentLikeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentLikeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentLikeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentLikeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommentLike mutation op: %q", m.Op())
+	}
+}
+
+// CredentialClient is a client for the Credential schema.
+type CredentialClient struct {
+	config
+}
+
+// NewCredentialClient returns a client for the Credential from the given config.
+func NewCredentialClient(c config) *CredentialClient {
+	return &CredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `credential.Hooks(f(g(h())))`.
+func (c *CredentialClient) Use(hooks ...Hook) {
+	c.hooks.Credential = append(c.hooks.Credential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `credential.Intercept(f(g(h())))`.
+func (c *CredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Credential = append(c.inters.Credential, interceptors...)
+}
+
+// Create returns a builder for creating a Credential entity.
+func (c *CredentialClient) Create() *CredentialCreate {
+	mutation := newCredentialMutation(c.config, OpCreate)
+	return &CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Credential entities.
+func (c *CredentialClient) CreateBulk(builders ...*CredentialCreate) *CredentialCreateBulk {
+	return &CredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CredentialClient) MapCreateBulk(slice any, setFunc func(*CredentialCreate, int)) *CredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CredentialCreateBulk{err: fmt.Errorf("calling to CredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Credential.
+func (c *CredentialClient) Update() *CredentialUpdate {
+	mutation := newCredentialMutation(c.config, OpUpdate)
+	return &CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CredentialClient) UpdateOne(cr *Credential) *CredentialUpdateOne {
+	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredential(cr))
+	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CredentialClient) UpdateOneID(id uuid.UUID) *CredentialUpdateOne {
+	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredentialID(id))
+	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Credential.
+func (c *CredentialClient) Delete() *CredentialDelete {
+	mutation := newCredentialMutation(c.config, OpDelete)
+	return &CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CredentialClient) DeleteOne(cr *Credential) *CredentialDeleteOne {
+	return c.DeleteOneID(cr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CredentialClient) DeleteOneID(id uuid.UUID) *CredentialDeleteOne {
+	builder := c.Delete().Where(credential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for Credential.
+func (c *CredentialClient) Query() *CredentialQuery {
+	return &CredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Credential entity by its id.
+func (c *CredentialClient) Get(ctx context.Context, id uuid.UUID) (*Credential, error) {
+	return c.Query().Where(credential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CredentialClient) GetX(ctx context.Context, id uuid.UUID) *Credential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CredentialClient) Hooks() []Hook {
+	return c.hooks.Credential
+}
+
+// Interceptors returns the client interceptors.
+func (c *CredentialClient) Interceptors() []Interceptor {
+	return c.inters.Credential
+}
+
+func (c *CredentialClient) mutate(ctx context.Context, m *CredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Credential mutation op: %q", m.Op())
+	}
+}
+
 // DailyTaskClient is a client for the DailyTask schema.
 type DailyTaskClient struct {
 	config
@@ -679,195 +1215,2552 @@ func (c *DailyTaskClient) DeleteOne(dt *DailyTask) *DailyTaskDeleteOne {
 	return c.DeleteOneID(dt.ID)
 }
 
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DailyTaskClient) DeleteOneID(id uuid.UUID) *DailyTaskDeleteOne {
-	builder := c.Delete().Where(dailytask.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &DailyTaskDeleteOne{builder}
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DailyTaskClient) DeleteOneID(id uuid.UUID) *DailyTaskDeleteOne {
+	builder := c.Delete().Where(dailytask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DailyTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for DailyTask.
+func (c *DailyTaskClient) Query() *DailyTaskQuery {
+	return &DailyTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDailyTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DailyTask entity by its id.
+func (c *DailyTaskClient) Get(ctx context.Context, id uuid.UUID) (*DailyTask, error) {
+	return c.Query().Where(dailytask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DailyTaskClient) GetX(ctx context.Context, id uuid.UUID) *DailyTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DailyTask.
+func (c *DailyTaskClient) QueryUser(dt *DailyTask) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dailytask.Table, dailytask.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dailytask.UserTable, dailytask.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPost queries the post edge of a DailyTask.
+func (c *DailyTaskClient) QueryPost(dt *DailyTask) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dailytask.Table, dailytask.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, dailytask.PostTable, dailytask.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DailyTaskClient) Hooks() []Hook {
+	return c.hooks.DailyTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *DailyTaskClient) Interceptors() []Interceptor {
+	return c.inters.DailyTask
+}
+
+func (c *DailyTaskClient) mutate(ctx context.Context, m *DailyTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DailyTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DailyTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DailyTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DailyTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DailyTask mutation op: %q", m.Op())
+	}
+}
+
+// DataMigrationClient is a client for the DataMigration schema.
+type DataMigrationClient struct {
+	config
+}
+
+// NewDataMigrationClient returns a client for the DataMigration from the given config.
+func NewDataMigrationClient(c config) *DataMigrationClient {
+	return &DataMigrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `datamigration.Hooks(f(g(h())))`.
+func (c *DataMigrationClient) Use(hooks ...Hook) {
+	c.hooks.DataMigration = append(c.hooks.DataMigration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `datamigration.Intercept(f(g(h())))`.
+func (c *DataMigrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataMigration = append(c.inters.DataMigration, interceptors...)
+}
+
+// Create returns a builder for creating a DataMigration entity.
+func (c *DataMigrationClient) Create() *DataMigrationCreate {
+	mutation := newDataMigrationMutation(c.config, OpCreate)
+	return &DataMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DataMigration entities.
+func (c *DataMigrationClient) CreateBulk(builders ...*DataMigrationCreate) *DataMigrationCreateBulk {
+	return &DataMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DataMigrationClient) MapCreateBulk(slice any, setFunc func(*DataMigrationCreate, int)) *DataMigrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DataMigrationCreateBulk{err: fmt.Errorf("calling to DataMigrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DataMigrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DataMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DataMigration.
+func (c *DataMigrationClient) Update() *DataMigrationUpdate {
+	mutation := newDataMigrationMutation(c.config, OpUpdate)
+	return &DataMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DataMigrationClient) UpdateOne(dm *DataMigration) *DataMigrationUpdateOne {
+	mutation := newDataMigrationMutation(c.config, OpUpdateOne, withDataMigration(dm))
+	return &DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DataMigrationClient) UpdateOneID(id string) *DataMigrationUpdateOne {
+	mutation := newDataMigrationMutation(c.config, OpUpdateOne, withDataMigrationID(id))
+	return &DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DataMigration.
+func (c *DataMigrationClient) Delete() *DataMigrationDelete {
+	mutation := newDataMigrationMutation(c.config, OpDelete)
+	return &DataMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DataMigrationClient) DeleteOne(dm *DataMigration) *DataMigrationDeleteOne {
+	return c.DeleteOneID(dm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DataMigrationClient) DeleteOneID(id string) *DataMigrationDeleteOne {
+	builder := c.Delete().Where(datamigration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DataMigrationDeleteOne{builder}
+}
+
+// Query returns a query builder for DataMigration.
+func (c *DataMigrationClient) Query() *DataMigrationQuery {
+	return &DataMigrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDataMigration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DataMigration entity by its id.
+func (c *DataMigrationClient) Get(ctx context.Context, id string) (*DataMigration, error) {
+	return c.Query().Where(datamigration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DataMigrationClient) GetX(ctx context.Context, id string) *DataMigration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DataMigrationClient) Hooks() []Hook {
+	return c.hooks.DataMigration
+}
+
+// Interceptors returns the client interceptors.
+func (c *DataMigrationClient) Interceptors() []Interceptor {
+	return c.inters.DataMigration
+}
+
+func (c *DataMigrationClient) mutate(ctx context.Context, m *DataMigrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DataMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DataMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DataMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DataMigration mutation op: %q", m.Op())
+	}
+}
+
+// DeviceTokenClient is a client for the DeviceToken schema.
+type DeviceTokenClient struct {
+	config
+}
+
+// NewDeviceTokenClient returns a client for the DeviceToken from the given config.
+func NewDeviceTokenClient(c config) *DeviceTokenClient {
+	return &DeviceTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `devicetoken.Hooks(f(g(h())))`.
+func (c *DeviceTokenClient) Use(hooks ...Hook) {
+	c.hooks.DeviceToken = append(c.hooks.DeviceToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `devicetoken.Intercept(f(g(h())))`.
+func (c *DeviceTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceToken = append(c.inters.DeviceToken, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceToken entity.
+func (c *DeviceTokenClient) Create() *DeviceTokenCreate {
+	mutation := newDeviceTokenMutation(c.config, OpCreate)
+	return &DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceToken entities.
+func (c *DeviceTokenClient) CreateBulk(builders ...*DeviceTokenCreate) *DeviceTokenCreateBulk {
+	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceTokenClient) MapCreateBulk(slice any, setFunc func(*DeviceTokenCreate, int)) *DeviceTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceTokenCreateBulk{err: fmt.Errorf("calling to DeviceTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceToken.
+func (c *DeviceTokenClient) Update() *DeviceTokenUpdate {
+	mutation := newDeviceTokenMutation(c.config, OpUpdate)
+	return &DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceTokenClient) UpdateOne(dt *DeviceToken) *DeviceTokenUpdateOne {
+	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceToken(dt))
+	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceTokenClient) UpdateOneID(id uuid.UUID) *DeviceTokenUpdateOne {
+	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceTokenID(id))
+	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceToken.
+func (c *DeviceTokenClient) Delete() *DeviceTokenDelete {
+	mutation := newDeviceTokenMutation(c.config, OpDelete)
+	return &DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceTokenClient) DeleteOne(dt *DeviceToken) *DeviceTokenDeleteOne {
+	return c.DeleteOneID(dt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceTokenClient) DeleteOneID(id uuid.UUID) *DeviceTokenDeleteOne {
+	builder := c.Delete().Where(devicetoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceToken.
+func (c *DeviceTokenClient) Query() *DeviceTokenQuery {
+	return &DeviceTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceToken entity by its id.
+func (c *DeviceTokenClient) Get(ctx context.Context, id uuid.UUID) (*DeviceToken, error) {
+	return c.Query().Where(devicetoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceTokenClient) GetX(ctx context.Context, id uuid.UUID) *DeviceToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DeviceToken.
+func (c *DeviceTokenClient) QueryUser(dt *DeviceToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(devicetoken.Table, devicetoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicetoken.UserTable, devicetoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceTokenClient) Hooks() []Hook {
+	return c.hooks.DeviceToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceTokenClient) Interceptors() []Interceptor {
+	return c.inters.DeviceToken
+}
+
+func (c *DeviceTokenClient) mutate(ctx context.Context, m *DeviceTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceToken mutation op: %q", m.Op())
+	}
+}
+
+// ExploreRankingClient is a client for the ExploreRanking schema.
+type ExploreRankingClient struct {
+	config
+}
+
+// NewExploreRankingClient returns a client for the ExploreRanking from the given config.
+func NewExploreRankingClient(c config) *ExploreRankingClient {
+	return &ExploreRankingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exploreranking.Hooks(f(g(h())))`.
+func (c *ExploreRankingClient) Use(hooks ...Hook) {
+	c.hooks.ExploreRanking = append(c.hooks.ExploreRanking, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exploreranking.Intercept(f(g(h())))`.
+func (c *ExploreRankingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExploreRanking = append(c.inters.ExploreRanking, interceptors...)
+}
+
+// Create returns a builder for creating a ExploreRanking entity.
+func (c *ExploreRankingClient) Create() *ExploreRankingCreate {
+	mutation := newExploreRankingMutation(c.config, OpCreate)
+	return &ExploreRankingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExploreRanking entities.
+func (c *ExploreRankingClient) CreateBulk(builders ...*ExploreRankingCreate) *ExploreRankingCreateBulk {
+	return &ExploreRankingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExploreRankingClient) MapCreateBulk(slice any, setFunc func(*ExploreRankingCreate, int)) *ExploreRankingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExploreRankingCreateBulk{err: fmt.Errorf("calling to ExploreRankingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExploreRankingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExploreRankingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExploreRanking.
+func (c *ExploreRankingClient) Update() *ExploreRankingUpdate {
+	mutation := newExploreRankingMutation(c.config, OpUpdate)
+	return &ExploreRankingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExploreRankingClient) UpdateOne(er *ExploreRanking) *ExploreRankingUpdateOne {
+	mutation := newExploreRankingMutation(c.config, OpUpdateOne, withExploreRanking(er))
+	return &ExploreRankingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExploreRankingClient) UpdateOneID(id uuid.UUID) *ExploreRankingUpdateOne {
+	mutation := newExploreRankingMutation(c.config, OpUpdateOne, withExploreRankingID(id))
+	return &ExploreRankingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExploreRanking.
+func (c *ExploreRankingClient) Delete() *ExploreRankingDelete {
+	mutation := newExploreRankingMutation(c.config, OpDelete)
+	return &ExploreRankingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExploreRankingClient) DeleteOne(er *ExploreRanking) *ExploreRankingDeleteOne {
+	return c.DeleteOneID(er.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExploreRankingClient) DeleteOneID(id uuid.UUID) *ExploreRankingDeleteOne {
+	builder := c.Delete().Where(exploreranking.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExploreRankingDeleteOne{builder}
+}
+
+// Query returns a query builder for ExploreRanking.
+func (c *ExploreRankingClient) Query() *ExploreRankingQuery {
+	return &ExploreRankingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExploreRanking},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExploreRanking entity by its id.
+func (c *ExploreRankingClient) Get(ctx context.Context, id uuid.UUID) (*ExploreRanking, error) {
+	return c.Query().Where(exploreranking.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExploreRankingClient) GetX(ctx context.Context, id uuid.UUID) *ExploreRanking {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a ExploreRanking.
+func (c *ExploreRankingClient) QueryPost(er *ExploreRanking) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := er.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exploreranking.Table, exploreranking.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, exploreranking.PostTable, exploreranking.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(er.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExploreRankingClient) Hooks() []Hook {
+	return c.hooks.ExploreRanking
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExploreRankingClient) Interceptors() []Interceptor {
+	return c.inters.ExploreRanking
+}
+
+func (c *ExploreRankingClient) mutate(ctx context.Context, m *ExploreRankingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExploreRankingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExploreRankingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExploreRankingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExploreRankingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExploreRanking mutation op: %q", m.Op())
+	}
+}
+
+// FollowRelationClient is a client for the FollowRelation schema.
+type FollowRelationClient struct {
+	config
+}
+
+// NewFollowRelationClient returns a client for the FollowRelation from the given config.
+func NewFollowRelationClient(c config) *FollowRelationClient {
+	return &FollowRelationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `followrelation.Hooks(f(g(h())))`.
+func (c *FollowRelationClient) Use(hooks ...Hook) {
+	c.hooks.FollowRelation = append(c.hooks.FollowRelation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `followrelation.Intercept(f(g(h())))`.
+func (c *FollowRelationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FollowRelation = append(c.inters.FollowRelation, interceptors...)
+}
+
+// Create returns a builder for creating a FollowRelation entity.
+func (c *FollowRelationClient) Create() *FollowRelationCreate {
+	mutation := newFollowRelationMutation(c.config, OpCreate)
+	return &FollowRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FollowRelation entities.
+func (c *FollowRelationClient) CreateBulk(builders ...*FollowRelationCreate) *FollowRelationCreateBulk {
+	return &FollowRelationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FollowRelationClient) MapCreateBulk(slice any, setFunc func(*FollowRelationCreate, int)) *FollowRelationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FollowRelationCreateBulk{err: fmt.Errorf("calling to FollowRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FollowRelationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FollowRelationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FollowRelation.
+func (c *FollowRelationClient) Update() *FollowRelationUpdate {
+	mutation := newFollowRelationMutation(c.config, OpUpdate)
+	return &FollowRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FollowRelationClient) UpdateOne(fr *FollowRelation) *FollowRelationUpdateOne {
+	mutation := newFollowRelationMutation(c.config, OpUpdateOne, withFollowRelation(fr))
+	return &FollowRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FollowRelationClient) UpdateOneID(id uuid.UUID) *FollowRelationUpdateOne {
+	mutation := newFollowRelationMutation(c.config, OpUpdateOne, withFollowRelationID(id))
+	return &FollowRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FollowRelation.
+func (c *FollowRelationClient) Delete() *FollowRelationDelete {
+	mutation := newFollowRelationMutation(c.config, OpDelete)
+	return &FollowRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FollowRelationClient) DeleteOne(fr *FollowRelation) *FollowRelationDeleteOne {
+	return c.DeleteOneID(fr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FollowRelationClient) DeleteOneID(id uuid.UUID) *FollowRelationDeleteOne {
+	builder := c.Delete().Where(followrelation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FollowRelationDeleteOne{builder}
+}
+
+// Query returns a query builder for FollowRelation.
+func (c *FollowRelationClient) Query() *FollowRelationQuery {
+	return &FollowRelationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFollowRelation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FollowRelation entity by its id.
+func (c *FollowRelationClient) Get(ctx context.Context, id uuid.UUID) (*FollowRelation, error) {
+	return c.Query().Where(followrelation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FollowRelationClient) GetX(ctx context.Context, id uuid.UUID) *FollowRelation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFrom queries the from edge of a FollowRelation.
+func (c *FollowRelationClient) QueryFrom(fr *FollowRelation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followrelation.Table, followrelation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followrelation.FromTable, followrelation.FromColumn),
+		)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTo queries the to edge of a FollowRelation.
+func (c *FollowRelationClient) QueryTo(fr *FollowRelation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followrelation.Table, followrelation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followrelation.ToTable, followrelation.ToColumn),
+		)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FollowRelationClient) Hooks() []Hook {
+	return c.hooks.FollowRelation
+}
+
+// Interceptors returns the client interceptors.
+func (c *FollowRelationClient) Interceptors() []Interceptor {
+	return c.inters.FollowRelation
+}
+
+func (c *FollowRelationClient) mutate(ctx context.Context, m *FollowRelationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FollowRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FollowRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FollowRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FollowRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FollowRelation mutation op: %q", m.Op())
+	}
+}
+
+// FollowRequestClient is a client for the FollowRequest schema.
+type FollowRequestClient struct {
+	config
+}
+
+// NewFollowRequestClient returns a client for the FollowRequest from the given config.
+func NewFollowRequestClient(c config) *FollowRequestClient {
+	return &FollowRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `followrequest.Hooks(f(g(h())))`.
+func (c *FollowRequestClient) Use(hooks ...Hook) {
+	c.hooks.FollowRequest = append(c.hooks.FollowRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `followrequest.Intercept(f(g(h())))`.
+func (c *FollowRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FollowRequest = append(c.inters.FollowRequest, interceptors...)
+}
+
+// Create returns a builder for creating a FollowRequest entity.
+func (c *FollowRequestClient) Create() *FollowRequestCreate {
+	mutation := newFollowRequestMutation(c.config, OpCreate)
+	return &FollowRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FollowRequest entities.
+func (c *FollowRequestClient) CreateBulk(builders ...*FollowRequestCreate) *FollowRequestCreateBulk {
+	return &FollowRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FollowRequestClient) MapCreateBulk(slice any, setFunc func(*FollowRequestCreate, int)) *FollowRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FollowRequestCreateBulk{err: fmt.Errorf("calling to FollowRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FollowRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FollowRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FollowRequest.
+func (c *FollowRequestClient) Update() *FollowRequestUpdate {
+	mutation := newFollowRequestMutation(c.config, OpUpdate)
+	return &FollowRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FollowRequestClient) UpdateOne(fr *FollowRequest) *FollowRequestUpdateOne {
+	mutation := newFollowRequestMutation(c.config, OpUpdateOne, withFollowRequest(fr))
+	return &FollowRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FollowRequestClient) UpdateOneID(id uuid.UUID) *FollowRequestUpdateOne {
+	mutation := newFollowRequestMutation(c.config, OpUpdateOne, withFollowRequestID(id))
+	return &FollowRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FollowRequest.
+func (c *FollowRequestClient) Delete() *FollowRequestDelete {
+	mutation := newFollowRequestMutation(c.config, OpDelete)
+	return &FollowRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FollowRequestClient) DeleteOne(fr *FollowRequest) *FollowRequestDeleteOne {
+	return c.DeleteOneID(fr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FollowRequestClient) DeleteOneID(id uuid.UUID) *FollowRequestDeleteOne {
+	builder := c.Delete().Where(followrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FollowRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for FollowRequest.
+func (c *FollowRequestClient) Query() *FollowRequestQuery {
+	return &FollowRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFollowRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FollowRequest entity by its id.
+func (c *FollowRequestClient) Get(ctx context.Context, id uuid.UUID) (*FollowRequest, error) {
+	return c.Query().Where(followrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FollowRequestClient) GetX(ctx context.Context, id uuid.UUID) *FollowRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFrom queries the from edge of a FollowRequest.
+func (c *FollowRequestClient) QueryFrom(fr *FollowRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followrequest.Table, followrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followrequest.FromTable, followrequest.FromColumn),
+		)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTo queries the to edge of a FollowRequest.
+func (c *FollowRequestClient) QueryTo(fr *FollowRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followrequest.Table, followrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followrequest.ToTable, followrequest.ToColumn),
+		)
+		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FollowRequestClient) Hooks() []Hook {
+	return c.hooks.FollowRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *FollowRequestClient) Interceptors() []Interceptor {
+	return c.inters.FollowRequest
+}
+
+func (c *FollowRequestClient) mutate(ctx context.Context, m *FollowRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FollowRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FollowRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FollowRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FollowRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FollowRequest mutation op: %q", m.Op())
+	}
+}
+
+// LikeClient is a client for the Like schema.
+type LikeClient struct {
+	config
+}
+
+// NewLikeClient returns a client for the Like from the given config.
+func NewLikeClient(c config) *LikeClient {
+	return &LikeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `like.Hooks(f(g(h())))`.
+func (c *LikeClient) Use(hooks ...Hook) {
+	c.hooks.Like = append(c.hooks.Like, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `like.Intercept(f(g(h())))`.
+func (c *LikeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Like = append(c.inters.Like, interceptors...)
+}
+
+// Create returns a builder for creating a Like entity.
+func (c *LikeClient) Create() *LikeCreate {
+	mutation := newLikeMutation(c.config, OpCreate)
+	return &LikeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Like entities.
+func (c *LikeClient) CreateBulk(builders ...*LikeCreate) *LikeCreateBulk {
+	return &LikeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LikeClient) MapCreateBulk(slice any, setFunc func(*LikeCreate, int)) *LikeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LikeCreateBulk{err: fmt.Errorf("calling to LikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LikeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LikeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Like.
+func (c *LikeClient) Update() *LikeUpdate {
+	mutation := newLikeMutation(c.config, OpUpdate)
+	return &LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LikeClient) UpdateOne(l *Like) *LikeUpdateOne {
+	mutation := newLikeMutation(c.config, OpUpdateOne, withLike(l))
+	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LikeClient) UpdateOneID(id uuid.UUID) *LikeUpdateOne {
+	mutation := newLikeMutation(c.config, OpUpdateOne, withLikeID(id))
+	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Like.
+func (c *LikeClient) Delete() *LikeDelete {
+	mutation := newLikeMutation(c.config, OpDelete)
+	return &LikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LikeClient) DeleteOne(l *Like) *LikeDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LikeClient) DeleteOneID(id uuid.UUID) *LikeDeleteOne {
+	builder := c.Delete().Where(like.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LikeDeleteOne{builder}
+}
+
+// Query returns a query builder for Like.
+func (c *LikeClient) Query() *LikeQuery {
+	return &LikeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLike},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Like entity by its id.
+func (c *LikeClient) Get(ctx context.Context, id uuid.UUID) (*Like, error) {
+	return c.Query().Where(like.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LikeClient) GetX(ctx context.Context, id uuid.UUID) *Like {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Like.
+func (c *LikeClient) QueryUser(l *Like) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(like.Table, like.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, like.UserTable, like.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPost queries the post edge of a Like.
+func (c *LikeClient) QueryPost(l *Like) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(like.Table, like.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, like.PostTable, like.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LikeClient) Hooks() []Hook {
+	return c.hooks.Like
+}
+
+// Interceptors returns the client interceptors.
+func (c *LikeClient) Interceptors() []Interceptor {
+	return c.inters.Like
+}
+
+func (c *LikeClient) mutate(ctx context.Context, m *LikeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LikeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LikeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Like mutation op: %q", m.Op())
+	}
+}
+
+// MuteRelationClient is a client for the MuteRelation schema.
+type MuteRelationClient struct {
+	config
+}
+
+// NewMuteRelationClient returns a client for the MuteRelation from the given config.
+func NewMuteRelationClient(c config) *MuteRelationClient {
+	return &MuteRelationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `muterelation.Hooks(f(g(h())))`.
+func (c *MuteRelationClient) Use(hooks ...Hook) {
+	c.hooks.MuteRelation = append(c.hooks.MuteRelation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `muterelation.Intercept(f(g(h())))`.
+func (c *MuteRelationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MuteRelation = append(c.inters.MuteRelation, interceptors...)
+}
+
+// Create returns a builder for creating a MuteRelation entity.
+func (c *MuteRelationClient) Create() *MuteRelationCreate {
+	mutation := newMuteRelationMutation(c.config, OpCreate)
+	return &MuteRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MuteRelation entities.
+func (c *MuteRelationClient) CreateBulk(builders ...*MuteRelationCreate) *MuteRelationCreateBulk {
+	return &MuteRelationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MuteRelationClient) MapCreateBulk(slice any, setFunc func(*MuteRelationCreate, int)) *MuteRelationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MuteRelationCreateBulk{err: fmt.Errorf("calling to MuteRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MuteRelationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MuteRelationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MuteRelation.
+func (c *MuteRelationClient) Update() *MuteRelationUpdate {
+	mutation := newMuteRelationMutation(c.config, OpUpdate)
+	return &MuteRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MuteRelationClient) UpdateOne(mr *MuteRelation) *MuteRelationUpdateOne {
+	mutation := newMuteRelationMutation(c.config, OpUpdateOne, withMuteRelation(mr))
+	return &MuteRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MuteRelationClient) UpdateOneID(id uuid.UUID) *MuteRelationUpdateOne {
+	mutation := newMuteRelationMutation(c.config, OpUpdateOne, withMuteRelationID(id))
+	return &MuteRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MuteRelation.
+func (c *MuteRelationClient) Delete() *MuteRelationDelete {
+	mutation := newMuteRelationMutation(c.config, OpDelete)
+	return &MuteRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MuteRelationClient) DeleteOne(mr *MuteRelation) *MuteRelationDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MuteRelationClient) DeleteOneID(id uuid.UUID) *MuteRelationDeleteOne {
+	builder := c.Delete().Where(muterelation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MuteRelationDeleteOne{builder}
+}
+
+// Query returns a query builder for MuteRelation.
+func (c *MuteRelationClient) Query() *MuteRelationQuery {
+	return &MuteRelationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMuteRelation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MuteRelation entity by its id.
+func (c *MuteRelationClient) Get(ctx context.Context, id uuid.UUID) (*MuteRelation, error) {
+	return c.Query().Where(muterelation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MuteRelationClient) GetX(ctx context.Context, id uuid.UUID) *MuteRelation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFrom queries the from edge of a MuteRelation.
+func (c *MuteRelationClient) QueryFrom(mr *MuteRelation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(muterelation.Table, muterelation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, muterelation.FromTable, muterelation.FromColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTo queries the to edge of a MuteRelation.
+func (c *MuteRelationClient) QueryTo(mr *MuteRelation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(muterelation.Table, muterelation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, muterelation.ToTable, muterelation.ToColumn),
+		)
+		fromV = sqlgraph.Neighbors(mr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MuteRelationClient) Hooks() []Hook {
+	return c.hooks.MuteRelation
+}
+
+// Interceptors returns the client interceptors.
+func (c *MuteRelationClient) Interceptors() []Interceptor {
+	return c.inters.MuteRelation
+}
+
+func (c *MuteRelationClient) mutate(ctx context.Context, m *MuteRelationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MuteRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MuteRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MuteRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MuteRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MuteRelation mutation op: %q", m.Op())
+	}
+}
+
+// MutedKeywordClient is a client for the MutedKeyword schema.
+type MutedKeywordClient struct {
+	config
+}
+
+// NewMutedKeywordClient returns a client for the MutedKeyword from the given config.
+func NewMutedKeywordClient(c config) *MutedKeywordClient {
+	return &MutedKeywordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mutedkeyword.Hooks(f(g(h())))`.
+func (c *MutedKeywordClient) Use(hooks ...Hook) {
+	c.hooks.MutedKeyword = append(c.hooks.MutedKeyword, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mutedkeyword.Intercept(f(g(h())))`.
+func (c *MutedKeywordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MutedKeyword = append(c.inters.MutedKeyword, interceptors...)
+}
+
+// Create returns a builder for creating a MutedKeyword entity.
+func (c *MutedKeywordClient) Create() *MutedKeywordCreate {
+	mutation := newMutedKeywordMutation(c.config, OpCreate)
+	return &MutedKeywordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MutedKeyword entities.
+func (c *MutedKeywordClient) CreateBulk(builders ...*MutedKeywordCreate) *MutedKeywordCreateBulk {
+	return &MutedKeywordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MutedKeywordClient) MapCreateBulk(slice any, setFunc func(*MutedKeywordCreate, int)) *MutedKeywordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MutedKeywordCreateBulk{err: fmt.Errorf("calling to MutedKeywordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MutedKeywordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MutedKeywordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MutedKeyword.
+func (c *MutedKeywordClient) Update() *MutedKeywordUpdate {
+	mutation := newMutedKeywordMutation(c.config, OpUpdate)
+	return &MutedKeywordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MutedKeywordClient) UpdateOne(mk *MutedKeyword) *MutedKeywordUpdateOne {
+	mutation := newMutedKeywordMutation(c.config, OpUpdateOne, withMutedKeyword(mk))
+	return &MutedKeywordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MutedKeywordClient) UpdateOneID(id uuid.UUID) *MutedKeywordUpdateOne {
+	mutation := newMutedKeywordMutation(c.config, OpUpdateOne, withMutedKeywordID(id))
+	return &MutedKeywordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MutedKeyword.
+func (c *MutedKeywordClient) Delete() *MutedKeywordDelete {
+	mutation := newMutedKeywordMutation(c.config, OpDelete)
+	return &MutedKeywordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MutedKeywordClient) DeleteOne(mk *MutedKeyword) *MutedKeywordDeleteOne {
+	return c.DeleteOneID(mk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MutedKeywordClient) DeleteOneID(id uuid.UUID) *MutedKeywordDeleteOne {
+	builder := c.Delete().Where(mutedkeyword.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MutedKeywordDeleteOne{builder}
+}
+
+// Query returns a query builder for MutedKeyword.
+func (c *MutedKeywordClient) Query() *MutedKeywordQuery {
+	return &MutedKeywordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMutedKeyword},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MutedKeyword entity by its id.
+func (c *MutedKeywordClient) Get(ctx context.Context, id uuid.UUID) (*MutedKeyword, error) {
+	return c.Query().Where(mutedkeyword.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MutedKeywordClient) GetX(ctx context.Context, id uuid.UUID) *MutedKeyword {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a MutedKeyword.
+func (c *MutedKeywordClient) QueryUser(mk *MutedKeyword) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mutedkeyword.Table, mutedkeyword.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mutedkeyword.UserTable, mutedkeyword.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(mk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MutedKeywordClient) Hooks() []Hook {
+	return c.hooks.MutedKeyword
+}
+
+// Interceptors returns the client interceptors.
+func (c *MutedKeywordClient) Interceptors() []Interceptor {
+	return c.inters.MutedKeyword
+}
+
+func (c *MutedKeywordClient) mutate(ctx context.Context, m *MutedKeywordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MutedKeywordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MutedKeywordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MutedKeywordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MutedKeywordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MutedKeyword mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRecipient queries the recipient edge of a Notification.
+func (c *NotificationClient) QueryRecipient(n *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.RecipientTable, notification.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActor queries the actor edge of a Notification.
+func (c *NotificationClient) QueryActor(n *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.ActorTable, notification.ActorColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPost queries the post edge of a Notification.
+func (c *NotificationClient) QueryPost(n *Notification) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.PostTable, notification.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComment queries the comment edge of a Notification.
+func (c *NotificationClient) QueryComment(n *Notification) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.CommentTable, notification.CommentColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	return c.hooks.Notification
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// PetClient is a client for the Pet schema.
+type PetClient struct {
+	config
+}
+
+// NewPetClient returns a client for the Pet from the given config.
+func NewPetClient(c config) *PetClient {
+	return &PetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pet.Hooks(f(g(h())))`.
+func (c *PetClient) Use(hooks ...Hook) {
+	c.hooks.Pet = append(c.hooks.Pet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
+func (c *PetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Pet = append(c.inters.Pet, interceptors...)
+}
+
+// Create returns a builder for creating a Pet entity.
+func (c *PetClient) Create() *PetCreate {
+	mutation := newPetMutation(c.config, OpCreate)
+	return &PetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Pet entities.
+func (c *PetClient) CreateBulk(builders ...*PetCreate) *PetCreateBulk {
+	return &PetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PetClient) MapCreateBulk(slice any, setFunc func(*PetCreate, int)) *PetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PetCreateBulk{err: fmt.Errorf("calling to PetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Pet.
+func (c *PetClient) Update() *PetUpdate {
+	mutation := newPetMutation(c.config, OpUpdate)
+	return &PetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PetClient) UpdateOne(pe *Pet) *PetUpdateOne {
+	mutation := newPetMutation(c.config, OpUpdateOne, withPet(pe))
+	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PetClient) UpdateOneID(id uuid.UUID) *PetUpdateOne {
+	mutation := newPetMutation(c.config, OpUpdateOne, withPetID(id))
+	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Pet.
+func (c *PetClient) Delete() *PetDelete {
+	mutation := newPetMutation(c.config, OpDelete)
+	return &PetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PetClient) DeleteOne(pe *Pet) *PetDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PetClient) DeleteOneID(id uuid.UUID) *PetDeleteOne {
+	builder := c.Delete().Where(pet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PetDeleteOne{builder}
+}
+
+// Query returns a query builder for Pet.
+func (c *PetClient) Query() *PetQuery {
+	return &PetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Pet entity by its id.
+func (c *PetClient) Get(ctx context.Context, id uuid.UUID) (*Pet, error) {
+	return c.Query().Where(pet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PetClient) GetX(ctx context.Context, id uuid.UUID) *Pet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Pet.
+func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pet.Table, pet.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pet.OwnerTable, pet.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPosts queries the posts edge of a Pet.
+func (c *PetClient) QueryPosts(pe *Pet) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pet.Table, pet.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, pet.PostsTable, pet.PostsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PetClient) Hooks() []Hook {
+	return c.hooks.Pet
+}
+
+// Interceptors returns the client interceptors.
+func (c *PetClient) Interceptors() []Interceptor {
+	return c.inters.Pet
+}
+
+func (c *PetClient) mutate(ctx context.Context, m *PetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Pet mutation op: %q", m.Op())
+	}
+}
+
+// PostClient is a client for the Post schema.
+type PostClient struct {
+	config
+}
+
+// NewPostClient returns a client for the Post from the given config.
+func NewPostClient(c config) *PostClient {
+	return &PostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
+func (c *PostClient) Use(hooks ...Hook) {
+	c.hooks.Post = append(c.hooks.Post, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
+// Create returns a builder for creating a Post entity.
+func (c *PostClient) Create() *PostCreate {
+	mutation := newPostMutation(c.config, OpCreate)
+	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Post entities.
+func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Post.
+func (c *PostClient) Update() *PostUpdate {
+	mutation := newPostMutation(c.config, OpUpdate)
+	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostClient) UpdateOne(po *Post) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPost(po))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostClient) UpdateOneID(id uuid.UUID) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Post.
+func (c *PostClient) Delete() *PostDelete {
+	mutation := newPostMutation(c.config, OpDelete)
+	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostClient) DeleteOne(po *Post) *PostDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostClient) DeleteOneID(id uuid.UUID) *PostDeleteOne {
+	builder := c.Delete().Where(post.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostDeleteOne{builder}
+}
+
+// Query returns a query builder for Post.
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Post entity by its id.
+func (c *PostClient) Get(ctx context.Context, id uuid.UUID) (*Post, error) {
+	return c.Query().Where(post.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostClient) GetX(ctx context.Context, id uuid.UUID) *Post {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Post.
+func (c *PostClient) QueryUser(po *Post) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.UserTable, post.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a Post.
+func (c *PostClient) QueryComments(po *Post) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.CommentsTable, post.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLikes queries the likes edge of a Post.
+func (c *PostClient) QueryLikes(po *Post) *LikeQuery {
+	query := (&LikeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(like.Table, like.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.LikesTable, post.LikesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDailyTask queries the daily_task edge of a Post.
+func (c *PostClient) QueryDailyTask(po *Post) *DailyTaskQuery {
+	query := (&DailyTaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(dailytask.Table, dailytask.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, post.DailyTaskTable, post.DailyTaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReports queries the reports edge of a Post.
+func (c *PostClient) QueryReports(po *Post) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.ReportsTable, post.ReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExploreRanking queries the explore_ranking edge of a Post.
+func (c *PostClient) QueryExploreRanking(po *Post) *ExploreRankingQuery {
+	query := (&ExploreRankingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(exploreranking.Table, exploreranking.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, post.ExploreRankingTable, post.ExploreRankingColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Post.
+func (c *PostClient) QueryRevisions(po *Post) *PostRevisionQuery {
+	query := (&PostRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(postrevision.Table, postrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.RevisionsTable, post.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMedia queries the media edge of a Post.
+func (c *PostClient) QueryMedia(po *Post) *PostMediaQuery {
+	query := (&PostMediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(postmedia.Table, postmedia.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.MediaTable, post.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPets queries the pets edge of a Post.
+func (c *PostClient) QueryPets(po *Post) *PetQuery {
+	query := (&PetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(pet.Table, pet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, post.PetsTable, post.PetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Post.
+func (c *PostClient) QueryTags(po *Post) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, post.TagsTable, post.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a Post.
+func (c *PostClient) QueryMentions(po *Post) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, post.MentionsTable, post.MentionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotifications queries the notifications edge of a Post.
+func (c *PostClient) QueryNotifications(po *Post) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.NotificationsTable, post.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPostTags queries the post_tags edge of a Post.
+func (c *PostClient) QueryPostTags(po *Post) *PostTagQuery {
+	query := (&PostTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(posttag.Table, posttag.PostColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, post.PostTagsTable, post.PostTagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostClient) Hooks() []Hook {
+	return c.hooks.Post
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
+func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+	}
+}
+
+// PostMediaClient is a client for the PostMedia schema.
+type PostMediaClient struct {
+	config
+}
+
+// NewPostMediaClient returns a client for the PostMedia from the given config.
+func NewPostMediaClient(c config) *PostMediaClient {
+	return &PostMediaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postmedia.Hooks(f(g(h())))`.
+func (c *PostMediaClient) Use(hooks ...Hook) {
+	c.hooks.PostMedia = append(c.hooks.PostMedia, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postmedia.Intercept(f(g(h())))`.
+func (c *PostMediaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostMedia = append(c.inters.PostMedia, interceptors...)
+}
+
+// Create returns a builder for creating a PostMedia entity.
+func (c *PostMediaClient) Create() *PostMediaCreate {
+	mutation := newPostMediaMutation(c.config, OpCreate)
+	return &PostMediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostMedia entities.
+func (c *PostMediaClient) CreateBulk(builders ...*PostMediaCreate) *PostMediaCreateBulk {
+	return &PostMediaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostMediaClient) MapCreateBulk(slice any, setFunc func(*PostMediaCreate, int)) *PostMediaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostMediaCreateBulk{err: fmt.Errorf("calling to PostMediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostMediaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostMediaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostMedia.
+func (c *PostMediaClient) Update() *PostMediaUpdate {
+	mutation := newPostMediaMutation(c.config, OpUpdate)
+	return &PostMediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostMediaClient) UpdateOne(pm *PostMedia) *PostMediaUpdateOne {
+	mutation := newPostMediaMutation(c.config, OpUpdateOne, withPostMedia(pm))
+	return &PostMediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostMediaClient) UpdateOneID(id uuid.UUID) *PostMediaUpdateOne {
+	mutation := newPostMediaMutation(c.config, OpUpdateOne, withPostMediaID(id))
+	return &PostMediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostMedia.
+func (c *PostMediaClient) Delete() *PostMediaDelete {
+	mutation := newPostMediaMutation(c.config, OpDelete)
+	return &PostMediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostMediaClient) DeleteOne(pm *PostMedia) *PostMediaDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostMediaClient) DeleteOneID(id uuid.UUID) *PostMediaDeleteOne {
+	builder := c.Delete().Where(postmedia.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostMediaDeleteOne{builder}
+}
+
+// Query returns a query builder for PostMedia.
+func (c *PostMediaClient) Query() *PostMediaQuery {
+	return &PostMediaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostMedia},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostMedia entity by its id.
+func (c *PostMediaClient) Get(ctx context.Context, id uuid.UUID) (*PostMedia, error) {
+	return c.Query().Where(postmedia.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostMediaClient) GetX(ctx context.Context, id uuid.UUID) *PostMedia {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a PostMedia.
+func (c *PostMediaClient) QueryPost(pm *PostMedia) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postmedia.Table, postmedia.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, postmedia.PostTable, postmedia.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostMediaClient) Hooks() []Hook {
+	return c.hooks.PostMedia
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostMediaClient) Interceptors() []Interceptor {
+	return c.inters.PostMedia
+}
+
+func (c *PostMediaClient) mutate(ctx context.Context, m *PostMediaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostMediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostMediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostMediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostMediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostMedia mutation op: %q", m.Op())
+	}
+}
+
+// PostRevisionClient is a client for the PostRevision schema.
+type PostRevisionClient struct {
+	config
+}
+
+// NewPostRevisionClient returns a client for the PostRevision from the given config.
+func NewPostRevisionClient(c config) *PostRevisionClient {
+	return &PostRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postrevision.Hooks(f(g(h())))`.
+func (c *PostRevisionClient) Use(hooks ...Hook) {
+	c.hooks.PostRevision = append(c.hooks.PostRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postrevision.Intercept(f(g(h())))`.
+func (c *PostRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostRevision = append(c.inters.PostRevision, interceptors...)
+}
+
+// Create returns a builder for creating a PostRevision entity.
+func (c *PostRevisionClient) Create() *PostRevisionCreate {
+	mutation := newPostRevisionMutation(c.config, OpCreate)
+	return &PostRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostRevision entities.
+func (c *PostRevisionClient) CreateBulk(builders ...*PostRevisionCreate) *PostRevisionCreateBulk {
+	return &PostRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostRevisionClient) MapCreateBulk(slice any, setFunc func(*PostRevisionCreate, int)) *PostRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostRevisionCreateBulk{err: fmt.Errorf("calling to PostRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostRevision.
+func (c *PostRevisionClient) Update() *PostRevisionUpdate {
+	mutation := newPostRevisionMutation(c.config, OpUpdate)
+	return &PostRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostRevisionClient) UpdateOne(pr *PostRevision) *PostRevisionUpdateOne {
+	mutation := newPostRevisionMutation(c.config, OpUpdateOne, withPostRevision(pr))
+	return &PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostRevisionClient) UpdateOneID(id uuid.UUID) *PostRevisionUpdateOne {
+	mutation := newPostRevisionMutation(c.config, OpUpdateOne, withPostRevisionID(id))
+	return &PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostRevision.
+func (c *PostRevisionClient) Delete() *PostRevisionDelete {
+	mutation := newPostRevisionMutation(c.config, OpDelete)
+	return &PostRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostRevisionClient) DeleteOne(pr *PostRevision) *PostRevisionDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostRevisionClient) DeleteOneID(id uuid.UUID) *PostRevisionDeleteOne {
+	builder := c.Delete().Where(postrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for PostRevision.
+func (c *PostRevisionClient) Query() *PostRevisionQuery {
+	return &PostRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostRevision entity by its id.
+func (c *PostRevisionClient) Get(ctx context.Context, id uuid.UUID) (*PostRevision, error) {
+	return c.Query().Where(postrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostRevisionClient) GetX(ctx context.Context, id uuid.UUID) *PostRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a PostRevision.
+func (c *PostRevisionClient) QueryPost(pr *PostRevision) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postrevision.Table, postrevision.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, postrevision.PostTable, postrevision.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostRevisionClient) Hooks() []Hook {
+	return c.hooks.PostRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostRevisionClient) Interceptors() []Interceptor {
+	return c.inters.PostRevision
+}
+
+func (c *PostRevisionClient) mutate(ctx context.Context, m *PostRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostRevision mutation op: %q", m.Op())
+	}
+}
+
+// PostTagClient is a client for the PostTag schema.
+type PostTagClient struct {
+	config
+}
+
+// NewPostTagClient returns a client for the PostTag from the given config.
+func NewPostTagClient(c config) *PostTagClient {
+	return &PostTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posttag.Hooks(f(g(h())))`.
+func (c *PostTagClient) Use(hooks ...Hook) {
+	c.hooks.PostTag = append(c.hooks.PostTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posttag.Intercept(f(g(h())))`.
+func (c *PostTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostTag = append(c.inters.PostTag, interceptors...)
+}
+
+// Create returns a builder for creating a PostTag entity.
+func (c *PostTagClient) Create() *PostTagCreate {
+	mutation := newPostTagMutation(c.config, OpCreate)
+	return &PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostTag entities.
+func (c *PostTagClient) CreateBulk(builders ...*PostTagCreate) *PostTagCreateBulk {
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostTagClient) MapCreateBulk(slice any, setFunc func(*PostTagCreate, int)) *PostTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostTagCreateBulk{err: fmt.Errorf("calling to PostTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostTag.
+func (c *PostTagClient) Update() *PostTagUpdate {
+	mutation := newPostTagMutation(c.config, OpUpdate)
+	return &PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Query returns a query builder for DailyTask.
-func (c *DailyTaskClient) Query() *DailyTaskQuery {
-	return &DailyTaskQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeDailyTask},
-		inters: c.Interceptors(),
-	}
+// UpdateOne returns an update builder for the given entity.
+func (c *PostTagClient) UpdateOne(pt *PostTag) *PostTagUpdateOne {
+	mutation := newPostTagMutation(c.config, OpUpdateOne)
+	mutation.post = &pt.PostID
+	mutation.tag = &pt.TagID
+	return &PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Get returns a DailyTask entity by its id.
-func (c *DailyTaskClient) Get(ctx context.Context, id uuid.UUID) (*DailyTask, error) {
-	return c.Query().Where(dailytask.ID(id)).Only(ctx)
+// Delete returns a delete builder for PostTag.
+func (c *PostTagClient) Delete() *PostTagDelete {
+	mutation := newPostTagMutation(c.config, OpDelete)
+	return &PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *DailyTaskClient) GetX(ctx context.Context, id uuid.UUID) *DailyTask {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// Query returns a query builder for PostTag.
+func (c *PostTagClient) Query() *PostTagQuery {
+	return &PostTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostTag},
+		inters: c.Interceptors(),
 	}
-	return obj
 }
 
-// QueryUser queries the user edge of a DailyTask.
-func (c *DailyTaskClient) QueryUser(dt *DailyTask) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := dt.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(dailytask.Table, dailytask.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, dailytask.UserTable, dailytask.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// QueryPost queries the post edge of a PostTag.
+func (c *PostTagClient) QueryPost(pt *PostTag) *PostQuery {
+	return c.Query().
+		Where(posttag.PostID(pt.PostID), posttag.TagID(pt.TagID)).
+		QueryPost()
 }
 
-// QueryPost queries the post edge of a DailyTask.
-func (c *DailyTaskClient) QueryPost(dt *DailyTask) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := dt.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(dailytask.Table, dailytask.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, dailytask.PostTable, dailytask.PostColumn),
-		)
-		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// QueryTag queries the tag edge of a PostTag.
+func (c *PostTagClient) QueryTag(pt *PostTag) *TagQuery {
+	return c.Query().
+		Where(posttag.PostID(pt.PostID), posttag.TagID(pt.TagID)).
+		QueryTag()
 }
 
 // Hooks returns the client hooks.
-func (c *DailyTaskClient) Hooks() []Hook {
-	return c.hooks.DailyTask
+func (c *PostTagClient) Hooks() []Hook {
+	return c.hooks.PostTag
 }
 
 // Interceptors returns the client interceptors.
-func (c *DailyTaskClient) Interceptors() []Interceptor {
-	return c.inters.DailyTask
+func (c *PostTagClient) Interceptors() []Interceptor {
+	return c.inters.PostTag
 }
 
-func (c *DailyTaskClient) mutate(ctx context.Context, m *DailyTaskMutation) (Value, error) {
+func (c *PostTagClient) mutate(ctx context.Context, m *PostTagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DailyTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DailyTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DailyTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PostTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DailyTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PostTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DailyTask mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PostTag mutation op: %q", m.Op())
 	}
 }
 
-// DeviceTokenClient is a client for the DeviceToken schema.
-type DeviceTokenClient struct {
+// ReportClient is a client for the Report schema.
+type ReportClient struct {
 	config
 }
 
-// NewDeviceTokenClient returns a client for the DeviceToken from the given config.
-func NewDeviceTokenClient(c config) *DeviceTokenClient {
-	return &DeviceTokenClient{config: c}
+// NewReportClient returns a client for the Report from the given config.
+func NewReportClient(c config) *ReportClient {
+	return &ReportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `devicetoken.Hooks(f(g(h())))`.
-func (c *DeviceTokenClient) Use(hooks ...Hook) {
-	c.hooks.DeviceToken = append(c.hooks.DeviceToken, hooks...)
+// A call to `Use(f, g, h)` equals to `report.Hooks(f(g(h())))`.
+func (c *ReportClient) Use(hooks ...Hook) {
+	c.hooks.Report = append(c.hooks.Report, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `devicetoken.Intercept(f(g(h())))`.
-func (c *DeviceTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeviceToken = append(c.inters.DeviceToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `report.Intercept(f(g(h())))`.
+func (c *ReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Report = append(c.inters.Report, interceptors...)
 }
 
-// Create returns a builder for creating a DeviceToken entity.
-func (c *DeviceTokenClient) Create() *DeviceTokenCreate {
-	mutation := newDeviceTokenMutation(c.config, OpCreate)
-	return &DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Report entity.
+func (c *ReportClient) Create() *ReportCreate {
+	mutation := newReportMutation(c.config, OpCreate)
+	return &ReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeviceToken entities.
-func (c *DeviceTokenClient) CreateBulk(builders ...*DeviceTokenCreate) *DeviceTokenCreateBulk {
-	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Report entities.
+func (c *ReportClient) CreateBulk(builders ...*ReportCreate) *ReportCreateBulk {
+	return &ReportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeviceTokenClient) MapCreateBulk(slice any, setFunc func(*DeviceTokenCreate, int)) *DeviceTokenCreateBulk {
+func (c *ReportClient) MapCreateBulk(slice any, setFunc func(*ReportCreate, int)) *ReportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeviceTokenCreateBulk{err: fmt.Errorf("calling to DeviceTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReportCreateBulk{err: fmt.Errorf("calling to ReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeviceTokenCreate, rv.Len())
+	builders := make([]*ReportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+	return &ReportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeviceToken.
-func (c *DeviceTokenClient) Update() *DeviceTokenUpdate {
-	mutation := newDeviceTokenMutation(c.config, OpUpdate)
-	return &DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Report.
+func (c *ReportClient) Update() *ReportUpdate {
+	mutation := newReportMutation(c.config, OpUpdate)
+	return &ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeviceTokenClient) UpdateOne(dt *DeviceToken) *DeviceTokenUpdateOne {
-	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceToken(dt))
-	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReportClient) UpdateOne(r *Report) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReport(r))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeviceTokenClient) UpdateOneID(id uuid.UUID) *DeviceTokenUpdateOne {
-	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceTokenID(id))
-	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReportClient) UpdateOneID(id uuid.UUID) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReportID(id))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeviceToken.
-func (c *DeviceTokenClient) Delete() *DeviceTokenDelete {
-	mutation := newDeviceTokenMutation(c.config, OpDelete)
-	return &DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Report.
+func (c *ReportClient) Delete() *ReportDelete {
+	mutation := newReportMutation(c.config, OpDelete)
+	return &ReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeviceTokenClient) DeleteOne(dt *DeviceToken) *DeviceTokenDeleteOne {
-	return c.DeleteOneID(dt.ID)
+func (c *ReportClient) DeleteOne(r *Report) *ReportDeleteOne {
+	return c.DeleteOneID(r.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeviceTokenClient) DeleteOneID(id uuid.UUID) *DeviceTokenDeleteOne {
-	builder := c.Delete().Where(devicetoken.ID(id))
+func (c *ReportClient) DeleteOneID(id uuid.UUID) *ReportDeleteOne {
+	builder := c.Delete().Where(report.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeviceTokenDeleteOne{builder}
+	return &ReportDeleteOne{builder}
 }
 
-// Query returns a query builder for DeviceToken.
-func (c *DeviceTokenClient) Query() *DeviceTokenQuery {
-	return &DeviceTokenQuery{
+// Query returns a query builder for Report.
+func (c *ReportClient) Query() *ReportQuery {
+	return &ReportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeviceToken},
+		ctx:    &QueryContext{Type: TypeReport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeviceToken entity by its id.
-func (c *DeviceTokenClient) Get(ctx context.Context, id uuid.UUID) (*DeviceToken, error) {
-	return c.Query().Where(devicetoken.ID(id)).Only(ctx)
+// Get returns a Report entity by its id.
+func (c *ReportClient) Get(ctx context.Context, id uuid.UUID) (*Report, error) {
+	return c.Query().Where(report.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeviceTokenClient) GetX(ctx context.Context, id uuid.UUID) *DeviceToken {
+func (c *ReportClient) GetX(ctx context.Context, id uuid.UUID) *Report {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -875,148 +3768,212 @@ func (c *DeviceTokenClient) GetX(ctx context.Context, id uuid.UUID) *DeviceToken
 	return obj
 }
 
-// QueryUser queries the user edge of a DeviceToken.
-func (c *DeviceTokenClient) QueryUser(dt *DeviceToken) *UserQuery {
+// QueryPost queries the post edge of a Report.
+func (c *ReportClient) QueryPost(r *Report) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.PostTable, report.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComment queries the comment edge of a Report.
+func (c *ReportClient) QueryComment(r *Report) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.CommentTable, report.CommentColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTargetUser queries the target_user edge of a Report.
+func (c *ReportClient) QueryTargetUser(r *Report) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := dt.ID
+		id := r.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(devicetoken.Table, devicetoken.FieldID, id),
+			sqlgraph.From(report.Table, report.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, devicetoken.UserTable, devicetoken.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.TargetUserTable, report.TargetUserColumn),
 		)
-		fromV = sqlgraph.Neighbors(dt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReporter queries the reporter edge of a Report.
+func (c *ReportClient) QueryReporter(r *Report) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.ReporterTable, report.ReporterColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResolver queries the resolver edge of a Report.
+func (c *ReportClient) QueryResolver(r *Report) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(report.Table, report.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, report.ResolverTable, report.ResolverColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *DeviceTokenClient) Hooks() []Hook {
-	return c.hooks.DeviceToken
+func (c *ReportClient) Hooks() []Hook {
+	return c.hooks.Report
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeviceTokenClient) Interceptors() []Interceptor {
-	return c.inters.DeviceToken
+func (c *ReportClient) Interceptors() []Interceptor {
+	return c.inters.Report
 }
 
-func (c *DeviceTokenClient) mutate(ctx context.Context, m *DeviceTokenMutation) (Value, error) {
+func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeviceToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Report mutation op: %q", m.Op())
 	}
 }
 
-// FollowRelationClient is a client for the FollowRelation schema.
-type FollowRelationClient struct {
+// SuspensionClient is a client for the Suspension schema.
+type SuspensionClient struct {
 	config
 }
 
-// NewFollowRelationClient returns a client for the FollowRelation from the given config.
-func NewFollowRelationClient(c config) *FollowRelationClient {
-	return &FollowRelationClient{config: c}
+// NewSuspensionClient returns a client for the Suspension from the given config.
+func NewSuspensionClient(c config) *SuspensionClient {
+	return &SuspensionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `followrelation.Hooks(f(g(h())))`.
-func (c *FollowRelationClient) Use(hooks ...Hook) {
-	c.hooks.FollowRelation = append(c.hooks.FollowRelation, hooks...)
+// A call to `Use(f, g, h)` equals to `suspension.Hooks(f(g(h())))`.
+func (c *SuspensionClient) Use(hooks ...Hook) {
+	c.hooks.Suspension = append(c.hooks.Suspension, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `followrelation.Intercept(f(g(h())))`.
-func (c *FollowRelationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FollowRelation = append(c.inters.FollowRelation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `suspension.Intercept(f(g(h())))`.
+func (c *SuspensionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Suspension = append(c.inters.Suspension, interceptors...)
 }
 
-// Create returns a builder for creating a FollowRelation entity.
-func (c *FollowRelationClient) Create() *FollowRelationCreate {
-	mutation := newFollowRelationMutation(c.config, OpCreate)
-	return &FollowRelationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Suspension entity.
+func (c *SuspensionClient) Create() *SuspensionCreate {
+	mutation := newSuspensionMutation(c.config, OpCreate)
+	return &SuspensionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FollowRelation entities.
-func (c *FollowRelationClient) CreateBulk(builders ...*FollowRelationCreate) *FollowRelationCreateBulk {
-	return &FollowRelationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Suspension entities.
+func (c *SuspensionClient) CreateBulk(builders ...*SuspensionCreate) *SuspensionCreateBulk {
+	return &SuspensionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FollowRelationClient) MapCreateBulk(slice any, setFunc func(*FollowRelationCreate, int)) *FollowRelationCreateBulk {
+func (c *SuspensionClient) MapCreateBulk(slice any, setFunc func(*SuspensionCreate, int)) *SuspensionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FollowRelationCreateBulk{err: fmt.Errorf("calling to FollowRelationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SuspensionCreateBulk{err: fmt.Errorf("calling to SuspensionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FollowRelationCreate, rv.Len())
+	builders := make([]*SuspensionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FollowRelationCreateBulk{config: c.config, builders: builders}
+	return &SuspensionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FollowRelation.
-func (c *FollowRelationClient) Update() *FollowRelationUpdate {
-	mutation := newFollowRelationMutation(c.config, OpUpdate)
-	return &FollowRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Suspension.
+func (c *SuspensionClient) Update() *SuspensionUpdate {
+	mutation := newSuspensionMutation(c.config, OpUpdate)
+	return &SuspensionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FollowRelationClient) UpdateOne(fr *FollowRelation) *FollowRelationUpdateOne {
-	mutation := newFollowRelationMutation(c.config, OpUpdateOne, withFollowRelation(fr))
-	return &FollowRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SuspensionClient) UpdateOne(s *Suspension) *SuspensionUpdateOne {
+	mutation := newSuspensionMutation(c.config, OpUpdateOne, withSuspension(s))
+	return &SuspensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FollowRelationClient) UpdateOneID(id uuid.UUID) *FollowRelationUpdateOne {
-	mutation := newFollowRelationMutation(c.config, OpUpdateOne, withFollowRelationID(id))
-	return &FollowRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SuspensionClient) UpdateOneID(id uuid.UUID) *SuspensionUpdateOne {
+	mutation := newSuspensionMutation(c.config, OpUpdateOne, withSuspensionID(id))
+	return &SuspensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FollowRelation.
-func (c *FollowRelationClient) Delete() *FollowRelationDelete {
-	mutation := newFollowRelationMutation(c.config, OpDelete)
-	return &FollowRelationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Suspension.
+func (c *SuspensionClient) Delete() *SuspensionDelete {
+	mutation := newSuspensionMutation(c.config, OpDelete)
+	return &SuspensionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FollowRelationClient) DeleteOne(fr *FollowRelation) *FollowRelationDeleteOne {
-	return c.DeleteOneID(fr.ID)
+func (c *SuspensionClient) DeleteOne(s *Suspension) *SuspensionDeleteOne {
+	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FollowRelationClient) DeleteOneID(id uuid.UUID) *FollowRelationDeleteOne {
-	builder := c.Delete().Where(followrelation.ID(id))
+func (c *SuspensionClient) DeleteOneID(id uuid.UUID) *SuspensionDeleteOne {
+	builder := c.Delete().Where(suspension.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FollowRelationDeleteOne{builder}
+	return &SuspensionDeleteOne{builder}
 }
 
-// Query returns a query builder for FollowRelation.
-func (c *FollowRelationClient) Query() *FollowRelationQuery {
-	return &FollowRelationQuery{
+// Query returns a query builder for Suspension.
+func (c *SuspensionClient) Query() *SuspensionQuery {
+	return &SuspensionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFollowRelation},
+		ctx:    &QueryContext{Type: TypeSuspension},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FollowRelation entity by its id.
-func (c *FollowRelationClient) Get(ctx context.Context, id uuid.UUID) (*FollowRelation, error) {
-	return c.Query().Where(followrelation.ID(id)).Only(ctx)
+// Get returns a Suspension entity by its id.
+func (c *SuspensionClient) Get(ctx context.Context, id uuid.UUID) (*Suspension, error) {
+	return c.Query().Where(suspension.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FollowRelationClient) GetX(ctx context.Context, id uuid.UUID) *FollowRelation {
+func (c *SuspensionClient) GetX(ctx context.Context, id uuid.UUID) *Suspension {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1024,164 +3981,164 @@ func (c *FollowRelationClient) GetX(ctx context.Context, id uuid.UUID) *FollowRe
 	return obj
 }
 
-// QueryFrom queries the from edge of a FollowRelation.
-func (c *FollowRelationClient) QueryFrom(fr *FollowRelation) *UserQuery {
+// QueryUser queries the user edge of a Suspension.
+func (c *SuspensionClient) QueryUser(s *Suspension) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fr.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(followrelation.Table, followrelation.FieldID, id),
+			sqlgraph.From(suspension.Table, suspension.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, followrelation.FromTable, followrelation.FromColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, suspension.UserTable, suspension.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTo queries the to edge of a FollowRelation.
-func (c *FollowRelationClient) QueryTo(fr *FollowRelation) *UserQuery {
+// QueryIssuedBy queries the issued_by edge of a Suspension.
+func (c *SuspensionClient) QueryIssuedBy(s *Suspension) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fr.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(followrelation.Table, followrelation.FieldID, id),
+			sqlgraph.From(suspension.Table, suspension.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, followrelation.ToTable, followrelation.ToColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, suspension.IssuedByTable, suspension.IssuedByColumn),
 		)
-		fromV = sqlgraph.Neighbors(fr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FollowRelationClient) Hooks() []Hook {
-	return c.hooks.FollowRelation
+func (c *SuspensionClient) Hooks() []Hook {
+	return c.hooks.Suspension
 }
 
 // Interceptors returns the client interceptors.
-func (c *FollowRelationClient) Interceptors() []Interceptor {
-	return c.inters.FollowRelation
+func (c *SuspensionClient) Interceptors() []Interceptor {
+	return c.inters.Suspension
 }
 
-func (c *FollowRelationClient) mutate(ctx context.Context, m *FollowRelationMutation) (Value, error) {
+func (c *SuspensionClient) mutate(ctx context.Context, m *SuspensionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FollowRelationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SuspensionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FollowRelationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SuspensionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FollowRelationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SuspensionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FollowRelationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SuspensionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FollowRelation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Suspension mutation op: %q", m.Op())
 	}
 }
 
-// LikeClient is a client for the Like schema.
-type LikeClient struct {
+// TagClient is a client for the Tag schema.
+type TagClient struct {
 	config
 }
 
-// NewLikeClient returns a client for the Like from the given config.
-func NewLikeClient(c config) *LikeClient {
-	return &LikeClient{config: c}
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `like.Hooks(f(g(h())))`.
-func (c *LikeClient) Use(hooks ...Hook) {
-	c.hooks.Like = append(c.hooks.Like, hooks...)
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
 }
 
-// Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `like.Intercept(f(g(h())))`.
-func (c *LikeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Like = append(c.inters.Like, interceptors...)
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
 }
 
-// Create returns a builder for creating a Like entity.
-func (c *LikeClient) Create() *LikeCreate {
-	mutation := newLikeMutation(c.config, OpCreate)
-	return &LikeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Like entities.
-func (c *LikeClient) CreateBulk(builders ...*LikeCreate) *LikeCreateBulk {
-	return &LikeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LikeClient) MapCreateBulk(slice any, setFunc func(*LikeCreate, int)) *LikeCreateBulk {
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LikeCreateBulk{err: fmt.Errorf("calling to LikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LikeCreate, rv.Len())
+	builders := make([]*TagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LikeCreateBulk{config: c.config, builders: builders}
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Like.
-func (c *LikeClient) Update() *LikeUpdate {
-	mutation := newLikeMutation(c.config, OpUpdate)
-	return &LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LikeClient) UpdateOne(l *Like) *LikeUpdateOne {
-	mutation := newLikeMutation(c.config, OpUpdateOne, withLike(l))
-	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LikeClient) UpdateOneID(id uuid.UUID) *LikeUpdateOne {
-	mutation := newLikeMutation(c.config, OpUpdateOne, withLikeID(id))
-	return &LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOneID(id uuid.UUID) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Like.
-func (c *LikeClient) Delete() *LikeDelete {
-	mutation := newLikeMutation(c.config, OpDelete)
-	return &LikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LikeClient) DeleteOne(l *Like) *LikeDeleteOne {
-	return c.DeleteOneID(l.ID)
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LikeClient) DeleteOneID(id uuid.UUID) *LikeDeleteOne {
-	builder := c.Delete().Where(like.ID(id))
+func (c *TagClient) DeleteOneID(id uuid.UUID) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LikeDeleteOne{builder}
+	return &TagDeleteOne{builder}
 }
 
-// Query returns a query builder for Like.
-func (c *LikeClient) Query() *LikeQuery {
-	return &LikeQuery{
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLike},
+		ctx:    &QueryContext{Type: TypeTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Like entity by its id.
-func (c *LikeClient) Get(ctx context.Context, id uuid.UUID) (*Like, error) {
-	return c.Query().Where(like.ID(id)).Only(ctx)
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uuid.UUID) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LikeClient) GetX(ctx context.Context, id uuid.UUID) *Like {
+func (c *TagClient) GetX(ctx context.Context, id uuid.UUID) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1189,164 +4146,164 @@ func (c *LikeClient) GetX(ctx context.Context, id uuid.UUID) *Like {
 	return obj
 }
 
-// QueryUser queries the user edge of a Like.
-func (c *LikeClient) QueryUser(l *Like) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryPosts queries the posts edge of a Tag.
+func (c *TagClient) QueryPosts(t *Tag) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := l.ID
+		id := t.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(like.Table, like.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, like.UserTable, like.UserColumn),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.PostsTable, tag.PostsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryPost queries the post edge of a Like.
-func (c *LikeClient) QueryPost(l *Like) *PostQuery {
-	query := (&PostClient{config: c.config}).Query()
+// QueryPostTags queries the post_tags edge of a Tag.
+func (c *TagClient) QueryPostTags(t *Tag) *PostTagQuery {
+	query := (&PostTagClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := l.ID
+		id := t.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(like.Table, like.FieldID, id),
-			sqlgraph.To(post.Table, post.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, like.PostTable, like.PostColumn),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(posttag.Table, posttag.TagColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, tag.PostTagsTable, tag.PostTagsColumn),
 		)
-		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LikeClient) Hooks() []Hook {
-	return c.hooks.Like
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
 }
 
 // Interceptors returns the client interceptors.
-func (c *LikeClient) Interceptors() []Interceptor {
-	return c.inters.Like
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *LikeClient) mutate(ctx context.Context, m *LikeMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LikeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LikeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LikeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Like mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
 	}
 }
 
-// PetClient is a client for the Pet schema.
-type PetClient struct {
+// TimelineCacheClient is a client for the TimelineCache schema.
+type TimelineCacheClient struct {
 	config
 }
 
-// NewPetClient returns a client for the Pet from the given config.
-func NewPetClient(c config) *PetClient {
-	return &PetClient{config: c}
+// NewTimelineCacheClient returns a client for the TimelineCache from the given config.
+func NewTimelineCacheClient(c config) *TimelineCacheClient {
+	return &TimelineCacheClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pet.Hooks(f(g(h())))`.
-func (c *PetClient) Use(hooks ...Hook) {
-	c.hooks.Pet = append(c.hooks.Pet, hooks...)
+// A call to `Use(f, g, h)` equals to `timelinecache.Hooks(f(g(h())))`.
+func (c *TimelineCacheClient) Use(hooks ...Hook) {
+	c.hooks.TimelineCache = append(c.hooks.TimelineCache, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pet.Intercept(f(g(h())))`.
-func (c *PetClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Pet = append(c.inters.Pet, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `timelinecache.Intercept(f(g(h())))`.
+func (c *TimelineCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TimelineCache = append(c.inters.TimelineCache, interceptors...)
 }
 
-// Create returns a builder for creating a Pet entity.
-func (c *PetClient) Create() *PetCreate {
-	mutation := newPetMutation(c.config, OpCreate)
-	return &PetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TimelineCache entity.
+func (c *TimelineCacheClient) Create() *TimelineCacheCreate {
+	mutation := newTimelineCacheMutation(c.config, OpCreate)
+	return &TimelineCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Pet entities.
-func (c *PetClient) CreateBulk(builders ...*PetCreate) *PetCreateBulk {
-	return &PetCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TimelineCache entities.
+func (c *TimelineCacheClient) CreateBulk(builders ...*TimelineCacheCreate) *TimelineCacheCreateBulk {
+	return &TimelineCacheCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PetClient) MapCreateBulk(slice any, setFunc func(*PetCreate, int)) *PetCreateBulk {
+func (c *TimelineCacheClient) MapCreateBulk(slice any, setFunc func(*TimelineCacheCreate, int)) *TimelineCacheCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PetCreateBulk{err: fmt.Errorf("calling to PetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TimelineCacheCreateBulk{err: fmt.Errorf("calling to TimelineCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PetCreate, rv.Len())
+	builders := make([]*TimelineCacheCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PetCreateBulk{config: c.config, builders: builders}
+	return &TimelineCacheCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Pet.
-func (c *PetClient) Update() *PetUpdate {
-	mutation := newPetMutation(c.config, OpUpdate)
-	return &PetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TimelineCache.
+func (c *TimelineCacheClient) Update() *TimelineCacheUpdate {
+	mutation := newTimelineCacheMutation(c.config, OpUpdate)
+	return &TimelineCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PetClient) UpdateOne(pe *Pet) *PetUpdateOne {
-	mutation := newPetMutation(c.config, OpUpdateOne, withPet(pe))
-	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TimelineCacheClient) UpdateOne(tc *TimelineCache) *TimelineCacheUpdateOne {
+	mutation := newTimelineCacheMutation(c.config, OpUpdateOne, withTimelineCache(tc))
+	return &TimelineCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PetClient) UpdateOneID(id uuid.UUID) *PetUpdateOne {
-	mutation := newPetMutation(c.config, OpUpdateOne, withPetID(id))
-	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TimelineCacheClient) UpdateOneID(id uuid.UUID) *TimelineCacheUpdateOne {
+	mutation := newTimelineCacheMutation(c.config, OpUpdateOne, withTimelineCacheID(id))
+	return &TimelineCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Pet.
-func (c *PetClient) Delete() *PetDelete {
-	mutation := newPetMutation(c.config, OpDelete)
-	return &PetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TimelineCache.
+func (c *TimelineCacheClient) Delete() *TimelineCacheDelete {
+	mutation := newTimelineCacheMutation(c.config, OpDelete)
+	return &TimelineCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PetClient) DeleteOne(pe *Pet) *PetDeleteOne {
-	return c.DeleteOneID(pe.ID)
+func (c *TimelineCacheClient) DeleteOne(tc *TimelineCache) *TimelineCacheDeleteOne {
+	return c.DeleteOneID(tc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PetClient) DeleteOneID(id uuid.UUID) *PetDeleteOne {
-	builder := c.Delete().Where(pet.ID(id))
+func (c *TimelineCacheClient) DeleteOneID(id uuid.UUID) *TimelineCacheDeleteOne {
+	builder := c.Delete().Where(timelinecache.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PetDeleteOne{builder}
+	return &TimelineCacheDeleteOne{builder}
 }
 
-// Query returns a query builder for Pet.
-func (c *PetClient) Query() *PetQuery {
-	return &PetQuery{
+// Query returns a query builder for TimelineCache.
+func (c *TimelineCacheClient) Query() *TimelineCacheQuery {
+	return &TimelineCacheQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePet},
+		ctx:    &QueryContext{Type: TypeTimelineCache},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Pet entity by its id.
-func (c *PetClient) Get(ctx context.Context, id uuid.UUID) (*Pet, error) {
-	return c.Query().Where(pet.ID(id)).Only(ctx)
+// Get returns a TimelineCache entity by its id.
+func (c *TimelineCacheClient) Get(ctx context.Context, id uuid.UUID) (*TimelineCache, error) {
+	return c.Query().Where(timelinecache.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PetClient) GetX(ctx context.Context, id uuid.UUID) *Pet {
+func (c *TimelineCacheClient) GetX(ctx context.Context, id uuid.UUID) *TimelineCache {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1354,241 +4311,161 @@ func (c *PetClient) GetX(ctx context.Context, id uuid.UUID) *Pet {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Pet.
-func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pe.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(pet.Table, pet.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pet.OwnerTable, pet.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PetClient) Hooks() []Hook {
-	return c.hooks.Pet
+func (c *TimelineCacheClient) Hooks() []Hook {
+	return c.hooks.TimelineCache
 }
 
 // Interceptors returns the client interceptors.
-func (c *PetClient) Interceptors() []Interceptor {
-	return c.inters.Pet
+func (c *TimelineCacheClient) Interceptors() []Interceptor {
+	return c.inters.TimelineCache
 }
 
-func (c *PetClient) mutate(ctx context.Context, m *PetMutation) (Value, error) {
+func (c *TimelineCacheClient) mutate(ctx context.Context, m *TimelineCacheMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TimelineCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TimelineCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TimelineCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TimelineCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Pet mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TimelineCache mutation op: %q", m.Op())
 	}
 }
 
-// PostClient is a client for the Post schema.
-type PostClient struct {
+// TokenRevocationClient is a client for the TokenRevocation schema.
+type TokenRevocationClient struct {
 	config
 }
 
-// NewPostClient returns a client for the Post from the given config.
-func NewPostClient(c config) *PostClient {
-	return &PostClient{config: c}
+// NewTokenRevocationClient returns a client for the TokenRevocation from the given config.
+func NewTokenRevocationClient(c config) *TokenRevocationClient {
+	return &TokenRevocationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
-func (c *PostClient) Use(hooks ...Hook) {
-	c.hooks.Post = append(c.hooks.Post, hooks...)
+// A call to `Use(f, g, h)` equals to `tokenrevocation.Hooks(f(g(h())))`.
+func (c *TokenRevocationClient) Use(hooks ...Hook) {
+	c.hooks.TokenRevocation = append(c.hooks.TokenRevocation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
-func (c *PostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Post = append(c.inters.Post, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tokenrevocation.Intercept(f(g(h())))`.
+func (c *TokenRevocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenRevocation = append(c.inters.TokenRevocation, interceptors...)
 }
 
-// Create returns a builder for creating a Post entity.
-func (c *PostClient) Create() *PostCreate {
-	mutation := newPostMutation(c.config, OpCreate)
-	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TokenRevocation entity.
+func (c *TokenRevocationClient) Create() *TokenRevocationCreate {
+	mutation := newTokenRevocationMutation(c.config, OpCreate)
+	return &TokenRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Post entities.
-func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
-	return &PostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TokenRevocation entities.
+func (c *TokenRevocationClient) CreateBulk(builders ...*TokenRevocationCreate) *TokenRevocationCreateBulk {
+	return &TokenRevocationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+func (c *TokenRevocationClient) MapCreateBulk(slice any, setFunc func(*TokenRevocationCreate, int)) *TokenRevocationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TokenRevocationCreateBulk{err: fmt.Errorf("calling to TokenRevocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostCreate, rv.Len())
+	builders := make([]*TokenRevocationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostCreateBulk{config: c.config, builders: builders}
+	return &TokenRevocationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Post.
-func (c *PostClient) Update() *PostUpdate {
-	mutation := newPostMutation(c.config, OpUpdate)
-	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TokenRevocation.
+func (c *TokenRevocationClient) Update() *TokenRevocationUpdate {
+	mutation := newTokenRevocationMutation(c.config, OpUpdate)
+	return &TokenRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostClient) UpdateOne(po *Post) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPost(po))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TokenRevocationClient) UpdateOne(tr *TokenRevocation) *TokenRevocationUpdateOne {
+	mutation := newTokenRevocationMutation(c.config, OpUpdateOne, withTokenRevocation(tr))
+	return &TokenRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostClient) UpdateOneID(id uuid.UUID) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TokenRevocationClient) UpdateOneID(id string) *TokenRevocationUpdateOne {
+	mutation := newTokenRevocationMutation(c.config, OpUpdateOne, withTokenRevocationID(id))
+	return &TokenRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Post.
-func (c *PostClient) Delete() *PostDelete {
-	mutation := newPostMutation(c.config, OpDelete)
-	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TokenRevocation.
+func (c *TokenRevocationClient) Delete() *TokenRevocationDelete {
+	mutation := newTokenRevocationMutation(c.config, OpDelete)
+	return &TokenRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostClient) DeleteOne(po *Post) *PostDeleteOne {
-	return c.DeleteOneID(po.ID)
+func (c *TokenRevocationClient) DeleteOne(tr *TokenRevocation) *TokenRevocationDeleteOne {
+	return c.DeleteOneID(tr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostClient) DeleteOneID(id uuid.UUID) *PostDeleteOne {
-	builder := c.Delete().Where(post.ID(id))
+func (c *TokenRevocationClient) DeleteOneID(id string) *TokenRevocationDeleteOne {
+	builder := c.Delete().Where(tokenrevocation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostDeleteOne{builder}
+	return &TokenRevocationDeleteOne{builder}
 }
 
-// Query returns a query builder for Post.
-func (c *PostClient) Query() *PostQuery {
-	return &PostQuery{
+// Query returns a query builder for TokenRevocation.
+func (c *TokenRevocationClient) Query() *TokenRevocationQuery {
+	return &TokenRevocationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePost},
+		ctx:    &QueryContext{Type: TypeTokenRevocation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Post entity by its id.
-func (c *PostClient) Get(ctx context.Context, id uuid.UUID) (*Post, error) {
-	return c.Query().Where(post.ID(id)).Only(ctx)
+// Get returns a TokenRevocation entity by its id.
+func (c *TokenRevocationClient) Get(ctx context.Context, id string) (*TokenRevocation, error) {
+	return c.Query().Where(tokenrevocation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostClient) GetX(ctx context.Context, id uuid.UUID) *Post {
+func (c *TokenRevocationClient) GetX(ctx context.Context, id string) *TokenRevocation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
-		panic(err)
-	}
-	return obj
-}
-
-// QueryUser queries the user edge of a Post.
-func (c *PostClient) QueryUser(po *Post) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, post.UserTable, post.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryComments queries the comments edge of a Post.
-func (c *PostClient) QueryComments(po *Post) *CommentQuery {
-	query := (&CommentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.CommentsTable, post.CommentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLikes queries the likes edge of a Post.
-func (c *PostClient) QueryLikes(po *Post) *LikeQuery {
-	query := (&LikeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(like.Table, like.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.LikesTable, post.LikesColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDailyTask queries the daily_task edge of a Post.
-func (c *PostClient) QueryDailyTask(po *Post) *DailyTaskQuery {
-	query := (&DailyTaskClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(dailytask.Table, dailytask.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, post.DailyTaskTable, post.DailyTaskColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *PostClient) Hooks() []Hook {
-	return c.hooks.Post
+func (c *TokenRevocationClient) Hooks() []Hook {
+	return c.hooks.TokenRevocation
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostClient) Interceptors() []Interceptor {
-	return c.inters.Post
+func (c *TokenRevocationClient) Interceptors() []Interceptor {
+	return c.inters.TokenRevocation
 }
 
-func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+func (c *TokenRevocationClient) mutate(ctx context.Context, m *TokenRevocationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TokenRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TokenRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TokenRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TokenRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TokenRevocation mutation op: %q", m.Op())
 	}
 }
 
@@ -1860,6 +4737,246 @@ func (c *UserClient) QueryDeviceTokens(u *User) *DeviceTokenQuery {
 	return query
 }
 
+// QueryReportsFiled queries the reports_filed edge of a User.
+func (c *UserClient) QueryReportsFiled(u *User) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportsFiledTable, user.ReportsFiledColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReportsReceived queries the reports_received edge of a User.
+func (c *UserClient) QueryReportsReceived(u *User) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportsReceivedTable, user.ReportsReceivedColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReportsResolved queries the reports_resolved edge of a User.
+func (c *UserClient) QueryReportsResolved(u *User) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportsResolvedTable, user.ReportsResolvedColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySuspensions queries the suspensions edge of a User.
+func (c *UserClient) QuerySuspensions(u *User) *SuspensionQuery {
+	query := (&SuspensionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(suspension.Table, suspension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SuspensionsTable, user.SuspensionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySuspensionsIssued queries the suspensions_issued edge of a User.
+func (c *UserClient) QuerySuspensionsIssued(u *User) *SuspensionQuery {
+	query := (&SuspensionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(suspension.Table, suspension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SuspensionsIssuedTable, user.SuspensionsIssuedColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMuting queries the muting edge of a User.
+func (c *UserClient) QueryMuting(u *User) *MuteRelationQuery {
+	query := (&MuteRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(muterelation.Table, muterelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MutingTable, user.MutingColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMutedBy queries the muted_by edge of a User.
+func (c *UserClient) QueryMutedBy(u *User) *MuteRelationQuery {
+	query := (&MuteRelationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(muterelation.Table, muterelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MutedByTable, user.MutedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMutedKeywords queries the muted_keywords edge of a User.
+func (c *UserClient) QueryMutedKeywords(u *User) *MutedKeywordQuery {
+	query := (&MutedKeywordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(mutedkeyword.Table, mutedkeyword.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MutedKeywordsTable, user.MutedKeywordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFollowRequestsSent queries the follow_requests_sent edge of a User.
+func (c *UserClient) QueryFollowRequestsSent(u *User) *FollowRequestQuery {
+	query := (&FollowRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(followrequest.Table, followrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FollowRequestsSentTable, user.FollowRequestsSentColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFollowRequestsReceived queries the follow_requests_received edge of a User.
+func (c *UserClient) QueryFollowRequestsReceived(u *User) *FollowRequestQuery {
+	query := (&FollowRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(followrequest.Table, followrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FollowRequestsReceivedTable, user.FollowRequestsReceivedColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentionedInPosts queries the mentioned_in_posts edge of a User.
+func (c *UserClient) QueryMentionedInPosts(u *User) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.MentionedInPostsTable, user.MentionedInPostsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentionedInComments queries the mentioned_in_comments edge of a User.
+func (c *UserClient) QueryMentionedInComments(u *User) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.MentionedInCommentsTable, user.MentionedInCommentsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotifications queries the notifications edge of a User.
+func (c *UserClient) QueryNotifications(u *User) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationsSent queries the notifications_sent edge of a User.
+func (c *UserClient) QueryNotificationsSent(u *User) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsSentTable, user.NotificationsSentColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCommentLikes queries the comment_likes edge of a User.
+func (c *UserClient) QueryCommentLikes(u *User) *CommentLikeQuery {
+	query := (&CommentLikeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(commentlike.Table, commentlike.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CommentLikesTable, user.CommentLikesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1885,14 +5002,153 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// VerificationCodeClient is a client for the VerificationCode schema.
+type VerificationCodeClient struct {
+	config
+}
+
+// NewVerificationCodeClient returns a client for the VerificationCode from the given config.
+func NewVerificationCodeClient(c config) *VerificationCodeClient {
+	return &VerificationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `verificationcode.Hooks(f(g(h())))`.
+func (c *VerificationCodeClient) Use(hooks ...Hook) {
+	c.hooks.VerificationCode = append(c.hooks.VerificationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `verificationcode.Intercept(f(g(h())))`.
+func (c *VerificationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VerificationCode = append(c.inters.VerificationCode, interceptors...)
+}
+
+// Create returns a builder for creating a VerificationCode entity.
+func (c *VerificationCodeClient) Create() *VerificationCodeCreate {
+	mutation := newVerificationCodeMutation(c.config, OpCreate)
+	return &VerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VerificationCode entities.
+func (c *VerificationCodeClient) CreateBulk(builders ...*VerificationCodeCreate) *VerificationCodeCreateBulk {
+	return &VerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VerificationCodeClient) MapCreateBulk(slice any, setFunc func(*VerificationCodeCreate, int)) *VerificationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VerificationCodeCreateBulk{err: fmt.Errorf("calling to VerificationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VerificationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VerificationCode.
+func (c *VerificationCodeClient) Update() *VerificationCodeUpdate {
+	mutation := newVerificationCodeMutation(c.config, OpUpdate)
+	return &VerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VerificationCodeClient) UpdateOne(vc *VerificationCode) *VerificationCodeUpdateOne {
+	mutation := newVerificationCodeMutation(c.config, OpUpdateOne, withVerificationCode(vc))
+	return &VerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VerificationCodeClient) UpdateOneID(id uuid.UUID) *VerificationCodeUpdateOne {
+	mutation := newVerificationCodeMutation(c.config, OpUpdateOne, withVerificationCodeID(id))
+	return &VerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VerificationCode.
+func (c *VerificationCodeClient) Delete() *VerificationCodeDelete {
+	mutation := newVerificationCodeMutation(c.config, OpDelete)
+	return &VerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VerificationCodeClient) DeleteOne(vc *VerificationCode) *VerificationCodeDeleteOne {
+	return c.DeleteOneID(vc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VerificationCodeClient) DeleteOneID(id uuid.UUID) *VerificationCodeDeleteOne {
+	builder := c.Delete().Where(verificationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VerificationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for VerificationCode.
+func (c *VerificationCodeClient) Query() *VerificationCodeQuery {
+	return &VerificationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVerificationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VerificationCode entity by its id.
+func (c *VerificationCodeClient) Get(ctx context.Context, id uuid.UUID) (*VerificationCode, error) {
+	return c.Query().Where(verificationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VerificationCodeClient) GetX(ctx context.Context, id uuid.UUID) *VerificationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VerificationCodeClient) Hooks() []Hook {
+	return c.hooks.VerificationCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *VerificationCodeClient) Interceptors() []Interceptor {
+	return c.inters.VerificationCode
+}
+
+func (c *VerificationCodeClient) mutate(ctx context.Context, m *VerificationCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VerificationCode mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		BlockRelation, Comment, DailyTask, DeviceToken, FollowRelation, Like, Pet, Post,
-		User []ent.Hook
+		BlockRelation, Comment, CommentLike, Credential, DailyTask, DataMigration,
+		DeviceToken, ExploreRanking, FollowRelation, FollowRequest, Like, MuteRelation,
+		MutedKeyword, Notification, Pet, Post, PostMedia, PostRevision, PostTag,
+		Report, Suspension, Tag, TimelineCache, TokenRevocation, User,
+		VerificationCode []ent.Hook
 	}
 	inters struct {
-		BlockRelation, Comment, DailyTask, DeviceToken, FollowRelation, Like, Pet, Post,
-		User []ent.Interceptor
+		BlockRelation, Comment, CommentLike, Credential, DailyTask, DataMigration,
+		DeviceToken, ExploreRanking, FollowRelation, FollowRequest, Like, MuteRelation,
+		MutedKeyword, Notification, Pet, Post, PostMedia, PostRevision, PostTag,
+		Report, Suspension, Tag, TimelineCache, TokenRevocation, User,
+		VerificationCode []ent.Interceptor
 	}
 )
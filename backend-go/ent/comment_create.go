@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -32,6 +35,20 @@ func (cc *CommentCreate) SetContent(s string) *CommentCreate {
 	return cc
 }
 
+// SetParentID sets the "parent_id" field.
+func (cc *CommentCreate) SetParentID(u uuid.UUID) *CommentCreate {
+	cc.mutation.SetParentID(u)
+	return cc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableParentID(u *uuid.UUID) *CommentCreate {
+	if u != nil {
+		cc.SetParentID(*u)
+	}
+	return cc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cc *CommentCreate) SetCreatedAt(t time.Time) *CommentCreate {
 	cc.mutation.SetCreatedAt(t)
@@ -46,6 +63,48 @@ func (cc *CommentCreate) SetNillableCreatedAt(t *time.Time) *CommentCreate {
 	return cc
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (cc *CommentCreate) SetEditedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetEditedAt(t)
+	return cc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEditedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetEditedAt(*t)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CommentCreate) SetDeletedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableDeletedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (cc *CommentCreate) SetHiddenAt(t time.Time) *CommentCreate {
+	cc.mutation.SetHiddenAt(t)
+	return cc
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableHiddenAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetHiddenAt(*t)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CommentCreate) SetID(u uuid.UUID) *CommentCreate {
 	cc.mutation.SetID(u)
@@ -82,6 +141,86 @@ func (cc *CommentCreate) SetUser(u *User) *CommentCreate {
 	return cc.SetUserID(u.ID)
 }
 
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (cc *CommentCreate) AddReportIDs(ids ...uuid.UUID) *CommentCreate {
+	cc.mutation.AddReportIDs(ids...)
+	return cc
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (cc *CommentCreate) AddReports(r ...*Report) *CommentCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return cc.AddReportIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (cc *CommentCreate) AddMentionIDs(ids ...uuid.UUID) *CommentCreate {
+	cc.mutation.AddMentionIDs(ids...)
+	return cc
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (cc *CommentCreate) AddMentions(u ...*User) *CommentCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return cc.AddMentionIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (cc *CommentCreate) AddNotificationIDs(ids ...uuid.UUID) *CommentCreate {
+	cc.mutation.AddNotificationIDs(ids...)
+	return cc
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (cc *CommentCreate) AddNotifications(n ...*Notification) *CommentCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return cc.AddNotificationIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cc *CommentCreate) SetParent(c *Comment) *CommentCreate {
+	return cc.SetParentID(c.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (cc *CommentCreate) AddReplyIDs(ids ...uuid.UUID) *CommentCreate {
+	cc.mutation.AddReplyIDs(ids...)
+	return cc
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (cc *CommentCreate) AddReplies(c ...*Comment) *CommentCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddReplyIDs(ids...)
+}
+
+// AddLikeIDs adds the "likes" edge to the CommentLike entity by IDs.
+func (cc *CommentCreate) AddLikeIDs(ids ...uuid.UUID) *CommentCreate {
+	cc.mutation.AddLikeIDs(ids...)
+	return cc
+}
+
+// AddLikes adds the "likes" edges to the CommentLike entity.
+func (cc *CommentCreate) AddLikes(c ...*CommentLike) *CommentCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddLikeIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (cc *CommentCreate) Mutation() *CommentMutation {
 	return cc.mutation
@@ -190,6 +329,18 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := cc.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := cc.mutation.HiddenAt(); ok {
+		_spec.SetField(comment.FieldHiddenAt, field.TypeTime, value)
+		_node.HiddenAt = &value
+	}
 	if nodes := cc.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -224,6 +375,103 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_node.user_comments = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReportsTable,
+			Columns: []string{comment.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   comment.MentionsTable,
+			Columns: comment.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.NotificationsTable,
+			Columns: []string{comment.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.LikesTable,
+			Columns: []string{comment.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -288,6 +536,24 @@ func (u *CommentUpsert) UpdateContent() *CommentUpsert {
 	return u
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsert) SetParentID(v uuid.UUID) *CommentUpsert {
+	u.Set(comment.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateParentID() *CommentUpsert {
+	u.SetExcluded(comment.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsert) ClearParentID() *CommentUpsert {
+	u.SetNull(comment.FieldParentID)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *CommentUpsert) SetCreatedAt(v time.Time) *CommentUpsert {
 	u.Set(comment.FieldCreatedAt, v)
@@ -300,6 +566,60 @@ func (u *CommentUpsert) UpdateCreatedAt() *CommentUpsert {
 	return u
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentUpsert) SetEditedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateEditedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentUpsert) ClearEditedAt() *CommentUpsert {
+	u.SetNull(comment.FieldEditedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsert) SetDeletedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateDeletedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsert) ClearDeletedAt() *CommentUpsert {
+	u.SetNull(comment.FieldDeletedAt)
+	return u
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (u *CommentUpsert) SetHiddenAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldHiddenAt, v)
+	return u
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateHiddenAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldHiddenAt)
+	return u
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *CommentUpsert) ClearHiddenAt() *CommentUpsert {
+	u.SetNull(comment.FieldHiddenAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -362,6 +682,27 @@ func (u *CommentUpsertOne) UpdateContent() *CommentUpsertOne {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsertOne) SetParentID(v uuid.UUID) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateParentID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsertOne) ClearParentID() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *CommentUpsertOne) SetCreatedAt(v time.Time) *CommentUpsertOne {
 	return u.Update(func(s *CommentUpsert) {
@@ -376,6 +717,69 @@ func (u *CommentUpsertOne) UpdateCreatedAt() *CommentUpsertOne {
 	})
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentUpsertOne) SetEditedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateEditedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentUpsertOne) ClearEditedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsertOne) SetDeletedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateDeletedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsertOne) ClearDeletedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (u *CommentUpsertOne) SetHiddenAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateHiddenAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *CommentUpsertOne) ClearHiddenAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
 // Exec executes the query.
 func (u *CommentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -605,6 +1009,27 @@ func (u *CommentUpsertBulk) UpdateContent() *CommentUpsertBulk {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *CommentUpsertBulk) SetParentID(v uuid.UUID) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateParentID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *CommentUpsertBulk) ClearParentID() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearParentID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *CommentUpsertBulk) SetCreatedAt(v time.Time) *CommentUpsertBulk {
 	return u.Update(func(s *CommentUpsert) {
@@ -619,6 +1044,69 @@ func (u *CommentUpsertBulk) UpdateCreatedAt() *CommentUpsertBulk {
 	})
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentUpsertBulk) SetEditedAt(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateEditedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentUpsertBulk) ClearEditedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CommentUpsertBulk) SetDeletedAt(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateDeletedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CommentUpsertBulk) ClearDeletedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (u *CommentUpsertBulk) SetHiddenAt(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateHiddenAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *CommentUpsertBulk) ClearHiddenAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
 // Exec executes the query.
 func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
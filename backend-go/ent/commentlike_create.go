@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// CommentLikeCreate is the builder for creating a CommentLike entity.
+type CommentLikeCreate struct {
+	config
+	mutation *CommentLikeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCommentID sets the "comment_id" field.
+func (clc *CommentLikeCreate) SetCommentID(u uuid.UUID) *CommentLikeCreate {
+	clc.mutation.SetCommentID(u)
+	return clc
+}
+
+// SetUserID sets the "user_id" field.
+func (clc *CommentLikeCreate) SetUserID(u uuid.UUID) *CommentLikeCreate {
+	clc.mutation.SetUserID(u)
+	return clc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (clc *CommentLikeCreate) SetCreatedAt(t time.Time) *CommentLikeCreate {
+	clc.mutation.SetCreatedAt(t)
+	return clc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (clc *CommentLikeCreate) SetNillableCreatedAt(t *time.Time) *CommentLikeCreate {
+	if t != nil {
+		clc.SetCreatedAt(*t)
+	}
+	return clc
+}
+
+// SetID sets the "id" field.
+func (clc *CommentLikeCreate) SetID(u uuid.UUID) *CommentLikeCreate {
+	clc.mutation.SetID(u)
+	return clc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (clc *CommentLikeCreate) SetNillableID(u *uuid.UUID) *CommentLikeCreate {
+	if u != nil {
+		clc.SetID(*u)
+	}
+	return clc
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (clc *CommentLikeCreate) SetComment(c *Comment) *CommentLikeCreate {
+	return clc.SetCommentID(c.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (clc *CommentLikeCreate) SetUser(u *User) *CommentLikeCreate {
+	return clc.SetUserID(u.ID)
+}
+
+// Mutation returns the CommentLikeMutation object of the builder.
+func (clc *CommentLikeCreate) Mutation() *CommentLikeMutation {
+	return clc.mutation
+}
+
+// Save creates the CommentLike in the database.
+func (clc *CommentLikeCreate) Save(ctx context.Context) (*CommentLike, error) {
+	clc.defaults()
+	return withHooks(ctx, clc.sqlSave, clc.mutation, clc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (clc *CommentLikeCreate) SaveX(ctx context.Context) *CommentLike {
+	v, err := clc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (clc *CommentLikeCreate) Exec(ctx context.Context) error {
+	_, err := clc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clc *CommentLikeCreate) ExecX(ctx context.Context) {
+	if err := clc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (clc *CommentLikeCreate) defaults() {
+	if _, ok := clc.mutation.CreatedAt(); !ok {
+		v := commentlike.DefaultCreatedAt()
+		clc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := clc.mutation.ID(); !ok {
+		v := commentlike.DefaultID()
+		clc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (clc *CommentLikeCreate) check() error {
+	if _, ok := clc.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment_id", err: errors.New(`ent: missing required field "CommentLike.comment_id"`)}
+	}
+	if _, ok := clc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CommentLike.user_id"`)}
+	}
+	if _, ok := clc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommentLike.created_at"`)}
+	}
+	if len(clc.mutation.CommentIDs()) == 0 {
+		return &ValidationError{Name: "comment", err: errors.New(`ent: missing required edge "CommentLike.comment"`)}
+	}
+	if len(clc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "CommentLike.user"`)}
+	}
+	return nil
+}
+
+func (clc *CommentLikeCreate) sqlSave(ctx context.Context) (*CommentLike, error) {
+	if err := clc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := clc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, clc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	clc.mutation.id = &_node.ID
+	clc.mutation.done = true
+	return _node, nil
+}
+
+func (clc *CommentLikeCreate) createSpec() (*CommentLike, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommentLike{config: clc.config}
+		_spec = sqlgraph.NewCreateSpec(commentlike.Table, sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = clc.conflict
+	if id, ok := clc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := clc.mutation.CreatedAt(); ok {
+		_spec.SetField(commentlike.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := clc.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentlike.CommentTable,
+			Columns: []string{commentlike.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CommentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := clc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentlike.UserTable,
+			Columns: []string{commentlike.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommentLike.Create().
+//		SetCommentID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentLikeUpsert) {
+//			SetCommentID(v+v).
+//		}).
+//		Exec(ctx)
+func (clc *CommentLikeCreate) OnConflict(opts ...sql.ConflictOption) *CommentLikeUpsertOne {
+	clc.conflict = opts
+	return &CommentLikeUpsertOne{
+		create: clc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommentLike.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (clc *CommentLikeCreate) OnConflictColumns(columns ...string) *CommentLikeUpsertOne {
+	clc.conflict = append(clc.conflict, sql.ConflictColumns(columns...))
+	return &CommentLikeUpsertOne{
+		create: clc,
+	}
+}
+
+type (
+	// CommentLikeUpsertOne is the builder for "upsert"-ing
+	//  one CommentLike node.
+	CommentLikeUpsertOne struct {
+		create *CommentLikeCreate
+	}
+
+	// CommentLikeUpsert is the "OnConflict" setter.
+	CommentLikeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCommentID sets the "comment_id" field.
+func (u *CommentLikeUpsert) SetCommentID(v uuid.UUID) *CommentLikeUpsert {
+	u.Set(commentlike.FieldCommentID, v)
+	return u
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *CommentLikeUpsert) UpdateCommentID() *CommentLikeUpsert {
+	u.SetExcluded(commentlike.FieldCommentID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentLikeUpsert) SetUserID(v uuid.UUID) *CommentLikeUpsert {
+	u.Set(commentlike.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentLikeUpsert) UpdateUserID() *CommentLikeUpsert {
+	u.SetExcluded(commentlike.FieldUserID)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentLikeUpsert) SetCreatedAt(v time.Time) *CommentLikeUpsert {
+	u.Set(commentlike.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentLikeUpsert) UpdateCreatedAt() *CommentLikeUpsert {
+	u.SetExcluded(commentlike.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CommentLike.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(commentlike.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommentLikeUpsertOne) UpdateNewValues() *CommentLikeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(commentlike.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommentLike.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommentLikeUpsertOne) Ignore() *CommentLikeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentLikeUpsertOne) DoNothing() *CommentLikeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentLikeCreate.OnConflict
+// documentation for more info.
+func (u *CommentLikeUpsertOne) Update(set func(*CommentLikeUpsert)) *CommentLikeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentLikeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *CommentLikeUpsertOne) SetCommentID(v uuid.UUID) *CommentLikeUpsertOne {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *CommentLikeUpsertOne) UpdateCommentID() *CommentLikeUpsertOne {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentLikeUpsertOne) SetUserID(v uuid.UUID) *CommentLikeUpsertOne {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentLikeUpsertOne) UpdateUserID() *CommentLikeUpsertOne {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentLikeUpsertOne) SetCreatedAt(v time.Time) *CommentLikeUpsertOne {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentLikeUpsertOne) UpdateCreatedAt() *CommentLikeUpsertOne {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentLikeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentLikeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentLikeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommentLikeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CommentLikeUpsertOne.ID is not supported by MySQL driver. Use CommentLikeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommentLikeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CommentLikeCreateBulk is the builder for creating many CommentLike entities in bulk.
+type CommentLikeCreateBulk struct {
+	config
+	err      error
+	builders []*CommentLikeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CommentLike entities in the database.
+func (clcb *CommentLikeCreateBulk) Save(ctx context.Context) ([]*CommentLike, error) {
+	if clcb.err != nil {
+		return nil, clcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(clcb.builders))
+	nodes := make([]*CommentLike, len(clcb.builders))
+	mutators := make([]Mutator, len(clcb.builders))
+	for i := range clcb.builders {
+		func(i int, root context.Context) {
+			builder := clcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentLikeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, clcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = clcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, clcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, clcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (clcb *CommentLikeCreateBulk) SaveX(ctx context.Context) []*CommentLike {
+	v, err := clcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (clcb *CommentLikeCreateBulk) Exec(ctx context.Context) error {
+	_, err := clcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (clcb *CommentLikeCreateBulk) ExecX(ctx context.Context) {
+	if err := clcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommentLike.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentLikeUpsert) {
+//			SetCommentID(v+v).
+//		}).
+//		Exec(ctx)
+func (clcb *CommentLikeCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommentLikeUpsertBulk {
+	clcb.conflict = opts
+	return &CommentLikeUpsertBulk{
+		create: clcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommentLike.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (clcb *CommentLikeCreateBulk) OnConflictColumns(columns ...string) *CommentLikeUpsertBulk {
+	clcb.conflict = append(clcb.conflict, sql.ConflictColumns(columns...))
+	return &CommentLikeUpsertBulk{
+		create: clcb,
+	}
+}
+
+// CommentLikeUpsertBulk is the builder for "upsert"-ing
+// a bulk of CommentLike nodes.
+type CommentLikeUpsertBulk struct {
+	create *CommentLikeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CommentLike.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(commentlike.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommentLikeUpsertBulk) UpdateNewValues() *CommentLikeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(commentlike.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommentLike.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommentLikeUpsertBulk) Ignore() *CommentLikeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentLikeUpsertBulk) DoNothing() *CommentLikeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentLikeCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommentLikeUpsertBulk) Update(set func(*CommentLikeUpsert)) *CommentLikeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentLikeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *CommentLikeUpsertBulk) SetCommentID(v uuid.UUID) *CommentLikeUpsertBulk {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *CommentLikeUpsertBulk) UpdateCommentID() *CommentLikeUpsertBulk {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommentLikeUpsertBulk) SetUserID(v uuid.UUID) *CommentLikeUpsertBulk {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommentLikeUpsertBulk) UpdateUserID() *CommentLikeUpsertBulk {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CommentLikeUpsertBulk) SetCreatedAt(v time.Time) *CommentLikeUpsertBulk {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CommentLikeUpsertBulk) UpdateCreatedAt() *CommentLikeUpsertBulk {
+	return u.Update(func(s *CommentLikeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentLikeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommentLikeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentLikeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentLikeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
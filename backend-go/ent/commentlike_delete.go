@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// CommentLikeDelete is the builder for deleting a CommentLike entity.
+type CommentLikeDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommentLikeMutation
+}
+
+// Where appends a list predicates to the CommentLikeDelete builder.
+func (cld *CommentLikeDelete) Where(ps ...predicate.CommentLike) *CommentLikeDelete {
+	cld.mutation.Where(ps...)
+	return cld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cld *CommentLikeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cld.sqlExec, cld.mutation, cld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cld *CommentLikeDelete) ExecX(ctx context.Context) int {
+	n, err := cld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cld *CommentLikeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commentlike.Table, sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID))
+	if ps := cld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cld.mutation.done = true
+	return affected, err
+}
+
+// CommentLikeDeleteOne is the builder for deleting a single CommentLike entity.
+type CommentLikeDeleteOne struct {
+	cld *CommentLikeDelete
+}
+
+// Where appends a list predicates to the CommentLikeDelete builder.
+func (cldo *CommentLikeDeleteOne) Where(ps ...predicate.CommentLike) *CommentLikeDeleteOne {
+	cldo.cld.mutation.Where(ps...)
+	return cldo
+}
+
+// Exec executes the deletion query.
+func (cldo *CommentLikeDeleteOne) Exec(ctx context.Context) error {
+	n, err := cldo.cld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commentlike.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cldo *CommentLikeDeleteOne) ExecX(ctx context.Context) {
+	if err := cldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
+)
+
+// DataMigration is the model entity for the DataMigration schema.
+type DataMigration struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AppliedAt holds the value of the "applied_at" field.
+	AppliedAt    time.Time `json:"applied_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataMigration) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case datamigration.FieldID:
+			values[i] = new(sql.NullString)
+		case datamigration.FieldAppliedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataMigration fields.
+func (dm *DataMigration) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case datamigration.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				dm.ID = value.String
+			}
+		case datamigration.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				dm.AppliedAt = value.Time
+			}
+		default:
+			dm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataMigration.
+// This includes values selected through modifiers, order, etc.
+func (dm *DataMigration) Value(name string) (ent.Value, error) {
+	return dm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DataMigration.
+// Note that you need to call DataMigration.Unwrap() before calling this method if this DataMigration
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dm *DataMigration) Update() *DataMigrationUpdateOne {
+	return NewDataMigrationClient(dm.config).UpdateOne(dm)
+}
+
+// Unwrap unwraps the DataMigration entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dm *DataMigration) Unwrap() *DataMigration {
+	_tx, ok := dm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataMigration is not a transactional entity")
+	}
+	dm.config.driver = _tx.drv
+	return dm
+}
+
+// String implements the fmt.Stringer.
+func (dm *DataMigration) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataMigration(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dm.ID))
+	builder.WriteString("applied_at=")
+	builder.WriteString(dm.AppliedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataMigrations is a parsable slice of DataMigration.
+type DataMigrations []*DataMigration
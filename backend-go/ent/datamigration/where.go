@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package datamigration
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldContainsFold(FieldID, id))
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldNotIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGT(FieldAppliedAt, v))
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldGTE(FieldAppliedAt, v))
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLT(FieldAppliedAt, v))
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.DataMigration {
+	return predicate.DataMigration(sql.FieldLTE(FieldAppliedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DataMigration) predicate.DataMigration {
+	return predicate.DataMigration(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DataMigration) predicate.DataMigration {
+	return predicate.DataMigration(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DataMigration) predicate.DataMigration {
+	return predicate.DataMigration(sql.NotPredicates(p))
+}
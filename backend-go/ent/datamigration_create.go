@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
+)
+
+// DataMigrationCreate is the builder for creating a DataMigration entity.
+type DataMigrationCreate struct {
+	config
+	mutation *DataMigrationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (dmc *DataMigrationCreate) SetAppliedAt(t time.Time) *DataMigrationCreate {
+	dmc.mutation.SetAppliedAt(t)
+	return dmc
+}
+
+// SetID sets the "id" field.
+func (dmc *DataMigrationCreate) SetID(s string) *DataMigrationCreate {
+	dmc.mutation.SetID(s)
+	return dmc
+}
+
+// Mutation returns the DataMigrationMutation object of the builder.
+func (dmc *DataMigrationCreate) Mutation() *DataMigrationMutation {
+	return dmc.mutation
+}
+
+// Save creates the DataMigration in the database.
+func (dmc *DataMigrationCreate) Save(ctx context.Context) (*DataMigration, error) {
+	return withHooks(ctx, dmc.sqlSave, dmc.mutation, dmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dmc *DataMigrationCreate) SaveX(ctx context.Context) *DataMigration {
+	v, err := dmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dmc *DataMigrationCreate) Exec(ctx context.Context) error {
+	_, err := dmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmc *DataMigrationCreate) ExecX(ctx context.Context) {
+	if err := dmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dmc *DataMigrationCreate) check() error {
+	if _, ok := dmc.mutation.AppliedAt(); !ok {
+		return &ValidationError{Name: "applied_at", err: errors.New(`ent: missing required field "DataMigration.applied_at"`)}
+	}
+	if v, ok := dmc.mutation.ID(); ok {
+		if err := datamigration.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DataMigration.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dmc *DataMigrationCreate) sqlSave(ctx context.Context) (*DataMigration, error) {
+	if err := dmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DataMigration.ID type: %T", _spec.ID.Value)
+		}
+	}
+	dmc.mutation.id = &_node.ID
+	dmc.mutation.done = true
+	return _node, nil
+}
+
+func (dmc *DataMigrationCreate) createSpec() (*DataMigration, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataMigration{config: dmc.config}
+		_spec = sqlgraph.NewCreateSpec(datamigration.Table, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = dmc.conflict
+	if id, ok := dmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dmc.mutation.AppliedAt(); ok {
+		_spec.SetField(datamigration.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataMigration.Create().
+//		SetAppliedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataMigrationUpsert) {
+//			SetAppliedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dmc *DataMigrationCreate) OnConflict(opts ...sql.ConflictOption) *DataMigrationUpsertOne {
+	dmc.conflict = opts
+	return &DataMigrationUpsertOne{
+		create: dmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataMigration.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dmc *DataMigrationCreate) OnConflictColumns(columns ...string) *DataMigrationUpsertOne {
+	dmc.conflict = append(dmc.conflict, sql.ConflictColumns(columns...))
+	return &DataMigrationUpsertOne{
+		create: dmc,
+	}
+}
+
+type (
+	// DataMigrationUpsertOne is the builder for "upsert"-ing
+	//  one DataMigration node.
+	DataMigrationUpsertOne struct {
+		create *DataMigrationCreate
+	}
+
+	// DataMigrationUpsert is the "OnConflict" setter.
+	DataMigrationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *DataMigrationUpsert) SetAppliedAt(v time.Time) *DataMigrationUpsert {
+	u.Set(datamigration.FieldAppliedAt, v)
+	return u
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *DataMigrationUpsert) UpdateAppliedAt() *DataMigrationUpsert {
+	u.SetExcluded(datamigration.FieldAppliedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DataMigration.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(datamigration.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataMigrationUpsertOne) UpdateNewValues() *DataMigrationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(datamigration.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataMigration.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DataMigrationUpsertOne) Ignore() *DataMigrationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataMigrationUpsertOne) DoNothing() *DataMigrationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataMigrationCreate.OnConflict
+// documentation for more info.
+func (u *DataMigrationUpsertOne) Update(set func(*DataMigrationUpsert)) *DataMigrationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataMigrationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *DataMigrationUpsertOne) SetAppliedAt(v time.Time) *DataMigrationUpsertOne {
+	return u.Update(func(s *DataMigrationUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *DataMigrationUpsertOne) UpdateAppliedAt() *DataMigrationUpsertOne {
+	return u.Update(func(s *DataMigrationUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataMigrationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataMigrationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataMigrationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DataMigrationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DataMigrationUpsertOne.ID is not supported by MySQL driver. Use DataMigrationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DataMigrationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DataMigrationCreateBulk is the builder for creating many DataMigration entities in bulk.
+type DataMigrationCreateBulk struct {
+	config
+	err      error
+	builders []*DataMigrationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DataMigration entities in the database.
+func (dmcb *DataMigrationCreateBulk) Save(ctx context.Context) ([]*DataMigration, error) {
+	if dmcb.err != nil {
+		return nil, dmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dmcb.builders))
+	nodes := make([]*DataMigration, len(dmcb.builders))
+	mutators := make([]Mutator, len(dmcb.builders))
+	for i := range dmcb.builders {
+		func(i int, root context.Context) {
+			builder := dmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataMigrationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dmcb *DataMigrationCreateBulk) SaveX(ctx context.Context) []*DataMigration {
+	v, err := dmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dmcb *DataMigrationCreateBulk) Exec(ctx context.Context) error {
+	_, err := dmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmcb *DataMigrationCreateBulk) ExecX(ctx context.Context) {
+	if err := dmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataMigration.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataMigrationUpsert) {
+//			SetAppliedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dmcb *DataMigrationCreateBulk) OnConflict(opts ...sql.ConflictOption) *DataMigrationUpsertBulk {
+	dmcb.conflict = opts
+	return &DataMigrationUpsertBulk{
+		create: dmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataMigration.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dmcb *DataMigrationCreateBulk) OnConflictColumns(columns ...string) *DataMigrationUpsertBulk {
+	dmcb.conflict = append(dmcb.conflict, sql.ConflictColumns(columns...))
+	return &DataMigrationUpsertBulk{
+		create: dmcb,
+	}
+}
+
+// DataMigrationUpsertBulk is the builder for "upsert"-ing
+// a bulk of DataMigration nodes.
+type DataMigrationUpsertBulk struct {
+	create *DataMigrationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DataMigration.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(datamigration.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataMigrationUpsertBulk) UpdateNewValues() *DataMigrationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(datamigration.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataMigration.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DataMigrationUpsertBulk) Ignore() *DataMigrationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataMigrationUpsertBulk) DoNothing() *DataMigrationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataMigrationCreateBulk.OnConflict
+// documentation for more info.
+func (u *DataMigrationUpsertBulk) Update(set func(*DataMigrationUpsert)) *DataMigrationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataMigrationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *DataMigrationUpsertBulk) SetAppliedAt(v time.Time) *DataMigrationUpsertBulk {
+	return u.Update(func(s *DataMigrationUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *DataMigrationUpsertBulk) UpdateAppliedAt() *DataMigrationUpsertBulk {
+	return u.Update(func(s *DataMigrationUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataMigrationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DataMigrationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataMigrationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataMigrationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
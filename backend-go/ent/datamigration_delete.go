@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// DataMigrationDelete is the builder for deleting a DataMigration entity.
+type DataMigrationDelete struct {
+	config
+	hooks    []Hook
+	mutation *DataMigrationMutation
+}
+
+// Where appends a list predicates to the DataMigrationDelete builder.
+func (dmd *DataMigrationDelete) Where(ps ...predicate.DataMigration) *DataMigrationDelete {
+	dmd.mutation.Where(ps...)
+	return dmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dmd *DataMigrationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dmd.sqlExec, dmd.mutation, dmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmd *DataMigrationDelete) ExecX(ctx context.Context) int {
+	n, err := dmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dmd *DataMigrationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(datamigration.Table, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeString))
+	if ps := dmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dmd.mutation.done = true
+	return affected, err
+}
+
+// DataMigrationDeleteOne is the builder for deleting a single DataMigration entity.
+type DataMigrationDeleteOne struct {
+	dmd *DataMigrationDelete
+}
+
+// Where appends a list predicates to the DataMigrationDelete builder.
+func (dmdo *DataMigrationDeleteOne) Where(ps ...predicate.DataMigration) *DataMigrationDeleteOne {
+	dmdo.dmd.mutation.Where(ps...)
+	return dmdo
+}
+
+// Exec executes the deletion query.
+func (dmdo *DataMigrationDeleteOne) Exec(ctx context.Context) error {
+	n, err := dmdo.dmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{datamigration.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmdo *DataMigrationDeleteOne) ExecX(ctx context.Context) {
+	if err := dmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// DataMigrationUpdate is the builder for updating DataMigration entities.
+type DataMigrationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DataMigrationMutation
+}
+
+// Where appends a list predicates to the DataMigrationUpdate builder.
+func (dmu *DataMigrationUpdate) Where(ps ...predicate.DataMigration) *DataMigrationUpdate {
+	dmu.mutation.Where(ps...)
+	return dmu
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (dmu *DataMigrationUpdate) SetAppliedAt(t time.Time) *DataMigrationUpdate {
+	dmu.mutation.SetAppliedAt(t)
+	return dmu
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (dmu *DataMigrationUpdate) SetNillableAppliedAt(t *time.Time) *DataMigrationUpdate {
+	if t != nil {
+		dmu.SetAppliedAt(*t)
+	}
+	return dmu
+}
+
+// Mutation returns the DataMigrationMutation object of the builder.
+func (dmu *DataMigrationUpdate) Mutation() *DataMigrationMutation {
+	return dmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dmu *DataMigrationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dmu.sqlSave, dmu.mutation, dmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dmu *DataMigrationUpdate) SaveX(ctx context.Context) int {
+	affected, err := dmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dmu *DataMigrationUpdate) Exec(ctx context.Context) error {
+	_, err := dmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmu *DataMigrationUpdate) ExecX(ctx context.Context) {
+	if err := dmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (dmu *DataMigrationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(datamigration.Table, datamigration.Columns, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeString))
+	if ps := dmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dmu.mutation.AppliedAt(); ok {
+		_spec.SetField(datamigration.FieldAppliedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datamigration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dmu.mutation.done = true
+	return n, nil
+}
+
+// DataMigrationUpdateOne is the builder for updating a single DataMigration entity.
+type DataMigrationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DataMigrationMutation
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (dmuo *DataMigrationUpdateOne) SetAppliedAt(t time.Time) *DataMigrationUpdateOne {
+	dmuo.mutation.SetAppliedAt(t)
+	return dmuo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (dmuo *DataMigrationUpdateOne) SetNillableAppliedAt(t *time.Time) *DataMigrationUpdateOne {
+	if t != nil {
+		dmuo.SetAppliedAt(*t)
+	}
+	return dmuo
+}
+
+// Mutation returns the DataMigrationMutation object of the builder.
+func (dmuo *DataMigrationUpdateOne) Mutation() *DataMigrationMutation {
+	return dmuo.mutation
+}
+
+// Where appends a list predicates to the DataMigrationUpdate builder.
+func (dmuo *DataMigrationUpdateOne) Where(ps ...predicate.DataMigration) *DataMigrationUpdateOne {
+	dmuo.mutation.Where(ps...)
+	return dmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dmuo *DataMigrationUpdateOne) Select(field string, fields ...string) *DataMigrationUpdateOne {
+	dmuo.fields = append([]string{field}, fields...)
+	return dmuo
+}
+
+// Save executes the query and returns the updated DataMigration entity.
+func (dmuo *DataMigrationUpdateOne) Save(ctx context.Context) (*DataMigration, error) {
+	return withHooks(ctx, dmuo.sqlSave, dmuo.mutation, dmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dmuo *DataMigrationUpdateOne) SaveX(ctx context.Context) *DataMigration {
+	node, err := dmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dmuo *DataMigrationUpdateOne) Exec(ctx context.Context) error {
+	_, err := dmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dmuo *DataMigrationUpdateOne) ExecX(ctx context.Context) {
+	if err := dmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (dmuo *DataMigrationUpdateOne) sqlSave(ctx context.Context) (_node *DataMigration, err error) {
+	_spec := sqlgraph.NewUpdateSpec(datamigration.Table, datamigration.Columns, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeString))
+	id, ok := dmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DataMigration.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, datamigration.FieldID)
+		for _, f := range fields {
+			if !datamigration.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != datamigration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dmuo.mutation.AppliedAt(); ok {
+		_spec.SetField(datamigration.FieldAppliedAt, field.TypeTime, value)
+	}
+	_node = &DataMigration{config: dmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{datamigration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dmuo.mutation.done = true
+	return _node, nil
+}
@@ -14,13 +14,30 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
 	"github.com/aki-13627/animalia/backend-go/ent/devicetoken"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -81,15 +98,32 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			blockrelation.Table:  blockrelation.ValidColumn,
-			comment.Table:        comment.ValidColumn,
-			dailytask.Table:      dailytask.ValidColumn,
-			devicetoken.Table:    devicetoken.ValidColumn,
-			followrelation.Table: followrelation.ValidColumn,
-			like.Table:           like.ValidColumn,
-			pet.Table:            pet.ValidColumn,
-			post.Table:           post.ValidColumn,
-			user.Table:           user.ValidColumn,
+			blockrelation.Table:    blockrelation.ValidColumn,
+			comment.Table:          comment.ValidColumn,
+			commentlike.Table:      commentlike.ValidColumn,
+			credential.Table:       credential.ValidColumn,
+			dailytask.Table:        dailytask.ValidColumn,
+			datamigration.Table:    datamigration.ValidColumn,
+			devicetoken.Table:      devicetoken.ValidColumn,
+			exploreranking.Table:   exploreranking.ValidColumn,
+			followrelation.Table:   followrelation.ValidColumn,
+			followrequest.Table:    followrequest.ValidColumn,
+			like.Table:             like.ValidColumn,
+			muterelation.Table:     muterelation.ValidColumn,
+			mutedkeyword.Table:     mutedkeyword.ValidColumn,
+			notification.Table:     notification.ValidColumn,
+			pet.Table:              pet.ValidColumn,
+			post.Table:             post.ValidColumn,
+			postmedia.Table:        postmedia.ValidColumn,
+			postrevision.Table:     postrevision.ValidColumn,
+			posttag.Table:          posttag.ValidColumn,
+			report.Table:           report.ValidColumn,
+			suspension.Table:       suspension.ValidColumn,
+			tag.Table:              tag.ValidColumn,
+			timelinecache.Table:    timelinecache.ValidColumn,
+			tokenrevocation.Table:  tokenrevocation.ValidColumn,
+			user.Table:             user.ValidColumn,
+			verificationcode.Table: verificationcode.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,17 @@
+package enum
+
+// MediaType is the kind of a post's media.
+type MediaType string
+
+const (
+	MediaTypeImage MediaType = "image"
+	MediaTypeVideo MediaType = "video"
+)
+
+func (MediaType) Values() []string {
+	return []string{string(MediaTypeImage), string(MediaTypeVideo)}
+}
+
+func (t MediaType) Valid() bool {
+	return contains(t.Values(), string(t))
+}
@@ -0,0 +1,17 @@
+package enum
+
+// NotificationType is the kind of event a user is notified of.
+type NotificationType string
+
+const (
+	// NotificationTypeMention is sent when a user is mentioned in a caption or a comment.
+	NotificationTypeMention NotificationType = "mention"
+)
+
+func (NotificationType) Values() []string {
+	return []string{string(NotificationTypeMention)}
+}
+
+func (t NotificationType) Valid() bool {
+	return contains(t.Values(), string(t))
+}
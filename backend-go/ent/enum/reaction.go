@@ -0,0 +1,24 @@
+package enum
+
+// ReactionType is the reaction of a like. Likes made before reactions existed are paws.
+type ReactionType string
+
+const (
+	ReactionTypePaw   ReactionType = "paw"
+	ReactionTypeHeart ReactionType = "heart"
+	ReactionTypeLaugh ReactionType = "laugh"
+	ReactionTypeWow   ReactionType = "wow"
+)
+
+func (ReactionType) Values() []string {
+	return []string{
+		string(ReactionTypePaw),
+		string(ReactionTypeHeart),
+		string(ReactionTypeLaugh),
+		string(ReactionTypeWow),
+	}
+}
+
+func (t ReactionType) Valid() bool {
+	return contains(t.Values(), string(t))
+}
@@ -0,0 +1,71 @@
+package enum
+
+// ReportTargetType is the kind of content a report points at.
+type ReportTargetType string
+
+const (
+	ReportTargetPost    ReportTargetType = "post"
+	ReportTargetComment ReportTargetType = "comment"
+	ReportTargetUser    ReportTargetType = "user"
+)
+
+func (ReportTargetType) Values() []string {
+	return []string{string(ReportTargetPost), string(ReportTargetComment), string(ReportTargetUser)}
+}
+
+func (t ReportTargetType) Valid() bool {
+	return contains(t.Values(), string(t))
+}
+
+// ReportReason is the category chosen by the reporter.
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"
+	ReportReasonHarassment    ReportReason = "harassment"
+	ReportReasonInappropriate ReportReason = "inappropriate"
+	ReportReasonAnimalAbuse   ReportReason = "animal_abuse"
+	ReportReasonImpersonation ReportReason = "impersonation"
+	ReportReasonOther         ReportReason = "other"
+)
+
+func (ReportReason) Values() []string {
+	return []string{
+		string(ReportReasonSpam),
+		string(ReportReasonHarassment),
+		string(ReportReasonInappropriate),
+		string(ReportReasonAnimalAbuse),
+		string(ReportReasonImpersonation),
+		string(ReportReasonOther),
+	}
+}
+
+func (r ReportReason) Valid() bool {
+	return contains(r.Values(), string(r))
+}
+
+// ReportStatus is the review state of a report.
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusActioned  ReportStatus = "actioned"
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+func (ReportStatus) Values() []string {
+	return []string{string(ReportStatusOpen), string(ReportStatusActioned), string(ReportStatusDismissed)}
+}
+
+func (s ReportStatus) Valid() bool {
+	return contains(s.Values(), string(s))
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/google/uuid"
+)
+
+// ExploreRanking is the model entity for the ExploreRanking schema.
+type ExploreRanking struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 1 が最上位
+	Position int `json:"position,omitempty"`
+	// Score holds the value of the "score" field.
+	Score float64 `json:"score,omitempty"`
+	// ComputedAt holds the value of the "computed_at" field.
+	ComputedAt time.Time `json:"computed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExploreRankingQuery when eager-loading is set.
+	Edges                ExploreRankingEdges `json:"edges"`
+	post_explore_ranking *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// ExploreRankingEdges holds the relations/edges for other nodes in the graph.
+type ExploreRankingEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExploreRankingEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExploreRanking) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exploreranking.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case exploreranking.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case exploreranking.FieldComputedAt:
+			values[i] = new(sql.NullTime)
+		case exploreranking.FieldID:
+			values[i] = new(uuid.UUID)
+		case exploreranking.ForeignKeys[0]: // post_explore_ranking
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExploreRanking fields.
+func (er *ExploreRanking) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exploreranking.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				er.ID = *value
+			}
+		case exploreranking.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				er.Position = int(value.Int64)
+			}
+		case exploreranking.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				er.Score = value.Float64
+			}
+		case exploreranking.FieldComputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field computed_at", values[i])
+			} else if value.Valid {
+				er.ComputedAt = value.Time
+			}
+		case exploreranking.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_explore_ranking", values[i])
+			} else if value.Valid {
+				er.post_explore_ranking = new(uuid.UUID)
+				*er.post_explore_ranking = *value.S.(*uuid.UUID)
+			}
+		default:
+			er.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExploreRanking.
+// This includes values selected through modifiers, order, etc.
+func (er *ExploreRanking) Value(name string) (ent.Value, error) {
+	return er.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the ExploreRanking entity.
+func (er *ExploreRanking) QueryPost() *PostQuery {
+	return NewExploreRankingClient(er.config).QueryPost(er)
+}
+
+// Update returns a builder for updating this ExploreRanking.
+// Note that you need to call ExploreRanking.Unwrap() before calling this method if this ExploreRanking
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (er *ExploreRanking) Update() *ExploreRankingUpdateOne {
+	return NewExploreRankingClient(er.config).UpdateOne(er)
+}
+
+// Unwrap unwraps the ExploreRanking entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (er *ExploreRanking) Unwrap() *ExploreRanking {
+	_tx, ok := er.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExploreRanking is not a transactional entity")
+	}
+	er.config.driver = _tx.drv
+	return er
+}
+
+// String implements the fmt.Stringer.
+func (er *ExploreRanking) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExploreRanking(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", er.ID))
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", er.Position))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", er.Score))
+	builder.WriteString(", ")
+	builder.WriteString("computed_at=")
+	builder.WriteString(er.ComputedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExploreRankings is a parsable slice of ExploreRanking.
+type ExploreRankings []*ExploreRanking
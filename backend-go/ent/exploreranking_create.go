@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/google/uuid"
+)
+
+// ExploreRankingCreate is the builder for creating a ExploreRanking entity.
+type ExploreRankingCreate struct {
+	config
+	mutation *ExploreRankingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPosition sets the "position" field.
+func (erc *ExploreRankingCreate) SetPosition(i int) *ExploreRankingCreate {
+	erc.mutation.SetPosition(i)
+	return erc
+}
+
+// SetScore sets the "score" field.
+func (erc *ExploreRankingCreate) SetScore(f float64) *ExploreRankingCreate {
+	erc.mutation.SetScore(f)
+	return erc
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (erc *ExploreRankingCreate) SetComputedAt(t time.Time) *ExploreRankingCreate {
+	erc.mutation.SetComputedAt(t)
+	return erc
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (erc *ExploreRankingCreate) SetNillableComputedAt(t *time.Time) *ExploreRankingCreate {
+	if t != nil {
+		erc.SetComputedAt(*t)
+	}
+	return erc
+}
+
+// SetID sets the "id" field.
+func (erc *ExploreRankingCreate) SetID(u uuid.UUID) *ExploreRankingCreate {
+	erc.mutation.SetID(u)
+	return erc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (erc *ExploreRankingCreate) SetNillableID(u *uuid.UUID) *ExploreRankingCreate {
+	if u != nil {
+		erc.SetID(*u)
+	}
+	return erc
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (erc *ExploreRankingCreate) SetPostID(id uuid.UUID) *ExploreRankingCreate {
+	erc.mutation.SetPostID(id)
+	return erc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (erc *ExploreRankingCreate) SetPost(p *Post) *ExploreRankingCreate {
+	return erc.SetPostID(p.ID)
+}
+
+// Mutation returns the ExploreRankingMutation object of the builder.
+func (erc *ExploreRankingCreate) Mutation() *ExploreRankingMutation {
+	return erc.mutation
+}
+
+// Save creates the ExploreRanking in the database.
+func (erc *ExploreRankingCreate) Save(ctx context.Context) (*ExploreRanking, error) {
+	erc.defaults()
+	return withHooks(ctx, erc.sqlSave, erc.mutation, erc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (erc *ExploreRankingCreate) SaveX(ctx context.Context) *ExploreRanking {
+	v, err := erc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (erc *ExploreRankingCreate) Exec(ctx context.Context) error {
+	_, err := erc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erc *ExploreRankingCreate) ExecX(ctx context.Context) {
+	if err := erc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (erc *ExploreRankingCreate) defaults() {
+	if _, ok := erc.mutation.ComputedAt(); !ok {
+		v := exploreranking.DefaultComputedAt()
+		erc.mutation.SetComputedAt(v)
+	}
+	if _, ok := erc.mutation.ID(); !ok {
+		v := exploreranking.DefaultID()
+		erc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (erc *ExploreRankingCreate) check() error {
+	if _, ok := erc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ExploreRanking.position"`)}
+	}
+	if v, ok := erc.mutation.Position(); ok {
+		if err := exploreranking.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ExploreRanking.position": %w`, err)}
+		}
+	}
+	if _, ok := erc.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "ExploreRanking.score"`)}
+	}
+	if _, ok := erc.mutation.ComputedAt(); !ok {
+		return &ValidationError{Name: "computed_at", err: errors.New(`ent: missing required field "ExploreRanking.computed_at"`)}
+	}
+	if len(erc.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "ExploreRanking.post"`)}
+	}
+	return nil
+}
+
+func (erc *ExploreRankingCreate) sqlSave(ctx context.Context) (*ExploreRanking, error) {
+	if err := erc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := erc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, erc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	erc.mutation.id = &_node.ID
+	erc.mutation.done = true
+	return _node, nil
+}
+
+func (erc *ExploreRankingCreate) createSpec() (*ExploreRanking, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExploreRanking{config: erc.config}
+		_spec = sqlgraph.NewCreateSpec(exploreranking.Table, sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = erc.conflict
+	if id, ok := erc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := erc.mutation.Position(); ok {
+		_spec.SetField(exploreranking.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := erc.mutation.Score(); ok {
+		_spec.SetField(exploreranking.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := erc.mutation.ComputedAt(); ok {
+		_spec.SetField(exploreranking.FieldComputedAt, field.TypeTime, value)
+		_node.ComputedAt = value
+	}
+	if nodes := erc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   exploreranking.PostTable,
+			Columns: []string{exploreranking.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_explore_ranking = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExploreRanking.Create().
+//		SetPosition(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExploreRankingUpsert) {
+//			SetPosition(v+v).
+//		}).
+//		Exec(ctx)
+func (erc *ExploreRankingCreate) OnConflict(opts ...sql.ConflictOption) *ExploreRankingUpsertOne {
+	erc.conflict = opts
+	return &ExploreRankingUpsertOne{
+		create: erc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExploreRanking.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (erc *ExploreRankingCreate) OnConflictColumns(columns ...string) *ExploreRankingUpsertOne {
+	erc.conflict = append(erc.conflict, sql.ConflictColumns(columns...))
+	return &ExploreRankingUpsertOne{
+		create: erc,
+	}
+}
+
+type (
+	// ExploreRankingUpsertOne is the builder for "upsert"-ing
+	//  one ExploreRanking node.
+	ExploreRankingUpsertOne struct {
+		create *ExploreRankingCreate
+	}
+
+	// ExploreRankingUpsert is the "OnConflict" setter.
+	ExploreRankingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPosition sets the "position" field.
+func (u *ExploreRankingUpsert) SetPosition(v int) *ExploreRankingUpsert {
+	u.Set(exploreranking.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ExploreRankingUpsert) UpdatePosition() *ExploreRankingUpsert {
+	u.SetExcluded(exploreranking.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *ExploreRankingUpsert) AddPosition(v int) *ExploreRankingUpsert {
+	u.Add(exploreranking.FieldPosition, v)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *ExploreRankingUpsert) SetScore(v float64) *ExploreRankingUpsert {
+	u.Set(exploreranking.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ExploreRankingUpsert) UpdateScore() *ExploreRankingUpsert {
+	u.SetExcluded(exploreranking.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *ExploreRankingUpsert) AddScore(v float64) *ExploreRankingUpsert {
+	u.Add(exploreranking.FieldScore, v)
+	return u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (u *ExploreRankingUpsert) SetComputedAt(v time.Time) *ExploreRankingUpsert {
+	u.Set(exploreranking.FieldComputedAt, v)
+	return u
+}
+
+// UpdateComputedAt sets the "computed_at" field to the value that was provided on create.
+func (u *ExploreRankingUpsert) UpdateComputedAt() *ExploreRankingUpsert {
+	u.SetExcluded(exploreranking.FieldComputedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExploreRanking.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exploreranking.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExploreRankingUpsertOne) UpdateNewValues() *ExploreRankingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(exploreranking.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExploreRanking.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExploreRankingUpsertOne) Ignore() *ExploreRankingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExploreRankingUpsertOne) DoNothing() *ExploreRankingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExploreRankingCreate.OnConflict
+// documentation for more info.
+func (u *ExploreRankingUpsertOne) Update(set func(*ExploreRankingUpsert)) *ExploreRankingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExploreRankingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *ExploreRankingUpsertOne) SetPosition(v int) *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *ExploreRankingUpsertOne) AddPosition(v int) *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ExploreRankingUpsertOne) UpdatePosition() *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ExploreRankingUpsertOne) SetScore(v float64) *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ExploreRankingUpsertOne) AddScore(v float64) *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ExploreRankingUpsertOne) UpdateScore() *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (u *ExploreRankingUpsertOne) SetComputedAt(v time.Time) *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.SetComputedAt(v)
+	})
+}
+
+// UpdateComputedAt sets the "computed_at" field to the value that was provided on create.
+func (u *ExploreRankingUpsertOne) UpdateComputedAt() *ExploreRankingUpsertOne {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.UpdateComputedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExploreRankingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExploreRankingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExploreRankingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExploreRankingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExploreRankingUpsertOne.ID is not supported by MySQL driver. Use ExploreRankingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExploreRankingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExploreRankingCreateBulk is the builder for creating many ExploreRanking entities in bulk.
+type ExploreRankingCreateBulk struct {
+	config
+	err      error
+	builders []*ExploreRankingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExploreRanking entities in the database.
+func (ercb *ExploreRankingCreateBulk) Save(ctx context.Context) ([]*ExploreRanking, error) {
+	if ercb.err != nil {
+		return nil, ercb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ercb.builders))
+	nodes := make([]*ExploreRanking, len(ercb.builders))
+	mutators := make([]Mutator, len(ercb.builders))
+	for i := range ercb.builders {
+		func(i int, root context.Context) {
+			builder := ercb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExploreRankingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ercb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ercb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ercb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ercb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ercb *ExploreRankingCreateBulk) SaveX(ctx context.Context) []*ExploreRanking {
+	v, err := ercb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ercb *ExploreRankingCreateBulk) Exec(ctx context.Context) error {
+	_, err := ercb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ercb *ExploreRankingCreateBulk) ExecX(ctx context.Context) {
+	if err := ercb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExploreRanking.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExploreRankingUpsert) {
+//			SetPosition(v+v).
+//		}).
+//		Exec(ctx)
+func (ercb *ExploreRankingCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExploreRankingUpsertBulk {
+	ercb.conflict = opts
+	return &ExploreRankingUpsertBulk{
+		create: ercb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExploreRanking.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ercb *ExploreRankingCreateBulk) OnConflictColumns(columns ...string) *ExploreRankingUpsertBulk {
+	ercb.conflict = append(ercb.conflict, sql.ConflictColumns(columns...))
+	return &ExploreRankingUpsertBulk{
+		create: ercb,
+	}
+}
+
+// ExploreRankingUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExploreRanking nodes.
+type ExploreRankingUpsertBulk struct {
+	create *ExploreRankingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExploreRanking.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exploreranking.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExploreRankingUpsertBulk) UpdateNewValues() *ExploreRankingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(exploreranking.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExploreRanking.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExploreRankingUpsertBulk) Ignore() *ExploreRankingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExploreRankingUpsertBulk) DoNothing() *ExploreRankingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExploreRankingCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExploreRankingUpsertBulk) Update(set func(*ExploreRankingUpsert)) *ExploreRankingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExploreRankingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *ExploreRankingUpsertBulk) SetPosition(v int) *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *ExploreRankingUpsertBulk) AddPosition(v int) *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ExploreRankingUpsertBulk) UpdatePosition() *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ExploreRankingUpsertBulk) SetScore(v float64) *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ExploreRankingUpsertBulk) AddScore(v float64) *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ExploreRankingUpsertBulk) UpdateScore() *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (u *ExploreRankingUpsertBulk) SetComputedAt(v time.Time) *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.SetComputedAt(v)
+	})
+}
+
+// UpdateComputedAt sets the "computed_at" field to the value that was provided on create.
+func (u *ExploreRankingUpsertBulk) UpdateComputedAt() *ExploreRankingUpsertBulk {
+	return u.Update(func(s *ExploreRankingUpsert) {
+		s.UpdateComputedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ExploreRankingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExploreRankingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExploreRankingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExploreRankingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
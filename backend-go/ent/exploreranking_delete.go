@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// ExploreRankingDelete is the builder for deleting a ExploreRanking entity.
+type ExploreRankingDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExploreRankingMutation
+}
+
+// Where appends a list predicates to the ExploreRankingDelete builder.
+func (erd *ExploreRankingDelete) Where(ps ...predicate.ExploreRanking) *ExploreRankingDelete {
+	erd.mutation.Where(ps...)
+	return erd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (erd *ExploreRankingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, erd.sqlExec, erd.mutation, erd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erd *ExploreRankingDelete) ExecX(ctx context.Context) int {
+	n, err := erd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (erd *ExploreRankingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(exploreranking.Table, sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID))
+	if ps := erd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, erd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	erd.mutation.done = true
+	return affected, err
+}
+
+// ExploreRankingDeleteOne is the builder for deleting a single ExploreRanking entity.
+type ExploreRankingDeleteOne struct {
+	erd *ExploreRankingDelete
+}
+
+// Where appends a list predicates to the ExploreRankingDelete builder.
+func (erdo *ExploreRankingDeleteOne) Where(ps ...predicate.ExploreRanking) *ExploreRankingDeleteOne {
+	erdo.erd.mutation.Where(ps...)
+	return erdo
+}
+
+// Exec executes the deletion query.
+func (erdo *ExploreRankingDeleteOne) Exec(ctx context.Context) error {
+	n, err := erdo.erd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{exploreranking.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (erdo *ExploreRankingDeleteOne) ExecX(ctx context.Context) {
+	if err := erdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ExploreRankingQuery is the builder for querying ExploreRanking entities.
+type ExploreRankingQuery struct {
+	config
+	ctx        *QueryContext
+	order      []exploreranking.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ExploreRanking
+	withPost   *PostQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExploreRankingQuery builder.
+func (erq *ExploreRankingQuery) Where(ps ...predicate.ExploreRanking) *ExploreRankingQuery {
+	erq.predicates = append(erq.predicates, ps...)
+	return erq
+}
+
+// Limit the number of records to be returned by this query.
+func (erq *ExploreRankingQuery) Limit(limit int) *ExploreRankingQuery {
+	erq.ctx.Limit = &limit
+	return erq
+}
+
+// Offset to start from.
+func (erq *ExploreRankingQuery) Offset(offset int) *ExploreRankingQuery {
+	erq.ctx.Offset = &offset
+	return erq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (erq *ExploreRankingQuery) Unique(unique bool) *ExploreRankingQuery {
+	erq.ctx.Unique = &unique
+	return erq
+}
+
+// Order specifies how the records should be ordered.
+func (erq *ExploreRankingQuery) Order(o ...exploreranking.OrderOption) *ExploreRankingQuery {
+	erq.order = append(erq.order, o...)
+	return erq
+}
+
+// QueryPost chains the current query on the "post" edge.
+func (erq *ExploreRankingQuery) QueryPost() *PostQuery {
+	query := (&PostClient{config: erq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := erq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := erq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exploreranking.Table, exploreranking.FieldID, selector),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, exploreranking.PostTable, exploreranking.PostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(erq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ExploreRanking entity from the query.
+// Returns a *NotFoundError when no ExploreRanking was found.
+func (erq *ExploreRankingQuery) First(ctx context.Context) (*ExploreRanking, error) {
+	nodes, err := erq.Limit(1).All(setContextOp(ctx, erq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{exploreranking.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (erq *ExploreRankingQuery) FirstX(ctx context.Context) *ExploreRanking {
+	node, err := erq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExploreRanking ID from the query.
+// Returns a *NotFoundError when no ExploreRanking ID was found.
+func (erq *ExploreRankingQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = erq.Limit(1).IDs(setContextOp(ctx, erq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{exploreranking.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (erq *ExploreRankingQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := erq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExploreRanking entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExploreRanking entity is found.
+// Returns a *NotFoundError when no ExploreRanking entities are found.
+func (erq *ExploreRankingQuery) Only(ctx context.Context) (*ExploreRanking, error) {
+	nodes, err := erq.Limit(2).All(setContextOp(ctx, erq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{exploreranking.Label}
+	default:
+		return nil, &NotSingularError{exploreranking.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (erq *ExploreRankingQuery) OnlyX(ctx context.Context) *ExploreRanking {
+	node, err := erq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExploreRanking ID in the query.
+// Returns a *NotSingularError when more than one ExploreRanking ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (erq *ExploreRankingQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = erq.Limit(2).IDs(setContextOp(ctx, erq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{exploreranking.Label}
+	default:
+		err = &NotSingularError{exploreranking.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (erq *ExploreRankingQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := erq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExploreRankings.
+func (erq *ExploreRankingQuery) All(ctx context.Context) ([]*ExploreRanking, error) {
+	ctx = setContextOp(ctx, erq.ctx, ent.OpQueryAll)
+	if err := erq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExploreRanking, *ExploreRankingQuery]()
+	return withInterceptors[[]*ExploreRanking](ctx, erq, qr, erq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (erq *ExploreRankingQuery) AllX(ctx context.Context) []*ExploreRanking {
+	nodes, err := erq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExploreRanking IDs.
+func (erq *ExploreRankingQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if erq.ctx.Unique == nil && erq.path != nil {
+		erq.Unique(true)
+	}
+	ctx = setContextOp(ctx, erq.ctx, ent.OpQueryIDs)
+	if err = erq.Select(exploreranking.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (erq *ExploreRankingQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := erq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (erq *ExploreRankingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, erq.ctx, ent.OpQueryCount)
+	if err := erq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, erq, querierCount[*ExploreRankingQuery](), erq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (erq *ExploreRankingQuery) CountX(ctx context.Context) int {
+	count, err := erq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (erq *ExploreRankingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, erq.ctx, ent.OpQueryExist)
+	switch _, err := erq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (erq *ExploreRankingQuery) ExistX(ctx context.Context) bool {
+	exist, err := erq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExploreRankingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (erq *ExploreRankingQuery) Clone() *ExploreRankingQuery {
+	if erq == nil {
+		return nil
+	}
+	return &ExploreRankingQuery{
+		config:     erq.config,
+		ctx:        erq.ctx.Clone(),
+		order:      append([]exploreranking.OrderOption{}, erq.order...),
+		inters:     append([]Interceptor{}, erq.inters...),
+		predicates: append([]predicate.ExploreRanking{}, erq.predicates...),
+		withPost:   erq.withPost.Clone(),
+		// clone intermediate query.
+		sql:  erq.sql.Clone(),
+		path: erq.path,
+	}
+}
+
+// WithPost tells the query-builder to eager-load the nodes that are connected to
+// the "post" edge. The optional arguments are used to configure the query builder of the edge.
+func (erq *ExploreRankingQuery) WithPost(opts ...func(*PostQuery)) *ExploreRankingQuery {
+	query := (&PostClient{config: erq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	erq.withPost = query
+	return erq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Position int `json:"position,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExploreRanking.Query().
+//		GroupBy(exploreranking.FieldPosition).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (erq *ExploreRankingQuery) GroupBy(field string, fields ...string) *ExploreRankingGroupBy {
+	erq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExploreRankingGroupBy{build: erq}
+	grbuild.flds = &erq.ctx.Fields
+	grbuild.label = exploreranking.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Position int `json:"position,omitempty"`
+//	}
+//
+//	client.ExploreRanking.Query().
+//		Select(exploreranking.FieldPosition).
+//		Scan(ctx, &v)
+func (erq *ExploreRankingQuery) Select(fields ...string) *ExploreRankingSelect {
+	erq.ctx.Fields = append(erq.ctx.Fields, fields...)
+	sbuild := &ExploreRankingSelect{ExploreRankingQuery: erq}
+	sbuild.label = exploreranking.Label
+	sbuild.flds, sbuild.scan = &erq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExploreRankingSelect configured with the given aggregations.
+func (erq *ExploreRankingQuery) Aggregate(fns ...AggregateFunc) *ExploreRankingSelect {
+	return erq.Select().Aggregate(fns...)
+}
+
+func (erq *ExploreRankingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range erq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, erq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range erq.ctx.Fields {
+		if !exploreranking.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if erq.path != nil {
+		prev, err := erq.path(ctx)
+		if err != nil {
+			return err
+		}
+		erq.sql = prev
+	}
+	return nil
+}
+
+func (erq *ExploreRankingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExploreRanking, error) {
+	var (
+		nodes       = []*ExploreRanking{}
+		withFKs     = erq.withFKs
+		_spec       = erq.querySpec()
+		loadedTypes = [1]bool{
+			erq.withPost != nil,
+		}
+	)
+	if erq.withPost != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, exploreranking.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExploreRanking).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExploreRanking{config: erq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, erq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := erq.withPost; query != nil {
+		if err := erq.loadPost(ctx, query, nodes, nil,
+			func(n *ExploreRanking, e *Post) { n.Edges.Post = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (erq *ExploreRankingQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*ExploreRanking, init func(*ExploreRanking), assign func(*ExploreRanking, *Post)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ExploreRanking)
+	for i := range nodes {
+		if nodes[i].post_explore_ranking == nil {
+			continue
+		}
+		fk := *nodes[i].post_explore_ranking
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "post_explore_ranking" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (erq *ExploreRankingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := erq.querySpec()
+	_spec.Node.Columns = erq.ctx.Fields
+	if len(erq.ctx.Fields) > 0 {
+		_spec.Unique = erq.ctx.Unique != nil && *erq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, erq.driver, _spec)
+}
+
+func (erq *ExploreRankingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(exploreranking.Table, exploreranking.Columns, sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID))
+	_spec.From = erq.sql
+	if unique := erq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if erq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := erq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exploreranking.FieldID)
+		for i := range fields {
+			if fields[i] != exploreranking.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := erq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := erq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := erq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := erq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (erq *ExploreRankingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(erq.driver.Dialect())
+	t1 := builder.Table(exploreranking.Table)
+	columns := erq.ctx.Fields
+	if len(columns) == 0 {
+		columns = exploreranking.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if erq.sql != nil {
+		selector = erq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if erq.ctx.Unique != nil && *erq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range erq.predicates {
+		p(selector)
+	}
+	for _, p := range erq.order {
+		p(selector)
+	}
+	if offset := erq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := erq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ExploreRankingGroupBy is the group-by builder for ExploreRanking entities.
+type ExploreRankingGroupBy struct {
+	selector
+	build *ExploreRankingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ergb *ExploreRankingGroupBy) Aggregate(fns ...AggregateFunc) *ExploreRankingGroupBy {
+	ergb.fns = append(ergb.fns, fns...)
+	return ergb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ergb *ExploreRankingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ergb.build.ctx, ent.OpQueryGroupBy)
+	if err := ergb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExploreRankingQuery, *ExploreRankingGroupBy](ctx, ergb.build, ergb, ergb.build.inters, v)
+}
+
+func (ergb *ExploreRankingGroupBy) sqlScan(ctx context.Context, root *ExploreRankingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ergb.fns))
+	for _, fn := range ergb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ergb.flds)+len(ergb.fns))
+		for _, f := range *ergb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ergb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ergb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExploreRankingSelect is the builder for selecting fields of ExploreRanking entities.
+type ExploreRankingSelect struct {
+	*ExploreRankingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ers *ExploreRankingSelect) Aggregate(fns ...AggregateFunc) *ExploreRankingSelect {
+	ers.fns = append(ers.fns, fns...)
+	return ers
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ers *ExploreRankingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ers.ctx, ent.OpQuerySelect)
+	if err := ers.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExploreRankingQuery, *ExploreRankingSelect](ctx, ers.ExploreRankingQuery, ers, ers.inters, v)
+}
+
+func (ers *ExploreRankingSelect) sqlScan(ctx context.Context, root *ExploreRankingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ers.fns))
+	for _, fn := range ers.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ers.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ers.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
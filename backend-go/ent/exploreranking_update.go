@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ExploreRankingUpdate is the builder for updating ExploreRanking entities.
+type ExploreRankingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExploreRankingMutation
+}
+
+// Where appends a list predicates to the ExploreRankingUpdate builder.
+func (eru *ExploreRankingUpdate) Where(ps ...predicate.ExploreRanking) *ExploreRankingUpdate {
+	eru.mutation.Where(ps...)
+	return eru
+}
+
+// SetPosition sets the "position" field.
+func (eru *ExploreRankingUpdate) SetPosition(i int) *ExploreRankingUpdate {
+	eru.mutation.ResetPosition()
+	eru.mutation.SetPosition(i)
+	return eru
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (eru *ExploreRankingUpdate) SetNillablePosition(i *int) *ExploreRankingUpdate {
+	if i != nil {
+		eru.SetPosition(*i)
+	}
+	return eru
+}
+
+// AddPosition adds i to the "position" field.
+func (eru *ExploreRankingUpdate) AddPosition(i int) *ExploreRankingUpdate {
+	eru.mutation.AddPosition(i)
+	return eru
+}
+
+// SetScore sets the "score" field.
+func (eru *ExploreRankingUpdate) SetScore(f float64) *ExploreRankingUpdate {
+	eru.mutation.ResetScore()
+	eru.mutation.SetScore(f)
+	return eru
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (eru *ExploreRankingUpdate) SetNillableScore(f *float64) *ExploreRankingUpdate {
+	if f != nil {
+		eru.SetScore(*f)
+	}
+	return eru
+}
+
+// AddScore adds f to the "score" field.
+func (eru *ExploreRankingUpdate) AddScore(f float64) *ExploreRankingUpdate {
+	eru.mutation.AddScore(f)
+	return eru
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (eru *ExploreRankingUpdate) SetComputedAt(t time.Time) *ExploreRankingUpdate {
+	eru.mutation.SetComputedAt(t)
+	return eru
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (eru *ExploreRankingUpdate) SetNillableComputedAt(t *time.Time) *ExploreRankingUpdate {
+	if t != nil {
+		eru.SetComputedAt(*t)
+	}
+	return eru
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (eru *ExploreRankingUpdate) SetPostID(id uuid.UUID) *ExploreRankingUpdate {
+	eru.mutation.SetPostID(id)
+	return eru
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (eru *ExploreRankingUpdate) SetPost(p *Post) *ExploreRankingUpdate {
+	return eru.SetPostID(p.ID)
+}
+
+// Mutation returns the ExploreRankingMutation object of the builder.
+func (eru *ExploreRankingUpdate) Mutation() *ExploreRankingMutation {
+	return eru.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (eru *ExploreRankingUpdate) ClearPost() *ExploreRankingUpdate {
+	eru.mutation.ClearPost()
+	return eru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eru *ExploreRankingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eru.sqlSave, eru.mutation, eru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eru *ExploreRankingUpdate) SaveX(ctx context.Context) int {
+	affected, err := eru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eru *ExploreRankingUpdate) Exec(ctx context.Context) error {
+	_, err := eru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eru *ExploreRankingUpdate) ExecX(ctx context.Context) {
+	if err := eru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eru *ExploreRankingUpdate) check() error {
+	if v, ok := eru.mutation.Position(); ok {
+		if err := exploreranking.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ExploreRanking.position": %w`, err)}
+		}
+	}
+	if eru.mutation.PostCleared() && len(eru.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExploreRanking.post"`)
+	}
+	return nil
+}
+
+func (eru *ExploreRankingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exploreranking.Table, exploreranking.Columns, sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID))
+	if ps := eru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eru.mutation.Position(); ok {
+		_spec.SetField(exploreranking.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := eru.mutation.AddedPosition(); ok {
+		_spec.AddField(exploreranking.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := eru.mutation.Score(); ok {
+		_spec.SetField(exploreranking.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := eru.mutation.AddedScore(); ok {
+		_spec.AddField(exploreranking.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := eru.mutation.ComputedAt(); ok {
+		_spec.SetField(exploreranking.FieldComputedAt, field.TypeTime, value)
+	}
+	if eru.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   exploreranking.PostTable,
+			Columns: []string{exploreranking.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eru.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   exploreranking.PostTable,
+			Columns: []string{exploreranking.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exploreranking.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eru.mutation.done = true
+	return n, nil
+}
+
+// ExploreRankingUpdateOne is the builder for updating a single ExploreRanking entity.
+type ExploreRankingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExploreRankingMutation
+}
+
+// SetPosition sets the "position" field.
+func (eruo *ExploreRankingUpdateOne) SetPosition(i int) *ExploreRankingUpdateOne {
+	eruo.mutation.ResetPosition()
+	eruo.mutation.SetPosition(i)
+	return eruo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (eruo *ExploreRankingUpdateOne) SetNillablePosition(i *int) *ExploreRankingUpdateOne {
+	if i != nil {
+		eruo.SetPosition(*i)
+	}
+	return eruo
+}
+
+// AddPosition adds i to the "position" field.
+func (eruo *ExploreRankingUpdateOne) AddPosition(i int) *ExploreRankingUpdateOne {
+	eruo.mutation.AddPosition(i)
+	return eruo
+}
+
+// SetScore sets the "score" field.
+func (eruo *ExploreRankingUpdateOne) SetScore(f float64) *ExploreRankingUpdateOne {
+	eruo.mutation.ResetScore()
+	eruo.mutation.SetScore(f)
+	return eruo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (eruo *ExploreRankingUpdateOne) SetNillableScore(f *float64) *ExploreRankingUpdateOne {
+	if f != nil {
+		eruo.SetScore(*f)
+	}
+	return eruo
+}
+
+// AddScore adds f to the "score" field.
+func (eruo *ExploreRankingUpdateOne) AddScore(f float64) *ExploreRankingUpdateOne {
+	eruo.mutation.AddScore(f)
+	return eruo
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (eruo *ExploreRankingUpdateOne) SetComputedAt(t time.Time) *ExploreRankingUpdateOne {
+	eruo.mutation.SetComputedAt(t)
+	return eruo
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (eruo *ExploreRankingUpdateOne) SetNillableComputedAt(t *time.Time) *ExploreRankingUpdateOne {
+	if t != nil {
+		eruo.SetComputedAt(*t)
+	}
+	return eruo
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (eruo *ExploreRankingUpdateOne) SetPostID(id uuid.UUID) *ExploreRankingUpdateOne {
+	eruo.mutation.SetPostID(id)
+	return eruo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (eruo *ExploreRankingUpdateOne) SetPost(p *Post) *ExploreRankingUpdateOne {
+	return eruo.SetPostID(p.ID)
+}
+
+// Mutation returns the ExploreRankingMutation object of the builder.
+func (eruo *ExploreRankingUpdateOne) Mutation() *ExploreRankingMutation {
+	return eruo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (eruo *ExploreRankingUpdateOne) ClearPost() *ExploreRankingUpdateOne {
+	eruo.mutation.ClearPost()
+	return eruo
+}
+
+// Where appends a list predicates to the ExploreRankingUpdate builder.
+func (eruo *ExploreRankingUpdateOne) Where(ps ...predicate.ExploreRanking) *ExploreRankingUpdateOne {
+	eruo.mutation.Where(ps...)
+	return eruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eruo *ExploreRankingUpdateOne) Select(field string, fields ...string) *ExploreRankingUpdateOne {
+	eruo.fields = append([]string{field}, fields...)
+	return eruo
+}
+
+// Save executes the query and returns the updated ExploreRanking entity.
+func (eruo *ExploreRankingUpdateOne) Save(ctx context.Context) (*ExploreRanking, error) {
+	return withHooks(ctx, eruo.sqlSave, eruo.mutation, eruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eruo *ExploreRankingUpdateOne) SaveX(ctx context.Context) *ExploreRanking {
+	node, err := eruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eruo *ExploreRankingUpdateOne) Exec(ctx context.Context) error {
+	_, err := eruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eruo *ExploreRankingUpdateOne) ExecX(ctx context.Context) {
+	if err := eruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eruo *ExploreRankingUpdateOne) check() error {
+	if v, ok := eruo.mutation.Position(); ok {
+		if err := exploreranking.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ExploreRanking.position": %w`, err)}
+		}
+	}
+	if eruo.mutation.PostCleared() && len(eruo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExploreRanking.post"`)
+	}
+	return nil
+}
+
+func (eruo *ExploreRankingUpdateOne) sqlSave(ctx context.Context) (_node *ExploreRanking, err error) {
+	if err := eruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exploreranking.Table, exploreranking.Columns, sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID))
+	id, ok := eruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExploreRanking.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exploreranking.FieldID)
+		for _, f := range fields {
+			if !exploreranking.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exploreranking.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eruo.mutation.Position(); ok {
+		_spec.SetField(exploreranking.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := eruo.mutation.AddedPosition(); ok {
+		_spec.AddField(exploreranking.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := eruo.mutation.Score(); ok {
+		_spec.SetField(exploreranking.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := eruo.mutation.AddedScore(); ok {
+		_spec.AddField(exploreranking.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := eruo.mutation.ComputedAt(); ok {
+		_spec.SetField(exploreranking.FieldComputedAt, field.TypeTime, value)
+	}
+	if eruo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   exploreranking.PostTable,
+			Columns: []string{exploreranking.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eruo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   exploreranking.PostTable,
+			Columns: []string{exploreranking.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExploreRanking{config: eruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exploreranking.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eruo.mutation.done = true
+	return _node, nil
+}
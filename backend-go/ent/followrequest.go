@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// FollowRequest is the model entity for the FollowRequest schema.
+type FollowRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FollowRequestQuery when eager-loading is set.
+	Edges                         FollowRequestEdges `json:"edges"`
+	user_follow_requests_sent     *uuid.UUID
+	user_follow_requests_received *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// FollowRequestEdges holds the relations/edges for other nodes in the graph.
+type FollowRequestEdges struct {
+	// From holds the value of the from edge.
+	From *User `json:"from,omitempty"`
+	// To holds the value of the to edge.
+	To *User `json:"to,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FromOrErr returns the From value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowRequestEdges) FromOrErr() (*User, error) {
+	if e.From != nil {
+		return e.From, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "from"}
+}
+
+// ToOrErr returns the To value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowRequestEdges) ToOrErr() (*User, error) {
+	if e.To != nil {
+		return e.To, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "to"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FollowRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case followrequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case followrequest.FieldID:
+			values[i] = new(uuid.UUID)
+		case followrequest.ForeignKeys[0]: // user_follow_requests_sent
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case followrequest.ForeignKeys[1]: // user_follow_requests_received
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FollowRequest fields.
+func (fr *FollowRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case followrequest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				fr.ID = *value
+			}
+		case followrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fr.CreatedAt = value.Time
+			}
+		case followrequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_follow_requests_sent", values[i])
+			} else if value.Valid {
+				fr.user_follow_requests_sent = new(uuid.UUID)
+				*fr.user_follow_requests_sent = *value.S.(*uuid.UUID)
+			}
+		case followrequest.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_follow_requests_received", values[i])
+			} else if value.Valid {
+				fr.user_follow_requests_received = new(uuid.UUID)
+				*fr.user_follow_requests_received = *value.S.(*uuid.UUID)
+			}
+		default:
+			fr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FollowRequest.
+// This includes values selected through modifiers, order, etc.
+func (fr *FollowRequest) Value(name string) (ent.Value, error) {
+	return fr.selectValues.Get(name)
+}
+
+// QueryFrom queries the "from" edge of the FollowRequest entity.
+func (fr *FollowRequest) QueryFrom() *UserQuery {
+	return NewFollowRequestClient(fr.config).QueryFrom(fr)
+}
+
+// QueryTo queries the "to" edge of the FollowRequest entity.
+func (fr *FollowRequest) QueryTo() *UserQuery {
+	return NewFollowRequestClient(fr.config).QueryTo(fr)
+}
+
+// Update returns a builder for updating this FollowRequest.
+// Note that you need to call FollowRequest.Unwrap() before calling this method if this FollowRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fr *FollowRequest) Update() *FollowRequestUpdateOne {
+	return NewFollowRequestClient(fr.config).UpdateOne(fr)
+}
+
+// Unwrap unwraps the FollowRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fr *FollowRequest) Unwrap() *FollowRequest {
+	_tx, ok := fr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FollowRequest is not a transactional entity")
+	}
+	fr.config.driver = _tx.drv
+	return fr
+}
+
+// String implements the fmt.Stringer.
+func (fr *FollowRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("FollowRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FollowRequests is a parsable slice of FollowRequest.
+type FollowRequests []*FollowRequest
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package followrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the followrequest type in the database.
+	Label = "follow_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeFrom holds the string denoting the from edge name in mutations.
+	EdgeFrom = "from"
+	// EdgeTo holds the string denoting the to edge name in mutations.
+	EdgeTo = "to"
+	// Table holds the table name of the followrequest in the database.
+	Table = "follow_requests"
+	// FromTable is the table that holds the from relation/edge.
+	FromTable = "follow_requests"
+	// FromInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FromInverseTable = "users"
+	// FromColumn is the table column denoting the from relation/edge.
+	FromColumn = "user_follow_requests_sent"
+	// ToTable is the table that holds the to relation/edge.
+	ToTable = "follow_requests"
+	// ToInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ToInverseTable = "users"
+	// ToColumn is the table column denoting the to relation/edge.
+	ToColumn = "user_follow_requests_received"
+)
+
+// Columns holds all SQL columns for followrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "follow_requests"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_follow_requests_sent",
+	"user_follow_requests_received",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the FollowRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFromField orders the results by from field.
+func ByFromField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFromStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByToField orders the results by to field.
+func ByToField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newToStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFromStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FromInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FromTable, FromColumn),
+	)
+}
+func newToStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ToInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ToTable, ToColumn),
+	)
+}
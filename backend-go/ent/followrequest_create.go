@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// FollowRequestCreate is the builder for creating a FollowRequest entity.
+type FollowRequestCreate struct {
+	config
+	mutation *FollowRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (frc *FollowRequestCreate) SetCreatedAt(t time.Time) *FollowRequestCreate {
+	frc.mutation.SetCreatedAt(t)
+	return frc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (frc *FollowRequestCreate) SetNillableCreatedAt(t *time.Time) *FollowRequestCreate {
+	if t != nil {
+		frc.SetCreatedAt(*t)
+	}
+	return frc
+}
+
+// SetID sets the "id" field.
+func (frc *FollowRequestCreate) SetID(u uuid.UUID) *FollowRequestCreate {
+	frc.mutation.SetID(u)
+	return frc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (frc *FollowRequestCreate) SetNillableID(u *uuid.UUID) *FollowRequestCreate {
+	if u != nil {
+		frc.SetID(*u)
+	}
+	return frc
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (frc *FollowRequestCreate) SetFromID(id uuid.UUID) *FollowRequestCreate {
+	frc.mutation.SetFromID(id)
+	return frc
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (frc *FollowRequestCreate) SetFrom(u *User) *FollowRequestCreate {
+	return frc.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (frc *FollowRequestCreate) SetToID(id uuid.UUID) *FollowRequestCreate {
+	frc.mutation.SetToID(id)
+	return frc
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (frc *FollowRequestCreate) SetTo(u *User) *FollowRequestCreate {
+	return frc.SetToID(u.ID)
+}
+
+// Mutation returns the FollowRequestMutation object of the builder.
+func (frc *FollowRequestCreate) Mutation() *FollowRequestMutation {
+	return frc.mutation
+}
+
+// Save creates the FollowRequest in the database.
+func (frc *FollowRequestCreate) Save(ctx context.Context) (*FollowRequest, error) {
+	frc.defaults()
+	return withHooks(ctx, frc.sqlSave, frc.mutation, frc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (frc *FollowRequestCreate) SaveX(ctx context.Context) *FollowRequest {
+	v, err := frc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frc *FollowRequestCreate) Exec(ctx context.Context) error {
+	_, err := frc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frc *FollowRequestCreate) ExecX(ctx context.Context) {
+	if err := frc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (frc *FollowRequestCreate) defaults() {
+	if _, ok := frc.mutation.CreatedAt(); !ok {
+		v := followrequest.DefaultCreatedAt()
+		frc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := frc.mutation.ID(); !ok {
+		v := followrequest.DefaultID()
+		frc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (frc *FollowRequestCreate) check() error {
+	if _, ok := frc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FollowRequest.created_at"`)}
+	}
+	if len(frc.mutation.FromIDs()) == 0 {
+		return &ValidationError{Name: "from", err: errors.New(`ent: missing required edge "FollowRequest.from"`)}
+	}
+	if len(frc.mutation.ToIDs()) == 0 {
+		return &ValidationError{Name: "to", err: errors.New(`ent: missing required edge "FollowRequest.to"`)}
+	}
+	return nil
+}
+
+func (frc *FollowRequestCreate) sqlSave(ctx context.Context) (*FollowRequest, error) {
+	if err := frc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := frc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, frc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	frc.mutation.id = &_node.ID
+	frc.mutation.done = true
+	return _node, nil
+}
+
+func (frc *FollowRequestCreate) createSpec() (*FollowRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FollowRequest{config: frc.config}
+		_spec = sqlgraph.NewCreateSpec(followrequest.Table, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = frc.conflict
+	if id, ok := frc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := frc.mutation.CreatedAt(); ok {
+		_spec.SetField(followrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := frc.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.FromTable,
+			Columns: []string{followrequest.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_follow_requests_sent = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := frc.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.ToTable,
+			Columns: []string{followrequest.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_follow_requests_received = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FollowRequest.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FollowRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (frc *FollowRequestCreate) OnConflict(opts ...sql.ConflictOption) *FollowRequestUpsertOne {
+	frc.conflict = opts
+	return &FollowRequestUpsertOne{
+		create: frc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FollowRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (frc *FollowRequestCreate) OnConflictColumns(columns ...string) *FollowRequestUpsertOne {
+	frc.conflict = append(frc.conflict, sql.ConflictColumns(columns...))
+	return &FollowRequestUpsertOne{
+		create: frc,
+	}
+}
+
+type (
+	// FollowRequestUpsertOne is the builder for "upsert"-ing
+	//  one FollowRequest node.
+	FollowRequestUpsertOne struct {
+		create *FollowRequestCreate
+	}
+
+	// FollowRequestUpsert is the "OnConflict" setter.
+	FollowRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *FollowRequestUpsert) SetCreatedAt(v time.Time) *FollowRequestUpsert {
+	u.Set(followrequest.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *FollowRequestUpsert) UpdateCreatedAt() *FollowRequestUpsert {
+	u.SetExcluded(followrequest.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.FollowRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(followrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FollowRequestUpsertOne) UpdateNewValues() *FollowRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(followrequest.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FollowRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FollowRequestUpsertOne) Ignore() *FollowRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FollowRequestUpsertOne) DoNothing() *FollowRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FollowRequestCreate.OnConflict
+// documentation for more info.
+func (u *FollowRequestUpsertOne) Update(set func(*FollowRequestUpsert)) *FollowRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FollowRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *FollowRequestUpsertOne) SetCreatedAt(v time.Time) *FollowRequestUpsertOne {
+	return u.Update(func(s *FollowRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *FollowRequestUpsertOne) UpdateCreatedAt() *FollowRequestUpsertOne {
+	return u.Update(func(s *FollowRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FollowRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FollowRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FollowRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FollowRequestUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: FollowRequestUpsertOne.ID is not supported by MySQL driver. Use FollowRequestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FollowRequestUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FollowRequestCreateBulk is the builder for creating many FollowRequest entities in bulk.
+type FollowRequestCreateBulk struct {
+	config
+	err      error
+	builders []*FollowRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FollowRequest entities in the database.
+func (frcb *FollowRequestCreateBulk) Save(ctx context.Context) ([]*FollowRequest, error) {
+	if frcb.err != nil {
+		return nil, frcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(frcb.builders))
+	nodes := make([]*FollowRequest, len(frcb.builders))
+	mutators := make([]Mutator, len(frcb.builders))
+	for i := range frcb.builders {
+		func(i int, root context.Context) {
+			builder := frcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FollowRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, frcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = frcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, frcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, frcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (frcb *FollowRequestCreateBulk) SaveX(ctx context.Context) []*FollowRequest {
+	v, err := frcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frcb *FollowRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := frcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frcb *FollowRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := frcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FollowRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FollowRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (frcb *FollowRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *FollowRequestUpsertBulk {
+	frcb.conflict = opts
+	return &FollowRequestUpsertBulk{
+		create: frcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FollowRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (frcb *FollowRequestCreateBulk) OnConflictColumns(columns ...string) *FollowRequestUpsertBulk {
+	frcb.conflict = append(frcb.conflict, sql.ConflictColumns(columns...))
+	return &FollowRequestUpsertBulk{
+		create: frcb,
+	}
+}
+
+// FollowRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of FollowRequest nodes.
+type FollowRequestUpsertBulk struct {
+	create *FollowRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FollowRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(followrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FollowRequestUpsertBulk) UpdateNewValues() *FollowRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(followrequest.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FollowRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FollowRequestUpsertBulk) Ignore() *FollowRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FollowRequestUpsertBulk) DoNothing() *FollowRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FollowRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *FollowRequestUpsertBulk) Update(set func(*FollowRequestUpsert)) *FollowRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FollowRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *FollowRequestUpsertBulk) SetCreatedAt(v time.Time) *FollowRequestUpsertBulk {
+	return u.Update(func(s *FollowRequestUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *FollowRequestUpsertBulk) UpdateCreatedAt() *FollowRequestUpsertBulk {
+	return u.Update(func(s *FollowRequestUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *FollowRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FollowRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FollowRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FollowRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// FollowRequestDelete is the builder for deleting a FollowRequest entity.
+type FollowRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *FollowRequestMutation
+}
+
+// Where appends a list predicates to the FollowRequestDelete builder.
+func (frd *FollowRequestDelete) Where(ps ...predicate.FollowRequest) *FollowRequestDelete {
+	frd.mutation.Where(ps...)
+	return frd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (frd *FollowRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, frd.sqlExec, frd.mutation, frd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frd *FollowRequestDelete) ExecX(ctx context.Context) int {
+	n, err := frd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (frd *FollowRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(followrequest.Table, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID))
+	if ps := frd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, frd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	frd.mutation.done = true
+	return affected, err
+}
+
+// FollowRequestDeleteOne is the builder for deleting a single FollowRequest entity.
+type FollowRequestDeleteOne struct {
+	frd *FollowRequestDelete
+}
+
+// Where appends a list predicates to the FollowRequestDelete builder.
+func (frdo *FollowRequestDeleteOne) Where(ps ...predicate.FollowRequest) *FollowRequestDeleteOne {
+	frdo.frd.mutation.Where(ps...)
+	return frdo
+}
+
+// Exec executes the deletion query.
+func (frdo *FollowRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := frdo.frd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{followrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frdo *FollowRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := frdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
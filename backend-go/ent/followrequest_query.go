@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// FollowRequestQuery is the builder for querying FollowRequest entities.
+type FollowRequestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []followrequest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FollowRequest
+	withFrom   *UserQuery
+	withTo     *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FollowRequestQuery builder.
+func (frq *FollowRequestQuery) Where(ps ...predicate.FollowRequest) *FollowRequestQuery {
+	frq.predicates = append(frq.predicates, ps...)
+	return frq
+}
+
+// Limit the number of records to be returned by this query.
+func (frq *FollowRequestQuery) Limit(limit int) *FollowRequestQuery {
+	frq.ctx.Limit = &limit
+	return frq
+}
+
+// Offset to start from.
+func (frq *FollowRequestQuery) Offset(offset int) *FollowRequestQuery {
+	frq.ctx.Offset = &offset
+	return frq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (frq *FollowRequestQuery) Unique(unique bool) *FollowRequestQuery {
+	frq.ctx.Unique = &unique
+	return frq
+}
+
+// Order specifies how the records should be ordered.
+func (frq *FollowRequestQuery) Order(o ...followrequest.OrderOption) *FollowRequestQuery {
+	frq.order = append(frq.order, o...)
+	return frq
+}
+
+// QueryFrom chains the current query on the "from" edge.
+func (frq *FollowRequestQuery) QueryFrom() *UserQuery {
+	query := (&UserClient{config: frq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := frq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := frq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followrequest.Table, followrequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followrequest.FromTable, followrequest.FromColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(frq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTo chains the current query on the "to" edge.
+func (frq *FollowRequestQuery) QueryTo() *UserQuery {
+	query := (&UserClient{config: frq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := frq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := frq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(followrequest.Table, followrequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, followrequest.ToTable, followrequest.ToColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(frq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FollowRequest entity from the query.
+// Returns a *NotFoundError when no FollowRequest was found.
+func (frq *FollowRequestQuery) First(ctx context.Context) (*FollowRequest, error) {
+	nodes, err := frq.Limit(1).All(setContextOp(ctx, frq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{followrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (frq *FollowRequestQuery) FirstX(ctx context.Context) *FollowRequest {
+	node, err := frq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FollowRequest ID from the query.
+// Returns a *NotFoundError when no FollowRequest ID was found.
+func (frq *FollowRequestQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = frq.Limit(1).IDs(setContextOp(ctx, frq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{followrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (frq *FollowRequestQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := frq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FollowRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FollowRequest entity is found.
+// Returns a *NotFoundError when no FollowRequest entities are found.
+func (frq *FollowRequestQuery) Only(ctx context.Context) (*FollowRequest, error) {
+	nodes, err := frq.Limit(2).All(setContextOp(ctx, frq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{followrequest.Label}
+	default:
+		return nil, &NotSingularError{followrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (frq *FollowRequestQuery) OnlyX(ctx context.Context) *FollowRequest {
+	node, err := frq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FollowRequest ID in the query.
+// Returns a *NotSingularError when more than one FollowRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (frq *FollowRequestQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = frq.Limit(2).IDs(setContextOp(ctx, frq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{followrequest.Label}
+	default:
+		err = &NotSingularError{followrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (frq *FollowRequestQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := frq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FollowRequests.
+func (frq *FollowRequestQuery) All(ctx context.Context) ([]*FollowRequest, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryAll)
+	if err := frq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FollowRequest, *FollowRequestQuery]()
+	return withInterceptors[[]*FollowRequest](ctx, frq, qr, frq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (frq *FollowRequestQuery) AllX(ctx context.Context) []*FollowRequest {
+	nodes, err := frq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FollowRequest IDs.
+func (frq *FollowRequestQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if frq.ctx.Unique == nil && frq.path != nil {
+		frq.Unique(true)
+	}
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryIDs)
+	if err = frq.Select(followrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (frq *FollowRequestQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := frq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (frq *FollowRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryCount)
+	if err := frq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, frq, querierCount[*FollowRequestQuery](), frq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (frq *FollowRequestQuery) CountX(ctx context.Context) int {
+	count, err := frq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (frq *FollowRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryExist)
+	switch _, err := frq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (frq *FollowRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := frq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FollowRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (frq *FollowRequestQuery) Clone() *FollowRequestQuery {
+	if frq == nil {
+		return nil
+	}
+	return &FollowRequestQuery{
+		config:     frq.config,
+		ctx:        frq.ctx.Clone(),
+		order:      append([]followrequest.OrderOption{}, frq.order...),
+		inters:     append([]Interceptor{}, frq.inters...),
+		predicates: append([]predicate.FollowRequest{}, frq.predicates...),
+		withFrom:   frq.withFrom.Clone(),
+		withTo:     frq.withTo.Clone(),
+		// clone intermediate query.
+		sql:  frq.sql.Clone(),
+		path: frq.path,
+	}
+}
+
+// WithFrom tells the query-builder to eager-load the nodes that are connected to
+// the "from" edge. The optional arguments are used to configure the query builder of the edge.
+func (frq *FollowRequestQuery) WithFrom(opts ...func(*UserQuery)) *FollowRequestQuery {
+	query := (&UserClient{config: frq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	frq.withFrom = query
+	return frq
+}
+
+// WithTo tells the query-builder to eager-load the nodes that are connected to
+// the "to" edge. The optional arguments are used to configure the query builder of the edge.
+func (frq *FollowRequestQuery) WithTo(opts ...func(*UserQuery)) *FollowRequestQuery {
+	query := (&UserClient{config: frq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	frq.withTo = query
+	return frq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FollowRequest.Query().
+//		GroupBy(followrequest.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (frq *FollowRequestQuery) GroupBy(field string, fields ...string) *FollowRequestGroupBy {
+	frq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FollowRequestGroupBy{build: frq}
+	grbuild.flds = &frq.ctx.Fields
+	grbuild.label = followrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.FollowRequest.Query().
+//		Select(followrequest.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (frq *FollowRequestQuery) Select(fields ...string) *FollowRequestSelect {
+	frq.ctx.Fields = append(frq.ctx.Fields, fields...)
+	sbuild := &FollowRequestSelect{FollowRequestQuery: frq}
+	sbuild.label = followrequest.Label
+	sbuild.flds, sbuild.scan = &frq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FollowRequestSelect configured with the given aggregations.
+func (frq *FollowRequestQuery) Aggregate(fns ...AggregateFunc) *FollowRequestSelect {
+	return frq.Select().Aggregate(fns...)
+}
+
+func (frq *FollowRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range frq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, frq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range frq.ctx.Fields {
+		if !followrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if frq.path != nil {
+		prev, err := frq.path(ctx)
+		if err != nil {
+			return err
+		}
+		frq.sql = prev
+	}
+	return nil
+}
+
+func (frq *FollowRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FollowRequest, error) {
+	var (
+		nodes       = []*FollowRequest{}
+		withFKs     = frq.withFKs
+		_spec       = frq.querySpec()
+		loadedTypes = [2]bool{
+			frq.withFrom != nil,
+			frq.withTo != nil,
+		}
+	)
+	if frq.withFrom != nil || frq.withTo != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, followrequest.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FollowRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FollowRequest{config: frq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, frq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := frq.withFrom; query != nil {
+		if err := frq.loadFrom(ctx, query, nodes, nil,
+			func(n *FollowRequest, e *User) { n.Edges.From = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := frq.withTo; query != nil {
+		if err := frq.loadTo(ctx, query, nodes, nil,
+			func(n *FollowRequest, e *User) { n.Edges.To = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (frq *FollowRequestQuery) loadFrom(ctx context.Context, query *UserQuery, nodes []*FollowRequest, init func(*FollowRequest), assign func(*FollowRequest, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*FollowRequest)
+	for i := range nodes {
+		if nodes[i].user_follow_requests_sent == nil {
+			continue
+		}
+		fk := *nodes[i].user_follow_requests_sent
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_follow_requests_sent" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (frq *FollowRequestQuery) loadTo(ctx context.Context, query *UserQuery, nodes []*FollowRequest, init func(*FollowRequest), assign func(*FollowRequest, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*FollowRequest)
+	for i := range nodes {
+		if nodes[i].user_follow_requests_received == nil {
+			continue
+		}
+		fk := *nodes[i].user_follow_requests_received
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_follow_requests_received" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (frq *FollowRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := frq.querySpec()
+	_spec.Node.Columns = frq.ctx.Fields
+	if len(frq.ctx.Fields) > 0 {
+		_spec.Unique = frq.ctx.Unique != nil && *frq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, frq.driver, _spec)
+}
+
+func (frq *FollowRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(followrequest.Table, followrequest.Columns, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID))
+	_spec.From = frq.sql
+	if unique := frq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if frq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := frq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, followrequest.FieldID)
+		for i := range fields {
+			if fields[i] != followrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := frq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := frq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := frq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := frq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (frq *FollowRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(frq.driver.Dialect())
+	t1 := builder.Table(followrequest.Table)
+	columns := frq.ctx.Fields
+	if len(columns) == 0 {
+		columns = followrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if frq.sql != nil {
+		selector = frq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if frq.ctx.Unique != nil && *frq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range frq.predicates {
+		p(selector)
+	}
+	for _, p := range frq.order {
+		p(selector)
+	}
+	if offset := frq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := frq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FollowRequestGroupBy is the group-by builder for FollowRequest entities.
+type FollowRequestGroupBy struct {
+	selector
+	build *FollowRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (frgb *FollowRequestGroupBy) Aggregate(fns ...AggregateFunc) *FollowRequestGroupBy {
+	frgb.fns = append(frgb.fns, fns...)
+	return frgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (frgb *FollowRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, frgb.build.ctx, ent.OpQueryGroupBy)
+	if err := frgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FollowRequestQuery, *FollowRequestGroupBy](ctx, frgb.build, frgb, frgb.build.inters, v)
+}
+
+func (frgb *FollowRequestGroupBy) sqlScan(ctx context.Context, root *FollowRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(frgb.fns))
+	for _, fn := range frgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*frgb.flds)+len(frgb.fns))
+		for _, f := range *frgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*frgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := frgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FollowRequestSelect is the builder for selecting fields of FollowRequest entities.
+type FollowRequestSelect struct {
+	*FollowRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (frs *FollowRequestSelect) Aggregate(fns ...AggregateFunc) *FollowRequestSelect {
+	frs.fns = append(frs.fns, fns...)
+	return frs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (frs *FollowRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, frs.ctx, ent.OpQuerySelect)
+	if err := frs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FollowRequestQuery, *FollowRequestSelect](ctx, frs.FollowRequestQuery, frs, frs.inters, v)
+}
+
+func (frs *FollowRequestSelect) sqlScan(ctx context.Context, root *FollowRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(frs.fns))
+	for _, fn := range frs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*frs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := frs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
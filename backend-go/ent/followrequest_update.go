@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// FollowRequestUpdate is the builder for updating FollowRequest entities.
+type FollowRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FollowRequestMutation
+}
+
+// Where appends a list predicates to the FollowRequestUpdate builder.
+func (fru *FollowRequestUpdate) Where(ps ...predicate.FollowRequest) *FollowRequestUpdate {
+	fru.mutation.Where(ps...)
+	return fru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fru *FollowRequestUpdate) SetCreatedAt(t time.Time) *FollowRequestUpdate {
+	fru.mutation.SetCreatedAt(t)
+	return fru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fru *FollowRequestUpdate) SetNillableCreatedAt(t *time.Time) *FollowRequestUpdate {
+	if t != nil {
+		fru.SetCreatedAt(*t)
+	}
+	return fru
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (fru *FollowRequestUpdate) SetFromID(id uuid.UUID) *FollowRequestUpdate {
+	fru.mutation.SetFromID(id)
+	return fru
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (fru *FollowRequestUpdate) SetFrom(u *User) *FollowRequestUpdate {
+	return fru.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (fru *FollowRequestUpdate) SetToID(id uuid.UUID) *FollowRequestUpdate {
+	fru.mutation.SetToID(id)
+	return fru
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (fru *FollowRequestUpdate) SetTo(u *User) *FollowRequestUpdate {
+	return fru.SetToID(u.ID)
+}
+
+// Mutation returns the FollowRequestMutation object of the builder.
+func (fru *FollowRequestUpdate) Mutation() *FollowRequestMutation {
+	return fru.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (fru *FollowRequestUpdate) ClearFrom() *FollowRequestUpdate {
+	fru.mutation.ClearFrom()
+	return fru
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (fru *FollowRequestUpdate) ClearTo() *FollowRequestUpdate {
+	fru.mutation.ClearTo()
+	return fru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fru *FollowRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fru.sqlSave, fru.mutation, fru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fru *FollowRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := fru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fru *FollowRequestUpdate) Exec(ctx context.Context) error {
+	_, err := fru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fru *FollowRequestUpdate) ExecX(ctx context.Context) {
+	if err := fru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fru *FollowRequestUpdate) check() error {
+	if fru.mutation.FromCleared() && len(fru.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.from"`)
+	}
+	if fru.mutation.ToCleared() && len(fru.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.to"`)
+	}
+	return nil
+}
+
+func (fru *FollowRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(followrequest.Table, followrequest.Columns, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID))
+	if ps := fru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fru.mutation.CreatedAt(); ok {
+		_spec.SetField(followrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if fru.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.FromTable,
+			Columns: []string{followrequest.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fru.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.FromTable,
+			Columns: []string{followrequest.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fru.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.ToTable,
+			Columns: []string{followrequest.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fru.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.ToTable,
+			Columns: []string{followrequest.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{followrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fru.mutation.done = true
+	return n, nil
+}
+
+// FollowRequestUpdateOne is the builder for updating a single FollowRequest entity.
+type FollowRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FollowRequestMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fruo *FollowRequestUpdateOne) SetCreatedAt(t time.Time) *FollowRequestUpdateOne {
+	fruo.mutation.SetCreatedAt(t)
+	return fruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fruo *FollowRequestUpdateOne) SetNillableCreatedAt(t *time.Time) *FollowRequestUpdateOne {
+	if t != nil {
+		fruo.SetCreatedAt(*t)
+	}
+	return fruo
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (fruo *FollowRequestUpdateOne) SetFromID(id uuid.UUID) *FollowRequestUpdateOne {
+	fruo.mutation.SetFromID(id)
+	return fruo
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (fruo *FollowRequestUpdateOne) SetFrom(u *User) *FollowRequestUpdateOne {
+	return fruo.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (fruo *FollowRequestUpdateOne) SetToID(id uuid.UUID) *FollowRequestUpdateOne {
+	fruo.mutation.SetToID(id)
+	return fruo
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (fruo *FollowRequestUpdateOne) SetTo(u *User) *FollowRequestUpdateOne {
+	return fruo.SetToID(u.ID)
+}
+
+// Mutation returns the FollowRequestMutation object of the builder.
+func (fruo *FollowRequestUpdateOne) Mutation() *FollowRequestMutation {
+	return fruo.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (fruo *FollowRequestUpdateOne) ClearFrom() *FollowRequestUpdateOne {
+	fruo.mutation.ClearFrom()
+	return fruo
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (fruo *FollowRequestUpdateOne) ClearTo() *FollowRequestUpdateOne {
+	fruo.mutation.ClearTo()
+	return fruo
+}
+
+// Where appends a list predicates to the FollowRequestUpdate builder.
+func (fruo *FollowRequestUpdateOne) Where(ps ...predicate.FollowRequest) *FollowRequestUpdateOne {
+	fruo.mutation.Where(ps...)
+	return fruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fruo *FollowRequestUpdateOne) Select(field string, fields ...string) *FollowRequestUpdateOne {
+	fruo.fields = append([]string{field}, fields...)
+	return fruo
+}
+
+// Save executes the query and returns the updated FollowRequest entity.
+func (fruo *FollowRequestUpdateOne) Save(ctx context.Context) (*FollowRequest, error) {
+	return withHooks(ctx, fruo.sqlSave, fruo.mutation, fruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fruo *FollowRequestUpdateOne) SaveX(ctx context.Context) *FollowRequest {
+	node, err := fruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fruo *FollowRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := fruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fruo *FollowRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := fruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fruo *FollowRequestUpdateOne) check() error {
+	if fruo.mutation.FromCleared() && len(fruo.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.from"`)
+	}
+	if fruo.mutation.ToCleared() && len(fruo.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FollowRequest.to"`)
+	}
+	return nil
+}
+
+func (fruo *FollowRequestUpdateOne) sqlSave(ctx context.Context) (_node *FollowRequest, err error) {
+	if err := fruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(followrequest.Table, followrequest.Columns, sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID))
+	id, ok := fruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FollowRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, followrequest.FieldID)
+		for _, f := range fields {
+			if !followrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != followrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fruo.mutation.CreatedAt(); ok {
+		_spec.SetField(followrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if fruo.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.FromTable,
+			Columns: []string{followrequest.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fruo.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.FromTable,
+			Columns: []string{followrequest.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fruo.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.ToTable,
+			Columns: []string{followrequest.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fruo.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   followrequest.ToTable,
+			Columns: []string{followrequest.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FollowRequest{config: fruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{followrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fruo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
@@ -20,6 +21,8 @@ type Like struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Reaction holds the value of the "reaction" field.
+	Reaction enum.ReactionType `json:"reaction,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -68,6 +71,8 @@ func (*Like) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case like.FieldReaction:
+			values[i] = new(sql.NullString)
 		case like.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case like.FieldID:
@@ -97,6 +102,12 @@ func (l *Like) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				l.ID = *value
 			}
+		case like.FieldReaction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reaction", values[i])
+			} else if value.Valid {
+				l.Reaction = enum.ReactionType(value.String)
+			}
 		case like.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -163,6 +174,9 @@ func (l *Like) String() string {
 	var builder strings.Builder
 	builder.WriteString("Like(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("reaction=")
+	builder.WriteString(fmt.Sprintf("%v", l.Reaction))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(l.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
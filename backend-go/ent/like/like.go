@@ -3,10 +3,12 @@
 package like
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
@@ -15,6 +17,8 @@ const (
 	Label = "like"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldReaction holds the string denoting the reaction field in the database.
+	FieldReaction = "reaction"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -42,6 +46,7 @@ const (
 // Columns holds all SQL columns for like fields.
 var Columns = []string{
 	FieldID,
+	FieldReaction,
 	FieldCreatedAt,
 }
 
@@ -74,6 +79,18 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+const DefaultReaction enum.ReactionType = "paw"
+
+// ReactionValidator is a validator for the "reaction" field enum values. It is called by the builders before save.
+func ReactionValidator(r enum.ReactionType) error {
+	switch r {
+	case "paw", "heart", "laugh", "wow":
+		return nil
+	default:
+		return fmt.Errorf("like: invalid enum value for reaction field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the Like queries.
 type OrderOption func(*sql.Selector)
 
@@ -82,6 +99,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByReaction orders the results by the reaction field.
+func ByReaction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReaction, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
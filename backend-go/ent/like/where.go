@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -61,6 +62,36 @@ func CreatedAt(v time.Time) predicate.Like {
 	return predicate.Like(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ReactionEQ applies the EQ predicate on the "reaction" field.
+func ReactionEQ(v enum.ReactionType) predicate.Like {
+	vc := v
+	return predicate.Like(sql.FieldEQ(FieldReaction, vc))
+}
+
+// ReactionNEQ applies the NEQ predicate on the "reaction" field.
+func ReactionNEQ(v enum.ReactionType) predicate.Like {
+	vc := v
+	return predicate.Like(sql.FieldNEQ(FieldReaction, vc))
+}
+
+// ReactionIn applies the In predicate on the "reaction" field.
+func ReactionIn(vs ...enum.ReactionType) predicate.Like {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Like(sql.FieldIn(FieldReaction, v...))
+}
+
+// ReactionNotIn applies the NotIn predicate on the "reaction" field.
+func ReactionNotIn(vs ...enum.ReactionType) predicate.Like {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Like(sql.FieldNotIn(FieldReaction, v...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Like {
 	return predicate.Like(sql.FieldEQ(FieldCreatedAt, v))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
@@ -26,6 +27,20 @@ type LikeCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetReaction sets the "reaction" field.
+func (lc *LikeCreate) SetReaction(et enum.ReactionType) *LikeCreate {
+	lc.mutation.SetReaction(et)
+	return lc
+}
+
+// SetNillableReaction sets the "reaction" field if the given value is not nil.
+func (lc *LikeCreate) SetNillableReaction(et *enum.ReactionType) *LikeCreate {
+	if et != nil {
+		lc.SetReaction(*et)
+	}
+	return lc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (lc *LikeCreate) SetCreatedAt(t time.Time) *LikeCreate {
 	lc.mutation.SetCreatedAt(t)
@@ -111,6 +126,10 @@ func (lc *LikeCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (lc *LikeCreate) defaults() {
+	if _, ok := lc.mutation.Reaction(); !ok {
+		v := like.DefaultReaction
+		lc.mutation.SetReaction(v)
+	}
 	if _, ok := lc.mutation.CreatedAt(); !ok {
 		v := like.DefaultCreatedAt()
 		lc.mutation.SetCreatedAt(v)
@@ -123,6 +142,14 @@ func (lc *LikeCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (lc *LikeCreate) check() error {
+	if _, ok := lc.mutation.Reaction(); !ok {
+		return &ValidationError{Name: "reaction", err: errors.New(`ent: missing required field "Like.reaction"`)}
+	}
+	if v, ok := lc.mutation.Reaction(); ok {
+		if err := like.ReactionValidator(v); err != nil {
+			return &ValidationError{Name: "reaction", err: fmt.Errorf(`ent: validator failed for field "Like.reaction": %w`, err)}
+		}
+	}
 	if _, ok := lc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Like.created_at"`)}
 	}
@@ -168,6 +195,10 @@ func (lc *LikeCreate) createSpec() (*Like, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := lc.mutation.Reaction(); ok {
+		_spec.SetField(like.FieldReaction, field.TypeEnum, value)
+		_node.Reaction = value
+	}
 	if value, ok := lc.mutation.CreatedAt(); ok {
 		_spec.SetField(like.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -213,7 +244,7 @@ func (lc *LikeCreate) createSpec() (*Like, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Like.Create().
-//		SetCreatedAt(v).
+//		SetReaction(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -222,7 +253,7 @@ func (lc *LikeCreate) createSpec() (*Like, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.LikeUpsert) {
-//			SetCreatedAt(v+v).
+//			SetReaction(v+v).
 //		}).
 //		Exec(ctx)
 func (lc *LikeCreate) OnConflict(opts ...sql.ConflictOption) *LikeUpsertOne {
@@ -258,6 +289,18 @@ type (
 	}
 )
 
+// SetReaction sets the "reaction" field.
+func (u *LikeUpsert) SetReaction(v enum.ReactionType) *LikeUpsert {
+	u.Set(like.FieldReaction, v)
+	return u
+}
+
+// UpdateReaction sets the "reaction" field to the value that was provided on create.
+func (u *LikeUpsert) UpdateReaction() *LikeUpsert {
+	u.SetExcluded(like.FieldReaction)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *LikeUpsert) SetCreatedAt(v time.Time) *LikeUpsert {
 	u.Set(like.FieldCreatedAt, v)
@@ -318,6 +361,20 @@ func (u *LikeUpsertOne) Update(set func(*LikeUpsert)) *LikeUpsertOne {
 	return u
 }
 
+// SetReaction sets the "reaction" field.
+func (u *LikeUpsertOne) SetReaction(v enum.ReactionType) *LikeUpsertOne {
+	return u.Update(func(s *LikeUpsert) {
+		s.SetReaction(v)
+	})
+}
+
+// UpdateReaction sets the "reaction" field to the value that was provided on create.
+func (u *LikeUpsertOne) UpdateReaction() *LikeUpsertOne {
+	return u.Update(func(s *LikeUpsert) {
+		s.UpdateReaction()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *LikeUpsertOne) SetCreatedAt(v time.Time) *LikeUpsertOne {
 	return u.Update(func(s *LikeUpsert) {
@@ -468,7 +525,7 @@ func (lcb *LikeCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.LikeUpsert) {
-//			SetCreatedAt(v+v).
+//			SetReaction(v+v).
 //		}).
 //		Exec(ctx)
 func (lcb *LikeCreateBulk) OnConflict(opts ...sql.ConflictOption) *LikeUpsertBulk {
@@ -547,6 +604,20 @@ func (u *LikeUpsertBulk) Update(set func(*LikeUpsert)) *LikeUpsertBulk {
 	return u
 }
 
+// SetReaction sets the "reaction" field.
+func (u *LikeUpsertBulk) SetReaction(v enum.ReactionType) *LikeUpsertBulk {
+	return u.Update(func(s *LikeUpsert) {
+		s.SetReaction(v)
+	})
+}
+
+// UpdateReaction sets the "reaction" field to the value that was provided on create.
+func (u *LikeUpsertBulk) UpdateReaction() *LikeUpsertBulk {
+	return u.Update(func(s *LikeUpsert) {
+		s.UpdateReaction()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *LikeUpsertBulk) SetCreatedAt(v time.Time) *LikeUpsertBulk {
 	return u.Update(func(s *LikeUpsert) {
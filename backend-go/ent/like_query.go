@@ -336,12 +336,12 @@ func (lq *LikeQuery) WithPost(opts ...func(*PostQuery)) *LikeQuery {
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Reaction enum.ReactionType `json:"reaction,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Like.Query().
-//		GroupBy(like.FieldCreatedAt).
+//		GroupBy(like.FieldReaction).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (lq *LikeQuery) GroupBy(field string, fields ...string) *LikeGroupBy {
@@ -359,11 +359,11 @@ func (lq *LikeQuery) GroupBy(field string, fields ...string) *LikeGroupBy {
 // Example:
 //
 //	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Reaction enum.ReactionType `json:"reaction,omitempty"`
 //	}
 //
 //	client.Like.Query().
-//		Select(like.FieldCreatedAt).
+//		Select(like.FieldReaction).
 //		Scan(ctx, &v)
 func (lq *LikeQuery) Select(fields ...string) *LikeSelect {
 	lq.ctx.Fields = append(lq.ctx.Fields, fields...)
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
@@ -31,6 +32,20 @@ func (lu *LikeUpdate) Where(ps ...predicate.Like) *LikeUpdate {
 	return lu
 }
 
+// SetReaction sets the "reaction" field.
+func (lu *LikeUpdate) SetReaction(et enum.ReactionType) *LikeUpdate {
+	lu.mutation.SetReaction(et)
+	return lu
+}
+
+// SetNillableReaction sets the "reaction" field if the given value is not nil.
+func (lu *LikeUpdate) SetNillableReaction(et *enum.ReactionType) *LikeUpdate {
+	if et != nil {
+		lu.SetReaction(*et)
+	}
+	return lu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (lu *LikeUpdate) SetCreatedAt(t time.Time) *LikeUpdate {
 	lu.mutation.SetCreatedAt(t)
@@ -113,6 +128,11 @@ func (lu *LikeUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (lu *LikeUpdate) check() error {
+	if v, ok := lu.mutation.Reaction(); ok {
+		if err := like.ReactionValidator(v); err != nil {
+			return &ValidationError{Name: "reaction", err: fmt.Errorf(`ent: validator failed for field "Like.reaction": %w`, err)}
+		}
+	}
 	if lu.mutation.UserCleared() && len(lu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Like.user"`)
 	}
@@ -134,6 +154,9 @@ func (lu *LikeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := lu.mutation.Reaction(); ok {
+		_spec.SetField(like.FieldReaction, field.TypeEnum, value)
+	}
 	if value, ok := lu.mutation.CreatedAt(); ok {
 		_spec.SetField(like.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -215,6 +238,20 @@ type LikeUpdateOne struct {
 	mutation *LikeMutation
 }
 
+// SetReaction sets the "reaction" field.
+func (luo *LikeUpdateOne) SetReaction(et enum.ReactionType) *LikeUpdateOne {
+	luo.mutation.SetReaction(et)
+	return luo
+}
+
+// SetNillableReaction sets the "reaction" field if the given value is not nil.
+func (luo *LikeUpdateOne) SetNillableReaction(et *enum.ReactionType) *LikeUpdateOne {
+	if et != nil {
+		luo.SetReaction(*et)
+	}
+	return luo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (luo *LikeUpdateOne) SetCreatedAt(t time.Time) *LikeUpdateOne {
 	luo.mutation.SetCreatedAt(t)
@@ -310,6 +347,11 @@ func (luo *LikeUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (luo *LikeUpdateOne) check() error {
+	if v, ok := luo.mutation.Reaction(); ok {
+		if err := like.ReactionValidator(v); err != nil {
+			return &ValidationError{Name: "reaction", err: fmt.Errorf(`ent: validator failed for field "Like.reaction": %w`, err)}
+		}
+	}
 	if luo.mutation.UserCleared() && len(luo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Like.user"`)
 	}
@@ -348,6 +390,9 @@ func (luo *LikeUpdateOne) sqlSave(ctx context.Context) (_node *Like, err error)
 			}
 		}
 	}
+	if value, ok := luo.mutation.Reaction(); ok {
+		_spec.SetField(like.FieldReaction, field.TypeEnum, value)
+	}
 	if value, ok := luo.mutation.CreatedAt(); ok {
 		_spec.SetField(like.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -47,6 +48,10 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "content", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "post_comments", Type: field.TypeUUID},
 		{Name: "user_comments", Type: field.TypeUUID},
 	}
@@ -56,20 +61,76 @@ var (
 		Columns:    CommentsColumns,
 		PrimaryKey: []*schema.Column{CommentsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[6]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 			{
 				Symbol:     "comments_posts_comments",
-				Columns:    []*schema.Column{CommentsColumns[3]},
+				Columns:    []*schema.Column{CommentsColumns[7]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "comments_users_comments",
-				Columns:    []*schema.Column{CommentsColumns[4]},
+				Columns:    []*schema.Column{CommentsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// CommentLikesColumns holds the columns for the "comment_likes" table.
+	CommentLikesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "comment_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// CommentLikesTable holds the schema information for the "comment_likes" table.
+	CommentLikesTable = &schema.Table{
+		Name:       "comment_likes",
+		Columns:    CommentLikesColumns,
+		PrimaryKey: []*schema.Column{CommentLikesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comment_likes_comments_likes",
+				Columns:    []*schema.Column{CommentLikesColumns[2]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comment_likes_users_comment_likes",
+				Columns:    []*schema.Column{CommentLikesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commentlike_comment_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{CommentLikesColumns[2], CommentLikesColumns[3]},
+			},
+		},
+	}
+	// CredentialsColumns holds the columns for the "credentials" table.
+	CredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "token_version", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CredentialsTable holds the schema information for the "credentials" table.
+	CredentialsTable = &schema.Table{
+		Name:       "credentials",
+		Columns:    CredentialsColumns,
+		PrimaryKey: []*schema.Column{CredentialsColumns[0]},
+	}
 	// DailyTasksColumns holds the columns for the "daily_tasks" table.
 	DailyTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -111,6 +172,17 @@ var (
 			},
 		},
 	}
+	// DataMigrationsColumns holds the columns for the "data_migrations" table.
+	DataMigrationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "applied_at", Type: field.TypeTime},
+	}
+	// DataMigrationsTable holds the schema information for the "data_migrations" table.
+	DataMigrationsTable = &schema.Table{
+		Name:       "data_migrations",
+		Columns:    DataMigrationsColumns,
+		PrimaryKey: []*schema.Column{DataMigrationsColumns[0]},
+	}
 	// DeviceTokensColumns holds the columns for the "device_tokens" table.
 	DeviceTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -142,6 +214,35 @@ var (
 			},
 		},
 	}
+	// ExploreRankingsColumns holds the columns for the "explore_rankings" table.
+	ExploreRankingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "score", Type: field.TypeFloat64},
+		{Name: "computed_at", Type: field.TypeTime},
+		{Name: "post_explore_ranking", Type: field.TypeUUID, Unique: true},
+	}
+	// ExploreRankingsTable holds the schema information for the "explore_rankings" table.
+	ExploreRankingsTable = &schema.Table{
+		Name:       "explore_rankings",
+		Columns:    ExploreRankingsColumns,
+		PrimaryKey: []*schema.Column{ExploreRankingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "explore_rankings_posts_explore_ranking",
+				Columns:    []*schema.Column{ExploreRankingsColumns[4]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exploreranking_position",
+				Unique:  false,
+				Columns: []*schema.Column{ExploreRankingsColumns[1]},
+			},
+		},
+	}
 	// FollowRelationsColumns holds the columns for the "follow_relations" table.
 	FollowRelationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -176,9 +277,44 @@ var (
 			},
 		},
 	}
+	// FollowRequestsColumns holds the columns for the "follow_requests" table.
+	FollowRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_follow_requests_sent", Type: field.TypeUUID},
+		{Name: "user_follow_requests_received", Type: field.TypeUUID},
+	}
+	// FollowRequestsTable holds the schema information for the "follow_requests" table.
+	FollowRequestsTable = &schema.Table{
+		Name:       "follow_requests",
+		Columns:    FollowRequestsColumns,
+		PrimaryKey: []*schema.Column{FollowRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "follow_requests_users_follow_requests_sent",
+				Columns:    []*schema.Column{FollowRequestsColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "follow_requests_users_follow_requests_received",
+				Columns:    []*schema.Column{FollowRequestsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "followrequest_user_follow_requests_sent_user_follow_requests_received",
+				Unique:  true,
+				Columns: []*schema.Column{FollowRequestsColumns[2], FollowRequestsColumns[3]},
+			},
+		},
+	}
 	// LikesColumns holds the columns for the "likes" table.
 	LikesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "reaction", Type: field.TypeEnum, Enums: []string{"paw", "heart", "laugh", "wow"}, Default: "paw"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "post_likes", Type: field.TypeUUID},
 		{Name: "user_likes", Type: field.TypeUUID},
@@ -191,13 +327,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "likes_posts_likes",
-				Columns:    []*schema.Column{LikesColumns[2]},
+				Columns:    []*schema.Column{LikesColumns[3]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "likes_users_likes",
-				Columns:    []*schema.Column{LikesColumns[3]},
+				Columns:    []*schema.Column{LikesColumns[4]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -206,7 +342,119 @@ var (
 			{
 				Name:    "like_user_likes_post_likes",
 				Unique:  true,
-				Columns: []*schema.Column{LikesColumns[3], LikesColumns[2]},
+				Columns: []*schema.Column{LikesColumns[4], LikesColumns[3]},
+			},
+		},
+	}
+	// MuteRelationsColumns holds the columns for the "mute_relations" table.
+	MuteRelationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_muting", Type: field.TypeUUID},
+		{Name: "user_muted_by", Type: field.TypeUUID},
+	}
+	// MuteRelationsTable holds the schema information for the "mute_relations" table.
+	MuteRelationsTable = &schema.Table{
+		Name:       "mute_relations",
+		Columns:    MuteRelationsColumns,
+		PrimaryKey: []*schema.Column{MuteRelationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mute_relations_users_muting",
+				Columns:    []*schema.Column{MuteRelationsColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "mute_relations_users_muted_by",
+				Columns:    []*schema.Column{MuteRelationsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "muterelation_user_muting_user_muted_by",
+				Unique:  true,
+				Columns: []*schema.Column{MuteRelationsColumns[2], MuteRelationsColumns[3]},
+			},
+		},
+	}
+	// MutedKeywordsColumns holds the columns for the "muted_keywords" table.
+	MutedKeywordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "keyword", Type: field.TypeString, Size: 200},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// MutedKeywordsTable holds the schema information for the "muted_keywords" table.
+	MutedKeywordsTable = &schema.Table{
+		Name:       "muted_keywords",
+		Columns:    MutedKeywordsColumns,
+		PrimaryKey: []*schema.Column{MutedKeywordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "muted_keywords_users_muted_keywords",
+				Columns:    []*schema.Column{MutedKeywordsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mutedkeyword_user_id_keyword",
+				Unique:  true,
+				Columns: []*schema.Column{MutedKeywordsColumns[3], MutedKeywordsColumns[1]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"mention"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "comment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "post_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "recipient_id", Type: field.TypeUUID},
+		{Name: "actor_id", Type: field.TypeUUID},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_comments_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[4]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifications_posts_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[5]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifications_users_notifications_sent",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_recipient_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[6], NotificationsColumns[2]},
 			},
 		},
 	}
@@ -242,8 +490,13 @@ var (
 		{Name: "index", Type: field.TypeUint32, Unique: true, Nullable: true},
 		{Name: "caption", Type: field.TypeString},
 		{Name: "image_key", Type: field.TypeString},
+		{Name: "media_type", Type: field.TypeEnum, Enums: []string{"image", "video"}, Default: "image"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "hidden_at", Type: field.TypeTime, Nullable: true},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "like_count", Type: field.TypeInt, Default: 0},
+		{Name: "comment_count", Type: field.TypeInt, Default: 0},
 		{Name: "user_posts", Type: field.TypeUUID},
 	}
 	// PostsTable holds the schema information for the "posts" table.
@@ -254,22 +507,305 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[6]},
+				Columns:    []*schema.Column{PostsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// PostMediaColumns holds the columns for the "post_media" table.
+	PostMediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"image", "video"}, Default: "image"},
+		{Name: "key", Type: field.TypeString},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "alt_text", Type: field.TypeString, Default: ""},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "poster_key", Type: field.TypeString, Nullable: true},
+		{Name: "post_media", Type: field.TypeUUID},
+	}
+	// PostMediaTable holds the schema information for the "post_media" table.
+	PostMediaTable = &schema.Table{
+		Name:       "post_media",
+		Columns:    PostMediaColumns,
+		PrimaryKey: []*schema.Column{PostMediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_media_posts_media",
+				Columns:    []*schema.Column{PostMediaColumns[9]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postmedia_position_post_media",
+				Unique:  true,
+				Columns: []*schema.Column{PostMediaColumns[1], PostMediaColumns[9]},
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "caption", Type: field.TypeString},
+		{Name: "pet_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_revisions", Type: field.TypeUUID},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[4]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_created_at_post_revisions",
+				Unique:  false,
+				Columns: []*schema.Column{PostRevisionsColumns[3], PostRevisionsColumns[4]},
+			},
+		},
+	}
+	// PostTagsColumns holds the columns for the "post_tags" table.
+	PostTagsColumns = []*schema.Column{
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeUUID},
+	}
+	// PostTagsTable holds the schema information for the "post_tags" table.
+	PostTagsTable = &schema.Table{
+		Name:       "post_tags",
+		Columns:    PostTagsColumns,
+		PrimaryKey: []*schema.Column{PostTagsColumns[1], PostTagsColumns[2]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_tags_posts_post",
+				Columns:    []*schema.Column{PostTagsColumns[1]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "post_tags_tags_tag",
+				Columns:    []*schema.Column{PostTagsColumns[2]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "posttag_created_at_tag_id",
+				Unique:  false,
+				Columns: []*schema.Column{PostTagsColumns[0], PostTagsColumns[2]},
+			},
+		},
+	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "target_type", Type: field.TypeEnum, Enums: []string{"post", "comment", "user"}},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"spam", "harassment", "inappropriate", "animal_abuse", "impersonation", "other"}},
+		{Name: "details", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "actioned", "dismissed"}, Default: "open"},
+		{Name: "resolution_note", Type: field.TypeString, Size: 1000, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "comment_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "post_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reporter_id", Type: field.TypeUUID},
+		{Name: "target_user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "resolver_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reports_comments_reports",
+				Columns:    []*schema.Column{ReportsColumns[8]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reports_posts_reports",
+				Columns:    []*schema.Column{ReportsColumns[9]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reports_users_reports_filed",
+				Columns:    []*schema.Column{ReportsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reports_users_reports_received",
+				Columns:    []*schema.Column{ReportsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reports_users_reports_resolved",
+				Columns:    []*schema.Column{ReportsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[4], ReportsColumns[6]},
+			},
+			{
+				Name:    "report_reporter_id_target_type",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[10], ReportsColumns[1]},
+			},
+			{
+				Name:    "report_reporter_id_target_type_post_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReportsColumns[10], ReportsColumns[1], ReportsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'open'",
+				},
+			},
+			{
+				Name:    "report_reporter_id_target_type_comment_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReportsColumns[10], ReportsColumns[1], ReportsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'open'",
+				},
+			},
+			{
+				Name:    "report_reporter_id_target_type_target_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReportsColumns[10], ReportsColumns[1], ReportsColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'open'",
+				},
+			},
+		},
+	}
+	// SuspensionsColumns holds the columns for the "suspensions" table.
+	SuspensionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "reason", Type: field.TypeString, Size: 1000},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "lifted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "issued_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// SuspensionsTable holds the schema information for the "suspensions" table.
+	SuspensionsTable = &schema.Table{
+		Name:       "suspensions",
+		Columns:    SuspensionsColumns,
+		PrimaryKey: []*schema.Column{SuspensionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "suspensions_users_suspensions",
+				Columns:    []*schema.Column{SuspensionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "suspensions_users_suspensions_issued",
+				Columns:    []*schema.Column{SuspensionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "suspension_user_id_ends_at",
+				Unique:  false,
+				Columns: []*schema.Column{SuspensionsColumns[6], SuspensionsColumns[3]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+	}
+	// TimelineCachesColumns holds the columns for the "timeline_caches" table.
+	TimelineCachesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+		{Name: "post_ids", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TimelineCachesTable holds the schema information for the "timeline_caches" table.
+	TimelineCachesTable = &schema.Table{
+		Name:       "timeline_caches",
+		Columns:    TimelineCachesColumns,
+		PrimaryKey: []*schema.Column{TimelineCachesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "timelinecache_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{TimelineCachesColumns[3]},
+			},
+		},
+	}
+	// TokenRevocationsColumns holds the columns for the "token_revocations" table.
+	TokenRevocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "revoked_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// TokenRevocationsTable holds the schema information for the "token_revocations" table.
+	TokenRevocationsTable = &schema.Table{
+		Name:       "token_revocations",
+		Columns:    TokenRevocationsColumns,
+		PrimaryKey: []*schema.Column{TokenRevocationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tokenrevocation_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{TokenRevocationsColumns[2]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "index", Type: field.TypeUint32, Unique: true, Nullable: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "auth_subject", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "handle", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "bio", Type: field.TypeString, Default: ""},
 		{Name: "streak_count", Type: field.TypeUint32, Default: 0},
+		{Name: "role", Type: field.TypeString, Default: "user"},
 		{Name: "icon_image_key", Type: field.TypeString, Nullable: true},
+		{Name: "is_private", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "follower_count", Type: field.TypeInt, Default: 0},
+		{Name: "following_count", Type: field.TypeInt, Default: 0},
+		{Name: "post_count", Type: field.TypeInt, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -277,32 +813,180 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// VerificationCodesColumns holds the columns for the "verification_codes" table.
+	VerificationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"sign_up", "reset_password"}},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VerificationCodesTable holds the schema information for the "verification_codes" table.
+	VerificationCodesTable = &schema.Table{
+		Name:       "verification_codes",
+		Columns:    VerificationCodesColumns,
+		PrimaryKey: []*schema.Column{VerificationCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "verificationcode_email_purpose",
+				Unique:  true,
+				Columns: []*schema.Column{VerificationCodesColumns[1], VerificationCodesColumns[2]},
+			},
+		},
+	}
+	// CommentMentionsColumns holds the columns for the "comment_mentions" table.
+	CommentMentionsColumns = []*schema.Column{
+		{Name: "comment_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// CommentMentionsTable holds the schema information for the "comment_mentions" table.
+	CommentMentionsTable = &schema.Table{
+		Name:       "comment_mentions",
+		Columns:    CommentMentionsColumns,
+		PrimaryKey: []*schema.Column{CommentMentionsColumns[0], CommentMentionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comment_mentions_comment_id",
+				Columns:    []*schema.Column{CommentMentionsColumns[0]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comment_mentions_user_id",
+				Columns:    []*schema.Column{CommentMentionsColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PostPetsColumns holds the columns for the "post_pets" table.
+	PostPetsColumns = []*schema.Column{
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "pet_id", Type: field.TypeUUID},
+	}
+	// PostPetsTable holds the schema information for the "post_pets" table.
+	PostPetsTable = &schema.Table{
+		Name:       "post_pets",
+		Columns:    PostPetsColumns,
+		PrimaryKey: []*schema.Column{PostPetsColumns[0], PostPetsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_pets_post_id",
+				Columns:    []*schema.Column{PostPetsColumns[0]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "post_pets_pet_id",
+				Columns:    []*schema.Column{PostPetsColumns[1]},
+				RefColumns: []*schema.Column{PetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PostMentionsColumns holds the columns for the "post_mentions" table.
+	PostMentionsColumns = []*schema.Column{
+		{Name: "post_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// PostMentionsTable holds the schema information for the "post_mentions" table.
+	PostMentionsTable = &schema.Table{
+		Name:       "post_mentions",
+		Columns:    PostMentionsColumns,
+		PrimaryKey: []*schema.Column{PostMentionsColumns[0], PostMentionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_mentions_post_id",
+				Columns:    []*schema.Column{PostMentionsColumns[0]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "post_mentions_user_id",
+				Columns:    []*schema.Column{PostMentionsColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		BlockRelationsTable,
 		CommentsTable,
+		CommentLikesTable,
+		CredentialsTable,
 		DailyTasksTable,
+		DataMigrationsTable,
 		DeviceTokensTable,
+		ExploreRankingsTable,
 		FollowRelationsTable,
+		FollowRequestsTable,
 		LikesTable,
+		MuteRelationsTable,
+		MutedKeywordsTable,
+		NotificationsTable,
 		PetsTable,
 		PostsTable,
+		PostMediaTable,
+		PostRevisionsTable,
+		PostTagsTable,
+		ReportsTable,
+		SuspensionsTable,
+		TagsTable,
+		TimelineCachesTable,
+		TokenRevocationsTable,
 		UsersTable,
+		VerificationCodesTable,
+		CommentMentionsTable,
+		PostPetsTable,
+		PostMentionsTable,
 	}
 )
 
 func init() {
 	BlockRelationsTable.ForeignKeys[0].RefTable = UsersTable
 	BlockRelationsTable.ForeignKeys[1].RefTable = UsersTable
-	CommentsTable.ForeignKeys[0].RefTable = PostsTable
-	CommentsTable.ForeignKeys[1].RefTable = UsersTable
+	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[1].RefTable = PostsTable
+	CommentsTable.ForeignKeys[2].RefTable = UsersTable
+	CommentLikesTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentLikesTable.ForeignKeys[1].RefTable = UsersTable
 	DailyTasksTable.ForeignKeys[0].RefTable = PostsTable
 	DailyTasksTable.ForeignKeys[1].RefTable = UsersTable
 	DeviceTokensTable.ForeignKeys[0].RefTable = UsersTable
+	ExploreRankingsTable.ForeignKeys[0].RefTable = PostsTable
 	FollowRelationsTable.ForeignKeys[0].RefTable = UsersTable
 	FollowRelationsTable.ForeignKeys[1].RefTable = UsersTable
+	FollowRequestsTable.ForeignKeys[0].RefTable = UsersTable
+	FollowRequestsTable.ForeignKeys[1].RefTable = UsersTable
 	LikesTable.ForeignKeys[0].RefTable = PostsTable
 	LikesTable.ForeignKeys[1].RefTable = UsersTable
+	MuteRelationsTable.ForeignKeys[0].RefTable = UsersTable
+	MuteRelationsTable.ForeignKeys[1].RefTable = UsersTable
+	MutedKeywordsTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[0].RefTable = CommentsTable
+	NotificationsTable.ForeignKeys[1].RefTable = PostsTable
+	NotificationsTable.ForeignKeys[2].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[3].RefTable = UsersTable
 	PetsTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
+	PostMediaTable.ForeignKeys[0].RefTable = PostsTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = PostsTable
+	PostTagsTable.ForeignKeys[0].RefTable = PostsTable
+	PostTagsTable.ForeignKeys[1].RefTable = TagsTable
+	ReportsTable.ForeignKeys[0].RefTable = CommentsTable
+	ReportsTable.ForeignKeys[1].RefTable = PostsTable
+	ReportsTable.ForeignKeys[2].RefTable = UsersTable
+	ReportsTable.ForeignKeys[3].RefTable = UsersTable
+	ReportsTable.ForeignKeys[4].RefTable = UsersTable
+	SuspensionsTable.ForeignKeys[0].RefTable = UsersTable
+	SuspensionsTable.ForeignKeys[1].RefTable = UsersTable
+	CommentMentionsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentMentionsTable.ForeignKeys[1].RefTable = UsersTable
+	PostPetsTable.ForeignKeys[0].RefTable = PostsTable
+	PostPetsTable.ForeignKeys[1].RefTable = PetsTable
+	PostMentionsTable.ForeignKeys[0].RefTable = PostsTable
+	PostMentionsTable.ForeignKeys[1].RefTable = UsersTable
 }
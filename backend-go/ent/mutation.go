@@ -13,15 +13,32 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
 	"github.com/aki-13627/animalia/backend-go/ent/devicetoken"
 	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
 	"github.com/google/uuid"
 )
 
@@ -34,15 +51,32 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBlockRelation  = "BlockRelation"
-	TypeComment        = "Comment"
-	TypeDailyTask      = "DailyTask"
-	TypeDeviceToken    = "DeviceToken"
-	TypeFollowRelation = "FollowRelation"
-	TypeLike           = "Like"
-	TypePet            = "Pet"
-	TypePost           = "Post"
-	TypeUser           = "User"
+	TypeBlockRelation    = "BlockRelation"
+	TypeComment          = "Comment"
+	TypeCommentLike      = "CommentLike"
+	TypeCredential       = "Credential"
+	TypeDailyTask        = "DailyTask"
+	TypeDataMigration    = "DataMigration"
+	TypeDeviceToken      = "DeviceToken"
+	TypeExploreRanking   = "ExploreRanking"
+	TypeFollowRelation   = "FollowRelation"
+	TypeFollowRequest    = "FollowRequest"
+	TypeLike             = "Like"
+	TypeMuteRelation     = "MuteRelation"
+	TypeMutedKeyword     = "MutedKeyword"
+	TypeNotification     = "Notification"
+	TypePet              = "Pet"
+	TypePost             = "Post"
+	TypePostMedia        = "PostMedia"
+	TypePostRevision     = "PostRevision"
+	TypePostTag          = "PostTag"
+	TypeReport           = "Report"
+	TypeSuspension       = "Suspension"
+	TypeTag              = "Tag"
+	TypeTimelineCache    = "TimelineCache"
+	TypeTokenRevocation  = "TokenRevocation"
+	TypeUser             = "User"
+	TypeVerificationCode = "VerificationCode"
 )
 
 // BlockRelationMutation represents an operation that mutates the BlockRelation nodes in the graph.
@@ -506,19 +540,39 @@ func (m *BlockRelationMutation) ResetEdge(name string) error {
 // CommentMutation represents an operation that mutates the Comment nodes in the graph.
 type CommentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	content       *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	post          *uuid.UUID
-	clearedpost   bool
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*Comment, error)
-	predicates    []predicate.Comment
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	content              *string
+	created_at           *time.Time
+	edited_at            *time.Time
+	deleted_at           *time.Time
+	hidden_at            *time.Time
+	clearedFields        map[string]struct{}
+	post                 *uuid.UUID
+	clearedpost          bool
+	user                 *uuid.UUID
+	cleareduser          bool
+	reports              map[uuid.UUID]struct{}
+	removedreports       map[uuid.UUID]struct{}
+	clearedreports       bool
+	mentions             map[uuid.UUID]struct{}
+	removedmentions      map[uuid.UUID]struct{}
+	clearedmentions      bool
+	notifications        map[uuid.UUID]struct{}
+	removednotifications map[uuid.UUID]struct{}
+	clearednotifications bool
+	parent               *uuid.UUID
+	clearedparent        bool
+	replies              map[uuid.UUID]struct{}
+	removedreplies       map[uuid.UUID]struct{}
+	clearedreplies       bool
+	likes                map[uuid.UUID]struct{}
+	removedlikes         map[uuid.UUID]struct{}
+	clearedlikes         bool
+	done                 bool
+	oldValue             func(context.Context) (*Comment, error)
+	predicates           []predicate.Comment
 }
 
 var _ ent.Mutation = (*CommentMutation)(nil)
@@ -661,6 +715,55 @@ func (m *CommentMutation) ResetContent() {
 	m.content = nil
 }
 
+// SetParentID sets the "parent_id" field.
+func (m *CommentMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CommentMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CommentMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[comment.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CommentMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CommentMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, comment.FieldParentID)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *CommentMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -697,6 +800,153 @@ func (m *CommentMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (m *CommentMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *CommentMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *CommentMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[comment.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *CommentMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *CommentMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, comment.FieldEditedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CommentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CommentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CommentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[comment.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CommentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CommentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, comment.FieldDeletedAt)
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (m *CommentMutation) SetHiddenAt(t time.Time) {
+	m.hidden_at = &t
+}
+
+// HiddenAt returns the value of the "hidden_at" field in the mutation.
+func (m *CommentMutation) HiddenAt() (r time.Time, exists bool) {
+	v := m.hidden_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHiddenAt returns the old "hidden_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldHiddenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHiddenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHiddenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHiddenAt: %w", err)
+	}
+	return oldValue.HiddenAt, nil
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (m *CommentMutation) ClearHiddenAt() {
+	m.hidden_at = nil
+	m.clearedFields[comment.FieldHiddenAt] = struct{}{}
+}
+
+// HiddenAtCleared returns if the "hidden_at" field was cleared in this mutation.
+func (m *CommentMutation) HiddenAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldHiddenAt]
+	return ok
+}
+
+// ResetHiddenAt resets all changes to the "hidden_at" field.
+func (m *CommentMutation) ResetHiddenAt() {
+	m.hidden_at = nil
+	delete(m.clearedFields, comment.FieldHiddenAt)
+}
+
 // SetPostID sets the "post" edge to the Post entity by id.
 func (m *CommentMutation) SetPostID(id uuid.UUID) {
 	m.post = &id
@@ -775,564 +1025,312 @@ func (m *CommentMutation) ResetUser() {
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the CommentMutation builder.
-func (m *CommentMutation) Where(ps ...predicate.Comment) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Comment, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddReportIDs adds the "reports" edge to the Report entity by ids.
+func (m *CommentMutation) AddReportIDs(ids ...uuid.UUID) {
+	if m.reports == nil {
+		m.reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *CommentMutation) Op() Op {
-	return m.op
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CommentMutation) SetOp(op Op) {
-	m.op = op
+// ClearReports clears the "reports" edge to the Report entity.
+func (m *CommentMutation) ClearReports() {
+	m.clearedreports = true
 }
 
-// Type returns the node type of this mutation (Comment).
-func (m *CommentMutation) Type() string {
-	return m.typ
+// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
+func (m *CommentMutation) ReportsCleared() bool {
+	return m.clearedreports
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.content != nil {
-		fields = append(fields, comment.FieldContent)
+// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
+func (m *CommentMutation) RemoveReportIDs(ids ...uuid.UUID) {
+	if m.removedreports == nil {
+		m.removedreports = make(map[uuid.UUID]struct{})
 	}
-	if m.created_at != nil {
-		fields = append(fields, comment.FieldCreatedAt)
+	for i := range ids {
+		delete(m.reports, ids[i])
+		m.removedreports[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CommentMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case comment.FieldContent:
-		return m.Content()
-	case comment.FieldCreatedAt:
-		return m.CreatedAt()
+// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
+func (m *CommentMutation) RemovedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case comment.FieldContent:
-		return m.OldContent(ctx)
-	case comment.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// ReportsIDs returns the "reports" edge IDs in the mutation.
+func (m *CommentMutation) ReportsIDs() (ids []uuid.UUID) {
+	for id := range m.reports {
+		ids = append(ids, id)
 	}
-	return nil, fmt.Errorf("unknown Comment field %s", name)
+	return
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CommentMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case comment.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case comment.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// ResetReports resets all changes to the "reports" edge.
+func (m *CommentMutation) ResetReports() {
+	m.reports = nil
+	m.clearedreports = false
+	m.removedreports = nil
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by ids.
+func (m *CommentMutation) AddMentionIDs(ids ...uuid.UUID) {
+	if m.mentions == nil {
+		m.mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.mentions[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CommentMutation) AddedFields() []string {
-	return nil
+// ClearMentions clears the "mentions" edge to the User entity.
+func (m *CommentMutation) ClearMentions() {
+	m.clearedmentions = true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// MentionsCleared reports if the "mentions" edge to the User entity was cleared.
+func (m *CommentMutation) MentionsCleared() bool {
+	return m.clearedmentions
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CommentMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// RemoveMentionIDs removes the "mentions" edge to the User entity by IDs.
+func (m *CommentMutation) RemoveMentionIDs(ids ...uuid.UUID) {
+	if m.removedmentions == nil {
+		m.removedmentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentions, ids[i])
+		m.removedmentions[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Comment numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CommentMutation) ClearedFields() []string {
-	return nil
+// RemovedMentions returns the removed IDs of the "mentions" edge to the User entity.
+func (m *CommentMutation) RemovedMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CommentMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// MentionsIDs returns the "mentions" edge IDs in the mutation.
+func (m *CommentMutation) MentionsIDs() (ids []uuid.UUID) {
+	for id := range m.mentions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CommentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Comment nullable field %s", name)
+// ResetMentions resets all changes to the "mentions" edge.
+func (m *CommentMutation) ResetMentions() {
+	m.mentions = nil
+	m.clearedmentions = false
+	m.removedmentions = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CommentMutation) ResetField(name string) error {
-	switch name {
-	case comment.FieldContent:
-		m.ResetContent()
-		return nil
-	case comment.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Comment field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.post != nil {
-		edges = append(edges, comment.EdgePost)
-	}
-	if m.user != nil {
-		edges = append(edges, comment.EdgeUser)
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *CommentMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CommentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case comment.EdgePost:
-		if id := m.post; id != nil {
-			return []ent.Value{*id}
-		}
-	case comment.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *CommentMutation) ClearNotifications() {
+	m.clearednotifications = true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *CommentMutation) NotificationsCleared() bool {
+	return m.clearednotifications
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedpost {
-		edges = append(edges, comment.EdgePost)
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *CommentMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
 	}
-	if m.cleareduser {
-		edges = append(edges, comment.EdgeUser)
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CommentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case comment.EdgePost:
-		return m.clearedpost
-	case comment.EdgeUser:
-		return m.cleareduser
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *CommentMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CommentMutation) ClearEdge(name string) error {
-	switch name {
-	case comment.EdgePost:
-		m.ClearPost()
-		return nil
-	case comment.EdgeUser:
-		m.ClearUser()
-		return nil
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *CommentMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Comment unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CommentMutation) ResetEdge(name string) error {
-	switch name {
-	case comment.EdgePost:
-		m.ResetPost()
-		return nil
-	case comment.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Comment edge %s", name)
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *CommentMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
 }
 
-// DailyTaskMutation represents an operation that mutates the DailyTask nodes in the graph.
-type DailyTaskMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	target_date   *time.Time
-	_type         *enum.TaskType
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	post          *uuid.UUID
-	clearedpost   bool
-	done          bool
-	oldValue      func(context.Context) (*DailyTask, error)
-	predicates    []predicate.DailyTask
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[comment.FieldParentID] = struct{}{}
 }
 
-var _ ent.Mutation = (*DailyTaskMutation)(nil)
-
-// dailytaskOption allows management of the mutation configuration using functional options.
-type dailytaskOption func(*DailyTaskMutation)
-
-// newDailyTaskMutation creates new mutation for the DailyTask entity.
-func newDailyTaskMutation(c config, op Op, opts ...dailytaskOption) *DailyTaskMutation {
-	m := &DailyTaskMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeDailyTask,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
 }
 
-// withDailyTaskID sets the ID field of the mutation.
-func withDailyTaskID(id uuid.UUID) dailytaskOption {
-	return func(m *DailyTaskMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *DailyTask
-		)
-		m.oldValue = func(ctx context.Context) (*DailyTask, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().DailyTask.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// withDailyTask sets the old DailyTask of the mutation.
-func withDailyTask(node *DailyTask) dailytaskOption {
-	return func(m *DailyTaskMutation) {
-		m.oldValue = func(context.Context) (*DailyTask, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DailyTaskMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddReplyIDs adds the "replies" edge to the Comment entity by ids.
+func (m *CommentMutation) AddReplyIDs(ids ...uuid.UUID) {
+	if m.replies == nil {
+		m.replies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m DailyTaskMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearReplies clears the "replies" edge to the Comment entity.
+func (m *CommentMutation) ClearReplies() {
+	m.clearedreplies = true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DailyTask entities.
-func (m *DailyTaskMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// RepliesCleared reports if the "replies" edge to the Comment entity was cleared.
+func (m *CommentMutation) RepliesCleared() bool {
+	return m.clearedreplies
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *DailyTaskMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// RemoveReplyIDs removes the "replies" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveReplyIDs(ids ...uuid.UUID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *DailyTaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DailyTask.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemovedReplies returns the removed IDs of the "replies" edge to the Comment entity.
+func (m *CommentMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DailyTaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *CommentMutation) RepliesIDs() (ids []uuid.UUID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DailyTaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetReplies resets all changes to the "replies" edge.
+func (m *CommentMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DailyTask entity.
-// If the DailyTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// AddLikeIDs adds the "likes" edge to the CommentLike entity by ids.
+func (m *CommentMutation) AddLikeIDs(ids ...uuid.UUID) {
+	if m.likes == nil {
+		m.likes = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.likes[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DailyTaskMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearLikes clears the "likes" edge to the CommentLike entity.
+func (m *CommentMutation) ClearLikes() {
+	m.clearedlikes = true
 }
 
-// SetTargetDate sets the "target_date" field.
-func (m *DailyTaskMutation) SetTargetDate(t time.Time) {
-	m.target_date = &t
+// LikesCleared reports if the "likes" edge to the CommentLike entity was cleared.
+func (m *CommentMutation) LikesCleared() bool {
+	return m.clearedlikes
 }
 
-// TargetDate returns the value of the "target_date" field in the mutation.
-func (m *DailyTaskMutation) TargetDate() (r time.Time, exists bool) {
-	v := m.target_date
-	if v == nil {
-		return
+// RemoveLikeIDs removes the "likes" edge to the CommentLike entity by IDs.
+func (m *CommentMutation) RemoveLikeIDs(ids ...uuid.UUID) {
+	if m.removedlikes == nil {
+		m.removedlikes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.likes, ids[i])
+		m.removedlikes[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTargetDate returns the old "target_date" field's value of the DailyTask entity.
-// If the DailyTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyTaskMutation) OldTargetDate(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetDate: %w", err)
-	}
-	return oldValue.TargetDate, nil
-}
-
-// ResetTargetDate resets all changes to the "target_date" field.
-func (m *DailyTaskMutation) ResetTargetDate() {
-	m.target_date = nil
-}
-
-// SetType sets the "type" field.
-func (m *DailyTaskMutation) SetType(et enum.TaskType) {
-	m._type = &et
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *DailyTaskMutation) GetType() (r enum.TaskType, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the DailyTask entity.
-// If the DailyTask object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyTaskMutation) OldType(ctx context.Context) (v enum.TaskType, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *DailyTaskMutation) ResetType() {
-	m._type = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *DailyTaskMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *DailyTaskMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *DailyTaskMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *DailyTaskMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *DailyTaskMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *DailyTaskMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// SetPostID sets the "post" edge to the Post entity by id.
-func (m *DailyTaskMutation) SetPostID(id uuid.UUID) {
-	m.post = &id
-}
-
-// ClearPost clears the "post" edge to the Post entity.
-func (m *DailyTaskMutation) ClearPost() {
-	m.clearedpost = true
-}
-
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *DailyTaskMutation) PostCleared() bool {
-	return m.clearedpost
-}
-
-// PostID returns the "post" edge ID in the mutation.
-func (m *DailyTaskMutation) PostID() (id uuid.UUID, exists bool) {
-	if m.post != nil {
-		return *m.post, true
+// RemovedLikes returns the removed IDs of the "likes" edge to the CommentLike entity.
+func (m *CommentMutation) RemovedLikesIDs() (ids []uuid.UUID) {
+	for id := range m.removedlikes {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *DailyTaskMutation) PostIDs() (ids []uuid.UUID) {
-	if id := m.post; id != nil {
-		ids = append(ids, *id)
+// LikesIDs returns the "likes" edge IDs in the mutation.
+func (m *CommentMutation) LikesIDs() (ids []uuid.UUID) {
+	for id := range m.likes {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *DailyTaskMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
+// ResetLikes resets all changes to the "likes" edge.
+func (m *CommentMutation) ResetLikes() {
+	m.likes = nil
+	m.clearedlikes = false
+	m.removedlikes = nil
 }
 
-// Where appends a list predicates to the DailyTaskMutation builder.
-func (m *DailyTaskMutation) Where(ps ...predicate.DailyTask) {
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DailyTaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DailyTaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DailyTask, len(ps))
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1340,33 +1338,42 @@ func (m *DailyTaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DailyTaskMutation) Op() Op {
+func (m *CommentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DailyTaskMutation) SetOp(op Op) {
+func (m *CommentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DailyTask).
-func (m *DailyTaskMutation) Type() string {
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DailyTaskMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.content != nil {
+		fields = append(fields, comment.FieldContent)
+	}
+	if m.parent != nil {
+		fields = append(fields, comment.FieldParentID)
+	}
 	if m.created_at != nil {
-		fields = append(fields, dailytask.FieldCreatedAt)
+		fields = append(fields, comment.FieldCreatedAt)
 	}
-	if m.target_date != nil {
-		fields = append(fields, dailytask.FieldTargetDate)
+	if m.edited_at != nil {
+		fields = append(fields, comment.FieldEditedAt)
 	}
-	if m._type != nil {
-		fields = append(fields, dailytask.FieldType)
+	if m.deleted_at != nil {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	if m.hidden_at != nil {
+		fields = append(fields, comment.FieldHiddenAt)
 	}
 	return fields
 }
@@ -1374,14 +1381,20 @@ func (m *DailyTaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DailyTaskMutation) Field(name string) (ent.Value, bool) {
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dailytask.FieldCreatedAt:
+	case comment.FieldContent:
+		return m.Content()
+	case comment.FieldParentID:
+		return m.ParentID()
+	case comment.FieldCreatedAt:
 		return m.CreatedAt()
-	case dailytask.FieldTargetDate:
-		return m.TargetDate()
-	case dailytask.FieldType:
-		return m.GetType()
+	case comment.FieldEditedAt:
+		return m.EditedAt()
+	case comment.FieldDeletedAt:
+		return m.DeletedAt()
+	case comment.FieldHiddenAt:
+		return m.HiddenAt()
 	}
 	return nil, false
 }
@@ -1389,228 +1402,439 @@ func (m *DailyTaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DailyTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dailytask.FieldCreatedAt:
+	case comment.FieldContent:
+		return m.OldContent(ctx)
+	case comment.FieldParentID:
+		return m.OldParentID(ctx)
+	case comment.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case dailytask.FieldTargetDate:
-		return m.OldTargetDate(ctx)
-	case dailytask.FieldType:
-		return m.OldType(ctx)
+	case comment.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case comment.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case comment.FieldHiddenAt:
+		return m.OldHiddenAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DailyTask field %s", name)
+	return nil, fmt.Errorf("unknown Comment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DailyTaskMutation) SetField(name string, value ent.Value) error {
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dailytask.FieldCreatedAt:
+	case comment.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case comment.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case comment.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case dailytask.FieldTargetDate:
+	case comment.FieldEditedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetDate(v)
+		m.SetEditedAt(v)
 		return nil
-	case dailytask.FieldType:
-		v, ok := value.(enum.TaskType)
+	case comment.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetDeletedAt(v)
+		return nil
+	case comment.FieldHiddenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHiddenAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DailyTask field %s", name)
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DailyTaskMutation) AddedFields() []string {
+func (m *CommentMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DailyTaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DailyTaskMutation) AddField(name string, value ent.Value) error {
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DailyTask numeric field %s", name)
+	return fmt.Errorf("unknown Comment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DailyTaskMutation) ClearedFields() []string {
-	return nil
+func (m *CommentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(comment.FieldParentID) {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.FieldCleared(comment.FieldEditedAt) {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	if m.FieldCleared(comment.FieldDeletedAt) {
+		fields = append(fields, comment.FieldDeletedAt)
+	}
+	if m.FieldCleared(comment.FieldHiddenAt) {
+		fields = append(fields, comment.FieldHiddenAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DailyTaskMutation) FieldCleared(name string) bool {
+func (m *CommentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DailyTaskMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DailyTask nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *DailyTaskMutation) ResetField(name string) error {
+func (m *CommentMutation) ClearField(name string) error {
 	switch name {
-	case dailytask.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case comment.FieldParentID:
+		m.ClearParentID()
 		return nil
-	case dailytask.FieldTargetDate:
-		m.ResetTargetDate()
+	case comment.FieldEditedAt:
+		m.ClearEditedAt()
 		return nil
-	case dailytask.FieldType:
-		m.ResetType()
+	case comment.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case comment.FieldHiddenAt:
+		m.ClearHiddenAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DailyTask field %s", name)
+	return fmt.Errorf("unknown Comment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldContent:
+		m.ResetContent()
+		return nil
+	case comment.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case comment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case comment.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case comment.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case comment.FieldHiddenAt:
+		m.ResetHiddenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DailyTaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.post != nil {
+		edges = append(edges, comment.EdgePost)
+	}
 	if m.user != nil {
-		edges = append(edges, dailytask.EdgeUser)
+		edges = append(edges, comment.EdgeUser)
 	}
-	if m.post != nil {
-		edges = append(edges, dailytask.EdgePost)
+	if m.reports != nil {
+		edges = append(edges, comment.EdgeReports)
+	}
+	if m.mentions != nil {
+		edges = append(edges, comment.EdgeMentions)
+	}
+	if m.notifications != nil {
+		edges = append(edges, comment.EdgeNotifications)
+	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
+	if m.likes != nil {
+		edges = append(edges, comment.EdgeLikes)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DailyTaskMutation) AddedIDs(name string) []ent.Value {
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case dailytask.EdgeUser:
+	case comment.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case dailytask.EdgePost:
-		if id := m.post; id != nil {
+	case comment.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.reports))
+		for id := range m.reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.mentions))
+		for id := range m.mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeParent:
+		if id := m.parent; id != nil {
 			return []ent.Value{*id}
 		}
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.likes))
+		for id := range m.likes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DailyTaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedreports != nil {
+		edges = append(edges, comment.EdgeReports)
+	}
+	if m.removedmentions != nil {
+		edges = append(edges, comment.EdgeMentions)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, comment.EdgeNotifications)
+	}
+	if m.removedreplies != nil {
+		edges = append(edges, comment.EdgeReplies)
+	}
+	if m.removedlikes != nil {
+		edges = append(edges, comment.EdgeLikes)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DailyTaskMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.removedreports))
+		for id := range m.removedreports {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedmentions))
+		for id := range m.removedmentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.removedlikes))
+		for id := range m.removedlikes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DailyTaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedpost {
+		edges = append(edges, comment.EdgePost)
+	}
 	if m.cleareduser {
-		edges = append(edges, dailytask.EdgeUser)
+		edges = append(edges, comment.EdgeUser)
 	}
-	if m.clearedpost {
-		edges = append(edges, dailytask.EdgePost)
+	if m.clearedreports {
+		edges = append(edges, comment.EdgeReports)
+	}
+	if m.clearedmentions {
+		edges = append(edges, comment.EdgeMentions)
+	}
+	if m.clearednotifications {
+		edges = append(edges, comment.EdgeNotifications)
+	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, comment.EdgeReplies)
+	}
+	if m.clearedlikes {
+		edges = append(edges, comment.EdgeLikes)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DailyTaskMutation) EdgeCleared(name string) bool {
+func (m *CommentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case dailytask.EdgeUser:
-		return m.cleareduser
-	case dailytask.EdgePost:
+	case comment.EdgePost:
 		return m.clearedpost
+	case comment.EdgeUser:
+		return m.cleareduser
+	case comment.EdgeReports:
+		return m.clearedreports
+	case comment.EdgeMentions:
+		return m.clearedmentions
+	case comment.EdgeNotifications:
+		return m.clearednotifications
+	case comment.EdgeParent:
+		return m.clearedparent
+	case comment.EdgeReplies:
+		return m.clearedreplies
+	case comment.EdgeLikes:
+		return m.clearedlikes
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DailyTaskMutation) ClearEdge(name string) error {
+func (m *CommentMutation) ClearEdge(name string) error {
 	switch name {
-	case dailytask.EdgeUser:
+	case comment.EdgePost:
+		m.ClearPost()
+		return nil
+	case comment.EdgeUser:
 		m.ClearUser()
 		return nil
-	case dailytask.EdgePost:
-		m.ClearPost()
+	case comment.EdgeParent:
+		m.ClearParent()
 		return nil
 	}
-	return fmt.Errorf("unknown DailyTask unique edge %s", name)
+	return fmt.Errorf("unknown Comment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DailyTaskMutation) ResetEdge(name string) error {
+func (m *CommentMutation) ResetEdge(name string) error {
 	switch name {
-	case dailytask.EdgeUser:
+	case comment.EdgePost:
+		m.ResetPost()
+		return nil
+	case comment.EdgeUser:
 		m.ResetUser()
 		return nil
-	case dailytask.EdgePost:
-		m.ResetPost()
+	case comment.EdgeReports:
+		m.ResetReports()
+		return nil
+	case comment.EdgeMentions:
+		m.ResetMentions()
+		return nil
+	case comment.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	case comment.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	case comment.EdgeLikes:
+		m.ResetLikes()
 		return nil
 	}
-	return fmt.Errorf("unknown DailyTask edge %s", name)
+	return fmt.Errorf("unknown Comment edge %s", name)
 }
 
-// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
-type DeviceTokenMutation struct {
+// CommentLikeMutation represents an operation that mutates the CommentLike nodes in the graph.
+type CommentLikeMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	device_id     *string
-	token         *string
-	platform      *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*DeviceToken, error)
-	predicates    []predicate.DeviceToken
-}
-
-var _ ent.Mutation = (*DeviceTokenMutation)(nil)
-
-// devicetokenOption allows management of the mutation configuration using functional options.
-type devicetokenOption func(*DeviceTokenMutation)
-
-// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
-func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
-	m := &DeviceTokenMutation{
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	comment        *uuid.UUID
+	clearedcomment bool
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*CommentLike, error)
+	predicates     []predicate.CommentLike
+}
+
+var _ ent.Mutation = (*CommentLikeMutation)(nil)
+
+// commentlikeOption allows management of the mutation configuration using functional options.
+type commentlikeOption func(*CommentLikeMutation)
+
+// newCommentLikeMutation creates new mutation for the CommentLike entity.
+func newCommentLikeMutation(c config, op Op, opts ...commentlikeOption) *CommentLikeMutation {
+	m := &CommentLikeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDeviceToken,
+		typ:           TypeCommentLike,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1619,20 +1843,20 @@ func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceT
 	return m
 }
 
-// withDeviceTokenID sets the ID field of the mutation.
-func withDeviceTokenID(id uuid.UUID) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
+// withCommentLikeID sets the ID field of the mutation.
+func withCommentLikeID(id uuid.UUID) commentlikeOption {
+	return func(m *CommentLikeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DeviceToken
+			value *CommentLike
 		)
-		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
+		m.oldValue = func(ctx context.Context) (*CommentLike, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DeviceToken.Get(ctx, id)
+					value, err = m.Client().CommentLike.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1641,10 +1865,10 @@ func withDeviceTokenID(id uuid.UUID) devicetokenOption {
 	}
 }
 
-// withDeviceToken sets the old DeviceToken of the mutation.
-func withDeviceToken(node *DeviceToken) devicetokenOption {
-	return func(m *DeviceTokenMutation) {
-		m.oldValue = func(context.Context) (*DeviceToken, error) {
+// withCommentLike sets the old CommentLike of the mutation.
+func withCommentLike(node *CommentLike) commentlikeOption {
+	return func(m *CommentLikeMutation) {
+		m.oldValue = func(context.Context) (*CommentLike, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1653,7 +1877,7 @@ func withDeviceToken(node *DeviceToken) devicetokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceTokenMutation) Client() *Client {
+func (m CommentLikeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1661,7 +1885,7 @@ func (m DeviceTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceTokenMutation) Tx() (*Tx, error) {
+func (m CommentLikeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1671,14 +1895,14 @@ func (m DeviceTokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DeviceToken entities.
-func (m *DeviceTokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of CommentLike entities.
+func (m *CommentLikeMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceTokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CommentLikeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1689,7 +1913,7 @@ func (m *DeviceTokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CommentLikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1698,243 +1922,162 @@ func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CommentLike.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *DeviceTokenMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetCommentID sets the "comment_id" field.
+func (m *CommentLikeMutation) SetCommentID(u uuid.UUID) {
+	m.comment = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *DeviceTokenMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// CommentID returns the value of the "comment_id" field in the mutation.
+func (m *CommentLikeMutation) CommentID() (r uuid.UUID, exists bool) {
+	v := m.comment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCommentID returns the old "comment_id" field's value of the CommentLike entity.
+// If the CommentLike object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *CommentLikeMutation) OldCommentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCommentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCommentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCommentID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CommentID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *DeviceTokenMutation) ResetUserID() {
-	m.user = nil
+// ResetCommentID resets all changes to the "comment_id" field.
+func (m *CommentLikeMutation) ResetCommentID() {
+	m.comment = nil
 }
 
-// SetDeviceID sets the "device_id" field.
-func (m *DeviceTokenMutation) SetDeviceID(s string) {
-	m.device_id = &s
+// SetUserID sets the "user_id" field.
+func (m *CommentLikeMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// DeviceID returns the value of the "device_id" field in the mutation.
-func (m *DeviceTokenMutation) DeviceID() (r string, exists bool) {
-	v := m.device_id
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CommentLikeMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceID returns the old "device_id" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the CommentLike entity.
+// If the CommentLike object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+func (m *CommentLikeMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.DeviceID, nil
+	return oldValue.UserID, nil
 }
 
-// ResetDeviceID resets all changes to the "device_id" field.
-func (m *DeviceTokenMutation) ResetDeviceID() {
-	m.device_id = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CommentLikeMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetToken sets the "token" field.
-func (m *DeviceTokenMutation) SetToken(s string) {
-	m.token = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentLikeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *DeviceTokenMutation) Token() (r string, exists bool) {
-	v := m.token
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentLikeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CommentLike entity.
+// If the CommentLike object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *CommentLikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Token, nil
-}
-
-// ResetToken resets all changes to the "token" field.
-func (m *DeviceTokenMutation) ResetToken() {
-	m.token = nil
-}
-
-// SetPlatform sets the "platform" field.
-func (m *DeviceTokenMutation) SetPlatform(s string) {
-	m.platform = &s
-}
-
-// Platform returns the value of the "platform" field in the mutation.
-func (m *DeviceTokenMutation) Platform() (r string, exists bool) {
-	v := m.platform
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPlatform returns the old "platform" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldPlatform(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatform requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
-	}
-	return oldValue.Platform, nil
-}
-
-// ResetPlatform resets all changes to the "platform" field.
-func (m *DeviceTokenMutation) ResetPlatform() {
-	m.platform = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *DeviceTokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DeviceTokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DeviceTokenMutation) ResetCreatedAt() {
+func (m *CommentLikeMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DeviceTokenMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearComment clears the "comment" edge to the Comment entity.
+func (m *CommentLikeMutation) ClearComment() {
+	m.clearedcomment = true
+	m.clearedFields[commentlike.FieldCommentID] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DeviceTokenMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// CommentCleared reports if the "comment" edge to the Comment entity was cleared.
+func (m *CommentLikeMutation) CommentCleared() bool {
+	return m.clearedcomment
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DeviceToken entity.
-// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// CommentIDs returns the "comment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CommentID instead. It exists only for internal usage by the builders.
+func (m *CommentLikeMutation) CommentIDs() (ids []uuid.UUID) {
+	if id := m.comment; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DeviceTokenMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetComment resets all changes to the "comment" edge.
+func (m *CommentLikeMutation) ResetComment() {
+	m.comment = nil
+	m.clearedcomment = false
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *DeviceTokenMutation) ClearUser() {
+func (m *CommentLikeMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[devicetoken.FieldUserID] = struct{}{}
+	m.clearedFields[commentlike.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *DeviceTokenMutation) UserCleared() bool {
+func (m *CommentLikeMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *DeviceTokenMutation) UserIDs() (ids []uuid.UUID) {
+func (m *CommentLikeMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1942,20 +2085,20 @@ func (m *DeviceTokenMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *DeviceTokenMutation) ResetUser() {
+func (m *CommentLikeMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the DeviceTokenMutation builder.
-func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
+// Where appends a list predicates to the CommentLikeMutation builder.
+func (m *CommentLikeMutation) Where(ps ...predicate.CommentLike) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeviceTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CommentLikeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DeviceToken, len(ps))
+func (m *CommentLikeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommentLike, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1963,42 +2106,33 @@ func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeviceTokenMutation) Op() Op {
+func (m *CommentLikeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeviceTokenMutation) SetOp(op Op) {
+func (m *CommentLikeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DeviceToken).
-func (m *DeviceTokenMutation) Type() string {
+// Type returns the node type of this mutation (CommentLike).
+func (m *CommentLikeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceTokenMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.user != nil {
-		fields = append(fields, devicetoken.FieldUserID)
-	}
-	if m.device_id != nil {
-		fields = append(fields, devicetoken.FieldDeviceID)
-	}
-	if m.token != nil {
-		fields = append(fields, devicetoken.FieldToken)
+func (m *CommentLikeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.comment != nil {
+		fields = append(fields, commentlike.FieldCommentID)
 	}
-	if m.platform != nil {
-		fields = append(fields, devicetoken.FieldPlatform)
+	if m.user != nil {
+		fields = append(fields, commentlike.FieldUserID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, devicetoken.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, devicetoken.FieldUpdatedAt)
+		fields = append(fields, commentlike.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2006,20 +2140,14 @@ func (m *DeviceTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *CommentLikeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case devicetoken.FieldUserID:
+	case commentlike.FieldCommentID:
+		return m.CommentID()
+	case commentlike.FieldUserID:
 		return m.UserID()
-	case devicetoken.FieldDeviceID:
-		return m.DeviceID()
-	case devicetoken.FieldToken:
-		return m.Token()
-	case devicetoken.FieldPlatform:
-		return m.Platform()
-	case devicetoken.FieldCreatedAt:
+	case commentlike.FieldCreatedAt:
 		return m.CreatedAt()
-	case devicetoken.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2027,156 +2155,127 @@ func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CommentLikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case devicetoken.FieldUserID:
+	case commentlike.FieldCommentID:
+		return m.OldCommentID(ctx)
+	case commentlike.FieldUserID:
 		return m.OldUserID(ctx)
-	case devicetoken.FieldDeviceID:
-		return m.OldDeviceID(ctx)
-	case devicetoken.FieldToken:
-		return m.OldToken(ctx)
-	case devicetoken.FieldPlatform:
-		return m.OldPlatform(ctx)
-	case devicetoken.FieldCreatedAt:
+	case commentlike.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case devicetoken.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
+	return nil, fmt.Errorf("unknown CommentLike field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
+func (m *CommentLikeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case devicetoken.FieldUserID:
+	case commentlike.FieldCommentID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
-		return nil
-	case devicetoken.FieldDeviceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeviceID(v)
-		return nil
-	case devicetoken.FieldToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToken(v)
+		m.SetCommentID(v)
 		return nil
-	case devicetoken.FieldPlatform:
-		v, ok := value.(string)
+	case commentlike.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlatform(v)
+		m.SetUserID(v)
 		return nil
-	case devicetoken.FieldCreatedAt:
+	case commentlike.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case devicetoken.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown CommentLike field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceTokenMutation) AddedFields() []string {
+func (m *CommentLikeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CommentLikeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
+func (m *CommentLikeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
+	return fmt.Errorf("unknown CommentLike numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceTokenMutation) ClearedFields() []string {
+func (m *CommentLikeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceTokenMutation) FieldCleared(name string) bool {
+func (m *CommentLikeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
+func (m *CommentLikeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CommentLike nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceTokenMutation) ResetField(name string) error {
+func (m *CommentLikeMutation) ResetField(name string) error {
 	switch name {
-	case devicetoken.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case devicetoken.FieldDeviceID:
-		m.ResetDeviceID()
-		return nil
-	case devicetoken.FieldToken:
-		m.ResetToken()
+	case commentlike.FieldCommentID:
+		m.ResetCommentID()
 		return nil
-	case devicetoken.FieldPlatform:
-		m.ResetPlatform()
+	case commentlike.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case devicetoken.FieldCreatedAt:
+	case commentlike.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case devicetoken.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken field %s", name)
+	return fmt.Errorf("unknown CommentLike field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CommentLikeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.comment != nil {
+		edges = append(edges, commentlike.EdgeComment)
+	}
 	if m.user != nil {
-		edges = append(edges, devicetoken.EdgeUser)
+		edges = append(edges, commentlike.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *CommentLikeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case devicetoken.EdgeUser:
+	case commentlike.EdgeComment:
+		if id := m.comment; id != nil {
+			return []ent.Value{*id}
+		}
+	case commentlike.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2185,31 +2284,36 @@ func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CommentLikeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *CommentLikeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CommentLikeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcomment {
+		edges = append(edges, commentlike.EdgeComment)
+	}
 	if m.cleareduser {
-		edges = append(edges, devicetoken.EdgeUser)
+		edges = append(edges, commentlike.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
+func (m *CommentLikeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case devicetoken.EdgeUser:
+	case commentlike.EdgeComment:
+		return m.clearedcomment
+	case commentlike.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -2217,54 +2321,62 @@ func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceTokenMutation) ClearEdge(name string) error {
+func (m *CommentLikeMutation) ClearEdge(name string) error {
 	switch name {
-	case devicetoken.EdgeUser:
+	case commentlike.EdgeComment:
+		m.ClearComment()
+		return nil
+	case commentlike.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
+	return fmt.Errorf("unknown CommentLike unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceTokenMutation) ResetEdge(name string) error {
+func (m *CommentLikeMutation) ResetEdge(name string) error {
 	switch name {
-	case devicetoken.EdgeUser:
+	case commentlike.EdgeComment:
+		m.ResetComment()
+		return nil
+	case commentlike.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown DeviceToken edge %s", name)
+	return fmt.Errorf("unknown CommentLike edge %s", name)
 }
 
-// FollowRelationMutation represents an operation that mutates the FollowRelation nodes in the graph.
-type FollowRelationMutation struct {
+// CredentialMutation represents an operation that mutates the Credential nodes in the graph.
+type CredentialMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	from          *uuid.UUID
-	clearedfrom   bool
-	to            *uuid.UUID
-	clearedto     bool
-	done          bool
-	oldValue      func(context.Context) (*FollowRelation, error)
-	predicates    []predicate.FollowRelation
-}
-
-var _ ent.Mutation = (*FollowRelationMutation)(nil)
-
-// followrelationOption allows management of the mutation configuration using functional options.
-type followrelationOption func(*FollowRelationMutation)
-
-// newFollowRelationMutation creates new mutation for the FollowRelation entity.
-func newFollowRelationMutation(c config, op Op, opts ...followrelationOption) *FollowRelationMutation {
-	m := &FollowRelationMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	email            *string
+	password_hash    *string
+	email_verified   *bool
+	token_version    *int
+	addtoken_version *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Credential, error)
+	predicates       []predicate.Credential
+}
+
+var _ ent.Mutation = (*CredentialMutation)(nil)
+
+// credentialOption allows management of the mutation configuration using functional options.
+type credentialOption func(*CredentialMutation)
+
+// newCredentialMutation creates new mutation for the Credential entity.
+func newCredentialMutation(c config, op Op, opts ...credentialOption) *CredentialMutation {
+	m := &CredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFollowRelation,
+		typ:           TypeCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2273,20 +2385,20 @@ func newFollowRelationMutation(c config, op Op, opts ...followrelationOption) *F
 	return m
 }
 
-// withFollowRelationID sets the ID field of the mutation.
-func withFollowRelationID(id uuid.UUID) followrelationOption {
-	return func(m *FollowRelationMutation) {
+// withCredentialID sets the ID field of the mutation.
+func withCredentialID(id uuid.UUID) credentialOption {
+	return func(m *CredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FollowRelation
+			value *Credential
 		)
-		m.oldValue = func(ctx context.Context) (*FollowRelation, error) {
+		m.oldValue = func(ctx context.Context) (*Credential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FollowRelation.Get(ctx, id)
+					value, err = m.Client().Credential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2295,10 +2407,10 @@ func withFollowRelationID(id uuid.UUID) followrelationOption {
 	}
 }
 
-// withFollowRelation sets the old FollowRelation of the mutation.
-func withFollowRelation(node *FollowRelation) followrelationOption {
-	return func(m *FollowRelationMutation) {
-		m.oldValue = func(context.Context) (*FollowRelation, error) {
+// withCredential sets the old Credential of the mutation.
+func withCredential(node *Credential) credentialOption {
+	return func(m *CredentialMutation) {
+		m.oldValue = func(context.Context) (*Credential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2307,7 +2419,7 @@ func withFollowRelation(node *FollowRelation) followrelationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FollowRelationMutation) Client() *Client {
+func (m CredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2315,7 +2427,7 @@ func (m FollowRelationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FollowRelationMutation) Tx() (*Tx, error) {
+func (m CredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2325,14 +2437,14 @@ func (m FollowRelationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FollowRelation entities.
-func (m *FollowRelationMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Credential entities.
+func (m *CredentialMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FollowRelationMutation) ID() (id uuid.UUID, exists bool) {
+func (m *CredentialMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2343,7 +2455,7 @@ func (m *FollowRelationMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FollowRelationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *CredentialMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2352,135 +2464,257 @@ func (m *FollowRelationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FollowRelation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Credential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FollowRelationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEmail sets the "email" field.
+func (m *CredentialMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FollowRelationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Email returns the value of the "email" field in the mutation.
+func (m *CredentialMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FollowRelation entity.
-// If the FollowRelation object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FollowRelationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CredentialMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Email, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FollowRelationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *CredentialMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetFromID sets the "from" edge to the User entity by id.
-func (m *FollowRelationMutation) SetFromID(id uuid.UUID) {
-	m.from = &id
+// SetPasswordHash sets the "password_hash" field.
+func (m *CredentialMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// ClearFrom clears the "from" edge to the User entity.
-func (m *FollowRelationMutation) ClearFrom() {
-	m.clearedfrom = true
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *CredentialMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FromCleared reports if the "from" edge to the User entity was cleared.
-func (m *FollowRelationMutation) FromCleared() bool {
-	return m.clearedfrom
+// OldPasswordHash returns the old "password_hash" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
 }
 
-// FromID returns the "from" edge ID in the mutation.
-func (m *FollowRelationMutation) FromID() (id uuid.UUID, exists bool) {
-	if m.from != nil {
-		return *m.from, true
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *CredentialMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (m *CredentialMutation) SetEmailVerified(b bool) {
+	m.email_verified = &b
+}
+
+// EmailVerified returns the value of the "email_verified" field in the mutation.
+func (m *CredentialMutation) EmailVerified() (r bool, exists bool) {
+	v := m.email_verified
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FromIDs returns the "from" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FromID instead. It exists only for internal usage by the builders.
-func (m *FollowRelationMutation) FromIDs() (ids []uuid.UUID) {
-	if id := m.from; id != nil {
-		ids = append(ids, *id)
+// OldEmailVerified returns the old "email_verified" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldEmailVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailVerified is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailVerified: %w", err)
+	}
+	return oldValue.EmailVerified, nil
 }
 
-// ResetFrom resets all changes to the "from" edge.
-func (m *FollowRelationMutation) ResetFrom() {
-	m.from = nil
-	m.clearedfrom = false
+// ResetEmailVerified resets all changes to the "email_verified" field.
+func (m *CredentialMutation) ResetEmailVerified() {
+	m.email_verified = nil
 }
 
-// SetToID sets the "to" edge to the User entity by id.
-func (m *FollowRelationMutation) SetToID(id uuid.UUID) {
-	m.to = &id
+// SetTokenVersion sets the "token_version" field.
+func (m *CredentialMutation) SetTokenVersion(i int) {
+	m.token_version = &i
+	m.addtoken_version = nil
 }
 
-// ClearTo clears the "to" edge to the User entity.
-func (m *FollowRelationMutation) ClearTo() {
-	m.clearedto = true
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *CredentialMutation) TokenVersion() (r int, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ToCleared reports if the "to" edge to the User entity was cleared.
-func (m *FollowRelationMutation) ToCleared() bool {
-	return m.clearedto
+// OldTokenVersion returns the old "token_version" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldTokenVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
 }
 
-// ToID returns the "to" edge ID in the mutation.
-func (m *FollowRelationMutation) ToID() (id uuid.UUID, exists bool) {
-	if m.to != nil {
-		return *m.to, true
+// AddTokenVersion adds i to the "token_version" field.
+func (m *CredentialMutation) AddTokenVersion(i int) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += i
+	} else {
+		m.addtoken_version = &i
 	}
-	return
 }
 
-// ToIDs returns the "to" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ToID instead. It exists only for internal usage by the builders.
-func (m *FollowRelationMutation) ToIDs() (ids []uuid.UUID) {
-	if id := m.to; id != nil {
-		ids = append(ids, *id)
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *CredentialMutation) AddedTokenVersion() (r int, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetTo resets all changes to the "to" edge.
-func (m *FollowRelationMutation) ResetTo() {
-	m.to = nil
-	m.clearedto = false
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *CredentialMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
 }
 
-// Where appends a list predicates to the FollowRelationMutation builder.
-func (m *FollowRelationMutation) Where(ps ...predicate.FollowRelation) {
+// SetCreatedAt sets the "created_at" field.
+func (m *CredentialMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CredentialMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CredentialMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CredentialMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CredentialMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CredentialMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the CredentialMutation builder.
+func (m *CredentialMutation) Where(ps ...predicate.Credential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FollowRelationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CredentialMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FollowRelationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FollowRelation, len(ps))
+func (m *CredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Credential, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2488,27 +2722,42 @@ func (m *FollowRelationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FollowRelationMutation) Op() Op {
+func (m *CredentialMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FollowRelationMutation) SetOp(op Op) {
+func (m *CredentialMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FollowRelation).
-func (m *FollowRelationMutation) Type() string {
+// Type returns the node type of this mutation (Credential).
+func (m *CredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FollowRelationMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *CredentialMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.email != nil {
+		fields = append(fields, credential.FieldEmail)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, credential.FieldPasswordHash)
+	}
+	if m.email_verified != nil {
+		fields = append(fields, credential.FieldEmailVerified)
+	}
+	if m.token_version != nil {
+		fields = append(fields, credential.FieldTokenVersion)
+	}
 	if m.created_at != nil {
-		fields = append(fields, followrelation.FieldCreatedAt)
+		fields = append(fields, credential.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, credential.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2516,10 +2765,20 @@ func (m *FollowRelationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FollowRelationMutation) Field(name string) (ent.Value, bool) {
+func (m *CredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case followrelation.FieldCreatedAt:
+	case credential.FieldEmail:
+		return m.Email()
+	case credential.FieldPasswordHash:
+		return m.PasswordHash()
+	case credential.FieldEmailVerified:
+		return m.EmailVerified()
+	case credential.FieldTokenVersion:
+		return m.TokenVersion()
+	case credential.FieldCreatedAt:
 		return m.CreatedAt()
+	case credential.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2527,202 +2786,235 @@ func (m *FollowRelationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FollowRelationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case followrelation.FieldCreatedAt:
+	case credential.FieldEmail:
+		return m.OldEmail(ctx)
+	case credential.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case credential.FieldEmailVerified:
+		return m.OldEmailVerified(ctx)
+	case credential.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
+	case credential.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case credential.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FollowRelation field %s", name)
+	return nil, fmt.Errorf("unknown Credential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FollowRelationMutation) SetField(name string, value ent.Value) error {
+func (m *CredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case followrelation.FieldCreatedAt:
+	case credential.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case credential.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case credential.FieldEmailVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerified(v)
+		return nil
+	case credential.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenVersion(v)
+		return nil
+	case credential.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	}
-	return fmt.Errorf("unknown FollowRelation field %s", name)
+	case credential.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Credential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FollowRelationMutation) AddedFields() []string {
-	return nil
+func (m *CredentialMutation) AddedFields() []string {
+	var fields []string
+	if m.addtoken_version != nil {
+		fields = append(fields, credential.FieldTokenVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FollowRelationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CredentialMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case credential.FieldTokenVersion:
+		return m.AddedTokenVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FollowRelationMutation) AddField(name string, value ent.Value) error {
+func (m *CredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case credential.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown FollowRelation numeric field %s", name)
+	return fmt.Errorf("unknown Credential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FollowRelationMutation) ClearedFields() []string {
+func (m *CredentialMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FollowRelationMutation) FieldCleared(name string) bool {
+func (m *CredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FollowRelationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FollowRelation nullable field %s", name)
+func (m *CredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Credential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FollowRelationMutation) ResetField(name string) error {
+func (m *CredentialMutation) ResetField(name string) error {
 	switch name {
-	case followrelation.FieldCreatedAt:
+	case credential.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case credential.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case credential.FieldEmailVerified:
+		m.ResetEmailVerified()
+		return nil
+	case credential.FieldTokenVersion:
+		m.ResetTokenVersion()
+		return nil
+	case credential.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case credential.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown FollowRelation field %s", name)
+	return fmt.Errorf("unknown Credential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FollowRelationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.from != nil {
-		edges = append(edges, followrelation.EdgeFrom)
-	}
-	if m.to != nil {
-		edges = append(edges, followrelation.EdgeTo)
-	}
+func (m *CredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FollowRelationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case followrelation.EdgeFrom:
-		if id := m.from; id != nil {
-			return []ent.Value{*id}
-		}
-	case followrelation.EdgeTo:
-		if id := m.to; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *CredentialMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FollowRelationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FollowRelationMutation) RemovedIDs(name string) []ent.Value {
+func (m *CredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FollowRelationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedfrom {
-		edges = append(edges, followrelation.EdgeFrom)
-	}
-	if m.clearedto {
-		edges = append(edges, followrelation.EdgeTo)
-	}
+func (m *CredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FollowRelationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case followrelation.EdgeFrom:
-		return m.clearedfrom
-	case followrelation.EdgeTo:
-		return m.clearedto
-	}
+func (m *CredentialMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FollowRelationMutation) ClearEdge(name string) error {
-	switch name {
-	case followrelation.EdgeFrom:
-		m.ClearFrom()
-		return nil
-	case followrelation.EdgeTo:
-		m.ClearTo()
-		return nil
-	}
-	return fmt.Errorf("unknown FollowRelation unique edge %s", name)
+func (m *CredentialMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Credential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FollowRelationMutation) ResetEdge(name string) error {
-	switch name {
-	case followrelation.EdgeFrom:
-		m.ResetFrom()
-		return nil
-	case followrelation.EdgeTo:
-		m.ResetTo()
-		return nil
-	}
-	return fmt.Errorf("unknown FollowRelation edge %s", name)
+func (m *CredentialMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Credential edge %s", name)
 }
 
-// LikeMutation represents an operation that mutates the Like nodes in the graph.
-type LikeMutation struct {
+// DailyTaskMutation represents an operation that mutates the DailyTask nodes in the graph.
+type DailyTaskMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	created_at    *time.Time
+	target_date   *time.Time
+	_type         *enum.TaskType
 	clearedFields map[string]struct{}
 	user          *uuid.UUID
 	cleareduser   bool
 	post          *uuid.UUID
 	clearedpost   bool
 	done          bool
-	oldValue      func(context.Context) (*Like, error)
-	predicates    []predicate.Like
+	oldValue      func(context.Context) (*DailyTask, error)
+	predicates    []predicate.DailyTask
 }
 
-var _ ent.Mutation = (*LikeMutation)(nil)
+var _ ent.Mutation = (*DailyTaskMutation)(nil)
 
-// likeOption allows management of the mutation configuration using functional options.
-type likeOption func(*LikeMutation)
+// dailytaskOption allows management of the mutation configuration using functional options.
+type dailytaskOption func(*DailyTaskMutation)
 
-// newLikeMutation creates new mutation for the Like entity.
-func newLikeMutation(c config, op Op, opts ...likeOption) *LikeMutation {
-	m := &LikeMutation{
+// newDailyTaskMutation creates new mutation for the DailyTask entity.
+func newDailyTaskMutation(c config, op Op, opts ...dailytaskOption) *DailyTaskMutation {
+	m := &DailyTaskMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLike,
+		typ:           TypeDailyTask,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2731,20 +3023,20 @@ func newLikeMutation(c config, op Op, opts ...likeOption) *LikeMutation {
 	return m
 }
 
-// withLikeID sets the ID field of the mutation.
-func withLikeID(id uuid.UUID) likeOption {
-	return func(m *LikeMutation) {
+// withDailyTaskID sets the ID field of the mutation.
+func withDailyTaskID(id uuid.UUID) dailytaskOption {
+	return func(m *DailyTaskMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Like
+			value *DailyTask
 		)
-		m.oldValue = func(ctx context.Context) (*Like, error) {
+		m.oldValue = func(ctx context.Context) (*DailyTask, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Like.Get(ctx, id)
+					value, err = m.Client().DailyTask.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2753,10 +3045,10 @@ func withLikeID(id uuid.UUID) likeOption {
 	}
 }
 
-// withLike sets the old Like of the mutation.
-func withLike(node *Like) likeOption {
-	return func(m *LikeMutation) {
-		m.oldValue = func(context.Context) (*Like, error) {
+// withDailyTask sets the old DailyTask of the mutation.
+func withDailyTask(node *DailyTask) dailytaskOption {
+	return func(m *DailyTaskMutation) {
+		m.oldValue = func(context.Context) (*DailyTask, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2765,7 +3057,7 @@ func withLike(node *Like) likeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LikeMutation) Client() *Client {
+func (m DailyTaskMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2773,7 +3065,7 @@ func (m LikeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LikeMutation) Tx() (*Tx, error) {
+func (m DailyTaskMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2783,14 +3075,14 @@ func (m LikeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Like entities.
-func (m *LikeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of DailyTask entities.
+func (m *DailyTaskMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LikeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DailyTaskMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2801,7 +3093,7 @@ func (m *LikeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DailyTaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2810,19 +3102,19 @@ func (m *LikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Like.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DailyTask.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LikeMutation) SetCreatedAt(t time.Time) {
+func (m *DailyTaskMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LikeMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DailyTaskMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2830,10 +3122,10 @@ func (m *LikeMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Like entity.
-// If the Like object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DailyTask entity.
+// If the DailyTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DailyTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2848,27 +3140,99 @@ func (m *LikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LikeMutation) ResetCreatedAt() {
+func (m *DailyTaskMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
+// SetTargetDate sets the "target_date" field.
+func (m *DailyTaskMutation) SetTargetDate(t time.Time) {
+	m.target_date = &t
+}
+
+// TargetDate returns the value of the "target_date" field in the mutation.
+func (m *DailyTaskMutation) TargetDate() (r time.Time, exists bool) {
+	v := m.target_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetDate returns the old "target_date" field's value of the DailyTask entity.
+// If the DailyTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyTaskMutation) OldTargetDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetDate: %w", err)
+	}
+	return oldValue.TargetDate, nil
+}
+
+// ResetTargetDate resets all changes to the "target_date" field.
+func (m *DailyTaskMutation) ResetTargetDate() {
+	m.target_date = nil
+}
+
+// SetType sets the "type" field.
+func (m *DailyTaskMutation) SetType(et enum.TaskType) {
+	m._type = &et
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *DailyTaskMutation) GetType() (r enum.TaskType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the DailyTask entity.
+// If the DailyTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyTaskMutation) OldType(ctx context.Context) (v enum.TaskType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *DailyTaskMutation) ResetType() {
+	m._type = nil
+}
+
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *LikeMutation) SetUserID(id uuid.UUID) {
+func (m *DailyTaskMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *LikeMutation) ClearUser() {
+func (m *DailyTaskMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *LikeMutation) UserCleared() bool {
+func (m *DailyTaskMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *LikeMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *DailyTaskMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -2878,7 +3242,7 @@ func (m *LikeMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *LikeMutation) UserIDs() (ids []uuid.UUID) {
+func (m *DailyTaskMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2886,28 +3250,28 @@ func (m *LikeMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *LikeMutation) ResetUser() {
+func (m *DailyTaskMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
 // SetPostID sets the "post" edge to the Post entity by id.
-func (m *LikeMutation) SetPostID(id uuid.UUID) {
+func (m *DailyTaskMutation) SetPostID(id uuid.UUID) {
 	m.post = &id
 }
 
 // ClearPost clears the "post" edge to the Post entity.
-func (m *LikeMutation) ClearPost() {
+func (m *DailyTaskMutation) ClearPost() {
 	m.clearedpost = true
 }
 
 // PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *LikeMutation) PostCleared() bool {
+func (m *DailyTaskMutation) PostCleared() bool {
 	return m.clearedpost
 }
 
 // PostID returns the "post" edge ID in the mutation.
-func (m *LikeMutation) PostID() (id uuid.UUID, exists bool) {
+func (m *DailyTaskMutation) PostID() (id uuid.UUID, exists bool) {
 	if m.post != nil {
 		return *m.post, true
 	}
@@ -2917,7 +3281,7 @@ func (m *LikeMutation) PostID() (id uuid.UUID, exists bool) {
 // PostIDs returns the "post" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // PostID instead. It exists only for internal usage by the builders.
-func (m *LikeMutation) PostIDs() (ids []uuid.UUID) {
+func (m *DailyTaskMutation) PostIDs() (ids []uuid.UUID) {
 	if id := m.post; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2925,20 +3289,20 @@ func (m *LikeMutation) PostIDs() (ids []uuid.UUID) {
 }
 
 // ResetPost resets all changes to the "post" edge.
-func (m *LikeMutation) ResetPost() {
+func (m *DailyTaskMutation) ResetPost() {
 	m.post = nil
 	m.clearedpost = false
 }
 
-// Where appends a list predicates to the LikeMutation builder.
-func (m *LikeMutation) Where(ps ...predicate.Like) {
+// Where appends a list predicates to the DailyTaskMutation builder.
+func (m *DailyTaskMutation) Where(ps ...predicate.DailyTask) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LikeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DailyTaskMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LikeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Like, len(ps))
+func (m *DailyTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DailyTask, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2946,27 +3310,33 @@ func (m *LikeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LikeMutation) Op() Op {
+func (m *DailyTaskMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LikeMutation) SetOp(op Op) {
+func (m *DailyTaskMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Like).
-func (m *LikeMutation) Type() string {
+// Type returns the node type of this mutation (DailyTask).
+func (m *DailyTaskMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LikeMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *DailyTaskMutation) Fields() []string {
+	fields := make([]string, 0, 3)
 	if m.created_at != nil {
-		fields = append(fields, like.FieldCreatedAt)
+		fields = append(fields, dailytask.FieldCreatedAt)
+	}
+	if m.target_date != nil {
+		fields = append(fields, dailytask.FieldTargetDate)
+	}
+	if m._type != nil {
+		fields = append(fields, dailytask.FieldType)
 	}
 	return fields
 }
@@ -2974,10 +3344,14 @@ func (m *LikeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LikeMutation) Field(name string) (ent.Value, bool) {
+func (m *DailyTaskMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case like.FieldCreatedAt:
+	case dailytask.FieldCreatedAt:
 		return m.CreatedAt()
+	case dailytask.FieldTargetDate:
+		return m.TargetDate()
+	case dailytask.FieldType:
+		return m.GetType()
 	}
 	return nil, false
 }
@@ -2985,103 +3359,127 @@ func (m *LikeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DailyTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case like.FieldCreatedAt:
+	case dailytask.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case dailytask.FieldTargetDate:
+		return m.OldTargetDate(ctx)
+	case dailytask.FieldType:
+		return m.OldType(ctx)
 	}
-	return nil, fmt.Errorf("unknown Like field %s", name)
+	return nil, fmt.Errorf("unknown DailyTask field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LikeMutation) SetField(name string, value ent.Value) error {
+func (m *DailyTaskMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case like.FieldCreatedAt:
+	case dailytask.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case dailytask.FieldTargetDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetDate(v)
+		return nil
+	case dailytask.FieldType:
+		v, ok := value.(enum.TaskType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Like field %s", name)
+	return fmt.Errorf("unknown DailyTask field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LikeMutation) AddedFields() []string {
+func (m *DailyTaskMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LikeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DailyTaskMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LikeMutation) AddField(name string, value ent.Value) error {
+func (m *DailyTaskMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Like numeric field %s", name)
+	return fmt.Errorf("unknown DailyTask numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LikeMutation) ClearedFields() []string {
+func (m *DailyTaskMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LikeMutation) FieldCleared(name string) bool {
+func (m *DailyTaskMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LikeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Like nullable field %s", name)
+func (m *DailyTaskMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DailyTask nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LikeMutation) ResetField(name string) error {
+func (m *DailyTaskMutation) ResetField(name string) error {
 	switch name {
-	case like.FieldCreatedAt:
+	case dailytask.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case dailytask.FieldTargetDate:
+		m.ResetTargetDate()
+		return nil
+	case dailytask.FieldType:
+		m.ResetType()
+		return nil
 	}
-	return fmt.Errorf("unknown Like field %s", name)
+	return fmt.Errorf("unknown DailyTask field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LikeMutation) AddedEdges() []string {
+func (m *DailyTaskMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, like.EdgeUser)
+		edges = append(edges, dailytask.EdgeUser)
 	}
 	if m.post != nil {
-		edges = append(edges, like.EdgePost)
+		edges = append(edges, dailytask.EdgePost)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LikeMutation) AddedIDs(name string) []ent.Value {
+func (m *DailyTaskMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case like.EdgeUser:
+	case dailytask.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case like.EdgePost:
+	case dailytask.EdgePost:
 		if id := m.post; id != nil {
 			return []ent.Value{*id}
 		}
@@ -3090,36 +3488,36 @@ func (m *LikeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LikeMutation) RemovedEdges() []string {
+func (m *DailyTaskMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LikeMutation) RemovedIDs(name string) []ent.Value {
+func (m *DailyTaskMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LikeMutation) ClearedEdges() []string {
+func (m *DailyTaskMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, like.EdgeUser)
+		edges = append(edges, dailytask.EdgeUser)
 	}
 	if m.clearedpost {
-		edges = append(edges, like.EdgePost)
+		edges = append(edges, dailytask.EdgePost)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LikeMutation) EdgeCleared(name string) bool {
+func (m *DailyTaskMutation) EdgeCleared(name string) bool {
 	switch name {
-	case like.EdgeUser:
+	case dailytask.EdgeUser:
 		return m.cleareduser
-	case like.EdgePost:
+	case dailytask.EdgePost:
 		return m.clearedpost
 	}
 	return false
@@ -3127,64 +3525,56 @@ func (m *LikeMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LikeMutation) ClearEdge(name string) error {
+func (m *DailyTaskMutation) ClearEdge(name string) error {
 	switch name {
-	case like.EdgeUser:
+	case dailytask.EdgeUser:
 		m.ClearUser()
 		return nil
-	case like.EdgePost:
+	case dailytask.EdgePost:
 		m.ClearPost()
 		return nil
 	}
-	return fmt.Errorf("unknown Like unique edge %s", name)
+	return fmt.Errorf("unknown DailyTask unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LikeMutation) ResetEdge(name string) error {
+func (m *DailyTaskMutation) ResetEdge(name string) error {
 	switch name {
-	case like.EdgeUser:
+	case dailytask.EdgeUser:
 		m.ResetUser()
 		return nil
-	case like.EdgePost:
+	case dailytask.EdgePost:
 		m.ResetPost()
 		return nil
 	}
-	return fmt.Errorf("unknown Like edge %s", name)
+	return fmt.Errorf("unknown DailyTask edge %s", name)
 }
 
-// PetMutation represents an operation that mutates the Pet nodes in the graph.
-type PetMutation struct {
+// DataMigrationMutation represents an operation that mutates the DataMigration nodes in the graph.
+type DataMigrationMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *uuid.UUID
-	name          *string
-	birth_day     *string
-	_type         *pet.Type
-	species       *pet.Species
-	image_key     *string
-	created_at    *time.Time
-	deleted_at    *time.Time
+	id            *string
+	applied_at    *time.Time
 	clearedFields map[string]struct{}
-	owner         *uuid.UUID
-	clearedowner  bool
 	done          bool
-	oldValue      func(context.Context) (*Pet, error)
-	predicates    []predicate.Pet
+	oldValue      func(context.Context) (*DataMigration, error)
+	predicates    []predicate.DataMigration
 }
 
-var _ ent.Mutation = (*PetMutation)(nil)
+var _ ent.Mutation = (*DataMigrationMutation)(nil)
 
-// petOption allows management of the mutation configuration using functional options.
-type petOption func(*PetMutation)
+// datamigrationOption allows management of the mutation configuration using functional options.
+type datamigrationOption func(*DataMigrationMutation)
 
-// newPetMutation creates new mutation for the Pet entity.
-func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
-	m := &PetMutation{
+// newDataMigrationMutation creates new mutation for the DataMigration entity.
+func newDataMigrationMutation(c config, op Op, opts ...datamigrationOption) *DataMigrationMutation {
+	m := &DataMigrationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePet,
+		typ:           TypeDataMigration,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3193,20 +3583,20 @@ func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
 	return m
 }
 
-// withPetID sets the ID field of the mutation.
-func withPetID(id uuid.UUID) petOption {
-	return func(m *PetMutation) {
+// withDataMigrationID sets the ID field of the mutation.
+func withDataMigrationID(id string) datamigrationOption {
+	return func(m *DataMigrationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pet
+			value *DataMigration
 		)
-		m.oldValue = func(ctx context.Context) (*Pet, error) {
+		m.oldValue = func(ctx context.Context) (*DataMigration, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pet.Get(ctx, id)
+					value, err = m.Client().DataMigration.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3215,10 +3605,10 @@ func withPetID(id uuid.UUID) petOption {
 	}
 }
 
-// withPet sets the old Pet of the mutation.
-func withPet(node *Pet) petOption {
-	return func(m *PetMutation) {
-		m.oldValue = func(context.Context) (*Pet, error) {
+// withDataMigration sets the old DataMigration of the mutation.
+func withDataMigration(node *DataMigration) datamigrationOption {
+	return func(m *DataMigrationMutation) {
+		m.oldValue = func(context.Context) (*DataMigration, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3227,7 +3617,7 @@ func withPet(node *Pet) petOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PetMutation) Client() *Client {
+func (m DataMigrationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3235,7 +3625,7 @@ func (m PetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PetMutation) Tx() (*Tx, error) {
+func (m DataMigrationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3245,14 +3635,14 @@ func (m PetMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Pet entities.
-func (m *PetMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of DataMigration entities.
+func (m *DataMigrationMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PetMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DataMigrationMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3263,933 +3653,521 @@ func (m *PetMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DataMigrationMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []string{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DataMigration.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PetMutation) SetName(s string) {
-	m.name = &s
+// SetAppliedAt sets the "applied_at" field.
+func (m *DataMigrationMutation) SetAppliedAt(t time.Time) {
+	m.applied_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PetMutation) Name() (r string, exists bool) {
-	v := m.name
+// AppliedAt returns the value of the "applied_at" field in the mutation.
+func (m *DataMigrationMutation) AppliedAt() (r time.Time, exists bool) {
+	v := m.applied_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// OldAppliedAt returns the old "applied_at" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DataMigrationMutation) OldAppliedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAppliedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldAppliedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAppliedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.AppliedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PetMutation) ResetName() {
-	m.name = nil
+// ResetAppliedAt resets all changes to the "applied_at" field.
+func (m *DataMigrationMutation) ResetAppliedAt() {
+	m.applied_at = nil
 }
 
-// SetBirthDay sets the "birth_day" field.
-func (m *PetMutation) SetBirthDay(s string) {
-	m.birth_day = &s
+// Where appends a list predicates to the DataMigrationMutation builder.
+func (m *DataMigrationMutation) Where(ps ...predicate.DataMigration) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// BirthDay returns the value of the "birth_day" field in the mutation.
-func (m *PetMutation) BirthDay() (r string, exists bool) {
-	v := m.birth_day
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the DataMigrationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DataMigrationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DataMigration, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldBirthDay returns the old "birth_day" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldBirthDay(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthDay is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthDay requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthDay: %w", err)
-	}
-	return oldValue.BirthDay, nil
+// Op returns the operation name.
+func (m *DataMigrationMutation) Op() Op {
+	return m.op
 }
 
-// ResetBirthDay resets all changes to the "birth_day" field.
-func (m *PetMutation) ResetBirthDay() {
-	m.birth_day = nil
+// SetOp allows setting the mutation operation.
+func (m *DataMigrationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetType sets the "type" field.
-func (m *PetMutation) SetType(pe pet.Type) {
-	m._type = &pe
+// Type returns the node type of this mutation (DataMigration).
+func (m *DataMigrationMutation) Type() string {
+	return m.typ
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *PetMutation) GetType() (r pet.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DataMigrationMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.applied_at != nil {
+		fields = append(fields, datamigration.FieldAppliedAt)
 	}
-	return *v, true
+	return fields
 }
 
-// OldType returns the old "type" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldType(ctx context.Context) (v pet.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DataMigrationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case datamigration.FieldAppliedAt:
+		return m.AppliedAt()
 	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *PetMutation) ResetType() {
-	m._type = nil
-}
-
-// SetSpecies sets the "species" field.
-func (m *PetMutation) SetSpecies(pe pet.Species) {
-	m.species = &pe
+	return nil, false
 }
 
-// Species returns the value of the "species" field in the mutation.
-func (m *PetMutation) Species() (r pet.Species, exists bool) {
-	v := m.species
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DataMigrationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case datamigration.FieldAppliedAt:
+		return m.OldAppliedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown DataMigration field %s", name)
 }
 
-// OldSpecies returns the old "species" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldSpecies(ctx context.Context) (v pet.Species, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpecies is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpecies requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpecies: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DataMigrationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case datamigration.FieldAppliedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedAt(v)
+		return nil
 	}
-	return oldValue.Species, nil
+	return fmt.Errorf("unknown DataMigration field %s", name)
 }
 
-// ResetSpecies resets all changes to the "species" field.
-func (m *PetMutation) ResetSpecies() {
-	m.species = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DataMigrationMutation) AddedFields() []string {
+	return nil
 }
 
-// SetImageKey sets the "image_key" field.
-func (m *PetMutation) SetImageKey(s string) {
-	m.image_key = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DataMigrationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// ImageKey returns the value of the "image_key" field in the mutation.
-func (m *PetMutation) ImageKey() (r string, exists bool) {
-	v := m.image_key
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DataMigrationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown DataMigration numeric field %s", name)
 }
 
-// OldImageKey returns the old "image_key" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldImageKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageKey: %w", err)
-	}
-	return oldValue.ImageKey, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DataMigrationMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetImageKey resets all changes to the "image_key" field.
-func (m *PetMutation) ResetImageKey() {
-	m.image_key = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DataMigrationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PetMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DataMigrationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DataMigration nullable field %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PetMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DataMigrationMutation) ResetField(name string) error {
+	switch name {
+	case datamigration.FieldAppliedAt:
+		m.ResetAppliedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown DataMigration field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DataMigrationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PetMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DataMigrationMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PetMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DataMigrationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PetMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DataMigrationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Pet entity.
-// If the Pet object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PetMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DataMigrationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PetMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[pet.FieldDeletedAt] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DataMigrationMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PetMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[pet.FieldDeletedAt]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DataMigrationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DataMigration unique edge %s", name)
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PetMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, pet.FieldDeletedAt)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DataMigrationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DataMigration edge %s", name)
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *PetMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
+type DeviceTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	device_id     *string
+	token         *string
+	platform      *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*DeviceToken, error)
+	predicates    []predicate.DeviceToken
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *PetMutation) ClearOwner() {
-	m.clearedowner = true
-}
+var _ ent.Mutation = (*DeviceTokenMutation)(nil)
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *PetMutation) OwnerCleared() bool {
-	return m.clearedowner
-}
+// devicetokenOption allows management of the mutation configuration using functional options.
+type devicetokenOption func(*DeviceTokenMutation)
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *PetMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
+func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
+	m := &DeviceTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceToken,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
-}
-
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *PetMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *PetMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+	return m
 }
 
-// Where appends a list predicates to the PetMutation builder.
-func (m *PetMutation) Where(ps ...predicate.Pet) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Pet, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// withDeviceTokenID sets the ID field of the mutation.
+func withDeviceTokenID(id uuid.UUID) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceToken
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *PetMutation) Op() Op {
-	return m.op
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PetMutation) SetOp(op Op) {
-	m.op = op
+// withDeviceToken sets the old DeviceToken of the mutation.
+func withDeviceToken(node *DeviceToken) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
+		m.oldValue = func(context.Context) (*DeviceToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Type returns the node type of this mutation (Pet).
-func (m *PetMutation) Type() string {
-	return m.typ
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PetMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, pet.FieldName)
-	}
-	if m.birth_day != nil {
-		fields = append(fields, pet.FieldBirthDay)
-	}
-	if m._type != nil {
-		fields = append(fields, pet.FieldType)
-	}
-	if m.species != nil {
-		fields = append(fields, pet.FieldSpecies)
-	}
-	if m.image_key != nil {
-		fields = append(fields, pet.FieldImageKey)
-	}
-	if m.created_at != nil {
-		fields = append(fields, pet.FieldCreatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, pet.FieldDeletedAt)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return fields
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PetMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case pet.FieldName:
-		return m.Name()
-	case pet.FieldBirthDay:
-		return m.BirthDay()
-	case pet.FieldType:
-		return m.GetType()
-	case pet.FieldSpecies:
-		return m.Species()
-	case pet.FieldImageKey:
-		return m.ImageKey()
-	case pet.FieldCreatedAt:
-		return m.CreatedAt()
-	case pet.FieldDeletedAt:
-		return m.DeletedAt()
-	}
-	return nil, false
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeviceToken entities.
+func (m *DeviceTokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case pet.FieldName:
-		return m.OldName(ctx)
-	case pet.FieldBirthDay:
-		return m.OldBirthDay(ctx)
-	case pet.FieldType:
-		return m.OldType(ctx)
-	case pet.FieldSpecies:
-		return m.OldSpecies(ctx)
-	case pet.FieldImageKey:
-		return m.OldImageKey(ctx)
-	case pet.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case pet.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceTokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Pet field %s", name)
+	return *m.id, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PetMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case pet.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case pet.FieldBirthDay:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBirthDay(v)
-		return nil
-	case pet.FieldType:
-		v, ok := value.(pet.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case pet.FieldSpecies:
-		v, ok := value.(pet.Species)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpecies(v)
-		return nil
-	case pet.FieldImageKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImageKey(v)
-		return nil
-	case pet.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case pet.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
 		}
-		m.SetDeletedAt(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PetMutation) AddedFields() []string {
-	return nil
+// SetUserID sets the "user_id" field.
+func (m *DeviceTokenMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DeviceTokenMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PetMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldUserID returns the old "user_id" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Pet numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PetMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(pet.FieldDeletedAt) {
-		fields = append(fields, pet.FieldDeletedAt)
-	}
-	return fields
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DeviceTokenMutation) ResetUserID() {
+	m.user = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PetMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceTokenMutation) SetDeviceID(s string) {
+	m.device_id = &s
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PetMutation) ClearField(name string) error {
-	switch name {
-	case pet.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceTokenMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Pet nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PetMutation) ResetField(name string) error {
-	switch name {
-	case pet.FieldName:
-		m.ResetName()
-		return nil
-	case pet.FieldBirthDay:
-		m.ResetBirthDay()
-		return nil
-	case pet.FieldType:
-		m.ResetType()
-		return nil
-	case pet.FieldSpecies:
-		m.ResetSpecies()
-		return nil
-	case pet.FieldImageKey:
-		m.ResetImageKey()
-		return nil
-	case pet.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case pet.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
+// OldDeviceID returns the old "device_id" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Pet field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.owner != nil {
-		edges = append(edges, pet.EdgeOwner)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PetMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case pet.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
 	}
-	return nil
+	return oldValue.DeviceID, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceTokenMutation) ResetDeviceID() {
+	m.device_id = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PetMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetToken sets the "token" field.
+func (m *DeviceTokenMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedowner {
-		edges = append(edges, pet.EdgeOwner)
+// Token returns the value of the "token" field in the mutation.
+func (m *DeviceTokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PetMutation) EdgeCleared(name string) bool {
-	switch name {
-	case pet.EdgeOwner:
-		return m.clearedowner
+// OldToken returns the old "token" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PetMutation) ClearEdge(name string) error {
-	switch name {
-	case pet.EdgeOwner:
-		m.ClearOwner()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Pet unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PetMutation) ResetEdge(name string) error {
-	switch name {
-	case pet.EdgeOwner:
-		m.ResetOwner()
-		return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return fmt.Errorf("unknown Pet edge %s", name)
+	return oldValue.Token, nil
 }
 
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	index             *uint32
-	addindex          *int32
-	caption           *string
-	image_key         *string
-	created_at        *time.Time
-	deleted_at        *time.Time
-	clearedFields     map[string]struct{}
-	user              *uuid.UUID
-	cleareduser       bool
-	comments          map[uuid.UUID]struct{}
-	removedcomments   map[uuid.UUID]struct{}
-	clearedcomments   bool
-	likes             map[uuid.UUID]struct{}
-	removedlikes      map[uuid.UUID]struct{}
-	clearedlikes      bool
-	daily_task        *uuid.UUID
-	cleareddaily_task bool
-	done              bool
-	oldValue          func(context.Context) (*Post, error)
-	predicates        []predicate.Post
+// ResetToken resets all changes to the "token" field.
+func (m *DeviceTokenMutation) ResetToken() {
+	m.token = nil
 }
 
-var _ ent.Mutation = (*PostMutation)(nil)
-
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
-
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePost,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetPlatform sets the "platform" field.
+func (m *DeviceTokenMutation) SetPlatform(s string) {
+	m.platform = &s
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id uuid.UUID) postOption {
-	return func(m *PostMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Post
-		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Post.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// Platform returns the value of the "platform" field in the mutation.
+func (m *DeviceTokenMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldPlatform returns the old "platform" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetPlatform resets all changes to the "platform" field.
+func (m *DeviceTokenMutation) ResetPlatform() {
+	m.platform = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Post entities.
-func (m *PostMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetCreatedAt sets the "created_at" field.
+func (m *DeviceTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeviceTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetIndex sets the "index" field.
-func (m *PostMutation) SetIndex(u uint32) {
-	m.index = &u
-	m.addindex = nil
-}
-
-// Index returns the value of the "index" field in the mutation.
-func (m *PostMutation) Index() (r uint32, exists bool) {
-	v := m.index
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIndex returns the old "index" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldIndex(ctx context.Context) (v uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIndex requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
-	}
-	return oldValue.Index, nil
-}
-
-// AddIndex adds u to the "index" field.
-func (m *PostMutation) AddIndex(u int32) {
-	if m.addindex != nil {
-		*m.addindex += u
-	} else {
-		m.addindex = &u
-	}
-}
-
-// AddedIndex returns the value that was added to the "index" field in this mutation.
-func (m *PostMutation) AddedIndex() (r int32, exists bool) {
-	v := m.addindex
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearIndex clears the value of the "index" field.
-func (m *PostMutation) ClearIndex() {
-	m.index = nil
-	m.addindex = nil
-	m.clearedFields[post.FieldIndex] = struct{}{}
-}
-
-// IndexCleared returns if the "index" field was cleared in this mutation.
-func (m *PostMutation) IndexCleared() bool {
-	_, ok := m.clearedFields[post.FieldIndex]
-	return ok
-}
-
-// ResetIndex resets all changes to the "index" field.
-func (m *PostMutation) ResetIndex() {
-	m.index = nil
-	m.addindex = nil
-	delete(m.clearedFields, post.FieldIndex)
-}
-
-// SetCaption sets the "caption" field.
-func (m *PostMutation) SetCaption(s string) {
-	m.caption = &s
-}
-
-// Caption returns the value of the "caption" field in the mutation.
-func (m *PostMutation) Caption() (r string, exists bool) {
-	v := m.caption
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCaption returns the old "caption" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCaption(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaption is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaption requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaption: %w", err)
-	}
-	return oldValue.Caption, nil
-}
-
-// ResetCaption resets all changes to the "caption" field.
-func (m *PostMutation) ResetCaption() {
-	m.caption = nil
-}
-
-// SetImageKey sets the "image_key" field.
-func (m *PostMutation) SetImageKey(s string) {
-	m.image_key = &s
-}
-
-// ImageKey returns the value of the "image_key" field in the mutation.
-func (m *PostMutation) ImageKey() (r string, exists bool) {
-	v := m.image_key
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldImageKey returns the old "image_key" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldImageKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageKey: %w", err)
-	}
-	return oldValue.ImageKey, nil
-}
-
-// ResetImageKey resets all changes to the "image_key" field.
-func (m *PostMutation) ResetImageKey() {
-	m.image_key = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *PostMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4204,86 +4182,61 @@ func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostMutation) ResetCreatedAt() {
+func (m *DeviceTokenMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *PostMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeviceTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeviceTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *PostMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[post.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *PostMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *PostMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, post.FieldDeletedAt)
+	return oldValue.UpdatedAt, nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *PostMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeviceTokenMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *PostMutation) ClearUser() {
+func (m *DeviceTokenMutation) ClearUser() {
 	m.cleareduser = true
+	m.clearedFields[devicetoken.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PostMutation) UserCleared() bool {
+func (m *DeviceTokenMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *PostMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) UserIDs() (ids []uuid.UUID) {
+func (m *DeviceTokenMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4291,167 +4244,20 @@ func (m *PostMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *PostMutation) ResetUser() {
+func (m *DeviceTokenMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *PostMutation) AddCommentIDs(ids ...uuid.UUID) {
-	if m.comments == nil {
-		m.comments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
-	}
-}
-
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *PostMutation) ClearComments() {
-	m.clearedcomments = true
-}
-
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *PostMutation) CommentsCleared() bool {
-	return m.clearedcomments
-}
-
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *PostMutation) RemoveCommentIDs(ids ...uuid.UUID) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *PostMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *PostMutation) CommentsIDs() (ids []uuid.UUID) {
-	for id := range m.comments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetComments resets all changes to the "comments" edge.
-func (m *PostMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
-}
-
-// AddLikeIDs adds the "likes" edge to the Like entity by ids.
-func (m *PostMutation) AddLikeIDs(ids ...uuid.UUID) {
-	if m.likes == nil {
-		m.likes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.likes[ids[i]] = struct{}{}
-	}
-}
-
-// ClearLikes clears the "likes" edge to the Like entity.
-func (m *PostMutation) ClearLikes() {
-	m.clearedlikes = true
-}
-
-// LikesCleared reports if the "likes" edge to the Like entity was cleared.
-func (m *PostMutation) LikesCleared() bool {
-	return m.clearedlikes
-}
-
-// RemoveLikeIDs removes the "likes" edge to the Like entity by IDs.
-func (m *PostMutation) RemoveLikeIDs(ids ...uuid.UUID) {
-	if m.removedlikes == nil {
-		m.removedlikes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.likes, ids[i])
-		m.removedlikes[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedLikes returns the removed IDs of the "likes" edge to the Like entity.
-func (m *PostMutation) RemovedLikesIDs() (ids []uuid.UUID) {
-	for id := range m.removedlikes {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// LikesIDs returns the "likes" edge IDs in the mutation.
-func (m *PostMutation) LikesIDs() (ids []uuid.UUID) {
-	for id := range m.likes {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetLikes resets all changes to the "likes" edge.
-func (m *PostMutation) ResetLikes() {
-	m.likes = nil
-	m.clearedlikes = false
-	m.removedlikes = nil
-}
-
-// SetDailyTaskID sets the "daily_task" edge to the DailyTask entity by id.
-func (m *PostMutation) SetDailyTaskID(id uuid.UUID) {
-	m.daily_task = &id
-}
-
-// ClearDailyTask clears the "daily_task" edge to the DailyTask entity.
-func (m *PostMutation) ClearDailyTask() {
-	m.cleareddaily_task = true
-}
-
-// DailyTaskCleared reports if the "daily_task" edge to the DailyTask entity was cleared.
-func (m *PostMutation) DailyTaskCleared() bool {
-	return m.cleareddaily_task
-}
-
-// DailyTaskID returns the "daily_task" edge ID in the mutation.
-func (m *PostMutation) DailyTaskID() (id uuid.UUID, exists bool) {
-	if m.daily_task != nil {
-		return *m.daily_task, true
-	}
-	return
-}
-
-// DailyTaskIDs returns the "daily_task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DailyTaskID instead. It exists only for internal usage by the builders.
-func (m *PostMutation) DailyTaskIDs() (ids []uuid.UUID) {
-	if id := m.daily_task; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetDailyTask resets all changes to the "daily_task" edge.
-func (m *PostMutation) ResetDailyTask() {
-	m.daily_task = nil
-	m.cleareddaily_task = false
-}
-
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
+// Where appends a list predicates to the DeviceTokenMutation builder.
+func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeviceTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
+func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4459,39 +4265,42 @@ func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostMutation) Op() Op {
+func (m *DeviceTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
+func (m *DeviceTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
+// Type returns the node type of this mutation (DeviceToken).
+func (m *DeviceTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.index != nil {
-		fields = append(fields, post.FieldIndex)
+func (m *DeviceTokenMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user != nil {
+		fields = append(fields, devicetoken.FieldUserID)
 	}
-	if m.caption != nil {
-		fields = append(fields, post.FieldCaption)
+	if m.device_id != nil {
+		fields = append(fields, devicetoken.FieldDeviceID)
 	}
-	if m.image_key != nil {
-		fields = append(fields, post.FieldImageKey)
+	if m.token != nil {
+		fields = append(fields, devicetoken.FieldToken)
+	}
+	if m.platform != nil {
+		fields = append(fields, devicetoken.FieldPlatform)
 	}
 	if m.created_at != nil {
-		fields = append(fields, post.FieldCreatedAt)
+		fields = append(fields, devicetoken.FieldCreatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, post.FieldDeletedAt)
+	if m.updated_at != nil {
+		fields = append(fields, devicetoken.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4499,18 +4308,20 @@ func (m *PostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
+func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldIndex:
-		return m.Index()
-	case post.FieldCaption:
-		return m.Caption()
-	case post.FieldImageKey:
-		return m.ImageKey()
-	case post.FieldCreatedAt:
+	case devicetoken.FieldUserID:
+		return m.UserID()
+	case devicetoken.FieldDeviceID:
+		return m.DeviceID()
+	case devicetoken.FieldToken:
+		return m.Token()
+	case devicetoken.FieldPlatform:
+		return m.Platform()
+	case devicetoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case post.FieldDeletedAt:
-		return m.DeletedAt()
+	case devicetoken.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4518,368 +4329,246 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case post.FieldIndex:
-		return m.OldIndex(ctx)
-	case post.FieldCaption:
-		return m.OldCaption(ctx)
-	case post.FieldImageKey:
-		return m.OldImageKey(ctx)
-	case post.FieldCreatedAt:
+	case devicetoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case devicetoken.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case devicetoken.FieldToken:
+		return m.OldToken(ctx)
+	case devicetoken.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case devicetoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case post.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case devicetoken.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldIndex:
-		v, ok := value.(uint32)
+	case devicetoken.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIndex(v)
+		m.SetUserID(v)
 		return nil
-	case post.FieldCaption:
+	case devicetoken.FieldDeviceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCaption(v)
+		m.SetDeviceID(v)
 		return nil
-	case post.FieldImageKey:
+	case devicetoken.FieldToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImageKey(v)
+		m.SetToken(v)
 		return nil
-	case post.FieldCreatedAt:
+	case devicetoken.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case devicetoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case post.FieldDeletedAt:
+	case devicetoken.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostMutation) AddedFields() []string {
-	var fields []string
-	if m.addindex != nil {
-		fields = append(fields, post.FieldIndex)
-	}
-	return fields
+func (m *DeviceTokenMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case post.FieldIndex:
-		return m.AddedIndex()
-	}
+func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldIndex:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIndex(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
+	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(post.FieldIndex) {
-		fields = append(fields, post.FieldIndex)
-	}
-	if m.FieldCleared(post.FieldDeletedAt) {
-		fields = append(fields, post.FieldDeletedAt)
-	}
-	return fields
+func (m *DeviceTokenMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *DeviceTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
-	switch name {
-	case post.FieldIndex:
-		m.ClearIndex()
-		return nil
-	case post.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Post nullable field %s", name)
+func (m *DeviceTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *DeviceTokenMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldIndex:
-		m.ResetIndex()
+	case devicetoken.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case post.FieldCaption:
-		m.ResetCaption()
+	case devicetoken.FieldDeviceID:
+		m.ResetDeviceID()
 		return nil
-	case post.FieldImageKey:
-		m.ResetImageKey()
+	case devicetoken.FieldToken:
+		m.ResetToken()
 		return nil
-	case post.FieldCreatedAt:
+	case devicetoken.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case devicetoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case post.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case devicetoken.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *DeviceTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, post.EdgeUser)
-	}
-	if m.comments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.likes != nil {
-		edges = append(edges, post.EdgeLikes)
-	}
-	if m.daily_task != nil {
-		edges = append(edges, post.EdgeDailyTask)
+		edges = append(edges, devicetoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
+func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeUser:
+	case devicetoken.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.likes))
-		for id := range m.likes {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeDailyTask:
-		if id := m.daily_task; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedcomments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.removedlikes != nil {
-		edges = append(edges, post.EdgeLikes)
-	}
+func (m *DeviceTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.removedlikes))
-		for id := range m.removedlikes {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *DeviceTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, post.EdgeUser)
-	}
-	if m.clearedcomments {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.clearedlikes {
-		edges = append(edges, post.EdgeLikes)
-	}
-	if m.cleareddaily_task {
-		edges = append(edges, post.EdgeDailyTask)
+		edges = append(edges, devicetoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case post.EdgeUser:
+	case devicetoken.EdgeUser:
 		return m.cleareduser
-	case post.EdgeComments:
-		return m.clearedcomments
-	case post.EdgeLikes:
-		return m.clearedlikes
-	case post.EdgeDailyTask:
-		return m.cleareddaily_task
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
+func (m *DeviceTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case post.EdgeUser:
+	case devicetoken.EdgeUser:
 		m.ClearUser()
 		return nil
-	case post.EdgeDailyTask:
-		m.ClearDailyTask()
-		return nil
 	}
-	return fmt.Errorf("unknown Post unique edge %s", name)
+	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
+func (m *DeviceTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case post.EdgeUser:
+	case devicetoken.EdgeUser:
 		m.ResetUser()
 		return nil
-	case post.EdgeComments:
-		m.ResetComments()
-		return nil
-	case post.EdgeLikes:
-		m.ResetLikes()
-		return nil
-	case post.EdgeDailyTask:
-		m.ResetDailyTask()
-		return nil
 	}
-	return fmt.Errorf("unknown Post edge %s", name)
+	return fmt.Errorf("unknown DeviceToken edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// ExploreRankingMutation represents an operation that mutates the ExploreRanking nodes in the graph.
+type ExploreRankingMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	index                *uint32
-	addindex             *int32
-	email                *string
-	name                 *string
-	bio                  *string
-	streak_count         *uint32
-	addstreak_count      *int32
-	icon_image_key       *string
-	created_at           *time.Time
-	clearedFields        map[string]struct{}
-	posts                map[uuid.UUID]struct{}
-	removedposts         map[uuid.UUID]struct{}
-	clearedposts         bool
-	comments             map[uuid.UUID]struct{}
-	removedcomments      map[uuid.UUID]struct{}
-	clearedcomments      bool
-	likes                map[uuid.UUID]struct{}
-	removedlikes         map[uuid.UUID]struct{}
-	clearedlikes         bool
-	pets                 map[uuid.UUID]struct{}
-	removedpets          map[uuid.UUID]struct{}
-	clearedpets          bool
-	following            map[uuid.UUID]struct{}
-	removedfollowing     map[uuid.UUID]struct{}
-	clearedfollowing     bool
-	followers            map[uuid.UUID]struct{}
-	removedfollowers     map[uuid.UUID]struct{}
-	clearedfollowers     bool
-	blocking             map[uuid.UUID]struct{}
-	removedblocking      map[uuid.UUID]struct{}
-	clearedblocking      bool
-	blocked_by           map[uuid.UUID]struct{}
-	removedblocked_by    map[uuid.UUID]struct{}
-	clearedblocked_by    bool
-	daily_tasks          map[uuid.UUID]struct{}
-	removeddaily_tasks   map[uuid.UUID]struct{}
-	cleareddaily_tasks   bool
-	device_tokens        map[uuid.UUID]struct{}
-	removeddevice_tokens map[uuid.UUID]struct{}
-	cleareddevice_tokens bool
-	done                 bool
-	oldValue             func(context.Context) (*User, error)
-	predicates           []predicate.User
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	position      *int
+	addposition   *int
+	score         *float64
+	addscore      *float64
+	computed_at   *time.Time
+	clearedFields map[string]struct{}
+	post          *uuid.UUID
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*ExploreRanking, error)
+	predicates    []predicate.ExploreRanking
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*ExploreRankingMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// explorerankingOption allows management of the mutation configuration using functional options.
+type explorerankingOption func(*ExploreRankingMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newExploreRankingMutation creates new mutation for the ExploreRanking entity.
+func newExploreRankingMutation(c config, op Op, opts ...explorerankingOption) *ExploreRankingMutation {
+	m := &ExploreRankingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeExploreRanking,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4888,20 +4577,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withExploreRankingID sets the ID field of the mutation.
+func withExploreRankingID(id uuid.UUID) explorerankingOption {
+	return func(m *ExploreRankingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *ExploreRanking
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*ExploreRanking, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().ExploreRanking.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4910,10 +4599,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withExploreRanking sets the old ExploreRanking of the mutation.
+func withExploreRanking(node *ExploreRanking) explorerankingOption {
+	return func(m *ExploreRankingMutation) {
+		m.oldValue = func(context.Context) (*ExploreRanking, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4922,7 +4611,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m ExploreRankingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4930,7 +4619,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m ExploreRankingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4940,14 +4629,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ExploreRanking entities.
+func (m *ExploreRankingMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ExploreRankingMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4958,7 +4647,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ExploreRankingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4967,880 +4656,1167 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExploreRanking.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIndex sets the "index" field.
-func (m *UserMutation) SetIndex(u uint32) {
-	m.index = &u
-	m.addindex = nil
+// SetPosition sets the "position" field.
+func (m *ExploreRankingMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// Index returns the value of the "index" field in the mutation.
-func (m *UserMutation) Index() (r uint32, exists bool) {
-	v := m.index
+// Position returns the value of the "position" field in the mutation.
+func (m *ExploreRankingMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIndex returns the old "index" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the ExploreRanking entity.
+// If the ExploreRanking object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIndex(ctx context.Context) (v uint32, err error) {
+func (m *ExploreRankingMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIndex requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.Index, nil
+	return oldValue.Position, nil
 }
 
-// AddIndex adds u to the "index" field.
-func (m *UserMutation) AddIndex(u int32) {
-	if m.addindex != nil {
-		*m.addindex += u
+// AddPosition adds i to the "position" field.
+func (m *ExploreRankingMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
 	} else {
-		m.addindex = &u
+		m.addposition = &i
 	}
 }
 
-// AddedIndex returns the value that was added to the "index" field in this mutation.
-func (m *UserMutation) AddedIndex() (r int32, exists bool) {
-	v := m.addindex
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ExploreRankingMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearIndex clears the value of the "index" field.
-func (m *UserMutation) ClearIndex() {
-	m.index = nil
-	m.addindex = nil
-	m.clearedFields[user.FieldIndex] = struct{}{}
+// ResetPosition resets all changes to the "position" field.
+func (m *ExploreRankingMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// IndexCleared returns if the "index" field was cleared in this mutation.
-func (m *UserMutation) IndexCleared() bool {
-	_, ok := m.clearedFields[user.FieldIndex]
-	return ok
-}
-
-// ResetIndex resets all changes to the "index" field.
-func (m *UserMutation) ResetIndex() {
-	m.index = nil
-	m.addindex = nil
-	delete(m.clearedFields, user.FieldIndex)
-}
-
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetScore sets the "score" field.
+func (m *ExploreRankingMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// Score returns the value of the "score" field in the mutation.
+func (m *ExploreRankingMutation) Score() (r float64, exists bool) {
+	v := m.score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldScore returns the old "score" field's value of the ExploreRanking entity.
+// If the ExploreRanking object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *ExploreRankingMutation) OldScore(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
 	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+	return oldValue.Score, nil
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// AddScore adds f to the "score" field.
+func (m *ExploreRankingMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *ExploreRankingMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ResetScore resets all changes to the "score" field.
+func (m *ExploreRankingMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
 }
 
-// SetBio sets the "bio" field.
-func (m *UserMutation) SetBio(s string) {
-	m.bio = &s
+// SetComputedAt sets the "computed_at" field.
+func (m *ExploreRankingMutation) SetComputedAt(t time.Time) {
+	m.computed_at = &t
 }
 
-// Bio returns the value of the "bio" field in the mutation.
-func (m *UserMutation) Bio() (r string, exists bool) {
-	v := m.bio
+// ComputedAt returns the value of the "computed_at" field in the mutation.
+func (m *ExploreRankingMutation) ComputedAt() (r time.Time, exists bool) {
+	v := m.computed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBio returns the old "bio" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldComputedAt returns the old "computed_at" field's value of the ExploreRanking entity.
+// If the ExploreRanking object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBio(ctx context.Context) (v string, err error) {
+func (m *ExploreRankingMutation) OldComputedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+		return v, errors.New("OldComputedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBio requires an ID field in the mutation")
+		return v, errors.New("OldComputedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+		return v, fmt.Errorf("querying old value for OldComputedAt: %w", err)
 	}
-	return oldValue.Bio, nil
+	return oldValue.ComputedAt, nil
 }
 
-// ResetBio resets all changes to the "bio" field.
-func (m *UserMutation) ResetBio() {
-	m.bio = nil
+// ResetComputedAt resets all changes to the "computed_at" field.
+func (m *ExploreRankingMutation) ResetComputedAt() {
+	m.computed_at = nil
 }
 
-// SetStreakCount sets the "streak_count" field.
-func (m *UserMutation) SetStreakCount(u uint32) {
-	m.streak_count = &u
-	m.addstreak_count = nil
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *ExploreRankingMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
 }
 
-// StreakCount returns the value of the "streak_count" field in the mutation.
-func (m *UserMutation) StreakCount() (r uint32, exists bool) {
-	v := m.streak_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearPost clears the "post" edge to the Post entity.
+func (m *ExploreRankingMutation) ClearPost() {
+	m.clearedpost = true
 }
 
-// OldStreakCount returns the old "streak_count" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStreakCount(ctx context.Context) (v uint32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStreakCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStreakCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStreakCount: %w", err)
-	}
-	return oldValue.StreakCount, nil
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *ExploreRankingMutation) PostCleared() bool {
+	return m.clearedpost
 }
 
-// AddStreakCount adds u to the "streak_count" field.
-func (m *UserMutation) AddStreakCount(u int32) {
-	if m.addstreak_count != nil {
-		*m.addstreak_count += u
-	} else {
-		m.addstreak_count = &u
+// PostID returns the "post" edge ID in the mutation.
+func (m *ExploreRankingMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
 	}
+	return
 }
 
-// AddedStreakCount returns the value that was added to the "streak_count" field in this mutation.
-func (m *UserMutation) AddedStreakCount() (r int32, exists bool) {
-	v := m.addstreak_count
-	if v == nil {
-		return
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *ExploreRankingMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
-}
-
-// ResetStreakCount resets all changes to the "streak_count" field.
-func (m *UserMutation) ResetStreakCount() {
-	m.streak_count = nil
-	m.addstreak_count = nil
+	return
 }
 
-// SetIconImageKey sets the "icon_image_key" field.
-func (m *UserMutation) SetIconImageKey(s string) {
-	m.icon_image_key = &s
+// ResetPost resets all changes to the "post" edge.
+func (m *ExploreRankingMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// IconImageKey returns the value of the "icon_image_key" field in the mutation.
-func (m *UserMutation) IconImageKey() (r string, exists bool) {
-	v := m.icon_image_key
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the ExploreRankingMutation builder.
+func (m *ExploreRankingMutation) Where(ps ...predicate.ExploreRanking) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldIconImageKey returns the old "icon_image_key" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIconImageKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIconImageKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIconImageKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIconImageKey: %w", err)
+// WhereP appends storage-level predicates to the ExploreRankingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExploreRankingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExploreRanking, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.IconImageKey, nil
+	m.Where(p...)
 }
 
-// ClearIconImageKey clears the value of the "icon_image_key" field.
-func (m *UserMutation) ClearIconImageKey() {
-	m.icon_image_key = nil
-	m.clearedFields[user.FieldIconImageKey] = struct{}{}
+// Op returns the operation name.
+func (m *ExploreRankingMutation) Op() Op {
+	return m.op
 }
 
-// IconImageKeyCleared returns if the "icon_image_key" field was cleared in this mutation.
-func (m *UserMutation) IconImageKeyCleared() bool {
-	_, ok := m.clearedFields[user.FieldIconImageKey]
-	return ok
+// SetOp allows setting the mutation operation.
+func (m *ExploreRankingMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ResetIconImageKey resets all changes to the "icon_image_key" field.
-func (m *UserMutation) ResetIconImageKey() {
-	m.icon_image_key = nil
-	delete(m.clearedFields, user.FieldIconImageKey)
+// Type returns the node type of this mutation (ExploreRanking).
+func (m *ExploreRankingMutation) Type() string {
+	return m.typ
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExploreRankingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.position != nil {
+		fields = append(fields, exploreranking.FieldPosition)
+	}
+	if m.score != nil {
+		fields = append(fields, exploreranking.FieldScore)
+	}
+	if m.computed_at != nil {
+		fields = append(fields, exploreranking.FieldComputedAt)
+	}
+	return fields
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExploreRankingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exploreranking.FieldPosition:
+		return m.Position()
+	case exploreranking.FieldScore:
+		return m.Score()
+	case exploreranking.FieldComputedAt:
+		return m.ComputedAt()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExploreRankingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exploreranking.FieldPosition:
+		return m.OldPosition(ctx)
+	case exploreranking.FieldScore:
+		return m.OldScore(ctx)
+	case exploreranking.FieldComputedAt:
+		return m.OldComputedAt(ctx)
 	}
-	return oldValue.CreatedAt, nil
+	return nil, fmt.Errorf("unknown ExploreRanking field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExploreRankingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exploreranking.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case exploreranking.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case exploreranking.FieldComputedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComputedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExploreRanking field %s", name)
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *UserMutation) AddPostIDs(ids ...uuid.UUID) {
-	if m.posts == nil {
-		m.posts = make(map[uuid.UUID]struct{})
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExploreRankingMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, exploreranking.FieldPosition)
 	}
-	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
+	if m.addscore != nil {
+		fields = append(fields, exploreranking.FieldScore)
 	}
+	return fields
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *UserMutation) ClearPosts() {
-	m.clearedposts = true
-}
-
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *UserMutation) PostsCleared() bool {
-	return m.clearedposts
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExploreRankingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exploreranking.FieldPosition:
+		return m.AddedPosition()
+	case exploreranking.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemovePostIDs(ids ...uuid.UUID) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExploreRankingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exploreranking.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case exploreranking.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
 	}
+	return fmt.Errorf("unknown ExploreRanking numeric field %s", name)
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *UserMutation) RemovedPostsIDs() (ids []uuid.UUID) {
-	for id := range m.removedposts {
-		ids = append(ids, id)
-	}
-	return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExploreRankingMutation) ClearedFields() []string {
+	return nil
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *UserMutation) PostsIDs() (ids []uuid.UUID) {
-	for id := range m.posts {
-		ids = append(ids, id)
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExploreRankingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *UserMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExploreRankingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExploreRanking nullable field %s", name)
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *UserMutation) AddCommentIDs(ids ...uuid.UUID) {
-	if m.comments == nil {
-		m.comments = make(map[uuid.UUID]struct{})
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExploreRankingMutation) ResetField(name string) error {
+	switch name {
+	case exploreranking.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case exploreranking.FieldScore:
+		m.ResetScore()
+		return nil
+	case exploreranking.FieldComputedAt:
+		m.ResetComputedAt()
+		return nil
 	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
+	return fmt.Errorf("unknown ExploreRanking field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExploreRankingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.post != nil {
+		edges = append(edges, exploreranking.EdgePost)
 	}
+	return edges
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *UserMutation) ClearComments() {
-	m.clearedcomments = true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExploreRankingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case exploreranking.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *UserMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExploreRankingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *UserMutation) RemoveCommentIDs(ids ...uuid.UUID) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExploreRankingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *UserMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExploreRankingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpost {
+		edges = append(edges, exploreranking.EdgePost)
 	}
-	return
+	return edges
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *UserMutation) CommentsIDs() (ids []uuid.UUID) {
-	for id := range m.comments {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExploreRankingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case exploreranking.EdgePost:
+		return m.clearedpost
 	}
-	return
+	return false
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *UserMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExploreRankingMutation) ClearEdge(name string) error {
+	switch name {
+	case exploreranking.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown ExploreRanking unique edge %s", name)
 }
 
-// AddLikeIDs adds the "likes" edge to the Like entity by ids.
-func (m *UserMutation) AddLikeIDs(ids ...uuid.UUID) {
-	if m.likes == nil {
-		m.likes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.likes[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExploreRankingMutation) ResetEdge(name string) error {
+	switch name {
+	case exploreranking.EdgePost:
+		m.ResetPost()
+		return nil
 	}
+	return fmt.Errorf("unknown ExploreRanking edge %s", name)
 }
 
-// ClearLikes clears the "likes" edge to the Like entity.
-func (m *UserMutation) ClearLikes() {
-	m.clearedlikes = true
+// FollowRelationMutation represents an operation that mutates the FollowRelation nodes in the graph.
+type FollowRelationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	from          *uuid.UUID
+	clearedfrom   bool
+	to            *uuid.UUID
+	clearedto     bool
+	done          bool
+	oldValue      func(context.Context) (*FollowRelation, error)
+	predicates    []predicate.FollowRelation
 }
 
-// LikesCleared reports if the "likes" edge to the Like entity was cleared.
-func (m *UserMutation) LikesCleared() bool {
-	return m.clearedlikes
-}
+var _ ent.Mutation = (*FollowRelationMutation)(nil)
 
-// RemoveLikeIDs removes the "likes" edge to the Like entity by IDs.
-func (m *UserMutation) RemoveLikeIDs(ids ...uuid.UUID) {
-	if m.removedlikes == nil {
-		m.removedlikes = make(map[uuid.UUID]struct{})
+// followrelationOption allows management of the mutation configuration using functional options.
+type followrelationOption func(*FollowRelationMutation)
+
+// newFollowRelationMutation creates new mutation for the FollowRelation entity.
+func newFollowRelationMutation(c config, op Op, opts ...followrelationOption) *FollowRelationMutation {
+	m := &FollowRelationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFollowRelation,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.likes, ids[i])
-		m.removedlikes[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedLikes returns the removed IDs of the "likes" edge to the Like entity.
-func (m *UserMutation) RemovedLikesIDs() (ids []uuid.UUID) {
-	for id := range m.removedlikes {
-		ids = append(ids, id)
+// withFollowRelationID sets the ID field of the mutation.
+func withFollowRelationID(id uuid.UUID) followrelationOption {
+	return func(m *FollowRelationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FollowRelation
+		)
+		m.oldValue = func(ctx context.Context) (*FollowRelation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FollowRelation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// LikesIDs returns the "likes" edge IDs in the mutation.
-func (m *UserMutation) LikesIDs() (ids []uuid.UUID) {
-	for id := range m.likes {
-		ids = append(ids, id)
+// withFollowRelation sets the old FollowRelation of the mutation.
+func withFollowRelation(node *FollowRelation) followrelationOption {
+	return func(m *FollowRelationMutation) {
+		m.oldValue = func(context.Context) (*FollowRelation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetLikes resets all changes to the "likes" edge.
-func (m *UserMutation) ResetLikes() {
-	m.likes = nil
-	m.clearedlikes = false
-	m.removedlikes = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FollowRelationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddPetIDs adds the "pets" edge to the Pet entity by ids.
-func (m *UserMutation) AddPetIDs(ids ...uuid.UUID) {
-	if m.pets == nil {
-		m.pets = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.pets[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FollowRelationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearPets clears the "pets" edge to the Pet entity.
-func (m *UserMutation) ClearPets() {
-	m.clearedpets = true
-}
-
-// PetsCleared reports if the "pets" edge to the Pet entity was cleared.
-func (m *UserMutation) PetsCleared() bool {
-	return m.clearedpets
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FollowRelation entities.
+func (m *FollowRelationMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// RemovePetIDs removes the "pets" edge to the Pet entity by IDs.
-func (m *UserMutation) RemovePetIDs(ids ...uuid.UUID) {
-	if m.removedpets == nil {
-		m.removedpets = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.pets, ids[i])
-		m.removedpets[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FollowRelationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedPets returns the removed IDs of the "pets" edge to the Pet entity.
-func (m *UserMutation) RemovedPetsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpets {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FollowRelationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FollowRelation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// PetsIDs returns the "pets" edge IDs in the mutation.
-func (m *UserMutation) PetsIDs() (ids []uuid.UUID) {
-	for id := range m.pets {
-		ids = append(ids, id)
-	}
-	return
+// SetCreatedAt sets the "created_at" field.
+func (m *FollowRelationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetPets resets all changes to the "pets" edge.
-func (m *UserMutation) ResetPets() {
-	m.pets = nil
-	m.clearedpets = false
-	m.removedpets = nil
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FollowRelationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddFollowingIDs adds the "following" edge to the FollowRelation entity by ids.
-func (m *UserMutation) AddFollowingIDs(ids ...uuid.UUID) {
-	if m.following == nil {
-		m.following = make(map[uuid.UUID]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the FollowRelation entity.
+// If the FollowRelation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowRelationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.following[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearFollowing clears the "following" edge to the FollowRelation entity.
-func (m *UserMutation) ClearFollowing() {
-	m.clearedfollowing = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FollowRelationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// FollowingCleared reports if the "following" edge to the FollowRelation entity was cleared.
-func (m *UserMutation) FollowingCleared() bool {
-	return m.clearedfollowing
+// SetFromID sets the "from" edge to the User entity by id.
+func (m *FollowRelationMutation) SetFromID(id uuid.UUID) {
+	m.from = &id
 }
 
-// RemoveFollowingIDs removes the "following" edge to the FollowRelation entity by IDs.
-func (m *UserMutation) RemoveFollowingIDs(ids ...uuid.UUID) {
-	if m.removedfollowing == nil {
-		m.removedfollowing = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.following, ids[i])
-		m.removedfollowing[ids[i]] = struct{}{}
-	}
+// ClearFrom clears the "from" edge to the User entity.
+func (m *FollowRelationMutation) ClearFrom() {
+	m.clearedfrom = true
 }
 
-// RemovedFollowing returns the removed IDs of the "following" edge to the FollowRelation entity.
-func (m *UserMutation) RemovedFollowingIDs() (ids []uuid.UUID) {
-	for id := range m.removedfollowing {
-		ids = append(ids, id)
+// FromCleared reports if the "from" edge to the User entity was cleared.
+func (m *FollowRelationMutation) FromCleared() bool {
+	return m.clearedfrom
+}
+
+// FromID returns the "from" edge ID in the mutation.
+func (m *FollowRelationMutation) FromID() (id uuid.UUID, exists bool) {
+	if m.from != nil {
+		return *m.from, true
 	}
 	return
 }
 
-// FollowingIDs returns the "following" edge IDs in the mutation.
-func (m *UserMutation) FollowingIDs() (ids []uuid.UUID) {
-	for id := range m.following {
-		ids = append(ids, id)
+// FromIDs returns the "from" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FromID instead. It exists only for internal usage by the builders.
+func (m *FollowRelationMutation) FromIDs() (ids []uuid.UUID) {
+	if id := m.from; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFollowing resets all changes to the "following" edge.
-func (m *UserMutation) ResetFollowing() {
-	m.following = nil
-	m.clearedfollowing = false
-	m.removedfollowing = nil
+// ResetFrom resets all changes to the "from" edge.
+func (m *FollowRelationMutation) ResetFrom() {
+	m.from = nil
+	m.clearedfrom = false
 }
 
-// AddFollowerIDs adds the "followers" edge to the FollowRelation entity by ids.
-func (m *UserMutation) AddFollowerIDs(ids ...uuid.UUID) {
-	if m.followers == nil {
-		m.followers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.followers[ids[i]] = struct{}{}
-	}
+// SetToID sets the "to" edge to the User entity by id.
+func (m *FollowRelationMutation) SetToID(id uuid.UUID) {
+	m.to = &id
 }
 
-// ClearFollowers clears the "followers" edge to the FollowRelation entity.
-func (m *UserMutation) ClearFollowers() {
-	m.clearedfollowers = true
+// ClearTo clears the "to" edge to the User entity.
+func (m *FollowRelationMutation) ClearTo() {
+	m.clearedto = true
 }
 
-// FollowersCleared reports if the "followers" edge to the FollowRelation entity was cleared.
-func (m *UserMutation) FollowersCleared() bool {
-	return m.clearedfollowers
+// ToCleared reports if the "to" edge to the User entity was cleared.
+func (m *FollowRelationMutation) ToCleared() bool {
+	return m.clearedto
 }
 
-// RemoveFollowerIDs removes the "followers" edge to the FollowRelation entity by IDs.
-func (m *UserMutation) RemoveFollowerIDs(ids ...uuid.UUID) {
-	if m.removedfollowers == nil {
-		m.removedfollowers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.followers, ids[i])
-		m.removedfollowers[ids[i]] = struct{}{}
+// ToID returns the "to" edge ID in the mutation.
+func (m *FollowRelationMutation) ToID() (id uuid.UUID, exists bool) {
+	if m.to != nil {
+		return *m.to, true
 	}
+	return
 }
 
-// RemovedFollowers returns the removed IDs of the "followers" edge to the FollowRelation entity.
-func (m *UserMutation) RemovedFollowersIDs() (ids []uuid.UUID) {
-	for id := range m.removedfollowers {
-		ids = append(ids, id)
+// ToIDs returns the "to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ToID instead. It exists only for internal usage by the builders.
+func (m *FollowRelationMutation) ToIDs() (ids []uuid.UUID) {
+	if id := m.to; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// FollowersIDs returns the "followers" edge IDs in the mutation.
-func (m *UserMutation) FollowersIDs() (ids []uuid.UUID) {
-	for id := range m.followers {
-		ids = append(ids, id)
-	}
-	return
+// ResetTo resets all changes to the "to" edge.
+func (m *FollowRelationMutation) ResetTo() {
+	m.to = nil
+	m.clearedto = false
 }
 
-// ResetFollowers resets all changes to the "followers" edge.
-func (m *UserMutation) ResetFollowers() {
-	m.followers = nil
-	m.clearedfollowers = false
-	m.removedfollowers = nil
+// Where appends a list predicates to the FollowRelationMutation builder.
+func (m *FollowRelationMutation) Where(ps ...predicate.FollowRelation) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddBlockingIDs adds the "blocking" edge to the BlockRelation entity by ids.
-func (m *UserMutation) AddBlockingIDs(ids ...uuid.UUID) {
-	if m.blocking == nil {
-		m.blocking = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.blocking[ids[i]] = struct{}{}
+// WhereP appends storage-level predicates to the FollowRelationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FollowRelationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FollowRelation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// ClearBlocking clears the "blocking" edge to the BlockRelation entity.
-func (m *UserMutation) ClearBlocking() {
-	m.clearedblocking = true
+// Op returns the operation name.
+func (m *FollowRelationMutation) Op() Op {
+	return m.op
 }
 
-// BlockingCleared reports if the "blocking" edge to the BlockRelation entity was cleared.
-func (m *UserMutation) BlockingCleared() bool {
-	return m.clearedblocking
+// SetOp allows setting the mutation operation.
+func (m *FollowRelationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// RemoveBlockingIDs removes the "blocking" edge to the BlockRelation entity by IDs.
-func (m *UserMutation) RemoveBlockingIDs(ids ...uuid.UUID) {
-	if m.removedblocking == nil {
-		m.removedblocking = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.blocking, ids[i])
-		m.removedblocking[ids[i]] = struct{}{}
-	}
+// Type returns the node type of this mutation (FollowRelation).
+func (m *FollowRelationMutation) Type() string {
+	return m.typ
 }
 
-// RemovedBlocking returns the removed IDs of the "blocking" edge to the BlockRelation entity.
-func (m *UserMutation) RemovedBlockingIDs() (ids []uuid.UUID) {
-	for id := range m.removedblocking {
-		ids = append(ids, id)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FollowRelationMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, followrelation.FieldCreatedAt)
 	}
-	return
+	return fields
 }
 
-// BlockingIDs returns the "blocking" edge IDs in the mutation.
-func (m *UserMutation) BlockingIDs() (ids []uuid.UUID) {
-	for id := range m.blocking {
-		ids = append(ids, id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FollowRelationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case followrelation.FieldCreatedAt:
+		return m.CreatedAt()
 	}
-	return
+	return nil, false
 }
 
-// ResetBlocking resets all changes to the "blocking" edge.
-func (m *UserMutation) ResetBlocking() {
-	m.blocking = nil
-	m.clearedblocking = false
-	m.removedblocking = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FollowRelationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case followrelation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FollowRelation field %s", name)
 }
 
-// AddBlockedByIDs adds the "blocked_by" edge to the BlockRelation entity by ids.
-func (m *UserMutation) AddBlockedByIDs(ids ...uuid.UUID) {
-	if m.blocked_by == nil {
-		m.blocked_by = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.blocked_by[ids[i]] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FollowRelationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case followrelation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
+	return fmt.Errorf("unknown FollowRelation field %s", name)
 }
 
-// ClearBlockedBy clears the "blocked_by" edge to the BlockRelation entity.
-func (m *UserMutation) ClearBlockedBy() {
-	m.clearedblocked_by = true
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FollowRelationMutation) AddedFields() []string {
+	return nil
 }
 
-// BlockedByCleared reports if the "blocked_by" edge to the BlockRelation entity was cleared.
-func (m *UserMutation) BlockedByCleared() bool {
-	return m.clearedblocked_by
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FollowRelationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// RemoveBlockedByIDs removes the "blocked_by" edge to the BlockRelation entity by IDs.
-func (m *UserMutation) RemoveBlockedByIDs(ids ...uuid.UUID) {
-	if m.removedblocked_by == nil {
-		m.removedblocked_by = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.blocked_by, ids[i])
-		m.removedblocked_by[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FollowRelationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown FollowRelation numeric field %s", name)
 }
 
-// RemovedBlockedBy returns the removed IDs of the "blocked_by" edge to the BlockRelation entity.
-func (m *UserMutation) RemovedBlockedByIDs() (ids []uuid.UUID) {
-	for id := range m.removedblocked_by {
-		ids = append(ids, id)
-	}
-	return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FollowRelationMutation) ClearedFields() []string {
+	return nil
 }
 
-// BlockedByIDs returns the "blocked_by" edge IDs in the mutation.
-func (m *UserMutation) BlockedByIDs() (ids []uuid.UUID) {
-	for id := range m.blocked_by {
-		ids = append(ids, id)
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FollowRelationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ResetBlockedBy resets all changes to the "blocked_by" edge.
-func (m *UserMutation) ResetBlockedBy() {
-	m.blocked_by = nil
-	m.clearedblocked_by = false
-	m.removedblocked_by = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FollowRelationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FollowRelation nullable field %s", name)
 }
 
-// AddDailyTaskIDs adds the "daily_tasks" edge to the DailyTask entity by ids.
-func (m *UserMutation) AddDailyTaskIDs(ids ...uuid.UUID) {
-	if m.daily_tasks == nil {
-		m.daily_tasks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.daily_tasks[ids[i]] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FollowRelationMutation) ResetField(name string) error {
+	switch name {
+	case followrelation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown FollowRelation field %s", name)
 }
 
-// ClearDailyTasks clears the "daily_tasks" edge to the DailyTask entity.
-func (m *UserMutation) ClearDailyTasks() {
-	m.cleareddaily_tasks = true
-}
-
-// DailyTasksCleared reports if the "daily_tasks" edge to the DailyTask entity was cleared.
-func (m *UserMutation) DailyTasksCleared() bool {
-	return m.cleareddaily_tasks
-}
-
-// RemoveDailyTaskIDs removes the "daily_tasks" edge to the DailyTask entity by IDs.
-func (m *UserMutation) RemoveDailyTaskIDs(ids ...uuid.UUID) {
-	if m.removeddaily_tasks == nil {
-		m.removeddaily_tasks = make(map[uuid.UUID]struct{})
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FollowRelationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.from != nil {
+		edges = append(edges, followrelation.EdgeFrom)
 	}
-	for i := range ids {
-		delete(m.daily_tasks, ids[i])
-		m.removeddaily_tasks[ids[i]] = struct{}{}
+	if m.to != nil {
+		edges = append(edges, followrelation.EdgeTo)
 	}
+	return edges
 }
 
-// RemovedDailyTasks returns the removed IDs of the "daily_tasks" edge to the DailyTask entity.
-func (m *UserMutation) RemovedDailyTasksIDs() (ids []uuid.UUID) {
-	for id := range m.removeddaily_tasks {
-		ids = append(ids, id)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FollowRelationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case followrelation.EdgeFrom:
+		if id := m.from; id != nil {
+			return []ent.Value{*id}
+		}
+	case followrelation.EdgeTo:
+		if id := m.to; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return
+	return nil
 }
 
-// DailyTasksIDs returns the "daily_tasks" edge IDs in the mutation.
-func (m *UserMutation) DailyTasksIDs() (ids []uuid.UUID) {
-	for id := range m.daily_tasks {
-		ids = append(ids, id)
-	}
-	return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FollowRelationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// ResetDailyTasks resets all changes to the "daily_tasks" edge.
-func (m *UserMutation) ResetDailyTasks() {
-	m.daily_tasks = nil
-	m.cleareddaily_tasks = false
-	m.removeddaily_tasks = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FollowRelationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddDeviceTokenIDs adds the "device_tokens" edge to the DeviceToken entity by ids.
-func (m *UserMutation) AddDeviceTokenIDs(ids ...uuid.UUID) {
-	if m.device_tokens == nil {
-		m.device_tokens = make(map[uuid.UUID]struct{})
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FollowRelationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfrom {
+		edges = append(edges, followrelation.EdgeFrom)
 	}
-	for i := range ids {
-		m.device_tokens[ids[i]] = struct{}{}
+	if m.clearedto {
+		edges = append(edges, followrelation.EdgeTo)
 	}
+	return edges
 }
 
-// ClearDeviceTokens clears the "device_tokens" edge to the DeviceToken entity.
-func (m *UserMutation) ClearDeviceTokens() {
-	m.cleareddevice_tokens = true
-}
-
-// DeviceTokensCleared reports if the "device_tokens" edge to the DeviceToken entity was cleared.
-func (m *UserMutation) DeviceTokensCleared() bool {
-	return m.cleareddevice_tokens
-}
-
-// RemoveDeviceTokenIDs removes the "device_tokens" edge to the DeviceToken entity by IDs.
-func (m *UserMutation) RemoveDeviceTokenIDs(ids ...uuid.UUID) {
-	if m.removeddevice_tokens == nil {
-		m.removeddevice_tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.device_tokens, ids[i])
-		m.removeddevice_tokens[ids[i]] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FollowRelationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case followrelation.EdgeFrom:
+		return m.clearedfrom
+	case followrelation.EdgeTo:
+		return m.clearedto
 	}
+	return false
 }
 
-// RemovedDeviceTokens returns the removed IDs of the "device_tokens" edge to the DeviceToken entity.
-func (m *UserMutation) RemovedDeviceTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removeddevice_tokens {
-		ids = append(ids, id)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FollowRelationMutation) ClearEdge(name string) error {
+	switch name {
+	case followrelation.EdgeFrom:
+		m.ClearFrom()
+		return nil
+	case followrelation.EdgeTo:
+		m.ClearTo()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown FollowRelation unique edge %s", name)
 }
 
-// DeviceTokensIDs returns the "device_tokens" edge IDs in the mutation.
-func (m *UserMutation) DeviceTokensIDs() (ids []uuid.UUID) {
-	for id := range m.device_tokens {
-		ids = append(ids, id)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FollowRelationMutation) ResetEdge(name string) error {
+	switch name {
+	case followrelation.EdgeFrom:
+		m.ResetFrom()
+		return nil
+	case followrelation.EdgeTo:
+		m.ResetTo()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown FollowRelation edge %s", name)
 }
 
-// ResetDeviceTokens resets all changes to the "device_tokens" edge.
-func (m *UserMutation) ResetDeviceTokens() {
-	m.device_tokens = nil
-	m.cleareddevice_tokens = false
-	m.removeddevice_tokens = nil
+// FollowRequestMutation represents an operation that mutates the FollowRequest nodes in the graph.
+type FollowRequestMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	from          *uuid.UUID
+	clearedfrom   bool
+	to            *uuid.UUID
+	clearedto     bool
+	done          bool
+	oldValue      func(context.Context) (*FollowRequest, error)
+	predicates    []predicate.FollowRequest
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+var _ ent.Mutation = (*FollowRequestMutation)(nil)
+
+// followrequestOption allows management of the mutation configuration using functional options.
+type followrequestOption func(*FollowRequestMutation)
+
+// newFollowRequestMutation creates new mutation for the FollowRequest entity.
+func newFollowRequestMutation(c config, op Op, opts ...followrequestOption) *FollowRequestMutation {
+	m := &FollowRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFollowRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFollowRequestID sets the ID field of the mutation.
+func withFollowRequestID(id uuid.UUID) followrequestOption {
+	return func(m *FollowRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FollowRequest
+		)
+		m.oldValue = func(ctx context.Context) (*FollowRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FollowRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFollowRequest sets the old FollowRequest of the mutation.
+func withFollowRequest(node *FollowRequest) followrequestOption {
+	return func(m *FollowRequestMutation) {
+		m.oldValue = func(context.Context) (*FollowRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FollowRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FollowRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FollowRequest entities.
+func (m *FollowRequestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FollowRequestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FollowRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FollowRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FollowRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FollowRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FollowRequest entity.
+// If the FollowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FollowRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetFromID sets the "from" edge to the User entity by id.
+func (m *FollowRequestMutation) SetFromID(id uuid.UUID) {
+	m.from = &id
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (m *FollowRequestMutation) ClearFrom() {
+	m.clearedfrom = true
+}
+
+// FromCleared reports if the "from" edge to the User entity was cleared.
+func (m *FollowRequestMutation) FromCleared() bool {
+	return m.clearedfrom
+}
+
+// FromID returns the "from" edge ID in the mutation.
+func (m *FollowRequestMutation) FromID() (id uuid.UUID, exists bool) {
+	if m.from != nil {
+		return *m.from, true
+	}
+	return
+}
+
+// FromIDs returns the "from" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FromID instead. It exists only for internal usage by the builders.
+func (m *FollowRequestMutation) FromIDs() (ids []uuid.UUID) {
+	if id := m.from; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFrom resets all changes to the "from" edge.
+func (m *FollowRequestMutation) ResetFrom() {
+	m.from = nil
+	m.clearedfrom = false
+}
+
+// SetToID sets the "to" edge to the User entity by id.
+func (m *FollowRequestMutation) SetToID(id uuid.UUID) {
+	m.to = &id
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (m *FollowRequestMutation) ClearTo() {
+	m.clearedto = true
+}
+
+// ToCleared reports if the "to" edge to the User entity was cleared.
+func (m *FollowRequestMutation) ToCleared() bool {
+	return m.clearedto
+}
+
+// ToID returns the "to" edge ID in the mutation.
+func (m *FollowRequestMutation) ToID() (id uuid.UUID, exists bool) {
+	if m.to != nil {
+		return *m.to, true
+	}
+	return
+}
+
+// ToIDs returns the "to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ToID instead. It exists only for internal usage by the builders.
+func (m *FollowRequestMutation) ToIDs() (ids []uuid.UUID) {
+	if id := m.to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTo resets all changes to the "to" edge.
+func (m *FollowRequestMutation) ResetTo() {
+	m.to = nil
+	m.clearedto = false
+}
+
+// Where appends a list predicates to the FollowRequestMutation builder.
+func (m *FollowRequestMutation) Where(ps ...predicate.FollowRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FollowRequestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *FollowRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FollowRequest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5848,45 +5824,27 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *FollowRequestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *FollowRequestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (FollowRequest).
+func (m *FollowRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.index != nil {
-		fields = append(fields, user.FieldIndex)
-	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
-	}
-	if m.bio != nil {
-		fields = append(fields, user.FieldBio)
-	}
-	if m.streak_count != nil {
-		fields = append(fields, user.FieldStreakCount)
-	}
-	if m.icon_image_key != nil {
-		fields = append(fields, user.FieldIconImageKey)
-	}
+func (m *FollowRequestMutation) Fields() []string {
+	fields := make([]string, 0, 1)
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, followrequest.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5894,21 +5852,9 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *FollowRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldIndex:
-		return m.Index()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldName:
-		return m.Name()
-	case user.FieldBio:
-		return m.Bio()
-	case user.FieldStreakCount:
-		return m.StreakCount()
-	case user.FieldIconImageKey:
-		return m.IconImageKey()
-	case user.FieldCreatedAt:
+	case followrequest.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -5917,349 +5863,13690 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FollowRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldIndex:
-		return m.OldIndex(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldBio:
-		return m.OldBio(ctx)
-	case user.FieldStreakCount:
-		return m.OldStreakCount(ctx)
-	case user.FieldIconImageKey:
-		return m.OldIconImageKey(ctx)
-	case user.FieldCreatedAt:
+	case followrequest.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown FollowRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *FollowRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldIndex:
-		v, ok := value.(uint32)
+	case followrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIndex(v)
-		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case user.FieldBio:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBio(v)
-		return nil
-	case user.FieldStreakCount:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStreakCount(v)
-		return nil
-	case user.FieldIconImageKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIconImageKey(v)
-		return nil
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown FollowRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	var fields []string
-	if m.addindex != nil {
-		fields = append(fields, user.FieldIndex)
-	}
-	if m.addstreak_count != nil {
-		fields = append(fields, user.FieldStreakCount)
-	}
-	return fields
+func (m *FollowRequestMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldIndex:
-		return m.AddedIndex()
-	case user.FieldStreakCount:
-		return m.AddedStreakCount()
-	}
+func (m *FollowRequestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *FollowRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldIndex:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIndex(v)
-		return nil
-	case user.FieldStreakCount:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStreakCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown FollowRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldIndex) {
-		fields = append(fields, user.FieldIndex)
-	}
-	if m.FieldCleared(user.FieldIconImageKey) {
-		fields = append(fields, user.FieldIconImageKey)
-	}
-	return fields
+func (m *FollowRequestMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *FollowRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldIndex:
-		m.ClearIndex()
-		return nil
-	case user.FieldIconImageKey:
-		m.ClearIconImageKey()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *FollowRequestMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FollowRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *FollowRequestMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldIndex:
-		m.ResetIndex()
-		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldName:
-		m.ResetName()
-		return nil
-	case user.FieldBio:
-		m.ResetBio()
-		return nil
-	case user.FieldStreakCount:
-		m.ResetStreakCount()
-		return nil
-	case user.FieldIconImageKey:
-		m.ResetIconImageKey()
-		return nil
-	case user.FieldCreatedAt:
+	case followrequest.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown FollowRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.posts != nil {
-		edges = append(edges, user.EdgePosts)
-	}
-	if m.comments != nil {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.likes != nil {
-		edges = append(edges, user.EdgeLikes)
-	}
-	if m.pets != nil {
-		edges = append(edges, user.EdgePets)
-	}
-	if m.following != nil {
-		edges = append(edges, user.EdgeFollowing)
-	}
-	if m.followers != nil {
-		edges = append(edges, user.EdgeFollowers)
-	}
-	if m.blocking != nil {
-		edges = append(edges, user.EdgeBlocking)
-	}
-	if m.blocked_by != nil {
-		edges = append(edges, user.EdgeBlockedBy)
-	}
-	if m.daily_tasks != nil {
-		edges = append(edges, user.EdgeDailyTasks)
+func (m *FollowRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.from != nil {
+		edges = append(edges, followrequest.EdgeFrom)
 	}
-	if m.device_tokens != nil {
-		edges = append(edges, user.EdgeDeviceTokens)
+	if m.to != nil {
+		edges = append(edges, followrequest.EdgeTo)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *FollowRequestMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.likes))
-		for id := range m.likes {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgePets:
-		ids := make([]ent.Value, 0, len(m.pets))
-		for id := range m.pets {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFollowing:
-		ids := make([]ent.Value, 0, len(m.following))
-		for id := range m.following {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFollowers:
-		ids := make([]ent.Value, 0, len(m.followers))
-		for id := range m.followers {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeBlocking:
-		ids := make([]ent.Value, 0, len(m.blocking))
-		for id := range m.blocking {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeBlockedBy:
-		ids := make([]ent.Value, 0, len(m.blocked_by))
-		for id := range m.blocked_by {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeDailyTasks:
-		ids := make([]ent.Value, 0, len(m.daily_tasks))
-		for id := range m.daily_tasks {
-			ids = append(ids, id)
+	case followrequest.EdgeFrom:
+		if id := m.from; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgeDeviceTokens:
-		ids := make([]ent.Value, 0, len(m.device_tokens))
-		for id := range m.device_tokens {
-			ids = append(ids, id)
+	case followrequest.EdgeTo:
+		if id := m.to; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.removedposts != nil {
-		edges = append(edges, user.EdgePosts)
-	}
-	if m.removedcomments != nil {
-		edges = append(edges, user.EdgeComments)
-	}
-	if m.removedlikes != nil {
-		edges = append(edges, user.EdgeLikes)
-	}
-	if m.removedpets != nil {
-		edges = append(edges, user.EdgePets)
-	}
-	if m.removedfollowing != nil {
-		edges = append(edges, user.EdgeFollowing)
-	}
-	if m.removedfollowers != nil {
-		edges = append(edges, user.EdgeFollowers)
-	}
-	if m.removedblocking != nil {
-		edges = append(edges, user.EdgeBlocking)
-	}
-	if m.removedblocked_by != nil {
-		edges = append(edges, user.EdgeBlockedBy)
-	}
-	if m.removeddaily_tasks != nil {
-		edges = append(edges, user.EdgeDailyTasks)
-	}
-	if m.removeddevice_tokens != nil {
-		edges = append(edges, user.EdgeDeviceTokens)
-	}
+func (m *FollowRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
+func (m *FollowRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FollowRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfrom {
+		edges = append(edges, followrequest.EdgeFrom)
+	}
+	if m.clearedto {
+		edges = append(edges, followrequest.EdgeTo)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FollowRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case followrequest.EdgeFrom:
+		return m.clearedfrom
+	case followrequest.EdgeTo:
+		return m.clearedto
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FollowRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case followrequest.EdgeFrom:
+		m.ClearFrom()
+		return nil
+	case followrequest.EdgeTo:
+		m.ClearTo()
+		return nil
+	}
+	return fmt.Errorf("unknown FollowRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FollowRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case followrequest.EdgeFrom:
+		m.ResetFrom()
+		return nil
+	case followrequest.EdgeTo:
+		m.ResetTo()
+		return nil
+	}
+	return fmt.Errorf("unknown FollowRequest edge %s", name)
+}
+
+// LikeMutation represents an operation that mutates the Like nodes in the graph.
+type LikeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	reaction      *enum.ReactionType
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	post          *uuid.UUID
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*Like, error)
+	predicates    []predicate.Like
+}
+
+var _ ent.Mutation = (*LikeMutation)(nil)
+
+// likeOption allows management of the mutation configuration using functional options.
+type likeOption func(*LikeMutation)
+
+// newLikeMutation creates new mutation for the Like entity.
+func newLikeMutation(c config, op Op, opts ...likeOption) *LikeMutation {
+	m := &LikeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLike,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLikeID sets the ID field of the mutation.
+func withLikeID(id uuid.UUID) likeOption {
+	return func(m *LikeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Like
+		)
+		m.oldValue = func(ctx context.Context) (*Like, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Like.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLike sets the old Like of the mutation.
+func withLike(node *Like) likeOption {
+	return func(m *LikeMutation) {
+		m.oldValue = func(context.Context) (*Like, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LikeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LikeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Like entities.
+func (m *LikeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LikeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LikeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Like.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetReaction sets the "reaction" field.
+func (m *LikeMutation) SetReaction(et enum.ReactionType) {
+	m.reaction = &et
+}
+
+// Reaction returns the value of the "reaction" field in the mutation.
+func (m *LikeMutation) Reaction() (r enum.ReactionType, exists bool) {
+	v := m.reaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReaction returns the old "reaction" field's value of the Like entity.
+// If the Like object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LikeMutation) OldReaction(ctx context.Context) (v enum.ReactionType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReaction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReaction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReaction: %w", err)
+	}
+	return oldValue.Reaction, nil
+}
+
+// ResetReaction resets all changes to the "reaction" field.
+func (m *LikeMutation) ResetReaction() {
+	m.reaction = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LikeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LikeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Like entity.
+// If the Like object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LikeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *LikeMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LikeMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LikeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *LikeMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LikeMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LikeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *LikeMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *LikeMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *LikeMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *LikeMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *LikeMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *LikeMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the LikeMutation builder.
+func (m *LikeMutation) Where(ps ...predicate.Like) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LikeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LikeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Like, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LikeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LikeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Like).
+func (m *LikeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LikeMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.reaction != nil {
+		fields = append(fields, like.FieldReaction)
+	}
+	if m.created_at != nil {
+		fields = append(fields, like.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LikeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case like.FieldReaction:
+		return m.Reaction()
+	case like.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case like.FieldReaction:
+		return m.OldReaction(ctx)
+	case like.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Like field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LikeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case like.FieldReaction:
+		v, ok := value.(enum.ReactionType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReaction(v)
+		return nil
+	case like.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Like field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LikeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LikeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LikeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Like numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LikeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LikeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LikeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Like nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LikeMutation) ResetField(name string) error {
+	switch name {
+	case like.FieldReaction:
+		m.ResetReaction()
+		return nil
+	case like.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Like field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LikeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, like.EdgeUser)
+	}
+	if m.post != nil {
+		edges = append(edges, like.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LikeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case like.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case like.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LikeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LikeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LikeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, like.EdgeUser)
+	}
+	if m.clearedpost {
+		edges = append(edges, like.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LikeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case like.EdgeUser:
+		return m.cleareduser
+	case like.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LikeMutation) ClearEdge(name string) error {
+	switch name {
+	case like.EdgeUser:
+		m.ClearUser()
+		return nil
+	case like.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Like unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LikeMutation) ResetEdge(name string) error {
+	switch name {
+	case like.EdgeUser:
+		m.ResetUser()
+		return nil
+	case like.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown Like edge %s", name)
+}
+
+// MuteRelationMutation represents an operation that mutates the MuteRelation nodes in the graph.
+type MuteRelationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	from          *uuid.UUID
+	clearedfrom   bool
+	to            *uuid.UUID
+	clearedto     bool
+	done          bool
+	oldValue      func(context.Context) (*MuteRelation, error)
+	predicates    []predicate.MuteRelation
+}
+
+var _ ent.Mutation = (*MuteRelationMutation)(nil)
+
+// muterelationOption allows management of the mutation configuration using functional options.
+type muterelationOption func(*MuteRelationMutation)
+
+// newMuteRelationMutation creates new mutation for the MuteRelation entity.
+func newMuteRelationMutation(c config, op Op, opts ...muterelationOption) *MuteRelationMutation {
+	m := &MuteRelationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMuteRelation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMuteRelationID sets the ID field of the mutation.
+func withMuteRelationID(id uuid.UUID) muterelationOption {
+	return func(m *MuteRelationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MuteRelation
+		)
+		m.oldValue = func(ctx context.Context) (*MuteRelation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MuteRelation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMuteRelation sets the old MuteRelation of the mutation.
+func withMuteRelation(node *MuteRelation) muterelationOption {
+	return func(m *MuteRelationMutation) {
+		m.oldValue = func(context.Context) (*MuteRelation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MuteRelationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MuteRelationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MuteRelation entities.
+func (m *MuteRelationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MuteRelationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MuteRelationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MuteRelation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MuteRelationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MuteRelationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MuteRelation entity.
+// If the MuteRelation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MuteRelationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MuteRelationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetFromID sets the "from" edge to the User entity by id.
+func (m *MuteRelationMutation) SetFromID(id uuid.UUID) {
+	m.from = &id
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (m *MuteRelationMutation) ClearFrom() {
+	m.clearedfrom = true
+}
+
+// FromCleared reports if the "from" edge to the User entity was cleared.
+func (m *MuteRelationMutation) FromCleared() bool {
+	return m.clearedfrom
+}
+
+// FromID returns the "from" edge ID in the mutation.
+func (m *MuteRelationMutation) FromID() (id uuid.UUID, exists bool) {
+	if m.from != nil {
+		return *m.from, true
+	}
+	return
+}
+
+// FromIDs returns the "from" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FromID instead. It exists only for internal usage by the builders.
+func (m *MuteRelationMutation) FromIDs() (ids []uuid.UUID) {
+	if id := m.from; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFrom resets all changes to the "from" edge.
+func (m *MuteRelationMutation) ResetFrom() {
+	m.from = nil
+	m.clearedfrom = false
+}
+
+// SetToID sets the "to" edge to the User entity by id.
+func (m *MuteRelationMutation) SetToID(id uuid.UUID) {
+	m.to = &id
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (m *MuteRelationMutation) ClearTo() {
+	m.clearedto = true
+}
+
+// ToCleared reports if the "to" edge to the User entity was cleared.
+func (m *MuteRelationMutation) ToCleared() bool {
+	return m.clearedto
+}
+
+// ToID returns the "to" edge ID in the mutation.
+func (m *MuteRelationMutation) ToID() (id uuid.UUID, exists bool) {
+	if m.to != nil {
+		return *m.to, true
+	}
+	return
+}
+
+// ToIDs returns the "to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ToID instead. It exists only for internal usage by the builders.
+func (m *MuteRelationMutation) ToIDs() (ids []uuid.UUID) {
+	if id := m.to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTo resets all changes to the "to" edge.
+func (m *MuteRelationMutation) ResetTo() {
+	m.to = nil
+	m.clearedto = false
+}
+
+// Where appends a list predicates to the MuteRelationMutation builder.
+func (m *MuteRelationMutation) Where(ps ...predicate.MuteRelation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MuteRelationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MuteRelationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MuteRelation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MuteRelationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MuteRelationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MuteRelation).
+func (m *MuteRelationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MuteRelationMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, muterelation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MuteRelationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case muterelation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MuteRelationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case muterelation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MuteRelation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MuteRelationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case muterelation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MuteRelation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MuteRelationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MuteRelationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MuteRelationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MuteRelation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MuteRelationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MuteRelationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MuteRelationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MuteRelation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MuteRelationMutation) ResetField(name string) error {
+	switch name {
+	case muterelation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MuteRelation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MuteRelationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.from != nil {
+		edges = append(edges, muterelation.EdgeFrom)
+	}
+	if m.to != nil {
+		edges = append(edges, muterelation.EdgeTo)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MuteRelationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case muterelation.EdgeFrom:
+		if id := m.from; id != nil {
+			return []ent.Value{*id}
+		}
+	case muterelation.EdgeTo:
+		if id := m.to; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MuteRelationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MuteRelationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MuteRelationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfrom {
+		edges = append(edges, muterelation.EdgeFrom)
+	}
+	if m.clearedto {
+		edges = append(edges, muterelation.EdgeTo)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MuteRelationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case muterelation.EdgeFrom:
+		return m.clearedfrom
+	case muterelation.EdgeTo:
+		return m.clearedto
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MuteRelationMutation) ClearEdge(name string) error {
+	switch name {
+	case muterelation.EdgeFrom:
+		m.ClearFrom()
+		return nil
+	case muterelation.EdgeTo:
+		m.ClearTo()
+		return nil
+	}
+	return fmt.Errorf("unknown MuteRelation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MuteRelationMutation) ResetEdge(name string) error {
+	switch name {
+	case muterelation.EdgeFrom:
+		m.ResetFrom()
+		return nil
+	case muterelation.EdgeTo:
+		m.ResetTo()
+		return nil
+	}
+	return fmt.Errorf("unknown MuteRelation edge %s", name)
+}
+
+// MutedKeywordMutation represents an operation that mutates the MutedKeyword nodes in the graph.
+type MutedKeywordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	keyword       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*MutedKeyword, error)
+	predicates    []predicate.MutedKeyword
+}
+
+var _ ent.Mutation = (*MutedKeywordMutation)(nil)
+
+// mutedkeywordOption allows management of the mutation configuration using functional options.
+type mutedkeywordOption func(*MutedKeywordMutation)
+
+// newMutedKeywordMutation creates new mutation for the MutedKeyword entity.
+func newMutedKeywordMutation(c config, op Op, opts ...mutedkeywordOption) *MutedKeywordMutation {
+	m := &MutedKeywordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMutedKeyword,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMutedKeywordID sets the ID field of the mutation.
+func withMutedKeywordID(id uuid.UUID) mutedkeywordOption {
+	return func(m *MutedKeywordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MutedKeyword
+		)
+		m.oldValue = func(ctx context.Context) (*MutedKeyword, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MutedKeyword.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMutedKeyword sets the old MutedKeyword of the mutation.
+func withMutedKeyword(node *MutedKeyword) mutedkeywordOption {
+	return func(m *MutedKeywordMutation) {
+		m.oldValue = func(context.Context) (*MutedKeyword, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MutedKeywordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MutedKeywordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MutedKeyword entities.
+func (m *MutedKeywordMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MutedKeywordMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MutedKeywordMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MutedKeyword.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MutedKeywordMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MutedKeywordMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MutedKeyword entity.
+// If the MutedKeyword object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MutedKeywordMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MutedKeywordMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetKeyword sets the "keyword" field.
+func (m *MutedKeywordMutation) SetKeyword(s string) {
+	m.keyword = &s
+}
+
+// Keyword returns the value of the "keyword" field in the mutation.
+func (m *MutedKeywordMutation) Keyword() (r string, exists bool) {
+	v := m.keyword
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyword returns the old "keyword" field's value of the MutedKeyword entity.
+// If the MutedKeyword object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MutedKeywordMutation) OldKeyword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyword: %w", err)
+	}
+	return oldValue.Keyword, nil
+}
+
+// ResetKeyword resets all changes to the "keyword" field.
+func (m *MutedKeywordMutation) ResetKeyword() {
+	m.keyword = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MutedKeywordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MutedKeywordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MutedKeyword entity.
+// If the MutedKeyword object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MutedKeywordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MutedKeywordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MutedKeywordMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[mutedkeyword.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MutedKeywordMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MutedKeywordMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MutedKeywordMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MutedKeywordMutation builder.
+func (m *MutedKeywordMutation) Where(ps ...predicate.MutedKeyword) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MutedKeywordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MutedKeywordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MutedKeyword, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MutedKeywordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MutedKeywordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MutedKeyword).
+func (m *MutedKeywordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MutedKeywordMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user != nil {
+		fields = append(fields, mutedkeyword.FieldUserID)
+	}
+	if m.keyword != nil {
+		fields = append(fields, mutedkeyword.FieldKeyword)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mutedkeyword.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MutedKeywordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mutedkeyword.FieldUserID:
+		return m.UserID()
+	case mutedkeyword.FieldKeyword:
+		return m.Keyword()
+	case mutedkeyword.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MutedKeywordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mutedkeyword.FieldUserID:
+		return m.OldUserID(ctx)
+	case mutedkeyword.FieldKeyword:
+		return m.OldKeyword(ctx)
+	case mutedkeyword.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MutedKeyword field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MutedKeywordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mutedkeyword.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case mutedkeyword.FieldKeyword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyword(v)
+		return nil
+	case mutedkeyword.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MutedKeyword field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MutedKeywordMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MutedKeywordMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MutedKeywordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MutedKeyword numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MutedKeywordMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MutedKeywordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MutedKeywordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MutedKeyword nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MutedKeywordMutation) ResetField(name string) error {
+	switch name {
+	case mutedkeyword.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case mutedkeyword.FieldKeyword:
+		m.ResetKeyword()
+		return nil
+	case mutedkeyword.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MutedKeyword field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MutedKeywordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, mutedkeyword.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MutedKeywordMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mutedkeyword.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MutedKeywordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MutedKeywordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MutedKeywordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, mutedkeyword.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MutedKeywordMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mutedkeyword.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MutedKeywordMutation) ClearEdge(name string) error {
+	switch name {
+	case mutedkeyword.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MutedKeyword unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MutedKeywordMutation) ResetEdge(name string) error {
+	switch name {
+	case mutedkeyword.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MutedKeyword edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	_type            *enum.NotificationType
+	created_at       *time.Time
+	read_at          *time.Time
+	clearedFields    map[string]struct{}
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	actor            *uuid.UUID
+	clearedactor     bool
+	post             *uuid.UUID
+	clearedpost      bool
+	comment          *uuid.UUID
+	clearedcomment   bool
+	done             bool
+	oldValue         func(context.Context) (*Notification, error)
+	predicates       []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id uuid.UUID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *NotificationMutation) SetType(et enum.NotificationType) {
+	m._type = &et
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationMutation) GetType() (r enum.NotificationType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldType(ctx context.Context) (v enum.NotificationType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationMutation) ResetType() {
+	m._type = nil
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (m *NotificationMutation) SetRecipientID(u uuid.UUID) {
+	m.recipient = &u
+}
+
+// RecipientID returns the value of the "recipient_id" field in the mutation.
+func (m *NotificationMutation) RecipientID() (r uuid.UUID, exists bool) {
+	v := m.recipient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipientID returns the old "recipient_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldRecipientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipientID: %w", err)
+	}
+	return oldValue.RecipientID, nil
+}
+
+// ResetRecipientID resets all changes to the "recipient_id" field.
+func (m *NotificationMutation) ResetRecipientID() {
+	m.recipient = nil
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *NotificationMutation) SetActorID(u uuid.UUID) {
+	m.actor = &u
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *NotificationMutation) ActorID() (r uuid.UUID, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldActorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *NotificationMutation) ResetActorID() {
+	m.actor = nil
+}
+
+// SetPostID sets the "post_id" field.
+func (m *NotificationMutation) SetPostID(u uuid.UUID) {
+	m.post = &u
+}
+
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *NotificationMutation) PostID() (r uuid.UUID, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostID returns the old "post_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldPostID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+	}
+	return oldValue.PostID, nil
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (m *NotificationMutation) ClearPostID() {
+	m.post = nil
+	m.clearedFields[notification.FieldPostID] = struct{}{}
+}
+
+// PostIDCleared returns if the "post_id" field was cleared in this mutation.
+func (m *NotificationMutation) PostIDCleared() bool {
+	_, ok := m.clearedFields[notification.FieldPostID]
+	return ok
+}
+
+// ResetPostID resets all changes to the "post_id" field.
+func (m *NotificationMutation) ResetPostID() {
+	m.post = nil
+	delete(m.clearedFields, notification.FieldPostID)
+}
+
+// SetCommentID sets the "comment_id" field.
+func (m *NotificationMutation) SetCommentID(u uuid.UUID) {
+	m.comment = &u
+}
+
+// CommentID returns the value of the "comment_id" field in the mutation.
+func (m *NotificationMutation) CommentID() (r uuid.UUID, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentID returns the old "comment_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCommentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentID: %w", err)
+	}
+	return oldValue.CommentID, nil
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (m *NotificationMutation) ClearCommentID() {
+	m.comment = nil
+	m.clearedFields[notification.FieldCommentID] = struct{}{}
+}
+
+// CommentIDCleared returns if the "comment_id" field was cleared in this mutation.
+func (m *NotificationMutation) CommentIDCleared() bool {
+	_, ok := m.clearedFields[notification.FieldCommentID]
+	return ok
+}
+
+// ResetCommentID resets all changes to the "comment_id" field.
+func (m *NotificationMutation) ResetCommentID() {
+	m.comment = nil
+	delete(m.clearedFields, notification.FieldCommentID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *NotificationMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *NotificationMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[notification.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *NotificationMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *NotificationMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, notification.FieldReadAt)
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (m *NotificationMutation) ClearRecipient() {
+	m.clearedrecipient = true
+	m.clearedFields[notification.FieldRecipientID] = struct{}{}
+}
+
+// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
+func (m *NotificationMutation) RecipientCleared() bool {
+	return m.clearedrecipient
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *NotificationMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (m *NotificationMutation) ClearActor() {
+	m.clearedactor = true
+	m.clearedFields[notification.FieldActorID] = struct{}{}
+}
+
+// ActorCleared reports if the "actor" edge to the User entity was cleared.
+func (m *NotificationMutation) ActorCleared() bool {
+	return m.clearedactor
+}
+
+// ActorIDs returns the "actor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ActorID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) ActorIDs() (ids []uuid.UUID) {
+	if id := m.actor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetActor resets all changes to the "actor" edge.
+func (m *NotificationMutation) ResetActor() {
+	m.actor = nil
+	m.clearedactor = false
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *NotificationMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[notification.FieldPostID] = struct{}{}
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *NotificationMutation) PostCleared() bool {
+	return m.PostIDCleared() || m.clearedpost
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *NotificationMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (m *NotificationMutation) ClearComment() {
+	m.clearedcomment = true
+	m.clearedFields[notification.FieldCommentID] = struct{}{}
+}
+
+// CommentCleared reports if the "comment" edge to the Comment entity was cleared.
+func (m *NotificationMutation) CommentCleared() bool {
+	return m.CommentIDCleared() || m.clearedcomment
+}
+
+// CommentIDs returns the "comment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CommentID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) CommentIDs() (ids []uuid.UUID) {
+	if id := m.comment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetComment resets all changes to the "comment" edge.
+func (m *NotificationMutation) ResetComment() {
+	m.comment = nil
+	m.clearedcomment = false
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m._type != nil {
+		fields = append(fields, notification.FieldType)
+	}
+	if m.recipient != nil {
+		fields = append(fields, notification.FieldRecipientID)
+	}
+	if m.actor != nil {
+		fields = append(fields, notification.FieldActorID)
+	}
+	if m.post != nil {
+		fields = append(fields, notification.FieldPostID)
+	}
+	if m.comment != nil {
+		fields = append(fields, notification.FieldCommentID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m.read_at != nil {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldType:
+		return m.GetType()
+	case notification.FieldRecipientID:
+		return m.RecipientID()
+	case notification.FieldActorID:
+		return m.ActorID()
+	case notification.FieldPostID:
+		return m.PostID()
+	case notification.FieldCommentID:
+		return m.CommentID()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldReadAt:
+		return m.ReadAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldType:
+		return m.OldType(ctx)
+	case notification.FieldRecipientID:
+		return m.OldRecipientID(ctx)
+	case notification.FieldActorID:
+		return m.OldActorID(ctx)
+	case notification.FieldPostID:
+		return m.OldPostID(ctx)
+	case notification.FieldCommentID:
+		return m.OldCommentID(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldReadAt:
+		return m.OldReadAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldType:
+		v, ok := value.(enum.NotificationType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case notification.FieldRecipientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipientID(v)
+		return nil
+	case notification.FieldActorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case notification.FieldPostID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostID(v)
+		return nil
+	case notification.FieldCommentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentID(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldPostID) {
+		fields = append(fields, notification.FieldPostID)
+	}
+	if m.FieldCleared(notification.FieldCommentID) {
+		fields = append(fields, notification.FieldCommentID)
+	}
+	if m.FieldCleared(notification.FieldReadAt) {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldPostID:
+		m.ClearPostID()
+		return nil
+	case notification.FieldCommentID:
+		m.ClearCommentID()
+		return nil
+	case notification.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldType:
+		m.ResetType()
+		return nil
+	case notification.FieldRecipientID:
+		m.ResetRecipientID()
+		return nil
+	case notification.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case notification.FieldPostID:
+		m.ResetPostID()
+		return nil
+	case notification.FieldCommentID:
+		m.ResetCommentID()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notification.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.recipient != nil {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.actor != nil {
+		edges = append(edges, notification.EdgeActor)
+	}
+	if m.post != nil {
+		edges = append(edges, notification.EdgePost)
+	}
+	if m.comment != nil {
+		edges = append(edges, notification.EdgeComment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeRecipient:
+		if id := m.recipient; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeActor:
+		if id := m.actor; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeComment:
+		if id := m.comment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedrecipient {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.clearedactor {
+		edges = append(edges, notification.EdgeActor)
+	}
+	if m.clearedpost {
+		edges = append(edges, notification.EdgePost)
+	}
+	if m.clearedcomment {
+		edges = append(edges, notification.EdgeComment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notification.EdgeRecipient:
+		return m.clearedrecipient
+	case notification.EdgeActor:
+		return m.clearedactor
+	case notification.EdgePost:
+		return m.clearedpost
+	case notification.EdgeComment:
+		return m.clearedcomment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
+	case notification.EdgeActor:
+		m.ClearActor()
+		return nil
+	case notification.EdgePost:
+		m.ClearPost()
+		return nil
+	case notification.EdgeComment:
+		m.ClearComment()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
+	case notification.EdgeActor:
+		m.ResetActor()
+		return nil
+	case notification.EdgePost:
+		m.ResetPost()
+		return nil
+	case notification.EdgeComment:
+		m.ResetComment()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// PetMutation represents an operation that mutates the Pet nodes in the graph.
+type PetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	birth_day     *string
+	_type         *pet.Type
+	species       *pet.Species
+	image_key     *string
+	created_at    *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	posts         map[uuid.UUID]struct{}
+	removedposts  map[uuid.UUID]struct{}
+	clearedposts  bool
+	done          bool
+	oldValue      func(context.Context) (*Pet, error)
+	predicates    []predicate.Pet
+}
+
+var _ ent.Mutation = (*PetMutation)(nil)
+
+// petOption allows management of the mutation configuration using functional options.
+type petOption func(*PetMutation)
+
+// newPetMutation creates new mutation for the Pet entity.
+func newPetMutation(c config, op Op, opts ...petOption) *PetMutation {
+	m := &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPetID sets the ID field of the mutation.
+func withPetID(id uuid.UUID) petOption {
+	return func(m *PetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pet
+		)
+		m.oldValue = func(ctx context.Context) (*Pet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPet sets the old Pet of the mutation.
+func withPet(node *Pet) petOption {
+	return func(m *PetMutation) {
+		m.oldValue = func(context.Context) (*Pet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Pet entities.
+func (m *PetMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PetMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetBirthDay sets the "birth_day" field.
+func (m *PetMutation) SetBirthDay(s string) {
+	m.birth_day = &s
+}
+
+// BirthDay returns the value of the "birth_day" field in the mutation.
+func (m *PetMutation) BirthDay() (r string, exists bool) {
+	v := m.birth_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthDay returns the old "birth_day" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldBirthDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthDay: %w", err)
+	}
+	return oldValue.BirthDay, nil
+}
+
+// ResetBirthDay resets all changes to the "birth_day" field.
+func (m *PetMutation) ResetBirthDay() {
+	m.birth_day = nil
+}
+
+// SetType sets the "type" field.
+func (m *PetMutation) SetType(pe pet.Type) {
+	m._type = &pe
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *PetMutation) GetType() (r pet.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldType(ctx context.Context) (v pet.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *PetMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSpecies sets the "species" field.
+func (m *PetMutation) SetSpecies(pe pet.Species) {
+	m.species = &pe
+}
+
+// Species returns the value of the "species" field in the mutation.
+func (m *PetMutation) Species() (r pet.Species, exists bool) {
+	v := m.species
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpecies returns the old "species" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldSpecies(ctx context.Context) (v pet.Species, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpecies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpecies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpecies: %w", err)
+	}
+	return oldValue.Species, nil
+}
+
+// ResetSpecies resets all changes to the "species" field.
+func (m *PetMutation) ResetSpecies() {
+	m.species = nil
+}
+
+// SetImageKey sets the "image_key" field.
+func (m *PetMutation) SetImageKey(s string) {
+	m.image_key = &s
+}
+
+// ImageKey returns the value of the "image_key" field in the mutation.
+func (m *PetMutation) ImageKey() (r string, exists bool) {
+	v := m.image_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageKey returns the old "image_key" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldImageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageKey: %w", err)
+	}
+	return oldValue.ImageKey, nil
+}
+
+// ResetImageKey resets all changes to the "image_key" field.
+func (m *PetMutation) ResetImageKey() {
+	m.image_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PetMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PetMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Pet entity.
+// If the Pet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PetMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PetMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[pet.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PetMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[pet.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PetMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, pet.FieldDeletedAt)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *PetMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PetMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PetMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *PetMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PetMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PetMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *PetMutation) AddPostIDs(ids ...uuid.UUID) {
+	if m.posts == nil {
+		m.posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *PetMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *PetMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *PetMutation) RemovePostIDs(ids ...uuid.UUID) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *PetMutation) RemovedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *PetMutation) PostsIDs() (ids []uuid.UUID) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *PetMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// Where appends a list predicates to the PetMutation builder.
+func (m *PetMutation) Where(ps ...predicate.Pet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pet).
+func (m *PetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PetMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, pet.FieldName)
+	}
+	if m.birth_day != nil {
+		fields = append(fields, pet.FieldBirthDay)
+	}
+	if m._type != nil {
+		fields = append(fields, pet.FieldType)
+	}
+	if m.species != nil {
+		fields = append(fields, pet.FieldSpecies)
+	}
+	if m.image_key != nil {
+		fields = append(fields, pet.FieldImageKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pet.FieldCreatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, pet.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pet.FieldName:
+		return m.Name()
+	case pet.FieldBirthDay:
+		return m.BirthDay()
+	case pet.FieldType:
+		return m.GetType()
+	case pet.FieldSpecies:
+		return m.Species()
+	case pet.FieldImageKey:
+		return m.ImageKey()
+	case pet.FieldCreatedAt:
+		return m.CreatedAt()
+	case pet.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pet.FieldName:
+		return m.OldName(ctx)
+	case pet.FieldBirthDay:
+		return m.OldBirthDay(ctx)
+	case pet.FieldType:
+		return m.OldType(ctx)
+	case pet.FieldSpecies:
+		return m.OldSpecies(ctx)
+	case pet.FieldImageKey:
+		return m.OldImageKey(ctx)
+	case pet.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pet.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pet.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case pet.FieldBirthDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBirthDay(v)
+		return nil
+	case pet.FieldType:
+		v, ok := value.(pet.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case pet.FieldSpecies:
+		v, ok := value.(pet.Species)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpecies(v)
+		return nil
+	case pet.FieldImageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageKey(v)
+		return nil
+	case pet.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pet.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Pet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pet.FieldDeletedAt) {
+		fields = append(fields, pet.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PetMutation) ClearField(name string) error {
+	switch name {
+	case pet.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PetMutation) ResetField(name string) error {
+	switch name {
+	case pet.FieldName:
+		m.ResetName()
+		return nil
+	case pet.FieldBirthDay:
+		m.ResetBirthDay()
+		return nil
+	case pet.FieldType:
+		m.ResetType()
+		return nil
+	case pet.FieldSpecies:
+		m.ResetSpecies()
+		return nil
+	case pet.FieldImageKey:
+		m.ResetImageKey()
+		return nil
+	case pet.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pet.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, pet.EdgeOwner)
+	}
+	if m.posts != nil {
+		edges = append(edges, pet.EdgePosts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pet.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case pet.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedposts != nil {
+		edges = append(edges, pet.EdgePosts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case pet.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, pet.EdgeOwner)
+	}
+	if m.clearedposts {
+		edges = append(edges, pet.EdgePosts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pet.EdgeOwner:
+		return m.clearedowner
+	case pet.EdgePosts:
+		return m.clearedposts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PetMutation) ClearEdge(name string) error {
+	switch name {
+	case pet.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PetMutation) ResetEdge(name string) error {
+	switch name {
+	case pet.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case pet.EdgePosts:
+		m.ResetPosts()
+		return nil
+	}
+	return fmt.Errorf("unknown Pet edge %s", name)
+}
+
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	index                  *uint32
+	addindex               *int32
+	caption                *string
+	image_key              *string
+	media_type             *enum.MediaType
+	created_at             *time.Time
+	deleted_at             *time.Time
+	hidden_at              *time.Time
+	edited_at              *time.Time
+	like_count             *int
+	addlike_count          *int
+	comment_count          *int
+	addcomment_count       *int
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	comments               map[uuid.UUID]struct{}
+	removedcomments        map[uuid.UUID]struct{}
+	clearedcomments        bool
+	likes                  map[uuid.UUID]struct{}
+	removedlikes           map[uuid.UUID]struct{}
+	clearedlikes           bool
+	daily_task             *uuid.UUID
+	cleareddaily_task      bool
+	reports                map[uuid.UUID]struct{}
+	removedreports         map[uuid.UUID]struct{}
+	clearedreports         bool
+	explore_ranking        *uuid.UUID
+	clearedexplore_ranking bool
+	revisions              map[uuid.UUID]struct{}
+	removedrevisions       map[uuid.UUID]struct{}
+	clearedrevisions       bool
+	media                  map[uuid.UUID]struct{}
+	removedmedia           map[uuid.UUID]struct{}
+	clearedmedia           bool
+	pets                   map[uuid.UUID]struct{}
+	removedpets            map[uuid.UUID]struct{}
+	clearedpets            bool
+	tags                   map[uuid.UUID]struct{}
+	removedtags            map[uuid.UUID]struct{}
+	clearedtags            bool
+	mentions               map[uuid.UUID]struct{}
+	removedmentions        map[uuid.UUID]struct{}
+	clearedmentions        bool
+	notifications          map[uuid.UUID]struct{}
+	removednotifications   map[uuid.UUID]struct{}
+	clearednotifications   bool
+	done                   bool
+	oldValue               func(context.Context) (*Post, error)
+	predicates             []predicate.Post
+}
+
+var _ ent.Mutation = (*PostMutation)(nil)
+
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
+
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostID sets the ID field of the mutation.
+func withPostID(id uuid.UUID) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Post entities.
+func (m *PostMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIndex sets the "index" field.
+func (m *PostMutation) SetIndex(u uint32) {
+	m.index = &u
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *PostMutation) Index() (r uint32, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldIndex(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds u to the "index" field.
+func (m *PostMutation) AddIndex(u int32) {
+	if m.addindex != nil {
+		*m.addindex += u
+	} else {
+		m.addindex = &u
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *PostMutation) AddedIndex() (r int32, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIndex clears the value of the "index" field.
+func (m *PostMutation) ClearIndex() {
+	m.index = nil
+	m.addindex = nil
+	m.clearedFields[post.FieldIndex] = struct{}{}
+}
+
+// IndexCleared returns if the "index" field was cleared in this mutation.
+func (m *PostMutation) IndexCleared() bool {
+	_, ok := m.clearedFields[post.FieldIndex]
+	return ok
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *PostMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+	delete(m.clearedFields, post.FieldIndex)
+}
+
+// SetCaption sets the "caption" field.
+func (m *PostMutation) SetCaption(s string) {
+	m.caption = &s
+}
+
+// Caption returns the value of the "caption" field in the mutation.
+func (m *PostMutation) Caption() (r string, exists bool) {
+	v := m.caption
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaption returns the old "caption" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCaption(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaption is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaption requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaption: %w", err)
+	}
+	return oldValue.Caption, nil
+}
+
+// ResetCaption resets all changes to the "caption" field.
+func (m *PostMutation) ResetCaption() {
+	m.caption = nil
+}
+
+// SetImageKey sets the "image_key" field.
+func (m *PostMutation) SetImageKey(s string) {
+	m.image_key = &s
+}
+
+// ImageKey returns the value of the "image_key" field in the mutation.
+func (m *PostMutation) ImageKey() (r string, exists bool) {
+	v := m.image_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageKey returns the old "image_key" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldImageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageKey: %w", err)
+	}
+	return oldValue.ImageKey, nil
+}
+
+// ResetImageKey resets all changes to the "image_key" field.
+func (m *PostMutation) ResetImageKey() {
+	m.image_key = nil
+}
+
+// SetMediaType sets the "media_type" field.
+func (m *PostMutation) SetMediaType(et enum.MediaType) {
+	m.media_type = &et
+}
+
+// MediaType returns the value of the "media_type" field in the mutation.
+func (m *PostMutation) MediaType() (r enum.MediaType, exists bool) {
+	v := m.media_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaType returns the old "media_type" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldMediaType(ctx context.Context) (v enum.MediaType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaType: %w", err)
+	}
+	return oldValue.MediaType, nil
+}
+
+// ResetMediaType resets all changes to the "media_type" field.
+func (m *PostMutation) ResetMediaType() {
+	m.media_type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *PostMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *PostMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *PostMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[post.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *PostMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *PostMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, post.FieldDeletedAt)
+}
+
+// SetHiddenAt sets the "hidden_at" field.
+func (m *PostMutation) SetHiddenAt(t time.Time) {
+	m.hidden_at = &t
+}
+
+// HiddenAt returns the value of the "hidden_at" field in the mutation.
+func (m *PostMutation) HiddenAt() (r time.Time, exists bool) {
+	v := m.hidden_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHiddenAt returns the old "hidden_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldHiddenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHiddenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHiddenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHiddenAt: %w", err)
+	}
+	return oldValue.HiddenAt, nil
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (m *PostMutation) ClearHiddenAt() {
+	m.hidden_at = nil
+	m.clearedFields[post.FieldHiddenAt] = struct{}{}
+}
+
+// HiddenAtCleared returns if the "hidden_at" field was cleared in this mutation.
+func (m *PostMutation) HiddenAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldHiddenAt]
+	return ok
+}
+
+// ResetHiddenAt resets all changes to the "hidden_at" field.
+func (m *PostMutation) ResetHiddenAt() {
+	m.hidden_at = nil
+	delete(m.clearedFields, post.FieldHiddenAt)
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *PostMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *PostMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *PostMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[post.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *PostMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *PostMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, post.FieldEditedAt)
+}
+
+// SetLikeCount sets the "like_count" field.
+func (m *PostMutation) SetLikeCount(i int) {
+	m.like_count = &i
+	m.addlike_count = nil
+}
+
+// LikeCount returns the value of the "like_count" field in the mutation.
+func (m *PostMutation) LikeCount() (r int, exists bool) {
+	v := m.like_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLikeCount returns the old "like_count" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldLikeCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLikeCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLikeCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLikeCount: %w", err)
+	}
+	return oldValue.LikeCount, nil
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (m *PostMutation) AddLikeCount(i int) {
+	if m.addlike_count != nil {
+		*m.addlike_count += i
+	} else {
+		m.addlike_count = &i
+	}
+}
+
+// AddedLikeCount returns the value that was added to the "like_count" field in this mutation.
+func (m *PostMutation) AddedLikeCount() (r int, exists bool) {
+	v := m.addlike_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLikeCount resets all changes to the "like_count" field.
+func (m *PostMutation) ResetLikeCount() {
+	m.like_count = nil
+	m.addlike_count = nil
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (m *PostMutation) SetCommentCount(i int) {
+	m.comment_count = &i
+	m.addcomment_count = nil
+}
+
+// CommentCount returns the value of the "comment_count" field in the mutation.
+func (m *PostMutation) CommentCount() (r int, exists bool) {
+	v := m.comment_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentCount returns the old "comment_count" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCommentCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentCount: %w", err)
+	}
+	return oldValue.CommentCount, nil
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (m *PostMutation) AddCommentCount(i int) {
+	if m.addcomment_count != nil {
+		*m.addcomment_count += i
+	} else {
+		m.addcomment_count = &i
+	}
+}
+
+// AddedCommentCount returns the value that was added to the "comment_count" field in this mutation.
+func (m *PostMutation) AddedCommentCount() (r int, exists bool) {
+	v := m.addcomment_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommentCount resets all changes to the "comment_count" field.
+func (m *PostMutation) ResetCommentCount() {
+	m.comment_count = nil
+	m.addcomment_count = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PostMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PostMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PostMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PostMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PostMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *PostMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *PostMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *PostMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *PostMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *PostMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *PostMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *PostMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddLikeIDs adds the "likes" edge to the Like entity by ids.
+func (m *PostMutation) AddLikeIDs(ids ...uuid.UUID) {
+	if m.likes == nil {
+		m.likes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.likes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikes clears the "likes" edge to the Like entity.
+func (m *PostMutation) ClearLikes() {
+	m.clearedlikes = true
+}
+
+// LikesCleared reports if the "likes" edge to the Like entity was cleared.
+func (m *PostMutation) LikesCleared() bool {
+	return m.clearedlikes
+}
+
+// RemoveLikeIDs removes the "likes" edge to the Like entity by IDs.
+func (m *PostMutation) RemoveLikeIDs(ids ...uuid.UUID) {
+	if m.removedlikes == nil {
+		m.removedlikes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.likes, ids[i])
+		m.removedlikes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikes returns the removed IDs of the "likes" edge to the Like entity.
+func (m *PostMutation) RemovedLikesIDs() (ids []uuid.UUID) {
+	for id := range m.removedlikes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikesIDs returns the "likes" edge IDs in the mutation.
+func (m *PostMutation) LikesIDs() (ids []uuid.UUID) {
+	for id := range m.likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikes resets all changes to the "likes" edge.
+func (m *PostMutation) ResetLikes() {
+	m.likes = nil
+	m.clearedlikes = false
+	m.removedlikes = nil
+}
+
+// SetDailyTaskID sets the "daily_task" edge to the DailyTask entity by id.
+func (m *PostMutation) SetDailyTaskID(id uuid.UUID) {
+	m.daily_task = &id
+}
+
+// ClearDailyTask clears the "daily_task" edge to the DailyTask entity.
+func (m *PostMutation) ClearDailyTask() {
+	m.cleareddaily_task = true
+}
+
+// DailyTaskCleared reports if the "daily_task" edge to the DailyTask entity was cleared.
+func (m *PostMutation) DailyTaskCleared() bool {
+	return m.cleareddaily_task
+}
+
+// DailyTaskID returns the "daily_task" edge ID in the mutation.
+func (m *PostMutation) DailyTaskID() (id uuid.UUID, exists bool) {
+	if m.daily_task != nil {
+		return *m.daily_task, true
+	}
+	return
+}
+
+// DailyTaskIDs returns the "daily_task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DailyTaskID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) DailyTaskIDs() (ids []uuid.UUID) {
+	if id := m.daily_task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDailyTask resets all changes to the "daily_task" edge.
+func (m *PostMutation) ResetDailyTask() {
+	m.daily_task = nil
+	m.cleareddaily_task = false
+}
+
+// AddReportIDs adds the "reports" edge to the Report entity by ids.
+func (m *PostMutation) AddReportIDs(ids ...uuid.UUID) {
+	if m.reports == nil {
+		m.reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReports clears the "reports" edge to the Report entity.
+func (m *PostMutation) ClearReports() {
+	m.clearedreports = true
+}
+
+// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
+func (m *PostMutation) ReportsCleared() bool {
+	return m.clearedreports
+}
+
+// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
+func (m *PostMutation) RemoveReportIDs(ids ...uuid.UUID) {
+	if m.removedreports == nil {
+		m.removedreports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reports, ids[i])
+		m.removedreports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
+func (m *PostMutation) RemovedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReportsIDs returns the "reports" edge IDs in the mutation.
+func (m *PostMutation) ReportsIDs() (ids []uuid.UUID) {
+	for id := range m.reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReports resets all changes to the "reports" edge.
+func (m *PostMutation) ResetReports() {
+	m.reports = nil
+	m.clearedreports = false
+	m.removedreports = nil
+}
+
+// SetExploreRankingID sets the "explore_ranking" edge to the ExploreRanking entity by id.
+func (m *PostMutation) SetExploreRankingID(id uuid.UUID) {
+	m.explore_ranking = &id
+}
+
+// ClearExploreRanking clears the "explore_ranking" edge to the ExploreRanking entity.
+func (m *PostMutation) ClearExploreRanking() {
+	m.clearedexplore_ranking = true
+}
+
+// ExploreRankingCleared reports if the "explore_ranking" edge to the ExploreRanking entity was cleared.
+func (m *PostMutation) ExploreRankingCleared() bool {
+	return m.clearedexplore_ranking
+}
+
+// ExploreRankingID returns the "explore_ranking" edge ID in the mutation.
+func (m *PostMutation) ExploreRankingID() (id uuid.UUID, exists bool) {
+	if m.explore_ranking != nil {
+		return *m.explore_ranking, true
+	}
+	return
+}
+
+// ExploreRankingIDs returns the "explore_ranking" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExploreRankingID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) ExploreRankingIDs() (ids []uuid.UUID) {
+	if id := m.explore_ranking; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExploreRanking resets all changes to the "explore_ranking" edge.
+func (m *PostMutation) ResetExploreRanking() {
+	m.explore_ranking = nil
+	m.clearedexplore_ranking = false
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by ids.
+func (m *PostMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the PostRevision entity was cleared.
+func (m *PostMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the PostRevision entity by IDs.
+func (m *PostMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *PostMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *PostMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddMediumIDs adds the "media" edge to the PostMedia entity by ids.
+func (m *PostMutation) AddMediumIDs(ids ...uuid.UUID) {
+	if m.media == nil {
+		m.media = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMedia clears the "media" edge to the PostMedia entity.
+func (m *PostMutation) ClearMedia() {
+	m.clearedmedia = true
+}
+
+// MediaCleared reports if the "media" edge to the PostMedia entity was cleared.
+func (m *PostMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// RemoveMediumIDs removes the "media" edge to the PostMedia entity by IDs.
+func (m *PostMutation) RemoveMediumIDs(ids ...uuid.UUID) {
+	if m.removedmedia == nil {
+		m.removedmedia = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.media, ids[i])
+		m.removedmedia[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMedia returns the removed IDs of the "media" edge to the PostMedia entity.
+func (m *PostMutation) RemovedMediaIDs() (ids []uuid.UUID) {
+	for id := range m.removedmedia {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+func (m *PostMutation) MediaIDs() (ids []uuid.UUID) {
+	for id := range m.media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *PostMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+	m.removedmedia = nil
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by ids.
+func (m *PostMutation) AddPetIDs(ids ...uuid.UUID) {
+	if m.pets == nil {
+		m.pets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.pets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPets clears the "pets" edge to the Pet entity.
+func (m *PostMutation) ClearPets() {
+	m.clearedpets = true
+}
+
+// PetsCleared reports if the "pets" edge to the Pet entity was cleared.
+func (m *PostMutation) PetsCleared() bool {
+	return m.clearedpets
+}
+
+// RemovePetIDs removes the "pets" edge to the Pet entity by IDs.
+func (m *PostMutation) RemovePetIDs(ids ...uuid.UUID) {
+	if m.removedpets == nil {
+		m.removedpets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.pets, ids[i])
+		m.removedpets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPets returns the removed IDs of the "pets" edge to the Pet entity.
+func (m *PostMutation) RemovedPetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PetsIDs returns the "pets" edge IDs in the mutation.
+func (m *PostMutation) PetsIDs() (ids []uuid.UUID) {
+	for id := range m.pets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPets resets all changes to the "pets" edge.
+func (m *PostMutation) ResetPets() {
+	m.pets = nil
+	m.clearedpets = false
+	m.removedpets = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *PostMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *PostMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *PostMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *PostMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *PostMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *PostMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *PostMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by ids.
+func (m *PostMutation) AddMentionIDs(ids ...uuid.UUID) {
+	if m.mentions == nil {
+		m.mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentions clears the "mentions" edge to the User entity.
+func (m *PostMutation) ClearMentions() {
+	m.clearedmentions = true
+}
+
+// MentionsCleared reports if the "mentions" edge to the User entity was cleared.
+func (m *PostMutation) MentionsCleared() bool {
+	return m.clearedmentions
+}
+
+// RemoveMentionIDs removes the "mentions" edge to the User entity by IDs.
+func (m *PostMutation) RemoveMentionIDs(ids ...uuid.UUID) {
+	if m.removedmentions == nil {
+		m.removedmentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentions, ids[i])
+		m.removedmentions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentions returns the removed IDs of the "mentions" edge to the User entity.
+func (m *PostMutation) RemovedMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionsIDs returns the "mentions" edge IDs in the mutation.
+func (m *PostMutation) MentionsIDs() (ids []uuid.UUID) {
+	for id := range m.mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentions resets all changes to the "mentions" edge.
+func (m *PostMutation) ResetMentions() {
+	m.mentions = nil
+	m.clearedmentions = false
+	m.removedmentions = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *PostMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *PostMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *PostMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *PostMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *PostMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *PostMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *PostMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.index != nil {
+		fields = append(fields, post.FieldIndex)
+	}
+	if m.caption != nil {
+		fields = append(fields, post.FieldCaption)
+	}
+	if m.image_key != nil {
+		fields = append(fields, post.FieldImageKey)
+	}
+	if m.media_type != nil {
+		fields = append(fields, post.FieldMediaType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, post.FieldCreatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, post.FieldDeletedAt)
+	}
+	if m.hidden_at != nil {
+		fields = append(fields, post.FieldHiddenAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, post.FieldEditedAt)
+	}
+	if m.like_count != nil {
+		fields = append(fields, post.FieldLikeCount)
+	}
+	if m.comment_count != nil {
+		fields = append(fields, post.FieldCommentCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldIndex:
+		return m.Index()
+	case post.FieldCaption:
+		return m.Caption()
+	case post.FieldImageKey:
+		return m.ImageKey()
+	case post.FieldMediaType:
+		return m.MediaType()
+	case post.FieldCreatedAt:
+		return m.CreatedAt()
+	case post.FieldDeletedAt:
+		return m.DeletedAt()
+	case post.FieldHiddenAt:
+		return m.HiddenAt()
+	case post.FieldEditedAt:
+		return m.EditedAt()
+	case post.FieldLikeCount:
+		return m.LikeCount()
+	case post.FieldCommentCount:
+		return m.CommentCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case post.FieldIndex:
+		return m.OldIndex(ctx)
+	case post.FieldCaption:
+		return m.OldCaption(ctx)
+	case post.FieldImageKey:
+		return m.OldImageKey(ctx)
+	case post.FieldMediaType:
+		return m.OldMediaType(ctx)
+	case post.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case post.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case post.FieldHiddenAt:
+		return m.OldHiddenAt(ctx)
+	case post.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case post.FieldLikeCount:
+		return m.OldLikeCount(ctx)
+	case post.FieldCommentCount:
+		return m.OldCommentCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown Post field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldIndex:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case post.FieldCaption:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaption(v)
+		return nil
+	case post.FieldImageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageKey(v)
+		return nil
+	case post.FieldMediaType:
+		v, ok := value.(enum.MediaType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaType(v)
+		return nil
+	case post.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case post.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case post.FieldHiddenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHiddenAt(v)
+		return nil
+	case post.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case post.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLikeCount(v)
+		return nil
+	case post.FieldCommentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, post.FieldIndex)
+	}
+	if m.addlike_count != nil {
+		fields = append(fields, post.FieldLikeCount)
+	}
+	if m.addcomment_count != nil {
+		fields = append(fields, post.FieldCommentCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldIndex:
+		return m.AddedIndex()
+	case post.FieldLikeCount:
+		return m.AddedLikeCount()
+	case post.FieldCommentCount:
+		return m.AddedCommentCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	case post.FieldLikeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLikeCount(v)
+		return nil
+	case post.FieldCommentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommentCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Post numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(post.FieldIndex) {
+		fields = append(fields, post.FieldIndex)
+	}
+	if m.FieldCleared(post.FieldDeletedAt) {
+		fields = append(fields, post.FieldDeletedAt)
+	}
+	if m.FieldCleared(post.FieldHiddenAt) {
+		fields = append(fields, post.FieldHiddenAt)
+	}
+	if m.FieldCleared(post.FieldEditedAt) {
+		fields = append(fields, post.FieldEditedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostMutation) ClearField(name string) error {
+	switch name {
+	case post.FieldIndex:
+		m.ClearIndex()
+		return nil
+	case post.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case post.FieldHiddenAt:
+		m.ClearHiddenAt()
+		return nil
+	case post.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Post nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostMutation) ResetField(name string) error {
+	switch name {
+	case post.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case post.FieldCaption:
+		m.ResetCaption()
+		return nil
+	case post.FieldImageKey:
+		m.ResetImageKey()
+		return nil
+	case post.FieldMediaType:
+		m.ResetMediaType()
+		return nil
+	case post.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case post.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case post.FieldHiddenAt:
+		m.ResetHiddenAt()
+		return nil
+	case post.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case post.FieldLikeCount:
+		m.ResetLikeCount()
+		return nil
+	case post.FieldCommentCount:
+		m.ResetCommentCount()
+		return nil
+	}
+	return fmt.Errorf("unknown Post field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.user != nil {
+		edges = append(edges, post.EdgeUser)
+	}
+	if m.comments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.likes != nil {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.daily_task != nil {
+		edges = append(edges, post.EdgeDailyTask)
+	}
+	if m.reports != nil {
+		edges = append(edges, post.EdgeReports)
+	}
+	if m.explore_ranking != nil {
+		edges = append(edges, post.EdgeExploreRanking)
+	}
+	if m.revisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.media != nil {
+		edges = append(edges, post.EdgeMedia)
+	}
+	if m.pets != nil {
+		edges = append(edges, post.EdgePets)
+	}
+	if m.tags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.mentions != nil {
+		edges = append(edges, post.EdgeMentions)
+	}
+	if m.notifications != nil {
+		edges = append(edges, post.EdgeNotifications)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.likes))
+		for id := range m.likes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeDailyTask:
+		if id := m.daily_task; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.reports))
+		for id := range m.reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeExploreRanking:
+		if id := m.explore_ranking; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.media))
+		for id := range m.media {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgePets:
+		ids := make([]ent.Value, 0, len(m.pets))
+		for id := range m.pets {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.mentions))
+		for id := range m.mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removedcomments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.removedlikes != nil {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.removedreports != nil {
+		edges = append(edges, post.EdgeReports)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.removedmedia != nil {
+		edges = append(edges, post.EdgeMedia)
+	}
+	if m.removedpets != nil {
+		edges = append(edges, post.EdgePets)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.removedmentions != nil {
+		edges = append(edges, post.EdgeMentions)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, post.EdgeNotifications)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.removedlikes))
+		for id := range m.removedlikes {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.removedreports))
+		for id := range m.removedreports {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.removedmedia))
+		for id := range m.removedmedia {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgePets:
+		ids := make([]ent.Value, 0, len(m.removedpets))
+		for id := range m.removedpets {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedmentions))
+		for id := range m.removedmentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.cleareduser {
+		edges = append(edges, post.EdgeUser)
+	}
+	if m.clearedcomments {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.clearedlikes {
+		edges = append(edges, post.EdgeLikes)
+	}
+	if m.cleareddaily_task {
+		edges = append(edges, post.EdgeDailyTask)
+	}
+	if m.clearedreports {
+		edges = append(edges, post.EdgeReports)
+	}
+	if m.clearedexplore_ranking {
+		edges = append(edges, post.EdgeExploreRanking)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.clearedmedia {
+		edges = append(edges, post.EdgeMedia)
+	}
+	if m.clearedpets {
+		edges = append(edges, post.EdgePets)
+	}
+	if m.clearedtags {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.clearedmentions {
+		edges = append(edges, post.EdgeMentions)
+	}
+	if m.clearednotifications {
+		edges = append(edges, post.EdgeNotifications)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case post.EdgeUser:
+		return m.cleareduser
+	case post.EdgeComments:
+		return m.clearedcomments
+	case post.EdgeLikes:
+		return m.clearedlikes
+	case post.EdgeDailyTask:
+		return m.cleareddaily_task
+	case post.EdgeReports:
+		return m.clearedreports
+	case post.EdgeExploreRanking:
+		return m.clearedexplore_ranking
+	case post.EdgeRevisions:
+		return m.clearedrevisions
+	case post.EdgeMedia:
+		return m.clearedmedia
+	case post.EdgePets:
+		return m.clearedpets
+	case post.EdgeTags:
+		return m.clearedtags
+	case post.EdgeMentions:
+		return m.clearedmentions
+	case post.EdgeNotifications:
+		return m.clearednotifications
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostMutation) ClearEdge(name string) error {
+	switch name {
+	case post.EdgeUser:
+		m.ClearUser()
+		return nil
+	case post.EdgeDailyTask:
+		m.ClearDailyTask()
+		return nil
+	case post.EdgeExploreRanking:
+		m.ClearExploreRanking()
+		return nil
+	}
+	return fmt.Errorf("unknown Post unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostMutation) ResetEdge(name string) error {
+	switch name {
+	case post.EdgeUser:
+		m.ResetUser()
+		return nil
+	case post.EdgeComments:
+		m.ResetComments()
+		return nil
+	case post.EdgeLikes:
+		m.ResetLikes()
+		return nil
+	case post.EdgeDailyTask:
+		m.ResetDailyTask()
+		return nil
+	case post.EdgeReports:
+		m.ResetReports()
+		return nil
+	case post.EdgeExploreRanking:
+		m.ResetExploreRanking()
+		return nil
+	case post.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case post.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case post.EdgePets:
+		m.ResetPets()
+		return nil
+	case post.EdgeTags:
+		m.ResetTags()
+		return nil
+	case post.EdgeMentions:
+		m.ResetMentions()
+		return nil
+	case post.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	}
+	return fmt.Errorf("unknown Post edge %s", name)
+}
+
+// PostMediaMutation represents an operation that mutates the PostMedia nodes in the graph.
+type PostMediaMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	position       *int
+	addposition    *int
+	_type          *enum.MediaType
+	key            *string
+	width          *int
+	addwidth       *int
+	height         *int
+	addheight      *int
+	alt_text       *string
+	duration_ms    *int
+	addduration_ms *int
+	poster_key     *string
+	clearedFields  map[string]struct{}
+	post           *uuid.UUID
+	clearedpost    bool
+	done           bool
+	oldValue       func(context.Context) (*PostMedia, error)
+	predicates     []predicate.PostMedia
+}
+
+var _ ent.Mutation = (*PostMediaMutation)(nil)
+
+// postmediaOption allows management of the mutation configuration using functional options.
+type postmediaOption func(*PostMediaMutation)
+
+// newPostMediaMutation creates new mutation for the PostMedia entity.
+func newPostMediaMutation(c config, op Op, opts ...postmediaOption) *PostMediaMutation {
+	m := &PostMediaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostMedia,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostMediaID sets the ID field of the mutation.
+func withPostMediaID(id uuid.UUID) postmediaOption {
+	return func(m *PostMediaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostMedia
+		)
+		m.oldValue = func(ctx context.Context) (*PostMedia, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostMedia.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostMedia sets the old PostMedia of the mutation.
+func withPostMedia(node *PostMedia) postmediaOption {
+	return func(m *PostMediaMutation) {
+		m.oldValue = func(context.Context) (*PostMedia, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMediaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMediaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostMedia entities.
+func (m *PostMediaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMediaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMediaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostMedia.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPosition sets the "position" field.
+func (m *PostMediaMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PostMediaMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *PostMediaMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PostMediaMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PostMediaMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetType sets the "type" field.
+func (m *PostMediaMutation) SetType(et enum.MediaType) {
+	m._type = &et
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *PostMediaMutation) GetType() (r enum.MediaType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldType(ctx context.Context) (v enum.MediaType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *PostMediaMutation) ResetType() {
+	m._type = nil
+}
+
+// SetKey sets the "key" field.
+func (m *PostMediaMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *PostMediaMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *PostMediaMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *PostMediaMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *PostMediaMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldWidth(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *PostMediaMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *PostMediaMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWidth clears the value of the "width" field.
+func (m *PostMediaMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[postmedia.FieldWidth] = struct{}{}
+}
+
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *PostMediaMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[postmedia.FieldWidth]
+	return ok
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *PostMediaMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, postmedia.FieldWidth)
+}
+
+// SetHeight sets the "height" field.
+func (m *PostMediaMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *PostMediaMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldHeight(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *PostMediaMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *PostMediaMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeight clears the value of the "height" field.
+func (m *PostMediaMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[postmedia.FieldHeight] = struct{}{}
+}
+
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *PostMediaMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[postmedia.FieldHeight]
+	return ok
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *PostMediaMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, postmedia.FieldHeight)
+}
+
+// SetAltText sets the "alt_text" field.
+func (m *PostMediaMutation) SetAltText(s string) {
+	m.alt_text = &s
+}
+
+// AltText returns the value of the "alt_text" field in the mutation.
+func (m *PostMediaMutation) AltText() (r string, exists bool) {
+	v := m.alt_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAltText returns the old "alt_text" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldAltText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAltText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAltText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAltText: %w", err)
+	}
+	return oldValue.AltText, nil
+}
+
+// ResetAltText resets all changes to the "alt_text" field.
+func (m *PostMediaMutation) ResetAltText() {
+	m.alt_text = nil
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *PostMediaMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *PostMediaMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldDurationMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *PostMediaMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *PostMediaMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *PostMediaMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[postmedia.FieldDurationMs] = struct{}{}
+}
+
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *PostMediaMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[postmedia.FieldDurationMs]
+	return ok
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *PostMediaMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, postmedia.FieldDurationMs)
+}
+
+// SetPosterKey sets the "poster_key" field.
+func (m *PostMediaMutation) SetPosterKey(s string) {
+	m.poster_key = &s
+}
+
+// PosterKey returns the value of the "poster_key" field in the mutation.
+func (m *PostMediaMutation) PosterKey() (r string, exists bool) {
+	v := m.poster_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosterKey returns the old "poster_key" field's value of the PostMedia entity.
+// If the PostMedia object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMediaMutation) OldPosterKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosterKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosterKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosterKey: %w", err)
+	}
+	return oldValue.PosterKey, nil
+}
+
+// ClearPosterKey clears the value of the "poster_key" field.
+func (m *PostMediaMutation) ClearPosterKey() {
+	m.poster_key = nil
+	m.clearedFields[postmedia.FieldPosterKey] = struct{}{}
+}
+
+// PosterKeyCleared returns if the "poster_key" field was cleared in this mutation.
+func (m *PostMediaMutation) PosterKeyCleared() bool {
+	_, ok := m.clearedFields[postmedia.FieldPosterKey]
+	return ok
+}
+
+// ResetPosterKey resets all changes to the "poster_key" field.
+func (m *PostMediaMutation) ResetPosterKey() {
+	m.poster_key = nil
+	delete(m.clearedFields, postmedia.FieldPosterKey)
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *PostMediaMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostMediaMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostMediaMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *PostMediaMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostMediaMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostMediaMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the PostMediaMutation builder.
+func (m *PostMediaMutation) Where(ps ...predicate.PostMedia) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostMediaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostMedia, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostMediaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostMediaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostMedia).
+func (m *PostMediaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostMediaMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.position != nil {
+		fields = append(fields, postmedia.FieldPosition)
+	}
+	if m._type != nil {
+		fields = append(fields, postmedia.FieldType)
+	}
+	if m.key != nil {
+		fields = append(fields, postmedia.FieldKey)
+	}
+	if m.width != nil {
+		fields = append(fields, postmedia.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, postmedia.FieldHeight)
+	}
+	if m.alt_text != nil {
+		fields = append(fields, postmedia.FieldAltText)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, postmedia.FieldDurationMs)
+	}
+	if m.poster_key != nil {
+		fields = append(fields, postmedia.FieldPosterKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostMediaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postmedia.FieldPosition:
+		return m.Position()
+	case postmedia.FieldType:
+		return m.GetType()
+	case postmedia.FieldKey:
+		return m.Key()
+	case postmedia.FieldWidth:
+		return m.Width()
+	case postmedia.FieldHeight:
+		return m.Height()
+	case postmedia.FieldAltText:
+		return m.AltText()
+	case postmedia.FieldDurationMs:
+		return m.DurationMs()
+	case postmedia.FieldPosterKey:
+		return m.PosterKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostMediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postmedia.FieldPosition:
+		return m.OldPosition(ctx)
+	case postmedia.FieldType:
+		return m.OldType(ctx)
+	case postmedia.FieldKey:
+		return m.OldKey(ctx)
+	case postmedia.FieldWidth:
+		return m.OldWidth(ctx)
+	case postmedia.FieldHeight:
+		return m.OldHeight(ctx)
+	case postmedia.FieldAltText:
+		return m.OldAltText(ctx)
+	case postmedia.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case postmedia.FieldPosterKey:
+		return m.OldPosterKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostMedia field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMediaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postmedia.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case postmedia.FieldType:
+		v, ok := value.(enum.MediaType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case postmedia.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case postmedia.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case postmedia.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case postmedia.FieldAltText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAltText(v)
+		return nil
+	case postmedia.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case postmedia.FieldPosterKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosterKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostMedia field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostMediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, postmedia.FieldPosition)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, postmedia.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, postmedia.FieldHeight)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, postmedia.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostMediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case postmedia.FieldPosition:
+		return m.AddedPosition()
+	case postmedia.FieldWidth:
+		return m.AddedWidth()
+	case postmedia.FieldHeight:
+		return m.AddedHeight()
+	case postmedia.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMediaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case postmedia.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case postmedia.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case postmedia.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case postmedia.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostMedia numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostMediaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(postmedia.FieldWidth) {
+		fields = append(fields, postmedia.FieldWidth)
+	}
+	if m.FieldCleared(postmedia.FieldHeight) {
+		fields = append(fields, postmedia.FieldHeight)
+	}
+	if m.FieldCleared(postmedia.FieldDurationMs) {
+		fields = append(fields, postmedia.FieldDurationMs)
+	}
+	if m.FieldCleared(postmedia.FieldPosterKey) {
+		fields = append(fields, postmedia.FieldPosterKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostMediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostMediaMutation) ClearField(name string) error {
+	switch name {
+	case postmedia.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case postmedia.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case postmedia.FieldDurationMs:
+		m.ClearDurationMs()
+		return nil
+	case postmedia.FieldPosterKey:
+		m.ClearPosterKey()
+		return nil
+	}
+	return fmt.Errorf("unknown PostMedia nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostMediaMutation) ResetField(name string) error {
+	switch name {
+	case postmedia.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case postmedia.FieldType:
+		m.ResetType()
+		return nil
+	case postmedia.FieldKey:
+		m.ResetKey()
+		return nil
+	case postmedia.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case postmedia.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case postmedia.FieldAltText:
+		m.ResetAltText()
+		return nil
+	case postmedia.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case postmedia.FieldPosterKey:
+		m.ResetPosterKey()
+		return nil
+	}
+	return fmt.Errorf("unknown PostMedia field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostMediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.post != nil {
+		edges = append(edges, postmedia.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostMediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postmedia.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostMediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostMediaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostMediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpost {
+		edges = append(edges, postmedia.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostMediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postmedia.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostMediaMutation) ClearEdge(name string) error {
+	switch name {
+	case postmedia.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostMedia unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostMediaMutation) ResetEdge(name string) error {
+	switch name {
+	case postmedia.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostMedia edge %s", name)
+}
+
+// PostRevisionMutation represents an operation that mutates the PostRevision nodes in the graph.
+type PostRevisionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	caption       *string
+	pet_ids       *[]uuid.UUID
+	appendpet_ids []uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	post          *uuid.UUID
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*PostRevision, error)
+	predicates    []predicate.PostRevision
+}
+
+var _ ent.Mutation = (*PostRevisionMutation)(nil)
+
+// postrevisionOption allows management of the mutation configuration using functional options.
+type postrevisionOption func(*PostRevisionMutation)
+
+// newPostRevisionMutation creates new mutation for the PostRevision entity.
+func newPostRevisionMutation(c config, op Op, opts ...postrevisionOption) *PostRevisionMutation {
+	m := &PostRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostRevisionID sets the ID field of the mutation.
+func withPostRevisionID(id uuid.UUID) postrevisionOption {
+	return func(m *PostRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostRevision
+		)
+		m.oldValue = func(ctx context.Context) (*PostRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPostRevision sets the old PostRevision of the mutation.
+func withPostRevision(node *PostRevision) postrevisionOption {
+	return func(m *PostRevisionMutation) {
+		m.oldValue = func(context.Context) (*PostRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PostRevision entities.
+func (m *PostRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCaption sets the "caption" field.
+func (m *PostRevisionMutation) SetCaption(s string) {
+	m.caption = &s
+}
+
+// Caption returns the value of the "caption" field in the mutation.
+func (m *PostRevisionMutation) Caption() (r string, exists bool) {
+	v := m.caption
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaption returns the old "caption" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldCaption(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaption is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaption requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaption: %w", err)
+	}
+	return oldValue.Caption, nil
+}
+
+// ResetCaption resets all changes to the "caption" field.
+func (m *PostRevisionMutation) ResetCaption() {
+	m.caption = nil
+}
+
+// SetPetIds sets the "pet_ids" field.
+func (m *PostRevisionMutation) SetPetIds(u []uuid.UUID) {
+	m.pet_ids = &u
+	m.appendpet_ids = nil
+}
+
+// PetIds returns the value of the "pet_ids" field in the mutation.
+func (m *PostRevisionMutation) PetIds() (r []uuid.UUID, exists bool) {
+	v := m.pet_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPetIds returns the old "pet_ids" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldPetIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPetIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPetIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPetIds: %w", err)
+	}
+	return oldValue.PetIds, nil
+}
+
+// AppendPetIds adds u to the "pet_ids" field.
+func (m *PostRevisionMutation) AppendPetIds(u []uuid.UUID) {
+	m.appendpet_ids = append(m.appendpet_ids, u...)
+}
+
+// AppendedPetIds returns the list of values that were appended to the "pet_ids" field in this mutation.
+func (m *PostRevisionMutation) AppendedPetIds() ([]uuid.UUID, bool) {
+	if len(m.appendpet_ids) == 0 {
+		return nil, false
+	}
+	return m.appendpet_ids, true
+}
+
+// ClearPetIds clears the value of the "pet_ids" field.
+func (m *PostRevisionMutation) ClearPetIds() {
+	m.pet_ids = nil
+	m.appendpet_ids = nil
+	m.clearedFields[postrevision.FieldPetIds] = struct{}{}
+}
+
+// PetIdsCleared returns if the "pet_ids" field was cleared in this mutation.
+func (m *PostRevisionMutation) PetIdsCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldPetIds]
+	return ok
+}
+
+// ResetPetIds resets all changes to the "pet_ids" field.
+func (m *PostRevisionMutation) ResetPetIds() {
+	m.pet_ids = nil
+	m.appendpet_ids = nil
+	delete(m.clearedFields, postrevision.FieldPetIds)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *PostRevisionMutation) SetPostID(id uuid.UUID) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostRevisionMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostRevisionMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *PostRevisionMutation) PostID() (id uuid.UUID, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostRevisionMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostRevisionMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the PostRevisionMutation builder.
+func (m *PostRevisionMutation) Where(ps ...predicate.PostRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostRevision).
+func (m *PostRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.caption != nil {
+		fields = append(fields, postrevision.FieldCaption)
+	}
+	if m.pet_ids != nil {
+		fields = append(fields, postrevision.FieldPetIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, postrevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postrevision.FieldCaption:
+		return m.Caption()
+	case postrevision.FieldPetIds:
+		return m.PetIds()
+	case postrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postrevision.FieldCaption:
+		return m.OldCaption(ctx)
+	case postrevision.FieldPetIds:
+		return m.OldPetIds(ctx)
+	case postrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postrevision.FieldCaption:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaption(v)
+		return nil
+	case postrevision.FieldPetIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPetIds(v)
+		return nil
+	case postrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostRevisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(postrevision.FieldPetIds) {
+		fields = append(fields, postrevision.FieldPetIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ClearField(name string) error {
+	switch name {
+	case postrevision.FieldPetIds:
+		m.ClearPetIds()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ResetField(name string) error {
+	switch name {
+	case postrevision.FieldCaption:
+		m.ResetCaption()
+		return nil
+	case postrevision.FieldPetIds:
+		m.ResetPetIds()
+		return nil
+	case postrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.post != nil {
+		edges = append(edges, postrevision.EdgePost)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postrevision.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpost {
+		edges = append(edges, postrevision.EdgePost)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postrevision.EdgePost:
+		return m.clearedpost
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ClearPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision edge %s", name)
+}
+
+// PostTagMutation represents an operation that mutates the PostTag nodes in the graph.
+type PostTagMutation struct {
+	config
+	op            Op
+	typ           string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	post          *uuid.UUID
+	clearedpost   bool
+	tag           *uuid.UUID
+	clearedtag    bool
+	done          bool
+	oldValue      func(context.Context) (*PostTag, error)
+	predicates    []predicate.PostTag
+}
+
+var _ ent.Mutation = (*PostTagMutation)(nil)
+
+// posttagOption allows management of the mutation configuration using functional options.
+type posttagOption func(*PostTagMutation)
+
+// newPostTagMutation creates new mutation for the PostTag entity.
+func newPostTagMutation(c config, op Op, opts ...posttagOption) *PostTagMutation {
+	m := &PostTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetPostID sets the "post_id" field.
+func (m *PostTagMutation) SetPostID(u uuid.UUID) {
+	m.post = &u
+}
+
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *PostTagMutation) PostID() (r uuid.UUID, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPostID resets all changes to the "post_id" field.
+func (m *PostTagMutation) ResetPostID() {
+	m.post = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *PostTagMutation) SetTagID(u uuid.UUID) {
+	m.tag = &u
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *PostTagMutation) TagID() (r uuid.UUID, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *PostTagMutation) ResetTagID() {
+	m.tag = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostTagMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[posttag.FieldPostID] = struct{}{}
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostTagMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostTagMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostTagMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *PostTagMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[posttag.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *PostTagMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *PostTagMutation) TagIDs() (ids []uuid.UUID) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *PostTagMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+}
+
+// Where appends a list predicates to the PostTagMutation builder.
+func (m *PostTagMutation) Where(ps ...predicate.PostTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostTag).
+func (m *PostTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostTagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.post != nil {
+		fields = append(fields, posttag.FieldPostID)
+	}
+	if m.tag != nil {
+		fields = append(fields, posttag.FieldTagID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, posttag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case posttag.FieldPostID:
+		return m.PostID()
+	case posttag.FieldTagID:
+		return m.TagID()
+	case posttag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, errors.New("edge schema PostTag does not support getting old values")
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case posttag.FieldPostID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostID(v)
+		return nil
+	case posttag.FieldTagID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case posttag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostTagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PostTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PostTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostTagMutation) ResetField(name string) error {
+	switch name {
+	case posttag.FieldPostID:
+		m.ResetPostID()
+		return nil
+	case posttag.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case posttag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PostTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.post != nil {
+		edges = append(edges, posttag.EdgePost)
+	}
+	if m.tag != nil {
+		edges = append(edges, posttag.EdgeTag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case posttag.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case posttag.EdgeTag:
+		if id := m.tag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostTagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpost {
+		edges = append(edges, posttag.EdgePost)
+	}
+	if m.clearedtag {
+		edges = append(edges, posttag.EdgeTag)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case posttag.EdgePost:
+		return m.clearedpost
+	case posttag.EdgeTag:
+		return m.clearedtag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostTagMutation) ClearEdge(name string) error {
+	switch name {
+	case posttag.EdgePost:
+		m.ClearPost()
+		return nil
+	case posttag.EdgeTag:
+		m.ClearTag()
+		return nil
+	}
+	return fmt.Errorf("unknown PostTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostTagMutation) ResetEdge(name string) error {
+	switch name {
+	case posttag.EdgePost:
+		m.ResetPost()
+		return nil
+	case posttag.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown PostTag edge %s", name)
+}
+
+// ReportMutation represents an operation that mutates the Report nodes in the graph.
+type ReportMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	target_type        *enum.ReportTargetType
+	reason             *enum.ReportReason
+	details            *string
+	status             *enum.ReportStatus
+	resolution_note    *string
+	created_at         *time.Time
+	resolved_at        *time.Time
+	clearedFields      map[string]struct{}
+	post               *uuid.UUID
+	clearedpost        bool
+	comment            *uuid.UUID
+	clearedcomment     bool
+	target_user        *uuid.UUID
+	clearedtarget_user bool
+	reporter           *uuid.UUID
+	clearedreporter    bool
+	resolver           *uuid.UUID
+	clearedresolver    bool
+	done               bool
+	oldValue           func(context.Context) (*Report, error)
+	predicates         []predicate.Report
+}
+
+var _ ent.Mutation = (*ReportMutation)(nil)
+
+// reportOption allows management of the mutation configuration using functional options.
+type reportOption func(*ReportMutation)
+
+// newReportMutation creates new mutation for the Report entity.
+func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
+	m := &ReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportID sets the ID field of the mutation.
+func withReportID(id uuid.UUID) reportOption {
+	return func(m *ReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Report
+		)
+		m.oldValue = func(ctx context.Context) (*Report, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Report.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReport sets the old Report of the mutation.
+func withReport(node *Report) reportOption {
+	return func(m *ReportMutation) {
+		m.oldValue = func(context.Context) (*Report, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Report entities.
+func (m *ReportMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Report.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTargetType sets the "target_type" field.
+func (m *ReportMutation) SetTargetType(ett enum.ReportTargetType) {
+	m.target_type = &ett
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *ReportMutation) TargetType() (r enum.ReportTargetType, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTargetType(ctx context.Context) (v enum.ReportTargetType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *ReportMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetPostID sets the "post_id" field.
+func (m *ReportMutation) SetPostID(u uuid.UUID) {
+	m.post = &u
+}
+
+// PostID returns the value of the "post_id" field in the mutation.
+func (m *ReportMutation) PostID() (r uuid.UUID, exists bool) {
+	v := m.post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostID returns the old "post_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldPostID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostID: %w", err)
+	}
+	return oldValue.PostID, nil
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (m *ReportMutation) ClearPostID() {
+	m.post = nil
+	m.clearedFields[report.FieldPostID] = struct{}{}
+}
+
+// PostIDCleared returns if the "post_id" field was cleared in this mutation.
+func (m *ReportMutation) PostIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldPostID]
+	return ok
+}
+
+// ResetPostID resets all changes to the "post_id" field.
+func (m *ReportMutation) ResetPostID() {
+	m.post = nil
+	delete(m.clearedFields, report.FieldPostID)
+}
+
+// SetCommentID sets the "comment_id" field.
+func (m *ReportMutation) SetCommentID(u uuid.UUID) {
+	m.comment = &u
+}
+
+// CommentID returns the value of the "comment_id" field in the mutation.
+func (m *ReportMutation) CommentID() (r uuid.UUID, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentID returns the old "comment_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldCommentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentID: %w", err)
+	}
+	return oldValue.CommentID, nil
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (m *ReportMutation) ClearCommentID() {
+	m.comment = nil
+	m.clearedFields[report.FieldCommentID] = struct{}{}
+}
+
+// CommentIDCleared returns if the "comment_id" field was cleared in this mutation.
+func (m *ReportMutation) CommentIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldCommentID]
+	return ok
+}
+
+// ResetCommentID resets all changes to the "comment_id" field.
+func (m *ReportMutation) ResetCommentID() {
+	m.comment = nil
+	delete(m.clearedFields, report.FieldCommentID)
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (m *ReportMutation) SetTargetUserID(u uuid.UUID) {
+	m.target_user = &u
+}
+
+// TargetUserID returns the value of the "target_user_id" field in the mutation.
+func (m *ReportMutation) TargetUserID() (r uuid.UUID, exists bool) {
+	v := m.target_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetUserID returns the old "target_user_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTargetUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
+	}
+	return oldValue.TargetUserID, nil
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (m *ReportMutation) ClearTargetUserID() {
+	m.target_user = nil
+	m.clearedFields[report.FieldTargetUserID] = struct{}{}
+}
+
+// TargetUserIDCleared returns if the "target_user_id" field was cleared in this mutation.
+func (m *ReportMutation) TargetUserIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldTargetUserID]
+	return ok
+}
+
+// ResetTargetUserID resets all changes to the "target_user_id" field.
+func (m *ReportMutation) ResetTargetUserID() {
+	m.target_user = nil
+	delete(m.clearedFields, report.FieldTargetUserID)
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (m *ReportMutation) SetReporterID(u uuid.UUID) {
+	m.reporter = &u
+}
+
+// ReporterID returns the value of the "reporter_id" field in the mutation.
+func (m *ReportMutation) ReporterID() (r uuid.UUID, exists bool) {
+	v := m.reporter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReporterID returns the old "reporter_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldReporterID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReporterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReporterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReporterID: %w", err)
+	}
+	return oldValue.ReporterID, nil
+}
+
+// ResetReporterID resets all changes to the "reporter_id" field.
+func (m *ReportMutation) ResetReporterID() {
+	m.reporter = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ReportMutation) SetReason(er enum.ReportReason) {
+	m.reason = &er
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ReportMutation) Reason() (r enum.ReportReason, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldReason(ctx context.Context) (v enum.ReportReason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ReportMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetDetails sets the "details" field.
+func (m *ReportMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *ReportMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *ReportMutation) ResetDetails() {
+	m.details = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ReportMutation) SetStatus(es enum.ReportStatus) {
+	m.status = &es
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReportMutation) Status() (r enum.ReportStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldStatus(ctx context.Context) (v enum.ReportStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (m *ReportMutation) SetResolverID(u uuid.UUID) {
+	m.resolver = &u
+}
+
+// ResolverID returns the value of the "resolver_id" field in the mutation.
+func (m *ReportMutation) ResolverID() (r uuid.UUID, exists bool) {
+	v := m.resolver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolverID returns the old "resolver_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolverID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolverID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolverID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolverID: %w", err)
+	}
+	return oldValue.ResolverID, nil
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (m *ReportMutation) ClearResolverID() {
+	m.resolver = nil
+	m.clearedFields[report.FieldResolverID] = struct{}{}
+}
+
+// ResolverIDCleared returns if the "resolver_id" field was cleared in this mutation.
+func (m *ReportMutation) ResolverIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolverID]
+	return ok
+}
+
+// ResetResolverID resets all changes to the "resolver_id" field.
+func (m *ReportMutation) ResetResolverID() {
+	m.resolver = nil
+	delete(m.clearedFields, report.FieldResolverID)
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (m *ReportMutation) SetResolutionNote(s string) {
+	m.resolution_note = &s
+}
+
+// ResolutionNote returns the value of the "resolution_note" field in the mutation.
+func (m *ReportMutation) ResolutionNote() (r string, exists bool) {
+	v := m.resolution_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolutionNote returns the old "resolution_note" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolutionNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolutionNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolutionNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolutionNote: %w", err)
+	}
+	return oldValue.ResolutionNote, nil
+}
+
+// ResetResolutionNote resets all changes to the "resolution_note" field.
+func (m *ReportMutation) ResetResolutionNote() {
+	m.resolution_note = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ReportMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ReportMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ReportMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[report.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ReportMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ReportMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, report.FieldResolvedAt)
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *ReportMutation) ClearPost() {
+	m.clearedpost = true
+	m.clearedFields[report.FieldPostID] = struct{}{}
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *ReportMutation) PostCleared() bool {
+	return m.PostIDCleared() || m.clearedpost
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) PostIDs() (ids []uuid.UUID) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *ReportMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (m *ReportMutation) ClearComment() {
+	m.clearedcomment = true
+	m.clearedFields[report.FieldCommentID] = struct{}{}
+}
+
+// CommentCleared reports if the "comment" edge to the Comment entity was cleared.
+func (m *ReportMutation) CommentCleared() bool {
+	return m.CommentIDCleared() || m.clearedcomment
+}
+
+// CommentIDs returns the "comment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CommentID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) CommentIDs() (ids []uuid.UUID) {
+	if id := m.comment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetComment resets all changes to the "comment" edge.
+func (m *ReportMutation) ResetComment() {
+	m.comment = nil
+	m.clearedcomment = false
+}
+
+// ClearTargetUser clears the "target_user" edge to the User entity.
+func (m *ReportMutation) ClearTargetUser() {
+	m.clearedtarget_user = true
+	m.clearedFields[report.FieldTargetUserID] = struct{}{}
+}
+
+// TargetUserCleared reports if the "target_user" edge to the User entity was cleared.
+func (m *ReportMutation) TargetUserCleared() bool {
+	return m.TargetUserIDCleared() || m.clearedtarget_user
+}
+
+// TargetUserIDs returns the "target_user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetUserID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) TargetUserIDs() (ids []uuid.UUID) {
+	if id := m.target_user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTargetUser resets all changes to the "target_user" edge.
+func (m *ReportMutation) ResetTargetUser() {
+	m.target_user = nil
+	m.clearedtarget_user = false
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (m *ReportMutation) ClearReporter() {
+	m.clearedreporter = true
+	m.clearedFields[report.FieldReporterID] = struct{}{}
+}
+
+// ReporterCleared reports if the "reporter" edge to the User entity was cleared.
+func (m *ReportMutation) ReporterCleared() bool {
+	return m.clearedreporter
+}
+
+// ReporterIDs returns the "reporter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReporterID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) ReporterIDs() (ids []uuid.UUID) {
+	if id := m.reporter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReporter resets all changes to the "reporter" edge.
+func (m *ReportMutation) ResetReporter() {
+	m.reporter = nil
+	m.clearedreporter = false
+}
+
+// ClearResolver clears the "resolver" edge to the User entity.
+func (m *ReportMutation) ClearResolver() {
+	m.clearedresolver = true
+	m.clearedFields[report.FieldResolverID] = struct{}{}
+}
+
+// ResolverCleared reports if the "resolver" edge to the User entity was cleared.
+func (m *ReportMutation) ResolverCleared() bool {
+	return m.ResolverIDCleared() || m.clearedresolver
+}
+
+// ResolverIDs returns the "resolver" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResolverID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) ResolverIDs() (ids []uuid.UUID) {
+	if id := m.resolver; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResolver resets all changes to the "resolver" edge.
+func (m *ReportMutation) ResetResolver() {
+	m.resolver = nil
+	m.clearedresolver = false
+}
+
+// Where appends a list predicates to the ReportMutation builder.
+func (m *ReportMutation) Where(ps ...predicate.Report) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Report, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Report).
+func (m *ReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReportMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.target_type != nil {
+		fields = append(fields, report.FieldTargetType)
+	}
+	if m.post != nil {
+		fields = append(fields, report.FieldPostID)
+	}
+	if m.comment != nil {
+		fields = append(fields, report.FieldCommentID)
+	}
+	if m.target_user != nil {
+		fields = append(fields, report.FieldTargetUserID)
+	}
+	if m.reporter != nil {
+		fields = append(fields, report.FieldReporterID)
+	}
+	if m.reason != nil {
+		fields = append(fields, report.FieldReason)
+	}
+	if m.details != nil {
+		fields = append(fields, report.FieldDetails)
+	}
+	if m.status != nil {
+		fields = append(fields, report.FieldStatus)
+	}
+	if m.resolver != nil {
+		fields = append(fields, report.FieldResolverID)
+	}
+	if m.resolution_note != nil {
+		fields = append(fields, report.FieldResolutionNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, report.FieldCreatedAt)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, report.FieldResolvedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldTargetType:
+		return m.TargetType()
+	case report.FieldPostID:
+		return m.PostID()
+	case report.FieldCommentID:
+		return m.CommentID()
+	case report.FieldTargetUserID:
+		return m.TargetUserID()
+	case report.FieldReporterID:
+		return m.ReporterID()
+	case report.FieldReason:
+		return m.Reason()
+	case report.FieldDetails:
+		return m.Details()
+	case report.FieldStatus:
+		return m.Status()
+	case report.FieldResolverID:
+		return m.ResolverID()
+	case report.FieldResolutionNote:
+		return m.ResolutionNote()
+	case report.FieldCreatedAt:
+		return m.CreatedAt()
+	case report.FieldResolvedAt:
+		return m.ResolvedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case report.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case report.FieldPostID:
+		return m.OldPostID(ctx)
+	case report.FieldCommentID:
+		return m.OldCommentID(ctx)
+	case report.FieldTargetUserID:
+		return m.OldTargetUserID(ctx)
+	case report.FieldReporterID:
+		return m.OldReporterID(ctx)
+	case report.FieldReason:
+		return m.OldReason(ctx)
+	case report.FieldDetails:
+		return m.OldDetails(ctx)
+	case report.FieldStatus:
+		return m.OldStatus(ctx)
+	case report.FieldResolverID:
+		return m.OldResolverID(ctx)
+	case report.FieldResolutionNote:
+		return m.OldResolutionNote(ctx)
+	case report.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case report.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Report field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldTargetType:
+		v, ok := value.(enum.ReportTargetType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case report.FieldPostID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostID(v)
+		return nil
+	case report.FieldCommentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentID(v)
+		return nil
+	case report.FieldTargetUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetUserID(v)
+		return nil
+	case report.FieldReporterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReporterID(v)
+		return nil
+	case report.FieldReason:
+		v, ok := value.(enum.ReportReason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case report.FieldDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case report.FieldStatus:
+		v, ok := value.(enum.ReportStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case report.FieldResolverID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolverID(v)
+		return nil
+	case report.FieldResolutionNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolutionNote(v)
+		return nil
+	case report.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case report.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReportMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReportMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Report numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(report.FieldPostID) {
+		fields = append(fields, report.FieldPostID)
+	}
+	if m.FieldCleared(report.FieldCommentID) {
+		fields = append(fields, report.FieldCommentID)
+	}
+	if m.FieldCleared(report.FieldTargetUserID) {
+		fields = append(fields, report.FieldTargetUserID)
+	}
+	if m.FieldCleared(report.FieldResolverID) {
+		fields = append(fields, report.FieldResolverID)
+	}
+	if m.FieldCleared(report.FieldResolvedAt) {
+		fields = append(fields, report.FieldResolvedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReportMutation) ClearField(name string) error {
+	switch name {
+	case report.FieldPostID:
+		m.ClearPostID()
+		return nil
+	case report.FieldCommentID:
+		m.ClearCommentID()
+		return nil
+	case report.FieldTargetUserID:
+		m.ClearTargetUserID()
+		return nil
+	case report.FieldResolverID:
+		m.ClearResolverID()
+		return nil
+	case report.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReportMutation) ResetField(name string) error {
+	switch name {
+	case report.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case report.FieldPostID:
+		m.ResetPostID()
+		return nil
+	case report.FieldCommentID:
+		m.ResetCommentID()
+		return nil
+	case report.FieldTargetUserID:
+		m.ResetTargetUserID()
+		return nil
+	case report.FieldReporterID:
+		m.ResetReporterID()
+		return nil
+	case report.FieldReason:
+		m.ResetReason()
+		return nil
+	case report.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case report.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case report.FieldResolverID:
+		m.ResetResolverID()
+		return nil
+	case report.FieldResolutionNote:
+		m.ResetResolutionNote()
+		return nil
+	case report.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case report.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.post != nil {
+		edges = append(edges, report.EdgePost)
+	}
+	if m.comment != nil {
+		edges = append(edges, report.EdgeComment)
+	}
+	if m.target_user != nil {
+		edges = append(edges, report.EdgeTargetUser)
+	}
+	if m.reporter != nil {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.resolver != nil {
+		edges = append(edges, report.EdgeResolver)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case report.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeComment:
+		if id := m.comment; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeTargetUser:
+		if id := m.target_user; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeReporter:
+		if id := m.reporter; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeResolver:
+		if id := m.resolver; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedpost {
+		edges = append(edges, report.EdgePost)
+	}
+	if m.clearedcomment {
+		edges = append(edges, report.EdgeComment)
+	}
+	if m.clearedtarget_user {
+		edges = append(edges, report.EdgeTargetUser)
+	}
+	if m.clearedreporter {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.clearedresolver {
+		edges = append(edges, report.EdgeResolver)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case report.EdgePost:
+		return m.clearedpost
+	case report.EdgeComment:
+		return m.clearedcomment
+	case report.EdgeTargetUser:
+		return m.clearedtarget_user
+	case report.EdgeReporter:
+		return m.clearedreporter
+	case report.EdgeResolver:
+		return m.clearedresolver
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReportMutation) ClearEdge(name string) error {
+	switch name {
+	case report.EdgePost:
+		m.ClearPost()
+		return nil
+	case report.EdgeComment:
+		m.ClearComment()
+		return nil
+	case report.EdgeTargetUser:
+		m.ClearTargetUser()
+		return nil
+	case report.EdgeReporter:
+		m.ClearReporter()
+		return nil
+	case report.EdgeResolver:
+		m.ClearResolver()
+		return nil
+	}
+	return fmt.Errorf("unknown Report unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReportMutation) ResetEdge(name string) error {
+	switch name {
+	case report.EdgePost:
+		m.ResetPost()
+		return nil
+	case report.EdgeComment:
+		m.ResetComment()
+		return nil
+	case report.EdgeTargetUser:
+		m.ResetTargetUser()
+		return nil
+	case report.EdgeReporter:
+		m.ResetReporter()
+		return nil
+	case report.EdgeResolver:
+		m.ResetResolver()
+		return nil
+	}
+	return fmt.Errorf("unknown Report edge %s", name)
+}
+
+// SuspensionMutation represents an operation that mutates the Suspension nodes in the graph.
+type SuspensionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	reason           *string
+	starts_at        *time.Time
+	ends_at          *time.Time
+	lifted_at        *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	issued_by        *uuid.UUID
+	clearedissued_by bool
+	done             bool
+	oldValue         func(context.Context) (*Suspension, error)
+	predicates       []predicate.Suspension
+}
+
+var _ ent.Mutation = (*SuspensionMutation)(nil)
+
+// suspensionOption allows management of the mutation configuration using functional options.
+type suspensionOption func(*SuspensionMutation)
+
+// newSuspensionMutation creates new mutation for the Suspension entity.
+func newSuspensionMutation(c config, op Op, opts ...suspensionOption) *SuspensionMutation {
+	m := &SuspensionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSuspension,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSuspensionID sets the ID field of the mutation.
+func withSuspensionID(id uuid.UUID) suspensionOption {
+	return func(m *SuspensionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Suspension
+		)
+		m.oldValue = func(ctx context.Context) (*Suspension, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Suspension.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSuspension sets the old Suspension of the mutation.
+func withSuspension(node *Suspension) suspensionOption {
+	return func(m *SuspensionMutation) {
+		m.oldValue = func(context.Context) (*Suspension, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SuspensionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SuspensionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Suspension entities.
+func (m *SuspensionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SuspensionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SuspensionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Suspension.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SuspensionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SuspensionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SuspensionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *SuspensionMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *SuspensionMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *SuspensionMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *SuspensionMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *SuspensionMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *SuspensionMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *SuspensionMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *SuspensionMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *SuspensionMutation) ResetEndsAt() {
+	m.ends_at = nil
+}
+
+// SetIssuedByID sets the "issued_by_id" field.
+func (m *SuspensionMutation) SetIssuedByID(u uuid.UUID) {
+	m.issued_by = &u
+}
+
+// IssuedByID returns the value of the "issued_by_id" field in the mutation.
+func (m *SuspensionMutation) IssuedByID() (r uuid.UUID, exists bool) {
+	v := m.issued_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuedByID returns the old "issued_by_id" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldIssuedByID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuedByID: %w", err)
+	}
+	return oldValue.IssuedByID, nil
+}
+
+// ClearIssuedByID clears the value of the "issued_by_id" field.
+func (m *SuspensionMutation) ClearIssuedByID() {
+	m.issued_by = nil
+	m.clearedFields[suspension.FieldIssuedByID] = struct{}{}
+}
+
+// IssuedByIDCleared returns if the "issued_by_id" field was cleared in this mutation.
+func (m *SuspensionMutation) IssuedByIDCleared() bool {
+	_, ok := m.clearedFields[suspension.FieldIssuedByID]
+	return ok
+}
+
+// ResetIssuedByID resets all changes to the "issued_by_id" field.
+func (m *SuspensionMutation) ResetIssuedByID() {
+	m.issued_by = nil
+	delete(m.clearedFields, suspension.FieldIssuedByID)
+}
+
+// SetLiftedAt sets the "lifted_at" field.
+func (m *SuspensionMutation) SetLiftedAt(t time.Time) {
+	m.lifted_at = &t
+}
+
+// LiftedAt returns the value of the "lifted_at" field in the mutation.
+func (m *SuspensionMutation) LiftedAt() (r time.Time, exists bool) {
+	v := m.lifted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLiftedAt returns the old "lifted_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldLiftedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLiftedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLiftedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLiftedAt: %w", err)
+	}
+	return oldValue.LiftedAt, nil
+}
+
+// ClearLiftedAt clears the value of the "lifted_at" field.
+func (m *SuspensionMutation) ClearLiftedAt() {
+	m.lifted_at = nil
+	m.clearedFields[suspension.FieldLiftedAt] = struct{}{}
+}
+
+// LiftedAtCleared returns if the "lifted_at" field was cleared in this mutation.
+func (m *SuspensionMutation) LiftedAtCleared() bool {
+	_, ok := m.clearedFields[suspension.FieldLiftedAt]
+	return ok
+}
+
+// ResetLiftedAt resets all changes to the "lifted_at" field.
+func (m *SuspensionMutation) ResetLiftedAt() {
+	m.lifted_at = nil
+	delete(m.clearedFields, suspension.FieldLiftedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SuspensionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SuspensionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Suspension entity.
+// If the Suspension object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SuspensionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SuspensionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SuspensionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[suspension.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SuspensionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SuspensionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SuspensionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearIssuedBy clears the "issued_by" edge to the User entity.
+func (m *SuspensionMutation) ClearIssuedBy() {
+	m.clearedissued_by = true
+	m.clearedFields[suspension.FieldIssuedByID] = struct{}{}
+}
+
+// IssuedByCleared reports if the "issued_by" edge to the User entity was cleared.
+func (m *SuspensionMutation) IssuedByCleared() bool {
+	return m.IssuedByIDCleared() || m.clearedissued_by
+}
+
+// IssuedByIDs returns the "issued_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IssuedByID instead. It exists only for internal usage by the builders.
+func (m *SuspensionMutation) IssuedByIDs() (ids []uuid.UUID) {
+	if id := m.issued_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIssuedBy resets all changes to the "issued_by" edge.
+func (m *SuspensionMutation) ResetIssuedBy() {
+	m.issued_by = nil
+	m.clearedissued_by = false
+}
+
+// Where appends a list predicates to the SuspensionMutation builder.
+func (m *SuspensionMutation) Where(ps ...predicate.Suspension) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SuspensionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SuspensionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Suspension, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SuspensionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SuspensionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Suspension).
+func (m *SuspensionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SuspensionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user != nil {
+		fields = append(fields, suspension.FieldUserID)
+	}
+	if m.reason != nil {
+		fields = append(fields, suspension.FieldReason)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, suspension.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, suspension.FieldEndsAt)
+	}
+	if m.issued_by != nil {
+		fields = append(fields, suspension.FieldIssuedByID)
+	}
+	if m.lifted_at != nil {
+		fields = append(fields, suspension.FieldLiftedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, suspension.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SuspensionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case suspension.FieldUserID:
+		return m.UserID()
+	case suspension.FieldReason:
+		return m.Reason()
+	case suspension.FieldStartsAt:
+		return m.StartsAt()
+	case suspension.FieldEndsAt:
+		return m.EndsAt()
+	case suspension.FieldIssuedByID:
+		return m.IssuedByID()
+	case suspension.FieldLiftedAt:
+		return m.LiftedAt()
+	case suspension.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SuspensionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case suspension.FieldUserID:
+		return m.OldUserID(ctx)
+	case suspension.FieldReason:
+		return m.OldReason(ctx)
+	case suspension.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case suspension.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case suspension.FieldIssuedByID:
+		return m.OldIssuedByID(ctx)
+	case suspension.FieldLiftedAt:
+		return m.OldLiftedAt(ctx)
+	case suspension.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Suspension field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SuspensionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case suspension.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case suspension.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case suspension.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case suspension.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case suspension.FieldIssuedByID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedByID(v)
+		return nil
+	case suspension.FieldLiftedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLiftedAt(v)
+		return nil
+	case suspension.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SuspensionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SuspensionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SuspensionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Suspension numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SuspensionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(suspension.FieldIssuedByID) {
+		fields = append(fields, suspension.FieldIssuedByID)
+	}
+	if m.FieldCleared(suspension.FieldLiftedAt) {
+		fields = append(fields, suspension.FieldLiftedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SuspensionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SuspensionMutation) ClearField(name string) error {
+	switch name {
+	case suspension.FieldIssuedByID:
+		m.ClearIssuedByID()
+		return nil
+	case suspension.FieldLiftedAt:
+		m.ClearLiftedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SuspensionMutation) ResetField(name string) error {
+	switch name {
+	case suspension.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case suspension.FieldReason:
+		m.ResetReason()
+		return nil
+	case suspension.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case suspension.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case suspension.FieldIssuedByID:
+		m.ResetIssuedByID()
+		return nil
+	case suspension.FieldLiftedAt:
+		m.ResetLiftedAt()
+		return nil
+	case suspension.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SuspensionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, suspension.EdgeUser)
+	}
+	if m.issued_by != nil {
+		edges = append(edges, suspension.EdgeIssuedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SuspensionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case suspension.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case suspension.EdgeIssuedBy:
+		if id := m.issued_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SuspensionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SuspensionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SuspensionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, suspension.EdgeUser)
+	}
+	if m.clearedissued_by {
+		edges = append(edges, suspension.EdgeIssuedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SuspensionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case suspension.EdgeUser:
+		return m.cleareduser
+	case suspension.EdgeIssuedBy:
+		return m.clearedissued_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SuspensionMutation) ClearEdge(name string) error {
+	switch name {
+	case suspension.EdgeUser:
+		m.ClearUser()
+		return nil
+	case suspension.EdgeIssuedBy:
+		m.ClearIssuedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SuspensionMutation) ResetEdge(name string) error {
+	switch name {
+	case suspension.EdgeUser:
+		m.ResetUser()
+		return nil
+	case suspension.EdgeIssuedBy:
+		m.ResetIssuedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Suspension edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	posts         map[uuid.UUID]struct{}
+	removedposts  map[uuid.UUID]struct{}
+	clearedposts  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id uuid.UUID) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *TagMutation) AddPostIDs(ids ...uuid.UUID) {
+	if m.posts == nil {
+		m.posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *TagMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *TagMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *TagMutation) RemovePostIDs(ids ...uuid.UUID) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *TagMutation) RemovedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *TagMutation) PostsIDs() (ids []uuid.UUID) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *TagMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.posts != nil {
+		edges = append(edges, tag.EdgePosts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedposts != nil {
+		edges = append(edges, tag.EdgePosts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedposts {
+		edges = append(edges, tag.EdgePosts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgePosts:
+		return m.clearedposts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgePosts:
+		m.ResetPosts()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TimelineCacheMutation represents an operation that mutates the TimelineCache nodes in the graph.
+type TimelineCacheMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	user_id        *uuid.UUID
+	post_ids       *[]uuid.UUID
+	appendpost_ids []uuid.UUID
+	expires_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*TimelineCache, error)
+	predicates     []predicate.TimelineCache
+}
+
+var _ ent.Mutation = (*TimelineCacheMutation)(nil)
+
+// timelinecacheOption allows management of the mutation configuration using functional options.
+type timelinecacheOption func(*TimelineCacheMutation)
+
+// newTimelineCacheMutation creates new mutation for the TimelineCache entity.
+func newTimelineCacheMutation(c config, op Op, opts ...timelinecacheOption) *TimelineCacheMutation {
+	m := &TimelineCacheMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTimelineCache,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTimelineCacheID sets the ID field of the mutation.
+func withTimelineCacheID(id uuid.UUID) timelinecacheOption {
+	return func(m *TimelineCacheMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TimelineCache
+		)
+		m.oldValue = func(ctx context.Context) (*TimelineCache, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TimelineCache.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTimelineCache sets the old TimelineCache of the mutation.
+func withTimelineCache(node *TimelineCache) timelinecacheOption {
+	return func(m *TimelineCacheMutation) {
+		m.oldValue = func(context.Context) (*TimelineCache, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TimelineCacheMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TimelineCacheMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TimelineCache entities.
+func (m *TimelineCacheMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TimelineCacheMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TimelineCacheMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TimelineCache.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TimelineCacheMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TimelineCacheMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the TimelineCache entity.
+// If the TimelineCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineCacheMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TimelineCacheMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetPostIds sets the "post_ids" field.
+func (m *TimelineCacheMutation) SetPostIds(u []uuid.UUID) {
+	m.post_ids = &u
+	m.appendpost_ids = nil
+}
+
+// PostIds returns the value of the "post_ids" field in the mutation.
+func (m *TimelineCacheMutation) PostIds() (r []uuid.UUID, exists bool) {
+	v := m.post_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostIds returns the old "post_ids" field's value of the TimelineCache entity.
+// If the TimelineCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineCacheMutation) OldPostIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostIds: %w", err)
+	}
+	return oldValue.PostIds, nil
+}
+
+// AppendPostIds adds u to the "post_ids" field.
+func (m *TimelineCacheMutation) AppendPostIds(u []uuid.UUID) {
+	m.appendpost_ids = append(m.appendpost_ids, u...)
+}
+
+// AppendedPostIds returns the list of values that were appended to the "post_ids" field in this mutation.
+func (m *TimelineCacheMutation) AppendedPostIds() ([]uuid.UUID, bool) {
+	if len(m.appendpost_ids) == 0 {
+		return nil, false
+	}
+	return m.appendpost_ids, true
+}
+
+// ResetPostIds resets all changes to the "post_ids" field.
+func (m *TimelineCacheMutation) ResetPostIds() {
+	m.post_ids = nil
+	m.appendpost_ids = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *TimelineCacheMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TimelineCacheMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the TimelineCache entity.
+// If the TimelineCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineCacheMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TimelineCacheMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TimelineCacheMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TimelineCacheMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TimelineCache entity.
+// If the TimelineCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineCacheMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TimelineCacheMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the TimelineCacheMutation builder.
+func (m *TimelineCacheMutation) Where(ps ...predicate.TimelineCache) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TimelineCacheMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TimelineCacheMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TimelineCache, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TimelineCacheMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TimelineCacheMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TimelineCache).
+func (m *TimelineCacheMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TimelineCacheMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, timelinecache.FieldUserID)
+	}
+	if m.post_ids != nil {
+		fields = append(fields, timelinecache.FieldPostIds)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, timelinecache.FieldExpiresAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, timelinecache.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TimelineCacheMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case timelinecache.FieldUserID:
+		return m.UserID()
+	case timelinecache.FieldPostIds:
+		return m.PostIds()
+	case timelinecache.FieldExpiresAt:
+		return m.ExpiresAt()
+	case timelinecache.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TimelineCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case timelinecache.FieldUserID:
+		return m.OldUserID(ctx)
+	case timelinecache.FieldPostIds:
+		return m.OldPostIds(ctx)
+	case timelinecache.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case timelinecache.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TimelineCache field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimelineCacheMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case timelinecache.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case timelinecache.FieldPostIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostIds(v)
+		return nil
+	case timelinecache.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case timelinecache.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TimelineCache field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TimelineCacheMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TimelineCacheMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimelineCacheMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TimelineCache numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TimelineCacheMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TimelineCacheMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TimelineCacheMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TimelineCache nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TimelineCacheMutation) ResetField(name string) error {
+	switch name {
+	case timelinecache.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case timelinecache.FieldPostIds:
+		m.ResetPostIds()
+		return nil
+	case timelinecache.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case timelinecache.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TimelineCache field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TimelineCacheMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TimelineCacheMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TimelineCacheMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TimelineCacheMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TimelineCacheMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TimelineCacheMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TimelineCacheMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TimelineCache unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TimelineCacheMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TimelineCache edge %s", name)
+}
+
+// TokenRevocationMutation represents an operation that mutates the TokenRevocation nodes in the graph.
+type TokenRevocationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	revoked_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TokenRevocation, error)
+	predicates    []predicate.TokenRevocation
+}
+
+var _ ent.Mutation = (*TokenRevocationMutation)(nil)
+
+// tokenrevocationOption allows management of the mutation configuration using functional options.
+type tokenrevocationOption func(*TokenRevocationMutation)
+
+// newTokenRevocationMutation creates new mutation for the TokenRevocation entity.
+func newTokenRevocationMutation(c config, op Op, opts ...tokenrevocationOption) *TokenRevocationMutation {
+	m := &TokenRevocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenRevocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenRevocationID sets the ID field of the mutation.
+func withTokenRevocationID(id string) tokenrevocationOption {
+	return func(m *TokenRevocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenRevocation
+		)
+		m.oldValue = func(ctx context.Context) (*TokenRevocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenRevocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTokenRevocation sets the old TokenRevocation of the mutation.
+func withTokenRevocation(node *TokenRevocation) tokenrevocationOption {
+	return func(m *TokenRevocationMutation) {
+		m.oldValue = func(context.Context) (*TokenRevocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenRevocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenRevocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenRevocation entities.
+func (m *TokenRevocationMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenRevocationMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenRevocationMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenRevocation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *TokenRevocationMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *TokenRevocationMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldRevokedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *TokenRevocationMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *TokenRevocationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TokenRevocationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TokenRevocationMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the TokenRevocationMutation builder.
+func (m *TokenRevocationMutation) Where(ps ...predicate.TokenRevocation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenRevocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenRevocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenRevocation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TokenRevocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenRevocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TokenRevocation).
+func (m *TokenRevocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenRevocationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.revoked_at != nil {
+		fields = append(fields, tokenrevocation.FieldRevokedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, tokenrevocation.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenRevocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokenrevocation.FieldRevokedAt:
+		return m.RevokedAt()
+	case tokenrevocation.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenRevocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokenrevocation.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case tokenrevocation.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TokenRevocation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenRevocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokenrevocation.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case tokenrevocation.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TokenRevocation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenRevocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenRevocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenRevocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TokenRevocation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenRevocationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenRevocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenRevocationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TokenRevocation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenRevocationMutation) ResetField(name string) error {
+	switch name {
+	case tokenrevocation.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case tokenrevocation.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenRevocation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenRevocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenRevocationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenRevocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenRevocationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenRevocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenRevocationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenRevocationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TokenRevocation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenRevocationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TokenRevocation edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *uuid.UUID
+	index                           *uint32
+	addindex                        *int32
+	email                           *string
+	auth_subject                    *string
+	name                            *string
+	handle                          *string
+	bio                             *string
+	streak_count                    *uint32
+	addstreak_count                 *int32
+	role                            *enum.Role
+	icon_image_key                  *string
+	is_private                      *bool
+	created_at                      *time.Time
+	follower_count                  *int
+	addfollower_count               *int
+	following_count                 *int
+	addfollowing_count              *int
+	post_count                      *int
+	addpost_count                   *int
+	clearedFields                   map[string]struct{}
+	posts                           map[uuid.UUID]struct{}
+	removedposts                    map[uuid.UUID]struct{}
+	clearedposts                    bool
+	comments                        map[uuid.UUID]struct{}
+	removedcomments                 map[uuid.UUID]struct{}
+	clearedcomments                 bool
+	likes                           map[uuid.UUID]struct{}
+	removedlikes                    map[uuid.UUID]struct{}
+	clearedlikes                    bool
+	pets                            map[uuid.UUID]struct{}
+	removedpets                     map[uuid.UUID]struct{}
+	clearedpets                     bool
+	following                       map[uuid.UUID]struct{}
+	removedfollowing                map[uuid.UUID]struct{}
+	clearedfollowing                bool
+	followers                       map[uuid.UUID]struct{}
+	removedfollowers                map[uuid.UUID]struct{}
+	clearedfollowers                bool
+	blocking                        map[uuid.UUID]struct{}
+	removedblocking                 map[uuid.UUID]struct{}
+	clearedblocking                 bool
+	blocked_by                      map[uuid.UUID]struct{}
+	removedblocked_by               map[uuid.UUID]struct{}
+	clearedblocked_by               bool
+	daily_tasks                     map[uuid.UUID]struct{}
+	removeddaily_tasks              map[uuid.UUID]struct{}
+	cleareddaily_tasks              bool
+	device_tokens                   map[uuid.UUID]struct{}
+	removeddevice_tokens            map[uuid.UUID]struct{}
+	cleareddevice_tokens            bool
+	reports_filed                   map[uuid.UUID]struct{}
+	removedreports_filed            map[uuid.UUID]struct{}
+	clearedreports_filed            bool
+	reports_received                map[uuid.UUID]struct{}
+	removedreports_received         map[uuid.UUID]struct{}
+	clearedreports_received         bool
+	reports_resolved                map[uuid.UUID]struct{}
+	removedreports_resolved         map[uuid.UUID]struct{}
+	clearedreports_resolved         bool
+	suspensions                     map[uuid.UUID]struct{}
+	removedsuspensions              map[uuid.UUID]struct{}
+	clearedsuspensions              bool
+	suspensions_issued              map[uuid.UUID]struct{}
+	removedsuspensions_issued       map[uuid.UUID]struct{}
+	clearedsuspensions_issued       bool
+	muting                          map[uuid.UUID]struct{}
+	removedmuting                   map[uuid.UUID]struct{}
+	clearedmuting                   bool
+	muted_by                        map[uuid.UUID]struct{}
+	removedmuted_by                 map[uuid.UUID]struct{}
+	clearedmuted_by                 bool
+	muted_keywords                  map[uuid.UUID]struct{}
+	removedmuted_keywords           map[uuid.UUID]struct{}
+	clearedmuted_keywords           bool
+	follow_requests_sent            map[uuid.UUID]struct{}
+	removedfollow_requests_sent     map[uuid.UUID]struct{}
+	clearedfollow_requests_sent     bool
+	follow_requests_received        map[uuid.UUID]struct{}
+	removedfollow_requests_received map[uuid.UUID]struct{}
+	clearedfollow_requests_received bool
+	mentioned_in_posts              map[uuid.UUID]struct{}
+	removedmentioned_in_posts       map[uuid.UUID]struct{}
+	clearedmentioned_in_posts       bool
+	mentioned_in_comments           map[uuid.UUID]struct{}
+	removedmentioned_in_comments    map[uuid.UUID]struct{}
+	clearedmentioned_in_comments    bool
+	notifications                   map[uuid.UUID]struct{}
+	removednotifications            map[uuid.UUID]struct{}
+	clearednotifications            bool
+	notifications_sent              map[uuid.UUID]struct{}
+	removednotifications_sent       map[uuid.UUID]struct{}
+	clearednotifications_sent       bool
+	comment_likes                   map[uuid.UUID]struct{}
+	removedcomment_likes            map[uuid.UUID]struct{}
+	clearedcomment_likes            bool
+	done                            bool
+	oldValue                        func(context.Context) (*User, error)
+	predicates                      []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIndex sets the "index" field.
+func (m *UserMutation) SetIndex(u uint32) {
+	m.index = &u
+	m.addindex = nil
+}
+
+// Index returns the value of the "index" field in the mutation.
+func (m *UserMutation) Index() (r uint32, exists bool) {
+	v := m.index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndex returns the old "index" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIndex(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+	}
+	return oldValue.Index, nil
+}
+
+// AddIndex adds u to the "index" field.
+func (m *UserMutation) AddIndex(u int32) {
+	if m.addindex != nil {
+		*m.addindex += u
+	} else {
+		m.addindex = &u
+	}
+}
+
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *UserMutation) AddedIndex() (r int32, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIndex clears the value of the "index" field.
+func (m *UserMutation) ClearIndex() {
+	m.index = nil
+	m.addindex = nil
+	m.clearedFields[user.FieldIndex] = struct{}{}
+}
+
+// IndexCleared returns if the "index" field was cleared in this mutation.
+func (m *UserMutation) IndexCleared() bool {
+	_, ok := m.clearedFields[user.FieldIndex]
+	return ok
+}
+
+// ResetIndex resets all changes to the "index" field.
+func (m *UserMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+	delete(m.clearedFields, user.FieldIndex)
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetAuthSubject sets the "auth_subject" field.
+func (m *UserMutation) SetAuthSubject(s string) {
+	m.auth_subject = &s
+}
+
+// AuthSubject returns the value of the "auth_subject" field in the mutation.
+func (m *UserMutation) AuthSubject() (r string, exists bool) {
+	v := m.auth_subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthSubject returns the old "auth_subject" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAuthSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthSubject: %w", err)
+	}
+	return oldValue.AuthSubject, nil
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (m *UserMutation) ClearAuthSubject() {
+	m.auth_subject = nil
+	m.clearedFields[user.FieldAuthSubject] = struct{}{}
+}
+
+// AuthSubjectCleared returns if the "auth_subject" field was cleared in this mutation.
+func (m *UserMutation) AuthSubjectCleared() bool {
+	_, ok := m.clearedFields[user.FieldAuthSubject]
+	return ok
+}
+
+// ResetAuthSubject resets all changes to the "auth_subject" field.
+func (m *UserMutation) ResetAuthSubject() {
+	m.auth_subject = nil
+	delete(m.clearedFields, user.FieldAuthSubject)
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHandle sets the "handle" field.
+func (m *UserMutation) SetHandle(s string) {
+	m.handle = &s
+}
+
+// Handle returns the value of the "handle" field in the mutation.
+func (m *UserMutation) Handle() (r string, exists bool) {
+	v := m.handle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHandle returns the old "handle" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHandle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHandle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHandle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandle: %w", err)
+	}
+	return oldValue.Handle, nil
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (m *UserMutation) ClearHandle() {
+	m.handle = nil
+	m.clearedFields[user.FieldHandle] = struct{}{}
+}
+
+// HandleCleared returns if the "handle" field was cleared in this mutation.
+func (m *UserMutation) HandleCleared() bool {
+	_, ok := m.clearedFields[user.FieldHandle]
+	return ok
+}
+
+// ResetHandle resets all changes to the "handle" field.
+func (m *UserMutation) ResetHandle() {
+	m.handle = nil
+	delete(m.clearedFields, user.FieldHandle)
+}
+
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBio(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	}
+	return oldValue.Bio, nil
+}
+
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+}
+
+// SetStreakCount sets the "streak_count" field.
+func (m *UserMutation) SetStreakCount(u uint32) {
+	m.streak_count = &u
+	m.addstreak_count = nil
+}
+
+// StreakCount returns the value of the "streak_count" field in the mutation.
+func (m *UserMutation) StreakCount() (r uint32, exists bool) {
+	v := m.streak_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStreakCount returns the old "streak_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStreakCount(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStreakCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStreakCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStreakCount: %w", err)
+	}
+	return oldValue.StreakCount, nil
+}
+
+// AddStreakCount adds u to the "streak_count" field.
+func (m *UserMutation) AddStreakCount(u int32) {
+	if m.addstreak_count != nil {
+		*m.addstreak_count += u
+	} else {
+		m.addstreak_count = &u
+	}
+}
+
+// AddedStreakCount returns the value that was added to the "streak_count" field in this mutation.
+func (m *UserMutation) AddedStreakCount() (r int32, exists bool) {
+	v := m.addstreak_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStreakCount resets all changes to the "streak_count" field.
+func (m *UserMutation) ResetStreakCount() {
+	m.streak_count = nil
+	m.addstreak_count = nil
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(e enum.Role) {
+	m.role = &e
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r enum.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v enum.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetIconImageKey sets the "icon_image_key" field.
+func (m *UserMutation) SetIconImageKey(s string) {
+	m.icon_image_key = &s
+}
+
+// IconImageKey returns the value of the "icon_image_key" field in the mutation.
+func (m *UserMutation) IconImageKey() (r string, exists bool) {
+	v := m.icon_image_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIconImageKey returns the old "icon_image_key" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIconImageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIconImageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIconImageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIconImageKey: %w", err)
+	}
+	return oldValue.IconImageKey, nil
+}
+
+// ClearIconImageKey clears the value of the "icon_image_key" field.
+func (m *UserMutation) ClearIconImageKey() {
+	m.icon_image_key = nil
+	m.clearedFields[user.FieldIconImageKey] = struct{}{}
+}
+
+// IconImageKeyCleared returns if the "icon_image_key" field was cleared in this mutation.
+func (m *UserMutation) IconImageKeyCleared() bool {
+	_, ok := m.clearedFields[user.FieldIconImageKey]
+	return ok
+}
+
+// ResetIconImageKey resets all changes to the "icon_image_key" field.
+func (m *UserMutation) ResetIconImageKey() {
+	m.icon_image_key = nil
+	delete(m.clearedFields, user.FieldIconImageKey)
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (m *UserMutation) SetIsPrivate(b bool) {
+	m.is_private = &b
+}
+
+// IsPrivate returns the value of the "is_private" field in the mutation.
+func (m *UserMutation) IsPrivate() (r bool, exists bool) {
+	v := m.is_private
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPrivate returns the old "is_private" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsPrivate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPrivate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPrivate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPrivate: %w", err)
+	}
+	return oldValue.IsPrivate, nil
+}
+
+// ResetIsPrivate resets all changes to the "is_private" field.
+func (m *UserMutation) ResetIsPrivate() {
+	m.is_private = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetFollowerCount sets the "follower_count" field.
+func (m *UserMutation) SetFollowerCount(i int) {
+	m.follower_count = &i
+	m.addfollower_count = nil
+}
+
+// FollowerCount returns the value of the "follower_count" field in the mutation.
+func (m *UserMutation) FollowerCount() (r int, exists bool) {
+	v := m.follower_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFollowerCount returns the old "follower_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFollowerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFollowerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFollowerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFollowerCount: %w", err)
+	}
+	return oldValue.FollowerCount, nil
+}
+
+// AddFollowerCount adds i to the "follower_count" field.
+func (m *UserMutation) AddFollowerCount(i int) {
+	if m.addfollower_count != nil {
+		*m.addfollower_count += i
+	} else {
+		m.addfollower_count = &i
+	}
+}
+
+// AddedFollowerCount returns the value that was added to the "follower_count" field in this mutation.
+func (m *UserMutation) AddedFollowerCount() (r int, exists bool) {
+	v := m.addfollower_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFollowerCount resets all changes to the "follower_count" field.
+func (m *UserMutation) ResetFollowerCount() {
+	m.follower_count = nil
+	m.addfollower_count = nil
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (m *UserMutation) SetFollowingCount(i int) {
+	m.following_count = &i
+	m.addfollowing_count = nil
+}
+
+// FollowingCount returns the value of the "following_count" field in the mutation.
+func (m *UserMutation) FollowingCount() (r int, exists bool) {
+	v := m.following_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFollowingCount returns the old "following_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFollowingCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFollowingCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFollowingCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFollowingCount: %w", err)
+	}
+	return oldValue.FollowingCount, nil
+}
+
+// AddFollowingCount adds i to the "following_count" field.
+func (m *UserMutation) AddFollowingCount(i int) {
+	if m.addfollowing_count != nil {
+		*m.addfollowing_count += i
+	} else {
+		m.addfollowing_count = &i
+	}
+}
+
+// AddedFollowingCount returns the value that was added to the "following_count" field in this mutation.
+func (m *UserMutation) AddedFollowingCount() (r int, exists bool) {
+	v := m.addfollowing_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFollowingCount resets all changes to the "following_count" field.
+func (m *UserMutation) ResetFollowingCount() {
+	m.following_count = nil
+	m.addfollowing_count = nil
+}
+
+// SetPostCount sets the "post_count" field.
+func (m *UserMutation) SetPostCount(i int) {
+	m.post_count = &i
+	m.addpost_count = nil
+}
+
+// PostCount returns the value of the "post_count" field in the mutation.
+func (m *UserMutation) PostCount() (r int, exists bool) {
+	v := m.post_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostCount returns the old "post_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPostCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostCount: %w", err)
+	}
+	return oldValue.PostCount, nil
+}
+
+// AddPostCount adds i to the "post_count" field.
+func (m *UserMutation) AddPostCount(i int) {
+	if m.addpost_count != nil {
+		*m.addpost_count += i
+	} else {
+		m.addpost_count = &i
+	}
+}
+
+// AddedPostCount returns the value that was added to the "post_count" field in this mutation.
+func (m *UserMutation) AddedPostCount() (r int, exists bool) {
+	v := m.addpost_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPostCount resets all changes to the "post_count" field.
+func (m *UserMutation) ResetPostCount() {
+	m.post_count = nil
+	m.addpost_count = nil
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *UserMutation) AddPostIDs(ids ...uuid.UUID) {
+	if m.posts == nil {
+		m.posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *UserMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *UserMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemovePostIDs(ids ...uuid.UUID) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *UserMutation) RemovedPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *UserMutation) PostsIDs() (ids []uuid.UUID) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *UserMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *UserMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *UserMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *UserMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *UserMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *UserMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddLikeIDs adds the "likes" edge to the Like entity by ids.
+func (m *UserMutation) AddLikeIDs(ids ...uuid.UUID) {
+	if m.likes == nil {
+		m.likes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.likes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikes clears the "likes" edge to the Like entity.
+func (m *UserMutation) ClearLikes() {
+	m.clearedlikes = true
+}
+
+// LikesCleared reports if the "likes" edge to the Like entity was cleared.
+func (m *UserMutation) LikesCleared() bool {
+	return m.clearedlikes
+}
+
+// RemoveLikeIDs removes the "likes" edge to the Like entity by IDs.
+func (m *UserMutation) RemoveLikeIDs(ids ...uuid.UUID) {
+	if m.removedlikes == nil {
+		m.removedlikes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.likes, ids[i])
+		m.removedlikes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikes returns the removed IDs of the "likes" edge to the Like entity.
+func (m *UserMutation) RemovedLikesIDs() (ids []uuid.UUID) {
+	for id := range m.removedlikes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikesIDs returns the "likes" edge IDs in the mutation.
+func (m *UserMutation) LikesIDs() (ids []uuid.UUID) {
+	for id := range m.likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikes resets all changes to the "likes" edge.
+func (m *UserMutation) ResetLikes() {
+	m.likes = nil
+	m.clearedlikes = false
+	m.removedlikes = nil
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by ids.
+func (m *UserMutation) AddPetIDs(ids ...uuid.UUID) {
+	if m.pets == nil {
+		m.pets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.pets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPets clears the "pets" edge to the Pet entity.
+func (m *UserMutation) ClearPets() {
+	m.clearedpets = true
+}
+
+// PetsCleared reports if the "pets" edge to the Pet entity was cleared.
+func (m *UserMutation) PetsCleared() bool {
+	return m.clearedpets
+}
+
+// RemovePetIDs removes the "pets" edge to the Pet entity by IDs.
+func (m *UserMutation) RemovePetIDs(ids ...uuid.UUID) {
+	if m.removedpets == nil {
+		m.removedpets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.pets, ids[i])
+		m.removedpets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPets returns the removed IDs of the "pets" edge to the Pet entity.
+func (m *UserMutation) RemovedPetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PetsIDs returns the "pets" edge IDs in the mutation.
+func (m *UserMutation) PetsIDs() (ids []uuid.UUID) {
+	for id := range m.pets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPets resets all changes to the "pets" edge.
+func (m *UserMutation) ResetPets() {
+	m.pets = nil
+	m.clearedpets = false
+	m.removedpets = nil
+}
+
+// AddFollowingIDs adds the "following" edge to the FollowRelation entity by ids.
+func (m *UserMutation) AddFollowingIDs(ids ...uuid.UUID) {
+	if m.following == nil {
+		m.following = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.following[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowing clears the "following" edge to the FollowRelation entity.
+func (m *UserMutation) ClearFollowing() {
+	m.clearedfollowing = true
+}
+
+// FollowingCleared reports if the "following" edge to the FollowRelation entity was cleared.
+func (m *UserMutation) FollowingCleared() bool {
+	return m.clearedfollowing
+}
+
+// RemoveFollowingIDs removes the "following" edge to the FollowRelation entity by IDs.
+func (m *UserMutation) RemoveFollowingIDs(ids ...uuid.UUID) {
+	if m.removedfollowing == nil {
+		m.removedfollowing = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.following, ids[i])
+		m.removedfollowing[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowing returns the removed IDs of the "following" edge to the FollowRelation entity.
+func (m *UserMutation) RemovedFollowingIDs() (ids []uuid.UUID) {
+	for id := range m.removedfollowing {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowingIDs returns the "following" edge IDs in the mutation.
+func (m *UserMutation) FollowingIDs() (ids []uuid.UUID) {
+	for id := range m.following {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowing resets all changes to the "following" edge.
+func (m *UserMutation) ResetFollowing() {
+	m.following = nil
+	m.clearedfollowing = false
+	m.removedfollowing = nil
+}
+
+// AddFollowerIDs adds the "followers" edge to the FollowRelation entity by ids.
+func (m *UserMutation) AddFollowerIDs(ids ...uuid.UUID) {
+	if m.followers == nil {
+		m.followers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.followers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowers clears the "followers" edge to the FollowRelation entity.
+func (m *UserMutation) ClearFollowers() {
+	m.clearedfollowers = true
+}
+
+// FollowersCleared reports if the "followers" edge to the FollowRelation entity was cleared.
+func (m *UserMutation) FollowersCleared() bool {
+	return m.clearedfollowers
+}
+
+// RemoveFollowerIDs removes the "followers" edge to the FollowRelation entity by IDs.
+func (m *UserMutation) RemoveFollowerIDs(ids ...uuid.UUID) {
+	if m.removedfollowers == nil {
+		m.removedfollowers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.followers, ids[i])
+		m.removedfollowers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowers returns the removed IDs of the "followers" edge to the FollowRelation entity.
+func (m *UserMutation) RemovedFollowersIDs() (ids []uuid.UUID) {
+	for id := range m.removedfollowers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowersIDs returns the "followers" edge IDs in the mutation.
+func (m *UserMutation) FollowersIDs() (ids []uuid.UUID) {
+	for id := range m.followers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowers resets all changes to the "followers" edge.
+func (m *UserMutation) ResetFollowers() {
+	m.followers = nil
+	m.clearedfollowers = false
+	m.removedfollowers = nil
+}
+
+// AddBlockingIDs adds the "blocking" edge to the BlockRelation entity by ids.
+func (m *UserMutation) AddBlockingIDs(ids ...uuid.UUID) {
+	if m.blocking == nil {
+		m.blocking = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocking[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlocking clears the "blocking" edge to the BlockRelation entity.
+func (m *UserMutation) ClearBlocking() {
+	m.clearedblocking = true
+}
+
+// BlockingCleared reports if the "blocking" edge to the BlockRelation entity was cleared.
+func (m *UserMutation) BlockingCleared() bool {
+	return m.clearedblocking
+}
+
+// RemoveBlockingIDs removes the "blocking" edge to the BlockRelation entity by IDs.
+func (m *UserMutation) RemoveBlockingIDs(ids ...uuid.UUID) {
+	if m.removedblocking == nil {
+		m.removedblocking = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocking, ids[i])
+		m.removedblocking[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlocking returns the removed IDs of the "blocking" edge to the BlockRelation entity.
+func (m *UserMutation) RemovedBlockingIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocking {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockingIDs returns the "blocking" edge IDs in the mutation.
+func (m *UserMutation) BlockingIDs() (ids []uuid.UUID) {
+	for id := range m.blocking {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlocking resets all changes to the "blocking" edge.
+func (m *UserMutation) ResetBlocking() {
+	m.blocking = nil
+	m.clearedblocking = false
+	m.removedblocking = nil
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the BlockRelation entity by ids.
+func (m *UserMutation) AddBlockedByIDs(ids ...uuid.UUID) {
+	if m.blocked_by == nil {
+		m.blocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedBy clears the "blocked_by" edge to the BlockRelation entity.
+func (m *UserMutation) ClearBlockedBy() {
+	m.clearedblocked_by = true
+}
+
+// BlockedByCleared reports if the "blocked_by" edge to the BlockRelation entity was cleared.
+func (m *UserMutation) BlockedByCleared() bool {
+	return m.clearedblocked_by
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to the BlockRelation entity by IDs.
+func (m *UserMutation) RemoveBlockedByIDs(ids ...uuid.UUID) {
+	if m.removedblocked_by == nil {
+		m.removedblocked_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by, ids[i])
+		m.removedblocked_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedBy returns the removed IDs of the "blocked_by" edge to the BlockRelation entity.
+func (m *UserMutation) RemovedBlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedByIDs returns the "blocked_by" edge IDs in the mutation.
+func (m *UserMutation) BlockedByIDs() (ids []uuid.UUID) {
+	for id := range m.blocked_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedBy resets all changes to the "blocked_by" edge.
+func (m *UserMutation) ResetBlockedBy() {
+	m.blocked_by = nil
+	m.clearedblocked_by = false
+	m.removedblocked_by = nil
+}
+
+// AddDailyTaskIDs adds the "daily_tasks" edge to the DailyTask entity by ids.
+func (m *UserMutation) AddDailyTaskIDs(ids ...uuid.UUID) {
+	if m.daily_tasks == nil {
+		m.daily_tasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.daily_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDailyTasks clears the "daily_tasks" edge to the DailyTask entity.
+func (m *UserMutation) ClearDailyTasks() {
+	m.cleareddaily_tasks = true
+}
+
+// DailyTasksCleared reports if the "daily_tasks" edge to the DailyTask entity was cleared.
+func (m *UserMutation) DailyTasksCleared() bool {
+	return m.cleareddaily_tasks
+}
+
+// RemoveDailyTaskIDs removes the "daily_tasks" edge to the DailyTask entity by IDs.
+func (m *UserMutation) RemoveDailyTaskIDs(ids ...uuid.UUID) {
+	if m.removeddaily_tasks == nil {
+		m.removeddaily_tasks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.daily_tasks, ids[i])
+		m.removeddaily_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDailyTasks returns the removed IDs of the "daily_tasks" edge to the DailyTask entity.
+func (m *UserMutation) RemovedDailyTasksIDs() (ids []uuid.UUID) {
+	for id := range m.removeddaily_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DailyTasksIDs returns the "daily_tasks" edge IDs in the mutation.
+func (m *UserMutation) DailyTasksIDs() (ids []uuid.UUID) {
+	for id := range m.daily_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDailyTasks resets all changes to the "daily_tasks" edge.
+func (m *UserMutation) ResetDailyTasks() {
+	m.daily_tasks = nil
+	m.cleareddaily_tasks = false
+	m.removeddaily_tasks = nil
+}
+
+// AddDeviceTokenIDs adds the "device_tokens" edge to the DeviceToken entity by ids.
+func (m *UserMutation) AddDeviceTokenIDs(ids ...uuid.UUID) {
+	if m.device_tokens == nil {
+		m.device_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.device_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeviceTokens clears the "device_tokens" edge to the DeviceToken entity.
+func (m *UserMutation) ClearDeviceTokens() {
+	m.cleareddevice_tokens = true
+}
+
+// DeviceTokensCleared reports if the "device_tokens" edge to the DeviceToken entity was cleared.
+func (m *UserMutation) DeviceTokensCleared() bool {
+	return m.cleareddevice_tokens
+}
+
+// RemoveDeviceTokenIDs removes the "device_tokens" edge to the DeviceToken entity by IDs.
+func (m *UserMutation) RemoveDeviceTokenIDs(ids ...uuid.UUID) {
+	if m.removeddevice_tokens == nil {
+		m.removeddevice_tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.device_tokens, ids[i])
+		m.removeddevice_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeviceTokens returns the removed IDs of the "device_tokens" edge to the DeviceToken entity.
+func (m *UserMutation) RemovedDeviceTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removeddevice_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeviceTokensIDs returns the "device_tokens" edge IDs in the mutation.
+func (m *UserMutation) DeviceTokensIDs() (ids []uuid.UUID) {
+	for id := range m.device_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeviceTokens resets all changes to the "device_tokens" edge.
+func (m *UserMutation) ResetDeviceTokens() {
+	m.device_tokens = nil
+	m.cleareddevice_tokens = false
+	m.removeddevice_tokens = nil
+}
+
+// AddReportsFiledIDs adds the "reports_filed" edge to the Report entity by ids.
+func (m *UserMutation) AddReportsFiledIDs(ids ...uuid.UUID) {
+	if m.reports_filed == nil {
+		m.reports_filed = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports_filed[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReportsFiled clears the "reports_filed" edge to the Report entity.
+func (m *UserMutation) ClearReportsFiled() {
+	m.clearedreports_filed = true
+}
+
+// ReportsFiledCleared reports if the "reports_filed" edge to the Report entity was cleared.
+func (m *UserMutation) ReportsFiledCleared() bool {
+	return m.clearedreports_filed
+}
+
+// RemoveReportsFiledIDs removes the "reports_filed" edge to the Report entity by IDs.
+func (m *UserMutation) RemoveReportsFiledIDs(ids ...uuid.UUID) {
+	if m.removedreports_filed == nil {
+		m.removedreports_filed = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reports_filed, ids[i])
+		m.removedreports_filed[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReportsFiled returns the removed IDs of the "reports_filed" edge to the Report entity.
+func (m *UserMutation) RemovedReportsFiledIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports_filed {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReportsFiledIDs returns the "reports_filed" edge IDs in the mutation.
+func (m *UserMutation) ReportsFiledIDs() (ids []uuid.UUID) {
+	for id := range m.reports_filed {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReportsFiled resets all changes to the "reports_filed" edge.
+func (m *UserMutation) ResetReportsFiled() {
+	m.reports_filed = nil
+	m.clearedreports_filed = false
+	m.removedreports_filed = nil
+}
+
+// AddReportsReceivedIDs adds the "reports_received" edge to the Report entity by ids.
+func (m *UserMutation) AddReportsReceivedIDs(ids ...uuid.UUID) {
+	if m.reports_received == nil {
+		m.reports_received = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports_received[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReportsReceived clears the "reports_received" edge to the Report entity.
+func (m *UserMutation) ClearReportsReceived() {
+	m.clearedreports_received = true
+}
+
+// ReportsReceivedCleared reports if the "reports_received" edge to the Report entity was cleared.
+func (m *UserMutation) ReportsReceivedCleared() bool {
+	return m.clearedreports_received
+}
+
+// RemoveReportsReceivedIDs removes the "reports_received" edge to the Report entity by IDs.
+func (m *UserMutation) RemoveReportsReceivedIDs(ids ...uuid.UUID) {
+	if m.removedreports_received == nil {
+		m.removedreports_received = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reports_received, ids[i])
+		m.removedreports_received[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReportsReceived returns the removed IDs of the "reports_received" edge to the Report entity.
+func (m *UserMutation) RemovedReportsReceivedIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports_received {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReportsReceivedIDs returns the "reports_received" edge IDs in the mutation.
+func (m *UserMutation) ReportsReceivedIDs() (ids []uuid.UUID) {
+	for id := range m.reports_received {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReportsReceived resets all changes to the "reports_received" edge.
+func (m *UserMutation) ResetReportsReceived() {
+	m.reports_received = nil
+	m.clearedreports_received = false
+	m.removedreports_received = nil
+}
+
+// AddReportsResolvedIDs adds the "reports_resolved" edge to the Report entity by ids.
+func (m *UserMutation) AddReportsResolvedIDs(ids ...uuid.UUID) {
+	if m.reports_resolved == nil {
+		m.reports_resolved = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports_resolved[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReportsResolved clears the "reports_resolved" edge to the Report entity.
+func (m *UserMutation) ClearReportsResolved() {
+	m.clearedreports_resolved = true
+}
+
+// ReportsResolvedCleared reports if the "reports_resolved" edge to the Report entity was cleared.
+func (m *UserMutation) ReportsResolvedCleared() bool {
+	return m.clearedreports_resolved
+}
+
+// RemoveReportsResolvedIDs removes the "reports_resolved" edge to the Report entity by IDs.
+func (m *UserMutation) RemoveReportsResolvedIDs(ids ...uuid.UUID) {
+	if m.removedreports_resolved == nil {
+		m.removedreports_resolved = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reports_resolved, ids[i])
+		m.removedreports_resolved[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReportsResolved returns the removed IDs of the "reports_resolved" edge to the Report entity.
+func (m *UserMutation) RemovedReportsResolvedIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports_resolved {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReportsResolvedIDs returns the "reports_resolved" edge IDs in the mutation.
+func (m *UserMutation) ReportsResolvedIDs() (ids []uuid.UUID) {
+	for id := range m.reports_resolved {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReportsResolved resets all changes to the "reports_resolved" edge.
+func (m *UserMutation) ResetReportsResolved() {
+	m.reports_resolved = nil
+	m.clearedreports_resolved = false
+	m.removedreports_resolved = nil
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by ids.
+func (m *UserMutation) AddSuspensionIDs(ids ...uuid.UUID) {
+	if m.suspensions == nil {
+		m.suspensions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.suspensions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSuspensions clears the "suspensions" edge to the Suspension entity.
+func (m *UserMutation) ClearSuspensions() {
+	m.clearedsuspensions = true
+}
+
+// SuspensionsCleared reports if the "suspensions" edge to the Suspension entity was cleared.
+func (m *UserMutation) SuspensionsCleared() bool {
+	return m.clearedsuspensions
+}
+
+// RemoveSuspensionIDs removes the "suspensions" edge to the Suspension entity by IDs.
+func (m *UserMutation) RemoveSuspensionIDs(ids ...uuid.UUID) {
+	if m.removedsuspensions == nil {
+		m.removedsuspensions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.suspensions, ids[i])
+		m.removedsuspensions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSuspensions returns the removed IDs of the "suspensions" edge to the Suspension entity.
+func (m *UserMutation) RemovedSuspensionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsuspensions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SuspensionsIDs returns the "suspensions" edge IDs in the mutation.
+func (m *UserMutation) SuspensionsIDs() (ids []uuid.UUID) {
+	for id := range m.suspensions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSuspensions resets all changes to the "suspensions" edge.
+func (m *UserMutation) ResetSuspensions() {
+	m.suspensions = nil
+	m.clearedsuspensions = false
+	m.removedsuspensions = nil
+}
+
+// AddSuspensionsIssuedIDs adds the "suspensions_issued" edge to the Suspension entity by ids.
+func (m *UserMutation) AddSuspensionsIssuedIDs(ids ...uuid.UUID) {
+	if m.suspensions_issued == nil {
+		m.suspensions_issued = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.suspensions_issued[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSuspensionsIssued clears the "suspensions_issued" edge to the Suspension entity.
+func (m *UserMutation) ClearSuspensionsIssued() {
+	m.clearedsuspensions_issued = true
+}
+
+// SuspensionsIssuedCleared reports if the "suspensions_issued" edge to the Suspension entity was cleared.
+func (m *UserMutation) SuspensionsIssuedCleared() bool {
+	return m.clearedsuspensions_issued
+}
+
+// RemoveSuspensionsIssuedIDs removes the "suspensions_issued" edge to the Suspension entity by IDs.
+func (m *UserMutation) RemoveSuspensionsIssuedIDs(ids ...uuid.UUID) {
+	if m.removedsuspensions_issued == nil {
+		m.removedsuspensions_issued = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.suspensions_issued, ids[i])
+		m.removedsuspensions_issued[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSuspensionsIssued returns the removed IDs of the "suspensions_issued" edge to the Suspension entity.
+func (m *UserMutation) RemovedSuspensionsIssuedIDs() (ids []uuid.UUID) {
+	for id := range m.removedsuspensions_issued {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SuspensionsIssuedIDs returns the "suspensions_issued" edge IDs in the mutation.
+func (m *UserMutation) SuspensionsIssuedIDs() (ids []uuid.UUID) {
+	for id := range m.suspensions_issued {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSuspensionsIssued resets all changes to the "suspensions_issued" edge.
+func (m *UserMutation) ResetSuspensionsIssued() {
+	m.suspensions_issued = nil
+	m.clearedsuspensions_issued = false
+	m.removedsuspensions_issued = nil
+}
+
+// AddMutingIDs adds the "muting" edge to the MuteRelation entity by ids.
+func (m *UserMutation) AddMutingIDs(ids ...uuid.UUID) {
+	if m.muting == nil {
+		m.muting = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.muting[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMuting clears the "muting" edge to the MuteRelation entity.
+func (m *UserMutation) ClearMuting() {
+	m.clearedmuting = true
+}
+
+// MutingCleared reports if the "muting" edge to the MuteRelation entity was cleared.
+func (m *UserMutation) MutingCleared() bool {
+	return m.clearedmuting
+}
+
+// RemoveMutingIDs removes the "muting" edge to the MuteRelation entity by IDs.
+func (m *UserMutation) RemoveMutingIDs(ids ...uuid.UUID) {
+	if m.removedmuting == nil {
+		m.removedmuting = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.muting, ids[i])
+		m.removedmuting[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMuting returns the removed IDs of the "muting" edge to the MuteRelation entity.
+func (m *UserMutation) RemovedMutingIDs() (ids []uuid.UUID) {
+	for id := range m.removedmuting {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MutingIDs returns the "muting" edge IDs in the mutation.
+func (m *UserMutation) MutingIDs() (ids []uuid.UUID) {
+	for id := range m.muting {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMuting resets all changes to the "muting" edge.
+func (m *UserMutation) ResetMuting() {
+	m.muting = nil
+	m.clearedmuting = false
+	m.removedmuting = nil
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the MuteRelation entity by ids.
+func (m *UserMutation) AddMutedByIDs(ids ...uuid.UUID) {
+	if m.muted_by == nil {
+		m.muted_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.muted_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMutedBy clears the "muted_by" edge to the MuteRelation entity.
+func (m *UserMutation) ClearMutedBy() {
+	m.clearedmuted_by = true
+}
+
+// MutedByCleared reports if the "muted_by" edge to the MuteRelation entity was cleared.
+func (m *UserMutation) MutedByCleared() bool {
+	return m.clearedmuted_by
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to the MuteRelation entity by IDs.
+func (m *UserMutation) RemoveMutedByIDs(ids ...uuid.UUID) {
+	if m.removedmuted_by == nil {
+		m.removedmuted_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.muted_by, ids[i])
+		m.removedmuted_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMutedBy returns the removed IDs of the "muted_by" edge to the MuteRelation entity.
+func (m *UserMutation) RemovedMutedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedmuted_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MutedByIDs returns the "muted_by" edge IDs in the mutation.
+func (m *UserMutation) MutedByIDs() (ids []uuid.UUID) {
+	for id := range m.muted_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMutedBy resets all changes to the "muted_by" edge.
+func (m *UserMutation) ResetMutedBy() {
+	m.muted_by = nil
+	m.clearedmuted_by = false
+	m.removedmuted_by = nil
+}
+
+// AddMutedKeywordIDs adds the "muted_keywords" edge to the MutedKeyword entity by ids.
+func (m *UserMutation) AddMutedKeywordIDs(ids ...uuid.UUID) {
+	if m.muted_keywords == nil {
+		m.muted_keywords = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.muted_keywords[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMutedKeywords clears the "muted_keywords" edge to the MutedKeyword entity.
+func (m *UserMutation) ClearMutedKeywords() {
+	m.clearedmuted_keywords = true
+}
+
+// MutedKeywordsCleared reports if the "muted_keywords" edge to the MutedKeyword entity was cleared.
+func (m *UserMutation) MutedKeywordsCleared() bool {
+	return m.clearedmuted_keywords
+}
+
+// RemoveMutedKeywordIDs removes the "muted_keywords" edge to the MutedKeyword entity by IDs.
+func (m *UserMutation) RemoveMutedKeywordIDs(ids ...uuid.UUID) {
+	if m.removedmuted_keywords == nil {
+		m.removedmuted_keywords = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.muted_keywords, ids[i])
+		m.removedmuted_keywords[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMutedKeywords returns the removed IDs of the "muted_keywords" edge to the MutedKeyword entity.
+func (m *UserMutation) RemovedMutedKeywordsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmuted_keywords {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MutedKeywordsIDs returns the "muted_keywords" edge IDs in the mutation.
+func (m *UserMutation) MutedKeywordsIDs() (ids []uuid.UUID) {
+	for id := range m.muted_keywords {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMutedKeywords resets all changes to the "muted_keywords" edge.
+func (m *UserMutation) ResetMutedKeywords() {
+	m.muted_keywords = nil
+	m.clearedmuted_keywords = false
+	m.removedmuted_keywords = nil
+}
+
+// AddFollowRequestsSentIDs adds the "follow_requests_sent" edge to the FollowRequest entity by ids.
+func (m *UserMutation) AddFollowRequestsSentIDs(ids ...uuid.UUID) {
+	if m.follow_requests_sent == nil {
+		m.follow_requests_sent = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.follow_requests_sent[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowRequestsSent clears the "follow_requests_sent" edge to the FollowRequest entity.
+func (m *UserMutation) ClearFollowRequestsSent() {
+	m.clearedfollow_requests_sent = true
+}
+
+// FollowRequestsSentCleared reports if the "follow_requests_sent" edge to the FollowRequest entity was cleared.
+func (m *UserMutation) FollowRequestsSentCleared() bool {
+	return m.clearedfollow_requests_sent
+}
+
+// RemoveFollowRequestsSentIDs removes the "follow_requests_sent" edge to the FollowRequest entity by IDs.
+func (m *UserMutation) RemoveFollowRequestsSentIDs(ids ...uuid.UUID) {
+	if m.removedfollow_requests_sent == nil {
+		m.removedfollow_requests_sent = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.follow_requests_sent, ids[i])
+		m.removedfollow_requests_sent[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowRequestsSent returns the removed IDs of the "follow_requests_sent" edge to the FollowRequest entity.
+func (m *UserMutation) RemovedFollowRequestsSentIDs() (ids []uuid.UUID) {
+	for id := range m.removedfollow_requests_sent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowRequestsSentIDs returns the "follow_requests_sent" edge IDs in the mutation.
+func (m *UserMutation) FollowRequestsSentIDs() (ids []uuid.UUID) {
+	for id := range m.follow_requests_sent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowRequestsSent resets all changes to the "follow_requests_sent" edge.
+func (m *UserMutation) ResetFollowRequestsSent() {
+	m.follow_requests_sent = nil
+	m.clearedfollow_requests_sent = false
+	m.removedfollow_requests_sent = nil
+}
+
+// AddFollowRequestsReceivedIDs adds the "follow_requests_received" edge to the FollowRequest entity by ids.
+func (m *UserMutation) AddFollowRequestsReceivedIDs(ids ...uuid.UUID) {
+	if m.follow_requests_received == nil {
+		m.follow_requests_received = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.follow_requests_received[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFollowRequestsReceived clears the "follow_requests_received" edge to the FollowRequest entity.
+func (m *UserMutation) ClearFollowRequestsReceived() {
+	m.clearedfollow_requests_received = true
+}
+
+// FollowRequestsReceivedCleared reports if the "follow_requests_received" edge to the FollowRequest entity was cleared.
+func (m *UserMutation) FollowRequestsReceivedCleared() bool {
+	return m.clearedfollow_requests_received
+}
+
+// RemoveFollowRequestsReceivedIDs removes the "follow_requests_received" edge to the FollowRequest entity by IDs.
+func (m *UserMutation) RemoveFollowRequestsReceivedIDs(ids ...uuid.UUID) {
+	if m.removedfollow_requests_received == nil {
+		m.removedfollow_requests_received = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.follow_requests_received, ids[i])
+		m.removedfollow_requests_received[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFollowRequestsReceived returns the removed IDs of the "follow_requests_received" edge to the FollowRequest entity.
+func (m *UserMutation) RemovedFollowRequestsReceivedIDs() (ids []uuid.UUID) {
+	for id := range m.removedfollow_requests_received {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FollowRequestsReceivedIDs returns the "follow_requests_received" edge IDs in the mutation.
+func (m *UserMutation) FollowRequestsReceivedIDs() (ids []uuid.UUID) {
+	for id := range m.follow_requests_received {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFollowRequestsReceived resets all changes to the "follow_requests_received" edge.
+func (m *UserMutation) ResetFollowRequestsReceived() {
+	m.follow_requests_received = nil
+	m.clearedfollow_requests_received = false
+	m.removedfollow_requests_received = nil
+}
+
+// AddMentionedInPostIDs adds the "mentioned_in_posts" edge to the Post entity by ids.
+func (m *UserMutation) AddMentionedInPostIDs(ids ...uuid.UUID) {
+	if m.mentioned_in_posts == nil {
+		m.mentioned_in_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.mentioned_in_posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentionedInPosts clears the "mentioned_in_posts" edge to the Post entity.
+func (m *UserMutation) ClearMentionedInPosts() {
+	m.clearedmentioned_in_posts = true
+}
+
+// MentionedInPostsCleared reports if the "mentioned_in_posts" edge to the Post entity was cleared.
+func (m *UserMutation) MentionedInPostsCleared() bool {
+	return m.clearedmentioned_in_posts
+}
+
+// RemoveMentionedInPostIDs removes the "mentioned_in_posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemoveMentionedInPostIDs(ids ...uuid.UUID) {
+	if m.removedmentioned_in_posts == nil {
+		m.removedmentioned_in_posts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_in_posts, ids[i])
+		m.removedmentioned_in_posts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentionedInPosts returns the removed IDs of the "mentioned_in_posts" edge to the Post entity.
+func (m *UserMutation) RemovedMentionedInPostsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentioned_in_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionedInPostsIDs returns the "mentioned_in_posts" edge IDs in the mutation.
+func (m *UserMutation) MentionedInPostsIDs() (ids []uuid.UUID) {
+	for id := range m.mentioned_in_posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentionedInPosts resets all changes to the "mentioned_in_posts" edge.
+func (m *UserMutation) ResetMentionedInPosts() {
+	m.mentioned_in_posts = nil
+	m.clearedmentioned_in_posts = false
+	m.removedmentioned_in_posts = nil
+}
+
+// AddMentionedInCommentIDs adds the "mentioned_in_comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddMentionedInCommentIDs(ids ...uuid.UUID) {
+	if m.mentioned_in_comments == nil {
+		m.mentioned_in_comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.mentioned_in_comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentionedInComments clears the "mentioned_in_comments" edge to the Comment entity.
+func (m *UserMutation) ClearMentionedInComments() {
+	m.clearedmentioned_in_comments = true
+}
+
+// MentionedInCommentsCleared reports if the "mentioned_in_comments" edge to the Comment entity was cleared.
+func (m *UserMutation) MentionedInCommentsCleared() bool {
+	return m.clearedmentioned_in_comments
+}
+
+// RemoveMentionedInCommentIDs removes the "mentioned_in_comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveMentionedInCommentIDs(ids ...uuid.UUID) {
+	if m.removedmentioned_in_comments == nil {
+		m.removedmentioned_in_comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_in_comments, ids[i])
+		m.removedmentioned_in_comments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentionedInComments returns the removed IDs of the "mentioned_in_comments" edge to the Comment entity.
+func (m *UserMutation) RemovedMentionedInCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentioned_in_comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionedInCommentsIDs returns the "mentioned_in_comments" edge IDs in the mutation.
+func (m *UserMutation) MentionedInCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.mentioned_in_comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentionedInComments resets all changes to the "mentioned_in_comments" edge.
+func (m *UserMutation) ResetMentionedInComments() {
+	m.mentioned_in_comments = nil
+	m.clearedmentioned_in_comments = false
+	m.removedmentioned_in_comments = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *UserMutation) AddNotificationIDs(ids ...uuid.UUID) {
+	if m.notifications == nil {
+		m.notifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *UserMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *UserMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveNotificationIDs(ids ...uuid.UUID) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *UserMutation) RemovedNotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *UserMutation) NotificationsIDs() (ids []uuid.UUID) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *UserMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// AddNotificationsSentIDs adds the "notifications_sent" edge to the Notification entity by ids.
+func (m *UserMutation) AddNotificationsSentIDs(ids ...uuid.UUID) {
+	if m.notifications_sent == nil {
+		m.notifications_sent = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notifications_sent[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotificationsSent clears the "notifications_sent" edge to the Notification entity.
+func (m *UserMutation) ClearNotificationsSent() {
+	m.clearednotifications_sent = true
+}
+
+// NotificationsSentCleared reports if the "notifications_sent" edge to the Notification entity was cleared.
+func (m *UserMutation) NotificationsSentCleared() bool {
+	return m.clearednotifications_sent
+}
+
+// RemoveNotificationsSentIDs removes the "notifications_sent" edge to the Notification entity by IDs.
+func (m *UserMutation) RemoveNotificationsSentIDs(ids ...uuid.UUID) {
+	if m.removednotifications_sent == nil {
+		m.removednotifications_sent = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications_sent, ids[i])
+		m.removednotifications_sent[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotificationsSent returns the removed IDs of the "notifications_sent" edge to the Notification entity.
+func (m *UserMutation) RemovedNotificationsSentIDs() (ids []uuid.UUID) {
+	for id := range m.removednotifications_sent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsSentIDs returns the "notifications_sent" edge IDs in the mutation.
+func (m *UserMutation) NotificationsSentIDs() (ids []uuid.UUID) {
+	for id := range m.notifications_sent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotificationsSent resets all changes to the "notifications_sent" edge.
+func (m *UserMutation) ResetNotificationsSent() {
+	m.notifications_sent = nil
+	m.clearednotifications_sent = false
+	m.removednotifications_sent = nil
+}
+
+// AddCommentLikeIDs adds the "comment_likes" edge to the CommentLike entity by ids.
+func (m *UserMutation) AddCommentLikeIDs(ids ...uuid.UUID) {
+	if m.comment_likes == nil {
+		m.comment_likes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comment_likes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCommentLikes clears the "comment_likes" edge to the CommentLike entity.
+func (m *UserMutation) ClearCommentLikes() {
+	m.clearedcomment_likes = true
+}
+
+// CommentLikesCleared reports if the "comment_likes" edge to the CommentLike entity was cleared.
+func (m *UserMutation) CommentLikesCleared() bool {
+	return m.clearedcomment_likes
+}
+
+// RemoveCommentLikeIDs removes the "comment_likes" edge to the CommentLike entity by IDs.
+func (m *UserMutation) RemoveCommentLikeIDs(ids ...uuid.UUID) {
+	if m.removedcomment_likes == nil {
+		m.removedcomment_likes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comment_likes, ids[i])
+		m.removedcomment_likes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCommentLikes returns the removed IDs of the "comment_likes" edge to the CommentLike entity.
+func (m *UserMutation) RemovedCommentLikesIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomment_likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentLikesIDs returns the "comment_likes" edge IDs in the mutation.
+func (m *UserMutation) CommentLikesIDs() (ids []uuid.UUID) {
+	for id := range m.comment_likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCommentLikes resets all changes to the "comment_likes" edge.
+func (m *UserMutation) ResetCommentLikes() {
+	m.comment_likes = nil
+	m.clearedcomment_likes = false
+	m.removedcomment_likes = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.index != nil {
+		fields = append(fields, user.FieldIndex)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.auth_subject != nil {
+		fields = append(fields, user.FieldAuthSubject)
+	}
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.handle != nil {
+		fields = append(fields, user.FieldHandle)
+	}
+	if m.bio != nil {
+		fields = append(fields, user.FieldBio)
+	}
+	if m.streak_count != nil {
+		fields = append(fields, user.FieldStreakCount)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.icon_image_key != nil {
+		fields = append(fields, user.FieldIconImageKey)
+	}
+	if m.is_private != nil {
+		fields = append(fields, user.FieldIsPrivate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.follower_count != nil {
+		fields = append(fields, user.FieldFollowerCount)
+	}
+	if m.following_count != nil {
+		fields = append(fields, user.FieldFollowingCount)
+	}
+	if m.post_count != nil {
+		fields = append(fields, user.FieldPostCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldIndex:
+		return m.Index()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldAuthSubject:
+		return m.AuthSubject()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldHandle:
+		return m.Handle()
+	case user.FieldBio:
+		return m.Bio()
+	case user.FieldStreakCount:
+		return m.StreakCount()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldIconImageKey:
+		return m.IconImageKey()
+	case user.FieldIsPrivate:
+		return m.IsPrivate()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldFollowerCount:
+		return m.FollowerCount()
+	case user.FieldFollowingCount:
+		return m.FollowingCount()
+	case user.FieldPostCount:
+		return m.PostCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldIndex:
+		return m.OldIndex(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldAuthSubject:
+		return m.OldAuthSubject(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldHandle:
+		return m.OldHandle(ctx)
+	case user.FieldBio:
+		return m.OldBio(ctx)
+	case user.FieldStreakCount:
+		return m.OldStreakCount(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldIconImageKey:
+		return m.OldIconImageKey(ctx)
+	case user.FieldIsPrivate:
+		return m.OldIsPrivate(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldFollowerCount:
+		return m.OldFollowerCount(ctx)
+	case user.FieldFollowingCount:
+		return m.OldFollowingCount(ctx)
+	case user.FieldPostCount:
+		return m.OldPostCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldIndex:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldAuthSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthSubject(v)
+		return nil
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldHandle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHandle(v)
+		return nil
+	case user.FieldBio:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBio(v)
+		return nil
+	case user.FieldStreakCount:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStreakCount(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(enum.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldIconImageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIconImageKey(v)
+		return nil
+	case user.FieldIsPrivate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPrivate(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldFollowerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFollowerCount(v)
+		return nil
+	case user.FieldFollowingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFollowingCount(v)
+		return nil
+	case user.FieldPostCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addindex != nil {
+		fields = append(fields, user.FieldIndex)
+	}
+	if m.addstreak_count != nil {
+		fields = append(fields, user.FieldStreakCount)
+	}
+	if m.addfollower_count != nil {
+		fields = append(fields, user.FieldFollowerCount)
+	}
+	if m.addfollowing_count != nil {
+		fields = append(fields, user.FieldFollowingCount)
+	}
+	if m.addpost_count != nil {
+		fields = append(fields, user.FieldPostCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldIndex:
+		return m.AddedIndex()
+	case user.FieldStreakCount:
+		return m.AddedStreakCount()
+	case user.FieldFollowerCount:
+		return m.AddedFollowerCount()
+	case user.FieldFollowingCount:
+		return m.AddedFollowingCount()
+	case user.FieldPostCount:
+		return m.AddedPostCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldIndex:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIndex(v)
+		return nil
+	case user.FieldStreakCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStreakCount(v)
+		return nil
+	case user.FieldFollowerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFollowerCount(v)
+		return nil
+	case user.FieldFollowingCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFollowingCount(v)
+		return nil
+	case user.FieldPostCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPostCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldIndex) {
+		fields = append(fields, user.FieldIndex)
+	}
+	if m.FieldCleared(user.FieldAuthSubject) {
+		fields = append(fields, user.FieldAuthSubject)
+	}
+	if m.FieldCleared(user.FieldHandle) {
+		fields = append(fields, user.FieldHandle)
+	}
+	if m.FieldCleared(user.FieldIconImageKey) {
+		fields = append(fields, user.FieldIconImageKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldIndex:
+		m.ClearIndex()
+		return nil
+	case user.FieldAuthSubject:
+		m.ClearAuthSubject()
+		return nil
+	case user.FieldHandle:
+		m.ClearHandle()
+		return nil
+	case user.FieldIconImageKey:
+		m.ClearIconImageKey()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldIndex:
+		m.ResetIndex()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldAuthSubject:
+		m.ResetAuthSubject()
+		return nil
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldHandle:
+		m.ResetHandle()
+		return nil
+	case user.FieldBio:
+		m.ResetBio()
+		return nil
+	case user.FieldStreakCount:
+		m.ResetStreakCount()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldIconImageKey:
+		m.ResetIconImageKey()
+		return nil
+	case user.FieldIsPrivate:
+		m.ResetIsPrivate()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldFollowerCount:
+		m.ResetFollowerCount()
+		return nil
+	case user.FieldFollowingCount:
+		m.ResetFollowingCount()
+		return nil
+	case user.FieldPostCount:
+		m.ResetPostCount()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 25)
+	if m.posts != nil {
+		edges = append(edges, user.EdgePosts)
+	}
+	if m.comments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.likes != nil {
+		edges = append(edges, user.EdgeLikes)
+	}
+	if m.pets != nil {
+		edges = append(edges, user.EdgePets)
+	}
+	if m.following != nil {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.followers != nil {
+		edges = append(edges, user.EdgeFollowers)
+	}
+	if m.blocking != nil {
+		edges = append(edges, user.EdgeBlocking)
+	}
+	if m.blocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.daily_tasks != nil {
+		edges = append(edges, user.EdgeDailyTasks)
+	}
+	if m.device_tokens != nil {
+		edges = append(edges, user.EdgeDeviceTokens)
+	}
+	if m.reports_filed != nil {
+		edges = append(edges, user.EdgeReportsFiled)
+	}
+	if m.reports_received != nil {
+		edges = append(edges, user.EdgeReportsReceived)
+	}
+	if m.reports_resolved != nil {
+		edges = append(edges, user.EdgeReportsResolved)
+	}
+	if m.suspensions != nil {
+		edges = append(edges, user.EdgeSuspensions)
+	}
+	if m.suspensions_issued != nil {
+		edges = append(edges, user.EdgeSuspensionsIssued)
+	}
+	if m.muting != nil {
+		edges = append(edges, user.EdgeMuting)
+	}
+	if m.muted_by != nil {
+		edges = append(edges, user.EdgeMutedBy)
+	}
+	if m.muted_keywords != nil {
+		edges = append(edges, user.EdgeMutedKeywords)
+	}
+	if m.follow_requests_sent != nil {
+		edges = append(edges, user.EdgeFollowRequestsSent)
+	}
+	if m.follow_requests_received != nil {
+		edges = append(edges, user.EdgeFollowRequestsReceived)
+	}
+	if m.mentioned_in_posts != nil {
+		edges = append(edges, user.EdgeMentionedInPosts)
+	}
+	if m.mentioned_in_comments != nil {
+		edges = append(edges, user.EdgeMentionedInComments)
+	}
+	if m.notifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.notifications_sent != nil {
+		edges = append(edges, user.EdgeNotificationsSent)
+	}
+	if m.comment_likes != nil {
+		edges = append(edges, user.EdgeCommentLikes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.likes))
+		for id := range m.likes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePets:
+		ids := make([]ent.Value, 0, len(m.pets))
+		for id := range m.pets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowing:
+		ids := make([]ent.Value, 0, len(m.following))
+		for id := range m.following {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowers:
+		ids := make([]ent.Value, 0, len(m.followers))
+		for id := range m.followers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlocking:
+		ids := make([]ent.Value, 0, len(m.blocking))
+		for id := range m.blocking {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.blocked_by))
+		for id := range m.blocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDailyTasks:
+		ids := make([]ent.Value, 0, len(m.daily_tasks))
+		for id := range m.daily_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDeviceTokens:
+		ids := make([]ent.Value, 0, len(m.device_tokens))
+		for id := range m.device_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReportsFiled:
+		ids := make([]ent.Value, 0, len(m.reports_filed))
+		for id := range m.reports_filed {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReportsReceived:
+		ids := make([]ent.Value, 0, len(m.reports_received))
+		for id := range m.reports_received {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReportsResolved:
+		ids := make([]ent.Value, 0, len(m.reports_resolved))
+		for id := range m.reports_resolved {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSuspensions:
+		ids := make([]ent.Value, 0, len(m.suspensions))
+		for id := range m.suspensions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSuspensionsIssued:
+		ids := make([]ent.Value, 0, len(m.suspensions_issued))
+		for id := range m.suspensions_issued {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMuting:
+		ids := make([]ent.Value, 0, len(m.muting))
+		for id := range m.muting {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedBy:
+		ids := make([]ent.Value, 0, len(m.muted_by))
+		for id := range m.muted_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedKeywords:
+		ids := make([]ent.Value, 0, len(m.muted_keywords))
+		for id := range m.muted_keywords {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowRequestsSent:
+		ids := make([]ent.Value, 0, len(m.follow_requests_sent))
+		for id := range m.follow_requests_sent {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowRequestsReceived:
+		ids := make([]ent.Value, 0, len(m.follow_requests_received))
+		for id := range m.follow_requests_received {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentionedInPosts:
+		ids := make([]ent.Value, 0, len(m.mentioned_in_posts))
+		for id := range m.mentioned_in_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentionedInComments:
+		ids := make([]ent.Value, 0, len(m.mentioned_in_comments))
+		for id := range m.mentioned_in_comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationsSent:
+		ids := make([]ent.Value, 0, len(m.notifications_sent))
+		for id := range m.notifications_sent {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCommentLikes:
+		ids := make([]ent.Value, 0, len(m.comment_likes))
+		for id := range m.comment_likes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 25)
+	if m.removedposts != nil {
+		edges = append(edges, user.EdgePosts)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.removedlikes != nil {
+		edges = append(edges, user.EdgeLikes)
+	}
+	if m.removedpets != nil {
+		edges = append(edges, user.EdgePets)
+	}
+	if m.removedfollowing != nil {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.removedfollowers != nil {
+		edges = append(edges, user.EdgeFollowers)
+	}
+	if m.removedblocking != nil {
+		edges = append(edges, user.EdgeBlocking)
+	}
+	if m.removedblocked_by != nil {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.removeddaily_tasks != nil {
+		edges = append(edges, user.EdgeDailyTasks)
+	}
+	if m.removeddevice_tokens != nil {
+		edges = append(edges, user.EdgeDeviceTokens)
+	}
+	if m.removedreports_filed != nil {
+		edges = append(edges, user.EdgeReportsFiled)
+	}
+	if m.removedreports_received != nil {
+		edges = append(edges, user.EdgeReportsReceived)
+	}
+	if m.removedreports_resolved != nil {
+		edges = append(edges, user.EdgeReportsResolved)
+	}
+	if m.removedsuspensions != nil {
+		edges = append(edges, user.EdgeSuspensions)
+	}
+	if m.removedsuspensions_issued != nil {
+		edges = append(edges, user.EdgeSuspensionsIssued)
+	}
+	if m.removedmuting != nil {
+		edges = append(edges, user.EdgeMuting)
+	}
+	if m.removedmuted_by != nil {
+		edges = append(edges, user.EdgeMutedBy)
+	}
+	if m.removedmuted_keywords != nil {
+		edges = append(edges, user.EdgeMutedKeywords)
+	}
+	if m.removedfollow_requests_sent != nil {
+		edges = append(edges, user.EdgeFollowRequestsSent)
+	}
+	if m.removedfollow_requests_received != nil {
+		edges = append(edges, user.EdgeFollowRequestsReceived)
+	}
+	if m.removedmentioned_in_posts != nil {
+		edges = append(edges, user.EdgeMentionedInPosts)
+	}
+	if m.removedmentioned_in_comments != nil {
+		edges = append(edges, user.EdgeMentionedInComments)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.removednotifications_sent != nil {
+		edges = append(edges, user.EdgeNotificationsSent)
+	}
+	if m.removedcomment_likes != nil {
+		edges = append(edges, user.EdgeCommentLikes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6282,145 +19569,993 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 		}
 		return ids
 	case user.EdgeFollowers:
-		ids := make([]ent.Value, 0, len(m.removedfollowers))
-		for id := range m.removedfollowers {
-			ids = append(ids, id)
-		}
-		return ids
+		ids := make([]ent.Value, 0, len(m.removedfollowers))
+		for id := range m.removedfollowers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlocking:
+		ids := make([]ent.Value, 0, len(m.removedblocking))
+		for id := range m.removedblocking {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlockedBy:
+		ids := make([]ent.Value, 0, len(m.removedblocked_by))
+		for id := range m.removedblocked_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDailyTasks:
+		ids := make([]ent.Value, 0, len(m.removeddaily_tasks))
+		for id := range m.removeddaily_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDeviceTokens:
+		ids := make([]ent.Value, 0, len(m.removeddevice_tokens))
+		for id := range m.removeddevice_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReportsFiled:
+		ids := make([]ent.Value, 0, len(m.removedreports_filed))
+		for id := range m.removedreports_filed {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReportsReceived:
+		ids := make([]ent.Value, 0, len(m.removedreports_received))
+		for id := range m.removedreports_received {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReportsResolved:
+		ids := make([]ent.Value, 0, len(m.removedreports_resolved))
+		for id := range m.removedreports_resolved {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSuspensions:
+		ids := make([]ent.Value, 0, len(m.removedsuspensions))
+		for id := range m.removedsuspensions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSuspensionsIssued:
+		ids := make([]ent.Value, 0, len(m.removedsuspensions_issued))
+		for id := range m.removedsuspensions_issued {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMuting:
+		ids := make([]ent.Value, 0, len(m.removedmuting))
+		for id := range m.removedmuting {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedBy:
+		ids := make([]ent.Value, 0, len(m.removedmuted_by))
+		for id := range m.removedmuted_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMutedKeywords:
+		ids := make([]ent.Value, 0, len(m.removedmuted_keywords))
+		for id := range m.removedmuted_keywords {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowRequestsSent:
+		ids := make([]ent.Value, 0, len(m.removedfollow_requests_sent))
+		for id := range m.removedfollow_requests_sent {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFollowRequestsReceived:
+		ids := make([]ent.Value, 0, len(m.removedfollow_requests_received))
+		for id := range m.removedfollow_requests_received {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentionedInPosts:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_in_posts))
+		for id := range m.removedmentioned_in_posts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentionedInComments:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_in_comments))
+		for id := range m.removedmentioned_in_comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotificationsSent:
+		ids := make([]ent.Value, 0, len(m.removednotifications_sent))
+		for id := range m.removednotifications_sent {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCommentLikes:
+		ids := make([]ent.Value, 0, len(m.removedcomment_likes))
+		for id := range m.removedcomment_likes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 25)
+	if m.clearedposts {
+		edges = append(edges, user.EdgePosts)
+	}
+	if m.clearedcomments {
+		edges = append(edges, user.EdgeComments)
+	}
+	if m.clearedlikes {
+		edges = append(edges, user.EdgeLikes)
+	}
+	if m.clearedpets {
+		edges = append(edges, user.EdgePets)
+	}
+	if m.clearedfollowing {
+		edges = append(edges, user.EdgeFollowing)
+	}
+	if m.clearedfollowers {
+		edges = append(edges, user.EdgeFollowers)
+	}
+	if m.clearedblocking {
+		edges = append(edges, user.EdgeBlocking)
+	}
+	if m.clearedblocked_by {
+		edges = append(edges, user.EdgeBlockedBy)
+	}
+	if m.cleareddaily_tasks {
+		edges = append(edges, user.EdgeDailyTasks)
+	}
+	if m.cleareddevice_tokens {
+		edges = append(edges, user.EdgeDeviceTokens)
+	}
+	if m.clearedreports_filed {
+		edges = append(edges, user.EdgeReportsFiled)
+	}
+	if m.clearedreports_received {
+		edges = append(edges, user.EdgeReportsReceived)
+	}
+	if m.clearedreports_resolved {
+		edges = append(edges, user.EdgeReportsResolved)
+	}
+	if m.clearedsuspensions {
+		edges = append(edges, user.EdgeSuspensions)
+	}
+	if m.clearedsuspensions_issued {
+		edges = append(edges, user.EdgeSuspensionsIssued)
+	}
+	if m.clearedmuting {
+		edges = append(edges, user.EdgeMuting)
+	}
+	if m.clearedmuted_by {
+		edges = append(edges, user.EdgeMutedBy)
+	}
+	if m.clearedmuted_keywords {
+		edges = append(edges, user.EdgeMutedKeywords)
+	}
+	if m.clearedfollow_requests_sent {
+		edges = append(edges, user.EdgeFollowRequestsSent)
+	}
+	if m.clearedfollow_requests_received {
+		edges = append(edges, user.EdgeFollowRequestsReceived)
+	}
+	if m.clearedmentioned_in_posts {
+		edges = append(edges, user.EdgeMentionedInPosts)
+	}
+	if m.clearedmentioned_in_comments {
+		edges = append(edges, user.EdgeMentionedInComments)
+	}
+	if m.clearednotifications {
+		edges = append(edges, user.EdgeNotifications)
+	}
+	if m.clearednotifications_sent {
+		edges = append(edges, user.EdgeNotificationsSent)
+	}
+	if m.clearedcomment_likes {
+		edges = append(edges, user.EdgeCommentLikes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgePosts:
+		return m.clearedposts
+	case user.EdgeComments:
+		return m.clearedcomments
+	case user.EdgeLikes:
+		return m.clearedlikes
+	case user.EdgePets:
+		return m.clearedpets
+	case user.EdgeFollowing:
+		return m.clearedfollowing
+	case user.EdgeFollowers:
+		return m.clearedfollowers
+	case user.EdgeBlocking:
+		return m.clearedblocking
+	case user.EdgeBlockedBy:
+		return m.clearedblocked_by
+	case user.EdgeDailyTasks:
+		return m.cleareddaily_tasks
+	case user.EdgeDeviceTokens:
+		return m.cleareddevice_tokens
+	case user.EdgeReportsFiled:
+		return m.clearedreports_filed
+	case user.EdgeReportsReceived:
+		return m.clearedreports_received
+	case user.EdgeReportsResolved:
+		return m.clearedreports_resolved
+	case user.EdgeSuspensions:
+		return m.clearedsuspensions
+	case user.EdgeSuspensionsIssued:
+		return m.clearedsuspensions_issued
+	case user.EdgeMuting:
+		return m.clearedmuting
+	case user.EdgeMutedBy:
+		return m.clearedmuted_by
+	case user.EdgeMutedKeywords:
+		return m.clearedmuted_keywords
+	case user.EdgeFollowRequestsSent:
+		return m.clearedfollow_requests_sent
+	case user.EdgeFollowRequestsReceived:
+		return m.clearedfollow_requests_received
+	case user.EdgeMentionedInPosts:
+		return m.clearedmentioned_in_posts
+	case user.EdgeMentionedInComments:
+		return m.clearedmentioned_in_comments
+	case user.EdgeNotifications:
+		return m.clearednotifications
+	case user.EdgeNotificationsSent:
+		return m.clearednotifications_sent
+	case user.EdgeCommentLikes:
+		return m.clearedcomment_likes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgePosts:
+		m.ResetPosts()
+		return nil
+	case user.EdgeComments:
+		m.ResetComments()
+		return nil
+	case user.EdgeLikes:
+		m.ResetLikes()
+		return nil
+	case user.EdgePets:
+		m.ResetPets()
+		return nil
+	case user.EdgeFollowing:
+		m.ResetFollowing()
+		return nil
+	case user.EdgeFollowers:
+		m.ResetFollowers()
+		return nil
 	case user.EdgeBlocking:
-		ids := make([]ent.Value, 0, len(m.removedblocking))
-		for id := range m.removedblocking {
-			ids = append(ids, id)
-		}
-		return ids
+		m.ResetBlocking()
+		return nil
 	case user.EdgeBlockedBy:
-		ids := make([]ent.Value, 0, len(m.removedblocked_by))
-		for id := range m.removedblocked_by {
-			ids = append(ids, id)
-		}
-		return ids
+		m.ResetBlockedBy()
+		return nil
 	case user.EdgeDailyTasks:
-		ids := make([]ent.Value, 0, len(m.removeddaily_tasks))
-		for id := range m.removeddaily_tasks {
-			ids = append(ids, id)
-		}
-		return ids
+		m.ResetDailyTasks()
+		return nil
 	case user.EdgeDeviceTokens:
-		ids := make([]ent.Value, 0, len(m.removeddevice_tokens))
-		for id := range m.removeddevice_tokens {
-			ids = append(ids, id)
+		m.ResetDeviceTokens()
+		return nil
+	case user.EdgeReportsFiled:
+		m.ResetReportsFiled()
+		return nil
+	case user.EdgeReportsReceived:
+		m.ResetReportsReceived()
+		return nil
+	case user.EdgeReportsResolved:
+		m.ResetReportsResolved()
+		return nil
+	case user.EdgeSuspensions:
+		m.ResetSuspensions()
+		return nil
+	case user.EdgeSuspensionsIssued:
+		m.ResetSuspensionsIssued()
+		return nil
+	case user.EdgeMuting:
+		m.ResetMuting()
+		return nil
+	case user.EdgeMutedBy:
+		m.ResetMutedBy()
+		return nil
+	case user.EdgeMutedKeywords:
+		m.ResetMutedKeywords()
+		return nil
+	case user.EdgeFollowRequestsSent:
+		m.ResetFollowRequestsSent()
+		return nil
+	case user.EdgeFollowRequestsReceived:
+		m.ResetFollowRequestsReceived()
+		return nil
+	case user.EdgeMentionedInPosts:
+		m.ResetMentionedInPosts()
+		return nil
+	case user.EdgeMentionedInComments:
+		m.ResetMentionedInComments()
+		return nil
+	case user.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case user.EdgeNotificationsSent:
+		m.ResetNotificationsSent()
+		return nil
+	case user.EdgeCommentLikes:
+		m.ResetCommentLikes()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// VerificationCodeMutation represents an operation that mutates the VerificationCode nodes in the graph.
+type VerificationCodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	email         *string
+	purpose       *verificationcode.Purpose
+	code_hash     *string
+	attempts      *int
+	addattempts   *int
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*VerificationCode, error)
+	predicates    []predicate.VerificationCode
+}
+
+var _ ent.Mutation = (*VerificationCodeMutation)(nil)
+
+// verificationcodeOption allows management of the mutation configuration using functional options.
+type verificationcodeOption func(*VerificationCodeMutation)
+
+// newVerificationCodeMutation creates new mutation for the VerificationCode entity.
+func newVerificationCodeMutation(c config, op Op, opts ...verificationcodeOption) *VerificationCodeMutation {
+	m := &VerificationCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVerificationCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVerificationCodeID sets the ID field of the mutation.
+func withVerificationCodeID(id uuid.UUID) verificationcodeOption {
+	return func(m *VerificationCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VerificationCode
+		)
+		m.oldValue = func(ctx context.Context) (*VerificationCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VerificationCode.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		return ids
+		m.id = &id
+	}
+}
+
+// withVerificationCode sets the old VerificationCode of the mutation.
+func withVerificationCode(node *VerificationCode) verificationcodeOption {
+	return func(m *VerificationCodeMutation) {
+		m.oldValue = func(context.Context) (*VerificationCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VerificationCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VerificationCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of VerificationCode entities.
+func (m *VerificationCodeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VerificationCodeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VerificationCodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VerificationCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *VerificationCodeMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *VerificationCodeMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *VerificationCodeMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPurpose sets the "purpose" field.
+func (m *VerificationCodeMutation) SetPurpose(v verificationcode.Purpose) {
+	m.purpose = &v
+}
+
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *VerificationCodeMutation) Purpose() (r verificationcode.Purpose, exists bool) {
+	v := m.purpose
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurpose returns the old "purpose" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldPurpose(ctx context.Context) (v verificationcode.Purpose, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+	}
+	return oldValue.Purpose, nil
+}
+
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *VerificationCodeMutation) ResetPurpose() {
+	m.purpose = nil
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (m *VerificationCodeMutation) SetCodeHash(s string) {
+	m.code_hash = &s
+}
+
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *VerificationCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "code_hash" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *VerificationCodeMutation) ResetCodeHash() {
+	m.code_hash = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *VerificationCodeMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *VerificationCodeMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *VerificationCodeMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *VerificationCodeMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *VerificationCodeMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *VerificationCodeMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *VerificationCodeMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 10)
-	if m.clearedposts {
-		edges = append(edges, user.EdgePosts)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *VerificationCodeMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VerificationCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VerificationCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.clearedcomments {
-		edges = append(edges, user.EdgeComments)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the VerificationCode entity.
+// If the VerificationCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VerificationCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.clearedlikes {
-		edges = append(edges, user.EdgeLikes)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.clearedpets {
-		edges = append(edges, user.EdgePets)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.clearedfollowing {
-		edges = append(edges, user.EdgeFollowing)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VerificationCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the VerificationCodeMutation builder.
+func (m *VerificationCodeMutation) Where(ps ...predicate.VerificationCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VerificationCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VerificationCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VerificationCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.clearedfollowers {
-		edges = append(edges, user.EdgeFollowers)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VerificationCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VerificationCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VerificationCode).
+func (m *VerificationCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VerificationCodeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.email != nil {
+		fields = append(fields, verificationcode.FieldEmail)
 	}
-	if m.clearedblocking {
-		edges = append(edges, user.EdgeBlocking)
+	if m.purpose != nil {
+		fields = append(fields, verificationcode.FieldPurpose)
 	}
-	if m.clearedblocked_by {
-		edges = append(edges, user.EdgeBlockedBy)
+	if m.code_hash != nil {
+		fields = append(fields, verificationcode.FieldCodeHash)
 	}
-	if m.cleareddaily_tasks {
-		edges = append(edges, user.EdgeDailyTasks)
+	if m.attempts != nil {
+		fields = append(fields, verificationcode.FieldAttempts)
 	}
-	if m.cleareddevice_tokens {
-		edges = append(edges, user.EdgeDeviceTokens)
+	if m.expires_at != nil {
+		fields = append(fields, verificationcode.FieldExpiresAt)
 	}
-	return edges
+	if m.created_at != nil {
+		fields = append(fields, verificationcode.FieldCreatedAt)
+	}
+	return fields
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VerificationCodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.EdgePosts:
-		return m.clearedposts
-	case user.EdgeComments:
-		return m.clearedcomments
-	case user.EdgeLikes:
-		return m.clearedlikes
-	case user.EdgePets:
-		return m.clearedpets
-	case user.EdgeFollowing:
-		return m.clearedfollowing
-	case user.EdgeFollowers:
-		return m.clearedfollowers
-	case user.EdgeBlocking:
-		return m.clearedblocking
-	case user.EdgeBlockedBy:
-		return m.clearedblocked_by
-	case user.EdgeDailyTasks:
-		return m.cleareddaily_tasks
-	case user.EdgeDeviceTokens:
-		return m.cleareddevice_tokens
+	case verificationcode.FieldEmail:
+		return m.Email()
+	case verificationcode.FieldPurpose:
+		return m.Purpose()
+	case verificationcode.FieldCodeHash:
+		return m.CodeHash()
+	case verificationcode.FieldAttempts:
+		return m.Attempts()
+	case verificationcode.FieldExpiresAt:
+		return m.ExpiresAt()
+	case verificationcode.FieldCreatedAt:
+		return m.CreatedAt()
 	}
-	return false
+	return nil, false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VerificationCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case verificationcode.FieldEmail:
+		return m.OldEmail(ctx)
+	case verificationcode.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case verificationcode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case verificationcode.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case verificationcode.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case verificationcode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return nil, fmt.Errorf("unknown VerificationCode field %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VerificationCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.EdgePosts:
-		m.ResetPosts()
+	case verificationcode.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
 		return nil
-	case user.EdgeComments:
-		m.ResetComments()
+	case verificationcode.FieldPurpose:
+		v, ok := value.(verificationcode.Purpose)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
 		return nil
-	case user.EdgeLikes:
-		m.ResetLikes()
+	case verificationcode.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
 		return nil
-	case user.EdgePets:
-		m.ResetPets()
+	case verificationcode.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
 		return nil
-	case user.EdgeFollowing:
-		m.ResetFollowing()
+	case verificationcode.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
 		return nil
-	case user.EdgeFollowers:
-		m.ResetFollowers()
+	case verificationcode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
-	case user.EdgeBlocking:
-		m.ResetBlocking()
+	}
+	return fmt.Errorf("unknown VerificationCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VerificationCodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, verificationcode.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VerificationCodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case verificationcode.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VerificationCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case verificationcode.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
 		return nil
-	case user.EdgeBlockedBy:
-		m.ResetBlockedBy()
+	}
+	return fmt.Errorf("unknown VerificationCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VerificationCodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VerificationCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VerificationCodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VerificationCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VerificationCodeMutation) ResetField(name string) error {
+	switch name {
+	case verificationcode.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case user.EdgeDailyTasks:
-		m.ResetDailyTasks()
+	case verificationcode.FieldPurpose:
+		m.ResetPurpose()
 		return nil
-	case user.EdgeDeviceTokens:
-		m.ResetDeviceTokens()
+	case verificationcode.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case verificationcode.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case verificationcode.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case verificationcode.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown VerificationCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VerificationCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VerificationCodeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VerificationCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VerificationCodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VerificationCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VerificationCodeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VerificationCodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VerificationCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VerificationCodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VerificationCode edge %s", name)
 }
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MutedKeyword is the model entity for the MutedKeyword schema.
+type MutedKeyword struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Keyword holds the value of the "keyword" field.
+	Keyword string `json:"keyword,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MutedKeywordQuery when eager-loading is set.
+	Edges        MutedKeywordEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MutedKeywordEdges holds the relations/edges for other nodes in the graph.
+type MutedKeywordEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MutedKeywordEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MutedKeyword) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mutedkeyword.FieldKeyword:
+			values[i] = new(sql.NullString)
+		case mutedkeyword.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case mutedkeyword.FieldID, mutedkeyword.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MutedKeyword fields.
+func (mk *MutedKeyword) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mutedkeyword.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mk.ID = *value
+			}
+		case mutedkeyword.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				mk.UserID = *value
+			}
+		case mutedkeyword.FieldKeyword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field keyword", values[i])
+			} else if value.Valid {
+				mk.Keyword = value.String
+			}
+		case mutedkeyword.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mk.CreatedAt = value.Time
+			}
+		default:
+			mk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MutedKeyword.
+// This includes values selected through modifiers, order, etc.
+func (mk *MutedKeyword) Value(name string) (ent.Value, error) {
+	return mk.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the MutedKeyword entity.
+func (mk *MutedKeyword) QueryUser() *UserQuery {
+	return NewMutedKeywordClient(mk.config).QueryUser(mk)
+}
+
+// Update returns a builder for updating this MutedKeyword.
+// Note that you need to call MutedKeyword.Unwrap() before calling this method if this MutedKeyword
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mk *MutedKeyword) Update() *MutedKeywordUpdateOne {
+	return NewMutedKeywordClient(mk.config).UpdateOne(mk)
+}
+
+// Unwrap unwraps the MutedKeyword entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mk *MutedKeyword) Unwrap() *MutedKeyword {
+	_tx, ok := mk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MutedKeyword is not a transactional entity")
+	}
+	mk.config.driver = _tx.drv
+	return mk
+}
+
+// String implements the fmt.Stringer.
+func (mk *MutedKeyword) String() string {
+	var builder strings.Builder
+	builder.WriteString("MutedKeyword(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mk.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", mk.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("keyword=")
+	builder.WriteString(mk.Keyword)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mk.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MutedKeywords is a parsable slice of MutedKeyword.
+type MutedKeywords []*MutedKeyword
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mutedkeyword
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldUserID, v))
+}
+
+// Keyword applies equality check predicate on the "keyword" field. It's identical to KeywordEQ.
+func Keyword(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldKeyword, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// KeywordEQ applies the EQ predicate on the "keyword" field.
+func KeywordEQ(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldKeyword, v))
+}
+
+// KeywordNEQ applies the NEQ predicate on the "keyword" field.
+func KeywordNEQ(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNEQ(FieldKeyword, v))
+}
+
+// KeywordIn applies the In predicate on the "keyword" field.
+func KeywordIn(vs ...string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldIn(FieldKeyword, vs...))
+}
+
+// KeywordNotIn applies the NotIn predicate on the "keyword" field.
+func KeywordNotIn(vs ...string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNotIn(FieldKeyword, vs...))
+}
+
+// KeywordGT applies the GT predicate on the "keyword" field.
+func KeywordGT(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldGT(FieldKeyword, v))
+}
+
+// KeywordGTE applies the GTE predicate on the "keyword" field.
+func KeywordGTE(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldGTE(FieldKeyword, v))
+}
+
+// KeywordLT applies the LT predicate on the "keyword" field.
+func KeywordLT(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldLT(FieldKeyword, v))
+}
+
+// KeywordLTE applies the LTE predicate on the "keyword" field.
+func KeywordLTE(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldLTE(FieldKeyword, v))
+}
+
+// KeywordContains applies the Contains predicate on the "keyword" field.
+func KeywordContains(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldContains(FieldKeyword, v))
+}
+
+// KeywordHasPrefix applies the HasPrefix predicate on the "keyword" field.
+func KeywordHasPrefix(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldHasPrefix(FieldKeyword, v))
+}
+
+// KeywordHasSuffix applies the HasSuffix predicate on the "keyword" field.
+func KeywordHasSuffix(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldHasSuffix(FieldKeyword, v))
+}
+
+// KeywordEqualFold applies the EqualFold predicate on the "keyword" field.
+func KeywordEqualFold(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEqualFold(FieldKeyword, v))
+}
+
+// KeywordContainsFold applies the ContainsFold predicate on the "keyword" field.
+func KeywordContainsFold(v string) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldContainsFold(FieldKeyword, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.MutedKeyword {
+	return predicate.MutedKeyword(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.MutedKeyword {
+	return predicate.MutedKeyword(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MutedKeyword) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MutedKeyword) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MutedKeyword) predicate.MutedKeyword {
+	return predicate.MutedKeyword(sql.NotPredicates(p))
+}
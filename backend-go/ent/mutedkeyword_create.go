@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MutedKeywordCreate is the builder for creating a MutedKeyword entity.
+type MutedKeywordCreate struct {
+	config
+	mutation *MutedKeywordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (mkc *MutedKeywordCreate) SetUserID(u uuid.UUID) *MutedKeywordCreate {
+	mkc.mutation.SetUserID(u)
+	return mkc
+}
+
+// SetKeyword sets the "keyword" field.
+func (mkc *MutedKeywordCreate) SetKeyword(s string) *MutedKeywordCreate {
+	mkc.mutation.SetKeyword(s)
+	return mkc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mkc *MutedKeywordCreate) SetCreatedAt(t time.Time) *MutedKeywordCreate {
+	mkc.mutation.SetCreatedAt(t)
+	return mkc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mkc *MutedKeywordCreate) SetNillableCreatedAt(t *time.Time) *MutedKeywordCreate {
+	if t != nil {
+		mkc.SetCreatedAt(*t)
+	}
+	return mkc
+}
+
+// SetID sets the "id" field.
+func (mkc *MutedKeywordCreate) SetID(u uuid.UUID) *MutedKeywordCreate {
+	mkc.mutation.SetID(u)
+	return mkc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mkc *MutedKeywordCreate) SetNillableID(u *uuid.UUID) *MutedKeywordCreate {
+	if u != nil {
+		mkc.SetID(*u)
+	}
+	return mkc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mkc *MutedKeywordCreate) SetUser(u *User) *MutedKeywordCreate {
+	return mkc.SetUserID(u.ID)
+}
+
+// Mutation returns the MutedKeywordMutation object of the builder.
+func (mkc *MutedKeywordCreate) Mutation() *MutedKeywordMutation {
+	return mkc.mutation
+}
+
+// Save creates the MutedKeyword in the database.
+func (mkc *MutedKeywordCreate) Save(ctx context.Context) (*MutedKeyword, error) {
+	mkc.defaults()
+	return withHooks(ctx, mkc.sqlSave, mkc.mutation, mkc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mkc *MutedKeywordCreate) SaveX(ctx context.Context) *MutedKeyword {
+	v, err := mkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mkc *MutedKeywordCreate) Exec(ctx context.Context) error {
+	_, err := mkc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mkc *MutedKeywordCreate) ExecX(ctx context.Context) {
+	if err := mkc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mkc *MutedKeywordCreate) defaults() {
+	if _, ok := mkc.mutation.CreatedAt(); !ok {
+		v := mutedkeyword.DefaultCreatedAt()
+		mkc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mkc.mutation.ID(); !ok {
+		v := mutedkeyword.DefaultID()
+		mkc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mkc *MutedKeywordCreate) check() error {
+	if _, ok := mkc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MutedKeyword.user_id"`)}
+	}
+	if _, ok := mkc.mutation.Keyword(); !ok {
+		return &ValidationError{Name: "keyword", err: errors.New(`ent: missing required field "MutedKeyword.keyword"`)}
+	}
+	if v, ok := mkc.mutation.Keyword(); ok {
+		if err := mutedkeyword.KeywordValidator(v); err != nil {
+			return &ValidationError{Name: "keyword", err: fmt.Errorf(`ent: validator failed for field "MutedKeyword.keyword": %w`, err)}
+		}
+	}
+	if _, ok := mkc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MutedKeyword.created_at"`)}
+	}
+	if len(mkc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "MutedKeyword.user"`)}
+	}
+	return nil
+}
+
+func (mkc *MutedKeywordCreate) sqlSave(ctx context.Context) (*MutedKeyword, error) {
+	if err := mkc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mkc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mkc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mkc.mutation.id = &_node.ID
+	mkc.mutation.done = true
+	return _node, nil
+}
+
+func (mkc *MutedKeywordCreate) createSpec() (*MutedKeyword, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MutedKeyword{config: mkc.config}
+		_spec = sqlgraph.NewCreateSpec(mutedkeyword.Table, sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mkc.conflict
+	if id, ok := mkc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mkc.mutation.Keyword(); ok {
+		_spec.SetField(mutedkeyword.FieldKeyword, field.TypeString, value)
+		_node.Keyword = value
+	}
+	if value, ok := mkc.mutation.CreatedAt(); ok {
+		_spec.SetField(mutedkeyword.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mkc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mutedkeyword.UserTable,
+			Columns: []string{mutedkeyword.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MutedKeyword.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MutedKeywordUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (mkc *MutedKeywordCreate) OnConflict(opts ...sql.ConflictOption) *MutedKeywordUpsertOne {
+	mkc.conflict = opts
+	return &MutedKeywordUpsertOne{
+		create: mkc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MutedKeyword.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mkc *MutedKeywordCreate) OnConflictColumns(columns ...string) *MutedKeywordUpsertOne {
+	mkc.conflict = append(mkc.conflict, sql.ConflictColumns(columns...))
+	return &MutedKeywordUpsertOne{
+		create: mkc,
+	}
+}
+
+type (
+	// MutedKeywordUpsertOne is the builder for "upsert"-ing
+	//  one MutedKeyword node.
+	MutedKeywordUpsertOne struct {
+		create *MutedKeywordCreate
+	}
+
+	// MutedKeywordUpsert is the "OnConflict" setter.
+	MutedKeywordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *MutedKeywordUpsert) SetUserID(v uuid.UUID) *MutedKeywordUpsert {
+	u.Set(mutedkeyword.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MutedKeywordUpsert) UpdateUserID() *MutedKeywordUpsert {
+	u.SetExcluded(mutedkeyword.FieldUserID)
+	return u
+}
+
+// SetKeyword sets the "keyword" field.
+func (u *MutedKeywordUpsert) SetKeyword(v string) *MutedKeywordUpsert {
+	u.Set(mutedkeyword.FieldKeyword, v)
+	return u
+}
+
+// UpdateKeyword sets the "keyword" field to the value that was provided on create.
+func (u *MutedKeywordUpsert) UpdateKeyword() *MutedKeywordUpsert {
+	u.SetExcluded(mutedkeyword.FieldKeyword)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MutedKeywordUpsert) SetCreatedAt(v time.Time) *MutedKeywordUpsert {
+	u.Set(mutedkeyword.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MutedKeywordUpsert) UpdateCreatedAt() *MutedKeywordUpsert {
+	u.SetExcluded(mutedkeyword.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MutedKeyword.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(mutedkeyword.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MutedKeywordUpsertOne) UpdateNewValues() *MutedKeywordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(mutedkeyword.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MutedKeyword.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MutedKeywordUpsertOne) Ignore() *MutedKeywordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MutedKeywordUpsertOne) DoNothing() *MutedKeywordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MutedKeywordCreate.OnConflict
+// documentation for more info.
+func (u *MutedKeywordUpsertOne) Update(set func(*MutedKeywordUpsert)) *MutedKeywordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MutedKeywordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MutedKeywordUpsertOne) SetUserID(v uuid.UUID) *MutedKeywordUpsertOne {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MutedKeywordUpsertOne) UpdateUserID() *MutedKeywordUpsertOne {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetKeyword sets the "keyword" field.
+func (u *MutedKeywordUpsertOne) SetKeyword(v string) *MutedKeywordUpsertOne {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.SetKeyword(v)
+	})
+}
+
+// UpdateKeyword sets the "keyword" field to the value that was provided on create.
+func (u *MutedKeywordUpsertOne) UpdateKeyword() *MutedKeywordUpsertOne {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.UpdateKeyword()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MutedKeywordUpsertOne) SetCreatedAt(v time.Time) *MutedKeywordUpsertOne {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MutedKeywordUpsertOne) UpdateCreatedAt() *MutedKeywordUpsertOne {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MutedKeywordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MutedKeywordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MutedKeywordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MutedKeywordUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MutedKeywordUpsertOne.ID is not supported by MySQL driver. Use MutedKeywordUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MutedKeywordUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MutedKeywordCreateBulk is the builder for creating many MutedKeyword entities in bulk.
+type MutedKeywordCreateBulk struct {
+	config
+	err      error
+	builders []*MutedKeywordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MutedKeyword entities in the database.
+func (mkcb *MutedKeywordCreateBulk) Save(ctx context.Context) ([]*MutedKeyword, error) {
+	if mkcb.err != nil {
+		return nil, mkcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mkcb.builders))
+	nodes := make([]*MutedKeyword, len(mkcb.builders))
+	mutators := make([]Mutator, len(mkcb.builders))
+	for i := range mkcb.builders {
+		func(i int, root context.Context) {
+			builder := mkcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MutedKeywordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mkcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mkcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mkcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mkcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mkcb *MutedKeywordCreateBulk) SaveX(ctx context.Context) []*MutedKeyword {
+	v, err := mkcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mkcb *MutedKeywordCreateBulk) Exec(ctx context.Context) error {
+	_, err := mkcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mkcb *MutedKeywordCreateBulk) ExecX(ctx context.Context) {
+	if err := mkcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MutedKeyword.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MutedKeywordUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (mkcb *MutedKeywordCreateBulk) OnConflict(opts ...sql.ConflictOption) *MutedKeywordUpsertBulk {
+	mkcb.conflict = opts
+	return &MutedKeywordUpsertBulk{
+		create: mkcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MutedKeyword.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mkcb *MutedKeywordCreateBulk) OnConflictColumns(columns ...string) *MutedKeywordUpsertBulk {
+	mkcb.conflict = append(mkcb.conflict, sql.ConflictColumns(columns...))
+	return &MutedKeywordUpsertBulk{
+		create: mkcb,
+	}
+}
+
+// MutedKeywordUpsertBulk is the builder for "upsert"-ing
+// a bulk of MutedKeyword nodes.
+type MutedKeywordUpsertBulk struct {
+	create *MutedKeywordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MutedKeyword.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(mutedkeyword.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MutedKeywordUpsertBulk) UpdateNewValues() *MutedKeywordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(mutedkeyword.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MutedKeyword.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MutedKeywordUpsertBulk) Ignore() *MutedKeywordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MutedKeywordUpsertBulk) DoNothing() *MutedKeywordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MutedKeywordCreateBulk.OnConflict
+// documentation for more info.
+func (u *MutedKeywordUpsertBulk) Update(set func(*MutedKeywordUpsert)) *MutedKeywordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MutedKeywordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MutedKeywordUpsertBulk) SetUserID(v uuid.UUID) *MutedKeywordUpsertBulk {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MutedKeywordUpsertBulk) UpdateUserID() *MutedKeywordUpsertBulk {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetKeyword sets the "keyword" field.
+func (u *MutedKeywordUpsertBulk) SetKeyword(v string) *MutedKeywordUpsertBulk {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.SetKeyword(v)
+	})
+}
+
+// UpdateKeyword sets the "keyword" field to the value that was provided on create.
+func (u *MutedKeywordUpsertBulk) UpdateKeyword() *MutedKeywordUpsertBulk {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.UpdateKeyword()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MutedKeywordUpsertBulk) SetCreatedAt(v time.Time) *MutedKeywordUpsertBulk {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MutedKeywordUpsertBulk) UpdateCreatedAt() *MutedKeywordUpsertBulk {
+	return u.Update(func(s *MutedKeywordUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MutedKeywordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MutedKeywordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MutedKeywordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MutedKeywordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
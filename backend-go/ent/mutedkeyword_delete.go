@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// MutedKeywordDelete is the builder for deleting a MutedKeyword entity.
+type MutedKeywordDelete struct {
+	config
+	hooks    []Hook
+	mutation *MutedKeywordMutation
+}
+
+// Where appends a list predicates to the MutedKeywordDelete builder.
+func (mkd *MutedKeywordDelete) Where(ps ...predicate.MutedKeyword) *MutedKeywordDelete {
+	mkd.mutation.Where(ps...)
+	return mkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mkd *MutedKeywordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mkd.sqlExec, mkd.mutation, mkd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mkd *MutedKeywordDelete) ExecX(ctx context.Context) int {
+	n, err := mkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mkd *MutedKeywordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mutedkeyword.Table, sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID))
+	if ps := mkd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mkd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mkd.mutation.done = true
+	return affected, err
+}
+
+// MutedKeywordDeleteOne is the builder for deleting a single MutedKeyword entity.
+type MutedKeywordDeleteOne struct {
+	mkd *MutedKeywordDelete
+}
+
+// Where appends a list predicates to the MutedKeywordDelete builder.
+func (mkdo *MutedKeywordDeleteOne) Where(ps ...predicate.MutedKeyword) *MutedKeywordDeleteOne {
+	mkdo.mkd.mutation.Where(ps...)
+	return mkdo
+}
+
+// Exec executes the deletion query.
+func (mkdo *MutedKeywordDeleteOne) Exec(ctx context.Context) error {
+	n, err := mkdo.mkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mutedkeyword.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mkdo *MutedKeywordDeleteOne) ExecX(ctx context.Context) {
+	if err := mkdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MutedKeywordQuery is the builder for querying MutedKeyword entities.
+type MutedKeywordQuery struct {
+	config
+	ctx        *QueryContext
+	order      []mutedkeyword.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MutedKeyword
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MutedKeywordQuery builder.
+func (mkq *MutedKeywordQuery) Where(ps ...predicate.MutedKeyword) *MutedKeywordQuery {
+	mkq.predicates = append(mkq.predicates, ps...)
+	return mkq
+}
+
+// Limit the number of records to be returned by this query.
+func (mkq *MutedKeywordQuery) Limit(limit int) *MutedKeywordQuery {
+	mkq.ctx.Limit = &limit
+	return mkq
+}
+
+// Offset to start from.
+func (mkq *MutedKeywordQuery) Offset(offset int) *MutedKeywordQuery {
+	mkq.ctx.Offset = &offset
+	return mkq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mkq *MutedKeywordQuery) Unique(unique bool) *MutedKeywordQuery {
+	mkq.ctx.Unique = &unique
+	return mkq
+}
+
+// Order specifies how the records should be ordered.
+func (mkq *MutedKeywordQuery) Order(o ...mutedkeyword.OrderOption) *MutedKeywordQuery {
+	mkq.order = append(mkq.order, o...)
+	return mkq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (mkq *MutedKeywordQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: mkq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mkq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mutedkeyword.Table, mutedkeyword.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mutedkeyword.UserTable, mutedkeyword.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MutedKeyword entity from the query.
+// Returns a *NotFoundError when no MutedKeyword was found.
+func (mkq *MutedKeywordQuery) First(ctx context.Context) (*MutedKeyword, error) {
+	nodes, err := mkq.Limit(1).All(setContextOp(ctx, mkq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mutedkeyword.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) FirstX(ctx context.Context) *MutedKeyword {
+	node, err := mkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MutedKeyword ID from the query.
+// Returns a *NotFoundError when no MutedKeyword ID was found.
+func (mkq *MutedKeywordQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mkq.Limit(1).IDs(setContextOp(ctx, mkq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mutedkeyword.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MutedKeyword entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MutedKeyword entity is found.
+// Returns a *NotFoundError when no MutedKeyword entities are found.
+func (mkq *MutedKeywordQuery) Only(ctx context.Context) (*MutedKeyword, error) {
+	nodes, err := mkq.Limit(2).All(setContextOp(ctx, mkq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mutedkeyword.Label}
+	default:
+		return nil, &NotSingularError{mutedkeyword.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) OnlyX(ctx context.Context) *MutedKeyword {
+	node, err := mkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MutedKeyword ID in the query.
+// Returns a *NotSingularError when more than one MutedKeyword ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mkq *MutedKeywordQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mkq.Limit(2).IDs(setContextOp(ctx, mkq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mutedkeyword.Label}
+	default:
+		err = &NotSingularError{mutedkeyword.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MutedKeywords.
+func (mkq *MutedKeywordQuery) All(ctx context.Context) ([]*MutedKeyword, error) {
+	ctx = setContextOp(ctx, mkq.ctx, ent.OpQueryAll)
+	if err := mkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MutedKeyword, *MutedKeywordQuery]()
+	return withInterceptors[[]*MutedKeyword](ctx, mkq, qr, mkq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) AllX(ctx context.Context) []*MutedKeyword {
+	nodes, err := mkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MutedKeyword IDs.
+func (mkq *MutedKeywordQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mkq.ctx.Unique == nil && mkq.path != nil {
+		mkq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mkq.ctx, ent.OpQueryIDs)
+	if err = mkq.Select(mutedkeyword.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mkq *MutedKeywordQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mkq.ctx, ent.OpQueryCount)
+	if err := mkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mkq, querierCount[*MutedKeywordQuery](), mkq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) CountX(ctx context.Context) int {
+	count, err := mkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mkq *MutedKeywordQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mkq.ctx, ent.OpQueryExist)
+	switch _, err := mkq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mkq *MutedKeywordQuery) ExistX(ctx context.Context) bool {
+	exist, err := mkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MutedKeywordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mkq *MutedKeywordQuery) Clone() *MutedKeywordQuery {
+	if mkq == nil {
+		return nil
+	}
+	return &MutedKeywordQuery{
+		config:     mkq.config,
+		ctx:        mkq.ctx.Clone(),
+		order:      append([]mutedkeyword.OrderOption{}, mkq.order...),
+		inters:     append([]Interceptor{}, mkq.inters...),
+		predicates: append([]predicate.MutedKeyword{}, mkq.predicates...),
+		withUser:   mkq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  mkq.sql.Clone(),
+		path: mkq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (mkq *MutedKeywordQuery) WithUser(opts ...func(*UserQuery)) *MutedKeywordQuery {
+	query := (&UserClient{config: mkq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mkq.withUser = query
+	return mkq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MutedKeyword.Query().
+//		GroupBy(mutedkeyword.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mkq *MutedKeywordQuery) GroupBy(field string, fields ...string) *MutedKeywordGroupBy {
+	mkq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MutedKeywordGroupBy{build: mkq}
+	grbuild.flds = &mkq.ctx.Fields
+	grbuild.label = mutedkeyword.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.MutedKeyword.Query().
+//		Select(mutedkeyword.FieldUserID).
+//		Scan(ctx, &v)
+func (mkq *MutedKeywordQuery) Select(fields ...string) *MutedKeywordSelect {
+	mkq.ctx.Fields = append(mkq.ctx.Fields, fields...)
+	sbuild := &MutedKeywordSelect{MutedKeywordQuery: mkq}
+	sbuild.label = mutedkeyword.Label
+	sbuild.flds, sbuild.scan = &mkq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MutedKeywordSelect configured with the given aggregations.
+func (mkq *MutedKeywordQuery) Aggregate(fns ...AggregateFunc) *MutedKeywordSelect {
+	return mkq.Select().Aggregate(fns...)
+}
+
+func (mkq *MutedKeywordQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mkq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mkq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mkq.ctx.Fields {
+		if !mutedkeyword.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mkq.path != nil {
+		prev, err := mkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mkq.sql = prev
+	}
+	return nil
+}
+
+func (mkq *MutedKeywordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MutedKeyword, error) {
+	var (
+		nodes       = []*MutedKeyword{}
+		_spec       = mkq.querySpec()
+		loadedTypes = [1]bool{
+			mkq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MutedKeyword).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MutedKeyword{config: mkq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mkq.withUser; query != nil {
+		if err := mkq.loadUser(ctx, query, nodes, nil,
+			func(n *MutedKeyword, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mkq *MutedKeywordQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*MutedKeyword, init func(*MutedKeyword), assign func(*MutedKeyword, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*MutedKeyword)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mkq *MutedKeywordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mkq.querySpec()
+	_spec.Node.Columns = mkq.ctx.Fields
+	if len(mkq.ctx.Fields) > 0 {
+		_spec.Unique = mkq.ctx.Unique != nil && *mkq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mkq.driver, _spec)
+}
+
+func (mkq *MutedKeywordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mutedkeyword.Table, mutedkeyword.Columns, sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID))
+	_spec.From = mkq.sql
+	if unique := mkq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mkq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mkq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mutedkeyword.FieldID)
+		for i := range fields {
+			if fields[i] != mutedkeyword.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mkq.withUser != nil {
+			_spec.Node.AddColumnOnce(mutedkeyword.FieldUserID)
+		}
+	}
+	if ps := mkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mkq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mkq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mkq *MutedKeywordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mkq.driver.Dialect())
+	t1 := builder.Table(mutedkeyword.Table)
+	columns := mkq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mutedkeyword.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mkq.sql != nil {
+		selector = mkq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mkq.ctx.Unique != nil && *mkq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mkq.predicates {
+		p(selector)
+	}
+	for _, p := range mkq.order {
+		p(selector)
+	}
+	if offset := mkq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mkq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MutedKeywordGroupBy is the group-by builder for MutedKeyword entities.
+type MutedKeywordGroupBy struct {
+	selector
+	build *MutedKeywordQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mkgb *MutedKeywordGroupBy) Aggregate(fns ...AggregateFunc) *MutedKeywordGroupBy {
+	mkgb.fns = append(mkgb.fns, fns...)
+	return mkgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mkgb *MutedKeywordGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mkgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mkgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MutedKeywordQuery, *MutedKeywordGroupBy](ctx, mkgb.build, mkgb, mkgb.build.inters, v)
+}
+
+func (mkgb *MutedKeywordGroupBy) sqlScan(ctx context.Context, root *MutedKeywordQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mkgb.fns))
+	for _, fn := range mkgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mkgb.flds)+len(mkgb.fns))
+		for _, f := range *mkgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mkgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mkgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MutedKeywordSelect is the builder for selecting fields of MutedKeyword entities.
+type MutedKeywordSelect struct {
+	*MutedKeywordQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mks *MutedKeywordSelect) Aggregate(fns ...AggregateFunc) *MutedKeywordSelect {
+	mks.fns = append(mks.fns, fns...)
+	return mks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mks *MutedKeywordSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mks.ctx, ent.OpQuerySelect)
+	if err := mks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MutedKeywordQuery, *MutedKeywordSelect](ctx, mks.MutedKeywordQuery, mks, mks.inters, v)
+}
+
+func (mks *MutedKeywordSelect) sqlScan(ctx context.Context, root *MutedKeywordQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mks.fns))
+	for _, fn := range mks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MutedKeywordUpdate is the builder for updating MutedKeyword entities.
+type MutedKeywordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MutedKeywordMutation
+}
+
+// Where appends a list predicates to the MutedKeywordUpdate builder.
+func (mku *MutedKeywordUpdate) Where(ps ...predicate.MutedKeyword) *MutedKeywordUpdate {
+	mku.mutation.Where(ps...)
+	return mku
+}
+
+// SetUserID sets the "user_id" field.
+func (mku *MutedKeywordUpdate) SetUserID(u uuid.UUID) *MutedKeywordUpdate {
+	mku.mutation.SetUserID(u)
+	return mku
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mku *MutedKeywordUpdate) SetNillableUserID(u *uuid.UUID) *MutedKeywordUpdate {
+	if u != nil {
+		mku.SetUserID(*u)
+	}
+	return mku
+}
+
+// SetKeyword sets the "keyword" field.
+func (mku *MutedKeywordUpdate) SetKeyword(s string) *MutedKeywordUpdate {
+	mku.mutation.SetKeyword(s)
+	return mku
+}
+
+// SetNillableKeyword sets the "keyword" field if the given value is not nil.
+func (mku *MutedKeywordUpdate) SetNillableKeyword(s *string) *MutedKeywordUpdate {
+	if s != nil {
+		mku.SetKeyword(*s)
+	}
+	return mku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mku *MutedKeywordUpdate) SetCreatedAt(t time.Time) *MutedKeywordUpdate {
+	mku.mutation.SetCreatedAt(t)
+	return mku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mku *MutedKeywordUpdate) SetNillableCreatedAt(t *time.Time) *MutedKeywordUpdate {
+	if t != nil {
+		mku.SetCreatedAt(*t)
+	}
+	return mku
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mku *MutedKeywordUpdate) SetUser(u *User) *MutedKeywordUpdate {
+	return mku.SetUserID(u.ID)
+}
+
+// Mutation returns the MutedKeywordMutation object of the builder.
+func (mku *MutedKeywordUpdate) Mutation() *MutedKeywordMutation {
+	return mku.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mku *MutedKeywordUpdate) ClearUser() *MutedKeywordUpdate {
+	mku.mutation.ClearUser()
+	return mku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mku *MutedKeywordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mku.sqlSave, mku.mutation, mku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mku *MutedKeywordUpdate) SaveX(ctx context.Context) int {
+	affected, err := mku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mku *MutedKeywordUpdate) Exec(ctx context.Context) error {
+	_, err := mku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mku *MutedKeywordUpdate) ExecX(ctx context.Context) {
+	if err := mku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mku *MutedKeywordUpdate) check() error {
+	if v, ok := mku.mutation.Keyword(); ok {
+		if err := mutedkeyword.KeywordValidator(v); err != nil {
+			return &ValidationError{Name: "keyword", err: fmt.Errorf(`ent: validator failed for field "MutedKeyword.keyword": %w`, err)}
+		}
+	}
+	if mku.mutation.UserCleared() && len(mku.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MutedKeyword.user"`)
+	}
+	return nil
+}
+
+func (mku *MutedKeywordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mutedkeyword.Table, mutedkeyword.Columns, sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID))
+	if ps := mku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mku.mutation.Keyword(); ok {
+		_spec.SetField(mutedkeyword.FieldKeyword, field.TypeString, value)
+	}
+	if value, ok := mku.mutation.CreatedAt(); ok {
+		_spec.SetField(mutedkeyword.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mku.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mutedkeyword.UserTable,
+			Columns: []string{mutedkeyword.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mku.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mutedkeyword.UserTable,
+			Columns: []string{mutedkeyword.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mutedkeyword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mku.mutation.done = true
+	return n, nil
+}
+
+// MutedKeywordUpdateOne is the builder for updating a single MutedKeyword entity.
+type MutedKeywordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MutedKeywordMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (mkuo *MutedKeywordUpdateOne) SetUserID(u uuid.UUID) *MutedKeywordUpdateOne {
+	mkuo.mutation.SetUserID(u)
+	return mkuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mkuo *MutedKeywordUpdateOne) SetNillableUserID(u *uuid.UUID) *MutedKeywordUpdateOne {
+	if u != nil {
+		mkuo.SetUserID(*u)
+	}
+	return mkuo
+}
+
+// SetKeyword sets the "keyword" field.
+func (mkuo *MutedKeywordUpdateOne) SetKeyword(s string) *MutedKeywordUpdateOne {
+	mkuo.mutation.SetKeyword(s)
+	return mkuo
+}
+
+// SetNillableKeyword sets the "keyword" field if the given value is not nil.
+func (mkuo *MutedKeywordUpdateOne) SetNillableKeyword(s *string) *MutedKeywordUpdateOne {
+	if s != nil {
+		mkuo.SetKeyword(*s)
+	}
+	return mkuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mkuo *MutedKeywordUpdateOne) SetCreatedAt(t time.Time) *MutedKeywordUpdateOne {
+	mkuo.mutation.SetCreatedAt(t)
+	return mkuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mkuo *MutedKeywordUpdateOne) SetNillableCreatedAt(t *time.Time) *MutedKeywordUpdateOne {
+	if t != nil {
+		mkuo.SetCreatedAt(*t)
+	}
+	return mkuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mkuo *MutedKeywordUpdateOne) SetUser(u *User) *MutedKeywordUpdateOne {
+	return mkuo.SetUserID(u.ID)
+}
+
+// Mutation returns the MutedKeywordMutation object of the builder.
+func (mkuo *MutedKeywordUpdateOne) Mutation() *MutedKeywordMutation {
+	return mkuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mkuo *MutedKeywordUpdateOne) ClearUser() *MutedKeywordUpdateOne {
+	mkuo.mutation.ClearUser()
+	return mkuo
+}
+
+// Where appends a list predicates to the MutedKeywordUpdate builder.
+func (mkuo *MutedKeywordUpdateOne) Where(ps ...predicate.MutedKeyword) *MutedKeywordUpdateOne {
+	mkuo.mutation.Where(ps...)
+	return mkuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mkuo *MutedKeywordUpdateOne) Select(field string, fields ...string) *MutedKeywordUpdateOne {
+	mkuo.fields = append([]string{field}, fields...)
+	return mkuo
+}
+
+// Save executes the query and returns the updated MutedKeyword entity.
+func (mkuo *MutedKeywordUpdateOne) Save(ctx context.Context) (*MutedKeyword, error) {
+	return withHooks(ctx, mkuo.sqlSave, mkuo.mutation, mkuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mkuo *MutedKeywordUpdateOne) SaveX(ctx context.Context) *MutedKeyword {
+	node, err := mkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mkuo *MutedKeywordUpdateOne) Exec(ctx context.Context) error {
+	_, err := mkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mkuo *MutedKeywordUpdateOne) ExecX(ctx context.Context) {
+	if err := mkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mkuo *MutedKeywordUpdateOne) check() error {
+	if v, ok := mkuo.mutation.Keyword(); ok {
+		if err := mutedkeyword.KeywordValidator(v); err != nil {
+			return &ValidationError{Name: "keyword", err: fmt.Errorf(`ent: validator failed for field "MutedKeyword.keyword": %w`, err)}
+		}
+	}
+	if mkuo.mutation.UserCleared() && len(mkuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MutedKeyword.user"`)
+	}
+	return nil
+}
+
+func (mkuo *MutedKeywordUpdateOne) sqlSave(ctx context.Context) (_node *MutedKeyword, err error) {
+	if err := mkuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mutedkeyword.Table, mutedkeyword.Columns, sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID))
+	id, ok := mkuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MutedKeyword.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mkuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mutedkeyword.FieldID)
+		for _, f := range fields {
+			if !mutedkeyword.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mutedkeyword.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mkuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mkuo.mutation.Keyword(); ok {
+		_spec.SetField(mutedkeyword.FieldKeyword, field.TypeString, value)
+	}
+	if value, ok := mkuo.mutation.CreatedAt(); ok {
+		_spec.SetField(mutedkeyword.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mkuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mutedkeyword.UserTable,
+			Columns: []string{mutedkeyword.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mkuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mutedkeyword.UserTable,
+			Columns: []string{mutedkeyword.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MutedKeyword{config: mkuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mutedkeyword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mkuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MuteRelation is the model entity for the MuteRelation schema.
+type MuteRelation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MuteRelationQuery when eager-loading is set.
+	Edges         MuteRelationEdges `json:"edges"`
+	user_muting   *uuid.UUID
+	user_muted_by *uuid.UUID
+	selectValues  sql.SelectValues
+}
+
+// MuteRelationEdges holds the relations/edges for other nodes in the graph.
+type MuteRelationEdges struct {
+	// From holds the value of the from edge.
+	From *User `json:"from,omitempty"`
+	// To holds the value of the to edge.
+	To *User `json:"to,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FromOrErr returns the From value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MuteRelationEdges) FromOrErr() (*User, error) {
+	if e.From != nil {
+		return e.From, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "from"}
+}
+
+// ToOrErr returns the To value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MuteRelationEdges) ToOrErr() (*User, error) {
+	if e.To != nil {
+		return e.To, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "to"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MuteRelation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case muterelation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case muterelation.FieldID:
+			values[i] = new(uuid.UUID)
+		case muterelation.ForeignKeys[0]: // user_muting
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case muterelation.ForeignKeys[1]: // user_muted_by
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MuteRelation fields.
+func (mr *MuteRelation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case muterelation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mr.ID = *value
+			}
+		case muterelation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mr.CreatedAt = value.Time
+			}
+		case muterelation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_muting", values[i])
+			} else if value.Valid {
+				mr.user_muting = new(uuid.UUID)
+				*mr.user_muting = *value.S.(*uuid.UUID)
+			}
+		case muterelation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_muted_by", values[i])
+			} else if value.Valid {
+				mr.user_muted_by = new(uuid.UUID)
+				*mr.user_muted_by = *value.S.(*uuid.UUID)
+			}
+		default:
+			mr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MuteRelation.
+// This includes values selected through modifiers, order, etc.
+func (mr *MuteRelation) Value(name string) (ent.Value, error) {
+	return mr.selectValues.Get(name)
+}
+
+// QueryFrom queries the "from" edge of the MuteRelation entity.
+func (mr *MuteRelation) QueryFrom() *UserQuery {
+	return NewMuteRelationClient(mr.config).QueryFrom(mr)
+}
+
+// QueryTo queries the "to" edge of the MuteRelation entity.
+func (mr *MuteRelation) QueryTo() *UserQuery {
+	return NewMuteRelationClient(mr.config).QueryTo(mr)
+}
+
+// Update returns a builder for updating this MuteRelation.
+// Note that you need to call MuteRelation.Unwrap() before calling this method if this MuteRelation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MuteRelation) Update() *MuteRelationUpdateOne {
+	return NewMuteRelationClient(mr.config).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MuteRelation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MuteRelation) Unwrap() *MuteRelation {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MuteRelation is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MuteRelation) String() string {
+	var builder strings.Builder
+	builder.WriteString("MuteRelation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MuteRelations is a parsable slice of MuteRelation.
+type MuteRelations []*MuteRelation
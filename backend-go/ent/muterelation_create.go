@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MuteRelationCreate is the builder for creating a MuteRelation entity.
+type MuteRelationCreate struct {
+	config
+	mutation *MuteRelationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mrc *MuteRelationCreate) SetCreatedAt(t time.Time) *MuteRelationCreate {
+	mrc.mutation.SetCreatedAt(t)
+	return mrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mrc *MuteRelationCreate) SetNillableCreatedAt(t *time.Time) *MuteRelationCreate {
+	if t != nil {
+		mrc.SetCreatedAt(*t)
+	}
+	return mrc
+}
+
+// SetID sets the "id" field.
+func (mrc *MuteRelationCreate) SetID(u uuid.UUID) *MuteRelationCreate {
+	mrc.mutation.SetID(u)
+	return mrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mrc *MuteRelationCreate) SetNillableID(u *uuid.UUID) *MuteRelationCreate {
+	if u != nil {
+		mrc.SetID(*u)
+	}
+	return mrc
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (mrc *MuteRelationCreate) SetFromID(id uuid.UUID) *MuteRelationCreate {
+	mrc.mutation.SetFromID(id)
+	return mrc
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (mrc *MuteRelationCreate) SetFrom(u *User) *MuteRelationCreate {
+	return mrc.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (mrc *MuteRelationCreate) SetToID(id uuid.UUID) *MuteRelationCreate {
+	mrc.mutation.SetToID(id)
+	return mrc
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (mrc *MuteRelationCreate) SetTo(u *User) *MuteRelationCreate {
+	return mrc.SetToID(u.ID)
+}
+
+// Mutation returns the MuteRelationMutation object of the builder.
+func (mrc *MuteRelationCreate) Mutation() *MuteRelationMutation {
+	return mrc.mutation
+}
+
+// Save creates the MuteRelation in the database.
+func (mrc *MuteRelationCreate) Save(ctx context.Context) (*MuteRelation, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MuteRelationCreate) SaveX(ctx context.Context) *MuteRelation {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MuteRelationCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MuteRelationCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MuteRelationCreate) defaults() {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		v := muterelation.DefaultCreatedAt()
+		mrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mrc.mutation.ID(); !ok {
+		v := muterelation.DefaultID()
+		mrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MuteRelationCreate) check() error {
+	if _, ok := mrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MuteRelation.created_at"`)}
+	}
+	if len(mrc.mutation.FromIDs()) == 0 {
+		return &ValidationError{Name: "from", err: errors.New(`ent: missing required edge "MuteRelation.from"`)}
+	}
+	if len(mrc.mutation.ToIDs()) == 0 {
+		return &ValidationError{Name: "to", err: errors.New(`ent: missing required edge "MuteRelation.to"`)}
+	}
+	return nil
+}
+
+func (mrc *MuteRelationCreate) sqlSave(ctx context.Context) (*MuteRelation, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MuteRelationCreate) createSpec() (*MuteRelation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MuteRelation{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(muterelation.Table, sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mrc.conflict
+	if id, ok := mrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mrc.mutation.CreatedAt(); ok {
+		_spec.SetField(muterelation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mrc.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.FromTable,
+			Columns: []string{muterelation.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_muting = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mrc.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.ToTable,
+			Columns: []string{muterelation.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_muted_by = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MuteRelation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MuteRelationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mrc *MuteRelationCreate) OnConflict(opts ...sql.ConflictOption) *MuteRelationUpsertOne {
+	mrc.conflict = opts
+	return &MuteRelationUpsertOne{
+		create: mrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MuteRelation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrc *MuteRelationCreate) OnConflictColumns(columns ...string) *MuteRelationUpsertOne {
+	mrc.conflict = append(mrc.conflict, sql.ConflictColumns(columns...))
+	return &MuteRelationUpsertOne{
+		create: mrc,
+	}
+}
+
+type (
+	// MuteRelationUpsertOne is the builder for "upsert"-ing
+	//  one MuteRelation node.
+	MuteRelationUpsertOne struct {
+		create *MuteRelationCreate
+	}
+
+	// MuteRelationUpsert is the "OnConflict" setter.
+	MuteRelationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MuteRelationUpsert) SetCreatedAt(v time.Time) *MuteRelationUpsert {
+	u.Set(muterelation.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MuteRelationUpsert) UpdateCreatedAt() *MuteRelationUpsert {
+	u.SetExcluded(muterelation.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MuteRelation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(muterelation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MuteRelationUpsertOne) UpdateNewValues() *MuteRelationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(muterelation.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MuteRelation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MuteRelationUpsertOne) Ignore() *MuteRelationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MuteRelationUpsertOne) DoNothing() *MuteRelationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MuteRelationCreate.OnConflict
+// documentation for more info.
+func (u *MuteRelationUpsertOne) Update(set func(*MuteRelationUpsert)) *MuteRelationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MuteRelationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MuteRelationUpsertOne) SetCreatedAt(v time.Time) *MuteRelationUpsertOne {
+	return u.Update(func(s *MuteRelationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MuteRelationUpsertOne) UpdateCreatedAt() *MuteRelationUpsertOne {
+	return u.Update(func(s *MuteRelationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MuteRelationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MuteRelationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MuteRelationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MuteRelationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MuteRelationUpsertOne.ID is not supported by MySQL driver. Use MuteRelationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MuteRelationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MuteRelationCreateBulk is the builder for creating many MuteRelation entities in bulk.
+type MuteRelationCreateBulk struct {
+	config
+	err      error
+	builders []*MuteRelationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MuteRelation entities in the database.
+func (mrcb *MuteRelationCreateBulk) Save(ctx context.Context) ([]*MuteRelation, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MuteRelation, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MuteRelationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MuteRelationCreateBulk) SaveX(ctx context.Context) []*MuteRelation {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MuteRelationCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MuteRelationCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MuteRelation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MuteRelationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mrcb *MuteRelationCreateBulk) OnConflict(opts ...sql.ConflictOption) *MuteRelationUpsertBulk {
+	mrcb.conflict = opts
+	return &MuteRelationUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MuteRelation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrcb *MuteRelationCreateBulk) OnConflictColumns(columns ...string) *MuteRelationUpsertBulk {
+	mrcb.conflict = append(mrcb.conflict, sql.ConflictColumns(columns...))
+	return &MuteRelationUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// MuteRelationUpsertBulk is the builder for "upsert"-ing
+// a bulk of MuteRelation nodes.
+type MuteRelationUpsertBulk struct {
+	create *MuteRelationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MuteRelation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(muterelation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MuteRelationUpsertBulk) UpdateNewValues() *MuteRelationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(muterelation.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MuteRelation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MuteRelationUpsertBulk) Ignore() *MuteRelationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MuteRelationUpsertBulk) DoNothing() *MuteRelationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MuteRelationCreateBulk.OnConflict
+// documentation for more info.
+func (u *MuteRelationUpsertBulk) Update(set func(*MuteRelationUpsert)) *MuteRelationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MuteRelationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MuteRelationUpsertBulk) SetCreatedAt(v time.Time) *MuteRelationUpsertBulk {
+	return u.Update(func(s *MuteRelationUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MuteRelationUpsertBulk) UpdateCreatedAt() *MuteRelationUpsertBulk {
+	return u.Update(func(s *MuteRelationUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MuteRelationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MuteRelationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MuteRelationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MuteRelationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// MuteRelationUpdate is the builder for updating MuteRelation entities.
+type MuteRelationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MuteRelationMutation
+}
+
+// Where appends a list predicates to the MuteRelationUpdate builder.
+func (mru *MuteRelationUpdate) Where(ps ...predicate.MuteRelation) *MuteRelationUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mru *MuteRelationUpdate) SetCreatedAt(t time.Time) *MuteRelationUpdate {
+	mru.mutation.SetCreatedAt(t)
+	return mru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mru *MuteRelationUpdate) SetNillableCreatedAt(t *time.Time) *MuteRelationUpdate {
+	if t != nil {
+		mru.SetCreatedAt(*t)
+	}
+	return mru
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (mru *MuteRelationUpdate) SetFromID(id uuid.UUID) *MuteRelationUpdate {
+	mru.mutation.SetFromID(id)
+	return mru
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (mru *MuteRelationUpdate) SetFrom(u *User) *MuteRelationUpdate {
+	return mru.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (mru *MuteRelationUpdate) SetToID(id uuid.UUID) *MuteRelationUpdate {
+	mru.mutation.SetToID(id)
+	return mru
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (mru *MuteRelationUpdate) SetTo(u *User) *MuteRelationUpdate {
+	return mru.SetToID(u.ID)
+}
+
+// Mutation returns the MuteRelationMutation object of the builder.
+func (mru *MuteRelationUpdate) Mutation() *MuteRelationMutation {
+	return mru.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (mru *MuteRelationUpdate) ClearFrom() *MuteRelationUpdate {
+	mru.mutation.ClearFrom()
+	return mru
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (mru *MuteRelationUpdate) ClearTo() *MuteRelationUpdate {
+	mru.mutation.ClearTo()
+	return mru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MuteRelationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mru.sqlSave, mru.mutation, mru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MuteRelationUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MuteRelationUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MuteRelationUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mru *MuteRelationUpdate) check() error {
+	if mru.mutation.FromCleared() && len(mru.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MuteRelation.from"`)
+	}
+	if mru.mutation.ToCleared() && len(mru.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MuteRelation.to"`)
+	}
+	return nil
+}
+
+func (mru *MuteRelationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(muterelation.Table, muterelation.Columns, sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID))
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mru.mutation.CreatedAt(); ok {
+		_spec.SetField(muterelation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mru.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.FromTable,
+			Columns: []string{muterelation.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mru.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.FromTable,
+			Columns: []string{muterelation.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mru.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.ToTable,
+			Columns: []string{muterelation.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mru.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.ToTable,
+			Columns: []string{muterelation.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{muterelation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mru.mutation.done = true
+	return n, nil
+}
+
+// MuteRelationUpdateOne is the builder for updating a single MuteRelation entity.
+type MuteRelationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MuteRelationMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mruo *MuteRelationUpdateOne) SetCreatedAt(t time.Time) *MuteRelationUpdateOne {
+	mruo.mutation.SetCreatedAt(t)
+	return mruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mruo *MuteRelationUpdateOne) SetNillableCreatedAt(t *time.Time) *MuteRelationUpdateOne {
+	if t != nil {
+		mruo.SetCreatedAt(*t)
+	}
+	return mruo
+}
+
+// SetFromID sets the "from" edge to the User entity by ID.
+func (mruo *MuteRelationUpdateOne) SetFromID(id uuid.UUID) *MuteRelationUpdateOne {
+	mruo.mutation.SetFromID(id)
+	return mruo
+}
+
+// SetFrom sets the "from" edge to the User entity.
+func (mruo *MuteRelationUpdateOne) SetFrom(u *User) *MuteRelationUpdateOne {
+	return mruo.SetFromID(u.ID)
+}
+
+// SetToID sets the "to" edge to the User entity by ID.
+func (mruo *MuteRelationUpdateOne) SetToID(id uuid.UUID) *MuteRelationUpdateOne {
+	mruo.mutation.SetToID(id)
+	return mruo
+}
+
+// SetTo sets the "to" edge to the User entity.
+func (mruo *MuteRelationUpdateOne) SetTo(u *User) *MuteRelationUpdateOne {
+	return mruo.SetToID(u.ID)
+}
+
+// Mutation returns the MuteRelationMutation object of the builder.
+func (mruo *MuteRelationUpdateOne) Mutation() *MuteRelationMutation {
+	return mruo.mutation
+}
+
+// ClearFrom clears the "from" edge to the User entity.
+func (mruo *MuteRelationUpdateOne) ClearFrom() *MuteRelationUpdateOne {
+	mruo.mutation.ClearFrom()
+	return mruo
+}
+
+// ClearTo clears the "to" edge to the User entity.
+func (mruo *MuteRelationUpdateOne) ClearTo() *MuteRelationUpdateOne {
+	mruo.mutation.ClearTo()
+	return mruo
+}
+
+// Where appends a list predicates to the MuteRelationUpdate builder.
+func (mruo *MuteRelationUpdateOne) Where(ps ...predicate.MuteRelation) *MuteRelationUpdateOne {
+	mruo.mutation.Where(ps...)
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MuteRelationUpdateOne) Select(field string, fields ...string) *MuteRelationUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MuteRelation entity.
+func (mruo *MuteRelationUpdateOne) Save(ctx context.Context) (*MuteRelation, error) {
+	return withHooks(ctx, mruo.sqlSave, mruo.mutation, mruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MuteRelationUpdateOne) SaveX(ctx context.Context) *MuteRelation {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MuteRelationUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MuteRelationUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mruo *MuteRelationUpdateOne) check() error {
+	if mruo.mutation.FromCleared() && len(mruo.mutation.FromIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MuteRelation.from"`)
+	}
+	if mruo.mutation.ToCleared() && len(mruo.mutation.ToIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MuteRelation.to"`)
+	}
+	return nil
+}
+
+func (mruo *MuteRelationUpdateOne) sqlSave(ctx context.Context) (_node *MuteRelation, err error) {
+	if err := mruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(muterelation.Table, muterelation.Columns, sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID))
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MuteRelation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, muterelation.FieldID)
+		for _, f := range fields {
+			if !muterelation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != muterelation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mruo.mutation.CreatedAt(); ok {
+		_spec.SetField(muterelation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mruo.mutation.FromCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.FromTable,
+			Columns: []string{muterelation.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mruo.mutation.FromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.FromTable,
+			Columns: []string{muterelation.FromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mruo.mutation.ToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.ToTable,
+			Columns: []string{muterelation.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mruo.mutation.ToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   muterelation.ToTable,
+			Columns: []string{muterelation.ToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MuteRelation{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{muterelation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetType sets the "type" field.
+func (nu *NotificationUpdate) SetType(et enum.NotificationType) *NotificationUpdate {
+	nu.mutation.SetType(et)
+	return nu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableType(et *enum.NotificationType) *NotificationUpdate {
+	if et != nil {
+		nu.SetType(*et)
+	}
+	return nu
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (nu *NotificationUpdate) SetRecipientID(u uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetRecipientID(u)
+	return nu
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableRecipientID(u *uuid.UUID) *NotificationUpdate {
+	if u != nil {
+		nu.SetRecipientID(*u)
+	}
+	return nu
+}
+
+// SetActorID sets the "actor_id" field.
+func (nu *NotificationUpdate) SetActorID(u uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetActorID(u)
+	return nu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableActorID(u *uuid.UUID) *NotificationUpdate {
+	if u != nil {
+		nu.SetActorID(*u)
+	}
+	return nu
+}
+
+// SetPostID sets the "post_id" field.
+func (nu *NotificationUpdate) SetPostID(u uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetPostID(u)
+	return nu
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillablePostID(u *uuid.UUID) *NotificationUpdate {
+	if u != nil {
+		nu.SetPostID(*u)
+	}
+	return nu
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (nu *NotificationUpdate) ClearPostID() *NotificationUpdate {
+	nu.mutation.ClearPostID()
+	return nu
+}
+
+// SetCommentID sets the "comment_id" field.
+func (nu *NotificationUpdate) SetCommentID(u uuid.UUID) *NotificationUpdate {
+	nu.mutation.SetCommentID(u)
+	return nu
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableCommentID(u *uuid.UUID) *NotificationUpdate {
+	if u != nil {
+		nu.SetCommentID(*u)
+	}
+	return nu
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (nu *NotificationUpdate) ClearCommentID() *NotificationUpdate {
+	nu.mutation.ClearCommentID()
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (nu *NotificationUpdate) SetRecipient(u *User) *NotificationUpdate {
+	return nu.SetRecipientID(u.ID)
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (nu *NotificationUpdate) SetActor(u *User) *NotificationUpdate {
+	return nu.SetActorID(u.ID)
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (nu *NotificationUpdate) SetPost(p *Post) *NotificationUpdate {
+	return nu.SetPostID(p.ID)
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (nu *NotificationUpdate) SetComment(c *Comment) *NotificationUpdate {
+	return nu.SetCommentID(c.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (nu *NotificationUpdate) ClearRecipient() *NotificationUpdate {
+	nu.mutation.ClearRecipient()
+	return nu
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (nu *NotificationUpdate) ClearActor() *NotificationUpdate {
+	nu.mutation.ClearActor()
+	return nu
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (nu *NotificationUpdate) ClearPost() *NotificationUpdate {
+	nu.mutation.ClearPost()
+	return nu
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (nu *NotificationUpdate) ClearComment() *NotificationUpdate {
+	nu.mutation.ClearComment()
+	return nu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if v, ok := nu.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	if nu.mutation.RecipientCleared() && len(nu.mutation.RecipientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.recipient"`)
+	}
+	if nu.mutation.ActorCleared() && len(nu.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.actor"`)
+	}
+	return nil
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nu.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.CommentTable,
+			Columns: []string{notification.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.CommentTable,
+			Columns: []string{notification.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetType sets the "type" field.
+func (nuo *NotificationUpdateOne) SetType(et enum.NotificationType) *NotificationUpdateOne {
+	nuo.mutation.SetType(et)
+	return nuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableType(et *enum.NotificationType) *NotificationUpdateOne {
+	if et != nil {
+		nuo.SetType(*et)
+	}
+	return nuo
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (nuo *NotificationUpdateOne) SetRecipientID(u uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetRecipientID(u)
+	return nuo
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableRecipientID(u *uuid.UUID) *NotificationUpdateOne {
+	if u != nil {
+		nuo.SetRecipientID(*u)
+	}
+	return nuo
+}
+
+// SetActorID sets the "actor_id" field.
+func (nuo *NotificationUpdateOne) SetActorID(u uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetActorID(u)
+	return nuo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableActorID(u *uuid.UUID) *NotificationUpdateOne {
+	if u != nil {
+		nuo.SetActorID(*u)
+	}
+	return nuo
+}
+
+// SetPostID sets the "post_id" field.
+func (nuo *NotificationUpdateOne) SetPostID(u uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetPostID(u)
+	return nuo
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillablePostID(u *uuid.UUID) *NotificationUpdateOne {
+	if u != nil {
+		nuo.SetPostID(*u)
+	}
+	return nuo
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (nuo *NotificationUpdateOne) ClearPostID() *NotificationUpdateOne {
+	nuo.mutation.ClearPostID()
+	return nuo
+}
+
+// SetCommentID sets the "comment_id" field.
+func (nuo *NotificationUpdateOne) SetCommentID(u uuid.UUID) *NotificationUpdateOne {
+	nuo.mutation.SetCommentID(u)
+	return nuo
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableCommentID(u *uuid.UUID) *NotificationUpdateOne {
+	if u != nil {
+		nuo.SetCommentID(*u)
+	}
+	return nuo
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (nuo *NotificationUpdateOne) ClearCommentID() *NotificationUpdateOne {
+	nuo.mutation.ClearCommentID()
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (nuo *NotificationUpdateOne) SetRecipient(u *User) *NotificationUpdateOne {
+	return nuo.SetRecipientID(u.ID)
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (nuo *NotificationUpdateOne) SetActor(u *User) *NotificationUpdateOne {
+	return nuo.SetActorID(u.ID)
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (nuo *NotificationUpdateOne) SetPost(p *Post) *NotificationUpdateOne {
+	return nuo.SetPostID(p.ID)
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (nuo *NotificationUpdateOne) SetComment(c *Comment) *NotificationUpdateOne {
+	return nuo.SetCommentID(c.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (nuo *NotificationUpdateOne) ClearRecipient() *NotificationUpdateOne {
+	nuo.mutation.ClearRecipient()
+	return nuo
+}
+
+// ClearActor clears the "actor" edge to the User entity.
+func (nuo *NotificationUpdateOne) ClearActor() *NotificationUpdateOne {
+	nuo.mutation.ClearActor()
+	return nuo
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (nuo *NotificationUpdateOne) ClearPost() *NotificationUpdateOne {
+	nuo.mutation.ClearPost()
+	return nuo
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (nuo *NotificationUpdateOne) ClearComment() *NotificationUpdateOne {
+	nuo.mutation.ClearComment()
+	return nuo
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if v, ok := nuo.mutation.GetType(); ok {
+		if err := notification.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Notification.type": %w`, err)}
+		}
+	}
+	if nuo.mutation.RecipientCleared() && len(nuo.mutation.RecipientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.recipient"`)
+	}
+	if nuo.mutation.ActorCleared() && len(nuo.mutation.ActorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.actor"`)
+	}
+	return nil
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nuo.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.RecipientTable,
+			Columns: []string{notification.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.ActorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.ActorTable,
+			Columns: []string{notification.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.PostTable,
+			Columns: []string{notification.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.CommentTable,
+			Columns: []string{notification.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.CommentTable,
+			Columns: []string{notification.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
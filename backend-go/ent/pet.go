@@ -44,9 +44,11 @@ type Pet struct {
 type PetEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Posts holds the value of the posts edge.
+	Posts []*Post `json:"posts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -60,6 +62,15 @@ func (e PetEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// PostsOrErr returns the Posts value or an error if the edge
+// was not loaded in eager-loading.
+func (e PetEdges) PostsOrErr() ([]*Post, error) {
+	if e.loadedTypes[1] {
+		return e.Posts, nil
+	}
+	return nil, &NotLoadedError{edge: "posts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Pet) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -161,6 +172,11 @@ func (pe *Pet) QueryOwner() *UserQuery {
 	return NewPetClient(pe.config).QueryOwner(pe)
 }
 
+// QueryPosts queries the "posts" edge of the Pet entity.
+func (pe *Pet) QueryPosts() *PostQuery {
+	return NewPetClient(pe.config).QueryPosts(pe)
+}
+
 // Update returns a builder for updating this Pet.
 // Note that you need to call Pet.Unwrap() before calling this method if this Pet
 // was returned from a transaction, and the transaction was committed or rolled back.
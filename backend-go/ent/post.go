@@ -10,6 +10,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
@@ -24,12 +26,22 @@ type Post struct {
 	Index uint32 `json:"index,omitempty"`
 	// Caption holds the value of the "caption" field.
 	Caption string `json:"caption,omitempty"`
-	// ImageKey holds the value of the "image_key" field.
+	// 1枚目の画像か動画のポスター画像のキー。アルゴリズムサービスが参照するため残している
 	ImageKey string `json:"image_key,omitempty"`
+	// MediaType holds the value of the "media_type" field.
+	MediaType enum.MediaType `json:"media_type,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// 通報数が閾値に達して自動的に非表示になった日時
+	HiddenAt *time.Time `json:"hidden_at,omitempty"`
+	// 最後に編集された日時
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// LikeCount holds the value of the "like_count" field.
+	LikeCount int `json:"like_count,omitempty"`
+	// 削除されていないコメントと返信の数。通報で非表示のものも含む
+	CommentCount int `json:"comment_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PostQuery when eager-loading is set.
 	Edges        PostEdges `json:"edges"`
@@ -47,9 +59,27 @@ type PostEdges struct {
 	Likes []*Like `json:"likes,omitempty"`
 	// DailyTask holds the value of the daily_task edge.
 	DailyTask *DailyTask `json:"daily_task,omitempty"`
+	// Reports holds the value of the reports edge.
+	Reports []*Report `json:"reports,omitempty"`
+	// ExploreRanking holds the value of the explore_ranking edge.
+	ExploreRanking *ExploreRanking `json:"explore_ranking,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// Media holds the value of the media edge.
+	Media []*PostMedia `json:"media,omitempty"`
+	// Pets holds the value of the pets edge.
+	Pets []*Pet `json:"pets,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*User `json:"mentions,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// PostTags holds the value of the post_tags edge.
+	PostTags []*PostTag `json:"post_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [13]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -92,16 +122,99 @@ func (e PostEdges) DailyTaskOrErr() (*DailyTask, error) {
 	return nil, &NotLoadedError{edge: "daily_task"}
 }
 
+// ReportsOrErr returns the Reports value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) ReportsOrErr() ([]*Report, error) {
+	if e.loadedTypes[4] {
+		return e.Reports, nil
+	}
+	return nil, &NotLoadedError{edge: "reports"}
+}
+
+// ExploreRankingOrErr returns the ExploreRanking value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) ExploreRankingOrErr() (*ExploreRanking, error) {
+	if e.ExploreRanking != nil {
+		return e.ExploreRanking, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: exploreranking.Label}
+	}
+	return nil, &NotLoadedError{edge: "explore_ranking"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[6] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) MediaOrErr() ([]*PostMedia, error) {
+	if e.loadedTypes[7] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// PetsOrErr returns the Pets value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) PetsOrErr() ([]*Pet, error) {
+	if e.loadedTypes[8] {
+		return e.Pets, nil
+	}
+	return nil, &NotLoadedError{edge: "pets"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[9] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) MentionsOrErr() ([]*User, error) {
+	if e.loadedTypes[10] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[11] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// PostTagsOrErr returns the PostTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) PostTagsOrErr() ([]*PostTag, error) {
+	if e.loadedTypes[12] {
+		return e.PostTags, nil
+	}
+	return nil, &NotLoadedError{edge: "post_tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldIndex:
+		case post.FieldIndex, post.FieldLikeCount, post.FieldCommentCount:
 			values[i] = new(sql.NullInt64)
-		case post.FieldCaption, post.FieldImageKey:
+		case post.FieldCaption, post.FieldImageKey, post.FieldMediaType:
 			values[i] = new(sql.NullString)
-		case post.FieldCreatedAt, post.FieldDeletedAt:
+		case post.FieldCreatedAt, post.FieldDeletedAt, post.FieldHiddenAt, post.FieldEditedAt:
 			values[i] = new(sql.NullTime)
 		case post.FieldID:
 			values[i] = new(uuid.UUID)
@@ -146,6 +259,12 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.ImageKey = value.String
 			}
+		case post.FieldMediaType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field media_type", values[i])
+			} else if value.Valid {
+				po.MediaType = enum.MediaType(value.String)
+			}
 		case post.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -158,6 +277,32 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.DeletedAt = value.Time
 			}
+		case post.FieldHiddenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hidden_at", values[i])
+			} else if value.Valid {
+				po.HiddenAt = new(time.Time)
+				*po.HiddenAt = value.Time
+			}
+		case post.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				po.EditedAt = new(time.Time)
+				*po.EditedAt = value.Time
+			}
+		case post.FieldLikeCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field like_count", values[i])
+			} else if value.Valid {
+				po.LikeCount = int(value.Int64)
+			}
+		case post.FieldCommentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_count", values[i])
+			} else if value.Valid {
+				po.CommentCount = int(value.Int64)
+			}
 		case post.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_posts", values[i])
@@ -198,6 +343,51 @@ func (po *Post) QueryDailyTask() *DailyTaskQuery {
 	return NewPostClient(po.config).QueryDailyTask(po)
 }
 
+// QueryReports queries the "reports" edge of the Post entity.
+func (po *Post) QueryReports() *ReportQuery {
+	return NewPostClient(po.config).QueryReports(po)
+}
+
+// QueryExploreRanking queries the "explore_ranking" edge of the Post entity.
+func (po *Post) QueryExploreRanking() *ExploreRankingQuery {
+	return NewPostClient(po.config).QueryExploreRanking(po)
+}
+
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (po *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(po.config).QueryRevisions(po)
+}
+
+// QueryMedia queries the "media" edge of the Post entity.
+func (po *Post) QueryMedia() *PostMediaQuery {
+	return NewPostClient(po.config).QueryMedia(po)
+}
+
+// QueryPets queries the "pets" edge of the Post entity.
+func (po *Post) QueryPets() *PetQuery {
+	return NewPostClient(po.config).QueryPets(po)
+}
+
+// QueryTags queries the "tags" edge of the Post entity.
+func (po *Post) QueryTags() *TagQuery {
+	return NewPostClient(po.config).QueryTags(po)
+}
+
+// QueryMentions queries the "mentions" edge of the Post entity.
+func (po *Post) QueryMentions() *UserQuery {
+	return NewPostClient(po.config).QueryMentions(po)
+}
+
+// QueryNotifications queries the "notifications" edge of the Post entity.
+func (po *Post) QueryNotifications() *NotificationQuery {
+	return NewPostClient(po.config).QueryNotifications(po)
+}
+
+// QueryPostTags queries the "post_tags" edge of the Post entity.
+func (po *Post) QueryPostTags() *PostTagQuery {
+	return NewPostClient(po.config).QueryPostTags(po)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -230,11 +420,30 @@ func (po *Post) String() string {
 	builder.WriteString("image_key=")
 	builder.WriteString(po.ImageKey)
 	builder.WriteString(", ")
+	builder.WriteString("media_type=")
+	builder.WriteString(fmt.Sprintf("%v", po.MediaType))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(po.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("deleted_at=")
 	builder.WriteString(po.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := po.HiddenAt; v != nil {
+		builder.WriteString("hidden_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := po.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("like_count=")
+	builder.WriteString(fmt.Sprintf("%v", po.LikeCount))
+	builder.WriteString(", ")
+	builder.WriteString("comment_count=")
+	builder.WriteString(fmt.Sprintf("%v", po.CommentCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
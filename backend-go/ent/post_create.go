@@ -14,8 +14,16 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -54,6 +62,20 @@ func (pc *PostCreate) SetImageKey(s string) *PostCreate {
 	return pc
 }
 
+// SetMediaType sets the "media_type" field.
+func (pc *PostCreate) SetMediaType(et enum.MediaType) *PostCreate {
+	pc.mutation.SetMediaType(et)
+	return pc
+}
+
+// SetNillableMediaType sets the "media_type" field if the given value is not nil.
+func (pc *PostCreate) SetNillableMediaType(et *enum.MediaType) *PostCreate {
+	if et != nil {
+		pc.SetMediaType(*et)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *PostCreate) SetCreatedAt(t time.Time) *PostCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -82,6 +104,62 @@ func (pc *PostCreate) SetNillableDeletedAt(t *time.Time) *PostCreate {
 	return pc
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (pc *PostCreate) SetHiddenAt(t time.Time) *PostCreate {
+	pc.mutation.SetHiddenAt(t)
+	return pc
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (pc *PostCreate) SetNillableHiddenAt(t *time.Time) *PostCreate {
+	if t != nil {
+		pc.SetHiddenAt(*t)
+	}
+	return pc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (pc *PostCreate) SetEditedAt(t time.Time) *PostCreate {
+	pc.mutation.SetEditedAt(t)
+	return pc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (pc *PostCreate) SetNillableEditedAt(t *time.Time) *PostCreate {
+	if t != nil {
+		pc.SetEditedAt(*t)
+	}
+	return pc
+}
+
+// SetLikeCount sets the "like_count" field.
+func (pc *PostCreate) SetLikeCount(i int) *PostCreate {
+	pc.mutation.SetLikeCount(i)
+	return pc
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (pc *PostCreate) SetNillableLikeCount(i *int) *PostCreate {
+	if i != nil {
+		pc.SetLikeCount(*i)
+	}
+	return pc
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (pc *PostCreate) SetCommentCount(i int) *PostCreate {
+	pc.mutation.SetCommentCount(i)
+	return pc
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (pc *PostCreate) SetNillableCommentCount(i *int) *PostCreate {
+	if i != nil {
+		pc.SetCommentCount(*i)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *PostCreate) SetID(u uuid.UUID) *PostCreate {
 	pc.mutation.SetID(u)
@@ -156,6 +234,130 @@ func (pc *PostCreate) SetDailyTask(d *DailyTask) *PostCreate {
 	return pc.SetDailyTaskID(d.ID)
 }
 
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (pc *PostCreate) AddReportIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddReportIDs(ids...)
+	return pc
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (pc *PostCreate) AddReports(r ...*Report) *PostCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddReportIDs(ids...)
+}
+
+// SetExploreRankingID sets the "explore_ranking" edge to the ExploreRanking entity by ID.
+func (pc *PostCreate) SetExploreRankingID(id uuid.UUID) *PostCreate {
+	pc.mutation.SetExploreRankingID(id)
+	return pc
+}
+
+// SetNillableExploreRankingID sets the "explore_ranking" edge to the ExploreRanking entity by ID if the given value is not nil.
+func (pc *PostCreate) SetNillableExploreRankingID(id *uuid.UUID) *PostCreate {
+	if id != nil {
+		pc = pc.SetExploreRankingID(*id)
+	}
+	return pc
+}
+
+// SetExploreRanking sets the "explore_ranking" edge to the ExploreRanking entity.
+func (pc *PostCreate) SetExploreRanking(e *ExploreRanking) *PostCreate {
+	return pc.SetExploreRankingID(e.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (pc *PostCreate) AddRevisionIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddRevisionIDs(ids...)
+	return pc
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (pc *PostCreate) AddRevisions(p ...*PostRevision) *PostCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRevisionIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the PostMedia entity by IDs.
+func (pc *PostCreate) AddMediumIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddMediumIDs(ids...)
+	return pc
+}
+
+// AddMedia adds the "media" edges to the PostMedia entity.
+func (pc *PostCreate) AddMedia(p ...*PostMedia) *PostCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddMediumIDs(ids...)
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by IDs.
+func (pc *PostCreate) AddPetIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddPetIDs(ids...)
+	return pc
+}
+
+// AddPets adds the "pets" edges to the Pet entity.
+func (pc *PostCreate) AddPets(p ...*Pet) *PostCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddPetIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (pc *PostCreate) AddTagIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddTagIDs(ids...)
+	return pc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (pc *PostCreate) AddTags(t ...*Tag) *PostCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTagIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (pc *PostCreate) AddMentionIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddMentionIDs(ids...)
+	return pc
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (pc *PostCreate) AddMentions(u ...*User) *PostCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pc.AddMentionIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (pc *PostCreate) AddNotificationIDs(ids ...uuid.UUID) *PostCreate {
+	pc.mutation.AddNotificationIDs(ids...)
+	return pc
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (pc *PostCreate) AddNotifications(n ...*Notification) *PostCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return pc.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pc *PostCreate) Mutation() *PostMutation {
 	return pc.mutation
@@ -191,10 +393,22 @@ func (pc *PostCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pc *PostCreate) defaults() {
+	if _, ok := pc.mutation.MediaType(); !ok {
+		v := post.DefaultMediaType
+		pc.mutation.SetMediaType(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		v := post.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := pc.mutation.LikeCount(); !ok {
+		v := post.DefaultLikeCount
+		pc.mutation.SetLikeCount(v)
+	}
+	if _, ok := pc.mutation.CommentCount(); !ok {
+		v := post.DefaultCommentCount
+		pc.mutation.SetCommentCount(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		v := post.DefaultID()
 		pc.mutation.SetID(v)
@@ -219,9 +433,23 @@ func (pc *PostCreate) check() error {
 			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "Post.image_key": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.MediaType(); !ok {
+		return &ValidationError{Name: "media_type", err: errors.New(`ent: missing required field "Post.media_type"`)}
+	}
+	if v, ok := pc.mutation.MediaType(); ok {
+		if err := post.MediaTypeValidator(v); err != nil {
+			return &ValidationError{Name: "media_type", err: fmt.Errorf(`ent: validator failed for field "Post.media_type": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Post.created_at"`)}
 	}
+	if _, ok := pc.mutation.LikeCount(); !ok {
+		return &ValidationError{Name: "like_count", err: errors.New(`ent: missing required field "Post.like_count"`)}
+	}
+	if _, ok := pc.mutation.CommentCount(); !ok {
+		return &ValidationError{Name: "comment_count", err: errors.New(`ent: missing required field "Post.comment_count"`)}
+	}
 	if len(pc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Post.user"`)}
 	}
@@ -273,6 +501,10 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldImageKey, field.TypeString, value)
 		_node.ImageKey = value
 	}
+	if value, ok := pc.mutation.MediaType(); ok {
+		_spec.SetField(post.FieldMediaType, field.TypeEnum, value)
+		_node.MediaType = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -281,6 +513,22 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := pc.mutation.HiddenAt(); ok {
+		_spec.SetField(post.FieldHiddenAt, field.TypeTime, value)
+		_node.HiddenAt = &value
+	}
+	if value, ok := pc.mutation.EditedAt(); ok {
+		_spec.SetField(post.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := pc.mutation.LikeCount(); ok {
+		_spec.SetField(post.FieldLikeCount, field.TypeInt, value)
+		_node.LikeCount = value
+	}
+	if value, ok := pc.mutation.CommentCount(); ok {
+		_spec.SetField(post.FieldCommentCount, field.TypeInt, value)
+		_node.CommentCount = value
+	}
 	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -346,6 +594,138 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.ReportsTable,
+			Columns: []string{post.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ExploreRankingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.ExploreRankingTable,
+			Columns: []string{post.ExploreRankingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.PetsTable,
+			Columns: post.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &PostTagCreate{config: pc.config, mutation: newPostTagMutation(pc.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -422,6 +802,18 @@ func (u *PostUpsert) UpdateImageKey() *PostUpsert {
 	return u
 }
 
+// SetMediaType sets the "media_type" field.
+func (u *PostUpsert) SetMediaType(v enum.MediaType) *PostUpsert {
+	u.Set(post.FieldMediaType, v)
+	return u
+}
+
+// UpdateMediaType sets the "media_type" field to the value that was provided on create.
+func (u *PostUpsert) UpdateMediaType() *PostUpsert {
+	u.SetExcluded(post.FieldMediaType)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *PostUpsert) SetCreatedAt(v time.Time) *PostUpsert {
 	u.Set(post.FieldCreatedAt, v)
@@ -452,6 +844,78 @@ func (u *PostUpsert) ClearDeletedAt() *PostUpsert {
 	return u
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsert) SetHiddenAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldHiddenAt, v)
+	return u
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateHiddenAt() *PostUpsert {
+	u.SetExcluded(post.FieldHiddenAt)
+	return u
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsert) ClearHiddenAt() *PostUpsert {
+	u.SetNull(post.FieldHiddenAt)
+	return u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *PostUpsert) SetEditedAt(v time.Time) *PostUpsert {
+	u.Set(post.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *PostUpsert) UpdateEditedAt() *PostUpsert {
+	u.SetExcluded(post.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *PostUpsert) ClearEditedAt() *PostUpsert {
+	u.SetNull(post.FieldEditedAt)
+	return u
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *PostUpsert) SetLikeCount(v int) *PostUpsert {
+	u.Set(post.FieldLikeCount, v)
+	return u
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *PostUpsert) UpdateLikeCount() *PostUpsert {
+	u.SetExcluded(post.FieldLikeCount)
+	return u
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *PostUpsert) AddLikeCount(v int) *PostUpsert {
+	u.Add(post.FieldLikeCount, v)
+	return u
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *PostUpsert) SetCommentCount(v int) *PostUpsert {
+	u.Set(post.FieldCommentCount, v)
+	return u
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *PostUpsert) UpdateCommentCount() *PostUpsert {
+	u.SetExcluded(post.FieldCommentCount)
+	return u
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *PostUpsert) AddCommentCount(v int) *PostUpsert {
+	u.Add(post.FieldCommentCount, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -531,6 +995,20 @@ func (u *PostUpsertOne) UpdateImageKey() *PostUpsertOne {
 	})
 }
 
+// SetMediaType sets the "media_type" field.
+func (u *PostUpsertOne) SetMediaType(v enum.MediaType) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetMediaType(v)
+	})
+}
+
+// UpdateMediaType sets the "media_type" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateMediaType() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateMediaType()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *PostUpsertOne) SetCreatedAt(v time.Time) *PostUpsertOne {
 	return u.Update(func(s *PostUpsert) {
@@ -566,6 +1044,90 @@ func (u *PostUpsertOne) ClearDeletedAt() *PostUpsertOne {
 	})
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsertOne) SetHiddenAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateHiddenAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsertOne) ClearHiddenAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *PostUpsertOne) SetEditedAt(v time.Time) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateEditedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *PostUpsertOne) ClearEditedAt() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *PostUpsertOne) SetLikeCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *PostUpsertOne) AddLikeCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateLikeCount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *PostUpsertOne) SetCommentCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.SetCommentCount(v)
+	})
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *PostUpsertOne) AddCommentCount(v int) *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.AddCommentCount(v)
+	})
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *PostUpsertOne) UpdateCommentCount() *PostUpsertOne {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateCommentCount()
+	})
+}
+
 // Exec executes the query.
 func (u *PostUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -812,6 +1374,20 @@ func (u *PostUpsertBulk) UpdateImageKey() *PostUpsertBulk {
 	})
 }
 
+// SetMediaType sets the "media_type" field.
+func (u *PostUpsertBulk) SetMediaType(v enum.MediaType) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetMediaType(v)
+	})
+}
+
+// UpdateMediaType sets the "media_type" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateMediaType() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateMediaType()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *PostUpsertBulk) SetCreatedAt(v time.Time) *PostUpsertBulk {
 	return u.Update(func(s *PostUpsert) {
@@ -847,6 +1423,90 @@ func (u *PostUpsertBulk) ClearDeletedAt() *PostUpsertBulk {
 	})
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (u *PostUpsertBulk) SetHiddenAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetHiddenAt(v)
+	})
+}
+
+// UpdateHiddenAt sets the "hidden_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateHiddenAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateHiddenAt()
+	})
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (u *PostUpsertBulk) ClearHiddenAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearHiddenAt()
+	})
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (u *PostUpsertBulk) SetEditedAt(v time.Time) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateEditedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *PostUpsertBulk) ClearEditedAt() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetLikeCount sets the "like_count" field.
+func (u *PostUpsertBulk) SetLikeCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetLikeCount(v)
+	})
+}
+
+// AddLikeCount adds v to the "like_count" field.
+func (u *PostUpsertBulk) AddLikeCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddLikeCount(v)
+	})
+}
+
+// UpdateLikeCount sets the "like_count" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateLikeCount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateLikeCount()
+	})
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (u *PostUpsertBulk) SetCommentCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.SetCommentCount(v)
+	})
+}
+
+// AddCommentCount adds v to the "comment_count" field.
+func (u *PostUpsertBulk) AddCommentCount(v int) *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.AddCommentCount(v)
+	})
+}
+
+// UpdateCommentCount sets the "comment_count" field to the value that was provided on create.
+func (u *PostUpsertBulk) UpdateCommentCount() *PostUpsertBulk {
+	return u.Update(func(s *PostUpsert) {
+		s.UpdateCommentCount()
+	})
+}
+
 // Exec executes the query.
 func (u *PostUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
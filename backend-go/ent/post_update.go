@@ -13,9 +13,17 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -61,6 +69,20 @@ func (pu *PostUpdate) SetNillableImageKey(s *string) *PostUpdate {
 	return pu
 }
 
+// SetMediaType sets the "media_type" field.
+func (pu *PostUpdate) SetMediaType(et enum.MediaType) *PostUpdate {
+	pu.mutation.SetMediaType(et)
+	return pu
+}
+
+// SetNillableMediaType sets the "media_type" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableMediaType(et *enum.MediaType) *PostUpdate {
+	if et != nil {
+		pu.SetMediaType(*et)
+	}
+	return pu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pu *PostUpdate) SetCreatedAt(t time.Time) *PostUpdate {
 	pu.mutation.SetCreatedAt(t)
@@ -95,6 +117,88 @@ func (pu *PostUpdate) ClearDeletedAt() *PostUpdate {
 	return pu
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (pu *PostUpdate) SetHiddenAt(t time.Time) *PostUpdate {
+	pu.mutation.SetHiddenAt(t)
+	return pu
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableHiddenAt(t *time.Time) *PostUpdate {
+	if t != nil {
+		pu.SetHiddenAt(*t)
+	}
+	return pu
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (pu *PostUpdate) ClearHiddenAt() *PostUpdate {
+	pu.mutation.ClearHiddenAt()
+	return pu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (pu *PostUpdate) SetEditedAt(t time.Time) *PostUpdate {
+	pu.mutation.SetEditedAt(t)
+	return pu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableEditedAt(t *time.Time) *PostUpdate {
+	if t != nil {
+		pu.SetEditedAt(*t)
+	}
+	return pu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (pu *PostUpdate) ClearEditedAt() *PostUpdate {
+	pu.mutation.ClearEditedAt()
+	return pu
+}
+
+// SetLikeCount sets the "like_count" field.
+func (pu *PostUpdate) SetLikeCount(i int) *PostUpdate {
+	pu.mutation.ResetLikeCount()
+	pu.mutation.SetLikeCount(i)
+	return pu
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableLikeCount(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetLikeCount(*i)
+	}
+	return pu
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (pu *PostUpdate) AddLikeCount(i int) *PostUpdate {
+	pu.mutation.AddLikeCount(i)
+	return pu
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (pu *PostUpdate) SetCommentCount(i int) *PostUpdate {
+	pu.mutation.ResetCommentCount()
+	pu.mutation.SetCommentCount(i)
+	return pu
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableCommentCount(i *int) *PostUpdate {
+	if i != nil {
+		pu.SetCommentCount(*i)
+	}
+	return pu
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (pu *PostUpdate) AddCommentCount(i int) *PostUpdate {
+	pu.mutation.AddCommentCount(i)
+	return pu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (pu *PostUpdate) SetUserID(id uuid.UUID) *PostUpdate {
 	pu.mutation.SetUserID(id)
@@ -155,6 +259,130 @@ func (pu *PostUpdate) SetDailyTask(d *DailyTask) *PostUpdate {
 	return pu.SetDailyTaskID(d.ID)
 }
 
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (pu *PostUpdate) AddReportIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddReportIDs(ids...)
+	return pu
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (pu *PostUpdate) AddReports(r ...*Report) *PostUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddReportIDs(ids...)
+}
+
+// SetExploreRankingID sets the "explore_ranking" edge to the ExploreRanking entity by ID.
+func (pu *PostUpdate) SetExploreRankingID(id uuid.UUID) *PostUpdate {
+	pu.mutation.SetExploreRankingID(id)
+	return pu
+}
+
+// SetNillableExploreRankingID sets the "explore_ranking" edge to the ExploreRanking entity by ID if the given value is not nil.
+func (pu *PostUpdate) SetNillableExploreRankingID(id *uuid.UUID) *PostUpdate {
+	if id != nil {
+		pu = pu.SetExploreRankingID(*id)
+	}
+	return pu
+}
+
+// SetExploreRanking sets the "explore_ranking" edge to the ExploreRanking entity.
+func (pu *PostUpdate) SetExploreRanking(e *ExploreRanking) *PostUpdate {
+	return pu.SetExploreRankingID(e.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (pu *PostUpdate) AddRevisionIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddRevisionIDs(ids...)
+	return pu
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (pu *PostUpdate) AddRevisions(p ...*PostRevision) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddRevisionIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the PostMedia entity by IDs.
+func (pu *PostUpdate) AddMediumIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddMediumIDs(ids...)
+	return pu
+}
+
+// AddMedia adds the "media" edges to the PostMedia entity.
+func (pu *PostUpdate) AddMedia(p ...*PostMedia) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddMediumIDs(ids...)
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by IDs.
+func (pu *PostUpdate) AddPetIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddPetIDs(ids...)
+	return pu
+}
+
+// AddPets adds the "pets" edges to the Pet entity.
+func (pu *PostUpdate) AddPets(p ...*Pet) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddPetIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (pu *PostUpdate) AddTagIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddTagIDs(ids...)
+	return pu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (pu *PostUpdate) AddTags(t ...*Tag) *PostUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTagIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (pu *PostUpdate) AddMentionIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddMentionIDs(ids...)
+	return pu
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (pu *PostUpdate) AddMentions(u ...*User) *PostUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pu.AddMentionIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (pu *PostUpdate) AddNotificationIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.AddNotificationIDs(ids...)
+	return pu
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (pu *PostUpdate) AddNotifications(n ...*Notification) *PostUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return pu.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pu *PostUpdate) Mutation() *PostMutation {
 	return pu.mutation
@@ -214,6 +442,159 @@ func (pu *PostUpdate) ClearDailyTask() *PostUpdate {
 	return pu
 }
 
+// ClearReports clears all "reports" edges to the Report entity.
+func (pu *PostUpdate) ClearReports() *PostUpdate {
+	pu.mutation.ClearReports()
+	return pu
+}
+
+// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
+func (pu *PostUpdate) RemoveReportIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveReportIDs(ids...)
+	return pu
+}
+
+// RemoveReports removes "reports" edges to Report entities.
+func (pu *PostUpdate) RemoveReports(r ...*Report) *PostUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveReportIDs(ids...)
+}
+
+// ClearExploreRanking clears the "explore_ranking" edge to the ExploreRanking entity.
+func (pu *PostUpdate) ClearExploreRanking() *PostUpdate {
+	pu.mutation.ClearExploreRanking()
+	return pu
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (pu *PostUpdate) ClearRevisions() *PostUpdate {
+	pu.mutation.ClearRevisions()
+	return pu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (pu *PostUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveRevisionIDs(ids...)
+	return pu
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (pu *PostUpdate) RemoveRevisions(p ...*PostRevision) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveRevisionIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the PostMedia entity.
+func (pu *PostUpdate) ClearMedia() *PostUpdate {
+	pu.mutation.ClearMedia()
+	return pu
+}
+
+// RemoveMediumIDs removes the "media" edge to PostMedia entities by IDs.
+func (pu *PostUpdate) RemoveMediumIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveMediumIDs(ids...)
+	return pu
+}
+
+// RemoveMedia removes "media" edges to PostMedia entities.
+func (pu *PostUpdate) RemoveMedia(p ...*PostMedia) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveMediumIDs(ids...)
+}
+
+// ClearPets clears all "pets" edges to the Pet entity.
+func (pu *PostUpdate) ClearPets() *PostUpdate {
+	pu.mutation.ClearPets()
+	return pu
+}
+
+// RemovePetIDs removes the "pets" edge to Pet entities by IDs.
+func (pu *PostUpdate) RemovePetIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemovePetIDs(ids...)
+	return pu
+}
+
+// RemovePets removes "pets" edges to Pet entities.
+func (pu *PostUpdate) RemovePets(p ...*Pet) *PostUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemovePetIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (pu *PostUpdate) ClearTags() *PostUpdate {
+	pu.mutation.ClearTags()
+	return pu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (pu *PostUpdate) RemoveTagIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveTagIDs(ids...)
+	return pu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (pu *PostUpdate) RemoveTags(t ...*Tag) *PostUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTagIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the User entity.
+func (pu *PostUpdate) ClearMentions() *PostUpdate {
+	pu.mutation.ClearMentions()
+	return pu
+}
+
+// RemoveMentionIDs removes the "mentions" edge to User entities by IDs.
+func (pu *PostUpdate) RemoveMentionIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveMentionIDs(ids...)
+	return pu
+}
+
+// RemoveMentions removes "mentions" edges to User entities.
+func (pu *PostUpdate) RemoveMentions(u ...*User) *PostUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pu.RemoveMentionIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (pu *PostUpdate) ClearNotifications() *PostUpdate {
+	pu.mutation.ClearNotifications()
+	return pu
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (pu *PostUpdate) RemoveNotificationIDs(ids ...uuid.UUID) *PostUpdate {
+	pu.mutation.RemoveNotificationIDs(ids...)
+	return pu
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (pu *PostUpdate) RemoveNotifications(n ...*Notification) *PostUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return pu.RemoveNotificationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
@@ -253,6 +634,11 @@ func (pu *PostUpdate) check() error {
 			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "Post.image_key": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.MediaType(); ok {
+		if err := post.MediaTypeValidator(v); err != nil {
+			return &ValidationError{Name: "media_type", err: fmt.Errorf(`ent: validator failed for field "Post.media_type": %w`, err)}
+		}
+	}
 	if pu.mutation.UserCleared() && len(pu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Post.user"`)
 	}
@@ -280,6 +666,9 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.ImageKey(); ok {
 		_spec.SetField(post.FieldImageKey, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.MediaType(); ok {
+		_spec.SetField(post.FieldMediaType, field.TypeEnum, value)
+	}
 	if value, ok := pu.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -289,6 +678,30 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.DeletedAtCleared() {
 		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := pu.mutation.HiddenAt(); ok {
+		_spec.SetField(post.FieldHiddenAt, field.TypeTime, value)
+	}
+	if pu.mutation.HiddenAtCleared() {
+		_spec.ClearField(post.FieldHiddenAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.EditedAt(); ok {
+		_spec.SetField(post.FieldEditedAt, field.TypeTime, value)
+	}
+	if pu.mutation.EditedAtCleared() {
+		_spec.ClearField(post.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.LikeCount(); ok {
+		_spec.SetField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedLikeCount(); ok {
+		_spec.AddField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.CommentCount(); ok {
+		_spec.SetField(post.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedCommentCount(); ok {
+		_spec.AddField(post.FieldCommentCount, field.TypeInt, value)
+	}
 	if pu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -437,59 +850,429 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{post.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if pu.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.ReportsTable,
+			Columns: []string{post.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	pu.mutation.done = true
-	return n, nil
-}
-
-// PostUpdateOne is the builder for updating a single Post entity.
-type PostUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *PostMutation
-}
-
-// SetCaption sets the "caption" field.
-func (puo *PostUpdateOne) SetCaption(s string) *PostUpdateOne {
-	puo.mutation.SetCaption(s)
-	return puo
-}
-
-// SetNillableCaption sets the "caption" field if the given value is not nil.
-func (puo *PostUpdateOne) SetNillableCaption(s *string) *PostUpdateOne {
-	if s != nil {
-		puo.SetCaption(*s)
+	if nodes := pu.mutation.RemovedReportsIDs(); len(nodes) > 0 && !pu.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.ReportsTable,
+			Columns: []string{post.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return puo
-}
-
-// SetImageKey sets the "image_key" field.
-func (puo *PostUpdateOne) SetImageKey(s string) *PostUpdateOne {
-	puo.mutation.SetImageKey(s)
-	return puo
-}
-
-// SetNillableImageKey sets the "image_key" field if the given value is not nil.
-func (puo *PostUpdateOne) SetNillableImageKey(s *string) *PostUpdateOne {
-	if s != nil {
-		puo.SetImageKey(*s)
+	if nodes := pu.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.ReportsTable,
+			Columns: []string{post.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return puo
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (puo *PostUpdateOne) SetCreatedAt(t time.Time) *PostUpdateOne {
-	puo.mutation.SetCreatedAt(t)
-	return puo
-}
+	if pu.mutation.ExploreRankingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.ExploreRankingTable,
+			Columns: []string{post.ExploreRankingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ExploreRankingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.ExploreRankingTable,
+			Columns: []string{post.ExploreRankingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMediaIDs(); len(nodes) > 0 && !pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.PetsTable,
+			Columns: post.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedPetsIDs(); len(nodes) > 0 && !pu.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.PetsTable,
+			Columns: post.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.PetsTable,
+			Columns: post.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &PostTagCreate{config: pu.config, mutation: newPostTagMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !pu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &PostTagCreate{config: pu.config, mutation: newPostTagMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &PostTagCreate{config: pu.config, mutation: newPostTagMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !pu.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !pu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{post.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PostUpdateOne is the builder for updating a single Post entity.
+type PostUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostMutation
+}
+
+// SetCaption sets the "caption" field.
+func (puo *PostUpdateOne) SetCaption(s string) *PostUpdateOne {
+	puo.mutation.SetCaption(s)
+	return puo
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableCaption(s *string) *PostUpdateOne {
+	if s != nil {
+		puo.SetCaption(*s)
+	}
+	return puo
+}
+
+// SetImageKey sets the "image_key" field.
+func (puo *PostUpdateOne) SetImageKey(s string) *PostUpdateOne {
+	puo.mutation.SetImageKey(s)
+	return puo
+}
+
+// SetNillableImageKey sets the "image_key" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableImageKey(s *string) *PostUpdateOne {
+	if s != nil {
+		puo.SetImageKey(*s)
+	}
+	return puo
+}
+
+// SetMediaType sets the "media_type" field.
+func (puo *PostUpdateOne) SetMediaType(et enum.MediaType) *PostUpdateOne {
+	puo.mutation.SetMediaType(et)
+	return puo
+}
+
+// SetNillableMediaType sets the "media_type" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableMediaType(et *enum.MediaType) *PostUpdateOne {
+	if et != nil {
+		puo.SetMediaType(*et)
+	}
+	return puo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (puo *PostUpdateOne) SetCreatedAt(t time.Time) *PostUpdateOne {
+	puo.mutation.SetCreatedAt(t)
+	return puo
+}
 
 // SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
 func (puo *PostUpdateOne) SetNillableCreatedAt(t *time.Time) *PostUpdateOne {
@@ -519,6 +1302,88 @@ func (puo *PostUpdateOne) ClearDeletedAt() *PostUpdateOne {
 	return puo
 }
 
+// SetHiddenAt sets the "hidden_at" field.
+func (puo *PostUpdateOne) SetHiddenAt(t time.Time) *PostUpdateOne {
+	puo.mutation.SetHiddenAt(t)
+	return puo
+}
+
+// SetNillableHiddenAt sets the "hidden_at" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableHiddenAt(t *time.Time) *PostUpdateOne {
+	if t != nil {
+		puo.SetHiddenAt(*t)
+	}
+	return puo
+}
+
+// ClearHiddenAt clears the value of the "hidden_at" field.
+func (puo *PostUpdateOne) ClearHiddenAt() *PostUpdateOne {
+	puo.mutation.ClearHiddenAt()
+	return puo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (puo *PostUpdateOne) SetEditedAt(t time.Time) *PostUpdateOne {
+	puo.mutation.SetEditedAt(t)
+	return puo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableEditedAt(t *time.Time) *PostUpdateOne {
+	if t != nil {
+		puo.SetEditedAt(*t)
+	}
+	return puo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (puo *PostUpdateOne) ClearEditedAt() *PostUpdateOne {
+	puo.mutation.ClearEditedAt()
+	return puo
+}
+
+// SetLikeCount sets the "like_count" field.
+func (puo *PostUpdateOne) SetLikeCount(i int) *PostUpdateOne {
+	puo.mutation.ResetLikeCount()
+	puo.mutation.SetLikeCount(i)
+	return puo
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableLikeCount(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetLikeCount(*i)
+	}
+	return puo
+}
+
+// AddLikeCount adds i to the "like_count" field.
+func (puo *PostUpdateOne) AddLikeCount(i int) *PostUpdateOne {
+	puo.mutation.AddLikeCount(i)
+	return puo
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (puo *PostUpdateOne) SetCommentCount(i int) *PostUpdateOne {
+	puo.mutation.ResetCommentCount()
+	puo.mutation.SetCommentCount(i)
+	return puo
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableCommentCount(i *int) *PostUpdateOne {
+	if i != nil {
+		puo.SetCommentCount(*i)
+	}
+	return puo
+}
+
+// AddCommentCount adds i to the "comment_count" field.
+func (puo *PostUpdateOne) AddCommentCount(i int) *PostUpdateOne {
+	puo.mutation.AddCommentCount(i)
+	return puo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (puo *PostUpdateOne) SetUserID(id uuid.UUID) *PostUpdateOne {
 	puo.mutation.SetUserID(id)
@@ -579,6 +1444,130 @@ func (puo *PostUpdateOne) SetDailyTask(d *DailyTask) *PostUpdateOne {
 	return puo.SetDailyTaskID(d.ID)
 }
 
+// AddReportIDs adds the "reports" edge to the Report entity by IDs.
+func (puo *PostUpdateOne) AddReportIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddReportIDs(ids...)
+	return puo
+}
+
+// AddReports adds the "reports" edges to the Report entity.
+func (puo *PostUpdateOne) AddReports(r ...*Report) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddReportIDs(ids...)
+}
+
+// SetExploreRankingID sets the "explore_ranking" edge to the ExploreRanking entity by ID.
+func (puo *PostUpdateOne) SetExploreRankingID(id uuid.UUID) *PostUpdateOne {
+	puo.mutation.SetExploreRankingID(id)
+	return puo
+}
+
+// SetNillableExploreRankingID sets the "explore_ranking" edge to the ExploreRanking entity by ID if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableExploreRankingID(id *uuid.UUID) *PostUpdateOne {
+	if id != nil {
+		puo = puo.SetExploreRankingID(*id)
+	}
+	return puo
+}
+
+// SetExploreRanking sets the "explore_ranking" edge to the ExploreRanking entity.
+func (puo *PostUpdateOne) SetExploreRanking(e *ExploreRanking) *PostUpdateOne {
+	return puo.SetExploreRankingID(e.ID)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (puo *PostUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddRevisionIDs(ids...)
+	return puo
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (puo *PostUpdateOne) AddRevisions(p ...*PostRevision) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddRevisionIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the PostMedia entity by IDs.
+func (puo *PostUpdateOne) AddMediumIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddMediumIDs(ids...)
+	return puo
+}
+
+// AddMedia adds the "media" edges to the PostMedia entity.
+func (puo *PostUpdateOne) AddMedia(p ...*PostMedia) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddMediumIDs(ids...)
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by IDs.
+func (puo *PostUpdateOne) AddPetIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddPetIDs(ids...)
+	return puo
+}
+
+// AddPets adds the "pets" edges to the Pet entity.
+func (puo *PostUpdateOne) AddPets(p ...*Pet) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddPetIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (puo *PostUpdateOne) AddTagIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddTagIDs(ids...)
+	return puo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (puo *PostUpdateOne) AddTags(t ...*Tag) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTagIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the User entity by IDs.
+func (puo *PostUpdateOne) AddMentionIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddMentionIDs(ids...)
+	return puo
+}
+
+// AddMentions adds the "mentions" edges to the User entity.
+func (puo *PostUpdateOne) AddMentions(u ...*User) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return puo.AddMentionIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (puo *PostUpdateOne) AddNotificationIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.AddNotificationIDs(ids...)
+	return puo
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (puo *PostUpdateOne) AddNotifications(n ...*Notification) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return puo.AddNotificationIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (puo *PostUpdateOne) Mutation() *PostMutation {
 	return puo.mutation
@@ -638,6 +1627,159 @@ func (puo *PostUpdateOne) ClearDailyTask() *PostUpdateOne {
 	return puo
 }
 
+// ClearReports clears all "reports" edges to the Report entity.
+func (puo *PostUpdateOne) ClearReports() *PostUpdateOne {
+	puo.mutation.ClearReports()
+	return puo
+}
+
+// RemoveReportIDs removes the "reports" edge to Report entities by IDs.
+func (puo *PostUpdateOne) RemoveReportIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveReportIDs(ids...)
+	return puo
+}
+
+// RemoveReports removes "reports" edges to Report entities.
+func (puo *PostUpdateOne) RemoveReports(r ...*Report) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveReportIDs(ids...)
+}
+
+// ClearExploreRanking clears the "explore_ranking" edge to the ExploreRanking entity.
+func (puo *PostUpdateOne) ClearExploreRanking() *PostUpdateOne {
+	puo.mutation.ClearExploreRanking()
+	return puo
+}
+
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (puo *PostUpdateOne) ClearRevisions() *PostUpdateOne {
+	puo.mutation.ClearRevisions()
+	return puo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (puo *PostUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveRevisionIDs(ids...)
+	return puo
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (puo *PostUpdateOne) RemoveRevisions(p ...*PostRevision) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveRevisionIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the PostMedia entity.
+func (puo *PostUpdateOne) ClearMedia() *PostUpdateOne {
+	puo.mutation.ClearMedia()
+	return puo
+}
+
+// RemoveMediumIDs removes the "media" edge to PostMedia entities by IDs.
+func (puo *PostUpdateOne) RemoveMediumIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveMediumIDs(ids...)
+	return puo
+}
+
+// RemoveMedia removes "media" edges to PostMedia entities.
+func (puo *PostUpdateOne) RemoveMedia(p ...*PostMedia) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveMediumIDs(ids...)
+}
+
+// ClearPets clears all "pets" edges to the Pet entity.
+func (puo *PostUpdateOne) ClearPets() *PostUpdateOne {
+	puo.mutation.ClearPets()
+	return puo
+}
+
+// RemovePetIDs removes the "pets" edge to Pet entities by IDs.
+func (puo *PostUpdateOne) RemovePetIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemovePetIDs(ids...)
+	return puo
+}
+
+// RemovePets removes "pets" edges to Pet entities.
+func (puo *PostUpdateOne) RemovePets(p ...*Pet) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemovePetIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (puo *PostUpdateOne) ClearTags() *PostUpdateOne {
+	puo.mutation.ClearTags()
+	return puo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (puo *PostUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveTagIDs(ids...)
+	return puo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (puo *PostUpdateOne) RemoveTags(t ...*Tag) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTagIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the User entity.
+func (puo *PostUpdateOne) ClearMentions() *PostUpdateOne {
+	puo.mutation.ClearMentions()
+	return puo
+}
+
+// RemoveMentionIDs removes the "mentions" edge to User entities by IDs.
+func (puo *PostUpdateOne) RemoveMentionIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveMentionIDs(ids...)
+	return puo
+}
+
+// RemoveMentions removes "mentions" edges to User entities.
+func (puo *PostUpdateOne) RemoveMentions(u ...*User) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return puo.RemoveMentionIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (puo *PostUpdateOne) ClearNotifications() *PostUpdateOne {
+	puo.mutation.ClearNotifications()
+	return puo
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (puo *PostUpdateOne) RemoveNotificationIDs(ids ...uuid.UUID) *PostUpdateOne {
+	puo.mutation.RemoveNotificationIDs(ids...)
+	return puo
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (puo *PostUpdateOne) RemoveNotifications(n ...*Notification) *PostUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return puo.RemoveNotificationIDs(ids...)
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (puo *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	puo.mutation.Where(ps...)
@@ -690,6 +1832,11 @@ func (puo *PostUpdateOne) check() error {
 			return &ValidationError{Name: "image_key", err: fmt.Errorf(`ent: validator failed for field "Post.image_key": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.MediaType(); ok {
+		if err := post.MediaTypeValidator(v); err != nil {
+			return &ValidationError{Name: "media_type", err: fmt.Errorf(`ent: validator failed for field "Post.media_type": %w`, err)}
+		}
+	}
 	if puo.mutation.UserCleared() && len(puo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Post.user"`)
 	}
@@ -734,6 +1881,9 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if value, ok := puo.mutation.ImageKey(); ok {
 		_spec.SetField(post.FieldImageKey, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.MediaType(); ok {
+		_spec.SetField(post.FieldMediaType, field.TypeEnum, value)
+	}
 	if value, ok := puo.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -743,6 +1893,30 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if puo.mutation.DeletedAtCleared() {
 		_spec.ClearField(post.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := puo.mutation.HiddenAt(); ok {
+		_spec.SetField(post.FieldHiddenAt, field.TypeTime, value)
+	}
+	if puo.mutation.HiddenAtCleared() {
+		_spec.ClearField(post.FieldHiddenAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.EditedAt(); ok {
+		_spec.SetField(post.FieldEditedAt, field.TypeTime, value)
+	}
+	if puo.mutation.EditedAtCleared() {
+		_spec.ClearField(post.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.LikeCount(); ok {
+		_spec.SetField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedLikeCount(); ok {
+		_spec.AddField(post.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.CommentCount(); ok {
+		_spec.SetField(post.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedCommentCount(); ok {
+		_spec.AddField(post.FieldCommentCount, field.TypeInt, value)
+	}
 	if puo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -891,6 +2065,362 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.ReportsTable,
+			Columns: []string{post.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedReportsIDs(); len(nodes) > 0 && !puo.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.ReportsTable,
+			Columns: []string{post.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.ReportsTable,
+			Columns: []string{post.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ExploreRankingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.ExploreRankingTable,
+			Columns: []string{post.ExploreRankingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ExploreRankingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   post.ExploreRankingTable,
+			Columns: []string{post.ExploreRankingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exploreranking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMediaIDs(); len(nodes) > 0 && !puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.MediaTable,
+			Columns: []string{post.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.PetsTable,
+			Columns: post.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedPetsIDs(); len(nodes) > 0 && !puo.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.PetsTable,
+			Columns: post.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.PetsTable,
+			Columns: post.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &PostTagCreate{config: puo.config, mutation: newPostTagMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !puo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &PostTagCreate{config: puo.config, mutation: newPostTagMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.TagsTable,
+			Columns: post.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &PostTagCreate{config: puo.config, mutation: newPostTagMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !puo.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   post.MentionsTable,
+			Columns: post.MentionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !puo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.NotificationsTable,
+			Columns: []string{post.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Post{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
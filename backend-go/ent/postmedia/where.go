@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postmedia
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldID, id))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosition, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldKey, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldHeight, v))
+}
+
+// AltText applies equality check predicate on the "alt_text" field. It's identical to AltTextEQ.
+func AltText(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldAltText, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// PosterKey applies equality check predicate on the "poster_key" field. It's identical to PosterKeyEQ.
+func PosterKey(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosterKey, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldPosition, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v enum.MediaType) predicate.PostMedia {
+	vc := v
+	return predicate.PostMedia(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v enum.MediaType) predicate.PostMedia {
+	vc := v
+	return predicate.PostMedia(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...enum.MediaType) predicate.PostMedia {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PostMedia(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...enum.MediaType) predicate.PostMedia {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PostMedia(sql.FieldNotIn(FieldType, v...))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContainsFold(FieldKey, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldWidth, v))
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIsNull(FieldWidth))
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotNull(FieldWidth))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldHeight, v))
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIsNull(FieldHeight))
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotNull(FieldHeight))
+}
+
+// AltTextEQ applies the EQ predicate on the "alt_text" field.
+func AltTextEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldAltText, v))
+}
+
+// AltTextNEQ applies the NEQ predicate on the "alt_text" field.
+func AltTextNEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldAltText, v))
+}
+
+// AltTextIn applies the In predicate on the "alt_text" field.
+func AltTextIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldAltText, vs...))
+}
+
+// AltTextNotIn applies the NotIn predicate on the "alt_text" field.
+func AltTextNotIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldAltText, vs...))
+}
+
+// AltTextGT applies the GT predicate on the "alt_text" field.
+func AltTextGT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldAltText, v))
+}
+
+// AltTextGTE applies the GTE predicate on the "alt_text" field.
+func AltTextGTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldAltText, v))
+}
+
+// AltTextLT applies the LT predicate on the "alt_text" field.
+func AltTextLT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldAltText, v))
+}
+
+// AltTextLTE applies the LTE predicate on the "alt_text" field.
+func AltTextLTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldAltText, v))
+}
+
+// AltTextContains applies the Contains predicate on the "alt_text" field.
+func AltTextContains(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContains(FieldAltText, v))
+}
+
+// AltTextHasPrefix applies the HasPrefix predicate on the "alt_text" field.
+func AltTextHasPrefix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasPrefix(FieldAltText, v))
+}
+
+// AltTextHasSuffix applies the HasSuffix predicate on the "alt_text" field.
+func AltTextHasSuffix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasSuffix(FieldAltText, v))
+}
+
+// AltTextEqualFold applies the EqualFold predicate on the "alt_text" field.
+func AltTextEqualFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEqualFold(FieldAltText, v))
+}
+
+// AltTextContainsFold applies the ContainsFold predicate on the "alt_text" field.
+func AltTextContainsFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContainsFold(FieldAltText, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// DurationMsIsNil applies the IsNil predicate on the "duration_ms" field.
+func DurationMsIsNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIsNull(FieldDurationMs))
+}
+
+// DurationMsNotNil applies the NotNil predicate on the "duration_ms" field.
+func DurationMsNotNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotNull(FieldDurationMs))
+}
+
+// PosterKeyEQ applies the EQ predicate on the "poster_key" field.
+func PosterKeyEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEQ(FieldPosterKey, v))
+}
+
+// PosterKeyNEQ applies the NEQ predicate on the "poster_key" field.
+func PosterKeyNEQ(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNEQ(FieldPosterKey, v))
+}
+
+// PosterKeyIn applies the In predicate on the "poster_key" field.
+func PosterKeyIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIn(FieldPosterKey, vs...))
+}
+
+// PosterKeyNotIn applies the NotIn predicate on the "poster_key" field.
+func PosterKeyNotIn(vs ...string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotIn(FieldPosterKey, vs...))
+}
+
+// PosterKeyGT applies the GT predicate on the "poster_key" field.
+func PosterKeyGT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGT(FieldPosterKey, v))
+}
+
+// PosterKeyGTE applies the GTE predicate on the "poster_key" field.
+func PosterKeyGTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldGTE(FieldPosterKey, v))
+}
+
+// PosterKeyLT applies the LT predicate on the "poster_key" field.
+func PosterKeyLT(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLT(FieldPosterKey, v))
+}
+
+// PosterKeyLTE applies the LTE predicate on the "poster_key" field.
+func PosterKeyLTE(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldLTE(FieldPosterKey, v))
+}
+
+// PosterKeyContains applies the Contains predicate on the "poster_key" field.
+func PosterKeyContains(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContains(FieldPosterKey, v))
+}
+
+// PosterKeyHasPrefix applies the HasPrefix predicate on the "poster_key" field.
+func PosterKeyHasPrefix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasPrefix(FieldPosterKey, v))
+}
+
+// PosterKeyHasSuffix applies the HasSuffix predicate on the "poster_key" field.
+func PosterKeyHasSuffix(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldHasSuffix(FieldPosterKey, v))
+}
+
+// PosterKeyIsNil applies the IsNil predicate on the "poster_key" field.
+func PosterKeyIsNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldIsNull(FieldPosterKey))
+}
+
+// PosterKeyNotNil applies the NotNil predicate on the "poster_key" field.
+func PosterKeyNotNil() predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldNotNull(FieldPosterKey))
+}
+
+// PosterKeyEqualFold applies the EqualFold predicate on the "poster_key" field.
+func PosterKeyEqualFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldEqualFold(FieldPosterKey, v))
+}
+
+// PosterKeyContainsFold applies the ContainsFold predicate on the "poster_key" field.
+func PosterKeyContainsFold(v string) predicate.PostMedia {
+	return predicate.PostMedia(sql.FieldContainsFold(FieldPosterKey, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.PostMedia {
+	return predicate.PostMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.PostMedia {
+	return predicate.PostMedia(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PostMedia) predicate.PostMedia {
+	return predicate.PostMedia(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PostMedia) predicate.PostMedia {
+	return predicate.PostMedia(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PostMedia) predicate.PostMedia {
+	return predicate.PostMedia(sql.NotPredicates(p))
+}
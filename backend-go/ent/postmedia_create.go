@@ -0,0 +1,1131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/google/uuid"
+)
+
+// PostMediaCreate is the builder for creating a PostMedia entity.
+type PostMediaCreate struct {
+	config
+	mutation *PostMediaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPosition sets the "position" field.
+func (pmc *PostMediaCreate) SetPosition(i int) *PostMediaCreate {
+	pmc.mutation.SetPosition(i)
+	return pmc
+}
+
+// SetType sets the "type" field.
+func (pmc *PostMediaCreate) SetType(et enum.MediaType) *PostMediaCreate {
+	pmc.mutation.SetType(et)
+	return pmc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (pmc *PostMediaCreate) SetNillableType(et *enum.MediaType) *PostMediaCreate {
+	if et != nil {
+		pmc.SetType(*et)
+	}
+	return pmc
+}
+
+// SetKey sets the "key" field.
+func (pmc *PostMediaCreate) SetKey(s string) *PostMediaCreate {
+	pmc.mutation.SetKey(s)
+	return pmc
+}
+
+// SetWidth sets the "width" field.
+func (pmc *PostMediaCreate) SetWidth(i int) *PostMediaCreate {
+	pmc.mutation.SetWidth(i)
+	return pmc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (pmc *PostMediaCreate) SetNillableWidth(i *int) *PostMediaCreate {
+	if i != nil {
+		pmc.SetWidth(*i)
+	}
+	return pmc
+}
+
+// SetHeight sets the "height" field.
+func (pmc *PostMediaCreate) SetHeight(i int) *PostMediaCreate {
+	pmc.mutation.SetHeight(i)
+	return pmc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pmc *PostMediaCreate) SetNillableHeight(i *int) *PostMediaCreate {
+	if i != nil {
+		pmc.SetHeight(*i)
+	}
+	return pmc
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmc *PostMediaCreate) SetAltText(s string) *PostMediaCreate {
+	pmc.mutation.SetAltText(s)
+	return pmc
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmc *PostMediaCreate) SetNillableAltText(s *string) *PostMediaCreate {
+	if s != nil {
+		pmc.SetAltText(*s)
+	}
+	return pmc
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (pmc *PostMediaCreate) SetDurationMs(i int) *PostMediaCreate {
+	pmc.mutation.SetDurationMs(i)
+	return pmc
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (pmc *PostMediaCreate) SetNillableDurationMs(i *int) *PostMediaCreate {
+	if i != nil {
+		pmc.SetDurationMs(*i)
+	}
+	return pmc
+}
+
+// SetPosterKey sets the "poster_key" field.
+func (pmc *PostMediaCreate) SetPosterKey(s string) *PostMediaCreate {
+	pmc.mutation.SetPosterKey(s)
+	return pmc
+}
+
+// SetNillablePosterKey sets the "poster_key" field if the given value is not nil.
+func (pmc *PostMediaCreate) SetNillablePosterKey(s *string) *PostMediaCreate {
+	if s != nil {
+		pmc.SetPosterKey(*s)
+	}
+	return pmc
+}
+
+// SetID sets the "id" field.
+func (pmc *PostMediaCreate) SetID(u uuid.UUID) *PostMediaCreate {
+	pmc.mutation.SetID(u)
+	return pmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pmc *PostMediaCreate) SetNillableID(u *uuid.UUID) *PostMediaCreate {
+	if u != nil {
+		pmc.SetID(*u)
+	}
+	return pmc
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pmc *PostMediaCreate) SetPostID(id uuid.UUID) *PostMediaCreate {
+	pmc.mutation.SetPostID(id)
+	return pmc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pmc *PostMediaCreate) SetPost(p *Post) *PostMediaCreate {
+	return pmc.SetPostID(p.ID)
+}
+
+// Mutation returns the PostMediaMutation object of the builder.
+func (pmc *PostMediaCreate) Mutation() *PostMediaMutation {
+	return pmc.mutation
+}
+
+// Save creates the PostMedia in the database.
+func (pmc *PostMediaCreate) Save(ctx context.Context) (*PostMedia, error) {
+	pmc.defaults()
+	return withHooks(ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *PostMediaCreate) SaveX(ctx context.Context) *PostMedia {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *PostMediaCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *PostMediaCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmc *PostMediaCreate) defaults() {
+	if _, ok := pmc.mutation.GetType(); !ok {
+		v := postmedia.DefaultType
+		pmc.mutation.SetType(v)
+	}
+	if _, ok := pmc.mutation.AltText(); !ok {
+		v := postmedia.DefaultAltText
+		pmc.mutation.SetAltText(v)
+	}
+	if _, ok := pmc.mutation.ID(); !ok {
+		v := postmedia.DefaultID()
+		pmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *PostMediaCreate) check() error {
+	if _, ok := pmc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PostMedia.position"`)}
+	}
+	if v, ok := pmc.mutation.Position(); ok {
+		if err := postmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "PostMedia.position": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "PostMedia.type"`)}
+	}
+	if v, ok := pmc.mutation.GetType(); ok {
+		if err := postmedia.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PostMedia.type": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "PostMedia.key"`)}
+	}
+	if v, ok := pmc.mutation.Key(); ok {
+		if err := postmedia.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "PostMedia.key": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.AltText(); !ok {
+		return &ValidationError{Name: "alt_text", err: errors.New(`ent: missing required field "PostMedia.alt_text"`)}
+	}
+	if len(pmc.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostMedia.post"`)}
+	}
+	return nil
+}
+
+func (pmc *PostMediaCreate) sqlSave(ctx context.Context) (*PostMedia, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *PostMediaCreate) createSpec() (*PostMedia, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostMedia{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(postmedia.Table, sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pmc.conflict
+	if id, ok := pmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pmc.mutation.Position(); ok {
+		_spec.SetField(postmedia.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := pmc.mutation.GetType(); ok {
+		_spec.SetField(postmedia.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := pmc.mutation.Key(); ok {
+		_spec.SetField(postmedia.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := pmc.mutation.Width(); ok {
+		_spec.SetField(postmedia.FieldWidth, field.TypeInt, value)
+		_node.Width = &value
+	}
+	if value, ok := pmc.mutation.Height(); ok {
+		_spec.SetField(postmedia.FieldHeight, field.TypeInt, value)
+		_node.Height = &value
+	}
+	if value, ok := pmc.mutation.AltText(); ok {
+		_spec.SetField(postmedia.FieldAltText, field.TypeString, value)
+		_node.AltText = value
+	}
+	if value, ok := pmc.mutation.DurationMs(); ok {
+		_spec.SetField(postmedia.FieldDurationMs, field.TypeInt, value)
+		_node.DurationMs = &value
+	}
+	if value, ok := pmc.mutation.PosterKey(); ok {
+		_spec.SetField(postmedia.FieldPosterKey, field.TypeString, value)
+		_node.PosterKey = value
+	}
+	if nodes := pmc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postmedia.PostTable,
+			Columns: []string{postmedia.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_media = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostMedia.Create().
+//		SetPosition(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostMediaUpsert) {
+//			SetPosition(v+v).
+//		}).
+//		Exec(ctx)
+func (pmc *PostMediaCreate) OnConflict(opts ...sql.ConflictOption) *PostMediaUpsertOne {
+	pmc.conflict = opts
+	return &PostMediaUpsertOne{
+		create: pmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostMedia.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmc *PostMediaCreate) OnConflictColumns(columns ...string) *PostMediaUpsertOne {
+	pmc.conflict = append(pmc.conflict, sql.ConflictColumns(columns...))
+	return &PostMediaUpsertOne{
+		create: pmc,
+	}
+}
+
+type (
+	// PostMediaUpsertOne is the builder for "upsert"-ing
+	//  one PostMedia node.
+	PostMediaUpsertOne struct {
+		create *PostMediaCreate
+	}
+
+	// PostMediaUpsert is the "OnConflict" setter.
+	PostMediaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPosition sets the "position" field.
+func (u *PostMediaUpsert) SetPosition(v int) *PostMediaUpsert {
+	u.Set(postmedia.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdatePosition() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PostMediaUpsert) AddPosition(v int) *PostMediaUpsert {
+	u.Add(postmedia.FieldPosition, v)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *PostMediaUpsert) SetType(v enum.MediaType) *PostMediaUpsert {
+	u.Set(postmedia.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdateType() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldType)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *PostMediaUpsert) SetKey(v string) *PostMediaUpsert {
+	u.Set(postmedia.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdateKey() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldKey)
+	return u
+}
+
+// SetWidth sets the "width" field.
+func (u *PostMediaUpsert) SetWidth(v int) *PostMediaUpsert {
+	u.Set(postmedia.FieldWidth, v)
+	return u
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdateWidth() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldWidth)
+	return u
+}
+
+// AddWidth adds v to the "width" field.
+func (u *PostMediaUpsert) AddWidth(v int) *PostMediaUpsert {
+	u.Add(postmedia.FieldWidth, v)
+	return u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *PostMediaUpsert) ClearWidth() *PostMediaUpsert {
+	u.SetNull(postmedia.FieldWidth)
+	return u
+}
+
+// SetHeight sets the "height" field.
+func (u *PostMediaUpsert) SetHeight(v int) *PostMediaUpsert {
+	u.Set(postmedia.FieldHeight, v)
+	return u
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdateHeight() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldHeight)
+	return u
+}
+
+// AddHeight adds v to the "height" field.
+func (u *PostMediaUpsert) AddHeight(v int) *PostMediaUpsert {
+	u.Add(postmedia.FieldHeight, v)
+	return u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *PostMediaUpsert) ClearHeight() *PostMediaUpsert {
+	u.SetNull(postmedia.FieldHeight)
+	return u
+}
+
+// SetAltText sets the "alt_text" field.
+func (u *PostMediaUpsert) SetAltText(v string) *PostMediaUpsert {
+	u.Set(postmedia.FieldAltText, v)
+	return u
+}
+
+// UpdateAltText sets the "alt_text" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdateAltText() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldAltText)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *PostMediaUpsert) SetDurationMs(v int) *PostMediaUpsert {
+	u.Set(postmedia.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdateDurationMs() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *PostMediaUpsert) AddDurationMs(v int) *PostMediaUpsert {
+	u.Add(postmedia.FieldDurationMs, v)
+	return u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *PostMediaUpsert) ClearDurationMs() *PostMediaUpsert {
+	u.SetNull(postmedia.FieldDurationMs)
+	return u
+}
+
+// SetPosterKey sets the "poster_key" field.
+func (u *PostMediaUpsert) SetPosterKey(v string) *PostMediaUpsert {
+	u.Set(postmedia.FieldPosterKey, v)
+	return u
+}
+
+// UpdatePosterKey sets the "poster_key" field to the value that was provided on create.
+func (u *PostMediaUpsert) UpdatePosterKey() *PostMediaUpsert {
+	u.SetExcluded(postmedia.FieldPosterKey)
+	return u
+}
+
+// ClearPosterKey clears the value of the "poster_key" field.
+func (u *PostMediaUpsert) ClearPosterKey() *PostMediaUpsert {
+	u.SetNull(postmedia.FieldPosterKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PostMedia.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postmedia.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostMediaUpsertOne) UpdateNewValues() *PostMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(postmedia.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostMedia.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostMediaUpsertOne) Ignore() *PostMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostMediaUpsertOne) DoNothing() *PostMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostMediaCreate.OnConflict
+// documentation for more info.
+func (u *PostMediaUpsertOne) Update(set func(*PostMediaUpsert)) *PostMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostMediaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *PostMediaUpsertOne) SetPosition(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PostMediaUpsertOne) AddPosition(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdatePosition() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PostMediaUpsertOne) SetType(v enum.MediaType) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdateType() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *PostMediaUpsertOne) SetKey(v string) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdateKey() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *PostMediaUpsertOne) SetWidth(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *PostMediaUpsertOne) AddWidth(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdateWidth() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *PostMediaUpsertOne) ClearWidth() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *PostMediaUpsertOne) SetHeight(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *PostMediaUpsertOne) AddHeight(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdateHeight() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *PostMediaUpsertOne) ClearHeight() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearHeight()
+	})
+}
+
+// SetAltText sets the "alt_text" field.
+func (u *PostMediaUpsertOne) SetAltText(v string) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetAltText(v)
+	})
+}
+
+// UpdateAltText sets the "alt_text" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdateAltText() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateAltText()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *PostMediaUpsertOne) SetDurationMs(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *PostMediaUpsertOne) AddDurationMs(v int) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdateDurationMs() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *PostMediaUpsertOne) ClearDurationMs() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetPosterKey sets the "poster_key" field.
+func (u *PostMediaUpsertOne) SetPosterKey(v string) *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetPosterKey(v)
+	})
+}
+
+// UpdatePosterKey sets the "poster_key" field to the value that was provided on create.
+func (u *PostMediaUpsertOne) UpdatePosterKey() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdatePosterKey()
+	})
+}
+
+// ClearPosterKey clears the value of the "poster_key" field.
+func (u *PostMediaUpsertOne) ClearPosterKey() *PostMediaUpsertOne {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearPosterKey()
+	})
+}
+
+// Exec executes the query.
+func (u *PostMediaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostMediaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostMediaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostMediaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PostMediaUpsertOne.ID is not supported by MySQL driver. Use PostMediaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostMediaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostMediaCreateBulk is the builder for creating many PostMedia entities in bulk.
+type PostMediaCreateBulk struct {
+	config
+	err      error
+	builders []*PostMediaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostMedia entities in the database.
+func (pmcb *PostMediaCreateBulk) Save(ctx context.Context) ([]*PostMedia, error) {
+	if pmcb.err != nil {
+		return nil, pmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*PostMedia, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostMediaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *PostMediaCreateBulk) SaveX(ctx context.Context) []*PostMedia {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *PostMediaCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *PostMediaCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostMedia.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostMediaUpsert) {
+//			SetPosition(v+v).
+//		}).
+//		Exec(ctx)
+func (pmcb *PostMediaCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostMediaUpsertBulk {
+	pmcb.conflict = opts
+	return &PostMediaUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostMedia.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmcb *PostMediaCreateBulk) OnConflictColumns(columns ...string) *PostMediaUpsertBulk {
+	pmcb.conflict = append(pmcb.conflict, sql.ConflictColumns(columns...))
+	return &PostMediaUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// PostMediaUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostMedia nodes.
+type PostMediaUpsertBulk struct {
+	create *PostMediaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostMedia.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postmedia.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostMediaUpsertBulk) UpdateNewValues() *PostMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(postmedia.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostMedia.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostMediaUpsertBulk) Ignore() *PostMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostMediaUpsertBulk) DoNothing() *PostMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostMediaCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostMediaUpsertBulk) Update(set func(*PostMediaUpsert)) *PostMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostMediaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *PostMediaUpsertBulk) SetPosition(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PostMediaUpsertBulk) AddPosition(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdatePosition() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PostMediaUpsertBulk) SetType(v enum.MediaType) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdateType() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *PostMediaUpsertBulk) SetKey(v string) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdateKey() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *PostMediaUpsertBulk) SetWidth(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *PostMediaUpsertBulk) AddWidth(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdateWidth() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// ClearWidth clears the value of the "width" field.
+func (u *PostMediaUpsertBulk) ClearWidth() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *PostMediaUpsertBulk) SetHeight(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *PostMediaUpsertBulk) AddHeight(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdateHeight() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// ClearHeight clears the value of the "height" field.
+func (u *PostMediaUpsertBulk) ClearHeight() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearHeight()
+	})
+}
+
+// SetAltText sets the "alt_text" field.
+func (u *PostMediaUpsertBulk) SetAltText(v string) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetAltText(v)
+	})
+}
+
+// UpdateAltText sets the "alt_text" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdateAltText() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateAltText()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *PostMediaUpsertBulk) SetDurationMs(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *PostMediaUpsertBulk) AddDurationMs(v int) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdateDurationMs() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *PostMediaUpsertBulk) ClearDurationMs() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetPosterKey sets the "poster_key" field.
+func (u *PostMediaUpsertBulk) SetPosterKey(v string) *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.SetPosterKey(v)
+	})
+}
+
+// UpdatePosterKey sets the "poster_key" field to the value that was provided on create.
+func (u *PostMediaUpsertBulk) UpdatePosterKey() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.UpdatePosterKey()
+	})
+}
+
+// ClearPosterKey clears the value of the "poster_key" field.
+func (u *PostMediaUpsertBulk) ClearPosterKey() *PostMediaUpsertBulk {
+	return u.Update(func(s *PostMediaUpsert) {
+		s.ClearPosterKey()
+	})
+}
+
+// Exec executes the query.
+func (u *PostMediaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostMediaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostMediaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostMediaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// PostMediaUpdate is the builder for updating PostMedia entities.
+type PostMediaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostMediaMutation
+}
+
+// Where appends a list predicates to the PostMediaUpdate builder.
+func (pmu *PostMediaUpdate) Where(ps ...predicate.PostMedia) *PostMediaUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// SetPosition sets the "position" field.
+func (pmu *PostMediaUpdate) SetPosition(i int) *PostMediaUpdate {
+	pmu.mutation.ResetPosition()
+	pmu.mutation.SetPosition(i)
+	return pmu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillablePosition(i *int) *PostMediaUpdate {
+	if i != nil {
+		pmu.SetPosition(*i)
+	}
+	return pmu
+}
+
+// AddPosition adds i to the "position" field.
+func (pmu *PostMediaUpdate) AddPosition(i int) *PostMediaUpdate {
+	pmu.mutation.AddPosition(i)
+	return pmu
+}
+
+// SetType sets the "type" field.
+func (pmu *PostMediaUpdate) SetType(et enum.MediaType) *PostMediaUpdate {
+	pmu.mutation.SetType(et)
+	return pmu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillableType(et *enum.MediaType) *PostMediaUpdate {
+	if et != nil {
+		pmu.SetType(*et)
+	}
+	return pmu
+}
+
+// SetKey sets the "key" field.
+func (pmu *PostMediaUpdate) SetKey(s string) *PostMediaUpdate {
+	pmu.mutation.SetKey(s)
+	return pmu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillableKey(s *string) *PostMediaUpdate {
+	if s != nil {
+		pmu.SetKey(*s)
+	}
+	return pmu
+}
+
+// SetWidth sets the "width" field.
+func (pmu *PostMediaUpdate) SetWidth(i int) *PostMediaUpdate {
+	pmu.mutation.ResetWidth()
+	pmu.mutation.SetWidth(i)
+	return pmu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillableWidth(i *int) *PostMediaUpdate {
+	if i != nil {
+		pmu.SetWidth(*i)
+	}
+	return pmu
+}
+
+// AddWidth adds i to the "width" field.
+func (pmu *PostMediaUpdate) AddWidth(i int) *PostMediaUpdate {
+	pmu.mutation.AddWidth(i)
+	return pmu
+}
+
+// ClearWidth clears the value of the "width" field.
+func (pmu *PostMediaUpdate) ClearWidth() *PostMediaUpdate {
+	pmu.mutation.ClearWidth()
+	return pmu
+}
+
+// SetHeight sets the "height" field.
+func (pmu *PostMediaUpdate) SetHeight(i int) *PostMediaUpdate {
+	pmu.mutation.ResetHeight()
+	pmu.mutation.SetHeight(i)
+	return pmu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillableHeight(i *int) *PostMediaUpdate {
+	if i != nil {
+		pmu.SetHeight(*i)
+	}
+	return pmu
+}
+
+// AddHeight adds i to the "height" field.
+func (pmu *PostMediaUpdate) AddHeight(i int) *PostMediaUpdate {
+	pmu.mutation.AddHeight(i)
+	return pmu
+}
+
+// ClearHeight clears the value of the "height" field.
+func (pmu *PostMediaUpdate) ClearHeight() *PostMediaUpdate {
+	pmu.mutation.ClearHeight()
+	return pmu
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmu *PostMediaUpdate) SetAltText(s string) *PostMediaUpdate {
+	pmu.mutation.SetAltText(s)
+	return pmu
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillableAltText(s *string) *PostMediaUpdate {
+	if s != nil {
+		pmu.SetAltText(*s)
+	}
+	return pmu
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (pmu *PostMediaUpdate) SetDurationMs(i int) *PostMediaUpdate {
+	pmu.mutation.ResetDurationMs()
+	pmu.mutation.SetDurationMs(i)
+	return pmu
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillableDurationMs(i *int) *PostMediaUpdate {
+	if i != nil {
+		pmu.SetDurationMs(*i)
+	}
+	return pmu
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (pmu *PostMediaUpdate) AddDurationMs(i int) *PostMediaUpdate {
+	pmu.mutation.AddDurationMs(i)
+	return pmu
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (pmu *PostMediaUpdate) ClearDurationMs() *PostMediaUpdate {
+	pmu.mutation.ClearDurationMs()
+	return pmu
+}
+
+// SetPosterKey sets the "poster_key" field.
+func (pmu *PostMediaUpdate) SetPosterKey(s string) *PostMediaUpdate {
+	pmu.mutation.SetPosterKey(s)
+	return pmu
+}
+
+// SetNillablePosterKey sets the "poster_key" field if the given value is not nil.
+func (pmu *PostMediaUpdate) SetNillablePosterKey(s *string) *PostMediaUpdate {
+	if s != nil {
+		pmu.SetPosterKey(*s)
+	}
+	return pmu
+}
+
+// ClearPosterKey clears the value of the "poster_key" field.
+func (pmu *PostMediaUpdate) ClearPosterKey() *PostMediaUpdate {
+	pmu.mutation.ClearPosterKey()
+	return pmu
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pmu *PostMediaUpdate) SetPostID(id uuid.UUID) *PostMediaUpdate {
+	pmu.mutation.SetPostID(id)
+	return pmu
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pmu *PostMediaUpdate) SetPost(p *Post) *PostMediaUpdate {
+	return pmu.SetPostID(p.ID)
+}
+
+// Mutation returns the PostMediaMutation object of the builder.
+func (pmu *PostMediaUpdate) Mutation() *PostMediaMutation {
+	return pmu.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pmu *PostMediaUpdate) ClearPost() *PostMediaUpdate {
+	pmu.mutation.ClearPost()
+	return pmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *PostMediaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *PostMediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *PostMediaUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *PostMediaUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmu *PostMediaUpdate) check() error {
+	if v, ok := pmu.mutation.Position(); ok {
+		if err := postmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "PostMedia.position": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.GetType(); ok {
+		if err := postmedia.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PostMedia.type": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.Key(); ok {
+		if err := postmedia.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "PostMedia.key": %w`, err)}
+		}
+	}
+	if pmu.mutation.PostCleared() && len(pmu.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostMedia.post"`)
+	}
+	return nil
+}
+
+func (pmu *PostMediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postmedia.Table, postmedia.Columns, sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmu.mutation.Position(); ok {
+		_spec.SetField(postmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.AddedPosition(); ok {
+		_spec.AddField(postmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.GetType(); ok {
+		_spec.SetField(postmedia.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := pmu.mutation.Key(); ok {
+		_spec.SetField(postmedia.FieldKey, field.TypeString, value)
+	}
+	if value, ok := pmu.mutation.Width(); ok {
+		_spec.SetField(postmedia.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.AddedWidth(); ok {
+		_spec.AddField(postmedia.FieldWidth, field.TypeInt, value)
+	}
+	if pmu.mutation.WidthCleared() {
+		_spec.ClearField(postmedia.FieldWidth, field.TypeInt)
+	}
+	if value, ok := pmu.mutation.Height(); ok {
+		_spec.SetField(postmedia.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.AddedHeight(); ok {
+		_spec.AddField(postmedia.FieldHeight, field.TypeInt, value)
+	}
+	if pmu.mutation.HeightCleared() {
+		_spec.ClearField(postmedia.FieldHeight, field.TypeInt)
+	}
+	if value, ok := pmu.mutation.AltText(); ok {
+		_spec.SetField(postmedia.FieldAltText, field.TypeString, value)
+	}
+	if value, ok := pmu.mutation.DurationMs(); ok {
+		_spec.SetField(postmedia.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.AddedDurationMs(); ok {
+		_spec.AddField(postmedia.FieldDurationMs, field.TypeInt, value)
+	}
+	if pmu.mutation.DurationMsCleared() {
+		_spec.ClearField(postmedia.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := pmu.mutation.PosterKey(); ok {
+		_spec.SetField(postmedia.FieldPosterKey, field.TypeString, value)
+	}
+	if pmu.mutation.PosterKeyCleared() {
+		_spec.ClearField(postmedia.FieldPosterKey, field.TypeString)
+	}
+	if pmu.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postmedia.PostTable,
+			Columns: []string{postmedia.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postmedia.PostTable,
+			Columns: []string{postmedia.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// PostMediaUpdateOne is the builder for updating a single PostMedia entity.
+type PostMediaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostMediaMutation
+}
+
+// SetPosition sets the "position" field.
+func (pmuo *PostMediaUpdateOne) SetPosition(i int) *PostMediaUpdateOne {
+	pmuo.mutation.ResetPosition()
+	pmuo.mutation.SetPosition(i)
+	return pmuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillablePosition(i *int) *PostMediaUpdateOne {
+	if i != nil {
+		pmuo.SetPosition(*i)
+	}
+	return pmuo
+}
+
+// AddPosition adds i to the "position" field.
+func (pmuo *PostMediaUpdateOne) AddPosition(i int) *PostMediaUpdateOne {
+	pmuo.mutation.AddPosition(i)
+	return pmuo
+}
+
+// SetType sets the "type" field.
+func (pmuo *PostMediaUpdateOne) SetType(et enum.MediaType) *PostMediaUpdateOne {
+	pmuo.mutation.SetType(et)
+	return pmuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillableType(et *enum.MediaType) *PostMediaUpdateOne {
+	if et != nil {
+		pmuo.SetType(*et)
+	}
+	return pmuo
+}
+
+// SetKey sets the "key" field.
+func (pmuo *PostMediaUpdateOne) SetKey(s string) *PostMediaUpdateOne {
+	pmuo.mutation.SetKey(s)
+	return pmuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillableKey(s *string) *PostMediaUpdateOne {
+	if s != nil {
+		pmuo.SetKey(*s)
+	}
+	return pmuo
+}
+
+// SetWidth sets the "width" field.
+func (pmuo *PostMediaUpdateOne) SetWidth(i int) *PostMediaUpdateOne {
+	pmuo.mutation.ResetWidth()
+	pmuo.mutation.SetWidth(i)
+	return pmuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillableWidth(i *int) *PostMediaUpdateOne {
+	if i != nil {
+		pmuo.SetWidth(*i)
+	}
+	return pmuo
+}
+
+// AddWidth adds i to the "width" field.
+func (pmuo *PostMediaUpdateOne) AddWidth(i int) *PostMediaUpdateOne {
+	pmuo.mutation.AddWidth(i)
+	return pmuo
+}
+
+// ClearWidth clears the value of the "width" field.
+func (pmuo *PostMediaUpdateOne) ClearWidth() *PostMediaUpdateOne {
+	pmuo.mutation.ClearWidth()
+	return pmuo
+}
+
+// SetHeight sets the "height" field.
+func (pmuo *PostMediaUpdateOne) SetHeight(i int) *PostMediaUpdateOne {
+	pmuo.mutation.ResetHeight()
+	pmuo.mutation.SetHeight(i)
+	return pmuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillableHeight(i *int) *PostMediaUpdateOne {
+	if i != nil {
+		pmuo.SetHeight(*i)
+	}
+	return pmuo
+}
+
+// AddHeight adds i to the "height" field.
+func (pmuo *PostMediaUpdateOne) AddHeight(i int) *PostMediaUpdateOne {
+	pmuo.mutation.AddHeight(i)
+	return pmuo
+}
+
+// ClearHeight clears the value of the "height" field.
+func (pmuo *PostMediaUpdateOne) ClearHeight() *PostMediaUpdateOne {
+	pmuo.mutation.ClearHeight()
+	return pmuo
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmuo *PostMediaUpdateOne) SetAltText(s string) *PostMediaUpdateOne {
+	pmuo.mutation.SetAltText(s)
+	return pmuo
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillableAltText(s *string) *PostMediaUpdateOne {
+	if s != nil {
+		pmuo.SetAltText(*s)
+	}
+	return pmuo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (pmuo *PostMediaUpdateOne) SetDurationMs(i int) *PostMediaUpdateOne {
+	pmuo.mutation.ResetDurationMs()
+	pmuo.mutation.SetDurationMs(i)
+	return pmuo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillableDurationMs(i *int) *PostMediaUpdateOne {
+	if i != nil {
+		pmuo.SetDurationMs(*i)
+	}
+	return pmuo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (pmuo *PostMediaUpdateOne) AddDurationMs(i int) *PostMediaUpdateOne {
+	pmuo.mutation.AddDurationMs(i)
+	return pmuo
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (pmuo *PostMediaUpdateOne) ClearDurationMs() *PostMediaUpdateOne {
+	pmuo.mutation.ClearDurationMs()
+	return pmuo
+}
+
+// SetPosterKey sets the "poster_key" field.
+func (pmuo *PostMediaUpdateOne) SetPosterKey(s string) *PostMediaUpdateOne {
+	pmuo.mutation.SetPosterKey(s)
+	return pmuo
+}
+
+// SetNillablePosterKey sets the "poster_key" field if the given value is not nil.
+func (pmuo *PostMediaUpdateOne) SetNillablePosterKey(s *string) *PostMediaUpdateOne {
+	if s != nil {
+		pmuo.SetPosterKey(*s)
+	}
+	return pmuo
+}
+
+// ClearPosterKey clears the value of the "poster_key" field.
+func (pmuo *PostMediaUpdateOne) ClearPosterKey() *PostMediaUpdateOne {
+	pmuo.mutation.ClearPosterKey()
+	return pmuo
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pmuo *PostMediaUpdateOne) SetPostID(id uuid.UUID) *PostMediaUpdateOne {
+	pmuo.mutation.SetPostID(id)
+	return pmuo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pmuo *PostMediaUpdateOne) SetPost(p *Post) *PostMediaUpdateOne {
+	return pmuo.SetPostID(p.ID)
+}
+
+// Mutation returns the PostMediaMutation object of the builder.
+func (pmuo *PostMediaUpdateOne) Mutation() *PostMediaMutation {
+	return pmuo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pmuo *PostMediaUpdateOne) ClearPost() *PostMediaUpdateOne {
+	pmuo.mutation.ClearPost()
+	return pmuo
+}
+
+// Where appends a list predicates to the PostMediaUpdate builder.
+func (pmuo *PostMediaUpdateOne) Where(ps ...predicate.PostMedia) *PostMediaUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *PostMediaUpdateOne) Select(field string, fields ...string) *PostMediaUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated PostMedia entity.
+func (pmuo *PostMediaUpdateOne) Save(ctx context.Context) (*PostMedia, error) {
+	return withHooks(ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *PostMediaUpdateOne) SaveX(ctx context.Context) *PostMedia {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *PostMediaUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *PostMediaUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmuo *PostMediaUpdateOne) check() error {
+	if v, ok := pmuo.mutation.Position(); ok {
+		if err := postmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "PostMedia.position": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.GetType(); ok {
+		if err := postmedia.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PostMedia.type": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.Key(); ok {
+		if err := postmedia.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "PostMedia.key": %w`, err)}
+		}
+	}
+	if pmuo.mutation.PostCleared() && len(pmuo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostMedia.post"`)
+	}
+	return nil
+}
+
+func (pmuo *PostMediaUpdateOne) sqlSave(ctx context.Context) (_node *PostMedia, err error) {
+	if err := pmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postmedia.Table, postmedia.Columns, sqlgraph.NewFieldSpec(postmedia.FieldID, field.TypeUUID))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostMedia.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postmedia.FieldID)
+		for _, f := range fields {
+			if !postmedia.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postmedia.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmuo.mutation.Position(); ok {
+		_spec.SetField(postmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.AddedPosition(); ok {
+		_spec.AddField(postmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.GetType(); ok {
+		_spec.SetField(postmedia.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := pmuo.mutation.Key(); ok {
+		_spec.SetField(postmedia.FieldKey, field.TypeString, value)
+	}
+	if value, ok := pmuo.mutation.Width(); ok {
+		_spec.SetField(postmedia.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.AddedWidth(); ok {
+		_spec.AddField(postmedia.FieldWidth, field.TypeInt, value)
+	}
+	if pmuo.mutation.WidthCleared() {
+		_spec.ClearField(postmedia.FieldWidth, field.TypeInt)
+	}
+	if value, ok := pmuo.mutation.Height(); ok {
+		_spec.SetField(postmedia.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.AddedHeight(); ok {
+		_spec.AddField(postmedia.FieldHeight, field.TypeInt, value)
+	}
+	if pmuo.mutation.HeightCleared() {
+		_spec.ClearField(postmedia.FieldHeight, field.TypeInt)
+	}
+	if value, ok := pmuo.mutation.AltText(); ok {
+		_spec.SetField(postmedia.FieldAltText, field.TypeString, value)
+	}
+	if value, ok := pmuo.mutation.DurationMs(); ok {
+		_spec.SetField(postmedia.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(postmedia.FieldDurationMs, field.TypeInt, value)
+	}
+	if pmuo.mutation.DurationMsCleared() {
+		_spec.ClearField(postmedia.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := pmuo.mutation.PosterKey(); ok {
+		_spec.SetField(postmedia.FieldPosterKey, field.TypeString, value)
+	}
+	if pmuo.mutation.PosterKeyCleared() {
+		_spec.ClearField(postmedia.FieldPosterKey, field.TypeString)
+	}
+	if pmuo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postmedia.PostTable,
+			Columns: []string{postmedia.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postmedia.PostTable,
+			Columns: []string{postmedia.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PostMedia{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
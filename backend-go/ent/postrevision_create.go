@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/google/uuid"
+)
+
+// PostRevisionCreate is the builder for creating a PostRevision entity.
+type PostRevisionCreate struct {
+	config
+	mutation *PostRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCaption sets the "caption" field.
+func (prc *PostRevisionCreate) SetCaption(s string) *PostRevisionCreate {
+	prc.mutation.SetCaption(s)
+	return prc
+}
+
+// SetPetIds sets the "pet_ids" field.
+func (prc *PostRevisionCreate) SetPetIds(u []uuid.UUID) *PostRevisionCreate {
+	prc.mutation.SetPetIds(u)
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PostRevisionCreate) SetCreatedAt(t time.Time) *PostRevisionCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PostRevisionCreate) SetNillableCreatedAt(t *time.Time) *PostRevisionCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *PostRevisionCreate) SetID(u uuid.UUID) *PostRevisionCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prc *PostRevisionCreate) SetNillableID(u *uuid.UUID) *PostRevisionCreate {
+	if u != nil {
+		prc.SetID(*u)
+	}
+	return prc
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (prc *PostRevisionCreate) SetPostID(id uuid.UUID) *PostRevisionCreate {
+	prc.mutation.SetPostID(id)
+	return prc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (prc *PostRevisionCreate) SetPost(p *Post) *PostRevisionCreate {
+	return prc.SetPostID(p.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (prc *PostRevisionCreate) Mutation() *PostRevisionMutation {
+	return prc.mutation
+}
+
+// Save creates the PostRevision in the database.
+func (prc *PostRevisionCreate) Save(ctx context.Context) (*PostRevision, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PostRevisionCreate) SaveX(ctx context.Context) *PostRevision {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PostRevisionCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PostRevisionCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PostRevisionCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := postrevision.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.ID(); !ok {
+		v := postrevision.DefaultID()
+		prc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PostRevisionCreate) check() error {
+	if _, ok := prc.mutation.Caption(); !ok {
+		return &ValidationError{Name: "caption", err: errors.New(`ent: missing required field "PostRevision.caption"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostRevision.created_at"`)}
+	}
+	if len(prc.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostRevision.post"`)}
+	}
+	return nil
+}
+
+func (prc *PostRevisionCreate) sqlSave(ctx context.Context) (*PostRevision, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PostRevisionCreate) createSpec() (*PostRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostRevision{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = prc.conflict
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prc.mutation.Caption(); ok {
+		_spec.SetField(postrevision.FieldCaption, field.TypeString, value)
+		_node.Caption = value
+	}
+	if value, ok := prc.mutation.PetIds(); ok {
+		_spec.SetField(postrevision.FieldPetIds, field.TypeJSON, value)
+		_node.PetIds = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := prc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostRevision.Create().
+//		SetCaption(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostRevisionUpsert) {
+//			SetCaption(v+v).
+//		}).
+//		Exec(ctx)
+func (prc *PostRevisionCreate) OnConflict(opts ...sql.ConflictOption) *PostRevisionUpsertOne {
+	prc.conflict = opts
+	return &PostRevisionUpsertOne{
+		create: prc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prc *PostRevisionCreate) OnConflictColumns(columns ...string) *PostRevisionUpsertOne {
+	prc.conflict = append(prc.conflict, sql.ConflictColumns(columns...))
+	return &PostRevisionUpsertOne{
+		create: prc,
+	}
+}
+
+type (
+	// PostRevisionUpsertOne is the builder for "upsert"-ing
+	//  one PostRevision node.
+	PostRevisionUpsertOne struct {
+		create *PostRevisionCreate
+	}
+
+	// PostRevisionUpsert is the "OnConflict" setter.
+	PostRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCaption sets the "caption" field.
+func (u *PostRevisionUpsert) SetCaption(v string) *PostRevisionUpsert {
+	u.Set(postrevision.FieldCaption, v)
+	return u
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *PostRevisionUpsert) UpdateCaption() *PostRevisionUpsert {
+	u.SetExcluded(postrevision.FieldCaption)
+	return u
+}
+
+// SetPetIds sets the "pet_ids" field.
+func (u *PostRevisionUpsert) SetPetIds(v []uuid.UUID) *PostRevisionUpsert {
+	u.Set(postrevision.FieldPetIds, v)
+	return u
+}
+
+// UpdatePetIds sets the "pet_ids" field to the value that was provided on create.
+func (u *PostRevisionUpsert) UpdatePetIds() *PostRevisionUpsert {
+	u.SetExcluded(postrevision.FieldPetIds)
+	return u
+}
+
+// ClearPetIds clears the value of the "pet_ids" field.
+func (u *PostRevisionUpsert) ClearPetIds() *PostRevisionUpsert {
+	u.SetNull(postrevision.FieldPetIds)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostRevisionUpsertOne) UpdateNewValues() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(postrevision.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(postrevision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostRevisionUpsertOne) Ignore() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostRevisionUpsertOne) DoNothing() *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostRevisionCreate.OnConflict
+// documentation for more info.
+func (u *PostRevisionUpsertOne) Update(set func(*PostRevisionUpsert)) *PostRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCaption sets the "caption" field.
+func (u *PostRevisionUpsertOne) SetCaption(v string) *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetCaption(v)
+	})
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *PostRevisionUpsertOne) UpdateCaption() *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateCaption()
+	})
+}
+
+// SetPetIds sets the "pet_ids" field.
+func (u *PostRevisionUpsertOne) SetPetIds(v []uuid.UUID) *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetPetIds(v)
+	})
+}
+
+// UpdatePetIds sets the "pet_ids" field to the value that was provided on create.
+func (u *PostRevisionUpsertOne) UpdatePetIds() *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdatePetIds()
+	})
+}
+
+// ClearPetIds clears the value of the "pet_ids" field.
+func (u *PostRevisionUpsertOne) ClearPetIds() *PostRevisionUpsertOne {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.ClearPetIds()
+	})
+}
+
+// Exec executes the query.
+func (u *PostRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostRevisionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PostRevisionUpsertOne.ID is not supported by MySQL driver. Use PostRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostRevisionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostRevisionCreateBulk is the builder for creating many PostRevision entities in bulk.
+type PostRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PostRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostRevision entities in the database.
+func (prcb *PostRevisionCreateBulk) Save(ctx context.Context) ([]*PostRevision, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PostRevision, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PostRevisionCreateBulk) SaveX(ctx context.Context) []*PostRevision {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PostRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PostRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostRevisionUpsert) {
+//			SetCaption(v+v).
+//		}).
+//		Exec(ctx)
+func (prcb *PostRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostRevisionUpsertBulk {
+	prcb.conflict = opts
+	return &PostRevisionUpsertBulk{
+		create: prcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prcb *PostRevisionCreateBulk) OnConflictColumns(columns ...string) *PostRevisionUpsertBulk {
+	prcb.conflict = append(prcb.conflict, sql.ConflictColumns(columns...))
+	return &PostRevisionUpsertBulk{
+		create: prcb,
+	}
+}
+
+// PostRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostRevision nodes.
+type PostRevisionUpsertBulk struct {
+	create *PostRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(postrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PostRevisionUpsertBulk) UpdateNewValues() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(postrevision.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(postrevision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostRevisionUpsertBulk) Ignore() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostRevisionUpsertBulk) DoNothing() *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostRevisionUpsertBulk) Update(set func(*PostRevisionUpsert)) *PostRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCaption sets the "caption" field.
+func (u *PostRevisionUpsertBulk) SetCaption(v string) *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetCaption(v)
+	})
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *PostRevisionUpsertBulk) UpdateCaption() *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdateCaption()
+	})
+}
+
+// SetPetIds sets the "pet_ids" field.
+func (u *PostRevisionUpsertBulk) SetPetIds(v []uuid.UUID) *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.SetPetIds(v)
+	})
+}
+
+// UpdatePetIds sets the "pet_ids" field to the value that was provided on create.
+func (u *PostRevisionUpsertBulk) UpdatePetIds() *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.UpdatePetIds()
+	})
+}
+
+// ClearPetIds clears the value of the "pet_ids" field.
+func (u *PostRevisionUpsertBulk) ClearPetIds() *PostRevisionUpsertBulk {
+	return u.Update(func(s *PostRevisionUpsert) {
+		s.ClearPetIds()
+	})
+}
+
+// Exec executes the query.
+func (u *PostRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
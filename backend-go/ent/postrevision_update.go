@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// PostRevisionUpdate is the builder for updating PostRevision entities.
+type PostRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pru *PostRevisionUpdate) Where(ps ...predicate.PostRevision) *PostRevisionUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetCaption sets the "caption" field.
+func (pru *PostRevisionUpdate) SetCaption(s string) *PostRevisionUpdate {
+	pru.mutation.SetCaption(s)
+	return pru
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableCaption(s *string) *PostRevisionUpdate {
+	if s != nil {
+		pru.SetCaption(*s)
+	}
+	return pru
+}
+
+// SetPetIds sets the "pet_ids" field.
+func (pru *PostRevisionUpdate) SetPetIds(u []uuid.UUID) *PostRevisionUpdate {
+	pru.mutation.SetPetIds(u)
+	return pru
+}
+
+// AppendPetIds appends u to the "pet_ids" field.
+func (pru *PostRevisionUpdate) AppendPetIds(u []uuid.UUID) *PostRevisionUpdate {
+	pru.mutation.AppendPetIds(u)
+	return pru
+}
+
+// ClearPetIds clears the value of the "pet_ids" field.
+func (pru *PostRevisionUpdate) ClearPetIds() *PostRevisionUpdate {
+	pru.mutation.ClearPetIds()
+	return pru
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pru *PostRevisionUpdate) SetPostID(id uuid.UUID) *PostRevisionUpdate {
+	pru.mutation.SetPostID(id)
+	return pru
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pru *PostRevisionUpdate) SetPost(p *Post) *PostRevisionUpdate {
+	return pru.SetPostID(p.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pru *PostRevisionUpdate) Mutation() *PostRevisionMutation {
+	return pru.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pru *PostRevisionUpdate) ClearPost() *PostRevisionUpdate {
+	pru.mutation.ClearPost()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PostRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PostRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PostRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PostRevisionUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PostRevisionUpdate) check() error {
+	if pru.mutation.PostCleared() && len(pru.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+func (pru *PostRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.Caption(); ok {
+		_spec.SetField(postrevision.FieldCaption, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.PetIds(); ok {
+		_spec.SetField(postrevision.FieldPetIds, field.TypeJSON, value)
+	}
+	if value, ok := pru.mutation.AppendedPetIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, postrevision.FieldPetIds, value)
+		})
+	}
+	if pru.mutation.PetIdsCleared() {
+		_spec.ClearField(postrevision.FieldPetIds, field.TypeJSON)
+	}
+	if pru.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PostRevisionUpdateOne is the builder for updating a single PostRevision entity.
+type PostRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// SetCaption sets the "caption" field.
+func (pruo *PostRevisionUpdateOne) SetCaption(s string) *PostRevisionUpdateOne {
+	pruo.mutation.SetCaption(s)
+	return pruo
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableCaption(s *string) *PostRevisionUpdateOne {
+	if s != nil {
+		pruo.SetCaption(*s)
+	}
+	return pruo
+}
+
+// SetPetIds sets the "pet_ids" field.
+func (pruo *PostRevisionUpdateOne) SetPetIds(u []uuid.UUID) *PostRevisionUpdateOne {
+	pruo.mutation.SetPetIds(u)
+	return pruo
+}
+
+// AppendPetIds appends u to the "pet_ids" field.
+func (pruo *PostRevisionUpdateOne) AppendPetIds(u []uuid.UUID) *PostRevisionUpdateOne {
+	pruo.mutation.AppendPetIds(u)
+	return pruo
+}
+
+// ClearPetIds clears the value of the "pet_ids" field.
+func (pruo *PostRevisionUpdateOne) ClearPetIds() *PostRevisionUpdateOne {
+	pruo.mutation.ClearPetIds()
+	return pruo
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pruo *PostRevisionUpdateOne) SetPostID(id uuid.UUID) *PostRevisionUpdateOne {
+	pruo.mutation.SetPostID(id)
+	return pruo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pruo *PostRevisionUpdateOne) SetPost(p *Post) *PostRevisionUpdateOne {
+	return pruo.SetPostID(p.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pruo *PostRevisionUpdateOne) Mutation() *PostRevisionMutation {
+	return pruo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pruo *PostRevisionUpdateOne) ClearPost() *PostRevisionUpdateOne {
+	pruo.mutation.ClearPost()
+	return pruo
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pruo *PostRevisionUpdateOne) Where(ps ...predicate.PostRevision) *PostRevisionUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PostRevisionUpdateOne) Select(field string, fields ...string) *PostRevisionUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PostRevision entity.
+func (pruo *PostRevisionUpdateOne) Save(ctx context.Context) (*PostRevision, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) SaveX(ctx context.Context) *PostRevision {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PostRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PostRevisionUpdateOne) check() error {
+	if pruo.mutation.PostCleared() && len(pruo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+func (pruo *PostRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PostRevision, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeUUID))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for _, f := range fields {
+			if !postrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.Caption(); ok {
+		_spec.SetField(postrevision.FieldCaption, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.PetIds(); ok {
+		_spec.SetField(postrevision.FieldPetIds, field.TypeJSON, value)
+	}
+	if value, ok := pruo.mutation.AppendedPetIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, postrevision.FieldPetIds, value)
+		})
+	}
+	if pruo.mutation.PetIdsCleared() {
+		_spec.ClearField(postrevision.FieldPetIds, field.TypeJSON)
+	}
+	if pruo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PostRevision{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/google/uuid"
+)
+
+// PostTagCreate is the builder for creating a PostTag entity.
+type PostTagCreate struct {
+	config
+	mutation *PostTagMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPostID sets the "post_id" field.
+func (ptc *PostTagCreate) SetPostID(u uuid.UUID) *PostTagCreate {
+	ptc.mutation.SetPostID(u)
+	return ptc
+}
+
+// SetTagID sets the "tag_id" field.
+func (ptc *PostTagCreate) SetTagID(u uuid.UUID) *PostTagCreate {
+	ptc.mutation.SetTagID(u)
+	return ptc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptc *PostTagCreate) SetCreatedAt(t time.Time) *PostTagCreate {
+	ptc.mutation.SetCreatedAt(t)
+	return ptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptc *PostTagCreate) SetNillableCreatedAt(t *time.Time) *PostTagCreate {
+	if t != nil {
+		ptc.SetCreatedAt(*t)
+	}
+	return ptc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (ptc *PostTagCreate) SetPost(p *Post) *PostTagCreate {
+	return ptc.SetPostID(p.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (ptc *PostTagCreate) SetTag(t *Tag) *PostTagCreate {
+	return ptc.SetTagID(t.ID)
+}
+
+// Mutation returns the PostTagMutation object of the builder.
+func (ptc *PostTagCreate) Mutation() *PostTagMutation {
+	return ptc.mutation
+}
+
+// Save creates the PostTag in the database.
+func (ptc *PostTagCreate) Save(ctx context.Context) (*PostTag, error) {
+	ptc.defaults()
+	return withHooks(ctx, ptc.sqlSave, ptc.mutation, ptc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ptc *PostTagCreate) SaveX(ctx context.Context) *PostTag {
+	v, err := ptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptc *PostTagCreate) Exec(ctx context.Context) error {
+	_, err := ptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptc *PostTagCreate) ExecX(ctx context.Context) {
+	if err := ptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptc *PostTagCreate) defaults() {
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		v := posttag.DefaultCreatedAt()
+		ptc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptc *PostTagCreate) check() error {
+	if _, ok := ptc.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "PostTag.post_id"`)}
+	}
+	if _, ok := ptc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "PostTag.tag_id"`)}
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostTag.created_at"`)}
+	}
+	if len(ptc.mutation.PostIDs()) == 0 {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostTag.post"`)}
+	}
+	if len(ptc.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "PostTag.tag"`)}
+	}
+	return nil
+}
+
+func (ptc *PostTagCreate) sqlSave(ctx context.Context) (*PostTag, error) {
+	if err := ptc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (ptc *PostTagCreate) createSpec() (*PostTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostTag{config: ptc.config}
+		_spec = sqlgraph.NewCreateSpec(posttag.Table, nil)
+	)
+	_spec.OnConflict = ptc.conflict
+	if value, ok := ptc.mutation.CreatedAt(); ok {
+		_spec.SetField(posttag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ptc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.PostTable,
+			Columns: []string{posttag.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ptc.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.TagTable,
+			Columns: []string{posttag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostTag.Create().
+//		SetPostID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostTagUpsert) {
+//			SetPostID(v+v).
+//		}).
+//		Exec(ctx)
+func (ptc *PostTagCreate) OnConflict(opts ...sql.ConflictOption) *PostTagUpsertOne {
+	ptc.conflict = opts
+	return &PostTagUpsertOne{
+		create: ptc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptc *PostTagCreate) OnConflictColumns(columns ...string) *PostTagUpsertOne {
+	ptc.conflict = append(ptc.conflict, sql.ConflictColumns(columns...))
+	return &PostTagUpsertOne{
+		create: ptc,
+	}
+}
+
+type (
+	// PostTagUpsertOne is the builder for "upsert"-ing
+	//  one PostTag node.
+	PostTagUpsertOne struct {
+		create *PostTagCreate
+	}
+
+	// PostTagUpsert is the "OnConflict" setter.
+	PostTagUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPostID sets the "post_id" field.
+func (u *PostTagUpsert) SetPostID(v uuid.UUID) *PostTagUpsert {
+	u.Set(posttag.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostTagUpsert) UpdatePostID() *PostTagUpsert {
+	u.SetExcluded(posttag.FieldPostID)
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *PostTagUpsert) SetTagID(v uuid.UUID) *PostTagUpsert {
+	u.Set(posttag.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *PostTagUpsert) UpdateTagID() *PostTagUpsert {
+	u.SetExcluded(posttag.FieldTagID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostTagUpsertOne) UpdateNewValues() *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(posttag.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostTagUpsertOne) Ignore() *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostTagUpsertOne) DoNothing() *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostTagCreate.OnConflict
+// documentation for more info.
+func (u *PostTagUpsertOne) Update(set func(*PostTagUpsert)) *PostTagUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PostTagUpsertOne) SetPostID(v uuid.UUID) *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostTagUpsertOne) UpdatePostID() *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *PostTagUpsertOne) SetTagID(v uuid.UUID) *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *PostTagUpsertOne) UpdateTagID() *PostTagUpsertOne {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *PostTagUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostTagCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostTagUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// PostTagCreateBulk is the builder for creating many PostTag entities in bulk.
+type PostTagCreateBulk struct {
+	config
+	err      error
+	builders []*PostTagCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostTag entities in the database.
+func (ptcb *PostTagCreateBulk) Save(ctx context.Context) ([]*PostTag, error) {
+	if ptcb.err != nil {
+		return nil, ptcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ptcb.builders))
+	nodes := make([]*PostTag, len(ptcb.builders))
+	mutators := make([]Mutator, len(ptcb.builders))
+	for i := range ptcb.builders {
+		func(i int, root context.Context) {
+			builder := ptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ptcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptcb *PostTagCreateBulk) SaveX(ctx context.Context) []*PostTag {
+	v, err := ptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptcb *PostTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := ptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptcb *PostTagCreateBulk) ExecX(ctx context.Context) {
+	if err := ptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostTag.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostTagUpsert) {
+//			SetPostID(v+v).
+//		}).
+//		Exec(ctx)
+func (ptcb *PostTagCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostTagUpsertBulk {
+	ptcb.conflict = opts
+	return &PostTagUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ptcb *PostTagCreateBulk) OnConflictColumns(columns ...string) *PostTagUpsertBulk {
+	ptcb.conflict = append(ptcb.conflict, sql.ConflictColumns(columns...))
+	return &PostTagUpsertBulk{
+		create: ptcb,
+	}
+}
+
+// PostTagUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostTag nodes.
+type PostTagUpsertBulk struct {
+	create *PostTagCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostTagUpsertBulk) UpdateNewValues() *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(posttag.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostTag.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostTagUpsertBulk) Ignore() *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostTagUpsertBulk) DoNothing() *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostTagCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostTagUpsertBulk) Update(set func(*PostTagUpsert)) *PostTagUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostTagUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *PostTagUpsertBulk) SetPostID(v uuid.UUID) *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *PostTagUpsertBulk) UpdatePostID() *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *PostTagUpsertBulk) SetTagID(v uuid.UUID) *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *PostTagUpsertBulk) UpdateTagID() *PostTagUpsertBulk {
+	return u.Update(func(s *PostTagUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// Exec executes the query.
+func (u *PostTagUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostTagCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostTagCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostTagUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
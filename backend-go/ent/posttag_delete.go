@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+)
+
+// PostTagDelete is the builder for deleting a PostTag entity.
+type PostTagDelete struct {
+	config
+	hooks    []Hook
+	mutation *PostTagMutation
+}
+
+// Where appends a list predicates to the PostTagDelete builder.
+func (ptd *PostTagDelete) Where(ps ...predicate.PostTag) *PostTagDelete {
+	ptd.mutation.Where(ps...)
+	return ptd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ptd *PostTagDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptd.sqlExec, ptd.mutation, ptd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptd *PostTagDelete) ExecX(ctx context.Context) int {
+	n, err := ptd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ptd *PostTagDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(posttag.Table, nil)
+	if ps := ptd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ptd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ptd.mutation.done = true
+	return affected, err
+}
+
+// PostTagDeleteOne is the builder for deleting a single PostTag entity.
+type PostTagDeleteOne struct {
+	ptd *PostTagDelete
+}
+
+// Where appends a list predicates to the PostTagDelete builder.
+func (ptdo *PostTagDeleteOne) Where(ps ...predicate.PostTag) *PostTagDeleteOne {
+	ptdo.ptd.mutation.Where(ps...)
+	return ptdo
+}
+
+// Exec executes the deletion query.
+func (ptdo *PostTagDeleteOne) Exec(ctx context.Context) error {
+	n, err := ptdo.ptd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{posttag.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptdo *PostTagDeleteOne) ExecX(ctx context.Context) {
+	if err := ptdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/google/uuid"
+)
+
+// PostTagQuery is the builder for querying PostTag entities.
+type PostTagQuery struct {
+	config
+	ctx        *QueryContext
+	order      []posttag.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PostTag
+	withPost   *PostQuery
+	withTag    *TagQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PostTagQuery builder.
+func (ptq *PostTagQuery) Where(ps ...predicate.PostTag) *PostTagQuery {
+	ptq.predicates = append(ptq.predicates, ps...)
+	return ptq
+}
+
+// Limit the number of records to be returned by this query.
+func (ptq *PostTagQuery) Limit(limit int) *PostTagQuery {
+	ptq.ctx.Limit = &limit
+	return ptq
+}
+
+// Offset to start from.
+func (ptq *PostTagQuery) Offset(offset int) *PostTagQuery {
+	ptq.ctx.Offset = &offset
+	return ptq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ptq *PostTagQuery) Unique(unique bool) *PostTagQuery {
+	ptq.ctx.Unique = &unique
+	return ptq
+}
+
+// Order specifies how the records should be ordered.
+func (ptq *PostTagQuery) Order(o ...posttag.OrderOption) *PostTagQuery {
+	ptq.order = append(ptq.order, o...)
+	return ptq
+}
+
+// QueryPost chains the current query on the "post" edge.
+func (ptq *PostTagQuery) QueryPost() *PostQuery {
+	query := (&PostClient{config: ptq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ptq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ptq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posttag.Table, posttag.PostColumn, selector),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, posttag.PostTable, posttag.PostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ptq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTag chains the current query on the "tag" edge.
+func (ptq *PostTagQuery) QueryTag() *TagQuery {
+	query := (&TagClient{config: ptq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ptq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ptq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posttag.Table, posttag.TagColumn, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, posttag.TagTable, posttag.TagColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ptq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PostTag entity from the query.
+// Returns a *NotFoundError when no PostTag was found.
+func (ptq *PostTagQuery) First(ctx context.Context) (*PostTag, error) {
+	nodes, err := ptq.Limit(1).All(setContextOp(ctx, ptq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{posttag.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ptq *PostTagQuery) FirstX(ctx context.Context) *PostTag {
+	node, err := ptq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single PostTag entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PostTag entity is found.
+// Returns a *NotFoundError when no PostTag entities are found.
+func (ptq *PostTagQuery) Only(ctx context.Context) (*PostTag, error) {
+	nodes, err := ptq.Limit(2).All(setContextOp(ctx, ptq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{posttag.Label}
+	default:
+		return nil, &NotSingularError{posttag.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ptq *PostTagQuery) OnlyX(ctx context.Context) *PostTag {
+	node, err := ptq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of PostTags.
+func (ptq *PostTagQuery) All(ctx context.Context) ([]*PostTag, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryAll)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PostTag, *PostTagQuery]()
+	return withInterceptors[[]*PostTag](ctx, ptq, qr, ptq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ptq *PostTagQuery) AllX(ctx context.Context) []*PostTag {
+	nodes, err := ptq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (ptq *PostTagQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryCount)
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ptq, querierCount[*PostTagQuery](), ptq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ptq *PostTagQuery) CountX(ctx context.Context) int {
+	count, err := ptq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ptq *PostTagQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ptq.ctx, ent.OpQueryExist)
+	switch _, err := ptq.First(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ptq *PostTagQuery) ExistX(ctx context.Context) bool {
+	exist, err := ptq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PostTagQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ptq *PostTagQuery) Clone() *PostTagQuery {
+	if ptq == nil {
+		return nil
+	}
+	return &PostTagQuery{
+		config:     ptq.config,
+		ctx:        ptq.ctx.Clone(),
+		order:      append([]posttag.OrderOption{}, ptq.order...),
+		inters:     append([]Interceptor{}, ptq.inters...),
+		predicates: append([]predicate.PostTag{}, ptq.predicates...),
+		withPost:   ptq.withPost.Clone(),
+		withTag:    ptq.withTag.Clone(),
+		// clone intermediate query.
+		sql:  ptq.sql.Clone(),
+		path: ptq.path,
+	}
+}
+
+// WithPost tells the query-builder to eager-load the nodes that are connected to
+// the "post" edge. The optional arguments are used to configure the query builder of the edge.
+func (ptq *PostTagQuery) WithPost(opts ...func(*PostQuery)) *PostTagQuery {
+	query := (&PostClient{config: ptq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ptq.withPost = query
+	return ptq
+}
+
+// WithTag tells the query-builder to eager-load the nodes that are connected to
+// the "tag" edge. The optional arguments are used to configure the query builder of the edge.
+func (ptq *PostTagQuery) WithTag(opts ...func(*TagQuery)) *PostTagQuery {
+	query := (&TagClient{config: ptq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ptq.withTag = query
+	return ptq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PostID uuid.UUID `json:"post_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PostTag.Query().
+//		GroupBy(posttag.FieldPostID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ptq *PostTagQuery) GroupBy(field string, fields ...string) *PostTagGroupBy {
+	ptq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PostTagGroupBy{build: ptq}
+	grbuild.flds = &ptq.ctx.Fields
+	grbuild.label = posttag.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PostID uuid.UUID `json:"post_id,omitempty"`
+//	}
+//
+//	client.PostTag.Query().
+//		Select(posttag.FieldPostID).
+//		Scan(ctx, &v)
+func (ptq *PostTagQuery) Select(fields ...string) *PostTagSelect {
+	ptq.ctx.Fields = append(ptq.ctx.Fields, fields...)
+	sbuild := &PostTagSelect{PostTagQuery: ptq}
+	sbuild.label = posttag.Label
+	sbuild.flds, sbuild.scan = &ptq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PostTagSelect configured with the given aggregations.
+func (ptq *PostTagQuery) Aggregate(fns ...AggregateFunc) *PostTagSelect {
+	return ptq.Select().Aggregate(fns...)
+}
+
+func (ptq *PostTagQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ptq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ptq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ptq.ctx.Fields {
+		if !posttag.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ptq.path != nil {
+		prev, err := ptq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ptq.sql = prev
+	}
+	return nil
+}
+
+func (ptq *PostTagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PostTag, error) {
+	var (
+		nodes       = []*PostTag{}
+		_spec       = ptq.querySpec()
+		loadedTypes = [2]bool{
+			ptq.withPost != nil,
+			ptq.withTag != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PostTag).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PostTag{config: ptq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ptq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ptq.withPost; query != nil {
+		if err := ptq.loadPost(ctx, query, nodes, nil,
+			func(n *PostTag, e *Post) { n.Edges.Post = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ptq.withTag; query != nil {
+		if err := ptq.loadTag(ctx, query, nodes, nil,
+			func(n *PostTag, e *Tag) { n.Edges.Tag = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ptq *PostTagQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*PostTag, init func(*PostTag), assign func(*PostTag, *Post)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PostTag)
+	for i := range nodes {
+		fk := nodes[i].PostID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "post_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ptq *PostTagQuery) loadTag(ctx context.Context, query *TagQuery, nodes []*PostTag, init func(*PostTag), assign func(*PostTag, *Tag)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PostTag)
+	for i := range nodes {
+		fk := nodes[i].TagID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tag.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tag_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ptq *PostTagQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ptq.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, ptq.driver, _spec)
+}
+
+func (ptq *PostTagQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(posttag.Table, posttag.Columns, nil)
+	_spec.From = ptq.sql
+	if unique := ptq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ptq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ptq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if ptq.withPost != nil {
+			_spec.Node.AddColumnOnce(posttag.FieldPostID)
+		}
+		if ptq.withTag != nil {
+			_spec.Node.AddColumnOnce(posttag.FieldTagID)
+		}
+	}
+	if ps := ptq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ptq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ptq *PostTagQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ptq.driver.Dialect())
+	t1 := builder.Table(posttag.Table)
+	columns := ptq.ctx.Fields
+	if len(columns) == 0 {
+		columns = posttag.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ptq.sql != nil {
+		selector = ptq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ptq.predicates {
+		p(selector)
+	}
+	for _, p := range ptq.order {
+		p(selector)
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PostTagGroupBy is the group-by builder for PostTag entities.
+type PostTagGroupBy struct {
+	selector
+	build *PostTagQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ptgb *PostTagGroupBy) Aggregate(fns ...AggregateFunc) *PostTagGroupBy {
+	ptgb.fns = append(ptgb.fns, fns...)
+	return ptgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ptgb *PostTagGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ptgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ptgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostTagQuery, *PostTagGroupBy](ctx, ptgb.build, ptgb, ptgb.build.inters, v)
+}
+
+func (ptgb *PostTagGroupBy) sqlScan(ctx context.Context, root *PostTagQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ptgb.fns))
+	for _, fn := range ptgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ptgb.flds)+len(ptgb.fns))
+		for _, f := range *ptgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ptgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ptgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PostTagSelect is the builder for selecting fields of PostTag entities.
+type PostTagSelect struct {
+	*PostTagQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pts *PostTagSelect) Aggregate(fns ...AggregateFunc) *PostTagSelect {
+	pts.fns = append(pts.fns, fns...)
+	return pts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pts *PostTagSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pts.ctx, ent.OpQuerySelect)
+	if err := pts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostTagQuery, *PostTagSelect](ctx, pts.PostTagQuery, pts, pts.inters, v)
+}
+
+func (pts *PostTagSelect) sqlScan(ctx context.Context, root *PostTagQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pts.fns))
+	for _, fn := range pts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
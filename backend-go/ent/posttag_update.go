@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/google/uuid"
+)
+
+// PostTagUpdate is the builder for updating PostTag entities.
+type PostTagUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostTagMutation
+}
+
+// Where appends a list predicates to the PostTagUpdate builder.
+func (ptu *PostTagUpdate) Where(ps ...predicate.PostTag) *PostTagUpdate {
+	ptu.mutation.Where(ps...)
+	return ptu
+}
+
+// SetPostID sets the "post_id" field.
+func (ptu *PostTagUpdate) SetPostID(u uuid.UUID) *PostTagUpdate {
+	ptu.mutation.SetPostID(u)
+	return ptu
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (ptu *PostTagUpdate) SetNillablePostID(u *uuid.UUID) *PostTagUpdate {
+	if u != nil {
+		ptu.SetPostID(*u)
+	}
+	return ptu
+}
+
+// SetTagID sets the "tag_id" field.
+func (ptu *PostTagUpdate) SetTagID(u uuid.UUID) *PostTagUpdate {
+	ptu.mutation.SetTagID(u)
+	return ptu
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (ptu *PostTagUpdate) SetNillableTagID(u *uuid.UUID) *PostTagUpdate {
+	if u != nil {
+		ptu.SetTagID(*u)
+	}
+	return ptu
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (ptu *PostTagUpdate) SetPost(p *Post) *PostTagUpdate {
+	return ptu.SetPostID(p.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (ptu *PostTagUpdate) SetTag(t *Tag) *PostTagUpdate {
+	return ptu.SetTagID(t.ID)
+}
+
+// Mutation returns the PostTagMutation object of the builder.
+func (ptu *PostTagUpdate) Mutation() *PostTagMutation {
+	return ptu.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (ptu *PostTagUpdate) ClearPost() *PostTagUpdate {
+	ptu.mutation.ClearPost()
+	return ptu
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (ptu *PostTagUpdate) ClearTag() *PostTagUpdate {
+	ptu.mutation.ClearTag()
+	return ptu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ptu *PostTagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptu *PostTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := ptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ptu *PostTagUpdate) Exec(ctx context.Context) error {
+	_, err := ptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptu *PostTagUpdate) ExecX(ctx context.Context) {
+	if err := ptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptu *PostTagUpdate) check() error {
+	if ptu.mutation.PostCleared() && len(ptu.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostTag.post"`)
+	}
+	if ptu.mutation.TagCleared() && len(ptu.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostTag.tag"`)
+	}
+	return nil
+}
+
+func (ptu *PostTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ptu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posttag.Table, posttag.Columns, sqlgraph.NewFieldSpec(posttag.FieldPostID, field.TypeUUID), sqlgraph.NewFieldSpec(posttag.FieldTagID, field.TypeUUID))
+	if ps := ptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ptu.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.PostTable,
+			Columns: []string{posttag.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptu.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.PostTable,
+			Columns: []string{posttag.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ptu.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.TagTable,
+			Columns: []string{posttag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptu.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.TagTable,
+			Columns: []string{posttag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posttag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ptu.mutation.done = true
+	return n, nil
+}
+
+// PostTagUpdateOne is the builder for updating a single PostTag entity.
+type PostTagUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostTagMutation
+}
+
+// SetPostID sets the "post_id" field.
+func (ptuo *PostTagUpdateOne) SetPostID(u uuid.UUID) *PostTagUpdateOne {
+	ptuo.mutation.SetPostID(u)
+	return ptuo
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (ptuo *PostTagUpdateOne) SetNillablePostID(u *uuid.UUID) *PostTagUpdateOne {
+	if u != nil {
+		ptuo.SetPostID(*u)
+	}
+	return ptuo
+}
+
+// SetTagID sets the "tag_id" field.
+func (ptuo *PostTagUpdateOne) SetTagID(u uuid.UUID) *PostTagUpdateOne {
+	ptuo.mutation.SetTagID(u)
+	return ptuo
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (ptuo *PostTagUpdateOne) SetNillableTagID(u *uuid.UUID) *PostTagUpdateOne {
+	if u != nil {
+		ptuo.SetTagID(*u)
+	}
+	return ptuo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (ptuo *PostTagUpdateOne) SetPost(p *Post) *PostTagUpdateOne {
+	return ptuo.SetPostID(p.ID)
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (ptuo *PostTagUpdateOne) SetTag(t *Tag) *PostTagUpdateOne {
+	return ptuo.SetTagID(t.ID)
+}
+
+// Mutation returns the PostTagMutation object of the builder.
+func (ptuo *PostTagUpdateOne) Mutation() *PostTagMutation {
+	return ptuo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (ptuo *PostTagUpdateOne) ClearPost() *PostTagUpdateOne {
+	ptuo.mutation.ClearPost()
+	return ptuo
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (ptuo *PostTagUpdateOne) ClearTag() *PostTagUpdateOne {
+	ptuo.mutation.ClearTag()
+	return ptuo
+}
+
+// Where appends a list predicates to the PostTagUpdate builder.
+func (ptuo *PostTagUpdateOne) Where(ps ...predicate.PostTag) *PostTagUpdateOne {
+	ptuo.mutation.Where(ps...)
+	return ptuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ptuo *PostTagUpdateOne) Select(field string, fields ...string) *PostTagUpdateOne {
+	ptuo.fields = append([]string{field}, fields...)
+	return ptuo
+}
+
+// Save executes the query and returns the updated PostTag entity.
+func (ptuo *PostTagUpdateOne) Save(ctx context.Context) (*PostTag, error) {
+	return withHooks(ctx, ptuo.sqlSave, ptuo.mutation, ptuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptuo *PostTagUpdateOne) SaveX(ctx context.Context) *PostTag {
+	node, err := ptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ptuo *PostTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := ptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptuo *PostTagUpdateOne) ExecX(ctx context.Context) {
+	if err := ptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptuo *PostTagUpdateOne) check() error {
+	if ptuo.mutation.PostCleared() && len(ptuo.mutation.PostIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostTag.post"`)
+	}
+	if ptuo.mutation.TagCleared() && len(ptuo.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PostTag.tag"`)
+	}
+	return nil
+}
+
+func (ptuo *PostTagUpdateOne) sqlSave(ctx context.Context) (_node *PostTag, err error) {
+	if err := ptuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posttag.Table, posttag.Columns, sqlgraph.NewFieldSpec(posttag.FieldPostID, field.TypeUUID), sqlgraph.NewFieldSpec(posttag.FieldTagID, field.TypeUUID))
+	if id, ok := ptuo.mutation.PostID(); !ok {
+		return nil, &ValidationError{Name: "post_id", err: errors.New(`ent: missing "PostTag.post_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := ptuo.mutation.TagID(); !ok {
+		return nil, &ValidationError{Name: "tag_id", err: errors.New(`ent: missing "PostTag.tag_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := ptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !posttag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := ptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ptuo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.PostTable,
+			Columns: []string{posttag.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptuo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.PostTable,
+			Columns: []string{posttag.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ptuo.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.TagTable,
+			Columns: []string{posttag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ptuo.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   posttag.TagTable,
+			Columns: []string{posttag.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PostTag{config: ptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posttag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ptuo.mutation.done = true
+	return _node, nil
+}
@@ -12,23 +12,74 @@ type BlockRelation func(*sql.Selector)
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// CommentLike is the predicate function for commentlike builders.
+type CommentLike func(*sql.Selector)
+
+// Credential is the predicate function for credential builders.
+type Credential func(*sql.Selector)
+
 // DailyTask is the predicate function for dailytask builders.
 type DailyTask func(*sql.Selector)
 
+// DataMigration is the predicate function for datamigration builders.
+type DataMigration func(*sql.Selector)
+
 // DeviceToken is the predicate function for devicetoken builders.
 type DeviceToken func(*sql.Selector)
 
+// ExploreRanking is the predicate function for exploreranking builders.
+type ExploreRanking func(*sql.Selector)
+
 // FollowRelation is the predicate function for followrelation builders.
 type FollowRelation func(*sql.Selector)
 
+// FollowRequest is the predicate function for followrequest builders.
+type FollowRequest func(*sql.Selector)
+
 // Like is the predicate function for like builders.
 type Like func(*sql.Selector)
 
+// MuteRelation is the predicate function for muterelation builders.
+type MuteRelation func(*sql.Selector)
+
+// MutedKeyword is the predicate function for mutedkeyword builders.
+type MutedKeyword func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
 // Pet is the predicate function for pet builders.
 type Pet func(*sql.Selector)
 
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
+// PostMedia is the predicate function for postmedia builders.
+type PostMedia func(*sql.Selector)
+
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// PostTag is the predicate function for posttag builders.
+type PostTag func(*sql.Selector)
+
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
+// Suspension is the predicate function for suspension builders.
+type Suspension func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// TimelineCache is the predicate function for timelinecache builders.
+type TimelineCache func(*sql.Selector)
+
+// TokenRevocation is the predicate function for tokenrevocation builders.
+type TokenRevocation func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// VerificationCode is the predicate function for verificationcode builders.
+type VerificationCode func(*sql.Selector)
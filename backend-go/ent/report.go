@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// Report is the model entity for the Report schema.
+type Report struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TargetType holds the value of the "target_type" field.
+	TargetType enum.ReportTargetType `json:"target_type,omitempty"`
+	// PostID holds the value of the "post_id" field.
+	PostID *uuid.UUID `json:"post_id,omitempty"`
+	// CommentID holds the value of the "comment_id" field.
+	CommentID *uuid.UUID `json:"comment_id,omitempty"`
+	// TargetUserID holds the value of the "target_user_id" field.
+	TargetUserID *uuid.UUID `json:"target_user_id,omitempty"`
+	// ReporterID holds the value of the "reporter_id" field.
+	ReporterID uuid.UUID `json:"reporter_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason enum.ReportReason `json:"reason,omitempty"`
+	// Details holds the value of the "details" field.
+	Details string `json:"details,omitempty"`
+	// Status holds the value of the "status" field.
+	Status enum.ReportStatus `json:"status,omitempty"`
+	// ResolverID holds the value of the "resolver_id" field.
+	ResolverID *uuid.UUID `json:"resolver_id,omitempty"`
+	// ResolutionNote holds the value of the "resolution_note" field.
+	ResolutionNote string `json:"resolution_note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReportQuery when eager-loading is set.
+	Edges        ReportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReportEdges holds the relations/edges for other nodes in the graph.
+type ReportEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// Comment holds the value of the comment edge.
+	Comment *Comment `json:"comment,omitempty"`
+	// TargetUser holds the value of the target_user edge.
+	TargetUser *User `json:"target_user,omitempty"`
+	// Reporter holds the value of the reporter edge.
+	Reporter *User `json:"reporter,omitempty"`
+	// Resolver holds the value of the resolver edge.
+	Resolver *User `json:"resolver,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// CommentOrErr returns the Comment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) CommentOrErr() (*Comment, error) {
+	if e.Comment != nil {
+		return e.Comment, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: comment.Label}
+	}
+	return nil, &NotLoadedError{edge: "comment"}
+}
+
+// TargetUserOrErr returns the TargetUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) TargetUserOrErr() (*User, error) {
+	if e.TargetUser != nil {
+		return e.TargetUser, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "target_user"}
+}
+
+// ReporterOrErr returns the Reporter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) ReporterOrErr() (*User, error) {
+	if e.Reporter != nil {
+		return e.Reporter, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "reporter"}
+}
+
+// ResolverOrErr returns the Resolver value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReportEdges) ResolverOrErr() (*User, error) {
+	if e.Resolver != nil {
+		return e.Resolver, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "resolver"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Report) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldPostID, report.FieldCommentID, report.FieldTargetUserID, report.FieldResolverID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case report.FieldTargetType, report.FieldReason, report.FieldDetails, report.FieldStatus, report.FieldResolutionNote:
+			values[i] = new(sql.NullString)
+		case report.FieldCreatedAt, report.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case report.FieldID, report.FieldReporterID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Report fields.
+func (r *Report) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				r.ID = *value
+			}
+		case report.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				r.TargetType = enum.ReportTargetType(value.String)
+			}
+		case report.FieldPostID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field post_id", values[i])
+			} else if value.Valid {
+				r.PostID = new(uuid.UUID)
+				*r.PostID = *value.S.(*uuid.UUID)
+			}
+		case report.FieldCommentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				r.CommentID = new(uuid.UUID)
+				*r.CommentID = *value.S.(*uuid.UUID)
+			}
+		case report.FieldTargetUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field target_user_id", values[i])
+			} else if value.Valid {
+				r.TargetUserID = new(uuid.UUID)
+				*r.TargetUserID = *value.S.(*uuid.UUID)
+			}
+		case report.FieldReporterID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field reporter_id", values[i])
+			} else if value != nil {
+				r.ReporterID = *value
+			}
+		case report.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				r.Reason = enum.ReportReason(value.String)
+			}
+		case report.FieldDetails:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value.Valid {
+				r.Details = value.String
+			}
+		case report.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = enum.ReportStatus(value.String)
+			}
+		case report.FieldResolverID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field resolver_id", values[i])
+			} else if value.Valid {
+				r.ResolverID = new(uuid.UUID)
+				*r.ResolverID = *value.S.(*uuid.UUID)
+			}
+		case report.FieldResolutionNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_note", values[i])
+			} else if value.Valid {
+				r.ResolutionNote = value.String
+			}
+		case report.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case report.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				r.ResolvedAt = new(time.Time)
+				*r.ResolvedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Report.
+// This includes values selected through modifiers, order, etc.
+func (r *Report) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the Report entity.
+func (r *Report) QueryPost() *PostQuery {
+	return NewReportClient(r.config).QueryPost(r)
+}
+
+// QueryComment queries the "comment" edge of the Report entity.
+func (r *Report) QueryComment() *CommentQuery {
+	return NewReportClient(r.config).QueryComment(r)
+}
+
+// QueryTargetUser queries the "target_user" edge of the Report entity.
+func (r *Report) QueryTargetUser() *UserQuery {
+	return NewReportClient(r.config).QueryTargetUser(r)
+}
+
+// QueryReporter queries the "reporter" edge of the Report entity.
+func (r *Report) QueryReporter() *UserQuery {
+	return NewReportClient(r.config).QueryReporter(r)
+}
+
+// QueryResolver queries the "resolver" edge of the Report entity.
+func (r *Report) QueryResolver() *UserQuery {
+	return NewReportClient(r.config).QueryResolver(r)
+}
+
+// Update returns a builder for updating this Report.
+// Note that you need to call Report.Unwrap() before calling this method if this Report
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Report) Update() *ReportUpdateOne {
+	return NewReportClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Report entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Report) Unwrap() *Report {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Report is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Report) String() string {
+	var builder strings.Builder
+	builder.WriteString("Report(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("target_type=")
+	builder.WriteString(fmt.Sprintf("%v", r.TargetType))
+	builder.WriteString(", ")
+	if v := r.PostID; v != nil {
+		builder.WriteString("post_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.CommentID; v != nil {
+		builder.WriteString("comment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := r.TargetUserID; v != nil {
+		builder.WriteString("target_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reporter_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ReporterID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", r.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(r.Details)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	if v := r.ResolverID; v != nil {
+		builder.WriteString("resolver_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resolution_note=")
+	builder.WriteString(r.ResolutionNote)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := r.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reports is a parsable slice of Report.
+type Reports []*Report
@@ -0,0 +1,1387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReportCreate is the builder for creating a Report entity.
+type ReportCreate struct {
+	config
+	mutation *ReportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTargetType sets the "target_type" field.
+func (rc *ReportCreate) SetTargetType(ett enum.ReportTargetType) *ReportCreate {
+	rc.mutation.SetTargetType(ett)
+	return rc
+}
+
+// SetPostID sets the "post_id" field.
+func (rc *ReportCreate) SetPostID(u uuid.UUID) *ReportCreate {
+	rc.mutation.SetPostID(u)
+	return rc
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillablePostID(u *uuid.UUID) *ReportCreate {
+	if u != nil {
+		rc.SetPostID(*u)
+	}
+	return rc
+}
+
+// SetCommentID sets the "comment_id" field.
+func (rc *ReportCreate) SetCommentID(u uuid.UUID) *ReportCreate {
+	rc.mutation.SetCommentID(u)
+	return rc
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableCommentID(u *uuid.UUID) *ReportCreate {
+	if u != nil {
+		rc.SetCommentID(*u)
+	}
+	return rc
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (rc *ReportCreate) SetTargetUserID(u uuid.UUID) *ReportCreate {
+	rc.mutation.SetTargetUserID(u)
+	return rc
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableTargetUserID(u *uuid.UUID) *ReportCreate {
+	if u != nil {
+		rc.SetTargetUserID(*u)
+	}
+	return rc
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (rc *ReportCreate) SetReporterID(u uuid.UUID) *ReportCreate {
+	rc.mutation.SetReporterID(u)
+	return rc
+}
+
+// SetReason sets the "reason" field.
+func (rc *ReportCreate) SetReason(er enum.ReportReason) *ReportCreate {
+	rc.mutation.SetReason(er)
+	return rc
+}
+
+// SetDetails sets the "details" field.
+func (rc *ReportCreate) SetDetails(s string) *ReportCreate {
+	rc.mutation.SetDetails(s)
+	return rc
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableDetails(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetDetails(*s)
+	}
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReportCreate) SetStatus(es enum.ReportStatus) *ReportCreate {
+	rc.mutation.SetStatus(es)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableStatus(es *enum.ReportStatus) *ReportCreate {
+	if es != nil {
+		rc.SetStatus(*es)
+	}
+	return rc
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (rc *ReportCreate) SetResolverID(u uuid.UUID) *ReportCreate {
+	rc.mutation.SetResolverID(u)
+	return rc
+}
+
+// SetNillableResolverID sets the "resolver_id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableResolverID(u *uuid.UUID) *ReportCreate {
+	if u != nil {
+		rc.SetResolverID(*u)
+	}
+	return rc
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (rc *ReportCreate) SetResolutionNote(s string) *ReportCreate {
+	rc.mutation.SetResolutionNote(s)
+	return rc
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableResolutionNote(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetResolutionNote(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReportCreate) SetCreatedAt(t time.Time) *ReportCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableCreatedAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (rc *ReportCreate) SetResolvedAt(t time.Time) *ReportCreate {
+	rc.mutation.SetResolvedAt(t)
+	return rc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableResolvedAt(t *time.Time) *ReportCreate {
+	if t != nil {
+		rc.SetResolvedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReportCreate) SetID(u uuid.UUID) *ReportCreate {
+	rc.mutation.SetID(u)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableID(u *uuid.UUID) *ReportCreate {
+	if u != nil {
+		rc.SetID(*u)
+	}
+	return rc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (rc *ReportCreate) SetPost(p *Post) *ReportCreate {
+	return rc.SetPostID(p.ID)
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (rc *ReportCreate) SetComment(c *Comment) *ReportCreate {
+	return rc.SetCommentID(c.ID)
+}
+
+// SetTargetUser sets the "target_user" edge to the User entity.
+func (rc *ReportCreate) SetTargetUser(u *User) *ReportCreate {
+	return rc.SetTargetUserID(u.ID)
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (rc *ReportCreate) SetReporter(u *User) *ReportCreate {
+	return rc.SetReporterID(u.ID)
+}
+
+// SetResolver sets the "resolver" edge to the User entity.
+func (rc *ReportCreate) SetResolver(u *User) *ReportCreate {
+	return rc.SetResolverID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (rc *ReportCreate) Mutation() *ReportMutation {
+	return rc.mutation
+}
+
+// Save creates the Report in the database.
+func (rc *ReportCreate) Save(ctx context.Context) (*Report, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReportCreate) SaveX(ctx context.Context) *Report {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReportCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReportCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReportCreate) defaults() {
+	if _, ok := rc.mutation.Details(); !ok {
+		v := report.DefaultDetails
+		rc.mutation.SetDetails(v)
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		v := report.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.ResolutionNote(); !ok {
+		v := report.DefaultResolutionNote
+		rc.mutation.SetResolutionNote(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := report.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := report.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReportCreate) check() error {
+	if _, ok := rc.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "Report.target_type"`)}
+	}
+	if v, ok := rc.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ReporterID(); !ok {
+		return &ValidationError{Name: "reporter_id", err: errors.New(`ent: missing required field "Report.reporter_id"`)}
+	}
+	if _, ok := rc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Report.reason"`)}
+	}
+	if v, ok := rc.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Details(); !ok {
+		return &ValidationError{Name: "details", err: errors.New(`ent: missing required field "Report.details"`)}
+	}
+	if v, ok := rc.mutation.Details(); ok {
+		if err := report.DetailsValidator(v); err != nil {
+			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "Report.details": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Report.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ResolutionNote(); !ok {
+		return &ValidationError{Name: "resolution_note", err: errors.New(`ent: missing required field "Report.resolution_note"`)}
+	}
+	if v, ok := rc.mutation.ResolutionNote(); ok {
+		if err := report.ResolutionNoteValidator(v); err != nil {
+			return &ValidationError{Name: "resolution_note", err: fmt.Errorf(`ent: validator failed for field "Report.resolution_note": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Report.created_at"`)}
+	}
+	if len(rc.mutation.ReporterIDs()) == 0 {
+		return &ValidationError{Name: "reporter", err: errors.New(`ent: missing required edge "Report.reporter"`)}
+	}
+	return nil
+}
+
+func (rc *ReportCreate) sqlSave(ctx context.Context) (*Report, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReportCreate) createSpec() (*Report, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Report{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(report.Table, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rc.conflict
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rc.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+		_node.TargetType = value
+	}
+	if value, ok := rc.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := rc.mutation.Details(); ok {
+		_spec.SetField(report.FieldDetails, field.TypeString, value)
+		_node.Details = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.ResolutionNote(); ok {
+		_spec.SetField(report.FieldResolutionNote, field.TypeString, value)
+		_node.ResolutionNote = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.ResolvedAt(); ok {
+		_spec.SetField(report.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if nodes := rc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.PostTable,
+			Columns: []string{report.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PostID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.CommentTable,
+			Columns: []string{report.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CommentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.TargetUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.TargetUserTable,
+			Columns: []string{report.TargetUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TargetUserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReporterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ResolverIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ResolverTable,
+			Columns: []string{report.ResolverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResolverID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.Create().
+//		SetTargetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ReportCreate) OnConflict(opts ...sql.ConflictOption) *ReportUpsertOne {
+	rc.conflict = opts
+	return &ReportUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ReportCreate) OnConflictColumns(columns ...string) *ReportUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ReportUpsertOne is the builder for "upsert"-ing
+	//  one Report node.
+	ReportUpsertOne struct {
+		create *ReportCreate
+	}
+
+	// ReportUpsert is the "OnConflict" setter.
+	ReportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTargetType sets the "target_type" field.
+func (u *ReportUpsert) SetTargetType(v enum.ReportTargetType) *ReportUpsert {
+	u.Set(report.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateTargetType() *ReportUpsert {
+	u.SetExcluded(report.FieldTargetType)
+	return u
+}
+
+// SetPostID sets the "post_id" field.
+func (u *ReportUpsert) SetPostID(v uuid.UUID) *ReportUpsert {
+	u.Set(report.FieldPostID, v)
+	return u
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdatePostID() *ReportUpsert {
+	u.SetExcluded(report.FieldPostID)
+	return u
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (u *ReportUpsert) ClearPostID() *ReportUpsert {
+	u.SetNull(report.FieldPostID)
+	return u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *ReportUpsert) SetCommentID(v uuid.UUID) *ReportUpsert {
+	u.Set(report.FieldCommentID, v)
+	return u
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateCommentID() *ReportUpsert {
+	u.SetExcluded(report.FieldCommentID)
+	return u
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (u *ReportUpsert) ClearCommentID() *ReportUpsert {
+	u.SetNull(report.FieldCommentID)
+	return u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *ReportUpsert) SetTargetUserID(v uuid.UUID) *ReportUpsert {
+	u.Set(report.FieldTargetUserID, v)
+	return u
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateTargetUserID() *ReportUpsert {
+	u.SetExcluded(report.FieldTargetUserID)
+	return u
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *ReportUpsert) ClearTargetUserID() *ReportUpsert {
+	u.SetNull(report.FieldTargetUserID)
+	return u
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (u *ReportUpsert) SetReporterID(v uuid.UUID) *ReportUpsert {
+	u.Set(report.FieldReporterID, v)
+	return u
+}
+
+// UpdateReporterID sets the "reporter_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateReporterID() *ReportUpsert {
+	u.SetExcluded(report.FieldReporterID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ReportUpsert) SetReason(v enum.ReportReason) *ReportUpsert {
+	u.Set(report.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateReason() *ReportUpsert {
+	u.SetExcluded(report.FieldReason)
+	return u
+}
+
+// SetDetails sets the "details" field.
+func (u *ReportUpsert) SetDetails(v string) *ReportUpsert {
+	u.Set(report.FieldDetails, v)
+	return u
+}
+
+// UpdateDetails sets the "details" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateDetails() *ReportUpsert {
+	u.SetExcluded(report.FieldDetails)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsert) SetStatus(v enum.ReportStatus) *ReportUpsert {
+	u.Set(report.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateStatus() *ReportUpsert {
+	u.SetExcluded(report.FieldStatus)
+	return u
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (u *ReportUpsert) SetResolverID(v uuid.UUID) *ReportUpsert {
+	u.Set(report.FieldResolverID, v)
+	return u
+}
+
+// UpdateResolverID sets the "resolver_id" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateResolverID() *ReportUpsert {
+	u.SetExcluded(report.FieldResolverID)
+	return u
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (u *ReportUpsert) ClearResolverID() *ReportUpsert {
+	u.SetNull(report.FieldResolverID)
+	return u
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *ReportUpsert) SetResolutionNote(v string) *ReportUpsert {
+	u.Set(report.FieldResolutionNote, v)
+	return u
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateResolutionNote() *ReportUpsert {
+	u.SetExcluded(report.FieldResolutionNote)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportUpsert) SetCreatedAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateCreatedAt() *ReportUpsert {
+	u.SetExcluded(report.FieldCreatedAt)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReportUpsert) SetResolvedAt(v time.Time) *ReportUpsert {
+	u.Set(report.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReportUpsert) UpdateResolvedAt() *ReportUpsert {
+	u.SetExcluded(report.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReportUpsert) ClearResolvedAt() *ReportUpsert {
+	u.SetNull(report.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(report.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertOne) UpdateNewValues() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(report.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReportUpsertOne) Ignore() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertOne) DoNothing() *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreate.OnConflict
+// documentation for more info.
+func (u *ReportUpsertOne) Update(set func(*ReportUpsert)) *ReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *ReportUpsertOne) SetTargetType(v enum.ReportTargetType) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateTargetType() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *ReportUpsertOne) SetPostID(v uuid.UUID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdatePostID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (u *ReportUpsertOne) ClearPostID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearPostID()
+	})
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *ReportUpsertOne) SetCommentID(v uuid.UUID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateCommentID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (u *ReportUpsertOne) ClearCommentID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearCommentID()
+	})
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *ReportUpsertOne) SetTargetUserID(v uuid.UUID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetUserID(v)
+	})
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateTargetUserID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetUserID()
+	})
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *ReportUpsertOne) ClearTargetUserID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearTargetUserID()
+	})
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (u *ReportUpsertOne) SetReporterID(v uuid.UUID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetReporterID(v)
+	})
+}
+
+// UpdateReporterID sets the "reporter_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateReporterID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateReporterID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReportUpsertOne) SetReason(v enum.ReportReason) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateReason() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDetails sets the "details" field.
+func (u *ReportUpsertOne) SetDetails(v string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetDetails(v)
+	})
+}
+
+// UpdateDetails sets the "details" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateDetails() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateDetails()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertOne) SetStatus(v enum.ReportStatus) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateStatus() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (u *ReportUpsertOne) SetResolverID(v uuid.UUID) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolverID(v)
+	})
+}
+
+// UpdateResolverID sets the "resolver_id" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateResolverID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolverID()
+	})
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (u *ReportUpsertOne) ClearResolverID() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolverID()
+	})
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *ReportUpsertOne) SetResolutionNote(v string) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolutionNote(v)
+	})
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateResolutionNote() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolutionNote()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportUpsertOne) SetCreatedAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateCreatedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReportUpsertOne) SetResolvedAt(v time.Time) *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReportUpsertOne) UpdateResolvedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReportUpsertOne) ClearResolvedAt() *ReportUpsertOne {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReportUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReportUpsertOne.ID is not supported by MySQL driver. Use ReportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReportUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReportCreateBulk is the builder for creating many Report entities in bulk.
+type ReportCreateBulk struct {
+	config
+	err      error
+	builders []*ReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Report entities in the database.
+func (rcb *ReportCreateBulk) Save(ctx context.Context) ([]*Report, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Report, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReportCreateBulk) SaveX(ctx context.Context) []*Report {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReportCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Report.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReportUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReportUpsertBulk {
+	rcb.conflict = opts
+	return &ReportUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ReportCreateBulk) OnConflictColumns(columns ...string) *ReportUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ReportUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of Report nodes.
+type ReportUpsertBulk struct {
+	create *ReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(report.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) UpdateNewValues() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(report.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Report.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReportUpsertBulk) Ignore() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReportUpsertBulk) DoNothing() *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReportUpsertBulk) Update(set func(*ReportUpsert)) *ReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *ReportUpsertBulk) SetTargetType(v enum.ReportTargetType) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateTargetType() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetPostID sets the "post_id" field.
+func (u *ReportUpsertBulk) SetPostID(v uuid.UUID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetPostID(v)
+	})
+}
+
+// UpdatePostID sets the "post_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdatePostID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdatePostID()
+	})
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (u *ReportUpsertBulk) ClearPostID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearPostID()
+	})
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *ReportUpsertBulk) SetCommentID(v uuid.UUID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateCommentID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (u *ReportUpsertBulk) ClearCommentID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearCommentID()
+	})
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (u *ReportUpsertBulk) SetTargetUserID(v uuid.UUID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetTargetUserID(v)
+	})
+}
+
+// UpdateTargetUserID sets the "target_user_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateTargetUserID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateTargetUserID()
+	})
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (u *ReportUpsertBulk) ClearTargetUserID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearTargetUserID()
+	})
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (u *ReportUpsertBulk) SetReporterID(v uuid.UUID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetReporterID(v)
+	})
+}
+
+// UpdateReporterID sets the "reporter_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateReporterID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateReporterID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ReportUpsertBulk) SetReason(v enum.ReportReason) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateReason() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDetails sets the "details" field.
+func (u *ReportUpsertBulk) SetDetails(v string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetDetails(v)
+	})
+}
+
+// UpdateDetails sets the "details" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateDetails() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateDetails()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReportUpsertBulk) SetStatus(v enum.ReportStatus) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateStatus() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (u *ReportUpsertBulk) SetResolverID(v uuid.UUID) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolverID(v)
+	})
+}
+
+// UpdateResolverID sets the "resolver_id" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateResolverID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolverID()
+	})
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (u *ReportUpsertBulk) ClearResolverID() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolverID()
+	})
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *ReportUpsertBulk) SetResolutionNote(v string) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolutionNote(v)
+	})
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateResolutionNote() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolutionNote()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ReportUpsertBulk) SetCreatedAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateCreatedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *ReportUpsertBulk) SetResolvedAt(v time.Time) *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *ReportUpsertBulk) UpdateResolvedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *ReportUpsertBulk) ClearResolvedAt() *ReportUpsertBulk {
+	return u.Update(func(s *ReportUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
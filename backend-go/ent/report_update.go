@@ -0,0 +1,1097 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReportUpdate is the builder for updating Report entities.
+type ReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ru *ReportUpdate) Where(ps ...predicate.Report) *ReportUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetTargetType sets the "target_type" field.
+func (ru *ReportUpdate) SetTargetType(ett enum.ReportTargetType) *ReportUpdate {
+	ru.mutation.SetTargetType(ett)
+	return ru
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableTargetType(ett *enum.ReportTargetType) *ReportUpdate {
+	if ett != nil {
+		ru.SetTargetType(*ett)
+	}
+	return ru
+}
+
+// SetPostID sets the "post_id" field.
+func (ru *ReportUpdate) SetPostID(u uuid.UUID) *ReportUpdate {
+	ru.mutation.SetPostID(u)
+	return ru
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillablePostID(u *uuid.UUID) *ReportUpdate {
+	if u != nil {
+		ru.SetPostID(*u)
+	}
+	return ru
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (ru *ReportUpdate) ClearPostID() *ReportUpdate {
+	ru.mutation.ClearPostID()
+	return ru
+}
+
+// SetCommentID sets the "comment_id" field.
+func (ru *ReportUpdate) SetCommentID(u uuid.UUID) *ReportUpdate {
+	ru.mutation.SetCommentID(u)
+	return ru
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableCommentID(u *uuid.UUID) *ReportUpdate {
+	if u != nil {
+		ru.SetCommentID(*u)
+	}
+	return ru
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (ru *ReportUpdate) ClearCommentID() *ReportUpdate {
+	ru.mutation.ClearCommentID()
+	return ru
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (ru *ReportUpdate) SetTargetUserID(u uuid.UUID) *ReportUpdate {
+	ru.mutation.SetTargetUserID(u)
+	return ru
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableTargetUserID(u *uuid.UUID) *ReportUpdate {
+	if u != nil {
+		ru.SetTargetUserID(*u)
+	}
+	return ru
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (ru *ReportUpdate) ClearTargetUserID() *ReportUpdate {
+	ru.mutation.ClearTargetUserID()
+	return ru
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (ru *ReportUpdate) SetReporterID(u uuid.UUID) *ReportUpdate {
+	ru.mutation.SetReporterID(u)
+	return ru
+}
+
+// SetNillableReporterID sets the "reporter_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableReporterID(u *uuid.UUID) *ReportUpdate {
+	if u != nil {
+		ru.SetReporterID(*u)
+	}
+	return ru
+}
+
+// SetReason sets the "reason" field.
+func (ru *ReportUpdate) SetReason(er enum.ReportReason) *ReportUpdate {
+	ru.mutation.SetReason(er)
+	return ru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableReason(er *enum.ReportReason) *ReportUpdate {
+	if er != nil {
+		ru.SetReason(*er)
+	}
+	return ru
+}
+
+// SetDetails sets the "details" field.
+func (ru *ReportUpdate) SetDetails(s string) *ReportUpdate {
+	ru.mutation.SetDetails(s)
+	return ru
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableDetails(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetDetails(*s)
+	}
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReportUpdate) SetStatus(es enum.ReportStatus) *ReportUpdate {
+	ru.mutation.SetStatus(es)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableStatus(es *enum.ReportStatus) *ReportUpdate {
+	if es != nil {
+		ru.SetStatus(*es)
+	}
+	return ru
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (ru *ReportUpdate) SetResolverID(u uuid.UUID) *ReportUpdate {
+	ru.mutation.SetResolverID(u)
+	return ru
+}
+
+// SetNillableResolverID sets the "resolver_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableResolverID(u *uuid.UUID) *ReportUpdate {
+	if u != nil {
+		ru.SetResolverID(*u)
+	}
+	return ru
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (ru *ReportUpdate) ClearResolverID() *ReportUpdate {
+	ru.mutation.ClearResolverID()
+	return ru
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (ru *ReportUpdate) SetResolutionNote(s string) *ReportUpdate {
+	ru.mutation.SetResolutionNote(s)
+	return ru
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableResolutionNote(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetResolutionNote(*s)
+	}
+	return ru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ru *ReportUpdate) SetCreatedAt(t time.Time) *ReportUpdate {
+	ru.mutation.SetCreatedAt(t)
+	return ru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableCreatedAt(t *time.Time) *ReportUpdate {
+	if t != nil {
+		ru.SetCreatedAt(*t)
+	}
+	return ru
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (ru *ReportUpdate) SetResolvedAt(t time.Time) *ReportUpdate {
+	ru.mutation.SetResolvedAt(t)
+	return ru
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableResolvedAt(t *time.Time) *ReportUpdate {
+	if t != nil {
+		ru.SetResolvedAt(*t)
+	}
+	return ru
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (ru *ReportUpdate) ClearResolvedAt() *ReportUpdate {
+	ru.mutation.ClearResolvedAt()
+	return ru
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (ru *ReportUpdate) SetPost(p *Post) *ReportUpdate {
+	return ru.SetPostID(p.ID)
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (ru *ReportUpdate) SetComment(c *Comment) *ReportUpdate {
+	return ru.SetCommentID(c.ID)
+}
+
+// SetTargetUser sets the "target_user" edge to the User entity.
+func (ru *ReportUpdate) SetTargetUser(u *User) *ReportUpdate {
+	return ru.SetTargetUserID(u.ID)
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (ru *ReportUpdate) SetReporter(u *User) *ReportUpdate {
+	return ru.SetReporterID(u.ID)
+}
+
+// SetResolver sets the "resolver" edge to the User entity.
+func (ru *ReportUpdate) SetResolver(u *User) *ReportUpdate {
+	return ru.SetResolverID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ru *ReportUpdate) Mutation() *ReportMutation {
+	return ru.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (ru *ReportUpdate) ClearPost() *ReportUpdate {
+	ru.mutation.ClearPost()
+	return ru
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (ru *ReportUpdate) ClearComment() *ReportUpdate {
+	ru.mutation.ClearComment()
+	return ru
+}
+
+// ClearTargetUser clears the "target_user" edge to the User entity.
+func (ru *ReportUpdate) ClearTargetUser() *ReportUpdate {
+	ru.mutation.ClearTargetUser()
+	return ru
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (ru *ReportUpdate) ClearReporter() *ReportUpdate {
+	ru.mutation.ClearReporter()
+	return ru
+}
+
+// ClearResolver clears the "resolver" edge to the User entity.
+func (ru *ReportUpdate) ClearResolver() *ReportUpdate {
+	ru.mutation.ClearResolver()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReportUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReportUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReportUpdate) check() error {
+	if v, ok := ru.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Details(); ok {
+		if err := report.DetailsValidator(v); err != nil {
+			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "Report.details": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.ResolutionNote(); ok {
+		if err := report.ResolutionNoteValidator(v); err != nil {
+			return &ValidationError{Name: "resolution_note", err: fmt.Errorf(`ent: validator failed for field "Report.resolution_note": %w`, err)}
+		}
+	}
+	if ru.mutation.ReporterCleared() && len(ru.mutation.ReporterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Report.reporter"`)
+	}
+	return nil
+}
+
+func (ru *ReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Details(); ok {
+		_spec.SetField(report.FieldDetails, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.ResolutionNote(); ok {
+		_spec.SetField(report.FieldResolutionNote, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.ResolvedAt(); ok {
+		_spec.SetField(report.FieldResolvedAt, field.TypeTime, value)
+	}
+	if ru.mutation.ResolvedAtCleared() {
+		_spec.ClearField(report.FieldResolvedAt, field.TypeTime)
+	}
+	if ru.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.PostTable,
+			Columns: []string{report.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.PostTable,
+			Columns: []string{report.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.CommentTable,
+			Columns: []string{report.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.CommentTable,
+			Columns: []string{report.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.TargetUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.TargetUserTable,
+			Columns: []string{report.TargetUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.TargetUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.TargetUserTable,
+			Columns: []string{report.TargetUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ResolverCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ResolverTable,
+			Columns: []string{report.ResolverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ResolverIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ResolverTable,
+			Columns: []string{report.ResolverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReportUpdateOne is the builder for updating a single Report entity.
+type ReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReportMutation
+}
+
+// SetTargetType sets the "target_type" field.
+func (ruo *ReportUpdateOne) SetTargetType(ett enum.ReportTargetType) *ReportUpdateOne {
+	ruo.mutation.SetTargetType(ett)
+	return ruo
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableTargetType(ett *enum.ReportTargetType) *ReportUpdateOne {
+	if ett != nil {
+		ruo.SetTargetType(*ett)
+	}
+	return ruo
+}
+
+// SetPostID sets the "post_id" field.
+func (ruo *ReportUpdateOne) SetPostID(u uuid.UUID) *ReportUpdateOne {
+	ruo.mutation.SetPostID(u)
+	return ruo
+}
+
+// SetNillablePostID sets the "post_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillablePostID(u *uuid.UUID) *ReportUpdateOne {
+	if u != nil {
+		ruo.SetPostID(*u)
+	}
+	return ruo
+}
+
+// ClearPostID clears the value of the "post_id" field.
+func (ruo *ReportUpdateOne) ClearPostID() *ReportUpdateOne {
+	ruo.mutation.ClearPostID()
+	return ruo
+}
+
+// SetCommentID sets the "comment_id" field.
+func (ruo *ReportUpdateOne) SetCommentID(u uuid.UUID) *ReportUpdateOne {
+	ruo.mutation.SetCommentID(u)
+	return ruo
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableCommentID(u *uuid.UUID) *ReportUpdateOne {
+	if u != nil {
+		ruo.SetCommentID(*u)
+	}
+	return ruo
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (ruo *ReportUpdateOne) ClearCommentID() *ReportUpdateOne {
+	ruo.mutation.ClearCommentID()
+	return ruo
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (ruo *ReportUpdateOne) SetTargetUserID(u uuid.UUID) *ReportUpdateOne {
+	ruo.mutation.SetTargetUserID(u)
+	return ruo
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableTargetUserID(u *uuid.UUID) *ReportUpdateOne {
+	if u != nil {
+		ruo.SetTargetUserID(*u)
+	}
+	return ruo
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (ruo *ReportUpdateOne) ClearTargetUserID() *ReportUpdateOne {
+	ruo.mutation.ClearTargetUserID()
+	return ruo
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (ruo *ReportUpdateOne) SetReporterID(u uuid.UUID) *ReportUpdateOne {
+	ruo.mutation.SetReporterID(u)
+	return ruo
+}
+
+// SetNillableReporterID sets the "reporter_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableReporterID(u *uuid.UUID) *ReportUpdateOne {
+	if u != nil {
+		ruo.SetReporterID(*u)
+	}
+	return ruo
+}
+
+// SetReason sets the "reason" field.
+func (ruo *ReportUpdateOne) SetReason(er enum.ReportReason) *ReportUpdateOne {
+	ruo.mutation.SetReason(er)
+	return ruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableReason(er *enum.ReportReason) *ReportUpdateOne {
+	if er != nil {
+		ruo.SetReason(*er)
+	}
+	return ruo
+}
+
+// SetDetails sets the "details" field.
+func (ruo *ReportUpdateOne) SetDetails(s string) *ReportUpdateOne {
+	ruo.mutation.SetDetails(s)
+	return ruo
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableDetails(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetDetails(*s)
+	}
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReportUpdateOne) SetStatus(es enum.ReportStatus) *ReportUpdateOne {
+	ruo.mutation.SetStatus(es)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableStatus(es *enum.ReportStatus) *ReportUpdateOne {
+	if es != nil {
+		ruo.SetStatus(*es)
+	}
+	return ruo
+}
+
+// SetResolverID sets the "resolver_id" field.
+func (ruo *ReportUpdateOne) SetResolverID(u uuid.UUID) *ReportUpdateOne {
+	ruo.mutation.SetResolverID(u)
+	return ruo
+}
+
+// SetNillableResolverID sets the "resolver_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableResolverID(u *uuid.UUID) *ReportUpdateOne {
+	if u != nil {
+		ruo.SetResolverID(*u)
+	}
+	return ruo
+}
+
+// ClearResolverID clears the value of the "resolver_id" field.
+func (ruo *ReportUpdateOne) ClearResolverID() *ReportUpdateOne {
+	ruo.mutation.ClearResolverID()
+	return ruo
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (ruo *ReportUpdateOne) SetResolutionNote(s string) *ReportUpdateOne {
+	ruo.mutation.SetResolutionNote(s)
+	return ruo
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableResolutionNote(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetResolutionNote(*s)
+	}
+	return ruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruo *ReportUpdateOne) SetCreatedAt(t time.Time) *ReportUpdateOne {
+	ruo.mutation.SetCreatedAt(t)
+	return ruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableCreatedAt(t *time.Time) *ReportUpdateOne {
+	if t != nil {
+		ruo.SetCreatedAt(*t)
+	}
+	return ruo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (ruo *ReportUpdateOne) SetResolvedAt(t time.Time) *ReportUpdateOne {
+	ruo.mutation.SetResolvedAt(t)
+	return ruo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableResolvedAt(t *time.Time) *ReportUpdateOne {
+	if t != nil {
+		ruo.SetResolvedAt(*t)
+	}
+	return ruo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (ruo *ReportUpdateOne) ClearResolvedAt() *ReportUpdateOne {
+	ruo.mutation.ClearResolvedAt()
+	return ruo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (ruo *ReportUpdateOne) SetPost(p *Post) *ReportUpdateOne {
+	return ruo.SetPostID(p.ID)
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (ruo *ReportUpdateOne) SetComment(c *Comment) *ReportUpdateOne {
+	return ruo.SetCommentID(c.ID)
+}
+
+// SetTargetUser sets the "target_user" edge to the User entity.
+func (ruo *ReportUpdateOne) SetTargetUser(u *User) *ReportUpdateOne {
+	return ruo.SetTargetUserID(u.ID)
+}
+
+// SetReporter sets the "reporter" edge to the User entity.
+func (ruo *ReportUpdateOne) SetReporter(u *User) *ReportUpdateOne {
+	return ruo.SetReporterID(u.ID)
+}
+
+// SetResolver sets the "resolver" edge to the User entity.
+func (ruo *ReportUpdateOne) SetResolver(u *User) *ReportUpdateOne {
+	return ruo.SetResolverID(u.ID)
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ruo *ReportUpdateOne) Mutation() *ReportMutation {
+	return ruo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (ruo *ReportUpdateOne) ClearPost() *ReportUpdateOne {
+	ruo.mutation.ClearPost()
+	return ruo
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (ruo *ReportUpdateOne) ClearComment() *ReportUpdateOne {
+	ruo.mutation.ClearComment()
+	return ruo
+}
+
+// ClearTargetUser clears the "target_user" edge to the User entity.
+func (ruo *ReportUpdateOne) ClearTargetUser() *ReportUpdateOne {
+	ruo.mutation.ClearTargetUser()
+	return ruo
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (ruo *ReportUpdateOne) ClearReporter() *ReportUpdateOne {
+	ruo.mutation.ClearReporter()
+	return ruo
+}
+
+// ClearResolver clears the "resolver" edge to the User entity.
+func (ruo *ReportUpdateOne) ClearResolver() *ReportUpdateOne {
+	ruo.mutation.ClearResolver()
+	return ruo
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ruo *ReportUpdateOne) Where(ps ...predicate.Report) *ReportUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReportUpdateOne) Select(field string, fields ...string) *ReportUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Report entity.
+func (ruo *ReportUpdateOne) Save(ctx context.Context) (*Report, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReportUpdateOne) SaveX(ctx context.Context) *Report {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReportUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReportUpdateOne) check() error {
+	if v, ok := ruo.mutation.TargetType(); ok {
+		if err := report.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Report.target_type": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Reason(); ok {
+		if err := report.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Report.reason": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Details(); ok {
+		if err := report.DetailsValidator(v); err != nil {
+			return &ValidationError{Name: "details", err: fmt.Errorf(`ent: validator failed for field "Report.details": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.ResolutionNote(); ok {
+		if err := report.ResolutionNoteValidator(v); err != nil {
+			return &ValidationError{Name: "resolution_note", err: fmt.Errorf(`ent: validator failed for field "Report.resolution_note": %w`, err)}
+		}
+	}
+	if ruo.mutation.ReporterCleared() && len(ruo.mutation.ReporterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Report.reporter"`)
+	}
+	return nil
+}
+
+func (ruo *ReportUpdateOne) sqlSave(ctx context.Context) (_node *Report, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Report.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, report.FieldID)
+		for _, f := range fields {
+			if !report.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != report.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.TargetType(); ok {
+		_spec.SetField(report.FieldTargetType, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Reason(); ok {
+		_spec.SetField(report.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Details(); ok {
+		_spec.SetField(report.FieldDetails, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.ResolutionNote(); ok {
+		_spec.SetField(report.FieldResolutionNote, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.ResolvedAt(); ok {
+		_spec.SetField(report.FieldResolvedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(report.FieldResolvedAt, field.TypeTime)
+	}
+	if ruo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.PostTable,
+			Columns: []string{report.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.PostTable,
+			Columns: []string{report.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.CommentTable,
+			Columns: []string{report.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.CommentTable,
+			Columns: []string{report.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.TargetUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.TargetUserTable,
+			Columns: []string{report.TargetUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.TargetUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.TargetUserTable,
+			Columns: []string{report.TargetUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ReporterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ReporterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ReporterTable,
+			Columns: []string{report.ReporterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ResolverCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ResolverTable,
+			Columns: []string{report.ResolverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ResolverIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   report.ResolverTable,
+			Columns: []string{report.ResolverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Report{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -7,14 +7,32 @@ import (
 
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
 	"github.com/aki-13627/animalia/backend-go/ent/devicetoken"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
 	"github.com/aki-13627/animalia/backend-go/ent/schema"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
 	"github.com/google/uuid"
 )
 
@@ -39,13 +57,55 @@ func init() {
 	// comment.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	comment.ContentValidator = commentDescContent.Validators[0].(func(string) error)
 	// commentDescCreatedAt is the schema descriptor for created_at field.
-	commentDescCreatedAt := commentFields[2].Descriptor()
+	commentDescCreatedAt := commentFields[3].Descriptor()
 	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
 	// commentDescID is the schema descriptor for id field.
 	commentDescID := commentFields[0].Descriptor()
 	// comment.DefaultID holds the default value on creation for the id field.
 	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	commentlikeFields := schema.CommentLike{}.Fields()
+	_ = commentlikeFields
+	// commentlikeDescCreatedAt is the schema descriptor for created_at field.
+	commentlikeDescCreatedAt := commentlikeFields[3].Descriptor()
+	// commentlike.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentlike.DefaultCreatedAt = commentlikeDescCreatedAt.Default.(func() time.Time)
+	// commentlikeDescID is the schema descriptor for id field.
+	commentlikeDescID := commentlikeFields[0].Descriptor()
+	// commentlike.DefaultID holds the default value on creation for the id field.
+	commentlike.DefaultID = commentlikeDescID.Default.(func() uuid.UUID)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescEmail is the schema descriptor for email field.
+	credentialDescEmail := credentialFields[1].Descriptor()
+	// credential.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	credential.EmailValidator = credentialDescEmail.Validators[0].(func(string) error)
+	// credentialDescPasswordHash is the schema descriptor for password_hash field.
+	credentialDescPasswordHash := credentialFields[2].Descriptor()
+	// credential.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	credential.PasswordHashValidator = credentialDescPasswordHash.Validators[0].(func(string) error)
+	// credentialDescEmailVerified is the schema descriptor for email_verified field.
+	credentialDescEmailVerified := credentialFields[3].Descriptor()
+	// credential.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	credential.DefaultEmailVerified = credentialDescEmailVerified.Default.(bool)
+	// credentialDescTokenVersion is the schema descriptor for token_version field.
+	credentialDescTokenVersion := credentialFields[4].Descriptor()
+	// credential.DefaultTokenVersion holds the default value on creation for the token_version field.
+	credential.DefaultTokenVersion = credentialDescTokenVersion.Default.(int)
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[5].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	// credentialDescUpdatedAt is the schema descriptor for updated_at field.
+	credentialDescUpdatedAt := credentialFields[6].Descriptor()
+	// credential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credential.DefaultUpdatedAt = credentialDescUpdatedAt.Default.(func() time.Time)
+	// credential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	credential.UpdateDefaultUpdatedAt = credentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// credentialDescID is the schema descriptor for id field.
+	credentialDescID := credentialFields[0].Descriptor()
+	// credential.DefaultID holds the default value on creation for the id field.
+	credential.DefaultID = credentialDescID.Default.(func() uuid.UUID)
 	dailytaskFields := schema.DailyTask{}.Fields()
 	_ = dailytaskFields
 	// dailytaskDescCreatedAt is the schema descriptor for created_at field.
@@ -60,6 +120,12 @@ func init() {
 	dailytaskDescID := dailytaskFields[0].Descriptor()
 	// dailytask.DefaultID holds the default value on creation for the id field.
 	dailytask.DefaultID = dailytaskDescID.Default.(func() uuid.UUID)
+	datamigrationFields := schema.DataMigration{}.Fields()
+	_ = datamigrationFields
+	// datamigrationDescID is the schema descriptor for id field.
+	datamigrationDescID := datamigrationFields[0].Descriptor()
+	// datamigration.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	datamigration.IDValidator = datamigrationDescID.Validators[0].(func(string) error)
 	devicetokenFields := schema.DeviceToken{}.Fields()
 	_ = devicetokenFields
 	// devicetokenDescDeviceID is the schema descriptor for device_id field.
@@ -88,6 +154,20 @@ func init() {
 	devicetokenDescID := devicetokenFields[0].Descriptor()
 	// devicetoken.DefaultID holds the default value on creation for the id field.
 	devicetoken.DefaultID = devicetokenDescID.Default.(func() uuid.UUID)
+	explorerankingFields := schema.ExploreRanking{}.Fields()
+	_ = explorerankingFields
+	// explorerankingDescPosition is the schema descriptor for position field.
+	explorerankingDescPosition := explorerankingFields[1].Descriptor()
+	// exploreranking.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	exploreranking.PositionValidator = explorerankingDescPosition.Validators[0].(func(int) error)
+	// explorerankingDescComputedAt is the schema descriptor for computed_at field.
+	explorerankingDescComputedAt := explorerankingFields[3].Descriptor()
+	// exploreranking.DefaultComputedAt holds the default value on creation for the computed_at field.
+	exploreranking.DefaultComputedAt = explorerankingDescComputedAt.Default.(func() time.Time)
+	// explorerankingDescID is the schema descriptor for id field.
+	explorerankingDescID := explorerankingFields[0].Descriptor()
+	// exploreranking.DefaultID holds the default value on creation for the id field.
+	exploreranking.DefaultID = explorerankingDescID.Default.(func() uuid.UUID)
 	followrelationFields := schema.FollowRelation{}.Fields()
 	_ = followrelationFields
 	// followrelationDescCreatedAt is the schema descriptor for created_at field.
@@ -98,16 +178,74 @@ func init() {
 	followrelationDescID := followrelationFields[0].Descriptor()
 	// followrelation.DefaultID holds the default value on creation for the id field.
 	followrelation.DefaultID = followrelationDescID.Default.(func() uuid.UUID)
+	followrequestFields := schema.FollowRequest{}.Fields()
+	_ = followrequestFields
+	// followrequestDescCreatedAt is the schema descriptor for created_at field.
+	followrequestDescCreatedAt := followrequestFields[1].Descriptor()
+	// followrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	followrequest.DefaultCreatedAt = followrequestDescCreatedAt.Default.(func() time.Time)
+	// followrequestDescID is the schema descriptor for id field.
+	followrequestDescID := followrequestFields[0].Descriptor()
+	// followrequest.DefaultID holds the default value on creation for the id field.
+	followrequest.DefaultID = followrequestDescID.Default.(func() uuid.UUID)
 	likeFields := schema.Like{}.Fields()
 	_ = likeFields
 	// likeDescCreatedAt is the schema descriptor for created_at field.
-	likeDescCreatedAt := likeFields[1].Descriptor()
+	likeDescCreatedAt := likeFields[2].Descriptor()
 	// like.DefaultCreatedAt holds the default value on creation for the created_at field.
 	like.DefaultCreatedAt = likeDescCreatedAt.Default.(func() time.Time)
 	// likeDescID is the schema descriptor for id field.
 	likeDescID := likeFields[0].Descriptor()
 	// like.DefaultID holds the default value on creation for the id field.
 	like.DefaultID = likeDescID.Default.(func() uuid.UUID)
+	muterelationFields := schema.MuteRelation{}.Fields()
+	_ = muterelationFields
+	// muterelationDescCreatedAt is the schema descriptor for created_at field.
+	muterelationDescCreatedAt := muterelationFields[1].Descriptor()
+	// muterelation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	muterelation.DefaultCreatedAt = muterelationDescCreatedAt.Default.(func() time.Time)
+	// muterelationDescID is the schema descriptor for id field.
+	muterelationDescID := muterelationFields[0].Descriptor()
+	// muterelation.DefaultID holds the default value on creation for the id field.
+	muterelation.DefaultID = muterelationDescID.Default.(func() uuid.UUID)
+	mutedkeywordFields := schema.MutedKeyword{}.Fields()
+	_ = mutedkeywordFields
+	// mutedkeywordDescKeyword is the schema descriptor for keyword field.
+	mutedkeywordDescKeyword := mutedkeywordFields[2].Descriptor()
+	// mutedkeyword.KeywordValidator is a validator for the "keyword" field. It is called by the builders before save.
+	mutedkeyword.KeywordValidator = func() func(string) error {
+		validators := mutedkeywordDescKeyword.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(keyword string) error {
+			for _, fn := range fns {
+				if err := fn(keyword); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// mutedkeywordDescCreatedAt is the schema descriptor for created_at field.
+	mutedkeywordDescCreatedAt := mutedkeywordFields[3].Descriptor()
+	// mutedkeyword.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mutedkeyword.DefaultCreatedAt = mutedkeywordDescCreatedAt.Default.(func() time.Time)
+	// mutedkeywordDescID is the schema descriptor for id field.
+	mutedkeywordDescID := mutedkeywordFields[0].Descriptor()
+	// mutedkeyword.DefaultID holds the default value on creation for the id field.
+	mutedkeyword.DefaultID = mutedkeywordDescID.Default.(func() uuid.UUID)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
 	petFields := schema.Pet{}.Fields()
 	_ = petFields
 	// petDescName is the schema descriptor for name field.
@@ -141,13 +279,141 @@ func init() {
 	// post.ImageKeyValidator is a validator for the "image_key" field. It is called by the builders before save.
 	post.ImageKeyValidator = postDescImageKey.Validators[0].(func(string) error)
 	// postDescCreatedAt is the schema descriptor for created_at field.
-	postDescCreatedAt := postFields[4].Descriptor()
+	postDescCreatedAt := postFields[5].Descriptor()
 	// post.DefaultCreatedAt holds the default value on creation for the created_at field.
 	post.DefaultCreatedAt = postDescCreatedAt.Default.(func() time.Time)
+	// postDescLikeCount is the schema descriptor for like_count field.
+	postDescLikeCount := postFields[9].Descriptor()
+	// post.DefaultLikeCount holds the default value on creation for the like_count field.
+	post.DefaultLikeCount = postDescLikeCount.Default.(int)
+	// postDescCommentCount is the schema descriptor for comment_count field.
+	postDescCommentCount := postFields[10].Descriptor()
+	// post.DefaultCommentCount holds the default value on creation for the comment_count field.
+	post.DefaultCommentCount = postDescCommentCount.Default.(int)
 	// postDescID is the schema descriptor for id field.
 	postDescID := postFields[0].Descriptor()
 	// post.DefaultID holds the default value on creation for the id field.
 	post.DefaultID = postDescID.Default.(func() uuid.UUID)
+	postmediaFields := schema.PostMedia{}.Fields()
+	_ = postmediaFields
+	// postmediaDescPosition is the schema descriptor for position field.
+	postmediaDescPosition := postmediaFields[1].Descriptor()
+	// postmedia.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	postmedia.PositionValidator = postmediaDescPosition.Validators[0].(func(int) error)
+	// postmediaDescKey is the schema descriptor for key field.
+	postmediaDescKey := postmediaFields[3].Descriptor()
+	// postmedia.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	postmedia.KeyValidator = postmediaDescKey.Validators[0].(func(string) error)
+	// postmediaDescAltText is the schema descriptor for alt_text field.
+	postmediaDescAltText := postmediaFields[6].Descriptor()
+	// postmedia.DefaultAltText holds the default value on creation for the alt_text field.
+	postmedia.DefaultAltText = postmediaDescAltText.Default.(string)
+	// postmediaDescID is the schema descriptor for id field.
+	postmediaDescID := postmediaFields[0].Descriptor()
+	// postmedia.DefaultID holds the default value on creation for the id field.
+	postmedia.DefaultID = postmediaDescID.Default.(func() uuid.UUID)
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescCreatedAt is the schema descriptor for created_at field.
+	postrevisionDescCreatedAt := postrevisionFields[3].Descriptor()
+	// postrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postrevision.DefaultCreatedAt = postrevisionDescCreatedAt.Default.(func() time.Time)
+	// postrevisionDescID is the schema descriptor for id field.
+	postrevisionDescID := postrevisionFields[0].Descriptor()
+	// postrevision.DefaultID holds the default value on creation for the id field.
+	postrevision.DefaultID = postrevisionDescID.Default.(func() uuid.UUID)
+	posttagFields := schema.PostTag{}.Fields()
+	_ = posttagFields
+	// posttagDescCreatedAt is the schema descriptor for created_at field.
+	posttagDescCreatedAt := posttagFields[2].Descriptor()
+	// posttag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	posttag.DefaultCreatedAt = posttagDescCreatedAt.Default.(func() time.Time)
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescDetails is the schema descriptor for details field.
+	reportDescDetails := reportFields[7].Descriptor()
+	// report.DefaultDetails holds the default value on creation for the details field.
+	report.DefaultDetails = reportDescDetails.Default.(string)
+	// report.DetailsValidator is a validator for the "details" field. It is called by the builders before save.
+	report.DetailsValidator = reportDescDetails.Validators[0].(func(string) error)
+	// reportDescResolutionNote is the schema descriptor for resolution_note field.
+	reportDescResolutionNote := reportFields[10].Descriptor()
+	// report.DefaultResolutionNote holds the default value on creation for the resolution_note field.
+	report.DefaultResolutionNote = reportDescResolutionNote.Default.(string)
+	// report.ResolutionNoteValidator is a validator for the "resolution_note" field. It is called by the builders before save.
+	report.ResolutionNoteValidator = reportDescResolutionNote.Validators[0].(func(string) error)
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportFields[11].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() time.Time)
+	// reportDescID is the schema descriptor for id field.
+	reportDescID := reportFields[0].Descriptor()
+	// report.DefaultID holds the default value on creation for the id field.
+	report.DefaultID = reportDescID.Default.(func() uuid.UUID)
+	suspensionFields := schema.Suspension{}.Fields()
+	_ = suspensionFields
+	// suspensionDescReason is the schema descriptor for reason field.
+	suspensionDescReason := suspensionFields[2].Descriptor()
+	// suspension.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	suspension.ReasonValidator = func() func(string) error {
+		validators := suspensionDescReason.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reason string) error {
+			for _, fn := range fns {
+				if err := fn(reason); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// suspensionDescStartsAt is the schema descriptor for starts_at field.
+	suspensionDescStartsAt := suspensionFields[3].Descriptor()
+	// suspension.DefaultStartsAt holds the default value on creation for the starts_at field.
+	suspension.DefaultStartsAt = suspensionDescStartsAt.Default.(func() time.Time)
+	// suspensionDescCreatedAt is the schema descriptor for created_at field.
+	suspensionDescCreatedAt := suspensionFields[7].Descriptor()
+	// suspension.DefaultCreatedAt holds the default value on creation for the created_at field.
+	suspension.DefaultCreatedAt = suspensionDescCreatedAt.Default.(func() time.Time)
+	// suspensionDescID is the schema descriptor for id field.
+	suspensionDescID := suspensionFields[0].Descriptor()
+	// suspension.DefaultID holds the default value on creation for the id field.
+	suspension.DefaultID = suspensionDescID.Default.(func() uuid.UUID)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[2].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
+	timelinecacheFields := schema.TimelineCache{}.Fields()
+	_ = timelinecacheFields
+	// timelinecacheDescUpdatedAt is the schema descriptor for updated_at field.
+	timelinecacheDescUpdatedAt := timelinecacheFields[4].Descriptor()
+	// timelinecache.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	timelinecache.DefaultUpdatedAt = timelinecacheDescUpdatedAt.Default.(func() time.Time)
+	// timelinecache.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	timelinecache.UpdateDefaultUpdatedAt = timelinecacheDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// timelinecacheDescID is the schema descriptor for id field.
+	timelinecacheDescID := timelinecacheFields[0].Descriptor()
+	// timelinecache.DefaultID holds the default value on creation for the id field.
+	timelinecache.DefaultID = timelinecacheDescID.Default.(func() uuid.UUID)
+	tokenrevocationFields := schema.TokenRevocation{}.Fields()
+	_ = tokenrevocationFields
+	// tokenrevocationDescID is the schema descriptor for id field.
+	tokenrevocationDescID := tokenrevocationFields[0].Descriptor()
+	// tokenrevocation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tokenrevocation.IDValidator = tokenrevocationDescID.Validators[0].(func(string) error)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -155,23 +421,65 @@ func init() {
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	// userDescName is the schema descriptor for name field.
-	userDescName := userFields[3].Descriptor()
+	userDescName := userFields[4].Descriptor()
 	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	user.NameValidator = userDescName.Validators[0].(func(string) error)
 	// userDescBio is the schema descriptor for bio field.
-	userDescBio := userFields[4].Descriptor()
+	userDescBio := userFields[6].Descriptor()
 	// user.DefaultBio holds the default value on creation for the bio field.
 	user.DefaultBio = userDescBio.Default.(string)
 	// userDescStreakCount is the schema descriptor for streak_count field.
-	userDescStreakCount := userFields[5].Descriptor()
+	userDescStreakCount := userFields[7].Descriptor()
 	// user.DefaultStreakCount holds the default value on creation for the streak_count field.
 	user.DefaultStreakCount = userDescStreakCount.Default.(uint32)
+	// userDescRole is the schema descriptor for role field.
+	userDescRole := userFields[8].Descriptor()
+	// user.DefaultRole holds the default value on creation for the role field.
+	user.DefaultRole = enum.Role(userDescRole.Default.(string))
+	// userDescIsPrivate is the schema descriptor for is_private field.
+	userDescIsPrivate := userFields[10].Descriptor()
+	// user.DefaultIsPrivate holds the default value on creation for the is_private field.
+	user.DefaultIsPrivate = userDescIsPrivate.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[7].Descriptor()
+	userDescCreatedAt := userFields[11].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescFollowerCount is the schema descriptor for follower_count field.
+	userDescFollowerCount := userFields[12].Descriptor()
+	// user.DefaultFollowerCount holds the default value on creation for the follower_count field.
+	user.DefaultFollowerCount = userDescFollowerCount.Default.(int)
+	// userDescFollowingCount is the schema descriptor for following_count field.
+	userDescFollowingCount := userFields[13].Descriptor()
+	// user.DefaultFollowingCount holds the default value on creation for the following_count field.
+	user.DefaultFollowingCount = userDescFollowingCount.Default.(int)
+	// userDescPostCount is the schema descriptor for post_count field.
+	userDescPostCount := userFields[14].Descriptor()
+	// user.DefaultPostCount holds the default value on creation for the post_count field.
+	user.DefaultPostCount = userDescPostCount.Default.(int)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	verificationcodeFields := schema.VerificationCode{}.Fields()
+	_ = verificationcodeFields
+	// verificationcodeDescEmail is the schema descriptor for email field.
+	verificationcodeDescEmail := verificationcodeFields[1].Descriptor()
+	// verificationcode.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	verificationcode.EmailValidator = verificationcodeDescEmail.Validators[0].(func(string) error)
+	// verificationcodeDescCodeHash is the schema descriptor for code_hash field.
+	verificationcodeDescCodeHash := verificationcodeFields[3].Descriptor()
+	// verificationcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	verificationcode.CodeHashValidator = verificationcodeDescCodeHash.Validators[0].(func(string) error)
+	// verificationcodeDescAttempts is the schema descriptor for attempts field.
+	verificationcodeDescAttempts := verificationcodeFields[4].Descriptor()
+	// verificationcode.DefaultAttempts holds the default value on creation for the attempts field.
+	verificationcode.DefaultAttempts = verificationcodeDescAttempts.Default.(int)
+	// verificationcodeDescCreatedAt is the schema descriptor for created_at field.
+	verificationcodeDescCreatedAt := verificationcodeFields[6].Descriptor()
+	// verificationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	verificationcode.DefaultCreatedAt = verificationcodeDescCreatedAt.Default.(func() time.Time)
+	// verificationcodeDescID is the schema descriptor for id field.
+	verificationcodeDescID := verificationcodeFields[0].Descriptor()
+	// verificationcode.DefaultID holds the default value on creation for the id field.
+	verificationcode.DefaultID = verificationcodeDescID.Default.(func() uuid.UUID)
 }
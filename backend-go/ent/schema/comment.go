@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,7 +20,11 @@ func (Comment) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
 		field.String("content").NotEmpty(),
+		field.UUID("parent_id", uuid.UUID{}).Optional().Nillable().Comment("返信先のコメント。返信への返信は作らず、一段のみ"),
 		field.Time("created_at").Default(time.Now),
+		field.Time("edited_at").Optional().Nillable(),
+		field.Time("deleted_at").Optional().Nillable().Comment("コメントの投稿者か投稿の所有者が削除した日時"),
+		field.Time("hidden_at").Optional().Nillable().Comment("通報数が閾値に達して自動的に非表示になった日時"),
 	}
 }
 
@@ -28,5 +33,15 @@ func (Comment) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("post", Post.Type).Ref("comments").Unique().Required(),
 		edge.From("user", User.Type).Ref("comments").Unique().Required(),
+		edge.To("reports", Report.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+		// 本文でメンションされたユーザー。ブロック関係にあるユーザーは含まない
+		edge.To("mentions", User.Type),
+		edge.To("notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("replies", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("likes", CommentLike.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CommentLike holds the schema definition for the CommentLike entity.
+type CommentLike struct {
+	ent.Schema
+}
+
+// Fields of the CommentLike.
+func (CommentLike) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("comment_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the CommentLike.
+func (CommentLike) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("comment", Comment.Type).Ref("likes").Field("comment_id").Unique().Required(),
+		edge.From("user", User.Type).Ref("comment_likes").Field("user_id").Unique().Required(),
+	}
+}
+
+// Indexes of the CommentLike.
+func (CommentLike) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("comment_id", "user_id").Unique(),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Credential holds the schema definition for the Credential entity.
+// Cognito を使わないローカル認証で利用するログイン情報。
+type Credential struct {
+	ent.Schema
+}
+
+// Fields of the Credential.
+func (Credential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("email").NotEmpty().Unique(),
+		field.String("password_hash").NotEmpty().Sensitive(),
+		field.Bool("email_verified").Default(false),
+		field.Int("token_version").Default(0).Comment("サインアウト時に加算し、発行済みのトークンを無効にする"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Credential.
+func (Credential) Edges() []ent.Edge {
+	return nil
+}
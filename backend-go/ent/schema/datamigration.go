@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// DataMigration holds the schema definition for the DataMigration entity.
+// 起動時に一度だけ実行するデータ移行の実行済みの記録。
+type DataMigration struct {
+	ent.Schema
+}
+
+// Fields of the DataMigration.
+func (DataMigration) Fields() []ent.Field {
+	return []ent.Field{
+		// 移行の名前
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.Time("applied_at"),
+	}
+}
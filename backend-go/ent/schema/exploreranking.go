@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ExploreRanking holds the schema definition for the ExploreRanking entity.
+// 探索フィードの順位。定期ジョブがまとめて作り直し、API は順位順に読むだけにする。
+type ExploreRanking struct {
+	ent.Schema
+}
+
+// Fields of the ExploreRanking.
+func (ExploreRanking) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Int("position").Positive().Comment("1 が最上位"),
+		field.Float("score"),
+		field.Time("computed_at").Default(time.Now),
+	}
+}
+
+// Edges of the ExploreRanking.
+func (ExploreRanking) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("explore_ranking").Unique().Required(),
+	}
+}
+
+// Indexes of the ExploreRanking.
+func (ExploreRanking) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position"),
+	}
+}
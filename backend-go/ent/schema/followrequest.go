@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FollowRequest holds the schema definition for the FollowRequest entity.
+// A pending request to follow a private account. It is deleted when the request
+// is approved (and a FollowRelation is created), rejected or cancelled.
+type FollowRequest struct {
+	ent.Schema
+}
+
+// Fields of the FollowRequest.
+func (FollowRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the FollowRequest.
+func (FollowRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("from", User.Type).Ref("follow_requests_sent").Unique().Required(),
+		edge.From("to", User.Type).Ref("follow_requests_received").Unique().Required(),
+	}
+}
+
+// Ensure uniqueness: a user has at most one pending request to the same person.
+func (FollowRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("from", "to").Unique(),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
@@ -19,6 +20,8 @@ type Like struct {
 func (Like) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		// 既存のいいねはマイグレーションで default の paw になる
+		field.Enum("reaction").GoType(enum.ReactionType("")).Default(string(enum.ReactionTypePaw)),
 		field.Time("created_at").Default(time.Now),
 	}
 }
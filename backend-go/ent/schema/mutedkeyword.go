@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MutedKeyword holds the schema definition for the MutedKeyword entity.
+// キャプションにキーワードを含む投稿はミュートしたユーザーのフィードに表示されない。
+type MutedKeyword struct {
+	ent.Schema
+}
+
+// Fields of the MutedKeyword.
+func (MutedKeyword) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("keyword").NotEmpty().MaxLen(200),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MutedKeyword.
+func (MutedKeyword) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("muted_keywords").Field("user_id").Unique().Required(),
+	}
+}
+
+// Indexes of the MutedKeyword.
+func (MutedKeyword) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "keyword").Unique(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MuteRelation holds the schema definition for the MuteRelation entity.
+// ブロックと違い相手には通知されず、フォロー関係もそのまま残る。
+type MuteRelation struct {
+	ent.Schema
+}
+
+// Fields of the MuteRelation.
+func (MuteRelation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MuteRelation.
+func (MuteRelation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("from", User.Type).Ref("muting").Unique().Required(),
+		edge.From("to", User.Type).Ref("muted_by").Unique().Required(),
+	}
+}
+
+// Ensure uniqueness: a user cannot mute the same person twice.
+func (MuteRelation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("from", "to").Unique(),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// アプリ内の通知。メンションされたユーザーなどに届く。
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Enum("type").GoType(enum.NotificationType("")),
+		field.UUID("recipient_id", uuid.UUID{}),
+		field.UUID("actor_id", uuid.UUID{}),
+		field.UUID("post_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("comment_id", uuid.UUID{}).Optional().Nillable().Comment("コメントでのメンションのみ"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("read_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("recipient", User.Type).Ref("notifications").Field("recipient_id").Unique().Required(),
+		edge.From("actor", User.Type).Ref("notifications_sent").Field("actor_id").Unique().Required(),
+		edge.From("post", Post.Type).Ref("notifications").Field("post_id").Unique(),
+		edge.From("comment", Comment.Type).Ref("notifications").Field("comment_id").Unique(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recipient_id", "created_at"),
+	}
+}
@@ -53,5 +53,6 @@ func (Pet) Fields() []ent.Field {
 func (Pet) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("owner", User.Type).Ref("pets").Unique().Required(),
+		edge.From("posts", Post.Type).Ref("pets"),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
@@ -21,9 +22,15 @@ func (Post) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
 		field.Uint32("index").Immutable().Unique().Optional(),
 		field.String("caption").NotEmpty(),
-		field.String("image_key").NotEmpty(),
+		field.String("image_key").NotEmpty().Comment("1枚目の画像か動画のポスター画像のキー。アルゴリズムサービスが参照するため残している"),
+		field.Enum("media_type").GoType(enum.MediaType("")).Default(string(enum.MediaTypeImage)),
 		field.Time("created_at").Default(time.Now),
 		field.Time("deleted_at").Optional(),
+		field.Time("hidden_at").Optional().Nillable().Comment("通報数が閾値に達して自動的に非表示になった日時"),
+		field.Time("edited_at").Optional().Nillable().Comment("最後に編集された日時"),
+		// 件数はいいね・コメントと同じトランザクションで更新し、ずれたら manage reconcile-counters で直す
+		field.Int("like_count").Default(0),
+		field.Int("comment_count").Default(0).Comment("削除されていないコメントと返信の数。通報で非表示のものも含む"),
 	}
 }
 
@@ -34,5 +41,16 @@ func (Post) Edges() []ent.Edge {
 		edge.To("comments", Comment.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("likes", Like.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("daily_task", DailyTask.Type).Unique(),
+		// 投稿が削除されても通報の記録は残す
+		edge.To("reports", Report.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("explore_ranking", ExploreRanking.Type).Unique().Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", PostRevision.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("media", PostMedia.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 写っているペット。投稿者自身のペットのみタグ付けできる
+		edge.To("pets", Pet.Type),
+		edge.To("tags", Tag.Type).Through("post_tags", PostTag.Type),
+		// キャプションでメンションされたユーザー。ブロック関係にあるユーザーは含まない
+		edge.To("mentions", User.Type),
+		edge.To("notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
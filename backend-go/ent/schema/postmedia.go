@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+)
+
+// PostMedia holds the schema definition for the PostMedia entity.
+// 投稿のカルーセルの1枚。position の順に表示する。動画の投稿は動画1本だけを持つ。
+type PostMedia struct {
+	ent.Schema
+}
+
+// Fields of the PostMedia.
+func (PostMedia) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Int("position").Min(0).Comment("0 始まりの表示順"),
+		field.Enum("type").GoType(enum.MediaType("")).Default(string(enum.MediaTypeImage)),
+		field.String("key").NotEmpty().Comment("ストレージ上のキー"),
+		field.Int("width").Optional().Nillable().Comment("画像から読み取れなかった場合は NULL"),
+		field.Int("height").Optional().Nillable(),
+		field.String("alt_text").Default(""),
+		field.Int("duration_ms").Optional().Nillable().Comment("動画の長さ"),
+		field.String("poster_key").Optional().Comment("動画のポスター画像のキー"),
+	}
+}
+
+// Edges of the PostMedia.
+func (PostMedia) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("media").Unique().Required(),
+	}
+}
+
+// Indexes of the PostMedia.
+func (PostMedia) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").Edges("post").Unique(),
+	}
+}
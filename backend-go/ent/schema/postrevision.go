@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PostRevision holds the schema definition for the PostRevision entity.
+// 投稿を編集するたびに、編集前のキャプションとタグ付けされていたペットを残す。
+type PostRevision struct {
+	ent.Schema
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("caption").Comment("編集前のキャプション"),
+		field.JSON("pet_ids", []uuid.UUID{}).Optional().Comment("編集前にタグ付けされていたペットのID"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("編集された日時"),
+	}
+}
+
+// Edges of the PostRevision.
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("revisions").Unique().Required(),
+	}
+}
+
+// Indexes of the PostRevision.
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").Edges("post"),
+	}
+}
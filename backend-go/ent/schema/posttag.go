@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PostTag holds the schema definition for the PostTag entity.
+// 投稿とハッシュタグの関連。トレンドの計算のため、タグが付いた日時を持つ。
+type PostTag struct {
+	ent.Schema
+}
+
+// Annotations of the PostTag.
+func (PostTag) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("post_id", "tag_id"),
+	}
+}
+
+// Fields of the PostTag.
+func (PostTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("post_id", uuid.UUID{}),
+		field.UUID("tag_id", uuid.UUID{}),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("投稿、または編集でタグが付いた日時"),
+	}
+}
+
+// Edges of the PostTag.
+func (PostTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("post", Post.Type).Unique().Required().Field("post_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tag", Tag.Type).Unique().Required().Field("tag_id").Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the PostTag.
+func (PostTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at", "tag_id"),
+	}
+}
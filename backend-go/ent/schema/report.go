@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+)
+
+// Report holds the schema definition for the Report entity.
+// ユーザーからの通報。モデレーターが確認して対応済み/却下にする。
+type Report struct {
+	ent.Schema
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Enum("target_type").GoType(enum.ReportTargetType("")),
+		field.UUID("post_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("comment_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("target_user_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("reporter_id", uuid.UUID{}),
+		field.Enum("reason").GoType(enum.ReportReason("")),
+		field.String("details").Default("").MaxLen(1000),
+		field.Enum("status").GoType(enum.ReportStatus("")).Default(string(enum.ReportStatusOpen)),
+		field.UUID("resolver_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("resolution_note").Default("").MaxLen(1000),
+		field.Time("created_at").Default(time.Now),
+		field.Time("resolved_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Report.
+func (Report) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).Ref("reports").Field("post_id").Unique(),
+		edge.From("comment", Comment.Type).Ref("reports").Field("comment_id").Unique(),
+		edge.From("target_user", User.Type).Ref("reports_received").Field("target_user_id").Unique(),
+		edge.From("reporter", User.Type).Ref("reports_filed").Field("reporter_id").Unique().Required(),
+		edge.From("resolver", User.Type).Ref("reports_resolved").Field("resolver_id").Unique(),
+	}
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("reporter_id", "target_type"),
+		// 同じ通報者から同じ対象への未対応の通報は1件だけ。同時に送られても重複しない
+		index.Fields("reporter_id", "target_type", "post_id").Unique().
+			Annotations(entsql.IndexWhere("status = 'open'")),
+		index.Fields("reporter_id", "target_type", "comment_id").Unique().
+			Annotations(entsql.IndexWhere("status = 'open'")),
+		index.Fields("reporter_id", "target_type", "target_user_id").Unique().
+			Annotations(entsql.IndexWhere("status = 'open'")),
+	}
+}
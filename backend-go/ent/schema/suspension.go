@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Suspension holds the schema definition for the Suspension entity.
+// 利用停止期間中のユーザーは API を利用できず、投稿やコメントも表示されない。
+type Suspension struct {
+	ent.Schema
+}
+
+// Fields of the Suspension.
+func (Suspension) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("reason").NotEmpty().MaxLen(1000),
+		field.Time("starts_at").Default(time.Now),
+		field.Time("ends_at"),
+		field.UUID("issued_by_id", uuid.UUID{}).Optional().Nillable(),
+		field.Time("lifted_at").Optional().Nillable().Comment("期間満了前に解除された日時"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Suspension.
+func (Suspension) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("suspensions").Field("user_id").Unique().Required(),
+		edge.From("issued_by", User.Type).Ref("suspensions_issued").Field("issued_by_id").Unique(),
+	}
+}
+
+// Indexes of the Suspension.
+func (Suspension) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "ends_at"),
+	}
+}
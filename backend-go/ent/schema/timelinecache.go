@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TimelineCache holds the schema definition for the TimelineCache entity.
+// 推薦タイムラインの投稿IDをユーザーごとに保存し、Lambda の各インスタンスで共有する。
+type TimelineCache struct {
+	ent.Schema
+}
+
+// Fields of the TimelineCache.
+func (TimelineCache) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("user_id", uuid.UUID{}).Unique(),
+		field.JSON("post_ids", []uuid.UUID{}),
+		field.Time("expires_at"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the TimelineCache.
+func (TimelineCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TokenRevocation holds the schema definition for the TokenRevocation entity.
+// サインアウトしたユーザーのアクセストークンを拒否するための記録。Lambda の各インスタンスで共有する。
+type TokenRevocation struct {
+	ent.Schema
+}
+
+// Fields of the TokenRevocation.
+func (TokenRevocation) Fields() []ent.Field {
+	return []ent.Field{
+		// Cognito の sub。認証のたびに主キーで引く
+		field.String("id").NotEmpty().Unique().Immutable(),
+		field.Time("revoked_at").Comment("この時刻までに発行されたアクセストークンを拒否する"),
+		field.Time("expires_at").Comment("これ以降は対象のトークンも期限切れなので記録は不要"),
+	}
+}
+
+// Indexes of the TokenRevocation.
+func (TokenRevocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
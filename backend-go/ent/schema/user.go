@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
@@ -21,11 +22,19 @@ func (User) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
 		field.Uint32("index").Immutable().Unique().Optional(),
 		field.String("email").NotEmpty().Unique(),
+		field.String("auth_subject").Optional().Unique().Comment("認証プロバイダ上のユーザー識別子 (sub)"),
 		field.String("name").NotEmpty(),
+		field.String("handle").Optional().Unique().Comment("プロフィールURLなどに使う変更可能な一意のID。既存ユーザーは manage backfill-handles で付与する"),
 		field.String("bio").Default(""),
 		field.Uint32("streak_count").Default(0),
+		field.String("role").GoType(enum.Role("")).Default(string(enum.RoleUser)),
 		field.String("icon_image_key").Optional(),
+		field.Bool("is_private").Default(false).Comment("非公開アカウントは承認したフォロワーにだけ投稿を公開する"),
 		field.Time("created_at").Default(time.Now),
+		// 件数はフォロー・投稿と同じトランザクションで更新し、ずれたら manage reconcile-counters で直す
+		field.Int("follower_count").Default(0),
+		field.Int("following_count").Default(0),
+		field.Int("post_count").Default(0).Comment("削除されていない投稿の数"),
 	}
 }
 
@@ -42,5 +51,20 @@ func (User) Edges() []ent.Edge {
 		edge.To("blocked_by", BlockRelation.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("daily_tasks", DailyTask.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("device_tokens", DeviceToken.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reports_filed", Report.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reports_received", Report.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("reports_resolved", Report.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("suspensions", Suspension.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("suspensions_issued", Suspension.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("muting", MuteRelation.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("muted_by", MuteRelation.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("muted_keywords", MutedKeyword.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("follow_requests_sent", FollowRequest.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("follow_requests_received", FollowRequest.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("mentioned_in_posts", Post.Type).Ref("mentions"),
+		edge.From("mentioned_in_comments", Comment.Type).Ref("mentions"),
+		edge.To("notifications", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("notifications_sent", Notification.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comment_likes", CommentLike.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// VerificationCode holds the schema definition for the VerificationCode entity.
+// メール認証・パスワードリセットでメール送信する確認コード。
+type VerificationCode struct {
+	ent.Schema
+}
+
+// Fields of the VerificationCode.
+func (VerificationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("email").NotEmpty(),
+		field.Enum("purpose").Values("sign_up", "reset_password"),
+		field.String("code_hash").NotEmpty().Sensitive(),
+		field.Int("attempts").Default(0),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the VerificationCode.
+func (VerificationCode) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the VerificationCode.
+func (VerificationCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email", "purpose").Unique(),
+	}
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// Suspension is the model entity for the Suspension schema.
+type Suspension struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// IssuedByID holds the value of the "issued_by_id" field.
+	IssuedByID *uuid.UUID `json:"issued_by_id,omitempty"`
+	// 期間満了前に解除された日時
+	LiftedAt *time.Time `json:"lifted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SuspensionQuery when eager-loading is set.
+	Edges        SuspensionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SuspensionEdges holds the relations/edges for other nodes in the graph.
+type SuspensionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// IssuedBy holds the value of the issued_by edge.
+	IssuedBy *User `json:"issued_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SuspensionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// IssuedByOrErr returns the IssuedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SuspensionEdges) IssuedByOrErr() (*User, error) {
+	if e.IssuedBy != nil {
+		return e.IssuedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "issued_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Suspension) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case suspension.FieldIssuedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case suspension.FieldReason:
+			values[i] = new(sql.NullString)
+		case suspension.FieldStartsAt, suspension.FieldEndsAt, suspension.FieldLiftedAt, suspension.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case suspension.FieldID, suspension.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Suspension fields.
+func (s *Suspension) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case suspension.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case suspension.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				s.UserID = *value
+			}
+		case suspension.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				s.Reason = value.String
+			}
+		case suspension.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				s.StartsAt = value.Time
+			}
+		case suspension.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				s.EndsAt = value.Time
+			}
+		case suspension.FieldIssuedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_by_id", values[i])
+			} else if value.Valid {
+				s.IssuedByID = new(uuid.UUID)
+				*s.IssuedByID = *value.S.(*uuid.UUID)
+			}
+		case suspension.FieldLiftedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lifted_at", values[i])
+			} else if value.Valid {
+				s.LiftedAt = new(time.Time)
+				*s.LiftedAt = value.Time
+			}
+		case suspension.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Suspension.
+// This includes values selected through modifiers, order, etc.
+func (s *Suspension) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Suspension entity.
+func (s *Suspension) QueryUser() *UserQuery {
+	return NewSuspensionClient(s.config).QueryUser(s)
+}
+
+// QueryIssuedBy queries the "issued_by" edge of the Suspension entity.
+func (s *Suspension) QueryIssuedBy() *UserQuery {
+	return NewSuspensionClient(s.config).QueryIssuedBy(s)
+}
+
+// Update returns a builder for updating this Suspension.
+// Note that you need to call Suspension.Unwrap() before calling this method if this Suspension
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Suspension) Update() *SuspensionUpdateOne {
+	return NewSuspensionClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Suspension entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Suspension) Unwrap() *Suspension {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Suspension is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Suspension) String() string {
+	var builder strings.Builder
+	builder.WriteString("Suspension(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(s.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(s.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(s.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.IssuedByID; v != nil {
+		builder.WriteString("issued_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := s.LiftedAt; v != nil {
+		builder.WriteString("lifted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Suspensions is a parsable slice of Suspension.
+type Suspensions []*Suspension
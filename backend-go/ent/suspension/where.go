@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package suspension
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldUserID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldReason, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// IssuedByID applies equality check predicate on the "issued_by_id" field. It's identical to IssuedByIDEQ.
+func IssuedByID(v uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldIssuedByID, v))
+}
+
+// LiftedAt applies equality check predicate on the "lifted_at" field. It's identical to LiftedAtEQ.
+func LiftedAt(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldLiftedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Suspension {
+	return predicate.Suspension(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// IssuedByIDEQ applies the EQ predicate on the "issued_by_id" field.
+func IssuedByIDEQ(v uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldIssuedByID, v))
+}
+
+// IssuedByIDNEQ applies the NEQ predicate on the "issued_by_id" field.
+func IssuedByIDNEQ(v uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldIssuedByID, v))
+}
+
+// IssuedByIDIn applies the In predicate on the "issued_by_id" field.
+func IssuedByIDIn(vs ...uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldIssuedByID, vs...))
+}
+
+// IssuedByIDNotIn applies the NotIn predicate on the "issued_by_id" field.
+func IssuedByIDNotIn(vs ...uuid.UUID) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldIssuedByID, vs...))
+}
+
+// IssuedByIDIsNil applies the IsNil predicate on the "issued_by_id" field.
+func IssuedByIDIsNil() predicate.Suspension {
+	return predicate.Suspension(sql.FieldIsNull(FieldIssuedByID))
+}
+
+// IssuedByIDNotNil applies the NotNil predicate on the "issued_by_id" field.
+func IssuedByIDNotNil() predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotNull(FieldIssuedByID))
+}
+
+// LiftedAtEQ applies the EQ predicate on the "lifted_at" field.
+func LiftedAtEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldLiftedAt, v))
+}
+
+// LiftedAtNEQ applies the NEQ predicate on the "lifted_at" field.
+func LiftedAtNEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldLiftedAt, v))
+}
+
+// LiftedAtIn applies the In predicate on the "lifted_at" field.
+func LiftedAtIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldLiftedAt, vs...))
+}
+
+// LiftedAtNotIn applies the NotIn predicate on the "lifted_at" field.
+func LiftedAtNotIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldLiftedAt, vs...))
+}
+
+// LiftedAtGT applies the GT predicate on the "lifted_at" field.
+func LiftedAtGT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGT(FieldLiftedAt, v))
+}
+
+// LiftedAtGTE applies the GTE predicate on the "lifted_at" field.
+func LiftedAtGTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGTE(FieldLiftedAt, v))
+}
+
+// LiftedAtLT applies the LT predicate on the "lifted_at" field.
+func LiftedAtLT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLT(FieldLiftedAt, v))
+}
+
+// LiftedAtLTE applies the LTE predicate on the "lifted_at" field.
+func LiftedAtLTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLTE(FieldLiftedAt, v))
+}
+
+// LiftedAtIsNil applies the IsNil predicate on the "lifted_at" field.
+func LiftedAtIsNil() predicate.Suspension {
+	return predicate.Suspension(sql.FieldIsNull(FieldLiftedAt))
+}
+
+// LiftedAtNotNil applies the NotNil predicate on the "lifted_at" field.
+func LiftedAtNotNil() predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotNull(FieldLiftedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Suspension {
+	return predicate.Suspension(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Suspension {
+	return predicate.Suspension(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Suspension {
+	return predicate.Suspension(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIssuedBy applies the HasEdge predicate on the "issued_by" edge.
+func HasIssuedBy() predicate.Suspension {
+	return predicate.Suspension(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, IssuedByTable, IssuedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIssuedByWith applies the HasEdge predicate on the "issued_by" edge with a given conditions (other predicates).
+func HasIssuedByWith(preds ...predicate.User) predicate.Suspension {
+	return predicate.Suspension(func(s *sql.Selector) {
+		step := newIssuedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Suspension) predicate.Suspension {
+	return predicate.Suspension(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Suspension) predicate.Suspension {
+	return predicate.Suspension(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Suspension) predicate.Suspension {
+	return predicate.Suspension(sql.NotPredicates(p))
+}
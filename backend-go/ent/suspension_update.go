@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// SuspensionUpdate is the builder for updating Suspension entities.
+type SuspensionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SuspensionMutation
+}
+
+// Where appends a list predicates to the SuspensionUpdate builder.
+func (su *SuspensionUpdate) Where(ps ...predicate.Suspension) *SuspensionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUserID sets the "user_id" field.
+func (su *SuspensionUpdate) SetUserID(u uuid.UUID) *SuspensionUpdate {
+	su.mutation.SetUserID(u)
+	return su
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableUserID(u *uuid.UUID) *SuspensionUpdate {
+	if u != nil {
+		su.SetUserID(*u)
+	}
+	return su
+}
+
+// SetReason sets the "reason" field.
+func (su *SuspensionUpdate) SetReason(s string) *SuspensionUpdate {
+	su.mutation.SetReason(s)
+	return su
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableReason(s *string) *SuspensionUpdate {
+	if s != nil {
+		su.SetReason(*s)
+	}
+	return su
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (su *SuspensionUpdate) SetStartsAt(t time.Time) *SuspensionUpdate {
+	su.mutation.SetStartsAt(t)
+	return su
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableStartsAt(t *time.Time) *SuspensionUpdate {
+	if t != nil {
+		su.SetStartsAt(*t)
+	}
+	return su
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (su *SuspensionUpdate) SetEndsAt(t time.Time) *SuspensionUpdate {
+	su.mutation.SetEndsAt(t)
+	return su
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableEndsAt(t *time.Time) *SuspensionUpdate {
+	if t != nil {
+		su.SetEndsAt(*t)
+	}
+	return su
+}
+
+// SetIssuedByID sets the "issued_by_id" field.
+func (su *SuspensionUpdate) SetIssuedByID(u uuid.UUID) *SuspensionUpdate {
+	su.mutation.SetIssuedByID(u)
+	return su
+}
+
+// SetNillableIssuedByID sets the "issued_by_id" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableIssuedByID(u *uuid.UUID) *SuspensionUpdate {
+	if u != nil {
+		su.SetIssuedByID(*u)
+	}
+	return su
+}
+
+// ClearIssuedByID clears the value of the "issued_by_id" field.
+func (su *SuspensionUpdate) ClearIssuedByID() *SuspensionUpdate {
+	su.mutation.ClearIssuedByID()
+	return su
+}
+
+// SetLiftedAt sets the "lifted_at" field.
+func (su *SuspensionUpdate) SetLiftedAt(t time.Time) *SuspensionUpdate {
+	su.mutation.SetLiftedAt(t)
+	return su
+}
+
+// SetNillableLiftedAt sets the "lifted_at" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableLiftedAt(t *time.Time) *SuspensionUpdate {
+	if t != nil {
+		su.SetLiftedAt(*t)
+	}
+	return su
+}
+
+// ClearLiftedAt clears the value of the "lifted_at" field.
+func (su *SuspensionUpdate) ClearLiftedAt() *SuspensionUpdate {
+	su.mutation.ClearLiftedAt()
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *SuspensionUpdate) SetCreatedAt(t time.Time) *SuspensionUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *SuspensionUpdate) SetNillableCreatedAt(t *time.Time) *SuspensionUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (su *SuspensionUpdate) SetUser(u *User) *SuspensionUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// SetIssuedBy sets the "issued_by" edge to the User entity.
+func (su *SuspensionUpdate) SetIssuedBy(u *User) *SuspensionUpdate {
+	return su.SetIssuedByID(u.ID)
+}
+
+// Mutation returns the SuspensionMutation object of the builder.
+func (su *SuspensionUpdate) Mutation() *SuspensionMutation {
+	return su.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (su *SuspensionUpdate) ClearUser() *SuspensionUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// ClearIssuedBy clears the "issued_by" edge to the User entity.
+func (su *SuspensionUpdate) ClearIssuedBy() *SuspensionUpdate {
+	su.mutation.ClearIssuedBy()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SuspensionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SuspensionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SuspensionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SuspensionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SuspensionUpdate) check() error {
+	if v, ok := su.mutation.Reason(); ok {
+		if err := suspension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Suspension.reason": %w`, err)}
+		}
+	}
+	if su.mutation.UserCleared() && len(su.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Suspension.user"`)
+	}
+	return nil
+}
+
+func (su *SuspensionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(suspension.Table, suspension.Columns, sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Reason(); ok {
+		_spec.SetField(suspension.FieldReason, field.TypeString, value)
+	}
+	if value, ok := su.mutation.StartsAt(); ok {
+		_spec.SetField(suspension.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.EndsAt(); ok {
+		_spec.SetField(suspension.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.LiftedAt(); ok {
+		_spec.SetField(suspension.FieldLiftedAt, field.TypeTime, value)
+	}
+	if su.mutation.LiftedAtCleared() {
+		_spec.ClearField(suspension.FieldLiftedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(suspension.FieldCreatedAt, field.TypeTime, value)
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.UserTable,
+			Columns: []string{suspension.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.UserTable,
+			Columns: []string{suspension.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.IssuedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.IssuedByTable,
+			Columns: []string{suspension.IssuedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.IssuedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.IssuedByTable,
+			Columns: []string{suspension.IssuedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{suspension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SuspensionUpdateOne is the builder for updating a single Suspension entity.
+type SuspensionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SuspensionMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (suo *SuspensionUpdateOne) SetUserID(u uuid.UUID) *SuspensionUpdateOne {
+	suo.mutation.SetUserID(u)
+	return suo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableUserID(u *uuid.UUID) *SuspensionUpdateOne {
+	if u != nil {
+		suo.SetUserID(*u)
+	}
+	return suo
+}
+
+// SetReason sets the "reason" field.
+func (suo *SuspensionUpdateOne) SetReason(s string) *SuspensionUpdateOne {
+	suo.mutation.SetReason(s)
+	return suo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableReason(s *string) *SuspensionUpdateOne {
+	if s != nil {
+		suo.SetReason(*s)
+	}
+	return suo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (suo *SuspensionUpdateOne) SetStartsAt(t time.Time) *SuspensionUpdateOne {
+	suo.mutation.SetStartsAt(t)
+	return suo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableStartsAt(t *time.Time) *SuspensionUpdateOne {
+	if t != nil {
+		suo.SetStartsAt(*t)
+	}
+	return suo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (suo *SuspensionUpdateOne) SetEndsAt(t time.Time) *SuspensionUpdateOne {
+	suo.mutation.SetEndsAt(t)
+	return suo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableEndsAt(t *time.Time) *SuspensionUpdateOne {
+	if t != nil {
+		suo.SetEndsAt(*t)
+	}
+	return suo
+}
+
+// SetIssuedByID sets the "issued_by_id" field.
+func (suo *SuspensionUpdateOne) SetIssuedByID(u uuid.UUID) *SuspensionUpdateOne {
+	suo.mutation.SetIssuedByID(u)
+	return suo
+}
+
+// SetNillableIssuedByID sets the "issued_by_id" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableIssuedByID(u *uuid.UUID) *SuspensionUpdateOne {
+	if u != nil {
+		suo.SetIssuedByID(*u)
+	}
+	return suo
+}
+
+// ClearIssuedByID clears the value of the "issued_by_id" field.
+func (suo *SuspensionUpdateOne) ClearIssuedByID() *SuspensionUpdateOne {
+	suo.mutation.ClearIssuedByID()
+	return suo
+}
+
+// SetLiftedAt sets the "lifted_at" field.
+func (suo *SuspensionUpdateOne) SetLiftedAt(t time.Time) *SuspensionUpdateOne {
+	suo.mutation.SetLiftedAt(t)
+	return suo
+}
+
+// SetNillableLiftedAt sets the "lifted_at" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableLiftedAt(t *time.Time) *SuspensionUpdateOne {
+	if t != nil {
+		suo.SetLiftedAt(*t)
+	}
+	return suo
+}
+
+// ClearLiftedAt clears the value of the "lifted_at" field.
+func (suo *SuspensionUpdateOne) ClearLiftedAt() *SuspensionUpdateOne {
+	suo.mutation.ClearLiftedAt()
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *SuspensionUpdateOne) SetCreatedAt(t time.Time) *SuspensionUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *SuspensionUpdateOne) SetNillableCreatedAt(t *time.Time) *SuspensionUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (suo *SuspensionUpdateOne) SetUser(u *User) *SuspensionUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// SetIssuedBy sets the "issued_by" edge to the User entity.
+func (suo *SuspensionUpdateOne) SetIssuedBy(u *User) *SuspensionUpdateOne {
+	return suo.SetIssuedByID(u.ID)
+}
+
+// Mutation returns the SuspensionMutation object of the builder.
+func (suo *SuspensionUpdateOne) Mutation() *SuspensionMutation {
+	return suo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (suo *SuspensionUpdateOne) ClearUser() *SuspensionUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// ClearIssuedBy clears the "issued_by" edge to the User entity.
+func (suo *SuspensionUpdateOne) ClearIssuedBy() *SuspensionUpdateOne {
+	suo.mutation.ClearIssuedBy()
+	return suo
+}
+
+// Where appends a list predicates to the SuspensionUpdate builder.
+func (suo *SuspensionUpdateOne) Where(ps ...predicate.Suspension) *SuspensionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SuspensionUpdateOne) Select(field string, fields ...string) *SuspensionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Suspension entity.
+func (suo *SuspensionUpdateOne) Save(ctx context.Context) (*Suspension, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SuspensionUpdateOne) SaveX(ctx context.Context) *Suspension {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SuspensionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SuspensionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SuspensionUpdateOne) check() error {
+	if v, ok := suo.mutation.Reason(); ok {
+		if err := suspension.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Suspension.reason": %w`, err)}
+		}
+	}
+	if suo.mutation.UserCleared() && len(suo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Suspension.user"`)
+	}
+	return nil
+}
+
+func (suo *SuspensionUpdateOne) sqlSave(ctx context.Context) (_node *Suspension, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(suspension.Table, suspension.Columns, sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Suspension.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, suspension.FieldID)
+		for _, f := range fields {
+			if !suspension.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != suspension.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Reason(); ok {
+		_spec.SetField(suspension.FieldReason, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.StartsAt(); ok {
+		_spec.SetField(suspension.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.EndsAt(); ok {
+		_spec.SetField(suspension.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.LiftedAt(); ok {
+		_spec.SetField(suspension.FieldLiftedAt, field.TypeTime, value)
+	}
+	if suo.mutation.LiftedAtCleared() {
+		_spec.ClearField(suspension.FieldLiftedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(suspension.FieldCreatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.UserTable,
+			Columns: []string{suspension.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.UserTable,
+			Columns: []string{suspension.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.IssuedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.IssuedByTable,
+			Columns: []string{suspension.IssuedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.IssuedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   suspension.IssuedByTable,
+			Columns: []string{suspension.IssuedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Suspension{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{suspension.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/google/uuid"
+)
+
+// TimelineCache is the model entity for the TimelineCache schema.
+type TimelineCache struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// PostIds holds the value of the "post_ids" field.
+	PostIds []uuid.UUID `json:"post_ids,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TimelineCache) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case timelinecache.FieldPostIds:
+			values[i] = new([]byte)
+		case timelinecache.FieldExpiresAt, timelinecache.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case timelinecache.FieldID, timelinecache.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TimelineCache fields.
+func (tc *TimelineCache) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case timelinecache.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tc.ID = *value
+			}
+		case timelinecache.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				tc.UserID = *value
+			}
+		case timelinecache.FieldPostIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field post_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tc.PostIds); err != nil {
+					return fmt.Errorf("unmarshal field post_ids: %w", err)
+				}
+			}
+		case timelinecache.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				tc.ExpiresAt = value.Time
+			}
+		case timelinecache.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tc.UpdatedAt = value.Time
+			}
+		default:
+			tc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TimelineCache.
+// This includes values selected through modifiers, order, etc.
+func (tc *TimelineCache) Value(name string) (ent.Value, error) {
+	return tc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TimelineCache.
+// Note that you need to call TimelineCache.Unwrap() before calling this method if this TimelineCache
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tc *TimelineCache) Update() *TimelineCacheUpdateOne {
+	return NewTimelineCacheClient(tc.config).UpdateOne(tc)
+}
+
+// Unwrap unwraps the TimelineCache entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tc *TimelineCache) Unwrap() *TimelineCache {
+	_tx, ok := tc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TimelineCache is not a transactional entity")
+	}
+	tc.config.driver = _tx.drv
+	return tc
+}
+
+// String implements the fmt.Stringer.
+func (tc *TimelineCache) String() string {
+	var builder strings.Builder
+	builder.WriteString("TimelineCache(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("post_ids=")
+	builder.WriteString(fmt.Sprintf("%v", tc.PostIds))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(tc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TimelineCaches is a parsable slice of TimelineCache.
+type TimelineCaches []*TimelineCache
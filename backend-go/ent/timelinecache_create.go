@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/google/uuid"
+)
+
+// TimelineCacheCreate is the builder for creating a TimelineCache entity.
+type TimelineCacheCreate struct {
+	config
+	mutation *TimelineCacheMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (tcc *TimelineCacheCreate) SetUserID(u uuid.UUID) *TimelineCacheCreate {
+	tcc.mutation.SetUserID(u)
+	return tcc
+}
+
+// SetPostIds sets the "post_ids" field.
+func (tcc *TimelineCacheCreate) SetPostIds(u []uuid.UUID) *TimelineCacheCreate {
+	tcc.mutation.SetPostIds(u)
+	return tcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tcc *TimelineCacheCreate) SetExpiresAt(t time.Time) *TimelineCacheCreate {
+	tcc.mutation.SetExpiresAt(t)
+	return tcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcc *TimelineCacheCreate) SetUpdatedAt(t time.Time) *TimelineCacheCreate {
+	tcc.mutation.SetUpdatedAt(t)
+	return tcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tcc *TimelineCacheCreate) SetNillableUpdatedAt(t *time.Time) *TimelineCacheCreate {
+	if t != nil {
+		tcc.SetUpdatedAt(*t)
+	}
+	return tcc
+}
+
+// SetID sets the "id" field.
+func (tcc *TimelineCacheCreate) SetID(u uuid.UUID) *TimelineCacheCreate {
+	tcc.mutation.SetID(u)
+	return tcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tcc *TimelineCacheCreate) SetNillableID(u *uuid.UUID) *TimelineCacheCreate {
+	if u != nil {
+		tcc.SetID(*u)
+	}
+	return tcc
+}
+
+// Mutation returns the TimelineCacheMutation object of the builder.
+func (tcc *TimelineCacheCreate) Mutation() *TimelineCacheMutation {
+	return tcc.mutation
+}
+
+// Save creates the TimelineCache in the database.
+func (tcc *TimelineCacheCreate) Save(ctx context.Context) (*TimelineCache, error) {
+	tcc.defaults()
+	return withHooks(ctx, tcc.sqlSave, tcc.mutation, tcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tcc *TimelineCacheCreate) SaveX(ctx context.Context) *TimelineCache {
+	v, err := tcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcc *TimelineCacheCreate) Exec(ctx context.Context) error {
+	_, err := tcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcc *TimelineCacheCreate) ExecX(ctx context.Context) {
+	if err := tcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcc *TimelineCacheCreate) defaults() {
+	if _, ok := tcc.mutation.UpdatedAt(); !ok {
+		v := timelinecache.DefaultUpdatedAt()
+		tcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tcc.mutation.ID(); !ok {
+		v := timelinecache.DefaultID()
+		tcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcc *TimelineCacheCreate) check() error {
+	if _, ok := tcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TimelineCache.user_id"`)}
+	}
+	if _, ok := tcc.mutation.PostIds(); !ok {
+		return &ValidationError{Name: "post_ids", err: errors.New(`ent: missing required field "TimelineCache.post_ids"`)}
+	}
+	if _, ok := tcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TimelineCache.expires_at"`)}
+	}
+	if _, ok := tcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TimelineCache.updated_at"`)}
+	}
+	return nil
+}
+
+func (tcc *TimelineCacheCreate) sqlSave(ctx context.Context) (*TimelineCache, error) {
+	if err := tcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tcc.mutation.id = &_node.ID
+	tcc.mutation.done = true
+	return _node, nil
+}
+
+func (tcc *TimelineCacheCreate) createSpec() (*TimelineCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TimelineCache{config: tcc.config}
+		_spec = sqlgraph.NewCreateSpec(timelinecache.Table, sqlgraph.NewFieldSpec(timelinecache.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tcc.conflict
+	if id, ok := tcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tcc.mutation.UserID(); ok {
+		_spec.SetField(timelinecache.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := tcc.mutation.PostIds(); ok {
+		_spec.SetField(timelinecache.FieldPostIds, field.TypeJSON, value)
+		_node.PostIds = value
+	}
+	if value, ok := tcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(timelinecache.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := tcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(timelinecache.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimelineCache.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimelineCacheUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (tcc *TimelineCacheCreate) OnConflict(opts ...sql.ConflictOption) *TimelineCacheUpsertOne {
+	tcc.conflict = opts
+	return &TimelineCacheUpsertOne{
+		create: tcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimelineCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcc *TimelineCacheCreate) OnConflictColumns(columns ...string) *TimelineCacheUpsertOne {
+	tcc.conflict = append(tcc.conflict, sql.ConflictColumns(columns...))
+	return &TimelineCacheUpsertOne{
+		create: tcc,
+	}
+}
+
+type (
+	// TimelineCacheUpsertOne is the builder for "upsert"-ing
+	//  one TimelineCache node.
+	TimelineCacheUpsertOne struct {
+		create *TimelineCacheCreate
+	}
+
+	// TimelineCacheUpsert is the "OnConflict" setter.
+	TimelineCacheUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *TimelineCacheUpsert) SetUserID(v uuid.UUID) *TimelineCacheUpsert {
+	u.Set(timelinecache.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TimelineCacheUpsert) UpdateUserID() *TimelineCacheUpsert {
+	u.SetExcluded(timelinecache.FieldUserID)
+	return u
+}
+
+// SetPostIds sets the "post_ids" field.
+func (u *TimelineCacheUpsert) SetPostIds(v []uuid.UUID) *TimelineCacheUpsert {
+	u.Set(timelinecache.FieldPostIds, v)
+	return u
+}
+
+// UpdatePostIds sets the "post_ids" field to the value that was provided on create.
+func (u *TimelineCacheUpsert) UpdatePostIds() *TimelineCacheUpsert {
+	u.SetExcluded(timelinecache.FieldPostIds)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TimelineCacheUpsert) SetExpiresAt(v time.Time) *TimelineCacheUpsert {
+	u.Set(timelinecache.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TimelineCacheUpsert) UpdateExpiresAt() *TimelineCacheUpsert {
+	u.SetExcluded(timelinecache.FieldExpiresAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TimelineCacheUpsert) SetUpdatedAt(v time.Time) *TimelineCacheUpsert {
+	u.Set(timelinecache.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TimelineCacheUpsert) UpdateUpdatedAt() *TimelineCacheUpsert {
+	u.SetExcluded(timelinecache.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TimelineCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(timelinecache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TimelineCacheUpsertOne) UpdateNewValues() *TimelineCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(timelinecache.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimelineCache.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TimelineCacheUpsertOne) Ignore() *TimelineCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimelineCacheUpsertOne) DoNothing() *TimelineCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimelineCacheCreate.OnConflict
+// documentation for more info.
+func (u *TimelineCacheUpsertOne) Update(set func(*TimelineCacheUpsert)) *TimelineCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimelineCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TimelineCacheUpsertOne) SetUserID(v uuid.UUID) *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TimelineCacheUpsertOne) UpdateUserID() *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostIds sets the "post_ids" field.
+func (u *TimelineCacheUpsertOne) SetPostIds(v []uuid.UUID) *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetPostIds(v)
+	})
+}
+
+// UpdatePostIds sets the "post_ids" field to the value that was provided on create.
+func (u *TimelineCacheUpsertOne) UpdatePostIds() *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdatePostIds()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TimelineCacheUpsertOne) SetExpiresAt(v time.Time) *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TimelineCacheUpsertOne) UpdateExpiresAt() *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TimelineCacheUpsertOne) SetUpdatedAt(v time.Time) *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TimelineCacheUpsertOne) UpdateUpdatedAt() *TimelineCacheUpsertOne {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TimelineCacheUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimelineCacheCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimelineCacheUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TimelineCacheUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TimelineCacheUpsertOne.ID is not supported by MySQL driver. Use TimelineCacheUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TimelineCacheUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TimelineCacheCreateBulk is the builder for creating many TimelineCache entities in bulk.
+type TimelineCacheCreateBulk struct {
+	config
+	err      error
+	builders []*TimelineCacheCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TimelineCache entities in the database.
+func (tccb *TimelineCacheCreateBulk) Save(ctx context.Context) ([]*TimelineCache, error) {
+	if tccb.err != nil {
+		return nil, tccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tccb.builders))
+	nodes := make([]*TimelineCache, len(tccb.builders))
+	mutators := make([]Mutator, len(tccb.builders))
+	for i := range tccb.builders {
+		func(i int, root context.Context) {
+			builder := tccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TimelineCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tccb *TimelineCacheCreateBulk) SaveX(ctx context.Context) []*TimelineCache {
+	v, err := tccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tccb *TimelineCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := tccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tccb *TimelineCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := tccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimelineCache.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimelineCacheUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (tccb *TimelineCacheCreateBulk) OnConflict(opts ...sql.ConflictOption) *TimelineCacheUpsertBulk {
+	tccb.conflict = opts
+	return &TimelineCacheUpsertBulk{
+		create: tccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimelineCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tccb *TimelineCacheCreateBulk) OnConflictColumns(columns ...string) *TimelineCacheUpsertBulk {
+	tccb.conflict = append(tccb.conflict, sql.ConflictColumns(columns...))
+	return &TimelineCacheUpsertBulk{
+		create: tccb,
+	}
+}
+
+// TimelineCacheUpsertBulk is the builder for "upsert"-ing
+// a bulk of TimelineCache nodes.
+type TimelineCacheUpsertBulk struct {
+	create *TimelineCacheCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TimelineCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(timelinecache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TimelineCacheUpsertBulk) UpdateNewValues() *TimelineCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(timelinecache.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimelineCache.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TimelineCacheUpsertBulk) Ignore() *TimelineCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimelineCacheUpsertBulk) DoNothing() *TimelineCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimelineCacheCreateBulk.OnConflict
+// documentation for more info.
+func (u *TimelineCacheUpsertBulk) Update(set func(*TimelineCacheUpsert)) *TimelineCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimelineCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TimelineCacheUpsertBulk) SetUserID(v uuid.UUID) *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TimelineCacheUpsertBulk) UpdateUserID() *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPostIds sets the "post_ids" field.
+func (u *TimelineCacheUpsertBulk) SetPostIds(v []uuid.UUID) *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetPostIds(v)
+	})
+}
+
+// UpdatePostIds sets the "post_ids" field to the value that was provided on create.
+func (u *TimelineCacheUpsertBulk) UpdatePostIds() *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdatePostIds()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TimelineCacheUpsertBulk) SetExpiresAt(v time.Time) *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TimelineCacheUpsertBulk) UpdateExpiresAt() *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TimelineCacheUpsertBulk) SetUpdatedAt(v time.Time) *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TimelineCacheUpsertBulk) UpdateUpdatedAt() *TimelineCacheUpsertBulk {
+	return u.Update(func(s *TimelineCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TimelineCacheUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TimelineCacheCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimelineCacheCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimelineCacheUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
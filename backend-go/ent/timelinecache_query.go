@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/google/uuid"
+)
+
+// TimelineCacheQuery is the builder for querying TimelineCache entities.
+type TimelineCacheQuery struct {
+	config
+	ctx        *QueryContext
+	order      []timelinecache.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TimelineCache
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TimelineCacheQuery builder.
+func (tcq *TimelineCacheQuery) Where(ps ...predicate.TimelineCache) *TimelineCacheQuery {
+	tcq.predicates = append(tcq.predicates, ps...)
+	return tcq
+}
+
+// Limit the number of records to be returned by this query.
+func (tcq *TimelineCacheQuery) Limit(limit int) *TimelineCacheQuery {
+	tcq.ctx.Limit = &limit
+	return tcq
+}
+
+// Offset to start from.
+func (tcq *TimelineCacheQuery) Offset(offset int) *TimelineCacheQuery {
+	tcq.ctx.Offset = &offset
+	return tcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tcq *TimelineCacheQuery) Unique(unique bool) *TimelineCacheQuery {
+	tcq.ctx.Unique = &unique
+	return tcq
+}
+
+// Order specifies how the records should be ordered.
+func (tcq *TimelineCacheQuery) Order(o ...timelinecache.OrderOption) *TimelineCacheQuery {
+	tcq.order = append(tcq.order, o...)
+	return tcq
+}
+
+// First returns the first TimelineCache entity from the query.
+// Returns a *NotFoundError when no TimelineCache was found.
+func (tcq *TimelineCacheQuery) First(ctx context.Context) (*TimelineCache, error) {
+	nodes, err := tcq.Limit(1).All(setContextOp(ctx, tcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{timelinecache.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) FirstX(ctx context.Context) *TimelineCache {
+	node, err := tcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TimelineCache ID from the query.
+// Returns a *NotFoundError when no TimelineCache ID was found.
+func (tcq *TimelineCacheQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tcq.Limit(1).IDs(setContextOp(ctx, tcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{timelinecache.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TimelineCache entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TimelineCache entity is found.
+// Returns a *NotFoundError when no TimelineCache entities are found.
+func (tcq *TimelineCacheQuery) Only(ctx context.Context) (*TimelineCache, error) {
+	nodes, err := tcq.Limit(2).All(setContextOp(ctx, tcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{timelinecache.Label}
+	default:
+		return nil, &NotSingularError{timelinecache.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) OnlyX(ctx context.Context) *TimelineCache {
+	node, err := tcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TimelineCache ID in the query.
+// Returns a *NotSingularError when more than one TimelineCache ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tcq *TimelineCacheQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tcq.Limit(2).IDs(setContextOp(ctx, tcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{timelinecache.Label}
+	default:
+		err = &NotSingularError{timelinecache.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TimelineCaches.
+func (tcq *TimelineCacheQuery) All(ctx context.Context) ([]*TimelineCache, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryAll)
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TimelineCache, *TimelineCacheQuery]()
+	return withInterceptors[[]*TimelineCache](ctx, tcq, qr, tcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) AllX(ctx context.Context) []*TimelineCache {
+	nodes, err := tcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TimelineCache IDs.
+func (tcq *TimelineCacheQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tcq.ctx.Unique == nil && tcq.path != nil {
+		tcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryIDs)
+	if err = tcq.Select(timelinecache.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tcq *TimelineCacheQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryCount)
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tcq, querierCount[*TimelineCacheQuery](), tcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) CountX(ctx context.Context) int {
+	count, err := tcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tcq *TimelineCacheQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tcq.ctx, ent.OpQueryExist)
+	switch _, err := tcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tcq *TimelineCacheQuery) ExistX(ctx context.Context) bool {
+	exist, err := tcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TimelineCacheQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tcq *TimelineCacheQuery) Clone() *TimelineCacheQuery {
+	if tcq == nil {
+		return nil
+	}
+	return &TimelineCacheQuery{
+		config:     tcq.config,
+		ctx:        tcq.ctx.Clone(),
+		order:      append([]timelinecache.OrderOption{}, tcq.order...),
+		inters:     append([]Interceptor{}, tcq.inters...),
+		predicates: append([]predicate.TimelineCache{}, tcq.predicates...),
+		// clone intermediate query.
+		sql:  tcq.sql.Clone(),
+		path: tcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TimelineCache.Query().
+//		GroupBy(timelinecache.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tcq *TimelineCacheQuery) GroupBy(field string, fields ...string) *TimelineCacheGroupBy {
+	tcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TimelineCacheGroupBy{build: tcq}
+	grbuild.flds = &tcq.ctx.Fields
+	grbuild.label = timelinecache.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.TimelineCache.Query().
+//		Select(timelinecache.FieldUserID).
+//		Scan(ctx, &v)
+func (tcq *TimelineCacheQuery) Select(fields ...string) *TimelineCacheSelect {
+	tcq.ctx.Fields = append(tcq.ctx.Fields, fields...)
+	sbuild := &TimelineCacheSelect{TimelineCacheQuery: tcq}
+	sbuild.label = timelinecache.Label
+	sbuild.flds, sbuild.scan = &tcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TimelineCacheSelect configured with the given aggregations.
+func (tcq *TimelineCacheQuery) Aggregate(fns ...AggregateFunc) *TimelineCacheSelect {
+	return tcq.Select().Aggregate(fns...)
+}
+
+func (tcq *TimelineCacheQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tcq.ctx.Fields {
+		if !timelinecache.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tcq.path != nil {
+		prev, err := tcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tcq.sql = prev
+	}
+	return nil
+}
+
+func (tcq *TimelineCacheQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TimelineCache, error) {
+	var (
+		nodes = []*TimelineCache{}
+		_spec = tcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TimelineCache).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TimelineCache{config: tcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tcq *TimelineCacheQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tcq.querySpec()
+	_spec.Node.Columns = tcq.ctx.Fields
+	if len(tcq.ctx.Fields) > 0 {
+		_spec.Unique = tcq.ctx.Unique != nil && *tcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tcq.driver, _spec)
+}
+
+func (tcq *TimelineCacheQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(timelinecache.Table, timelinecache.Columns, sqlgraph.NewFieldSpec(timelinecache.FieldID, field.TypeUUID))
+	_spec.From = tcq.sql
+	if unique := tcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timelinecache.FieldID)
+		for i := range fields {
+			if fields[i] != timelinecache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tcq *TimelineCacheQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tcq.driver.Dialect())
+	t1 := builder.Table(timelinecache.Table)
+	columns := tcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = timelinecache.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tcq.sql != nil {
+		selector = tcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tcq.ctx.Unique != nil && *tcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tcq.predicates {
+		p(selector)
+	}
+	for _, p := range tcq.order {
+		p(selector)
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TimelineCacheGroupBy is the group-by builder for TimelineCache entities.
+type TimelineCacheGroupBy struct {
+	selector
+	build *TimelineCacheQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tcgb *TimelineCacheGroupBy) Aggregate(fns ...AggregateFunc) *TimelineCacheGroupBy {
+	tcgb.fns = append(tcgb.fns, fns...)
+	return tcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcgb *TimelineCacheGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TimelineCacheQuery, *TimelineCacheGroupBy](ctx, tcgb.build, tcgb, tcgb.build.inters, v)
+}
+
+func (tcgb *TimelineCacheGroupBy) sqlScan(ctx context.Context, root *TimelineCacheQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tcgb.fns))
+	for _, fn := range tcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tcgb.flds)+len(tcgb.fns))
+		for _, f := range *tcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TimelineCacheSelect is the builder for selecting fields of TimelineCache entities.
+type TimelineCacheSelect struct {
+	*TimelineCacheQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tcs *TimelineCacheSelect) Aggregate(fns ...AggregateFunc) *TimelineCacheSelect {
+	tcs.fns = append(tcs.fns, fns...)
+	return tcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcs *TimelineCacheSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcs.ctx, ent.OpQuerySelect)
+	if err := tcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TimelineCacheQuery, *TimelineCacheSelect](ctx, tcs.TimelineCacheQuery, tcs, tcs.inters, v)
+}
+
+func (tcs *TimelineCacheSelect) sqlScan(ctx context.Context, root *TimelineCacheQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tcs.fns))
+	for _, fn := range tcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/google/uuid"
+)
+
+// TimelineCacheUpdate is the builder for updating TimelineCache entities.
+type TimelineCacheUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TimelineCacheMutation
+}
+
+// Where appends a list predicates to the TimelineCacheUpdate builder.
+func (tcu *TimelineCacheUpdate) Where(ps ...predicate.TimelineCache) *TimelineCacheUpdate {
+	tcu.mutation.Where(ps...)
+	return tcu
+}
+
+// SetUserID sets the "user_id" field.
+func (tcu *TimelineCacheUpdate) SetUserID(u uuid.UUID) *TimelineCacheUpdate {
+	tcu.mutation.SetUserID(u)
+	return tcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tcu *TimelineCacheUpdate) SetNillableUserID(u *uuid.UUID) *TimelineCacheUpdate {
+	if u != nil {
+		tcu.SetUserID(*u)
+	}
+	return tcu
+}
+
+// SetPostIds sets the "post_ids" field.
+func (tcu *TimelineCacheUpdate) SetPostIds(u []uuid.UUID) *TimelineCacheUpdate {
+	tcu.mutation.SetPostIds(u)
+	return tcu
+}
+
+// AppendPostIds appends u to the "post_ids" field.
+func (tcu *TimelineCacheUpdate) AppendPostIds(u []uuid.UUID) *TimelineCacheUpdate {
+	tcu.mutation.AppendPostIds(u)
+	return tcu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tcu *TimelineCacheUpdate) SetExpiresAt(t time.Time) *TimelineCacheUpdate {
+	tcu.mutation.SetExpiresAt(t)
+	return tcu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tcu *TimelineCacheUpdate) SetNillableExpiresAt(t *time.Time) *TimelineCacheUpdate {
+	if t != nil {
+		tcu.SetExpiresAt(*t)
+	}
+	return tcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcu *TimelineCacheUpdate) SetUpdatedAt(t time.Time) *TimelineCacheUpdate {
+	tcu.mutation.SetUpdatedAt(t)
+	return tcu
+}
+
+// Mutation returns the TimelineCacheMutation object of the builder.
+func (tcu *TimelineCacheUpdate) Mutation() *TimelineCacheMutation {
+	return tcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tcu *TimelineCacheUpdate) Save(ctx context.Context) (int, error) {
+	tcu.defaults()
+	return withHooks(ctx, tcu.sqlSave, tcu.mutation, tcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcu *TimelineCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := tcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tcu *TimelineCacheUpdate) Exec(ctx context.Context) error {
+	_, err := tcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcu *TimelineCacheUpdate) ExecX(ctx context.Context) {
+	if err := tcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcu *TimelineCacheUpdate) defaults() {
+	if _, ok := tcu.mutation.UpdatedAt(); !ok {
+		v := timelinecache.UpdateDefaultUpdatedAt()
+		tcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (tcu *TimelineCacheUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(timelinecache.Table, timelinecache.Columns, sqlgraph.NewFieldSpec(timelinecache.FieldID, field.TypeUUID))
+	if ps := tcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcu.mutation.UserID(); ok {
+		_spec.SetField(timelinecache.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := tcu.mutation.PostIds(); ok {
+		_spec.SetField(timelinecache.FieldPostIds, field.TypeJSON, value)
+	}
+	if value, ok := tcu.mutation.AppendedPostIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, timelinecache.FieldPostIds, value)
+		})
+	}
+	if value, ok := tcu.mutation.ExpiresAt(); ok {
+		_spec.SetField(timelinecache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := tcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(timelinecache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timelinecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tcu.mutation.done = true
+	return n, nil
+}
+
+// TimelineCacheUpdateOne is the builder for updating a single TimelineCache entity.
+type TimelineCacheUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TimelineCacheMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (tcuo *TimelineCacheUpdateOne) SetUserID(u uuid.UUID) *TimelineCacheUpdateOne {
+	tcuo.mutation.SetUserID(u)
+	return tcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tcuo *TimelineCacheUpdateOne) SetNillableUserID(u *uuid.UUID) *TimelineCacheUpdateOne {
+	if u != nil {
+		tcuo.SetUserID(*u)
+	}
+	return tcuo
+}
+
+// SetPostIds sets the "post_ids" field.
+func (tcuo *TimelineCacheUpdateOne) SetPostIds(u []uuid.UUID) *TimelineCacheUpdateOne {
+	tcuo.mutation.SetPostIds(u)
+	return tcuo
+}
+
+// AppendPostIds appends u to the "post_ids" field.
+func (tcuo *TimelineCacheUpdateOne) AppendPostIds(u []uuid.UUID) *TimelineCacheUpdateOne {
+	tcuo.mutation.AppendPostIds(u)
+	return tcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tcuo *TimelineCacheUpdateOne) SetExpiresAt(t time.Time) *TimelineCacheUpdateOne {
+	tcuo.mutation.SetExpiresAt(t)
+	return tcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tcuo *TimelineCacheUpdateOne) SetNillableExpiresAt(t *time.Time) *TimelineCacheUpdateOne {
+	if t != nil {
+		tcuo.SetExpiresAt(*t)
+	}
+	return tcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcuo *TimelineCacheUpdateOne) SetUpdatedAt(t time.Time) *TimelineCacheUpdateOne {
+	tcuo.mutation.SetUpdatedAt(t)
+	return tcuo
+}
+
+// Mutation returns the TimelineCacheMutation object of the builder.
+func (tcuo *TimelineCacheUpdateOne) Mutation() *TimelineCacheMutation {
+	return tcuo.mutation
+}
+
+// Where appends a list predicates to the TimelineCacheUpdate builder.
+func (tcuo *TimelineCacheUpdateOne) Where(ps ...predicate.TimelineCache) *TimelineCacheUpdateOne {
+	tcuo.mutation.Where(ps...)
+	return tcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tcuo *TimelineCacheUpdateOne) Select(field string, fields ...string) *TimelineCacheUpdateOne {
+	tcuo.fields = append([]string{field}, fields...)
+	return tcuo
+}
+
+// Save executes the query and returns the updated TimelineCache entity.
+func (tcuo *TimelineCacheUpdateOne) Save(ctx context.Context) (*TimelineCache, error) {
+	tcuo.defaults()
+	return withHooks(ctx, tcuo.sqlSave, tcuo.mutation, tcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcuo *TimelineCacheUpdateOne) SaveX(ctx context.Context) *TimelineCache {
+	node, err := tcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tcuo *TimelineCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := tcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcuo *TimelineCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := tcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcuo *TimelineCacheUpdateOne) defaults() {
+	if _, ok := tcuo.mutation.UpdatedAt(); !ok {
+		v := timelinecache.UpdateDefaultUpdatedAt()
+		tcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (tcuo *TimelineCacheUpdateOne) sqlSave(ctx context.Context) (_node *TimelineCache, err error) {
+	_spec := sqlgraph.NewUpdateSpec(timelinecache.Table, timelinecache.Columns, sqlgraph.NewFieldSpec(timelinecache.FieldID, field.TypeUUID))
+	id, ok := tcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TimelineCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timelinecache.FieldID)
+		for _, f := range fields {
+			if !timelinecache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != timelinecache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcuo.mutation.UserID(); ok {
+		_spec.SetField(timelinecache.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := tcuo.mutation.PostIds(); ok {
+		_spec.SetField(timelinecache.FieldPostIds, field.TypeJSON, value)
+	}
+	if value, ok := tcuo.mutation.AppendedPostIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, timelinecache.FieldPostIds, value)
+		})
+	}
+	if value, ok := tcuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(timelinecache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := tcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(timelinecache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TimelineCache{config: tcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timelinecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tcuo.mutation.done = true
+	return _node, nil
+}
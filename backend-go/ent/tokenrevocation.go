@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
+)
+
+// TokenRevocation is the model entity for the TokenRevocation schema.
+type TokenRevocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// この時刻までに発行されたアクセストークンを拒否する
+	RevokedAt time.Time `json:"revoked_at,omitempty"`
+	// これ以降は対象のトークンも期限切れなので記録は不要
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TokenRevocation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tokenrevocation.FieldID:
+			values[i] = new(sql.NullString)
+		case tokenrevocation.FieldRevokedAt, tokenrevocation.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TokenRevocation fields.
+func (tr *TokenRevocation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tokenrevocation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				tr.ID = value.String
+			}
+		case tokenrevocation.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				tr.RevokedAt = value.Time
+			}
+		case tokenrevocation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				tr.ExpiresAt = value.Time
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TokenRevocation.
+// This includes values selected through modifiers, order, etc.
+func (tr *TokenRevocation) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TokenRevocation.
+// Note that you need to call TokenRevocation.Unwrap() before calling this method if this TokenRevocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TokenRevocation) Update() *TokenRevocationUpdateOne {
+	return NewTokenRevocationClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TokenRevocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TokenRevocation) Unwrap() *TokenRevocation {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TokenRevocation is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TokenRevocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("TokenRevocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("revoked_at=")
+	builder.WriteString(tr.RevokedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(tr.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TokenRevocations is a parsable slice of TokenRevocation.
+type TokenRevocations []*TokenRevocation
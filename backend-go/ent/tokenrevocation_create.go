@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
+)
+
+// TokenRevocationCreate is the builder for creating a TokenRevocation entity.
+type TokenRevocationCreate struct {
+	config
+	mutation *TokenRevocationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (trc *TokenRevocationCreate) SetRevokedAt(t time.Time) *TokenRevocationCreate {
+	trc.mutation.SetRevokedAt(t)
+	return trc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (trc *TokenRevocationCreate) SetExpiresAt(t time.Time) *TokenRevocationCreate {
+	trc.mutation.SetExpiresAt(t)
+	return trc
+}
+
+// SetID sets the "id" field.
+func (trc *TokenRevocationCreate) SetID(s string) *TokenRevocationCreate {
+	trc.mutation.SetID(s)
+	return trc
+}
+
+// Mutation returns the TokenRevocationMutation object of the builder.
+func (trc *TokenRevocationCreate) Mutation() *TokenRevocationMutation {
+	return trc.mutation
+}
+
+// Save creates the TokenRevocation in the database.
+func (trc *TokenRevocationCreate) Save(ctx context.Context) (*TokenRevocation, error) {
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TokenRevocationCreate) SaveX(ctx context.Context) *TokenRevocation {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TokenRevocationCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TokenRevocationCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TokenRevocationCreate) check() error {
+	if _, ok := trc.mutation.RevokedAt(); !ok {
+		return &ValidationError{Name: "revoked_at", err: errors.New(`ent: missing required field "TokenRevocation.revoked_at"`)}
+	}
+	if _, ok := trc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TokenRevocation.expires_at"`)}
+	}
+	if v, ok := trc.mutation.ID(); ok {
+		if err := tokenrevocation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TokenRevocation.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (trc *TokenRevocationCreate) sqlSave(ctx context.Context) (*TokenRevocation, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TokenRevocation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TokenRevocationCreate) createSpec() (*TokenRevocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TokenRevocation{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(tokenrevocation.Table, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = trc.conflict
+	if id, ok := trc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := trc.mutation.RevokedAt(); ok {
+		_spec.SetField(tokenrevocation.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = value
+	}
+	if value, ok := trc.mutation.ExpiresAt(); ok {
+		_spec.SetField(tokenrevocation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenRevocation.Create().
+//		SetRevokedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenRevocationUpsert) {
+//			SetRevokedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trc *TokenRevocationCreate) OnConflict(opts ...sql.ConflictOption) *TokenRevocationUpsertOne {
+	trc.conflict = opts
+	return &TokenRevocationUpsertOne{
+		create: trc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trc *TokenRevocationCreate) OnConflictColumns(columns ...string) *TokenRevocationUpsertOne {
+	trc.conflict = append(trc.conflict, sql.ConflictColumns(columns...))
+	return &TokenRevocationUpsertOne{
+		create: trc,
+	}
+}
+
+type (
+	// TokenRevocationUpsertOne is the builder for "upsert"-ing
+	//  one TokenRevocation node.
+	TokenRevocationUpsertOne struct {
+		create *TokenRevocationCreate
+	}
+
+	// TokenRevocationUpsert is the "OnConflict" setter.
+	TokenRevocationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *TokenRevocationUpsert) SetRevokedAt(v time.Time) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateRevokedAt() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldRevokedAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TokenRevocationUpsert) SetExpiresAt(v time.Time) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateExpiresAt() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenrevocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenRevocationUpsertOne) UpdateNewValues() *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tokenrevocation.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenRevocationUpsertOne) Ignore() *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenRevocationUpsertOne) DoNothing() *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenRevocationCreate.OnConflict
+// documentation for more info.
+func (u *TokenRevocationUpsertOne) Update(set func(*TokenRevocationUpsert)) *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenRevocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *TokenRevocationUpsertOne) SetRevokedAt(v time.Time) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateRevokedAt() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TokenRevocationUpsertOne) SetExpiresAt(v time.Time) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateExpiresAt() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenRevocationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenRevocationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenRevocationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenRevocationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenRevocationUpsertOne.ID is not supported by MySQL driver. Use TokenRevocationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenRevocationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenRevocationCreateBulk is the builder for creating many TokenRevocation entities in bulk.
+type TokenRevocationCreateBulk struct {
+	config
+	err      error
+	builders []*TokenRevocationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TokenRevocation entities in the database.
+func (trcb *TokenRevocationCreateBulk) Save(ctx context.Context) ([]*TokenRevocation, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TokenRevocation, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenRevocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TokenRevocationCreateBulk) SaveX(ctx context.Context) []*TokenRevocation {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TokenRevocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TokenRevocationCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenRevocation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenRevocationUpsert) {
+//			SetRevokedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trcb *TokenRevocationCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenRevocationUpsertBulk {
+	trcb.conflict = opts
+	return &TokenRevocationUpsertBulk{
+		create: trcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trcb *TokenRevocationCreateBulk) OnConflictColumns(columns ...string) *TokenRevocationUpsertBulk {
+	trcb.conflict = append(trcb.conflict, sql.ConflictColumns(columns...))
+	return &TokenRevocationUpsertBulk{
+		create: trcb,
+	}
+}
+
+// TokenRevocationUpsertBulk is the builder for "upsert"-ing
+// a bulk of TokenRevocation nodes.
+type TokenRevocationUpsertBulk struct {
+	create *TokenRevocationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenrevocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenRevocationUpsertBulk) UpdateNewValues() *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tokenrevocation.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenRevocationUpsertBulk) Ignore() *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenRevocationUpsertBulk) DoNothing() *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenRevocationCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenRevocationUpsertBulk) Update(set func(*TokenRevocationUpsert)) *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenRevocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *TokenRevocationUpsertBulk) SetRevokedAt(v time.Time) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateRevokedAt() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TokenRevocationUpsertBulk) SetExpiresAt(v time.Time) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateExpiresAt() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenRevocationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenRevocationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenRevocationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenRevocationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
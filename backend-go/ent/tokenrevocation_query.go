@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
+)
+
+// TokenRevocationQuery is the builder for querying TokenRevocation entities.
+type TokenRevocationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tokenrevocation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TokenRevocation
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TokenRevocationQuery builder.
+func (trq *TokenRevocationQuery) Where(ps ...predicate.TokenRevocation) *TokenRevocationQuery {
+	trq.predicates = append(trq.predicates, ps...)
+	return trq
+}
+
+// Limit the number of records to be returned by this query.
+func (trq *TokenRevocationQuery) Limit(limit int) *TokenRevocationQuery {
+	trq.ctx.Limit = &limit
+	return trq
+}
+
+// Offset to start from.
+func (trq *TokenRevocationQuery) Offset(offset int) *TokenRevocationQuery {
+	trq.ctx.Offset = &offset
+	return trq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (trq *TokenRevocationQuery) Unique(unique bool) *TokenRevocationQuery {
+	trq.ctx.Unique = &unique
+	return trq
+}
+
+// Order specifies how the records should be ordered.
+func (trq *TokenRevocationQuery) Order(o ...tokenrevocation.OrderOption) *TokenRevocationQuery {
+	trq.order = append(trq.order, o...)
+	return trq
+}
+
+// First returns the first TokenRevocation entity from the query.
+// Returns a *NotFoundError when no TokenRevocation was found.
+func (trq *TokenRevocationQuery) First(ctx context.Context) (*TokenRevocation, error) {
+	nodes, err := trq.Limit(1).All(setContextOp(ctx, trq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tokenrevocation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (trq *TokenRevocationQuery) FirstX(ctx context.Context) *TokenRevocation {
+	node, err := trq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TokenRevocation ID from the query.
+// Returns a *NotFoundError when no TokenRevocation ID was found.
+func (trq *TokenRevocationQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = trq.Limit(1).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tokenrevocation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (trq *TokenRevocationQuery) FirstIDX(ctx context.Context) string {
+	id, err := trq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TokenRevocation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TokenRevocation entity is found.
+// Returns a *NotFoundError when no TokenRevocation entities are found.
+func (trq *TokenRevocationQuery) Only(ctx context.Context) (*TokenRevocation, error) {
+	nodes, err := trq.Limit(2).All(setContextOp(ctx, trq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tokenrevocation.Label}
+	default:
+		return nil, &NotSingularError{tokenrevocation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (trq *TokenRevocationQuery) OnlyX(ctx context.Context) *TokenRevocation {
+	node, err := trq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TokenRevocation ID in the query.
+// Returns a *NotSingularError when more than one TokenRevocation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (trq *TokenRevocationQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = trq.Limit(2).IDs(setContextOp(ctx, trq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tokenrevocation.Label}
+	default:
+		err = &NotSingularError{tokenrevocation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (trq *TokenRevocationQuery) OnlyIDX(ctx context.Context) string {
+	id, err := trq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TokenRevocations.
+func (trq *TokenRevocationQuery) All(ctx context.Context) ([]*TokenRevocation, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryAll)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TokenRevocation, *TokenRevocationQuery]()
+	return withInterceptors[[]*TokenRevocation](ctx, trq, qr, trq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (trq *TokenRevocationQuery) AllX(ctx context.Context) []*TokenRevocation {
+	nodes, err := trq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TokenRevocation IDs.
+func (trq *TokenRevocationQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if trq.ctx.Unique == nil && trq.path != nil {
+		trq.Unique(true)
+	}
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryIDs)
+	if err = trq.Select(tokenrevocation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (trq *TokenRevocationQuery) IDsX(ctx context.Context) []string {
+	ids, err := trq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (trq *TokenRevocationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryCount)
+	if err := trq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, trq, querierCount[*TokenRevocationQuery](), trq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (trq *TokenRevocationQuery) CountX(ctx context.Context) int {
+	count, err := trq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (trq *TokenRevocationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, trq.ctx, ent.OpQueryExist)
+	switch _, err := trq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (trq *TokenRevocationQuery) ExistX(ctx context.Context) bool {
+	exist, err := trq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TokenRevocationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (trq *TokenRevocationQuery) Clone() *TokenRevocationQuery {
+	if trq == nil {
+		return nil
+	}
+	return &TokenRevocationQuery{
+		config:     trq.config,
+		ctx:        trq.ctx.Clone(),
+		order:      append([]tokenrevocation.OrderOption{}, trq.order...),
+		inters:     append([]Interceptor{}, trq.inters...),
+		predicates: append([]predicate.TokenRevocation{}, trq.predicates...),
+		// clone intermediate query.
+		sql:  trq.sql.Clone(),
+		path: trq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RevokedAt time.Time `json:"revoked_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TokenRevocation.Query().
+//		GroupBy(tokenrevocation.FieldRevokedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (trq *TokenRevocationQuery) GroupBy(field string, fields ...string) *TokenRevocationGroupBy {
+	trq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TokenRevocationGroupBy{build: trq}
+	grbuild.flds = &trq.ctx.Fields
+	grbuild.label = tokenrevocation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RevokedAt time.Time `json:"revoked_at,omitempty"`
+//	}
+//
+//	client.TokenRevocation.Query().
+//		Select(tokenrevocation.FieldRevokedAt).
+//		Scan(ctx, &v)
+func (trq *TokenRevocationQuery) Select(fields ...string) *TokenRevocationSelect {
+	trq.ctx.Fields = append(trq.ctx.Fields, fields...)
+	sbuild := &TokenRevocationSelect{TokenRevocationQuery: trq}
+	sbuild.label = tokenrevocation.Label
+	sbuild.flds, sbuild.scan = &trq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TokenRevocationSelect configured with the given aggregations.
+func (trq *TokenRevocationQuery) Aggregate(fns ...AggregateFunc) *TokenRevocationSelect {
+	return trq.Select().Aggregate(fns...)
+}
+
+func (trq *TokenRevocationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range trq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, trq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range trq.ctx.Fields {
+		if !tokenrevocation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if trq.path != nil {
+		prev, err := trq.path(ctx)
+		if err != nil {
+			return err
+		}
+		trq.sql = prev
+	}
+	return nil
+}
+
+func (trq *TokenRevocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TokenRevocation, error) {
+	var (
+		nodes = []*TokenRevocation{}
+		_spec = trq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TokenRevocation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TokenRevocation{config: trq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, trq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (trq *TokenRevocationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := trq.querySpec()
+	_spec.Node.Columns = trq.ctx.Fields
+	if len(trq.ctx.Fields) > 0 {
+		_spec.Unique = trq.ctx.Unique != nil && *trq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, trq.driver, _spec)
+}
+
+func (trq *TokenRevocationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tokenrevocation.Table, tokenrevocation.Columns, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeString))
+	_spec.From = trq.sql
+	if unique := trq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if trq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := trq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenrevocation.FieldID)
+		for i := range fields {
+			if fields[i] != tokenrevocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := trq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := trq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (trq *TokenRevocationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(trq.driver.Dialect())
+	t1 := builder.Table(tokenrevocation.Table)
+	columns := trq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tokenrevocation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if trq.sql != nil {
+		selector = trq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if trq.ctx.Unique != nil && *trq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range trq.predicates {
+		p(selector)
+	}
+	for _, p := range trq.order {
+		p(selector)
+	}
+	if offset := trq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := trq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TokenRevocationGroupBy is the group-by builder for TokenRevocation entities.
+type TokenRevocationGroupBy struct {
+	selector
+	build *TokenRevocationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (trgb *TokenRevocationGroupBy) Aggregate(fns ...AggregateFunc) *TokenRevocationGroupBy {
+	trgb.fns = append(trgb.fns, fns...)
+	return trgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trgb *TokenRevocationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trgb.build.ctx, ent.OpQueryGroupBy)
+	if err := trgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenRevocationQuery, *TokenRevocationGroupBy](ctx, trgb.build, trgb, trgb.build.inters, v)
+}
+
+func (trgb *TokenRevocationGroupBy) sqlScan(ctx context.Context, root *TokenRevocationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(trgb.fns))
+	for _, fn := range trgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*trgb.flds)+len(trgb.fns))
+		for _, f := range *trgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*trgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TokenRevocationSelect is the builder for selecting fields of TokenRevocation entities.
+type TokenRevocationSelect struct {
+	*TokenRevocationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (trs *TokenRevocationSelect) Aggregate(fns ...AggregateFunc) *TokenRevocationSelect {
+	trs.fns = append(trs.fns, fns...)
+	return trs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (trs *TokenRevocationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, trs.ctx, ent.OpQuerySelect)
+	if err := trs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenRevocationQuery, *TokenRevocationSelect](ctx, trs.TokenRevocationQuery, trs, trs.inters, v)
+}
+
+func (trs *TokenRevocationSelect) sqlScan(ctx context.Context, root *TokenRevocationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(trs.fns))
+	for _, fn := range trs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*trs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := trs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
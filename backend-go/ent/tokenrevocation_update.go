@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
+)
+
+// TokenRevocationUpdate is the builder for updating TokenRevocation entities.
+type TokenRevocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenRevocationMutation
+}
+
+// Where appends a list predicates to the TokenRevocationUpdate builder.
+func (tru *TokenRevocationUpdate) Where(ps ...predicate.TokenRevocation) *TokenRevocationUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (tru *TokenRevocationUpdate) SetRevokedAt(t time.Time) *TokenRevocationUpdate {
+	tru.mutation.SetRevokedAt(t)
+	return tru
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableRevokedAt(t *time.Time) *TokenRevocationUpdate {
+	if t != nil {
+		tru.SetRevokedAt(*t)
+	}
+	return tru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tru *TokenRevocationUpdate) SetExpiresAt(t time.Time) *TokenRevocationUpdate {
+	tru.mutation.SetExpiresAt(t)
+	return tru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableExpiresAt(t *time.Time) *TokenRevocationUpdate {
+	if t != nil {
+		tru.SetExpiresAt(*t)
+	}
+	return tru
+}
+
+// Mutation returns the TokenRevocationMutation object of the builder.
+func (tru *TokenRevocationUpdate) Mutation() *TokenRevocationMutation {
+	return tru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TokenRevocationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TokenRevocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TokenRevocationUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TokenRevocationUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tru *TokenRevocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenrevocation.Table, tokenrevocation.Columns, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeString))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.RevokedAt(); ok {
+		_spec.SetField(tokenrevocation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.ExpiresAt(); ok {
+		_spec.SetField(tokenrevocation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenrevocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TokenRevocationUpdateOne is the builder for updating a single TokenRevocation entity.
+type TokenRevocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenRevocationMutation
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (truo *TokenRevocationUpdateOne) SetRevokedAt(t time.Time) *TokenRevocationUpdateOne {
+	truo.mutation.SetRevokedAt(t)
+	return truo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableRevokedAt(t *time.Time) *TokenRevocationUpdateOne {
+	if t != nil {
+		truo.SetRevokedAt(*t)
+	}
+	return truo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (truo *TokenRevocationUpdateOne) SetExpiresAt(t time.Time) *TokenRevocationUpdateOne {
+	truo.mutation.SetExpiresAt(t)
+	return truo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableExpiresAt(t *time.Time) *TokenRevocationUpdateOne {
+	if t != nil {
+		truo.SetExpiresAt(*t)
+	}
+	return truo
+}
+
+// Mutation returns the TokenRevocationMutation object of the builder.
+func (truo *TokenRevocationUpdateOne) Mutation() *TokenRevocationMutation {
+	return truo.mutation
+}
+
+// Where appends a list predicates to the TokenRevocationUpdate builder.
+func (truo *TokenRevocationUpdateOne) Where(ps ...predicate.TokenRevocation) *TokenRevocationUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TokenRevocationUpdateOne) Select(field string, fields ...string) *TokenRevocationUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TokenRevocation entity.
+func (truo *TokenRevocationUpdateOne) Save(ctx context.Context) (*TokenRevocation, error) {
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TokenRevocationUpdateOne) SaveX(ctx context.Context) *TokenRevocation {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TokenRevocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TokenRevocationUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (truo *TokenRevocationUpdateOne) sqlSave(ctx context.Context) (_node *TokenRevocation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenrevocation.Table, tokenrevocation.Columns, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeString))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenRevocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenrevocation.FieldID)
+		for _, f := range fields {
+			if !tokenrevocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenrevocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.RevokedAt(); ok {
+		_spec.SetField(tokenrevocation.FieldRevokedAt, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.ExpiresAt(); ok {
+		_spec.SetField(tokenrevocation.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &TokenRevocation{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenrevocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
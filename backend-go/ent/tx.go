@@ -16,20 +16,54 @@ type Tx struct {
 	BlockRelation *BlockRelationClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentLike is the client for interacting with the CommentLike builders.
+	CommentLike *CommentLikeClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
 	// DailyTask is the client for interacting with the DailyTask builders.
 	DailyTask *DailyTaskClient
+	// DataMigration is the client for interacting with the DataMigration builders.
+	DataMigration *DataMigrationClient
 	// DeviceToken is the client for interacting with the DeviceToken builders.
 	DeviceToken *DeviceTokenClient
+	// ExploreRanking is the client for interacting with the ExploreRanking builders.
+	ExploreRanking *ExploreRankingClient
 	// FollowRelation is the client for interacting with the FollowRelation builders.
 	FollowRelation *FollowRelationClient
+	// FollowRequest is the client for interacting with the FollowRequest builders.
+	FollowRequest *FollowRequestClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
+	// MuteRelation is the client for interacting with the MuteRelation builders.
+	MuteRelation *MuteRelationClient
+	// MutedKeyword is the client for interacting with the MutedKeyword builders.
+	MutedKeyword *MutedKeywordClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
 	// Pet is the client for interacting with the Pet builders.
 	Pet *PetClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostMedia is the client for interacting with the PostMedia builders.
+	PostMedia *PostMediaClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostTag is the client for interacting with the PostTag builders.
+	PostTag *PostTagClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// Suspension is the client for interacting with the Suspension builders.
+	Suspension *SuspensionClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TimelineCache is the client for interacting with the TimelineCache builders.
+	TimelineCache *TimelineCacheClient
+	// TokenRevocation is the client for interacting with the TokenRevocation builders.
+	TokenRevocation *TokenRevocationClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// VerificationCode is the client for interacting with the VerificationCode builders.
+	VerificationCode *VerificationCodeClient
 
 	// lazily loaded.
 	client     *Client
@@ -163,13 +197,30 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.BlockRelation = NewBlockRelationClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.CommentLike = NewCommentLikeClient(tx.config)
+	tx.Credential = NewCredentialClient(tx.config)
 	tx.DailyTask = NewDailyTaskClient(tx.config)
+	tx.DataMigration = NewDataMigrationClient(tx.config)
 	tx.DeviceToken = NewDeviceTokenClient(tx.config)
+	tx.ExploreRanking = NewExploreRankingClient(tx.config)
 	tx.FollowRelation = NewFollowRelationClient(tx.config)
+	tx.FollowRequest = NewFollowRequestClient(tx.config)
 	tx.Like = NewLikeClient(tx.config)
+	tx.MuteRelation = NewMuteRelationClient(tx.config)
+	tx.MutedKeyword = NewMutedKeywordClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
 	tx.Pet = NewPetClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.PostMedia = NewPostMediaClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.PostTag = NewPostTagClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
+	tx.Suspension = NewSuspensionClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.TimelineCache = NewTimelineCacheClient(tx.config)
+	tx.TokenRevocation = NewTokenRevocationClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.VerificationCode = NewVerificationCodeClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -22,16 +23,30 @@ type User struct {
 	Index uint32 `json:"index,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// 認証プロバイダ上のユーザー識別子 (sub)
+	AuthSubject string `json:"auth_subject,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// プロフィールURLなどに使う変更可能な一意のID。既存ユーザーは manage backfill-handles で付与する
+	Handle string `json:"handle,omitempty"`
 	// Bio holds the value of the "bio" field.
 	Bio string `json:"bio,omitempty"`
 	// StreakCount holds the value of the "streak_count" field.
 	StreakCount uint32 `json:"streak_count,omitempty"`
+	// Role holds the value of the "role" field.
+	Role enum.Role `json:"role,omitempty"`
 	// IconImageKey holds the value of the "icon_image_key" field.
 	IconImageKey string `json:"icon_image_key,omitempty"`
+	// 非公開アカウントは承認したフォロワーにだけ投稿を公開する
+	IsPrivate bool `json:"is_private,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FollowerCount holds the value of the "follower_count" field.
+	FollowerCount int `json:"follower_count,omitempty"`
+	// FollowingCount holds the value of the "following_count" field.
+	FollowingCount int `json:"following_count,omitempty"`
+	// 削除されていない投稿の数
+	PostCount int `json:"post_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -60,9 +75,39 @@ type UserEdges struct {
 	DailyTasks []*DailyTask `json:"daily_tasks,omitempty"`
 	// DeviceTokens holds the value of the device_tokens edge.
 	DeviceTokens []*DeviceToken `json:"device_tokens,omitempty"`
+	// ReportsFiled holds the value of the reports_filed edge.
+	ReportsFiled []*Report `json:"reports_filed,omitempty"`
+	// ReportsReceived holds the value of the reports_received edge.
+	ReportsReceived []*Report `json:"reports_received,omitempty"`
+	// ReportsResolved holds the value of the reports_resolved edge.
+	ReportsResolved []*Report `json:"reports_resolved,omitempty"`
+	// Suspensions holds the value of the suspensions edge.
+	Suspensions []*Suspension `json:"suspensions,omitempty"`
+	// SuspensionsIssued holds the value of the suspensions_issued edge.
+	SuspensionsIssued []*Suspension `json:"suspensions_issued,omitempty"`
+	// Muting holds the value of the muting edge.
+	Muting []*MuteRelation `json:"muting,omitempty"`
+	// MutedBy holds the value of the muted_by edge.
+	MutedBy []*MuteRelation `json:"muted_by,omitempty"`
+	// MutedKeywords holds the value of the muted_keywords edge.
+	MutedKeywords []*MutedKeyword `json:"muted_keywords,omitempty"`
+	// FollowRequestsSent holds the value of the follow_requests_sent edge.
+	FollowRequestsSent []*FollowRequest `json:"follow_requests_sent,omitempty"`
+	// FollowRequestsReceived holds the value of the follow_requests_received edge.
+	FollowRequestsReceived []*FollowRequest `json:"follow_requests_received,omitempty"`
+	// MentionedInPosts holds the value of the mentioned_in_posts edge.
+	MentionedInPosts []*Post `json:"mentioned_in_posts,omitempty"`
+	// MentionedInComments holds the value of the mentioned_in_comments edge.
+	MentionedInComments []*Comment `json:"mentioned_in_comments,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// NotificationsSent holds the value of the notifications_sent edge.
+	NotificationsSent []*Notification `json:"notifications_sent,omitempty"`
+	// CommentLikes holds the value of the comment_likes edge.
+	CommentLikes []*CommentLike `json:"comment_likes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [10]bool
+	loadedTypes [25]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -155,14 +200,151 @@ func (e UserEdges) DeviceTokensOrErr() ([]*DeviceToken, error) {
 	return nil, &NotLoadedError{edge: "device_tokens"}
 }
 
+// ReportsFiledOrErr returns the ReportsFiled value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportsFiledOrErr() ([]*Report, error) {
+	if e.loadedTypes[10] {
+		return e.ReportsFiled, nil
+	}
+	return nil, &NotLoadedError{edge: "reports_filed"}
+}
+
+// ReportsReceivedOrErr returns the ReportsReceived value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportsReceivedOrErr() ([]*Report, error) {
+	if e.loadedTypes[11] {
+		return e.ReportsReceived, nil
+	}
+	return nil, &NotLoadedError{edge: "reports_received"}
+}
+
+// ReportsResolvedOrErr returns the ReportsResolved value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReportsResolvedOrErr() ([]*Report, error) {
+	if e.loadedTypes[12] {
+		return e.ReportsResolved, nil
+	}
+	return nil, &NotLoadedError{edge: "reports_resolved"}
+}
+
+// SuspensionsOrErr returns the Suspensions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SuspensionsOrErr() ([]*Suspension, error) {
+	if e.loadedTypes[13] {
+		return e.Suspensions, nil
+	}
+	return nil, &NotLoadedError{edge: "suspensions"}
+}
+
+// SuspensionsIssuedOrErr returns the SuspensionsIssued value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SuspensionsIssuedOrErr() ([]*Suspension, error) {
+	if e.loadedTypes[14] {
+		return e.SuspensionsIssued, nil
+	}
+	return nil, &NotLoadedError{edge: "suspensions_issued"}
+}
+
+// MutingOrErr returns the Muting value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutingOrErr() ([]*MuteRelation, error) {
+	if e.loadedTypes[15] {
+		return e.Muting, nil
+	}
+	return nil, &NotLoadedError{edge: "muting"}
+}
+
+// MutedByOrErr returns the MutedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutedByOrErr() ([]*MuteRelation, error) {
+	if e.loadedTypes[16] {
+		return e.MutedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "muted_by"}
+}
+
+// MutedKeywordsOrErr returns the MutedKeywords value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MutedKeywordsOrErr() ([]*MutedKeyword, error) {
+	if e.loadedTypes[17] {
+		return e.MutedKeywords, nil
+	}
+	return nil, &NotLoadedError{edge: "muted_keywords"}
+}
+
+// FollowRequestsSentOrErr returns the FollowRequestsSent value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowRequestsSentOrErr() ([]*FollowRequest, error) {
+	if e.loadedTypes[18] {
+		return e.FollowRequestsSent, nil
+	}
+	return nil, &NotLoadedError{edge: "follow_requests_sent"}
+}
+
+// FollowRequestsReceivedOrErr returns the FollowRequestsReceived value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowRequestsReceivedOrErr() ([]*FollowRequest, error) {
+	if e.loadedTypes[19] {
+		return e.FollowRequestsReceived, nil
+	}
+	return nil, &NotLoadedError{edge: "follow_requests_received"}
+}
+
+// MentionedInPostsOrErr returns the MentionedInPosts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MentionedInPostsOrErr() ([]*Post, error) {
+	if e.loadedTypes[20] {
+		return e.MentionedInPosts, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_in_posts"}
+}
+
+// MentionedInCommentsOrErr returns the MentionedInComments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MentionedInCommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[21] {
+		return e.MentionedInComments, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_in_comments"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[22] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// NotificationsSentOrErr returns the NotificationsSent value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsSentOrErr() ([]*Notification, error) {
+	if e.loadedTypes[23] {
+		return e.NotificationsSent, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications_sent"}
+}
+
+// CommentLikesOrErr returns the CommentLikes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentLikesOrErr() ([]*CommentLike, error) {
+	if e.loadedTypes[24] {
+		return e.CommentLikes, nil
+	}
+	return nil, &NotLoadedError{edge: "comment_likes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldIndex, user.FieldStreakCount:
+		case user.FieldIsPrivate:
+			values[i] = new(sql.NullBool)
+		case user.FieldIndex, user.FieldStreakCount, user.FieldFollowerCount, user.FieldFollowingCount, user.FieldPostCount:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldName, user.FieldBio, user.FieldIconImageKey:
+		case user.FieldEmail, user.FieldAuthSubject, user.FieldName, user.FieldHandle, user.FieldBio, user.FieldRole, user.FieldIconImageKey:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -201,12 +383,24 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Email = value.String
 			}
+		case user.FieldAuthSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_subject", values[i])
+			} else if value.Valid {
+				u.AuthSubject = value.String
+			}
 		case user.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
 				u.Name = value.String
 			}
+		case user.FieldHandle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handle", values[i])
+			} else if value.Valid {
+				u.Handle = value.String
+			}
 		case user.FieldBio:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field bio", values[i])
@@ -219,18 +413,48 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.StreakCount = uint32(value.Int64)
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = enum.Role(value.String)
+			}
 		case user.FieldIconImageKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field icon_image_key", values[i])
 			} else if value.Valid {
 				u.IconImageKey = value.String
 			}
+		case user.FieldIsPrivate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_private", values[i])
+			} else if value.Valid {
+				u.IsPrivate = value.Bool
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				u.CreatedAt = value.Time
 			}
+		case user.FieldFollowerCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field follower_count", values[i])
+			} else if value.Valid {
+				u.FollowerCount = int(value.Int64)
+			}
+		case user.FieldFollowingCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field following_count", values[i])
+			} else if value.Valid {
+				u.FollowingCount = int(value.Int64)
+			}
+		case user.FieldPostCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field post_count", values[i])
+			} else if value.Valid {
+				u.PostCount = int(value.Int64)
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -294,6 +518,81 @@ func (u *User) QueryDeviceTokens() *DeviceTokenQuery {
 	return NewUserClient(u.config).QueryDeviceTokens(u)
 }
 
+// QueryReportsFiled queries the "reports_filed" edge of the User entity.
+func (u *User) QueryReportsFiled() *ReportQuery {
+	return NewUserClient(u.config).QueryReportsFiled(u)
+}
+
+// QueryReportsReceived queries the "reports_received" edge of the User entity.
+func (u *User) QueryReportsReceived() *ReportQuery {
+	return NewUserClient(u.config).QueryReportsReceived(u)
+}
+
+// QueryReportsResolved queries the "reports_resolved" edge of the User entity.
+func (u *User) QueryReportsResolved() *ReportQuery {
+	return NewUserClient(u.config).QueryReportsResolved(u)
+}
+
+// QuerySuspensions queries the "suspensions" edge of the User entity.
+func (u *User) QuerySuspensions() *SuspensionQuery {
+	return NewUserClient(u.config).QuerySuspensions(u)
+}
+
+// QuerySuspensionsIssued queries the "suspensions_issued" edge of the User entity.
+func (u *User) QuerySuspensionsIssued() *SuspensionQuery {
+	return NewUserClient(u.config).QuerySuspensionsIssued(u)
+}
+
+// QueryMuting queries the "muting" edge of the User entity.
+func (u *User) QueryMuting() *MuteRelationQuery {
+	return NewUserClient(u.config).QueryMuting(u)
+}
+
+// QueryMutedBy queries the "muted_by" edge of the User entity.
+func (u *User) QueryMutedBy() *MuteRelationQuery {
+	return NewUserClient(u.config).QueryMutedBy(u)
+}
+
+// QueryMutedKeywords queries the "muted_keywords" edge of the User entity.
+func (u *User) QueryMutedKeywords() *MutedKeywordQuery {
+	return NewUserClient(u.config).QueryMutedKeywords(u)
+}
+
+// QueryFollowRequestsSent queries the "follow_requests_sent" edge of the User entity.
+func (u *User) QueryFollowRequestsSent() *FollowRequestQuery {
+	return NewUserClient(u.config).QueryFollowRequestsSent(u)
+}
+
+// QueryFollowRequestsReceived queries the "follow_requests_received" edge of the User entity.
+func (u *User) QueryFollowRequestsReceived() *FollowRequestQuery {
+	return NewUserClient(u.config).QueryFollowRequestsReceived(u)
+}
+
+// QueryMentionedInPosts queries the "mentioned_in_posts" edge of the User entity.
+func (u *User) QueryMentionedInPosts() *PostQuery {
+	return NewUserClient(u.config).QueryMentionedInPosts(u)
+}
+
+// QueryMentionedInComments queries the "mentioned_in_comments" edge of the User entity.
+func (u *User) QueryMentionedInComments() *CommentQuery {
+	return NewUserClient(u.config).QueryMentionedInComments(u)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (u *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(u.config).QueryNotifications(u)
+}
+
+// QueryNotificationsSent queries the "notifications_sent" edge of the User entity.
+func (u *User) QueryNotificationsSent() *NotificationQuery {
+	return NewUserClient(u.config).QueryNotificationsSent(u)
+}
+
+// QueryCommentLikes queries the "comment_likes" edge of the User entity.
+func (u *User) QueryCommentLikes() *CommentLikeQuery {
+	return NewUserClient(u.config).QueryCommentLikes(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -323,20 +622,41 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
+	builder.WriteString("auth_subject=")
+	builder.WriteString(u.AuthSubject)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(u.Name)
 	builder.WriteString(", ")
+	builder.WriteString("handle=")
+	builder.WriteString(u.Handle)
+	builder.WriteString(", ")
 	builder.WriteString("bio=")
 	builder.WriteString(u.Bio)
 	builder.WriteString(", ")
 	builder.WriteString("streak_count=")
 	builder.WriteString(fmt.Sprintf("%v", u.StreakCount))
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
 	builder.WriteString("icon_image_key=")
 	builder.WriteString(u.IconImageKey)
 	builder.WriteString(", ")
+	builder.WriteString("is_private=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsPrivate))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("follower_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.FollowerCount))
+	builder.WriteString(", ")
+	builder.WriteString("following_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.FollowingCount))
+	builder.WriteString(", ")
+	builder.WriteString("post_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.PostCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
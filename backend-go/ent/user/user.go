@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
@@ -19,16 +20,30 @@ const (
 	FieldIndex = "index"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldAuthSubject holds the string denoting the auth_subject field in the database.
+	FieldAuthSubject = "auth_subject"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldHandle holds the string denoting the handle field in the database.
+	FieldHandle = "handle"
 	// FieldBio holds the string denoting the bio field in the database.
 	FieldBio = "bio"
 	// FieldStreakCount holds the string denoting the streak_count field in the database.
 	FieldStreakCount = "streak_count"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// FieldIconImageKey holds the string denoting the icon_image_key field in the database.
 	FieldIconImageKey = "icon_image_key"
+	// FieldIsPrivate holds the string denoting the is_private field in the database.
+	FieldIsPrivate = "is_private"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldFollowerCount holds the string denoting the follower_count field in the database.
+	FieldFollowerCount = "follower_count"
+	// FieldFollowingCount holds the string denoting the following_count field in the database.
+	FieldFollowingCount = "following_count"
+	// FieldPostCount holds the string denoting the post_count field in the database.
+	FieldPostCount = "post_count"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgeComments holds the string denoting the comments edge name in mutations.
@@ -49,6 +64,36 @@ const (
 	EdgeDailyTasks = "daily_tasks"
 	// EdgeDeviceTokens holds the string denoting the device_tokens edge name in mutations.
 	EdgeDeviceTokens = "device_tokens"
+	// EdgeReportsFiled holds the string denoting the reports_filed edge name in mutations.
+	EdgeReportsFiled = "reports_filed"
+	// EdgeReportsReceived holds the string denoting the reports_received edge name in mutations.
+	EdgeReportsReceived = "reports_received"
+	// EdgeReportsResolved holds the string denoting the reports_resolved edge name in mutations.
+	EdgeReportsResolved = "reports_resolved"
+	// EdgeSuspensions holds the string denoting the suspensions edge name in mutations.
+	EdgeSuspensions = "suspensions"
+	// EdgeSuspensionsIssued holds the string denoting the suspensions_issued edge name in mutations.
+	EdgeSuspensionsIssued = "suspensions_issued"
+	// EdgeMuting holds the string denoting the muting edge name in mutations.
+	EdgeMuting = "muting"
+	// EdgeMutedBy holds the string denoting the muted_by edge name in mutations.
+	EdgeMutedBy = "muted_by"
+	// EdgeMutedKeywords holds the string denoting the muted_keywords edge name in mutations.
+	EdgeMutedKeywords = "muted_keywords"
+	// EdgeFollowRequestsSent holds the string denoting the follow_requests_sent edge name in mutations.
+	EdgeFollowRequestsSent = "follow_requests_sent"
+	// EdgeFollowRequestsReceived holds the string denoting the follow_requests_received edge name in mutations.
+	EdgeFollowRequestsReceived = "follow_requests_received"
+	// EdgeMentionedInPosts holds the string denoting the mentioned_in_posts edge name in mutations.
+	EdgeMentionedInPosts = "mentioned_in_posts"
+	// EdgeMentionedInComments holds the string denoting the mentioned_in_comments edge name in mutations.
+	EdgeMentionedInComments = "mentioned_in_comments"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeNotificationsSent holds the string denoting the notifications_sent edge name in mutations.
+	EdgeNotificationsSent = "notifications_sent"
+	// EdgeCommentLikes holds the string denoting the comment_likes edge name in mutations.
+	EdgeCommentLikes = "comment_likes"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// PostsTable is the table that holds the posts relation/edge.
@@ -121,6 +166,107 @@ const (
 	DeviceTokensInverseTable = "device_tokens"
 	// DeviceTokensColumn is the table column denoting the device_tokens relation/edge.
 	DeviceTokensColumn = "user_id"
+	// ReportsFiledTable is the table that holds the reports_filed relation/edge.
+	ReportsFiledTable = "reports"
+	// ReportsFiledInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportsFiledInverseTable = "reports"
+	// ReportsFiledColumn is the table column denoting the reports_filed relation/edge.
+	ReportsFiledColumn = "reporter_id"
+	// ReportsReceivedTable is the table that holds the reports_received relation/edge.
+	ReportsReceivedTable = "reports"
+	// ReportsReceivedInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportsReceivedInverseTable = "reports"
+	// ReportsReceivedColumn is the table column denoting the reports_received relation/edge.
+	ReportsReceivedColumn = "target_user_id"
+	// ReportsResolvedTable is the table that holds the reports_resolved relation/edge.
+	ReportsResolvedTable = "reports"
+	// ReportsResolvedInverseTable is the table name for the Report entity.
+	// It exists in this package in order to avoid circular dependency with the "report" package.
+	ReportsResolvedInverseTable = "reports"
+	// ReportsResolvedColumn is the table column denoting the reports_resolved relation/edge.
+	ReportsResolvedColumn = "resolver_id"
+	// SuspensionsTable is the table that holds the suspensions relation/edge.
+	SuspensionsTable = "suspensions"
+	// SuspensionsInverseTable is the table name for the Suspension entity.
+	// It exists in this package in order to avoid circular dependency with the "suspension" package.
+	SuspensionsInverseTable = "suspensions"
+	// SuspensionsColumn is the table column denoting the suspensions relation/edge.
+	SuspensionsColumn = "user_id"
+	// SuspensionsIssuedTable is the table that holds the suspensions_issued relation/edge.
+	SuspensionsIssuedTable = "suspensions"
+	// SuspensionsIssuedInverseTable is the table name for the Suspension entity.
+	// It exists in this package in order to avoid circular dependency with the "suspension" package.
+	SuspensionsIssuedInverseTable = "suspensions"
+	// SuspensionsIssuedColumn is the table column denoting the suspensions_issued relation/edge.
+	SuspensionsIssuedColumn = "issued_by_id"
+	// MutingTable is the table that holds the muting relation/edge.
+	MutingTable = "mute_relations"
+	// MutingInverseTable is the table name for the MuteRelation entity.
+	// It exists in this package in order to avoid circular dependency with the "muterelation" package.
+	MutingInverseTable = "mute_relations"
+	// MutingColumn is the table column denoting the muting relation/edge.
+	MutingColumn = "user_muting"
+	// MutedByTable is the table that holds the muted_by relation/edge.
+	MutedByTable = "mute_relations"
+	// MutedByInverseTable is the table name for the MuteRelation entity.
+	// It exists in this package in order to avoid circular dependency with the "muterelation" package.
+	MutedByInverseTable = "mute_relations"
+	// MutedByColumn is the table column denoting the muted_by relation/edge.
+	MutedByColumn = "user_muted_by"
+	// MutedKeywordsTable is the table that holds the muted_keywords relation/edge.
+	MutedKeywordsTable = "muted_keywords"
+	// MutedKeywordsInverseTable is the table name for the MutedKeyword entity.
+	// It exists in this package in order to avoid circular dependency with the "mutedkeyword" package.
+	MutedKeywordsInverseTable = "muted_keywords"
+	// MutedKeywordsColumn is the table column denoting the muted_keywords relation/edge.
+	MutedKeywordsColumn = "user_id"
+	// FollowRequestsSentTable is the table that holds the follow_requests_sent relation/edge.
+	FollowRequestsSentTable = "follow_requests"
+	// FollowRequestsSentInverseTable is the table name for the FollowRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "followrequest" package.
+	FollowRequestsSentInverseTable = "follow_requests"
+	// FollowRequestsSentColumn is the table column denoting the follow_requests_sent relation/edge.
+	FollowRequestsSentColumn = "user_follow_requests_sent"
+	// FollowRequestsReceivedTable is the table that holds the follow_requests_received relation/edge.
+	FollowRequestsReceivedTable = "follow_requests"
+	// FollowRequestsReceivedInverseTable is the table name for the FollowRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "followrequest" package.
+	FollowRequestsReceivedInverseTable = "follow_requests"
+	// FollowRequestsReceivedColumn is the table column denoting the follow_requests_received relation/edge.
+	FollowRequestsReceivedColumn = "user_follow_requests_received"
+	// MentionedInPostsTable is the table that holds the mentioned_in_posts relation/edge. The primary key declared below.
+	MentionedInPostsTable = "post_mentions"
+	// MentionedInPostsInverseTable is the table name for the Post entity.
+	// It exists in this package in order to avoid circular dependency with the "post" package.
+	MentionedInPostsInverseTable = "posts"
+	// MentionedInCommentsTable is the table that holds the mentioned_in_comments relation/edge. The primary key declared below.
+	MentionedInCommentsTable = "comment_mentions"
+	// MentionedInCommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	MentionedInCommentsInverseTable = "comments"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "recipient_id"
+	// NotificationsSentTable is the table that holds the notifications_sent relation/edge.
+	NotificationsSentTable = "notifications"
+	// NotificationsSentInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsSentInverseTable = "notifications"
+	// NotificationsSentColumn is the table column denoting the notifications_sent relation/edge.
+	NotificationsSentColumn = "actor_id"
+	// CommentLikesTable is the table that holds the comment_likes relation/edge.
+	CommentLikesTable = "comment_likes"
+	// CommentLikesInverseTable is the table name for the CommentLike entity.
+	// It exists in this package in order to avoid circular dependency with the "commentlike" package.
+	CommentLikesInverseTable = "comment_likes"
+	// CommentLikesColumn is the table column denoting the comment_likes relation/edge.
+	CommentLikesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -128,13 +274,29 @@ var Columns = []string{
 	FieldID,
 	FieldIndex,
 	FieldEmail,
+	FieldAuthSubject,
 	FieldName,
+	FieldHandle,
 	FieldBio,
 	FieldStreakCount,
+	FieldRole,
 	FieldIconImageKey,
+	FieldIsPrivate,
 	FieldCreatedAt,
+	FieldFollowerCount,
+	FieldFollowingCount,
+	FieldPostCount,
 }
 
+var (
+	// MentionedInPostsPrimaryKey and MentionedInPostsColumn2 are the table columns denoting the
+	// primary key for the mentioned_in_posts relation (M2M).
+	MentionedInPostsPrimaryKey = []string{"post_id", "user_id"}
+	// MentionedInCommentsPrimaryKey and MentionedInCommentsColumn2 are the table columns denoting the
+	// primary key for the mentioned_in_comments relation (M2M).
+	MentionedInCommentsPrimaryKey = []string{"comment_id", "user_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -154,8 +316,18 @@ var (
 	DefaultBio string
 	// DefaultStreakCount holds the default value on creation for the "streak_count" field.
 	DefaultStreakCount uint32
+	// DefaultRole holds the default value on creation for the "role" field.
+	DefaultRole enum.Role
+	// DefaultIsPrivate holds the default value on creation for the "is_private" field.
+	DefaultIsPrivate bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultFollowerCount holds the default value on creation for the "follower_count" field.
+	DefaultFollowerCount int
+	// DefaultFollowingCount holds the default value on creation for the "following_count" field.
+	DefaultFollowingCount int
+	// DefaultPostCount holds the default value on creation for the "post_count" field.
+	DefaultPostCount int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -178,11 +350,21 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByAuthSubject orders the results by the auth_subject field.
+func ByAuthSubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthSubject, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByHandle orders the results by the handle field.
+func ByHandle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandle, opts...).ToFunc()
+}
+
 // ByBio orders the results by the bio field.
 func ByBio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBio, opts...).ToFunc()
@@ -193,16 +375,41 @@ func ByStreakCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStreakCount, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
 // ByIconImageKey orders the results by the icon_image_key field.
 func ByIconImageKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIconImageKey, opts...).ToFunc()
 }
 
+// ByIsPrivate orders the results by the is_private field.
+func ByIsPrivate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPrivate, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByFollowerCount orders the results by the follower_count field.
+func ByFollowerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowerCount, opts...).ToFunc()
+}
+
+// ByFollowingCountField orders the results by the following_count field.
+func ByFollowingCountField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowingCount, opts...).ToFunc()
+}
+
+// ByPostCount orders the results by the post_count field.
+func ByPostCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostCount, opts...).ToFunc()
+}
+
 // ByPostsCount orders the results by posts count.
 func ByPostsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -342,6 +549,216 @@ func ByDeviceTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDeviceTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByReportsFiledCount orders the results by reports_filed count.
+func ByReportsFiledCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportsFiledStep(), opts...)
+	}
+}
+
+// ByReportsFiled orders the results by reports_filed terms.
+func ByReportsFiled(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportsFiledStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReportsReceivedCount orders the results by reports_received count.
+func ByReportsReceivedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportsReceivedStep(), opts...)
+	}
+}
+
+// ByReportsReceived orders the results by reports_received terms.
+func ByReportsReceived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportsReceivedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReportsResolvedCount orders the results by reports_resolved count.
+func ByReportsResolvedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReportsResolvedStep(), opts...)
+	}
+}
+
+// ByReportsResolved orders the results by reports_resolved terms.
+func ByReportsResolved(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReportsResolvedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySuspensionsCount orders the results by suspensions count.
+func BySuspensionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSuspensionsStep(), opts...)
+	}
+}
+
+// BySuspensions orders the results by suspensions terms.
+func BySuspensions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSuspensionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySuspensionsIssuedCount orders the results by suspensions_issued count.
+func BySuspensionsIssuedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSuspensionsIssuedStep(), opts...)
+	}
+}
+
+// BySuspensionsIssued orders the results by suspensions_issued terms.
+func BySuspensionsIssued(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSuspensionsIssuedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutingCount orders the results by muting count.
+func ByMutingCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutingStep(), opts...)
+	}
+}
+
+// ByMuting orders the results by muting terms.
+func ByMuting(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutingStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutedByCount orders the results by muted_by count.
+func ByMutedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutedByStep(), opts...)
+	}
+}
+
+// ByMutedBy orders the results by muted_by terms.
+func ByMutedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMutedKeywordsCount orders the results by muted_keywords count.
+func ByMutedKeywordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMutedKeywordsStep(), opts...)
+	}
+}
+
+// ByMutedKeywords orders the results by muted_keywords terms.
+func ByMutedKeywords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMutedKeywordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowRequestsSentCount orders the results by follow_requests_sent count.
+func ByFollowRequestsSentCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowRequestsSentStep(), opts...)
+	}
+}
+
+// ByFollowRequestsSent orders the results by follow_requests_sent terms.
+func ByFollowRequestsSent(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowRequestsSentStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowRequestsReceivedCount orders the results by follow_requests_received count.
+func ByFollowRequestsReceivedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowRequestsReceivedStep(), opts...)
+	}
+}
+
+// ByFollowRequestsReceived orders the results by follow_requests_received terms.
+func ByFollowRequestsReceived(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowRequestsReceivedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionedInPostsCount orders the results by mentioned_in_posts count.
+func ByMentionedInPostsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionedInPostsStep(), opts...)
+	}
+}
+
+// ByMentionedInPosts orders the results by mentioned_in_posts terms.
+func ByMentionedInPosts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionedInPostsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionedInCommentsCount orders the results by mentioned_in_comments count.
+func ByMentionedInCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionedInCommentsStep(), opts...)
+	}
+}
+
+// ByMentionedInComments orders the results by mentioned_in_comments terms.
+func ByMentionedInComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionedInCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsSentCount orders the results by notifications_sent count.
+func ByNotificationsSentCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsSentStep(), opts...)
+	}
+}
+
+// ByNotificationsSent orders the results by notifications_sent terms.
+func ByNotificationsSent(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsSentStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCommentLikesCount orders the results by comment_likes count.
+func ByCommentLikesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentLikesStep(), opts...)
+	}
+}
+
+// ByCommentLikes orders the results by comment_likes terms.
+func ByCommentLikes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentLikesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -412,3 +829,108 @@ func newDeviceTokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DeviceTokensTable, DeviceTokensColumn),
 	)
 }
+func newReportsFiledStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportsFiledInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReportsFiledTable, ReportsFiledColumn),
+	)
+}
+func newReportsReceivedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportsReceivedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReportsReceivedTable, ReportsReceivedColumn),
+	)
+}
+func newReportsResolvedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReportsResolvedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReportsResolvedTable, ReportsResolvedColumn),
+	)
+}
+func newSuspensionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SuspensionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SuspensionsTable, SuspensionsColumn),
+	)
+}
+func newSuspensionsIssuedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SuspensionsIssuedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SuspensionsIssuedTable, SuspensionsIssuedColumn),
+	)
+}
+func newMutingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MutingTable, MutingColumn),
+	)
+}
+func newMutedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MutedByTable, MutedByColumn),
+	)
+}
+func newMutedKeywordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MutedKeywordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MutedKeywordsTable, MutedKeywordsColumn),
+	)
+}
+func newFollowRequestsSentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowRequestsSentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowRequestsSentTable, FollowRequestsSentColumn),
+	)
+}
+func newFollowRequestsReceivedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowRequestsReceivedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FollowRequestsReceivedTable, FollowRequestsReceivedColumn),
+	)
+}
+func newMentionedInPostsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionedInPostsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MentionedInPostsTable, MentionedInPostsPrimaryKey...),
+	)
+}
+func newMentionedInCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionedInCommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MentionedInCommentsTable, MentionedInCommentsPrimaryKey...),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newNotificationsSentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsSentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsSentTable, NotificationsSentColumn),
+	)
+}
+func newCommentLikesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentLikesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentLikesTable, CommentLikesColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -66,11 +67,21 @@ func Email(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
 }
 
+// AuthSubject applies equality check predicate on the "auth_subject" field. It's identical to AuthSubjectEQ.
+func AuthSubject(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAuthSubject, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
 }
 
+// Handle applies equality check predicate on the "handle" field. It's identical to HandleEQ.
+func Handle(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHandle, v))
+}
+
 // Bio applies equality check predicate on the "bio" field. It's identical to BioEQ.
 func Bio(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBio, v))
@@ -81,16 +92,42 @@ func StreakCount(v uint32) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStreakCount, v))
 }
 
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldEQ(FieldRole, vc))
+}
+
 // IconImageKey applies equality check predicate on the "icon_image_key" field. It's identical to IconImageKeyEQ.
 func IconImageKey(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIconImageKey, v))
 }
 
+// IsPrivate applies equality check predicate on the "is_private" field. It's identical to IsPrivateEQ.
+func IsPrivate(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsPrivate, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// FollowerCount applies equality check predicate on the "follower_count" field. It's identical to FollowerCountEQ.
+func FollowerCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowerCount, v))
+}
+
+// FollowingCount applies equality check predicate on the "following_count" field. It's identical to FollowingCountEQ.
+func FollowingCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowingCount, v))
+}
+
+// PostCount applies equality check predicate on the "post_count" field. It's identical to PostCountEQ.
+func PostCount(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPostCount, v))
+}
+
 // IndexEQ applies the EQ predicate on the "index" field.
 func IndexEQ(v uint32) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIndex, v))
@@ -206,6 +243,81 @@ func EmailContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// AuthSubjectEQ applies the EQ predicate on the "auth_subject" field.
+func AuthSubjectEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAuthSubject, v))
+}
+
+// AuthSubjectNEQ applies the NEQ predicate on the "auth_subject" field.
+func AuthSubjectNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAuthSubject, v))
+}
+
+// AuthSubjectIn applies the In predicate on the "auth_subject" field.
+func AuthSubjectIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldAuthSubject, vs...))
+}
+
+// AuthSubjectNotIn applies the NotIn predicate on the "auth_subject" field.
+func AuthSubjectNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldAuthSubject, vs...))
+}
+
+// AuthSubjectGT applies the GT predicate on the "auth_subject" field.
+func AuthSubjectGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldAuthSubject, v))
+}
+
+// AuthSubjectGTE applies the GTE predicate on the "auth_subject" field.
+func AuthSubjectGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldAuthSubject, v))
+}
+
+// AuthSubjectLT applies the LT predicate on the "auth_subject" field.
+func AuthSubjectLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldAuthSubject, v))
+}
+
+// AuthSubjectLTE applies the LTE predicate on the "auth_subject" field.
+func AuthSubjectLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldAuthSubject, v))
+}
+
+// AuthSubjectContains applies the Contains predicate on the "auth_subject" field.
+func AuthSubjectContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldAuthSubject, v))
+}
+
+// AuthSubjectHasPrefix applies the HasPrefix predicate on the "auth_subject" field.
+func AuthSubjectHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldAuthSubject, v))
+}
+
+// AuthSubjectHasSuffix applies the HasSuffix predicate on the "auth_subject" field.
+func AuthSubjectHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldAuthSubject, v))
+}
+
+// AuthSubjectIsNil applies the IsNil predicate on the "auth_subject" field.
+func AuthSubjectIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldAuthSubject))
+}
+
+// AuthSubjectNotNil applies the NotNil predicate on the "auth_subject" field.
+func AuthSubjectNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldAuthSubject))
+}
+
+// AuthSubjectEqualFold applies the EqualFold predicate on the "auth_subject" field.
+func AuthSubjectEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldAuthSubject, v))
+}
+
+// AuthSubjectContainsFold applies the ContainsFold predicate on the "auth_subject" field.
+func AuthSubjectContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldAuthSubject, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
@@ -271,6 +383,81 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// HandleEQ applies the EQ predicate on the "handle" field.
+func HandleEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHandle, v))
+}
+
+// HandleNEQ applies the NEQ predicate on the "handle" field.
+func HandleNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldHandle, v))
+}
+
+// HandleIn applies the In predicate on the "handle" field.
+func HandleIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldHandle, vs...))
+}
+
+// HandleNotIn applies the NotIn predicate on the "handle" field.
+func HandleNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldHandle, vs...))
+}
+
+// HandleGT applies the GT predicate on the "handle" field.
+func HandleGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldHandle, v))
+}
+
+// HandleGTE applies the GTE predicate on the "handle" field.
+func HandleGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldHandle, v))
+}
+
+// HandleLT applies the LT predicate on the "handle" field.
+func HandleLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldHandle, v))
+}
+
+// HandleLTE applies the LTE predicate on the "handle" field.
+func HandleLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldHandle, v))
+}
+
+// HandleContains applies the Contains predicate on the "handle" field.
+func HandleContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldHandle, v))
+}
+
+// HandleHasPrefix applies the HasPrefix predicate on the "handle" field.
+func HandleHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldHandle, v))
+}
+
+// HandleHasSuffix applies the HasSuffix predicate on the "handle" field.
+func HandleHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldHandle, v))
+}
+
+// HandleIsNil applies the IsNil predicate on the "handle" field.
+func HandleIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldHandle))
+}
+
+// HandleNotNil applies the NotNil predicate on the "handle" field.
+func HandleNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldHandle))
+}
+
+// HandleEqualFold applies the EqualFold predicate on the "handle" field.
+func HandleEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldHandle, v))
+}
+
+// HandleContainsFold applies the ContainsFold predicate on the "handle" field.
+func HandleContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldHandle, v))
+}
+
 // BioEQ applies the EQ predicate on the "bio" field.
 func BioEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldBio, v))
@@ -376,6 +563,90 @@ func StreakCountLTE(v uint32) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldStreakCount, v))
 }
 
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldEQ(FieldRole, vc))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldNEQ(FieldRole, vc))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...enum.Role) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.User(sql.FieldIn(FieldRole, v...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...enum.Role) predicate.User {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.User(sql.FieldNotIn(FieldRole, v...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldGT(FieldRole, vc))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldGTE(FieldRole, vc))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldLT(FieldRole, vc))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldLTE(FieldRole, vc))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldContains(FieldRole, vc))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldHasPrefix(FieldRole, vc))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldHasSuffix(FieldRole, vc))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldEqualFold(FieldRole, vc))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v enum.Role) predicate.User {
+	vc := string(v)
+	return predicate.User(sql.FieldContainsFold(FieldRole, vc))
+}
+
 // IconImageKeyEQ applies the EQ predicate on the "icon_image_key" field.
 func IconImageKeyEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIconImageKey, v))
@@ -451,6 +722,16 @@ func IconImageKeyContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldIconImageKey, v))
 }
 
+// IsPrivateEQ applies the EQ predicate on the "is_private" field.
+func IsPrivateEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsPrivate, v))
+}
+
+// IsPrivateNEQ applies the NEQ predicate on the "is_private" field.
+func IsPrivateNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldIsPrivate, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -491,6 +772,126 @@ func CreatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// FollowerCountEQ applies the EQ predicate on the "follower_count" field.
+func FollowerCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowerCount, v))
+}
+
+// FollowerCountNEQ applies the NEQ predicate on the "follower_count" field.
+func FollowerCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFollowerCount, v))
+}
+
+// FollowerCountIn applies the In predicate on the "follower_count" field.
+func FollowerCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFollowerCount, vs...))
+}
+
+// FollowerCountNotIn applies the NotIn predicate on the "follower_count" field.
+func FollowerCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFollowerCount, vs...))
+}
+
+// FollowerCountGT applies the GT predicate on the "follower_count" field.
+func FollowerCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFollowerCount, v))
+}
+
+// FollowerCountGTE applies the GTE predicate on the "follower_count" field.
+func FollowerCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFollowerCount, v))
+}
+
+// FollowerCountLT applies the LT predicate on the "follower_count" field.
+func FollowerCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFollowerCount, v))
+}
+
+// FollowerCountLTE applies the LTE predicate on the "follower_count" field.
+func FollowerCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFollowerCount, v))
+}
+
+// FollowingCountEQ applies the EQ predicate on the "following_count" field.
+func FollowingCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowingCount, v))
+}
+
+// FollowingCountNEQ applies the NEQ predicate on the "following_count" field.
+func FollowingCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFollowingCount, v))
+}
+
+// FollowingCountIn applies the In predicate on the "following_count" field.
+func FollowingCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFollowingCount, vs...))
+}
+
+// FollowingCountNotIn applies the NotIn predicate on the "following_count" field.
+func FollowingCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFollowingCount, vs...))
+}
+
+// FollowingCountGT applies the GT predicate on the "following_count" field.
+func FollowingCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFollowingCount, v))
+}
+
+// FollowingCountGTE applies the GTE predicate on the "following_count" field.
+func FollowingCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFollowingCount, v))
+}
+
+// FollowingCountLT applies the LT predicate on the "following_count" field.
+func FollowingCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFollowingCount, v))
+}
+
+// FollowingCountLTE applies the LTE predicate on the "following_count" field.
+func FollowingCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFollowingCount, v))
+}
+
+// PostCountEQ applies the EQ predicate on the "post_count" field.
+func PostCountEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPostCount, v))
+}
+
+// PostCountNEQ applies the NEQ predicate on the "post_count" field.
+func PostCountNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPostCount, v))
+}
+
+// PostCountIn applies the In predicate on the "post_count" field.
+func PostCountIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPostCount, vs...))
+}
+
+// PostCountNotIn applies the NotIn predicate on the "post_count" field.
+func PostCountNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPostCount, vs...))
+}
+
+// PostCountGT applies the GT predicate on the "post_count" field.
+func PostCountGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPostCount, v))
+}
+
+// PostCountGTE applies the GTE predicate on the "post_count" field.
+func PostCountGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPostCount, v))
+}
+
+// PostCountLT applies the LT predicate on the "post_count" field.
+func PostCountLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPostCount, v))
+}
+
+// PostCountLTE applies the LTE predicate on the "post_count" field.
+func PostCountLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPostCount, v))
+}
+
 // HasPosts applies the HasEdge predicate on the "posts" edge.
 func HasPosts() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -721,6 +1122,351 @@ func HasDeviceTokensWith(preds ...predicate.DeviceToken) predicate.User {
 	})
 }
 
+// HasReportsFiled applies the HasEdge predicate on the "reports_filed" edge.
+func HasReportsFiled() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReportsFiledTable, ReportsFiledColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportsFiledWith applies the HasEdge predicate on the "reports_filed" edge with a given conditions (other predicates).
+func HasReportsFiledWith(preds ...predicate.Report) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReportsFiledStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReportsReceived applies the HasEdge predicate on the "reports_received" edge.
+func HasReportsReceived() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReportsReceivedTable, ReportsReceivedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportsReceivedWith applies the HasEdge predicate on the "reports_received" edge with a given conditions (other predicates).
+func HasReportsReceivedWith(preds ...predicate.Report) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReportsReceivedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReportsResolved applies the HasEdge predicate on the "reports_resolved" edge.
+func HasReportsResolved() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReportsResolvedTable, ReportsResolvedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReportsResolvedWith applies the HasEdge predicate on the "reports_resolved" edge with a given conditions (other predicates).
+func HasReportsResolvedWith(preds ...predicate.Report) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReportsResolvedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSuspensions applies the HasEdge predicate on the "suspensions" edge.
+func HasSuspensions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SuspensionsTable, SuspensionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSuspensionsWith applies the HasEdge predicate on the "suspensions" edge with a given conditions (other predicates).
+func HasSuspensionsWith(preds ...predicate.Suspension) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSuspensionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSuspensionsIssued applies the HasEdge predicate on the "suspensions_issued" edge.
+func HasSuspensionsIssued() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SuspensionsIssuedTable, SuspensionsIssuedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSuspensionsIssuedWith applies the HasEdge predicate on the "suspensions_issued" edge with a given conditions (other predicates).
+func HasSuspensionsIssuedWith(preds ...predicate.Suspension) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSuspensionsIssuedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMuting applies the HasEdge predicate on the "muting" edge.
+func HasMuting() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MutingTable, MutingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutingWith applies the HasEdge predicate on the "muting" edge with a given conditions (other predicates).
+func HasMutingWith(preds ...predicate.MuteRelation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMutingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMutedBy applies the HasEdge predicate on the "muted_by" edge.
+func HasMutedBy() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MutedByTable, MutedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutedByWith applies the HasEdge predicate on the "muted_by" edge with a given conditions (other predicates).
+func HasMutedByWith(preds ...predicate.MuteRelation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMutedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMutedKeywords applies the HasEdge predicate on the "muted_keywords" edge.
+func HasMutedKeywords() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MutedKeywordsTable, MutedKeywordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMutedKeywordsWith applies the HasEdge predicate on the "muted_keywords" edge with a given conditions (other predicates).
+func HasMutedKeywordsWith(preds ...predicate.MutedKeyword) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMutedKeywordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowRequestsSent applies the HasEdge predicate on the "follow_requests_sent" edge.
+func HasFollowRequestsSent() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FollowRequestsSentTable, FollowRequestsSentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowRequestsSentWith applies the HasEdge predicate on the "follow_requests_sent" edge with a given conditions (other predicates).
+func HasFollowRequestsSentWith(preds ...predicate.FollowRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFollowRequestsSentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowRequestsReceived applies the HasEdge predicate on the "follow_requests_received" edge.
+func HasFollowRequestsReceived() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FollowRequestsReceivedTable, FollowRequestsReceivedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowRequestsReceivedWith applies the HasEdge predicate on the "follow_requests_received" edge with a given conditions (other predicates).
+func HasFollowRequestsReceivedWith(preds ...predicate.FollowRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFollowRequestsReceivedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentionedInPosts applies the HasEdge predicate on the "mentioned_in_posts" edge.
+func HasMentionedInPosts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, MentionedInPostsTable, MentionedInPostsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionedInPostsWith applies the HasEdge predicate on the "mentioned_in_posts" edge with a given conditions (other predicates).
+func HasMentionedInPostsWith(preds ...predicate.Post) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMentionedInPostsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentionedInComments applies the HasEdge predicate on the "mentioned_in_comments" edge.
+func HasMentionedInComments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, MentionedInCommentsTable, MentionedInCommentsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionedInCommentsWith applies the HasEdge predicate on the "mentioned_in_comments" edge with a given conditions (other predicates).
+func HasMentionedInCommentsWith(preds ...predicate.Comment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMentionedInCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationsSent applies the HasEdge predicate on the "notifications_sent" edge.
+func HasNotificationsSent() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsSentTable, NotificationsSentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsSentWith applies the HasEdge predicate on the "notifications_sent" edge with a given conditions (other predicates).
+func HasNotificationsSentWith(preds ...predicate.Notification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationsSentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCommentLikes applies the HasEdge predicate on the "comment_likes" edge.
+func HasCommentLikes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentLikesTable, CommentLikesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentLikesWith applies the HasEdge predicate on the "comment_likes" edge with a given conditions (other predicates).
+func HasCommentLikesWith(preds ...predicate.CommentLike) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCommentLikesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
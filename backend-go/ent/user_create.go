@@ -14,12 +14,20 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/devicetoken"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -52,12 +60,40 @@ func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	return uc
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (uc *UserCreate) SetAuthSubject(s string) *UserCreate {
+	uc.mutation.SetAuthSubject(s)
+	return uc
+}
+
+// SetNillableAuthSubject sets the "auth_subject" field if the given value is not nil.
+func (uc *UserCreate) SetNillableAuthSubject(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAuthSubject(*s)
+	}
+	return uc
+}
+
 // SetName sets the "name" field.
 func (uc *UserCreate) SetName(s string) *UserCreate {
 	uc.mutation.SetName(s)
 	return uc
 }
 
+// SetHandle sets the "handle" field.
+func (uc *UserCreate) SetHandle(s string) *UserCreate {
+	uc.mutation.SetHandle(s)
+	return uc
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (uc *UserCreate) SetNillableHandle(s *string) *UserCreate {
+	if s != nil {
+		uc.SetHandle(*s)
+	}
+	return uc
+}
+
 // SetBio sets the "bio" field.
 func (uc *UserCreate) SetBio(s string) *UserCreate {
 	uc.mutation.SetBio(s)
@@ -86,6 +122,20 @@ func (uc *UserCreate) SetNillableStreakCount(u *uint32) *UserCreate {
 	return uc
 }
 
+// SetRole sets the "role" field.
+func (uc *UserCreate) SetRole(e enum.Role) *UserCreate {
+	uc.mutation.SetRole(e)
+	return uc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(e *enum.Role) *UserCreate {
+	if e != nil {
+		uc.SetRole(*e)
+	}
+	return uc
+}
+
 // SetIconImageKey sets the "icon_image_key" field.
 func (uc *UserCreate) SetIconImageKey(s string) *UserCreate {
 	uc.mutation.SetIconImageKey(s)
@@ -100,6 +150,20 @@ func (uc *UserCreate) SetNillableIconImageKey(s *string) *UserCreate {
 	return uc
 }
 
+// SetIsPrivate sets the "is_private" field.
+func (uc *UserCreate) SetIsPrivate(b bool) *UserCreate {
+	uc.mutation.SetIsPrivate(b)
+	return uc
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsPrivate(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsPrivate(*b)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -114,6 +178,48 @@ func (uc *UserCreate) SetNillableCreatedAt(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetFollowerCount sets the "follower_count" field.
+func (uc *UserCreate) SetFollowerCount(i int) *UserCreate {
+	uc.mutation.SetFollowerCount(i)
+	return uc
+}
+
+// SetNillableFollowerCount sets the "follower_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFollowerCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFollowerCount(*i)
+	}
+	return uc
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (uc *UserCreate) SetFollowingCount(i int) *UserCreate {
+	uc.mutation.SetFollowingCount(i)
+	return uc
+}
+
+// SetNillableFollowingCount sets the "following_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFollowingCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFollowingCount(*i)
+	}
+	return uc
+}
+
+// SetPostCount sets the "post_count" field.
+func (uc *UserCreate) SetPostCount(i int) *UserCreate {
+	uc.mutation.SetPostCount(i)
+	return uc
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePostCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetPostCount(*i)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -278,6 +384,231 @@ func (uc *UserCreate) AddDeviceTokens(d ...*DeviceToken) *UserCreate {
 	return uc.AddDeviceTokenIDs(ids...)
 }
 
+// AddReportsFiledIDs adds the "reports_filed" edge to the Report entity by IDs.
+func (uc *UserCreate) AddReportsFiledIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddReportsFiledIDs(ids...)
+	return uc
+}
+
+// AddReportsFiled adds the "reports_filed" edges to the Report entity.
+func (uc *UserCreate) AddReportsFiled(r ...*Report) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddReportsFiledIDs(ids...)
+}
+
+// AddReportsReceivedIDs adds the "reports_received" edge to the Report entity by IDs.
+func (uc *UserCreate) AddReportsReceivedIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddReportsReceivedIDs(ids...)
+	return uc
+}
+
+// AddReportsReceived adds the "reports_received" edges to the Report entity.
+func (uc *UserCreate) AddReportsReceived(r ...*Report) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddReportsReceivedIDs(ids...)
+}
+
+// AddReportsResolvedIDs adds the "reports_resolved" edge to the Report entity by IDs.
+func (uc *UserCreate) AddReportsResolvedIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddReportsResolvedIDs(ids...)
+	return uc
+}
+
+// AddReportsResolved adds the "reports_resolved" edges to the Report entity.
+func (uc *UserCreate) AddReportsResolved(r ...*Report) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddReportsResolvedIDs(ids...)
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by IDs.
+func (uc *UserCreate) AddSuspensionIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddSuspensionIDs(ids...)
+	return uc
+}
+
+// AddSuspensions adds the "suspensions" edges to the Suspension entity.
+func (uc *UserCreate) AddSuspensions(s ...*Suspension) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSuspensionIDs(ids...)
+}
+
+// AddSuspensionsIssuedIDs adds the "suspensions_issued" edge to the Suspension entity by IDs.
+func (uc *UserCreate) AddSuspensionsIssuedIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddSuspensionsIssuedIDs(ids...)
+	return uc
+}
+
+// AddSuspensionsIssued adds the "suspensions_issued" edges to the Suspension entity.
+func (uc *UserCreate) AddSuspensionsIssued(s ...*Suspension) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSuspensionsIssuedIDs(ids...)
+}
+
+// AddMutingIDs adds the "muting" edge to the MuteRelation entity by IDs.
+func (uc *UserCreate) AddMutingIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMutingIDs(ids...)
+	return uc
+}
+
+// AddMuting adds the "muting" edges to the MuteRelation entity.
+func (uc *UserCreate) AddMuting(m ...*MuteRelation) *UserCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMutingIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the MuteRelation entity by IDs.
+func (uc *UserCreate) AddMutedByIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMutedByIDs(ids...)
+	return uc
+}
+
+// AddMutedBy adds the "muted_by" edges to the MuteRelation entity.
+func (uc *UserCreate) AddMutedBy(m ...*MuteRelation) *UserCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMutedByIDs(ids...)
+}
+
+// AddMutedKeywordIDs adds the "muted_keywords" edge to the MutedKeyword entity by IDs.
+func (uc *UserCreate) AddMutedKeywordIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMutedKeywordIDs(ids...)
+	return uc
+}
+
+// AddMutedKeywords adds the "muted_keywords" edges to the MutedKeyword entity.
+func (uc *UserCreate) AddMutedKeywords(m ...*MutedKeyword) *UserCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMutedKeywordIDs(ids...)
+}
+
+// AddFollowRequestsSentIDs adds the "follow_requests_sent" edge to the FollowRequest entity by IDs.
+func (uc *UserCreate) AddFollowRequestsSentIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddFollowRequestsSentIDs(ids...)
+	return uc
+}
+
+// AddFollowRequestsSent adds the "follow_requests_sent" edges to the FollowRequest entity.
+func (uc *UserCreate) AddFollowRequestsSent(f ...*FollowRequest) *UserCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFollowRequestsSentIDs(ids...)
+}
+
+// AddFollowRequestsReceivedIDs adds the "follow_requests_received" edge to the FollowRequest entity by IDs.
+func (uc *UserCreate) AddFollowRequestsReceivedIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddFollowRequestsReceivedIDs(ids...)
+	return uc
+}
+
+// AddFollowRequestsReceived adds the "follow_requests_received" edges to the FollowRequest entity.
+func (uc *UserCreate) AddFollowRequestsReceived(f ...*FollowRequest) *UserCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFollowRequestsReceivedIDs(ids...)
+}
+
+// AddMentionedInPostIDs adds the "mentioned_in_posts" edge to the Post entity by IDs.
+func (uc *UserCreate) AddMentionedInPostIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMentionedInPostIDs(ids...)
+	return uc
+}
+
+// AddMentionedInPosts adds the "mentioned_in_posts" edges to the Post entity.
+func (uc *UserCreate) AddMentionedInPosts(p ...*Post) *UserCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddMentionedInPostIDs(ids...)
+}
+
+// AddMentionedInCommentIDs adds the "mentioned_in_comments" edge to the Comment entity by IDs.
+func (uc *UserCreate) AddMentionedInCommentIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMentionedInCommentIDs(ids...)
+	return uc
+}
+
+// AddMentionedInComments adds the "mentioned_in_comments" edges to the Comment entity.
+func (uc *UserCreate) AddMentionedInComments(c ...*Comment) *UserCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddMentionedInCommentIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uc *UserCreate) AddNotificationIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddNotificationIDs(ids...)
+	return uc
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uc *UserCreate) AddNotifications(n ...*Notification) *UserCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNotificationIDs(ids...)
+}
+
+// AddNotificationsSentIDs adds the "notifications_sent" edge to the Notification entity by IDs.
+func (uc *UserCreate) AddNotificationsSentIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddNotificationsSentIDs(ids...)
+	return uc
+}
+
+// AddNotificationsSent adds the "notifications_sent" edges to the Notification entity.
+func (uc *UserCreate) AddNotificationsSent(n ...*Notification) *UserCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNotificationsSentIDs(ids...)
+}
+
+// AddCommentLikeIDs adds the "comment_likes" edge to the CommentLike entity by IDs.
+func (uc *UserCreate) AddCommentLikeIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddCommentLikeIDs(ids...)
+	return uc
+}
+
+// AddCommentLikes adds the "comment_likes" edges to the CommentLike entity.
+func (uc *UserCreate) AddCommentLikes(c ...*CommentLike) *UserCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCommentLikeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -321,10 +652,30 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultStreakCount
 		uc.mutation.SetStreakCount(v)
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+	if _, ok := uc.mutation.IsPrivate(); !ok {
+		v := user.DefaultIsPrivate
+		uc.mutation.SetIsPrivate(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := uc.mutation.FollowerCount(); !ok {
+		v := user.DefaultFollowerCount
+		uc.mutation.SetFollowerCount(v)
+	}
+	if _, ok := uc.mutation.FollowingCount(); !ok {
+		v := user.DefaultFollowingCount
+		uc.mutation.SetFollowingCount(v)
+	}
+	if _, ok := uc.mutation.PostCount(); !ok {
+		v := user.DefaultPostCount
+		uc.mutation.SetPostCount(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -355,9 +706,24 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.StreakCount(); !ok {
 		return &ValidationError{Name: "streak_count", err: errors.New(`ent: missing required field "User.streak_count"`)}
 	}
+	if _, ok := uc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if _, ok := uc.mutation.IsPrivate(); !ok {
+		return &ValidationError{Name: "is_private", err: errors.New(`ent: missing required field "User.is_private"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
+	if _, ok := uc.mutation.FollowerCount(); !ok {
+		return &ValidationError{Name: "follower_count", err: errors.New(`ent: missing required field "User.follower_count"`)}
+	}
+	if _, ok := uc.mutation.FollowingCount(); !ok {
+		return &ValidationError{Name: "following_count", err: errors.New(`ent: missing required field "User.following_count"`)}
+	}
+	if _, ok := uc.mutation.PostCount(); !ok {
+		return &ValidationError{Name: "post_count", err: errors.New(`ent: missing required field "User.post_count"`)}
+	}
 	return nil
 }
 
@@ -402,10 +768,18 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.AuthSubject(); ok {
+		_spec.SetField(user.FieldAuthSubject, field.TypeString, value)
+		_node.AuthSubject = value
+	}
 	if value, ok := uc.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := uc.mutation.Handle(); ok {
+		_spec.SetField(user.FieldHandle, field.TypeString, value)
+		_node.Handle = value
+	}
 	if value, ok := uc.mutation.Bio(); ok {
 		_spec.SetField(user.FieldBio, field.TypeString, value)
 		_node.Bio = value
@@ -414,23 +788,283 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldStreakCount, field.TypeUint32, value)
 		_node.StreakCount = value
 	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
 	if value, ok := uc.mutation.IconImageKey(); ok {
 		_spec.SetField(user.FieldIconImageKey, field.TypeString, value)
 		_node.IconImageKey = value
 	}
+	if value, ok := uc.mutation.IsPrivate(); ok {
+		_spec.SetField(user.FieldIsPrivate, field.TypeBool, value)
+		_node.IsPrivate = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
-	if nodes := uc.mutation.PostsIDs(); len(nodes) > 0 {
+	if value, ok := uc.mutation.FollowerCount(); ok {
+		_spec.SetField(user.FieldFollowerCount, field.TypeInt, value)
+		_node.FollowerCount = value
+	}
+	if value, ok := uc.mutation.FollowingCount(); ok {
+		_spec.SetField(user.FieldFollowingCount, field.TypeInt, value)
+		_node.FollowingCount = value
+	}
+	if value, ok := uc.mutation.PostCount(); ok {
+		_spec.SetField(user.FieldPostCount, field.TypeInt, value)
+		_node.PostCount = value
+	}
+	if nodes := uc.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LikesTable,
+			Columns: []string{user.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PetsTable,
+			Columns: []string{user.PetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DailyTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DailyTasksTable,
+			Columns: []string{user.DailyTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DeviceTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceTokensTable,
+			Columns: []string{user.DeviceTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ReportsFiledIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsFiledTable,
+			Columns: []string{user.ReportsFiledColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ReportsResolvedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SuspensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SuspensionsIssuedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsIssuedTable,
+			Columns: []string{user.SuspensionsIssuedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MutingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PostsTable,
-			Columns: []string{user.PostsColumn},
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -438,15 +1072,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.CommentsIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.MutedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CommentsTable,
-			Columns: []string{user.CommentsColumn},
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -454,15 +1088,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.MutedKeywordsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LikesTable,
-			Columns: []string{user.LikesColumn},
+			Table:   user.MutedKeywordsTable,
+			Columns: []string{user.MutedKeywordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -470,15 +1104,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.PetsIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.FollowRequestsSentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PetsTable,
-			Columns: []string{user.PetsColumn},
+			Table:   user.FollowRequestsSentTable,
+			Columns: []string{user.FollowRequestsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -486,15 +1120,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.FollowingIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.FollowRequestsReceivedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FollowingTable,
-			Columns: []string{user.FollowingColumn},
+			Table:   user.FollowRequestsReceivedTable,
+			Columns: []string{user.FollowRequestsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -502,15 +1136,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.FollowersIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.MentionedInPostsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.FollowersTable,
-			Columns: []string{user.FollowersColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInPostsTable,
+			Columns: user.MentionedInPostsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -518,15 +1152,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.BlockingIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.MentionedInCommentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   user.BlockingTable,
-			Columns: []string{user.BlockingColumn},
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInCommentsTable,
+			Columns: user.MentionedInCommentsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -534,15 +1168,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.BlockedByIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.NotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedByTable,
-			Columns: []string{user.BlockedByColumn},
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -550,15 +1184,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.DailyTasksIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.NotificationsSentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DailyTasksTable,
-			Columns: []string{user.DailyTasksColumn},
+			Table:   user.NotificationsSentTable,
+			Columns: []string{user.NotificationsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -566,15 +1200,15 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := uc.mutation.DeviceTokensIDs(); len(nodes) > 0 {
+	if nodes := uc.mutation.CommentLikesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DeviceTokensTable,
-			Columns: []string{user.DeviceTokensColumn},
+			Table:   user.CommentLikesTable,
+			Columns: []string{user.CommentLikesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -646,6 +1280,24 @@ func (u *UserUpsert) UpdateEmail() *UserUpsert {
 	return u
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (u *UserUpsert) SetAuthSubject(v string) *UserUpsert {
+	u.Set(user.FieldAuthSubject, v)
+	return u
+}
+
+// UpdateAuthSubject sets the "auth_subject" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAuthSubject() *UserUpsert {
+	u.SetExcluded(user.FieldAuthSubject)
+	return u
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (u *UserUpsert) ClearAuthSubject() *UserUpsert {
+	u.SetNull(user.FieldAuthSubject)
+	return u
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsert) SetName(v string) *UserUpsert {
 	u.Set(user.FieldName, v)
@@ -658,6 +1310,24 @@ func (u *UserUpsert) UpdateName() *UserUpsert {
 	return u
 }
 
+// SetHandle sets the "handle" field.
+func (u *UserUpsert) SetHandle(v string) *UserUpsert {
+	u.Set(user.FieldHandle, v)
+	return u
+}
+
+// UpdateHandle sets the "handle" field to the value that was provided on create.
+func (u *UserUpsert) UpdateHandle() *UserUpsert {
+	u.SetExcluded(user.FieldHandle)
+	return u
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (u *UserUpsert) ClearHandle() *UserUpsert {
+	u.SetNull(user.FieldHandle)
+	return u
+}
+
 // SetBio sets the "bio" field.
 func (u *UserUpsert) SetBio(v string) *UserUpsert {
 	u.Set(user.FieldBio, v)
@@ -688,6 +1358,18 @@ func (u *UserUpsert) AddStreakCount(v uint32) *UserUpsert {
 	return u
 }
 
+// SetRole sets the "role" field.
+func (u *UserUpsert) SetRole(v enum.Role) *UserUpsert {
+	u.Set(user.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsert) UpdateRole() *UserUpsert {
+	u.SetExcluded(user.FieldRole)
+	return u
+}
+
 // SetIconImageKey sets the "icon_image_key" field.
 func (u *UserUpsert) SetIconImageKey(v string) *UserUpsert {
 	u.Set(user.FieldIconImageKey, v)
@@ -706,6 +1388,18 @@ func (u *UserUpsert) ClearIconImageKey() *UserUpsert {
 	return u
 }
 
+// SetIsPrivate sets the "is_private" field.
+func (u *UserUpsert) SetIsPrivate(v bool) *UserUpsert {
+	u.Set(user.FieldIsPrivate, v)
+	return u
+}
+
+// UpdateIsPrivate sets the "is_private" field to the value that was provided on create.
+func (u *UserUpsert) UpdateIsPrivate() *UserUpsert {
+	u.SetExcluded(user.FieldIsPrivate)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserUpsert) SetCreatedAt(v time.Time) *UserUpsert {
 	u.Set(user.FieldCreatedAt, v)
@@ -718,6 +1412,60 @@ func (u *UserUpsert) UpdateCreatedAt() *UserUpsert {
 	return u
 }
 
+// SetFollowerCount sets the "follower_count" field.
+func (u *UserUpsert) SetFollowerCount(v int) *UserUpsert {
+	u.Set(user.FieldFollowerCount, v)
+	return u
+}
+
+// UpdateFollowerCount sets the "follower_count" field to the value that was provided on create.
+func (u *UserUpsert) UpdateFollowerCount() *UserUpsert {
+	u.SetExcluded(user.FieldFollowerCount)
+	return u
+}
+
+// AddFollowerCount adds v to the "follower_count" field.
+func (u *UserUpsert) AddFollowerCount(v int) *UserUpsert {
+	u.Add(user.FieldFollowerCount, v)
+	return u
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (u *UserUpsert) SetFollowingCount(v int) *UserUpsert {
+	u.Set(user.FieldFollowingCount, v)
+	return u
+}
+
+// UpdateFollowingCount sets the "following_count" field to the value that was provided on create.
+func (u *UserUpsert) UpdateFollowingCount() *UserUpsert {
+	u.SetExcluded(user.FieldFollowingCount)
+	return u
+}
+
+// AddFollowingCount adds v to the "following_count" field.
+func (u *UserUpsert) AddFollowingCount(v int) *UserUpsert {
+	u.Add(user.FieldFollowingCount, v)
+	return u
+}
+
+// SetPostCount sets the "post_count" field.
+func (u *UserUpsert) SetPostCount(v int) *UserUpsert {
+	u.Set(user.FieldPostCount, v)
+	return u
+}
+
+// UpdatePostCount sets the "post_count" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePostCount() *UserUpsert {
+	u.SetExcluded(user.FieldPostCount)
+	return u
+}
+
+// AddPostCount adds v to the "post_count" field.
+func (u *UserUpsert) AddPostCount(v int) *UserUpsert {
+	u.Add(user.FieldPostCount, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -783,6 +1531,27 @@ func (u *UserUpsertOne) UpdateEmail() *UserUpsertOne {
 	})
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (u *UserUpsertOne) SetAuthSubject(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAuthSubject(v)
+	})
+}
+
+// UpdateAuthSubject sets the "auth_subject" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAuthSubject() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAuthSubject()
+	})
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (u *UserUpsertOne) ClearAuthSubject() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAuthSubject()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsertOne) SetName(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -797,6 +1566,27 @@ func (u *UserUpsertOne) UpdateName() *UserUpsertOne {
 	})
 }
 
+// SetHandle sets the "handle" field.
+func (u *UserUpsertOne) SetHandle(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHandle(v)
+	})
+}
+
+// UpdateHandle sets the "handle" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateHandle() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHandle()
+	})
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (u *UserUpsertOne) ClearHandle() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearHandle()
+	})
+}
+
 // SetBio sets the "bio" field.
 func (u *UserUpsertOne) SetBio(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -832,6 +1622,20 @@ func (u *UserUpsertOne) UpdateStreakCount() *UserUpsertOne {
 	})
 }
 
+// SetRole sets the "role" field.
+func (u *UserUpsertOne) SetRole(v enum.Role) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateRole() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
 // SetIconImageKey sets the "icon_image_key" field.
 func (u *UserUpsertOne) SetIconImageKey(v string) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -853,6 +1657,20 @@ func (u *UserUpsertOne) ClearIconImageKey() *UserUpsertOne {
 	})
 }
 
+// SetIsPrivate sets the "is_private" field.
+func (u *UserUpsertOne) SetIsPrivate(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsPrivate(v)
+	})
+}
+
+// UpdateIsPrivate sets the "is_private" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateIsPrivate() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsPrivate()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserUpsertOne) SetCreatedAt(v time.Time) *UserUpsertOne {
 	return u.Update(func(s *UserUpsert) {
@@ -867,6 +1685,69 @@ func (u *UserUpsertOne) UpdateCreatedAt() *UserUpsertOne {
 	})
 }
 
+// SetFollowerCount sets the "follower_count" field.
+func (u *UserUpsertOne) SetFollowerCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFollowerCount(v)
+	})
+}
+
+// AddFollowerCount adds v to the "follower_count" field.
+func (u *UserUpsertOne) AddFollowerCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFollowerCount(v)
+	})
+}
+
+// UpdateFollowerCount sets the "follower_count" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateFollowerCount() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFollowerCount()
+	})
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (u *UserUpsertOne) SetFollowingCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFollowingCount(v)
+	})
+}
+
+// AddFollowingCount adds v to the "following_count" field.
+func (u *UserUpsertOne) AddFollowingCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFollowingCount(v)
+	})
+}
+
+// UpdateFollowingCount sets the "following_count" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateFollowingCount() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFollowingCount()
+	})
+}
+
+// SetPostCount sets the "post_count" field.
+func (u *UserUpsertOne) SetPostCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPostCount(v)
+	})
+}
+
+// AddPostCount adds v to the "post_count" field.
+func (u *UserUpsertOne) AddPostCount(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddPostCount(v)
+	})
+}
+
+// UpdatePostCount sets the "post_count" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePostCount() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePostCount()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1099,6 +1980,27 @@ func (u *UserUpsertBulk) UpdateEmail() *UserUpsertBulk {
 	})
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (u *UserUpsertBulk) SetAuthSubject(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAuthSubject(v)
+	})
+}
+
+// UpdateAuthSubject sets the "auth_subject" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAuthSubject() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAuthSubject()
+	})
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (u *UserUpsertBulk) ClearAuthSubject() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearAuthSubject()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *UserUpsertBulk) SetName(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1113,6 +2015,27 @@ func (u *UserUpsertBulk) UpdateName() *UserUpsertBulk {
 	})
 }
 
+// SetHandle sets the "handle" field.
+func (u *UserUpsertBulk) SetHandle(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHandle(v)
+	})
+}
+
+// UpdateHandle sets the "handle" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateHandle() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHandle()
+	})
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (u *UserUpsertBulk) ClearHandle() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearHandle()
+	})
+}
+
 // SetBio sets the "bio" field.
 func (u *UserUpsertBulk) SetBio(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1148,6 +2071,20 @@ func (u *UserUpsertBulk) UpdateStreakCount() *UserUpsertBulk {
 	})
 }
 
+// SetRole sets the "role" field.
+func (u *UserUpsertBulk) SetRole(v enum.Role) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateRole() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateRole()
+	})
+}
+
 // SetIconImageKey sets the "icon_image_key" field.
 func (u *UserUpsertBulk) SetIconImageKey(v string) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1169,6 +2106,20 @@ func (u *UserUpsertBulk) ClearIconImageKey() *UserUpsertBulk {
 	})
 }
 
+// SetIsPrivate sets the "is_private" field.
+func (u *UserUpsertBulk) SetIsPrivate(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsPrivate(v)
+	})
+}
+
+// UpdateIsPrivate sets the "is_private" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateIsPrivate() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsPrivate()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserUpsertBulk) SetCreatedAt(v time.Time) *UserUpsertBulk {
 	return u.Update(func(s *UserUpsert) {
@@ -1183,6 +2134,69 @@ func (u *UserUpsertBulk) UpdateCreatedAt() *UserUpsertBulk {
 	})
 }
 
+// SetFollowerCount sets the "follower_count" field.
+func (u *UserUpsertBulk) SetFollowerCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFollowerCount(v)
+	})
+}
+
+// AddFollowerCount adds v to the "follower_count" field.
+func (u *UserUpsertBulk) AddFollowerCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFollowerCount(v)
+	})
+}
+
+// UpdateFollowerCount sets the "follower_count" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateFollowerCount() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFollowerCount()
+	})
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (u *UserUpsertBulk) SetFollowingCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetFollowingCount(v)
+	})
+}
+
+// AddFollowingCount adds v to the "following_count" field.
+func (u *UserUpsertBulk) AddFollowingCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddFollowingCount(v)
+	})
+}
+
+// UpdateFollowingCount sets the "following_count" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateFollowingCount() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateFollowingCount()
+	})
+}
+
+// SetPostCount sets the "post_count" field.
+func (u *UserUpsertBulk) SetPostCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPostCount(v)
+	})
+}
+
+// AddPostCount adds v to the "post_count" field.
+func (u *UserUpsertBulk) AddPostCount(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddPostCount(v)
+	})
+}
+
+// UpdatePostCount sets the "post_count" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePostCount() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePostCount()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -14,13 +14,20 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/devicetoken"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -28,20 +35,35 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx              *QueryContext
-	order            []user.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.User
-	withPosts        *PostQuery
-	withComments     *CommentQuery
-	withLikes        *LikeQuery
-	withPets         *PetQuery
-	withFollowing    *FollowRelationQuery
-	withFollowers    *FollowRelationQuery
-	withBlocking     *BlockRelationQuery
-	withBlockedBy    *BlockRelationQuery
-	withDailyTasks   *DailyTaskQuery
-	withDeviceTokens *DeviceTokenQuery
+	ctx                        *QueryContext
+	order                      []user.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.User
+	withPosts                  *PostQuery
+	withComments               *CommentQuery
+	withLikes                  *LikeQuery
+	withPets                   *PetQuery
+	withFollowing              *FollowRelationQuery
+	withFollowers              *FollowRelationQuery
+	withBlocking               *BlockRelationQuery
+	withBlockedBy              *BlockRelationQuery
+	withDailyTasks             *DailyTaskQuery
+	withDeviceTokens           *DeviceTokenQuery
+	withReportsFiled           *ReportQuery
+	withReportsReceived        *ReportQuery
+	withReportsResolved        *ReportQuery
+	withSuspensions            *SuspensionQuery
+	withSuspensionsIssued      *SuspensionQuery
+	withMuting                 *MuteRelationQuery
+	withMutedBy                *MuteRelationQuery
+	withMutedKeywords          *MutedKeywordQuery
+	withFollowRequestsSent     *FollowRequestQuery
+	withFollowRequestsReceived *FollowRequestQuery
+	withMentionedInPosts       *PostQuery
+	withMentionedInComments    *CommentQuery
+	withNotifications          *NotificationQuery
+	withNotificationsSent      *NotificationQuery
+	withCommentLikes           *CommentLikeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -298,139 +320,469 @@ func (uq *UserQuery) QueryDeviceTokens() *DeviceTokenQuery {
 	return query
 }
 
-// First returns the first User entity from the query.
-// Returns a *NotFoundError when no User was found.
-func (uq *UserQuery) First(ctx context.Context) (*User, error) {
-	nodes, err := uq.Limit(1).All(setContextOp(ctx, uq.ctx, ent.OpQueryFirst))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+// QueryReportsFiled chains the current query on the "reports_filed" edge.
+func (uq *UserQuery) QueryReportsFiled() *ReportQuery {
+	query := (&ReportClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportsFiledTable, user.ReportsFiledColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes[0], nil
+	return query
 }
 
-// FirstX is like First, but panics if an error occurs.
-func (uq *UserQuery) FirstX(ctx context.Context) *User {
-	node, err := uq.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryReportsReceived chains the current query on the "reports_received" edge.
+func (uq *UserQuery) QueryReportsReceived() *ReportQuery {
+	query := (&ReportClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportsReceivedTable, user.ReportsReceivedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// FirstID returns the first User ID from the query.
-// Returns a *NotFoundError when no User ID was found.
-func (uq *UserQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
-	var ids []uuid.UUID
-	if ids, err = uq.Limit(1).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryFirstID)); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
-		return
+// QueryReportsResolved chains the current query on the "reports_resolved" edge.
+func (uq *UserQuery) QueryReportsResolved() *ReportQuery {
+	query := (&ReportClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReportsResolvedTable, user.ReportsResolvedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids[0], nil
+	return query
 }
 
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (uq *UserQuery) FirstIDX(ctx context.Context) uuid.UUID {
-	id, err := uq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QuerySuspensions chains the current query on the "suspensions" edge.
+func (uq *UserQuery) QuerySuspensions() *SuspensionQuery {
+	query := (&SuspensionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(suspension.Table, suspension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SuspensionsTable, user.SuspensionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// Only returns a single User entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one User entity is found.
-// Returns a *NotFoundError when no User entities are found.
-func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
-	nodes, err := uq.Limit(2).All(setContextOp(ctx, uq.ctx, ent.OpQueryOnly))
-	if err != nil {
-		return nil, err
-	}
-	switch len(nodes) {
-	case 1:
-		return nodes[0], nil
-	case 0:
-		return nil, &NotFoundError{user.Label}
-	default:
-		return nil, &NotSingularError{user.Label}
+// QuerySuspensionsIssued chains the current query on the "suspensions_issued" edge.
+func (uq *UserQuery) QuerySuspensionsIssued() *SuspensionQuery {
+	query := (&SuspensionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(suspension.Table, suspension.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SuspensionsIssuedTable, user.SuspensionsIssuedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// OnlyX is like Only, but panics if an error occurs.
-func (uq *UserQuery) OnlyX(ctx context.Context) *User {
-	node, err := uq.Only(ctx)
-	if err != nil {
-		panic(err)
+// QueryMuting chains the current query on the "muting" edge.
+func (uq *UserQuery) QueryMuting() *MuteRelationQuery {
+	query := (&MuteRelationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(muterelation.Table, muterelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MutingTable, user.MutingColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// OnlyID is like Only, but returns the only User ID in the query.
-// Returns a *NotSingularError when more than one User ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (uq *UserQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
-	var ids []uuid.UUID
-	if ids, err = uq.Limit(2).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryOnlyID)); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{user.Label}
-	default:
-		err = &NotSingularError{user.Label}
+// QueryMutedBy chains the current query on the "muted_by" edge.
+func (uq *UserQuery) QueryMutedBy() *MuteRelationQuery {
+	query := (&MuteRelationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(muterelation.Table, muterelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MutedByTable, user.MutedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return
+	return query
 }
 
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (uq *UserQuery) OnlyIDX(ctx context.Context) uuid.UUID {
-	id, err := uq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
+// QueryMutedKeywords chains the current query on the "muted_keywords" edge.
+func (uq *UserQuery) QueryMutedKeywords() *MutedKeywordQuery {
+	query := (&MutedKeywordClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(mutedkeyword.Table, mutedkeyword.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MutedKeywordsTable, user.MutedKeywordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// All executes the query and returns a list of Users.
-func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
-	ctx = setContextOp(ctx, uq.ctx, ent.OpQueryAll)
-	if err := uq.prepareQuery(ctx); err != nil {
-		return nil, err
+// QueryFollowRequestsSent chains the current query on the "follow_requests_sent" edge.
+func (uq *UserQuery) QueryFollowRequestsSent() *FollowRequestQuery {
+	query := (&FollowRequestClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(followrequest.Table, followrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FollowRequestsSentTable, user.FollowRequestsSentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	qr := querierAll[[]*User, *UserQuery]()
-	return withInterceptors[[]*User](ctx, uq, qr, uq.inters)
+	return query
 }
 
-// AllX is like All, but panics if an error occurs.
-func (uq *UserQuery) AllX(ctx context.Context) []*User {
-	nodes, err := uq.All(ctx)
-	if err != nil {
-		panic(err)
+// QueryFollowRequestsReceived chains the current query on the "follow_requests_received" edge.
+func (uq *UserQuery) QueryFollowRequestsReceived() *FollowRequestQuery {
+	query := (&FollowRequestClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(followrequest.Table, followrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FollowRequestsReceivedTable, user.FollowRequestsReceivedColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes
+	return query
 }
 
-// IDs executes the query and returns a list of User IDs.
-func (uq *UserQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
-	if uq.ctx.Unique == nil && uq.path != nil {
-		uq.Unique(true)
-	}
-	ctx = setContextOp(ctx, uq.ctx, ent.OpQueryIDs)
-	if err = uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
+// QueryMentionedInPosts chains the current query on the "mentioned_in_posts" edge.
+func (uq *UserQuery) QueryMentionedInPosts() *PostQuery {
+	query := (&PostClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.MentionedInPostsTable, user.MentionedInPostsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids, nil
+	return query
 }
 
-// IDsX is like IDs, but panics if an error occurs.
-func (uq *UserQuery) IDsX(ctx context.Context) []uuid.UUID {
+// QueryMentionedInComments chains the current query on the "mentioned_in_comments" edge.
+func (uq *UserQuery) QueryMentionedInComments() *CommentQuery {
+	query := (&CommentClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.MentionedInCommentsTable, user.MentionedInCommentsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotifications chains the current query on the "notifications" edge.
+func (uq *UserQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationsSent chains the current query on the "notifications_sent" edge.
+func (uq *UserQuery) QueryNotificationsSent() *NotificationQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsSentTable, user.NotificationsSentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCommentLikes chains the current query on the "comment_likes" edge.
+func (uq *UserQuery) QueryCommentLikes() *CommentLikeQuery {
+	query := (&CommentLikeClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(commentlike.Table, commentlike.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CommentLikesTable, user.CommentLikesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first User entity from the query.
+// Returns a *NotFoundError when no User was found.
+func (uq *UserQuery) First(ctx context.Context) (*User, error) {
+	nodes, err := uq.Limit(1).All(setContextOp(ctx, uq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{user.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uq *UserQuery) FirstX(ctx context.Context) *User {
+	node, err := uq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first User ID from the query.
+// Returns a *NotFoundError when no User ID was found.
+func (uq *UserQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uq.Limit(1).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{user.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uq *UserQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := uq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single User entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one User entity is found.
+// Returns a *NotFoundError when no User entities are found.
+func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
+	nodes, err := uq.Limit(2).All(setContextOp(ctx, uq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{user.Label}
+	default:
+		return nil, &NotSingularError{user.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uq *UserQuery) OnlyX(ctx context.Context) *User {
+	node, err := uq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only User ID in the query.
+// Returns a *NotSingularError when more than one User ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uq *UserQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uq.Limit(2).IDs(setContextOp(ctx, uq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{user.Label}
+	default:
+		err = &NotSingularError{user.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uq *UserQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := uq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Users.
+func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
+	ctx = setContextOp(ctx, uq.ctx, ent.OpQueryAll)
+	if err := uq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*User, *UserQuery]()
+	return withInterceptors[[]*User](ctx, uq, qr, uq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uq *UserQuery) AllX(ctx context.Context) []*User {
+	nodes, err := uq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of User IDs.
+func (uq *UserQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if uq.ctx.Unique == nil && uq.path != nil {
+		uq.Unique(true)
+	}
+	ctx = setContextOp(ctx, uq.ctx, ent.OpQueryIDs)
+	if err = uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uq *UserQuery) IDsX(ctx context.Context) []uuid.UUID {
 	ids, err := uq.IDs(ctx)
 	if err != nil {
 		panic(err)
@@ -485,21 +837,36 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:           uq.config,
-		ctx:              uq.ctx.Clone(),
-		order:            append([]user.OrderOption{}, uq.order...),
-		inters:           append([]Interceptor{}, uq.inters...),
-		predicates:       append([]predicate.User{}, uq.predicates...),
-		withPosts:        uq.withPosts.Clone(),
-		withComments:     uq.withComments.Clone(),
-		withLikes:        uq.withLikes.Clone(),
-		withPets:         uq.withPets.Clone(),
-		withFollowing:    uq.withFollowing.Clone(),
-		withFollowers:    uq.withFollowers.Clone(),
-		withBlocking:     uq.withBlocking.Clone(),
-		withBlockedBy:    uq.withBlockedBy.Clone(),
-		withDailyTasks:   uq.withDailyTasks.Clone(),
-		withDeviceTokens: uq.withDeviceTokens.Clone(),
+		config:                     uq.config,
+		ctx:                        uq.ctx.Clone(),
+		order:                      append([]user.OrderOption{}, uq.order...),
+		inters:                     append([]Interceptor{}, uq.inters...),
+		predicates:                 append([]predicate.User{}, uq.predicates...),
+		withPosts:                  uq.withPosts.Clone(),
+		withComments:               uq.withComments.Clone(),
+		withLikes:                  uq.withLikes.Clone(),
+		withPets:                   uq.withPets.Clone(),
+		withFollowing:              uq.withFollowing.Clone(),
+		withFollowers:              uq.withFollowers.Clone(),
+		withBlocking:               uq.withBlocking.Clone(),
+		withBlockedBy:              uq.withBlockedBy.Clone(),
+		withDailyTasks:             uq.withDailyTasks.Clone(),
+		withDeviceTokens:           uq.withDeviceTokens.Clone(),
+		withReportsFiled:           uq.withReportsFiled.Clone(),
+		withReportsReceived:        uq.withReportsReceived.Clone(),
+		withReportsResolved:        uq.withReportsResolved.Clone(),
+		withSuspensions:            uq.withSuspensions.Clone(),
+		withSuspensionsIssued:      uq.withSuspensionsIssued.Clone(),
+		withMuting:                 uq.withMuting.Clone(),
+		withMutedBy:                uq.withMutedBy.Clone(),
+		withMutedKeywords:          uq.withMutedKeywords.Clone(),
+		withFollowRequestsSent:     uq.withFollowRequestsSent.Clone(),
+		withFollowRequestsReceived: uq.withFollowRequestsReceived.Clone(),
+		withMentionedInPosts:       uq.withMentionedInPosts.Clone(),
+		withMentionedInComments:    uq.withMentionedInComments.Clone(),
+		withNotifications:          uq.withNotifications.Clone(),
+		withNotificationsSent:      uq.withNotificationsSent.Clone(),
+		withCommentLikes:           uq.withCommentLikes.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -616,55 +983,220 @@ func (uq *UserQuery) WithDeviceTokens(opts ...func(*DeviceTokenQuery)) *UserQuer
 	return uq
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		Index uint32 `json:"index,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		GroupBy(user.FieldIndex).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
-	uq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &UserGroupBy{build: uq}
-	grbuild.flds = &uq.ctx.Fields
-	grbuild.label = user.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
-}
-
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		Index uint32 `json:"index,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		Select(user.FieldIndex).
-//		Scan(ctx, &v)
-func (uq *UserQuery) Select(fields ...string) *UserSelect {
-	uq.ctx.Fields = append(uq.ctx.Fields, fields...)
-	sbuild := &UserSelect{UserQuery: uq}
-	sbuild.label = user.Label
-	sbuild.flds, sbuild.scan = &uq.ctx.Fields, sbuild.Scan
-	return sbuild
+// WithReportsFiled tells the query-builder to eager-load the nodes that are connected to
+// the "reports_filed" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithReportsFiled(opts ...func(*ReportQuery)) *UserQuery {
+	query := (&ReportClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withReportsFiled = query
+	return uq
 }
 
-// Aggregate returns a UserSelect configured with the given aggregations.
-func (uq *UserQuery) Aggregate(fns ...AggregateFunc) *UserSelect {
-	return uq.Select().Aggregate(fns...)
+// WithReportsReceived tells the query-builder to eager-load the nodes that are connected to
+// the "reports_received" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithReportsReceived(opts ...func(*ReportQuery)) *UserQuery {
+	query := (&ReportClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withReportsReceived = query
+	return uq
 }
 
-func (uq *UserQuery) prepareQuery(ctx context.Context) error {
+// WithReportsResolved tells the query-builder to eager-load the nodes that are connected to
+// the "reports_resolved" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithReportsResolved(opts ...func(*ReportQuery)) *UserQuery {
+	query := (&ReportClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withReportsResolved = query
+	return uq
+}
+
+// WithSuspensions tells the query-builder to eager-load the nodes that are connected to
+// the "suspensions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSuspensions(opts ...func(*SuspensionQuery)) *UserQuery {
+	query := (&SuspensionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSuspensions = query
+	return uq
+}
+
+// WithSuspensionsIssued tells the query-builder to eager-load the nodes that are connected to
+// the "suspensions_issued" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSuspensionsIssued(opts ...func(*SuspensionQuery)) *UserQuery {
+	query := (&SuspensionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSuspensionsIssued = query
+	return uq
+}
+
+// WithMuting tells the query-builder to eager-load the nodes that are connected to
+// the "muting" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMuting(opts ...func(*MuteRelationQuery)) *UserQuery {
+	query := (&MuteRelationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMuting = query
+	return uq
+}
+
+// WithMutedBy tells the query-builder to eager-load the nodes that are connected to
+// the "muted_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMutedBy(opts ...func(*MuteRelationQuery)) *UserQuery {
+	query := (&MuteRelationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMutedBy = query
+	return uq
+}
+
+// WithMutedKeywords tells the query-builder to eager-load the nodes that are connected to
+// the "muted_keywords" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMutedKeywords(opts ...func(*MutedKeywordQuery)) *UserQuery {
+	query := (&MutedKeywordClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMutedKeywords = query
+	return uq
+}
+
+// WithFollowRequestsSent tells the query-builder to eager-load the nodes that are connected to
+// the "follow_requests_sent" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithFollowRequestsSent(opts ...func(*FollowRequestQuery)) *UserQuery {
+	query := (&FollowRequestClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFollowRequestsSent = query
+	return uq
+}
+
+// WithFollowRequestsReceived tells the query-builder to eager-load the nodes that are connected to
+// the "follow_requests_received" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithFollowRequestsReceived(opts ...func(*FollowRequestQuery)) *UserQuery {
+	query := (&FollowRequestClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFollowRequestsReceived = query
+	return uq
+}
+
+// WithMentionedInPosts tells the query-builder to eager-load the nodes that are connected to
+// the "mentioned_in_posts" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMentionedInPosts(opts ...func(*PostQuery)) *UserQuery {
+	query := (&PostClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMentionedInPosts = query
+	return uq
+}
+
+// WithMentionedInComments tells the query-builder to eager-load the nodes that are connected to
+// the "mentioned_in_comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMentionedInComments(opts ...func(*CommentQuery)) *UserQuery {
+	query := (&CommentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMentionedInComments = query
+	return uq
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotifications = query
+	return uq
+}
+
+// WithNotificationsSent tells the query-builder to eager-load the nodes that are connected to
+// the "notifications_sent" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotificationsSent(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotificationsSent = query
+	return uq
+}
+
+// WithCommentLikes tells the query-builder to eager-load the nodes that are connected to
+// the "comment_likes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCommentLikes(opts ...func(*CommentLikeQuery)) *UserQuery {
+	query := (&CommentLikeClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCommentLikes = query
+	return uq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Index uint32 `json:"index,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldIndex).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
+	uq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserGroupBy{build: uq}
+	grbuild.flds = &uq.ctx.Fields
+	grbuild.label = user.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Index uint32 `json:"index,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		Select(user.FieldIndex).
+//		Scan(ctx, &v)
+func (uq *UserQuery) Select(fields ...string) *UserSelect {
+	uq.ctx.Fields = append(uq.ctx.Fields, fields...)
+	sbuild := &UserSelect{UserQuery: uq}
+	sbuild.label = user.Label
+	sbuild.flds, sbuild.scan = &uq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserSelect configured with the given aggregations.
+func (uq *UserQuery) Aggregate(fns ...AggregateFunc) *UserSelect {
+	return uq.Select().Aggregate(fns...)
+}
+
+func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 	for _, inter := range uq.inters {
 		if inter == nil {
 			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
@@ -694,7 +1226,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [10]bool{
+		loadedTypes = [25]bool{
 			uq.withPosts != nil,
 			uq.withComments != nil,
 			uq.withLikes != nil,
@@ -705,6 +1237,21 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			uq.withBlockedBy != nil,
 			uq.withDailyTasks != nil,
 			uq.withDeviceTokens != nil,
+			uq.withReportsFiled != nil,
+			uq.withReportsReceived != nil,
+			uq.withReportsResolved != nil,
+			uq.withSuspensions != nil,
+			uq.withSuspensionsIssued != nil,
+			uq.withMuting != nil,
+			uq.withMutedBy != nil,
+			uq.withMutedKeywords != nil,
+			uq.withFollowRequestsSent != nil,
+			uq.withFollowRequestsReceived != nil,
+			uq.withMentionedInPosts != nil,
+			uq.withMentionedInComments != nil,
+			uq.withNotifications != nil,
+			uq.withNotificationsSent != nil,
+			uq.withCommentLikes != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -719,86 +1266,565 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
-		return nil, err
+	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := uq.withPosts; query != nil {
+		if err := uq.loadPosts(ctx, query, nodes,
+			func(n *User) { n.Edges.Posts = []*Post{} },
+			func(n *User, e *Post) { n.Edges.Posts = append(n.Edges.Posts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withComments; query != nil {
+		if err := uq.loadComments(ctx, query, nodes,
+			func(n *User) { n.Edges.Comments = []*Comment{} },
+			func(n *User, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withLikes; query != nil {
+		if err := uq.loadLikes(ctx, query, nodes,
+			func(n *User) { n.Edges.Likes = []*Like{} },
+			func(n *User, e *Like) { n.Edges.Likes = append(n.Edges.Likes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withPets; query != nil {
+		if err := uq.loadPets(ctx, query, nodes,
+			func(n *User) { n.Edges.Pets = []*Pet{} },
+			func(n *User, e *Pet) { n.Edges.Pets = append(n.Edges.Pets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowing; query != nil {
+		if err := uq.loadFollowing(ctx, query, nodes,
+			func(n *User) { n.Edges.Following = []*FollowRelation{} },
+			func(n *User, e *FollowRelation) { n.Edges.Following = append(n.Edges.Following, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowers; query != nil {
+		if err := uq.loadFollowers(ctx, query, nodes,
+			func(n *User) { n.Edges.Followers = []*FollowRelation{} },
+			func(n *User, e *FollowRelation) { n.Edges.Followers = append(n.Edges.Followers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlocking; query != nil {
+		if err := uq.loadBlocking(ctx, query, nodes,
+			func(n *User) { n.Edges.Blocking = []*BlockRelation{} },
+			func(n *User, e *BlockRelation) { n.Edges.Blocking = append(n.Edges.Blocking, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlockedBy; query != nil {
+		if err := uq.loadBlockedBy(ctx, query, nodes,
+			func(n *User) { n.Edges.BlockedBy = []*BlockRelation{} },
+			func(n *User, e *BlockRelation) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withDailyTasks; query != nil {
+		if err := uq.loadDailyTasks(ctx, query, nodes,
+			func(n *User) { n.Edges.DailyTasks = []*DailyTask{} },
+			func(n *User, e *DailyTask) { n.Edges.DailyTasks = append(n.Edges.DailyTasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withDeviceTokens; query != nil {
+		if err := uq.loadDeviceTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.DeviceTokens = []*DeviceToken{} },
+			func(n *User, e *DeviceToken) { n.Edges.DeviceTokens = append(n.Edges.DeviceTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withReportsFiled; query != nil {
+		if err := uq.loadReportsFiled(ctx, query, nodes,
+			func(n *User) { n.Edges.ReportsFiled = []*Report{} },
+			func(n *User, e *Report) { n.Edges.ReportsFiled = append(n.Edges.ReportsFiled, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withReportsReceived; query != nil {
+		if err := uq.loadReportsReceived(ctx, query, nodes,
+			func(n *User) { n.Edges.ReportsReceived = []*Report{} },
+			func(n *User, e *Report) { n.Edges.ReportsReceived = append(n.Edges.ReportsReceived, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withReportsResolved; query != nil {
+		if err := uq.loadReportsResolved(ctx, query, nodes,
+			func(n *User) { n.Edges.ReportsResolved = []*Report{} },
+			func(n *User, e *Report) { n.Edges.ReportsResolved = append(n.Edges.ReportsResolved, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSuspensions; query != nil {
+		if err := uq.loadSuspensions(ctx, query, nodes,
+			func(n *User) { n.Edges.Suspensions = []*Suspension{} },
+			func(n *User, e *Suspension) { n.Edges.Suspensions = append(n.Edges.Suspensions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSuspensionsIssued; query != nil {
+		if err := uq.loadSuspensionsIssued(ctx, query, nodes,
+			func(n *User) { n.Edges.SuspensionsIssued = []*Suspension{} },
+			func(n *User, e *Suspension) { n.Edges.SuspensionsIssued = append(n.Edges.SuspensionsIssued, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMuting; query != nil {
+		if err := uq.loadMuting(ctx, query, nodes,
+			func(n *User) { n.Edges.Muting = []*MuteRelation{} },
+			func(n *User, e *MuteRelation) { n.Edges.Muting = append(n.Edges.Muting, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMutedBy; query != nil {
+		if err := uq.loadMutedBy(ctx, query, nodes,
+			func(n *User) { n.Edges.MutedBy = []*MuteRelation{} },
+			func(n *User, e *MuteRelation) { n.Edges.MutedBy = append(n.Edges.MutedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMutedKeywords; query != nil {
+		if err := uq.loadMutedKeywords(ctx, query, nodes,
+			func(n *User) { n.Edges.MutedKeywords = []*MutedKeyword{} },
+			func(n *User, e *MutedKeyword) { n.Edges.MutedKeywords = append(n.Edges.MutedKeywords, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowRequestsSent; query != nil {
+		if err := uq.loadFollowRequestsSent(ctx, query, nodes,
+			func(n *User) { n.Edges.FollowRequestsSent = []*FollowRequest{} },
+			func(n *User, e *FollowRequest) { n.Edges.FollowRequestsSent = append(n.Edges.FollowRequestsSent, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFollowRequestsReceived; query != nil {
+		if err := uq.loadFollowRequestsReceived(ctx, query, nodes,
+			func(n *User) { n.Edges.FollowRequestsReceived = []*FollowRequest{} },
+			func(n *User, e *FollowRequest) {
+				n.Edges.FollowRequestsReceived = append(n.Edges.FollowRequestsReceived, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMentionedInPosts; query != nil {
+		if err := uq.loadMentionedInPosts(ctx, query, nodes,
+			func(n *User) { n.Edges.MentionedInPosts = []*Post{} },
+			func(n *User, e *Post) { n.Edges.MentionedInPosts = append(n.Edges.MentionedInPosts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMentionedInComments; query != nil {
+		if err := uq.loadMentionedInComments(ctx, query, nodes,
+			func(n *User) { n.Edges.MentionedInComments = []*Comment{} },
+			func(n *User, e *Comment) { n.Edges.MentionedInComments = append(n.Edges.MentionedInComments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNotifications; query != nil {
+		if err := uq.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.Edges.Notifications = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNotificationsSent; query != nil {
+		if err := uq.loadNotificationsSent(ctx, query, nodes,
+			func(n *User) { n.Edges.NotificationsSent = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.NotificationsSent = append(n.Edges.NotificationsSent, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCommentLikes; query != nil {
+		if err := uq.loadCommentLikes(ctx, query, nodes,
+			func(n *User) { n.Edges.CommentLikes = []*CommentLike{} },
+			func(n *User, e *CommentLike) { n.Edges.CommentLikes = append(n.Edges.CommentLikes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (uq *UserQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*User, init func(*User), assign func(*User, *Post)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Post(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PostsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_posts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_posts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_posts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*User, init func(*User), assign func(*User, *Comment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_comments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_comments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_comments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadLikes(ctx context.Context, query *LikeQuery, nodes []*User, init func(*User), assign func(*User, *Like)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Like(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LikesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_likes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_likes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_likes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadPets(ctx context.Context, query *PetQuery, nodes []*User, init func(*User), assign func(*User, *Pet)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Pet(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_pets
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_pets" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_pets" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadFollowing(ctx context.Context, query *FollowRelationQuery, nodes []*User, init func(*User), assign func(*User, *FollowRelation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.FollowRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FollowingColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_following
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_following" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_following" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadFollowers(ctx context.Context, query *FollowRelationQuery, nodes []*User, init func(*User), assign func(*User, *FollowRelation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.FollowRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FollowersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_followers
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_followers" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_followers" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadBlocking(ctx context.Context, query *BlockRelationQuery, nodes []*User, init func(*User), assign func(*User, *BlockRelation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BlockRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BlockingColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_blocking
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_blocking" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_blocking" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadBlockedBy(ctx context.Context, query *BlockRelationQuery, nodes []*User, init func(*User), assign func(*User, *BlockRelation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BlockRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BlockedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_blocked_by
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_blocked_by" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_blocked_by" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadDailyTasks(ctx context.Context, query *DailyTaskQuery, nodes []*User, init func(*User), assign func(*User, *DailyTask)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.DailyTask(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.DailyTasksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_daily_tasks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_daily_tasks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_daily_tasks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadDeviceTokens(ctx context.Context, query *DeviceTokenQuery, nodes []*User, init func(*User), assign func(*User, *DeviceToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(devicetoken.FieldUserID)
 	}
-	if len(nodes) == 0 {
-		return nodes, nil
+	query.Where(predicate.DeviceToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.DeviceTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := uq.withPosts; query != nil {
-		if err := uq.loadPosts(ctx, query, nodes,
-			func(n *User) { n.Edges.Posts = []*Post{} },
-			func(n *User, e *Post) { n.Edges.Posts = append(n.Edges.Posts, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withComments; query != nil {
-		if err := uq.loadComments(ctx, query, nodes,
-			func(n *User) { n.Edges.Comments = []*Comment{} },
-			func(n *User, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadReportsFiled(ctx context.Context, query *ReportQuery, nodes []*User, init func(*User), assign func(*User, *Report)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withLikes; query != nil {
-		if err := uq.loadLikes(ctx, query, nodes,
-			func(n *User) { n.Edges.Likes = []*Like{} },
-			func(n *User, e *Like) { n.Edges.Likes = append(n.Edges.Likes, e) }); err != nil {
-			return nil, err
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(report.FieldReporterID)
 	}
-	if query := uq.withPets; query != nil {
-		if err := uq.loadPets(ctx, query, nodes,
-			func(n *User) { n.Edges.Pets = []*Pet{} },
-			func(n *User, e *Pet) { n.Edges.Pets = append(n.Edges.Pets, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReportsFiledColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := uq.withFollowing; query != nil {
-		if err := uq.loadFollowing(ctx, query, nodes,
-			func(n *User) { n.Edges.Following = []*FollowRelation{} },
-			func(n *User, e *FollowRelation) { n.Edges.Following = append(n.Edges.Following, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.ReporterID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reporter_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withFollowers; query != nil {
-		if err := uq.loadFollowers(ctx, query, nodes,
-			func(n *User) { n.Edges.Followers = []*FollowRelation{} },
-			func(n *User, e *FollowRelation) { n.Edges.Followers = append(n.Edges.Followers, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadReportsReceived(ctx context.Context, query *ReportQuery, nodes []*User, init func(*User), assign func(*User, *Report)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withBlocking; query != nil {
-		if err := uq.loadBlocking(ctx, query, nodes,
-			func(n *User) { n.Edges.Blocking = []*BlockRelation{} },
-			func(n *User, e *BlockRelation) { n.Edges.Blocking = append(n.Edges.Blocking, e) }); err != nil {
-			return nil, err
-		}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(report.FieldTargetUserID)
 	}
-	if query := uq.withBlockedBy; query != nil {
-		if err := uq.loadBlockedBy(ctx, query, nodes,
-			func(n *User) { n.Edges.BlockedBy = []*BlockRelation{} },
-			func(n *User, e *BlockRelation) { n.Edges.BlockedBy = append(n.Edges.BlockedBy, e) }); err != nil {
-			return nil, err
-		}
+	query.Where(predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReportsReceivedColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if query := uq.withDailyTasks; query != nil {
-		if err := uq.loadDailyTasks(ctx, query, nodes,
-			func(n *User) { n.Edges.DailyTasks = []*DailyTask{} },
-			func(n *User, e *DailyTask) { n.Edges.DailyTasks = append(n.Edges.DailyTasks, e) }); err != nil {
-			return nil, err
+	for _, n := range neighbors {
+		fk := n.TargetUserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "target_user_id" is nil for node %v`, n.ID)
 		}
-	}
-	if query := uq.withDeviceTokens; query != nil {
-		if err := uq.loadDeviceTokens(ctx, query, nodes,
-			func(n *User) { n.Edges.DeviceTokens = []*DeviceToken{} },
-			func(n *User, e *DeviceToken) { n.Edges.DeviceTokens = append(n.Edges.DeviceTokens, e) }); err != nil {
-			return nil, err
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "target_user_id" returned %v for node %v`, *fk, n.ID)
 		}
+		assign(node, n)
 	}
-	return nodes, nil
+	return nil
 }
-
-func (uq *UserQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*User, init func(*User), assign func(*User, *Post)) error {
+func (uq *UserQuery) loadReportsResolved(ctx context.Context, query *ReportQuery, nodes []*User, init func(*User), assign func(*User, *Report)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -808,28 +1834,30 @@ func (uq *UserQuery) loadPosts(ctx context.Context, query *PostQuery, nodes []*U
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Post(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.PostsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(report.FieldResolverID)
+	}
+	query.Where(predicate.Report(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReportsResolvedColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_posts
+		fk := n.ResolverID
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_posts" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "resolver_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_posts" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "resolver_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*User, init func(*User), assign func(*User, *Comment)) error {
+func (uq *UserQuery) loadSuspensions(ctx context.Context, query *SuspensionQuery, nodes []*User, init func(*User), assign func(*User, *Suspension)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -839,28 +1867,27 @@ func (uq *UserQuery) loadComments(ctx context.Context, query *CommentQuery, node
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Comment(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.CommentsColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(suspension.FieldUserID)
+	}
+	query.Where(predicate.Suspension(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SuspensionsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_comments
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_comments" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_comments" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadLikes(ctx context.Context, query *LikeQuery, nodes []*User, init func(*User), assign func(*User, *Like)) error {
+func (uq *UserQuery) loadSuspensionsIssued(ctx context.Context, query *SuspensionQuery, nodes []*User, init func(*User), assign func(*User, *Suspension)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -870,28 +1897,30 @@ func (uq *UserQuery) loadLikes(ctx context.Context, query *LikeQuery, nodes []*U
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Like(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.LikesColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(suspension.FieldIssuedByID)
+	}
+	query.Where(predicate.Suspension(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SuspensionsIssuedColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_likes
+		fk := n.IssuedByID
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_likes" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "issued_by_id" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_likes" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "issued_by_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadPets(ctx context.Context, query *PetQuery, nodes []*User, init func(*User), assign func(*User, *Pet)) error {
+func (uq *UserQuery) loadMuting(ctx context.Context, query *MuteRelationQuery, nodes []*User, init func(*User), assign func(*User, *MuteRelation)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -902,27 +1931,27 @@ func (uq *UserQuery) loadPets(ctx context.Context, query *PetQuery, nodes []*Use
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.Pet(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.PetsColumn), fks...))
+	query.Where(predicate.MuteRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MutingColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_pets
+		fk := n.user_muting
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_pets" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_muting" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_pets" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_muting" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadFollowing(ctx context.Context, query *FollowRelationQuery, nodes []*User, init func(*User), assign func(*User, *FollowRelation)) error {
+func (uq *UserQuery) loadMutedBy(ctx context.Context, query *MuteRelationQuery, nodes []*User, init func(*User), assign func(*User, *MuteRelation)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -933,27 +1962,27 @@ func (uq *UserQuery) loadFollowing(ctx context.Context, query *FollowRelationQue
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.FollowRelation(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.FollowingColumn), fks...))
+	query.Where(predicate.MuteRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MutedByColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_following
+		fk := n.user_muted_by
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_following" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_muted_by" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_following" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_muted_by" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadFollowers(ctx context.Context, query *FollowRelationQuery, nodes []*User, init func(*User), assign func(*User, *FollowRelation)) error {
+func (uq *UserQuery) loadMutedKeywords(ctx context.Context, query *MutedKeywordQuery, nodes []*User, init func(*User), assign func(*User, *MutedKeyword)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -963,28 +1992,27 @@ func (uq *UserQuery) loadFollowers(ctx context.Context, query *FollowRelationQue
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.FollowRelation(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.FollowersColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(mutedkeyword.FieldUserID)
+	}
+	query.Where(predicate.MutedKeyword(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MutedKeywordsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_followers
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_followers" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_followers" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadBlocking(ctx context.Context, query *BlockRelationQuery, nodes []*User, init func(*User), assign func(*User, *BlockRelation)) error {
+func (uq *UserQuery) loadFollowRequestsSent(ctx context.Context, query *FollowRequestQuery, nodes []*User, init func(*User), assign func(*User, *FollowRequest)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -995,27 +2023,27 @@ func (uq *UserQuery) loadBlocking(ctx context.Context, query *BlockRelationQuery
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.BlockRelation(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.BlockingColumn), fks...))
+	query.Where(predicate.FollowRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FollowRequestsSentColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_blocking
+		fk := n.user_follow_requests_sent
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_blocking" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_follow_requests_sent" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_blocking" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_follow_requests_sent" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadBlockedBy(ctx context.Context, query *BlockRelationQuery, nodes []*User, init func(*User), assign func(*User, *BlockRelation)) error {
+func (uq *UserQuery) loadFollowRequestsReceived(ctx context.Context, query *FollowRequestQuery, nodes []*User, init func(*User), assign func(*User, *FollowRequest)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -1026,27 +2054,149 @@ func (uq *UserQuery) loadBlockedBy(ctx context.Context, query *BlockRelationQuer
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.BlockRelation(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.BlockedByColumn), fks...))
+	query.Where(predicate.FollowRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FollowRequestsReceivedColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_blocked_by
+		fk := n.user_follow_requests_received
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_blocked_by" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_follow_requests_received" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_blocked_by" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_follow_requests_received" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadDailyTasks(ctx context.Context, query *DailyTaskQuery, nodes []*User, init func(*User), assign func(*User, *DailyTask)) error {
+func (uq *UserQuery) loadMentionedInPosts(ctx context.Context, query *PostQuery, nodes []*User, init func(*User), assign func(*User, *Post)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.MentionedInPostsTable)
+		s.Join(joinT).On(s.C(post.FieldID), joinT.C(user.MentionedInPostsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(user.MentionedInPostsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.MentionedInPostsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Post](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "mentioned_in_posts" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadMentionedInComments(ctx context.Context, query *CommentQuery, nodes []*User, init func(*User), assign func(*User, *Comment)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.MentionedInCommentsTable)
+		s.Join(joinT).On(s.C(comment.FieldID), joinT.C(user.MentionedInCommentsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(user.MentionedInCommentsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.MentionedInCommentsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Comment](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "mentioned_in_comments" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*User, init func(*User), assign func(*User, *Notification)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -1056,28 +2206,57 @@ func (uq *UserQuery) loadDailyTasks(ctx context.Context, query *DailyTaskQuery,
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.DailyTask(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.DailyTasksColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldRecipientID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_daily_tasks
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_daily_tasks" is nil for node %v`, n.ID)
+		fk := n.RecipientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "recipient_id" returned %v for node %v`, fk, n.ID)
 		}
-		node, ok := nodeids[*fk]
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadNotificationsSent(ctx context.Context, query *NotificationQuery, nodes []*User, init func(*User), assign func(*User, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldActorID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationsSentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ActorID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_daily_tasks" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "actor_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadDeviceTokens(ctx context.Context, query *DeviceTokenQuery, nodes []*User, init func(*User), assign func(*User, *DeviceToken)) error {
+func (uq *UserQuery) loadCommentLikes(ctx context.Context, query *CommentLikeQuery, nodes []*User, init func(*User), assign func(*User, *CommentLike)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -1088,10 +2267,10 @@ func (uq *UserQuery) loadDeviceTokens(ctx context.Context, query *DeviceTokenQue
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(devicetoken.FieldUserID)
+		query.ctx.AppendFieldOnce(commentlike.FieldUserID)
 	}
-	query.Where(predicate.DeviceToken(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.DeviceTokensColumn), fks...))
+	query.Where(predicate.CommentLike(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CommentLikesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
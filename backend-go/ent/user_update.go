@@ -13,13 +13,21 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
 	"github.com/aki-13627/animalia/backend-go/ent/devicetoken"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
 	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -51,6 +59,26 @@ func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
 	return uu
 }
 
+// SetAuthSubject sets the "auth_subject" field.
+func (uu *UserUpdate) SetAuthSubject(s string) *UserUpdate {
+	uu.mutation.SetAuthSubject(s)
+	return uu
+}
+
+// SetNillableAuthSubject sets the "auth_subject" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAuthSubject(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetAuthSubject(*s)
+	}
+	return uu
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (uu *UserUpdate) ClearAuthSubject() *UserUpdate {
+	uu.mutation.ClearAuthSubject()
+	return uu
+}
+
 // SetName sets the "name" field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
@@ -65,6 +93,26 @@ func (uu *UserUpdate) SetNillableName(s *string) *UserUpdate {
 	return uu
 }
 
+// SetHandle sets the "handle" field.
+func (uu *UserUpdate) SetHandle(s string) *UserUpdate {
+	uu.mutation.SetHandle(s)
+	return uu
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableHandle(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetHandle(*s)
+	}
+	return uu
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (uu *UserUpdate) ClearHandle() *UserUpdate {
+	uu.mutation.ClearHandle()
+	return uu
+}
+
 // SetBio sets the "bio" field.
 func (uu *UserUpdate) SetBio(s string) *UserUpdate {
 	uu.mutation.SetBio(s)
@@ -100,6 +148,20 @@ func (uu *UserUpdate) AddStreakCount(u int32) *UserUpdate {
 	return uu
 }
 
+// SetRole sets the "role" field.
+func (uu *UserUpdate) SetRole(e enum.Role) *UserUpdate {
+	uu.mutation.SetRole(e)
+	return uu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(e *enum.Role) *UserUpdate {
+	if e != nil {
+		uu.SetRole(*e)
+	}
+	return uu
+}
+
 // SetIconImageKey sets the "icon_image_key" field.
 func (uu *UserUpdate) SetIconImageKey(s string) *UserUpdate {
 	uu.mutation.SetIconImageKey(s)
@@ -120,6 +182,20 @@ func (uu *UserUpdate) ClearIconImageKey() *UserUpdate {
 	return uu
 }
 
+// SetIsPrivate sets the "is_private" field.
+func (uu *UserUpdate) SetIsPrivate(b bool) *UserUpdate {
+	uu.mutation.SetIsPrivate(b)
+	return uu
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIsPrivate(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetIsPrivate(*b)
+	}
+	return uu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uu *UserUpdate) SetCreatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetCreatedAt(t)
@@ -134,6 +210,69 @@ func (uu *UserUpdate) SetNillableCreatedAt(t *time.Time) *UserUpdate {
 	return uu
 }
 
+// SetFollowerCount sets the "follower_count" field.
+func (uu *UserUpdate) SetFollowerCount(i int) *UserUpdate {
+	uu.mutation.ResetFollowerCount()
+	uu.mutation.SetFollowerCount(i)
+	return uu
+}
+
+// SetNillableFollowerCount sets the "follower_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFollowerCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetFollowerCount(*i)
+	}
+	return uu
+}
+
+// AddFollowerCount adds i to the "follower_count" field.
+func (uu *UserUpdate) AddFollowerCount(i int) *UserUpdate {
+	uu.mutation.AddFollowerCount(i)
+	return uu
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (uu *UserUpdate) SetFollowingCount(i int) *UserUpdate {
+	uu.mutation.ResetFollowingCount()
+	uu.mutation.SetFollowingCount(i)
+	return uu
+}
+
+// SetNillableFollowingCount sets the "following_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableFollowingCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetFollowingCount(*i)
+	}
+	return uu
+}
+
+// AddFollowingCount adds i to the "following_count" field.
+func (uu *UserUpdate) AddFollowingCount(i int) *UserUpdate {
+	uu.mutation.AddFollowingCount(i)
+	return uu
+}
+
+// SetPostCount sets the "post_count" field.
+func (uu *UserUpdate) SetPostCount(i int) *UserUpdate {
+	uu.mutation.ResetPostCount()
+	uu.mutation.SetPostCount(i)
+	return uu
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePostCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetPostCount(*i)
+	}
+	return uu
+}
+
+// AddPostCount adds i to the "post_count" field.
+func (uu *UserUpdate) AddPostCount(i int) *UserUpdate {
+	uu.mutation.AddPostCount(i)
+	return uu
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (uu *UserUpdate) AddPostIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddPostIDs(ids...)
@@ -284,6 +423,231 @@ func (uu *UserUpdate) AddDeviceTokens(d ...*DeviceToken) *UserUpdate {
 	return uu.AddDeviceTokenIDs(ids...)
 }
 
+// AddReportsFiledIDs adds the "reports_filed" edge to the Report entity by IDs.
+func (uu *UserUpdate) AddReportsFiledIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddReportsFiledIDs(ids...)
+	return uu
+}
+
+// AddReportsFiled adds the "reports_filed" edges to the Report entity.
+func (uu *UserUpdate) AddReportsFiled(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddReportsFiledIDs(ids...)
+}
+
+// AddReportsReceivedIDs adds the "reports_received" edge to the Report entity by IDs.
+func (uu *UserUpdate) AddReportsReceivedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddReportsReceivedIDs(ids...)
+	return uu
+}
+
+// AddReportsReceived adds the "reports_received" edges to the Report entity.
+func (uu *UserUpdate) AddReportsReceived(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddReportsReceivedIDs(ids...)
+}
+
+// AddReportsResolvedIDs adds the "reports_resolved" edge to the Report entity by IDs.
+func (uu *UserUpdate) AddReportsResolvedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddReportsResolvedIDs(ids...)
+	return uu
+}
+
+// AddReportsResolved adds the "reports_resolved" edges to the Report entity.
+func (uu *UserUpdate) AddReportsResolved(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddReportsResolvedIDs(ids...)
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by IDs.
+func (uu *UserUpdate) AddSuspensionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddSuspensionIDs(ids...)
+	return uu
+}
+
+// AddSuspensions adds the "suspensions" edges to the Suspension entity.
+func (uu *UserUpdate) AddSuspensions(s ...*Suspension) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSuspensionIDs(ids...)
+}
+
+// AddSuspensionsIssuedIDs adds the "suspensions_issued" edge to the Suspension entity by IDs.
+func (uu *UserUpdate) AddSuspensionsIssuedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddSuspensionsIssuedIDs(ids...)
+	return uu
+}
+
+// AddSuspensionsIssued adds the "suspensions_issued" edges to the Suspension entity.
+func (uu *UserUpdate) AddSuspensionsIssued(s ...*Suspension) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSuspensionsIssuedIDs(ids...)
+}
+
+// AddMutingIDs adds the "muting" edge to the MuteRelation entity by IDs.
+func (uu *UserUpdate) AddMutingIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMutingIDs(ids...)
+	return uu
+}
+
+// AddMuting adds the "muting" edges to the MuteRelation entity.
+func (uu *UserUpdate) AddMuting(m ...*MuteRelation) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMutingIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the MuteRelation entity by IDs.
+func (uu *UserUpdate) AddMutedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMutedByIDs(ids...)
+	return uu
+}
+
+// AddMutedBy adds the "muted_by" edges to the MuteRelation entity.
+func (uu *UserUpdate) AddMutedBy(m ...*MuteRelation) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMutedByIDs(ids...)
+}
+
+// AddMutedKeywordIDs adds the "muted_keywords" edge to the MutedKeyword entity by IDs.
+func (uu *UserUpdate) AddMutedKeywordIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMutedKeywordIDs(ids...)
+	return uu
+}
+
+// AddMutedKeywords adds the "muted_keywords" edges to the MutedKeyword entity.
+func (uu *UserUpdate) AddMutedKeywords(m ...*MutedKeyword) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMutedKeywordIDs(ids...)
+}
+
+// AddFollowRequestsSentIDs adds the "follow_requests_sent" edge to the FollowRequest entity by IDs.
+func (uu *UserUpdate) AddFollowRequestsSentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddFollowRequestsSentIDs(ids...)
+	return uu
+}
+
+// AddFollowRequestsSent adds the "follow_requests_sent" edges to the FollowRequest entity.
+func (uu *UserUpdate) AddFollowRequestsSent(f ...*FollowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFollowRequestsSentIDs(ids...)
+}
+
+// AddFollowRequestsReceivedIDs adds the "follow_requests_received" edge to the FollowRequest entity by IDs.
+func (uu *UserUpdate) AddFollowRequestsReceivedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddFollowRequestsReceivedIDs(ids...)
+	return uu
+}
+
+// AddFollowRequestsReceived adds the "follow_requests_received" edges to the FollowRequest entity.
+func (uu *UserUpdate) AddFollowRequestsReceived(f ...*FollowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFollowRequestsReceivedIDs(ids...)
+}
+
+// AddMentionedInPostIDs adds the "mentioned_in_posts" edge to the Post entity by IDs.
+func (uu *UserUpdate) AddMentionedInPostIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMentionedInPostIDs(ids...)
+	return uu
+}
+
+// AddMentionedInPosts adds the "mentioned_in_posts" edges to the Post entity.
+func (uu *UserUpdate) AddMentionedInPosts(p ...*Post) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddMentionedInPostIDs(ids...)
+}
+
+// AddMentionedInCommentIDs adds the "mentioned_in_comments" edge to the Comment entity by IDs.
+func (uu *UserUpdate) AddMentionedInCommentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMentionedInCommentIDs(ids...)
+	return uu
+}
+
+// AddMentionedInComments adds the "mentioned_in_comments" edges to the Comment entity.
+func (uu *UserUpdate) AddMentionedInComments(c ...*Comment) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddMentionedInCommentIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uu *UserUpdate) AddNotificationIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddNotificationIDs(ids...)
+	return uu
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uu *UserUpdate) AddNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.AddNotificationIDs(ids...)
+}
+
+// AddNotificationsSentIDs adds the "notifications_sent" edge to the Notification entity by IDs.
+func (uu *UserUpdate) AddNotificationsSentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddNotificationsSentIDs(ids...)
+	return uu
+}
+
+// AddNotificationsSent adds the "notifications_sent" edges to the Notification entity.
+func (uu *UserUpdate) AddNotificationsSent(n ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.AddNotificationsSentIDs(ids...)
+}
+
+// AddCommentLikeIDs adds the "comment_likes" edge to the CommentLike entity by IDs.
+func (uu *UserUpdate) AddCommentLikeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddCommentLikeIDs(ids...)
+	return uu
+}
+
+// AddCommentLikes adds the "comment_likes" edges to the CommentLike entity.
+func (uu *UserUpdate) AddCommentLikes(c ...*CommentLike) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddCommentLikeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -499,69 +863,396 @@ func (uu *UserUpdate) RemoveDeviceTokens(d ...*DeviceToken) *UserUpdate {
 	return uu.RemoveDeviceTokenIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+// ClearReportsFiled clears all "reports_filed" edges to the Report entity.
+func (uu *UserUpdate) ClearReportsFiled() *UserUpdate {
+	uu.mutation.ClearReportsFiled()
+	return uu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveReportsFiledIDs removes the "reports_filed" edge to Report entities by IDs.
+func (uu *UserUpdate) RemoveReportsFiledIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveReportsFiledIDs(ids...)
+	return uu
+}
+
+// RemoveReportsFiled removes "reports_filed" edges to Report entities.
+func (uu *UserUpdate) RemoveReportsFiled(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	return affected
+	return uu.RemoveReportsFiledIDs(ids...)
 }
 
-// Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
-	return err
+// ClearReportsReceived clears all "reports_received" edges to the Report entity.
+func (uu *UserUpdate) ClearReportsReceived() *UserUpdate {
+	uu.mutation.ClearReportsReceived()
+	return uu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
-		panic(err)
+// RemoveReportsReceivedIDs removes the "reports_received" edge to Report entities by IDs.
+func (uu *UserUpdate) RemoveReportsReceivedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveReportsReceivedIDs(ids...)
+	return uu
+}
+
+// RemoveReportsReceived removes "reports_received" edges to Report entities.
+func (uu *UserUpdate) RemoveReportsReceived(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
+	return uu.RemoveReportsReceivedIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (uu *UserUpdate) check() error {
-	if v, ok := uu.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
-		}
+// ClearReportsResolved clears all "reports_resolved" edges to the Report entity.
+func (uu *UserUpdate) ClearReportsResolved() *UserUpdate {
+	uu.mutation.ClearReportsResolved()
+	return uu
+}
+
+// RemoveReportsResolvedIDs removes the "reports_resolved" edge to Report entities by IDs.
+func (uu *UserUpdate) RemoveReportsResolvedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveReportsResolvedIDs(ids...)
+	return uu
+}
+
+// RemoveReportsResolved removes "reports_resolved" edges to Report entities.
+func (uu *UserUpdate) RemoveReportsResolved(r ...*Report) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	if v, ok := uu.mutation.Name(); ok {
-		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
-		}
+	return uu.RemoveReportsResolvedIDs(ids...)
+}
+
+// ClearSuspensions clears all "suspensions" edges to the Suspension entity.
+func (uu *UserUpdate) ClearSuspensions() *UserUpdate {
+	uu.mutation.ClearSuspensions()
+	return uu
+}
+
+// RemoveSuspensionIDs removes the "suspensions" edge to Suspension entities by IDs.
+func (uu *UserUpdate) RemoveSuspensionIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveSuspensionIDs(ids...)
+	return uu
+}
+
+// RemoveSuspensions removes "suspensions" edges to Suspension entities.
+func (uu *UserUpdate) RemoveSuspensions(s ...*Suspension) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return nil
+	return uu.RemoveSuspensionIDs(ids...)
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := uu.check(); err != nil {
-		return n, err
+// ClearSuspensionsIssued clears all "suspensions_issued" edges to the Suspension entity.
+func (uu *UserUpdate) ClearSuspensionsIssued() *UserUpdate {
+	uu.mutation.ClearSuspensionsIssued()
+	return uu
+}
+
+// RemoveSuspensionsIssuedIDs removes the "suspensions_issued" edge to Suspension entities by IDs.
+func (uu *UserUpdate) RemoveSuspensionsIssuedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveSuspensionsIssuedIDs(ids...)
+	return uu
+}
+
+// RemoveSuspensionsIssued removes "suspensions_issued" edges to Suspension entities.
+func (uu *UserUpdate) RemoveSuspensionsIssued(s ...*Suspension) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	if ps := uu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return uu.RemoveSuspensionsIssuedIDs(ids...)
+}
+
+// ClearMuting clears all "muting" edges to the MuteRelation entity.
+func (uu *UserUpdate) ClearMuting() *UserUpdate {
+	uu.mutation.ClearMuting()
+	return uu
+}
+
+// RemoveMutingIDs removes the "muting" edge to MuteRelation entities by IDs.
+func (uu *UserUpdate) RemoveMutingIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMutingIDs(ids...)
+	return uu
+}
+
+// RemoveMuting removes "muting" edges to MuteRelation entities.
+func (uu *UserUpdate) RemoveMuting(m ...*MuteRelation) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	if uu.mutation.IndexCleared() {
-		_spec.ClearField(user.FieldIndex, field.TypeUint32)
+	return uu.RemoveMutingIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the MuteRelation entity.
+func (uu *UserUpdate) ClearMutedBy() *UserUpdate {
+	uu.mutation.ClearMutedBy()
+	return uu
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to MuteRelation entities by IDs.
+func (uu *UserUpdate) RemoveMutedByIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMutedByIDs(ids...)
+	return uu
+}
+
+// RemoveMutedBy removes "muted_by" edges to MuteRelation entities.
+func (uu *UserUpdate) RemoveMutedBy(m ...*MuteRelation) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	if value, ok := uu.mutation.Email(); ok {
+	return uu.RemoveMutedByIDs(ids...)
+}
+
+// ClearMutedKeywords clears all "muted_keywords" edges to the MutedKeyword entity.
+func (uu *UserUpdate) ClearMutedKeywords() *UserUpdate {
+	uu.mutation.ClearMutedKeywords()
+	return uu
+}
+
+// RemoveMutedKeywordIDs removes the "muted_keywords" edge to MutedKeyword entities by IDs.
+func (uu *UserUpdate) RemoveMutedKeywordIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMutedKeywordIDs(ids...)
+	return uu
+}
+
+// RemoveMutedKeywords removes "muted_keywords" edges to MutedKeyword entities.
+func (uu *UserUpdate) RemoveMutedKeywords(m ...*MutedKeyword) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMutedKeywordIDs(ids...)
+}
+
+// ClearFollowRequestsSent clears all "follow_requests_sent" edges to the FollowRequest entity.
+func (uu *UserUpdate) ClearFollowRequestsSent() *UserUpdate {
+	uu.mutation.ClearFollowRequestsSent()
+	return uu
+}
+
+// RemoveFollowRequestsSentIDs removes the "follow_requests_sent" edge to FollowRequest entities by IDs.
+func (uu *UserUpdate) RemoveFollowRequestsSentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveFollowRequestsSentIDs(ids...)
+	return uu
+}
+
+// RemoveFollowRequestsSent removes "follow_requests_sent" edges to FollowRequest entities.
+func (uu *UserUpdate) RemoveFollowRequestsSent(f ...*FollowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFollowRequestsSentIDs(ids...)
+}
+
+// ClearFollowRequestsReceived clears all "follow_requests_received" edges to the FollowRequest entity.
+func (uu *UserUpdate) ClearFollowRequestsReceived() *UserUpdate {
+	uu.mutation.ClearFollowRequestsReceived()
+	return uu
+}
+
+// RemoveFollowRequestsReceivedIDs removes the "follow_requests_received" edge to FollowRequest entities by IDs.
+func (uu *UserUpdate) RemoveFollowRequestsReceivedIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveFollowRequestsReceivedIDs(ids...)
+	return uu
+}
+
+// RemoveFollowRequestsReceived removes "follow_requests_received" edges to FollowRequest entities.
+func (uu *UserUpdate) RemoveFollowRequestsReceived(f ...*FollowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFollowRequestsReceivedIDs(ids...)
+}
+
+// ClearMentionedInPosts clears all "mentioned_in_posts" edges to the Post entity.
+func (uu *UserUpdate) ClearMentionedInPosts() *UserUpdate {
+	uu.mutation.ClearMentionedInPosts()
+	return uu
+}
+
+// RemoveMentionedInPostIDs removes the "mentioned_in_posts" edge to Post entities by IDs.
+func (uu *UserUpdate) RemoveMentionedInPostIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMentionedInPostIDs(ids...)
+	return uu
+}
+
+// RemoveMentionedInPosts removes "mentioned_in_posts" edges to Post entities.
+func (uu *UserUpdate) RemoveMentionedInPosts(p ...*Post) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemoveMentionedInPostIDs(ids...)
+}
+
+// ClearMentionedInComments clears all "mentioned_in_comments" edges to the Comment entity.
+func (uu *UserUpdate) ClearMentionedInComments() *UserUpdate {
+	uu.mutation.ClearMentionedInComments()
+	return uu
+}
+
+// RemoveMentionedInCommentIDs removes the "mentioned_in_comments" edge to Comment entities by IDs.
+func (uu *UserUpdate) RemoveMentionedInCommentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMentionedInCommentIDs(ids...)
+	return uu
+}
+
+// RemoveMentionedInComments removes "mentioned_in_comments" edges to Comment entities.
+func (uu *UserUpdate) RemoveMentionedInComments(c ...*Comment) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveMentionedInCommentIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uu *UserUpdate) ClearNotifications() *UserUpdate {
+	uu.mutation.ClearNotifications()
+	return uu
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uu *UserUpdate) RemoveNotificationIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveNotificationIDs(ids...)
+	return uu
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uu *UserUpdate) RemoveNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationsSent clears all "notifications_sent" edges to the Notification entity.
+func (uu *UserUpdate) ClearNotificationsSent() *UserUpdate {
+	uu.mutation.ClearNotificationsSent()
+	return uu
+}
+
+// RemoveNotificationsSentIDs removes the "notifications_sent" edge to Notification entities by IDs.
+func (uu *UserUpdate) RemoveNotificationsSentIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveNotificationsSentIDs(ids...)
+	return uu
+}
+
+// RemoveNotificationsSent removes "notifications_sent" edges to Notification entities.
+func (uu *UserUpdate) RemoveNotificationsSent(n ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveNotificationsSentIDs(ids...)
+}
+
+// ClearCommentLikes clears all "comment_likes" edges to the CommentLike entity.
+func (uu *UserUpdate) ClearCommentLikes() *UserUpdate {
+	uu.mutation.ClearCommentLikes()
+	return uu
+}
+
+// RemoveCommentLikeIDs removes the "comment_likes" edge to CommentLike entities by IDs.
+func (uu *UserUpdate) RemoveCommentLikeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveCommentLikeIDs(ids...)
+	return uu
+}
+
+// RemoveCommentLikes removes "comment_likes" edges to CommentLike entities.
+func (uu *UserUpdate) RemoveCommentLikes(c ...*CommentLike) *UserUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCommentLikeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UserUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UserUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if uu.mutation.IndexCleared() {
+		_spec.ClearField(user.FieldIndex, field.TypeUint32)
+	}
+	if value, ok := uu.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.AuthSubject(); ok {
+		_spec.SetField(user.FieldAuthSubject, field.TypeString, value)
+	}
+	if uu.mutation.AuthSubjectCleared() {
+		_spec.ClearField(user.FieldAuthSubject, field.TypeString)
+	}
 	if value, ok := uu.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.Handle(); ok {
+		_spec.SetField(user.FieldHandle, field.TypeString, value)
+	}
+	if uu.mutation.HandleCleared() {
+		_spec.ClearField(user.FieldHandle, field.TypeString)
+	}
 	if value, ok := uu.mutation.Bio(); ok {
 		_spec.SetField(user.FieldBio, field.TypeString, value)
 	}
@@ -571,15 +1262,39 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.AddedStreakCount(); ok {
 		_spec.AddField(user.FieldStreakCount, field.TypeUint32, value)
 	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeString, value)
+	}
 	if value, ok := uu.mutation.IconImageKey(); ok {
 		_spec.SetField(user.FieldIconImageKey, field.TypeString, value)
 	}
 	if uu.mutation.IconImageKeyCleared() {
 		_spec.ClearField(user.FieldIconImageKey, field.TypeString)
 	}
+	if value, ok := uu.mutation.IsPrivate(); ok {
+		_spec.SetField(user.FieldIsPrivate, field.TypeBool, value)
+	}
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := uu.mutation.FollowerCount(); ok {
+		_spec.SetField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedFollowerCount(); ok {
+		_spec.AddField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.FollowingCount(); ok {
+		_spec.SetField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedFollowingCount(); ok {
+		_spec.AddField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.PostCount(); ok {
+		_spec.SetField(user.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedPostCount(); ok {
+		_spec.AddField(user.FieldPostCount, field.TypeInt, value)
+	}
 	if uu.mutation.PostsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1030,621 +1745,2588 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.ReportsFiledCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsFiledTable,
+			Columns: []string{user.ReportsFiledColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.RemovedReportsFiledIDs(); len(nodes) > 0 && !uu.mutation.ReportsFiledCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsFiledTable,
+			Columns: []string{user.ReportsFiledColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetName sets the "name" field.
-func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
-	uuo.mutation.SetName(s)
-	return uuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetName(*s)
+	if nodes := uu.mutation.ReportsFiledIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsFiledTable,
+			Columns: []string{user.ReportsFiledColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetBio sets the "bio" field.
-func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
-	uuo.mutation.SetBio(s)
-	return uuo
-}
-
-// SetNillableBio sets the "bio" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetBio(*s)
+	if uu.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetStreakCount sets the "streak_count" field.
-func (uuo *UserUpdateOne) SetStreakCount(u uint32) *UserUpdateOne {
-	uuo.mutation.ResetStreakCount()
-	uuo.mutation.SetStreakCount(u)
-	return uuo
-}
-
-// SetNillableStreakCount sets the "streak_count" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableStreakCount(u *uint32) *UserUpdateOne {
-	if u != nil {
-		uuo.SetStreakCount(*u)
+	if nodes := uu.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !uu.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// AddStreakCount adds u to the "streak_count" field.
-func (uuo *UserUpdateOne) AddStreakCount(u int32) *UserUpdateOne {
-	uuo.mutation.AddStreakCount(u)
-	return uuo
-}
-
-// SetIconImageKey sets the "icon_image_key" field.
-func (uuo *UserUpdateOne) SetIconImageKey(s string) *UserUpdateOne {
-	uuo.mutation.SetIconImageKey(s)
-	return uuo
-}
-
-// SetNillableIconImageKey sets the "icon_image_key" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableIconImageKey(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetIconImageKey(*s)
+	if nodes := uu.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearIconImageKey clears the value of the "icon_image_key" field.
-func (uuo *UserUpdateOne) ClearIconImageKey() *UserUpdateOne {
-	uuo.mutation.ClearIconImageKey()
-	return uuo
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetCreatedAt(t)
-	return uuo
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetCreatedAt(*t)
+	if uu.mutation.ReportsResolvedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// AddPostIDs adds the "posts" edge to the Post entity by IDs.
-func (uuo *UserUpdateOne) AddPostIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddPostIDs(ids...)
-	return uuo
-}
-
-// AddPosts adds the "posts" edges to the Post entity.
-func (uuo *UserUpdateOne) AddPosts(p ...*Post) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uu.mutation.RemovedReportsResolvedIDs(); len(nodes) > 0 && !uu.mutation.ReportsResolvedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddPostIDs(ids...)
-}
-
-// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
-func (uuo *UserUpdateOne) AddCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddCommentIDs(ids...)
-	return uuo
-}
-
-// AddComments adds the "comments" edges to the Comment entity.
-func (uuo *UserUpdateOne) AddComments(c ...*Comment) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uu.mutation.ReportsResolvedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddCommentIDs(ids...)
-}
-
-// AddLikeIDs adds the "likes" edge to the Like entity by IDs.
-func (uuo *UserUpdateOne) AddLikeIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddLikeIDs(ids...)
-	return uuo
-}
-
-// AddLikes adds the "likes" edges to the Like entity.
-func (uuo *UserUpdateOne) AddLikes(l ...*Like) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+	if uu.mutation.SuspensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddLikeIDs(ids...)
-}
-
-// AddPetIDs adds the "pets" edge to the Pet entity by IDs.
-func (uuo *UserUpdateOne) AddPetIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddPetIDs(ids...)
-	return uuo
-}
-
-// AddPets adds the "pets" edges to the Pet entity.
-func (uuo *UserUpdateOne) AddPets(p ...*Pet) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uu.mutation.RemovedSuspensionsIDs(); len(nodes) > 0 && !uu.mutation.SuspensionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddPetIDs(ids...)
-}
-
-// AddFollowingIDs adds the "following" edge to the FollowRelation entity by IDs.
-func (uuo *UserUpdateOne) AddFollowingIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddFollowingIDs(ids...)
-	return uuo
-}
-
-// AddFollowing adds the "following" edges to the FollowRelation entity.
-func (uuo *UserUpdateOne) AddFollowing(f ...*FollowRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.SuspensionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddFollowingIDs(ids...)
-}
-
-// AddFollowerIDs adds the "followers" edge to the FollowRelation entity by IDs.
-func (uuo *UserUpdateOne) AddFollowerIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddFollowerIDs(ids...)
-	return uuo
-}
-
-// AddFollowers adds the "followers" edges to the FollowRelation entity.
-func (uuo *UserUpdateOne) AddFollowers(f ...*FollowRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if uu.mutation.SuspensionsIssuedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsIssuedTable,
+			Columns: []string{user.SuspensionsIssuedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddFollowerIDs(ids...)
-}
-
-// AddBlockingIDs adds the "blocking" edge to the BlockRelation entity by IDs.
-func (uuo *UserUpdateOne) AddBlockingIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddBlockingIDs(ids...)
-	return uuo
-}
-
-// AddBlocking adds the "blocking" edges to the BlockRelation entity.
-func (uuo *UserUpdateOne) AddBlocking(b ...*BlockRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+	if nodes := uu.mutation.RemovedSuspensionsIssuedIDs(); len(nodes) > 0 && !uu.mutation.SuspensionsIssuedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsIssuedTable,
+			Columns: []string{user.SuspensionsIssuedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddBlockingIDs(ids...)
-}
-
-// AddBlockedByIDs adds the "blocked_by" edge to the BlockRelation entity by IDs.
-func (uuo *UserUpdateOne) AddBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddBlockedByIDs(ids...)
-	return uuo
-}
-
-// AddBlockedBy adds the "blocked_by" edges to the BlockRelation entity.
-func (uuo *UserUpdateOne) AddBlockedBy(b ...*BlockRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+	if nodes := uu.mutation.SuspensionsIssuedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SuspensionsIssuedTable,
+			Columns: []string{user.SuspensionsIssuedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddBlockedByIDs(ids...)
-}
-
-// AddDailyTaskIDs adds the "daily_tasks" edge to the DailyTask entity by IDs.
-func (uuo *UserUpdateOne) AddDailyTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddDailyTaskIDs(ids...)
-	return uuo
-}
-
-// AddDailyTasks adds the "daily_tasks" edges to the DailyTask entity.
-func (uuo *UserUpdateOne) AddDailyTasks(d ...*DailyTask) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+	if uu.mutation.MutingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddDailyTaskIDs(ids...)
-}
-
-// AddDeviceTokenIDs adds the "device_tokens" edge to the DeviceToken entity by IDs.
-func (uuo *UserUpdateOne) AddDeviceTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddDeviceTokenIDs(ids...)
-	return uuo
-}
-
-// AddDeviceTokens adds the "device_tokens" edges to the DeviceToken entity.
-func (uuo *UserUpdateOne) AddDeviceTokens(d ...*DeviceToken) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+	if nodes := uu.mutation.RemovedMutingIDs(); len(nodes) > 0 && !uu.mutation.MutingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddDeviceTokenIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
-}
-
-// ClearPosts clears all "posts" edges to the Post entity.
-func (uuo *UserUpdateOne) ClearPosts() *UserUpdateOne {
-	uuo.mutation.ClearPosts()
-	return uuo
-}
-
-// RemovePostIDs removes the "posts" edge to Post entities by IDs.
-func (uuo *UserUpdateOne) RemovePostIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemovePostIDs(ids...)
-	return uuo
-}
-
-// RemovePosts removes "posts" edges to Post entities.
-func (uuo *UserUpdateOne) RemovePosts(p ...*Post) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uu.mutation.MutingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemovePostIDs(ids...)
-}
-
-// ClearComments clears all "comments" edges to the Comment entity.
-func (uuo *UserUpdateOne) ClearComments() *UserUpdateOne {
-	uuo.mutation.ClearComments()
-	return uuo
-}
-
-// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
-func (uuo *UserUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveCommentIDs(ids...)
-	return uuo
-}
-
-// RemoveComments removes "comments" edges to Comment entities.
-func (uuo *UserUpdateOne) RemoveComments(c ...*Comment) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveCommentIDs(ids...)
-}
-
-// ClearLikes clears all "likes" edges to the Like entity.
-func (uuo *UserUpdateOne) ClearLikes() *UserUpdateOne {
-	uuo.mutation.ClearLikes()
-	return uuo
-}
-
-// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
-func (uuo *UserUpdateOne) RemoveLikeIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveLikeIDs(ids...)
-	return uuo
-}
-
-// RemoveLikes removes "likes" edges to Like entities.
-func (uuo *UserUpdateOne) RemoveLikes(l ...*Like) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+	if nodes := uu.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uu.mutation.MutedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveLikeIDs(ids...)
-}
-
-// ClearPets clears all "pets" edges to the Pet entity.
-func (uuo *UserUpdateOne) ClearPets() *UserUpdateOne {
-	uuo.mutation.ClearPets()
-	return uuo
-}
-
-// RemovePetIDs removes the "pets" edge to Pet entities by IDs.
-func (uuo *UserUpdateOne) RemovePetIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemovePetIDs(ids...)
-	return uuo
-}
-
-// RemovePets removes "pets" edges to Pet entities.
-func (uuo *UserUpdateOne) RemovePets(p ...*Pet) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uu.mutation.MutedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemovePetIDs(ids...)
-}
-
-// ClearFollowing clears all "following" edges to the FollowRelation entity.
-func (uuo *UserUpdateOne) ClearFollowing() *UserUpdateOne {
-	uuo.mutation.ClearFollowing()
-	return uuo
-}
-
-// RemoveFollowingIDs removes the "following" edge to FollowRelation entities by IDs.
-func (uuo *UserUpdateOne) RemoveFollowingIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveFollowingIDs(ids...)
-	return uuo
-}
-
-// RemoveFollowing removes "following" edges to FollowRelation entities.
-func (uuo *UserUpdateOne) RemoveFollowing(f ...*FollowRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if uu.mutation.MutedKeywordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedKeywordsTable,
+			Columns: []string{user.MutedKeywordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveFollowingIDs(ids...)
-}
-
-// ClearFollowers clears all "followers" edges to the FollowRelation entity.
-func (uuo *UserUpdateOne) ClearFollowers() *UserUpdateOne {
-	uuo.mutation.ClearFollowers()
-	return uuo
-}
-
-// RemoveFollowerIDs removes the "followers" edge to FollowRelation entities by IDs.
-func (uuo *UserUpdateOne) RemoveFollowerIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveFollowerIDs(ids...)
-	return uuo
-}
-
-// RemoveFollowers removes "followers" edges to FollowRelation entities.
-func (uuo *UserUpdateOne) RemoveFollowers(f ...*FollowRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.RemovedMutedKeywordsIDs(); len(nodes) > 0 && !uu.mutation.MutedKeywordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedKeywordsTable,
+			Columns: []string{user.MutedKeywordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveFollowerIDs(ids...)
-}
-
-// ClearBlocking clears all "blocking" edges to the BlockRelation entity.
-func (uuo *UserUpdateOne) ClearBlocking() *UserUpdateOne {
-	uuo.mutation.ClearBlocking()
-	return uuo
-}
-
-// RemoveBlockingIDs removes the "blocking" edge to BlockRelation entities by IDs.
+	if nodes := uu.mutation.MutedKeywordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MutedKeywordsTable,
+			Columns: []string{user.MutedKeywordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.FollowRequestsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowRequestsSentTable,
+			Columns: []string{user.FollowRequestsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedFollowRequestsSentIDs(); len(nodes) > 0 && !uu.mutation.FollowRequestsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowRequestsSentTable,
+			Columns: []string{user.FollowRequestsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FollowRequestsSentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowRequestsSentTable,
+			Columns: []string{user.FollowRequestsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.FollowRequestsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowRequestsReceivedTable,
+			Columns: []string{user.FollowRequestsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedFollowRequestsReceivedIDs(); len(nodes) > 0 && !uu.mutation.FollowRequestsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowRequestsReceivedTable,
+			Columns: []string{user.FollowRequestsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FollowRequestsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowRequestsReceivedTable,
+			Columns: []string{user.FollowRequestsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MentionedInPostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInPostsTable,
+			Columns: user.MentionedInPostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMentionedInPostsIDs(); len(nodes) > 0 && !uu.mutation.MentionedInPostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInPostsTable,
+			Columns: user.MentionedInPostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MentionedInPostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInPostsTable,
+			Columns: user.MentionedInPostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MentionedInCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInCommentsTable,
+			Columns: user.MentionedInCommentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMentionedInCommentsIDs(); len(nodes) > 0 && !uu.mutation.MentionedInCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInCommentsTable,
+			Columns: user.MentionedInCommentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MentionedInCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInCommentsTable,
+			Columns: user.MentionedInCommentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.NotificationsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsSentTable,
+			Columns: []string{user.NotificationsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedNotificationsSentIDs(); len(nodes) > 0 && !uu.mutation.NotificationsSentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsSentTable,
+			Columns: []string{user.NotificationsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NotificationsSentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsSentTable,
+			Columns: []string{user.NotificationsSentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.CommentLikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentLikesTable,
+			Columns: []string{user.CommentLikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCommentLikesIDs(); len(nodes) > 0 && !uu.mutation.CommentLikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentLikesTable,
+			Columns: []string{user.CommentLikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CommentLikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentLikesTable,
+			Columns: []string{user.CommentLikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetAuthSubject sets the "auth_subject" field.
+func (uuo *UserUpdateOne) SetAuthSubject(s string) *UserUpdateOne {
+	uuo.mutation.SetAuthSubject(s)
+	return uuo
+}
+
+// SetNillableAuthSubject sets the "auth_subject" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAuthSubject(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAuthSubject(*s)
+	}
+	return uuo
+}
+
+// ClearAuthSubject clears the value of the "auth_subject" field.
+func (uuo *UserUpdateOne) ClearAuthSubject() *UserUpdateOne {
+	uuo.mutation.ClearAuthSubject()
+	return uuo
+}
+
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
+	return uuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// SetHandle sets the "handle" field.
+func (uuo *UserUpdateOne) SetHandle(s string) *UserUpdateOne {
+	uuo.mutation.SetHandle(s)
+	return uuo
+}
+
+// SetNillableHandle sets the "handle" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableHandle(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetHandle(*s)
+	}
+	return uuo
+}
+
+// ClearHandle clears the value of the "handle" field.
+func (uuo *UserUpdateOne) ClearHandle() *UserUpdateOne {
+	uuo.mutation.ClearHandle()
+	return uuo
+}
+
+// SetBio sets the "bio" field.
+func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
+	uuo.mutation.SetBio(s)
+	return uuo
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBio(*s)
+	}
+	return uuo
+}
+
+// SetStreakCount sets the "streak_count" field.
+func (uuo *UserUpdateOne) SetStreakCount(u uint32) *UserUpdateOne {
+	uuo.mutation.ResetStreakCount()
+	uuo.mutation.SetStreakCount(u)
+	return uuo
+}
+
+// SetNillableStreakCount sets the "streak_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStreakCount(u *uint32) *UserUpdateOne {
+	if u != nil {
+		uuo.SetStreakCount(*u)
+	}
+	return uuo
+}
+
+// AddStreakCount adds u to the "streak_count" field.
+func (uuo *UserUpdateOne) AddStreakCount(u int32) *UserUpdateOne {
+	uuo.mutation.AddStreakCount(u)
+	return uuo
+}
+
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(e enum.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(e)
+	return uuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(e *enum.Role) *UserUpdateOne {
+	if e != nil {
+		uuo.SetRole(*e)
+	}
+	return uuo
+}
+
+// SetIconImageKey sets the "icon_image_key" field.
+func (uuo *UserUpdateOne) SetIconImageKey(s string) *UserUpdateOne {
+	uuo.mutation.SetIconImageKey(s)
+	return uuo
+}
+
+// SetNillableIconImageKey sets the "icon_image_key" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIconImageKey(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetIconImageKey(*s)
+	}
+	return uuo
+}
+
+// ClearIconImageKey clears the value of the "icon_image_key" field.
+func (uuo *UserUpdateOne) ClearIconImageKey() *UserUpdateOne {
+	uuo.mutation.ClearIconImageKey()
+	return uuo
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (uuo *UserUpdateOne) SetIsPrivate(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsPrivate(b)
+	return uuo
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsPrivate(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsPrivate(*b)
+	}
+	return uuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetCreatedAt(t)
+	return uuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetCreatedAt(*t)
+	}
+	return uuo
+}
+
+// SetFollowerCount sets the "follower_count" field.
+func (uuo *UserUpdateOne) SetFollowerCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetFollowerCount()
+	uuo.mutation.SetFollowerCount(i)
+	return uuo
+}
+
+// SetNillableFollowerCount sets the "follower_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFollowerCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetFollowerCount(*i)
+	}
+	return uuo
+}
+
+// AddFollowerCount adds i to the "follower_count" field.
+func (uuo *UserUpdateOne) AddFollowerCount(i int) *UserUpdateOne {
+	uuo.mutation.AddFollowerCount(i)
+	return uuo
+}
+
+// SetFollowingCount sets the "following_count" field.
+func (uuo *UserUpdateOne) SetFollowingCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetFollowingCount()
+	uuo.mutation.SetFollowingCount(i)
+	return uuo
+}
+
+// SetNillableFollowingCount sets the "following_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableFollowingCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetFollowingCount(*i)
+	}
+	return uuo
+}
+
+// AddFollowingCount adds i to the "following_count" field.
+func (uuo *UserUpdateOne) AddFollowingCount(i int) *UserUpdateOne {
+	uuo.mutation.AddFollowingCount(i)
+	return uuo
+}
+
+// SetPostCount sets the "post_count" field.
+func (uuo *UserUpdateOne) SetPostCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetPostCount()
+	uuo.mutation.SetPostCount(i)
+	return uuo
+}
+
+// SetNillablePostCount sets the "post_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePostCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetPostCount(*i)
+	}
+	return uuo
+}
+
+// AddPostCount adds i to the "post_count" field.
+func (uuo *UserUpdateOne) AddPostCount(i int) *UserUpdateOne {
+	uuo.mutation.AddPostCount(i)
+	return uuo
+}
+
+// AddPostIDs adds the "posts" edge to the Post entity by IDs.
+func (uuo *UserUpdateOne) AddPostIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddPostIDs(ids...)
+	return uuo
+}
+
+// AddPosts adds the "posts" edges to the Post entity.
+func (uuo *UserUpdateOne) AddPosts(p ...*Post) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPostIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (uuo *UserUpdateOne) AddCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddCommentIDs(ids...)
+	return uuo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (uuo *UserUpdateOne) AddComments(c ...*Comment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCommentIDs(ids...)
+}
+
+// AddLikeIDs adds the "likes" edge to the Like entity by IDs.
+func (uuo *UserUpdateOne) AddLikeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddLikeIDs(ids...)
+	return uuo
+}
+
+// AddLikes adds the "likes" edges to the Like entity.
+func (uuo *UserUpdateOne) AddLikes(l ...*Like) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.AddLikeIDs(ids...)
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by IDs.
+func (uuo *UserUpdateOne) AddPetIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddPetIDs(ids...)
+	return uuo
+}
+
+// AddPets adds the "pets" edges to the Pet entity.
+func (uuo *UserUpdateOne) AddPets(p ...*Pet) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPetIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the FollowRelation entity by IDs.
+func (uuo *UserUpdateOne) AddFollowingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddFollowingIDs(ids...)
+	return uuo
+}
+
+// AddFollowing adds the "following" edges to the FollowRelation entity.
+func (uuo *UserUpdateOne) AddFollowing(f ...*FollowRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFollowingIDs(ids...)
+}
+
+// AddFollowerIDs adds the "followers" edge to the FollowRelation entity by IDs.
+func (uuo *UserUpdateOne) AddFollowerIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddFollowerIDs(ids...)
+	return uuo
+}
+
+// AddFollowers adds the "followers" edges to the FollowRelation entity.
+func (uuo *UserUpdateOne) AddFollowers(f ...*FollowRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFollowerIDs(ids...)
+}
+
+// AddBlockingIDs adds the "blocking" edge to the BlockRelation entity by IDs.
+func (uuo *UserUpdateOne) AddBlockingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddBlockingIDs(ids...)
+	return uuo
+}
+
+// AddBlocking adds the "blocking" edges to the BlockRelation entity.
+func (uuo *UserUpdateOne) AddBlocking(b ...*BlockRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockingIDs(ids...)
+}
+
+// AddBlockedByIDs adds the "blocked_by" edge to the BlockRelation entity by IDs.
+func (uuo *UserUpdateOne) AddBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddBlockedByIDs(ids...)
+	return uuo
+}
+
+// AddBlockedBy adds the "blocked_by" edges to the BlockRelation entity.
+func (uuo *UserUpdateOne) AddBlockedBy(b ...*BlockRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlockedByIDs(ids...)
+}
+
+// AddDailyTaskIDs adds the "daily_tasks" edge to the DailyTask entity by IDs.
+func (uuo *UserUpdateOne) AddDailyTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddDailyTaskIDs(ids...)
+	return uuo
+}
+
+// AddDailyTasks adds the "daily_tasks" edges to the DailyTask entity.
+func (uuo *UserUpdateOne) AddDailyTasks(d ...*DailyTask) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDailyTaskIDs(ids...)
+}
+
+// AddDeviceTokenIDs adds the "device_tokens" edge to the DeviceToken entity by IDs.
+func (uuo *UserUpdateOne) AddDeviceTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddDeviceTokenIDs(ids...)
+	return uuo
+}
+
+// AddDeviceTokens adds the "device_tokens" edges to the DeviceToken entity.
+func (uuo *UserUpdateOne) AddDeviceTokens(d ...*DeviceToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDeviceTokenIDs(ids...)
+}
+
+// AddReportsFiledIDs adds the "reports_filed" edge to the Report entity by IDs.
+func (uuo *UserUpdateOne) AddReportsFiledIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddReportsFiledIDs(ids...)
+	return uuo
+}
+
+// AddReportsFiled adds the "reports_filed" edges to the Report entity.
+func (uuo *UserUpdateOne) AddReportsFiled(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddReportsFiledIDs(ids...)
+}
+
+// AddReportsReceivedIDs adds the "reports_received" edge to the Report entity by IDs.
+func (uuo *UserUpdateOne) AddReportsReceivedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddReportsReceivedIDs(ids...)
+	return uuo
+}
+
+// AddReportsReceived adds the "reports_received" edges to the Report entity.
+func (uuo *UserUpdateOne) AddReportsReceived(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddReportsReceivedIDs(ids...)
+}
+
+// AddReportsResolvedIDs adds the "reports_resolved" edge to the Report entity by IDs.
+func (uuo *UserUpdateOne) AddReportsResolvedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddReportsResolvedIDs(ids...)
+	return uuo
+}
+
+// AddReportsResolved adds the "reports_resolved" edges to the Report entity.
+func (uuo *UserUpdateOne) AddReportsResolved(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddReportsResolvedIDs(ids...)
+}
+
+// AddSuspensionIDs adds the "suspensions" edge to the Suspension entity by IDs.
+func (uuo *UserUpdateOne) AddSuspensionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddSuspensionIDs(ids...)
+	return uuo
+}
+
+// AddSuspensions adds the "suspensions" edges to the Suspension entity.
+func (uuo *UserUpdateOne) AddSuspensions(s ...*Suspension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSuspensionIDs(ids...)
+}
+
+// AddSuspensionsIssuedIDs adds the "suspensions_issued" edge to the Suspension entity by IDs.
+func (uuo *UserUpdateOne) AddSuspensionsIssuedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddSuspensionsIssuedIDs(ids...)
+	return uuo
+}
+
+// AddSuspensionsIssued adds the "suspensions_issued" edges to the Suspension entity.
+func (uuo *UserUpdateOne) AddSuspensionsIssued(s ...*Suspension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSuspensionsIssuedIDs(ids...)
+}
+
+// AddMutingIDs adds the "muting" edge to the MuteRelation entity by IDs.
+func (uuo *UserUpdateOne) AddMutingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMutingIDs(ids...)
+	return uuo
+}
+
+// AddMuting adds the "muting" edges to the MuteRelation entity.
+func (uuo *UserUpdateOne) AddMuting(m ...*MuteRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMutingIDs(ids...)
+}
+
+// AddMutedByIDs adds the "muted_by" edge to the MuteRelation entity by IDs.
+func (uuo *UserUpdateOne) AddMutedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMutedByIDs(ids...)
+	return uuo
+}
+
+// AddMutedBy adds the "muted_by" edges to the MuteRelation entity.
+func (uuo *UserUpdateOne) AddMutedBy(m ...*MuteRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMutedByIDs(ids...)
+}
+
+// AddMutedKeywordIDs adds the "muted_keywords" edge to the MutedKeyword entity by IDs.
+func (uuo *UserUpdateOne) AddMutedKeywordIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMutedKeywordIDs(ids...)
+	return uuo
+}
+
+// AddMutedKeywords adds the "muted_keywords" edges to the MutedKeyword entity.
+func (uuo *UserUpdateOne) AddMutedKeywords(m ...*MutedKeyword) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMutedKeywordIDs(ids...)
+}
+
+// AddFollowRequestsSentIDs adds the "follow_requests_sent" edge to the FollowRequest entity by IDs.
+func (uuo *UserUpdateOne) AddFollowRequestsSentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddFollowRequestsSentIDs(ids...)
+	return uuo
+}
+
+// AddFollowRequestsSent adds the "follow_requests_sent" edges to the FollowRequest entity.
+func (uuo *UserUpdateOne) AddFollowRequestsSent(f ...*FollowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFollowRequestsSentIDs(ids...)
+}
+
+// AddFollowRequestsReceivedIDs adds the "follow_requests_received" edge to the FollowRequest entity by IDs.
+func (uuo *UserUpdateOne) AddFollowRequestsReceivedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddFollowRequestsReceivedIDs(ids...)
+	return uuo
+}
+
+// AddFollowRequestsReceived adds the "follow_requests_received" edges to the FollowRequest entity.
+func (uuo *UserUpdateOne) AddFollowRequestsReceived(f ...*FollowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFollowRequestsReceivedIDs(ids...)
+}
+
+// AddMentionedInPostIDs adds the "mentioned_in_posts" edge to the Post entity by IDs.
+func (uuo *UserUpdateOne) AddMentionedInPostIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMentionedInPostIDs(ids...)
+	return uuo
+}
+
+// AddMentionedInPosts adds the "mentioned_in_posts" edges to the Post entity.
+func (uuo *UserUpdateOne) AddMentionedInPosts(p ...*Post) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddMentionedInPostIDs(ids...)
+}
+
+// AddMentionedInCommentIDs adds the "mentioned_in_comments" edge to the Comment entity by IDs.
+func (uuo *UserUpdateOne) AddMentionedInCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMentionedInCommentIDs(ids...)
+	return uuo
+}
+
+// AddMentionedInComments adds the "mentioned_in_comments" edges to the Comment entity.
+func (uuo *UserUpdateOne) AddMentionedInComments(c ...*Comment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddMentionedInCommentIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uuo *UserUpdateOne) AddNotificationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddNotificationIDs(ids...)
+	return uuo
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) AddNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNotificationIDs(ids...)
+}
+
+// AddNotificationsSentIDs adds the "notifications_sent" edge to the Notification entity by IDs.
+func (uuo *UserUpdateOne) AddNotificationsSentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddNotificationsSentIDs(ids...)
+	return uuo
+}
+
+// AddNotificationsSent adds the "notifications_sent" edges to the Notification entity.
+func (uuo *UserUpdateOne) AddNotificationsSent(n ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNotificationsSentIDs(ids...)
+}
+
+// AddCommentLikeIDs adds the "comment_likes" edge to the CommentLike entity by IDs.
+func (uuo *UserUpdateOne) AddCommentLikeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddCommentLikeIDs(ids...)
+	return uuo
+}
+
+// AddCommentLikes adds the "comment_likes" edges to the CommentLike entity.
+func (uuo *UserUpdateOne) AddCommentLikes(c ...*CommentLike) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCommentLikeIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearPosts clears all "posts" edges to the Post entity.
+func (uuo *UserUpdateOne) ClearPosts() *UserUpdateOne {
+	uuo.mutation.ClearPosts()
+	return uuo
+}
+
+// RemovePostIDs removes the "posts" edge to Post entities by IDs.
+func (uuo *UserUpdateOne) RemovePostIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemovePostIDs(ids...)
+	return uuo
+}
+
+// RemovePosts removes "posts" edges to Post entities.
+func (uuo *UserUpdateOne) RemovePosts(p ...*Post) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePostIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (uuo *UserUpdateOne) ClearComments() *UserUpdateOne {
+	uuo.mutation.ClearComments()
+	return uuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (uuo *UserUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveCommentIDs(ids...)
+	return uuo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (uuo *UserUpdateOne) RemoveComments(c ...*Comment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCommentIDs(ids...)
+}
+
+// ClearLikes clears all "likes" edges to the Like entity.
+func (uuo *UserUpdateOne) ClearLikes() *UserUpdateOne {
+	uuo.mutation.ClearLikes()
+	return uuo
+}
+
+// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
+func (uuo *UserUpdateOne) RemoveLikeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveLikeIDs(ids...)
+	return uuo
+}
+
+// RemoveLikes removes "likes" edges to Like entities.
+func (uuo *UserUpdateOne) RemoveLikes(l ...*Like) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.RemoveLikeIDs(ids...)
+}
+
+// ClearPets clears all "pets" edges to the Pet entity.
+func (uuo *UserUpdateOne) ClearPets() *UserUpdateOne {
+	uuo.mutation.ClearPets()
+	return uuo
+}
+
+// RemovePetIDs removes the "pets" edge to Pet entities by IDs.
+func (uuo *UserUpdateOne) RemovePetIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemovePetIDs(ids...)
+	return uuo
+}
+
+// RemovePets removes "pets" edges to Pet entities.
+func (uuo *UserUpdateOne) RemovePets(p ...*Pet) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePetIDs(ids...)
+}
+
+// ClearFollowing clears all "following" edges to the FollowRelation entity.
+func (uuo *UserUpdateOne) ClearFollowing() *UserUpdateOne {
+	uuo.mutation.ClearFollowing()
+	return uuo
+}
+
+// RemoveFollowingIDs removes the "following" edge to FollowRelation entities by IDs.
+func (uuo *UserUpdateOne) RemoveFollowingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveFollowingIDs(ids...)
+	return uuo
+}
+
+// RemoveFollowing removes "following" edges to FollowRelation entities.
+func (uuo *UserUpdateOne) RemoveFollowing(f ...*FollowRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFollowingIDs(ids...)
+}
+
+// ClearFollowers clears all "followers" edges to the FollowRelation entity.
+func (uuo *UserUpdateOne) ClearFollowers() *UserUpdateOne {
+	uuo.mutation.ClearFollowers()
+	return uuo
+}
+
+// RemoveFollowerIDs removes the "followers" edge to FollowRelation entities by IDs.
+func (uuo *UserUpdateOne) RemoveFollowerIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveFollowerIDs(ids...)
+	return uuo
+}
+
+// RemoveFollowers removes "followers" edges to FollowRelation entities.
+func (uuo *UserUpdateOne) RemoveFollowers(f ...*FollowRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFollowerIDs(ids...)
+}
+
+// ClearBlocking clears all "blocking" edges to the BlockRelation entity.
+func (uuo *UserUpdateOne) ClearBlocking() *UserUpdateOne {
+	uuo.mutation.ClearBlocking()
+	return uuo
+}
+
+// RemoveBlockingIDs removes the "blocking" edge to BlockRelation entities by IDs.
 func (uuo *UserUpdateOne) RemoveBlockingIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.RemoveBlockingIDs(ids...)
 	return uuo
 }
 
-// RemoveBlocking removes "blocking" edges to BlockRelation entities.
-func (uuo *UserUpdateOne) RemoveBlocking(b ...*BlockRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+// RemoveBlocking removes "blocking" edges to BlockRelation entities.
+func (uuo *UserUpdateOne) RemoveBlocking(b ...*BlockRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockingIDs(ids...)
+}
+
+// ClearBlockedBy clears all "blocked_by" edges to the BlockRelation entity.
+func (uuo *UserUpdateOne) ClearBlockedBy() *UserUpdateOne {
+	uuo.mutation.ClearBlockedBy()
+	return uuo
+}
+
+// RemoveBlockedByIDs removes the "blocked_by" edge to BlockRelation entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveBlockedByIDs(ids...)
+	return uuo
+}
+
+// RemoveBlockedBy removes "blocked_by" edges to BlockRelation entities.
+func (uuo *UserUpdateOne) RemoveBlockedBy(b ...*BlockRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlockedByIDs(ids...)
+}
+
+// ClearDailyTasks clears all "daily_tasks" edges to the DailyTask entity.
+func (uuo *UserUpdateOne) ClearDailyTasks() *UserUpdateOne {
+	uuo.mutation.ClearDailyTasks()
+	return uuo
+}
+
+// RemoveDailyTaskIDs removes the "daily_tasks" edge to DailyTask entities by IDs.
+func (uuo *UserUpdateOne) RemoveDailyTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveDailyTaskIDs(ids...)
+	return uuo
+}
+
+// RemoveDailyTasks removes "daily_tasks" edges to DailyTask entities.
+func (uuo *UserUpdateOne) RemoveDailyTasks(d ...*DailyTask) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDailyTaskIDs(ids...)
+}
+
+// ClearDeviceTokens clears all "device_tokens" edges to the DeviceToken entity.
+func (uuo *UserUpdateOne) ClearDeviceTokens() *UserUpdateOne {
+	uuo.mutation.ClearDeviceTokens()
+	return uuo
+}
+
+// RemoveDeviceTokenIDs removes the "device_tokens" edge to DeviceToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveDeviceTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveDeviceTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveDeviceTokens removes "device_tokens" edges to DeviceToken entities.
+func (uuo *UserUpdateOne) RemoveDeviceTokens(d ...*DeviceToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDeviceTokenIDs(ids...)
+}
+
+// ClearReportsFiled clears all "reports_filed" edges to the Report entity.
+func (uuo *UserUpdateOne) ClearReportsFiled() *UserUpdateOne {
+	uuo.mutation.ClearReportsFiled()
+	return uuo
+}
+
+// RemoveReportsFiledIDs removes the "reports_filed" edge to Report entities by IDs.
+func (uuo *UserUpdateOne) RemoveReportsFiledIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveReportsFiledIDs(ids...)
+	return uuo
+}
+
+// RemoveReportsFiled removes "reports_filed" edges to Report entities.
+func (uuo *UserUpdateOne) RemoveReportsFiled(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveReportsFiledIDs(ids...)
+}
+
+// ClearReportsReceived clears all "reports_received" edges to the Report entity.
+func (uuo *UserUpdateOne) ClearReportsReceived() *UserUpdateOne {
+	uuo.mutation.ClearReportsReceived()
+	return uuo
+}
+
+// RemoveReportsReceivedIDs removes the "reports_received" edge to Report entities by IDs.
+func (uuo *UserUpdateOne) RemoveReportsReceivedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveReportsReceivedIDs(ids...)
+	return uuo
+}
+
+// RemoveReportsReceived removes "reports_received" edges to Report entities.
+func (uuo *UserUpdateOne) RemoveReportsReceived(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveReportsReceivedIDs(ids...)
+}
+
+// ClearReportsResolved clears all "reports_resolved" edges to the Report entity.
+func (uuo *UserUpdateOne) ClearReportsResolved() *UserUpdateOne {
+	uuo.mutation.ClearReportsResolved()
+	return uuo
+}
+
+// RemoveReportsResolvedIDs removes the "reports_resolved" edge to Report entities by IDs.
+func (uuo *UserUpdateOne) RemoveReportsResolvedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveReportsResolvedIDs(ids...)
+	return uuo
+}
+
+// RemoveReportsResolved removes "reports_resolved" edges to Report entities.
+func (uuo *UserUpdateOne) RemoveReportsResolved(r ...*Report) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveReportsResolvedIDs(ids...)
+}
+
+// ClearSuspensions clears all "suspensions" edges to the Suspension entity.
+func (uuo *UserUpdateOne) ClearSuspensions() *UserUpdateOne {
+	uuo.mutation.ClearSuspensions()
+	return uuo
+}
+
+// RemoveSuspensionIDs removes the "suspensions" edge to Suspension entities by IDs.
+func (uuo *UserUpdateOne) RemoveSuspensionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveSuspensionIDs(ids...)
+	return uuo
+}
+
+// RemoveSuspensions removes "suspensions" edges to Suspension entities.
+func (uuo *UserUpdateOne) RemoveSuspensions(s ...*Suspension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSuspensionIDs(ids...)
+}
+
+// ClearSuspensionsIssued clears all "suspensions_issued" edges to the Suspension entity.
+func (uuo *UserUpdateOne) ClearSuspensionsIssued() *UserUpdateOne {
+	uuo.mutation.ClearSuspensionsIssued()
+	return uuo
+}
+
+// RemoveSuspensionsIssuedIDs removes the "suspensions_issued" edge to Suspension entities by IDs.
+func (uuo *UserUpdateOne) RemoveSuspensionsIssuedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveSuspensionsIssuedIDs(ids...)
+	return uuo
+}
+
+// RemoveSuspensionsIssued removes "suspensions_issued" edges to Suspension entities.
+func (uuo *UserUpdateOne) RemoveSuspensionsIssued(s ...*Suspension) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSuspensionsIssuedIDs(ids...)
+}
+
+// ClearMuting clears all "muting" edges to the MuteRelation entity.
+func (uuo *UserUpdateOne) ClearMuting() *UserUpdateOne {
+	uuo.mutation.ClearMuting()
+	return uuo
+}
+
+// RemoveMutingIDs removes the "muting" edge to MuteRelation entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMutingIDs(ids...)
+	return uuo
+}
+
+// RemoveMuting removes "muting" edges to MuteRelation entities.
+func (uuo *UserUpdateOne) RemoveMuting(m ...*MuteRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMutingIDs(ids...)
+}
+
+// ClearMutedBy clears all "muted_by" edges to the MuteRelation entity.
+func (uuo *UserUpdateOne) ClearMutedBy() *UserUpdateOne {
+	uuo.mutation.ClearMutedBy()
+	return uuo
+}
+
+// RemoveMutedByIDs removes the "muted_by" edge to MuteRelation entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutedByIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMutedByIDs(ids...)
+	return uuo
+}
+
+// RemoveMutedBy removes "muted_by" edges to MuteRelation entities.
+func (uuo *UserUpdateOne) RemoveMutedBy(m ...*MuteRelation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMutedByIDs(ids...)
+}
+
+// ClearMutedKeywords clears all "muted_keywords" edges to the MutedKeyword entity.
+func (uuo *UserUpdateOne) ClearMutedKeywords() *UserUpdateOne {
+	uuo.mutation.ClearMutedKeywords()
+	return uuo
+}
+
+// RemoveMutedKeywordIDs removes the "muted_keywords" edge to MutedKeyword entities by IDs.
+func (uuo *UserUpdateOne) RemoveMutedKeywordIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMutedKeywordIDs(ids...)
+	return uuo
+}
+
+// RemoveMutedKeywords removes "muted_keywords" edges to MutedKeyword entities.
+func (uuo *UserUpdateOne) RemoveMutedKeywords(m ...*MutedKeyword) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMutedKeywordIDs(ids...)
+}
+
+// ClearFollowRequestsSent clears all "follow_requests_sent" edges to the FollowRequest entity.
+func (uuo *UserUpdateOne) ClearFollowRequestsSent() *UserUpdateOne {
+	uuo.mutation.ClearFollowRequestsSent()
+	return uuo
+}
+
+// RemoveFollowRequestsSentIDs removes the "follow_requests_sent" edge to FollowRequest entities by IDs.
+func (uuo *UserUpdateOne) RemoveFollowRequestsSentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveFollowRequestsSentIDs(ids...)
+	return uuo
+}
+
+// RemoveFollowRequestsSent removes "follow_requests_sent" edges to FollowRequest entities.
+func (uuo *UserUpdateOne) RemoveFollowRequestsSent(f ...*FollowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFollowRequestsSentIDs(ids...)
+}
+
+// ClearFollowRequestsReceived clears all "follow_requests_received" edges to the FollowRequest entity.
+func (uuo *UserUpdateOne) ClearFollowRequestsReceived() *UserUpdateOne {
+	uuo.mutation.ClearFollowRequestsReceived()
+	return uuo
+}
+
+// RemoveFollowRequestsReceivedIDs removes the "follow_requests_received" edge to FollowRequest entities by IDs.
+func (uuo *UserUpdateOne) RemoveFollowRequestsReceivedIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveFollowRequestsReceivedIDs(ids...)
+	return uuo
+}
+
+// RemoveFollowRequestsReceived removes "follow_requests_received" edges to FollowRequest entities.
+func (uuo *UserUpdateOne) RemoveFollowRequestsReceived(f ...*FollowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFollowRequestsReceivedIDs(ids...)
+}
+
+// ClearMentionedInPosts clears all "mentioned_in_posts" edges to the Post entity.
+func (uuo *UserUpdateOne) ClearMentionedInPosts() *UserUpdateOne {
+	uuo.mutation.ClearMentionedInPosts()
+	return uuo
+}
+
+// RemoveMentionedInPostIDs removes the "mentioned_in_posts" edge to Post entities by IDs.
+func (uuo *UserUpdateOne) RemoveMentionedInPostIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMentionedInPostIDs(ids...)
+	return uuo
+}
+
+// RemoveMentionedInPosts removes "mentioned_in_posts" edges to Post entities.
+func (uuo *UserUpdateOne) RemoveMentionedInPosts(p ...*Post) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemoveMentionedInPostIDs(ids...)
+}
+
+// ClearMentionedInComments clears all "mentioned_in_comments" edges to the Comment entity.
+func (uuo *UserUpdateOne) ClearMentionedInComments() *UserUpdateOne {
+	uuo.mutation.ClearMentionedInComments()
+	return uuo
+}
+
+// RemoveMentionedInCommentIDs removes the "mentioned_in_comments" edge to Comment entities by IDs.
+func (uuo *UserUpdateOne) RemoveMentionedInCommentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMentionedInCommentIDs(ids...)
+	return uuo
+}
+
+// RemoveMentionedInComments removes "mentioned_in_comments" edges to Comment entities.
+func (uuo *UserUpdateOne) RemoveMentionedInComments(c ...*Comment) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveMentionedInCommentIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) ClearNotifications() *UserUpdateOne {
+	uuo.mutation.ClearNotifications()
+	return uuo
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uuo *UserUpdateOne) RemoveNotificationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveNotificationIDs(ids...)
+	return uuo
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uuo *UserUpdateOne) RemoveNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationsSent clears all "notifications_sent" edges to the Notification entity.
+func (uuo *UserUpdateOne) ClearNotificationsSent() *UserUpdateOne {
+	uuo.mutation.ClearNotificationsSent()
+	return uuo
+}
+
+// RemoveNotificationsSentIDs removes the "notifications_sent" edge to Notification entities by IDs.
+func (uuo *UserUpdateOne) RemoveNotificationsSentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveNotificationsSentIDs(ids...)
+	return uuo
+}
+
+// RemoveNotificationsSent removes "notifications_sent" edges to Notification entities.
+func (uuo *UserUpdateOne) RemoveNotificationsSent(n ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNotificationsSentIDs(ids...)
+}
+
+// ClearCommentLikes clears all "comment_likes" edges to the CommentLike entity.
+func (uuo *UserUpdateOne) ClearCommentLikes() *UserUpdateOne {
+	uuo.mutation.ClearCommentLikes()
+	return uuo
+}
+
+// RemoveCommentLikeIDs removes the "comment_likes" edge to CommentLike entities by IDs.
+func (uuo *UserUpdateOne) RemoveCommentLikeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveCommentLikeIDs(ids...)
+	return uuo
+}
+
+// RemoveCommentLikes removes "comment_likes" edges to CommentLike entities.
+func (uuo *UserUpdateOne) RemoveCommentLikes(c ...*CommentLike) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCommentLikeIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if uuo.mutation.IndexCleared() {
+		_spec.ClearField(user.FieldIndex, field.TypeUint32)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.AuthSubject(); ok {
+		_spec.SetField(user.FieldAuthSubject, field.TypeString, value)
+	}
+	if uuo.mutation.AuthSubjectCleared() {
+		_spec.ClearField(user.FieldAuthSubject, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Handle(); ok {
+		_spec.SetField(user.FieldHandle, field.TypeString, value)
+	}
+	if uuo.mutation.HandleCleared() {
+		_spec.ClearField(user.FieldHandle, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.StreakCount(); ok {
+		_spec.SetField(user.FieldStreakCount, field.TypeUint32, value)
+	}
+	if value, ok := uuo.mutation.AddedStreakCount(); ok {
+		_spec.AddField(user.FieldStreakCount, field.TypeUint32, value)
+	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.IconImageKey(); ok {
+		_spec.SetField(user.FieldIconImageKey, field.TypeString, value)
+	}
+	if uuo.mutation.IconImageKeyCleared() {
+		_spec.ClearField(user.FieldIconImageKey, field.TypeString)
+	}
+	if value, ok := uuo.mutation.IsPrivate(); ok {
+		_spec.SetField(user.FieldIsPrivate, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.FollowerCount(); ok {
+		_spec.SetField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedFollowerCount(); ok {
+		_spec.AddField(user.FieldFollowerCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.FollowingCount(); ok {
+		_spec.SetField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedFollowingCount(); ok {
+		_spec.AddField(user.FieldFollowingCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.PostCount(); ok {
+		_spec.SetField(user.FieldPostCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedPostCount(); ok {
+		_spec.AddField(user.FieldPostCount, field.TypeInt, value)
+	}
+	if uuo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPostsIDs(); len(nodes) > 0 && !uuo.mutation.PostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PostsTable,
+			Columns: []string{user.PostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !uuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveBlockingIDs(ids...)
-}
-
-// ClearBlockedBy clears all "blocked_by" edges to the BlockRelation entity.
-func (uuo *UserUpdateOne) ClearBlockedBy() *UserUpdateOne {
-	uuo.mutation.ClearBlockedBy()
-	return uuo
-}
-
-// RemoveBlockedByIDs removes the "blocked_by" edge to BlockRelation entities by IDs.
-func (uuo *UserUpdateOne) RemoveBlockedByIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveBlockedByIDs(ids...)
-	return uuo
-}
-
-// RemoveBlockedBy removes "blocked_by" edges to BlockRelation entities.
-func (uuo *UserUpdateOne) RemoveBlockedBy(b ...*BlockRelation) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(b))
-	for i := range b {
-		ids[i] = b[i].ID
+	if uuo.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LikesTable,
+			Columns: []string{user.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveBlockedByIDs(ids...)
-}
-
-// ClearDailyTasks clears all "daily_tasks" edges to the DailyTask entity.
-func (uuo *UserUpdateOne) ClearDailyTasks() *UserUpdateOne {
-	uuo.mutation.ClearDailyTasks()
-	return uuo
-}
-
-// RemoveDailyTaskIDs removes the "daily_tasks" edge to DailyTask entities by IDs.
-func (uuo *UserUpdateOne) RemoveDailyTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveDailyTaskIDs(ids...)
-	return uuo
-}
-
-// RemoveDailyTasks removes "daily_tasks" edges to DailyTask entities.
-func (uuo *UserUpdateOne) RemoveDailyTasks(d ...*DailyTask) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+	if nodes := uuo.mutation.RemovedLikesIDs(); len(nodes) > 0 && !uuo.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LikesTable,
+			Columns: []string{user.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LikesTable,
+			Columns: []string{user.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PetsTable,
+			Columns: []string{user.PetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPetsIDs(); len(nodes) > 0 && !uuo.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PetsTable,
+			Columns: []string{user.PetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PetsTable,
+			Columns: []string{user.PetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !uuo.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !uuo.mutation.FollowersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FollowersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FollowersTable,
+			Columns: []string{user.FollowersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedBlockingIDs(); len(nodes) > 0 && !uuo.mutation.BlockingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BlockingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockingTable,
+			Columns: []string{user.BlockingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveDailyTaskIDs(ids...)
-}
-
-// ClearDeviceTokens clears all "device_tokens" edges to the DeviceToken entity.
-func (uuo *UserUpdateOne) ClearDeviceTokens() *UserUpdateOne {
-	uuo.mutation.ClearDeviceTokens()
-	return uuo
-}
-
-// RemoveDeviceTokenIDs removes the "device_tokens" edge to DeviceToken entities by IDs.
-func (uuo *UserUpdateOne) RemoveDeviceTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveDeviceTokenIDs(ids...)
-	return uuo
-}
-
-// RemoveDeviceTokens removes "device_tokens" edges to DeviceToken entities.
-func (uuo *UserUpdateOne) RemoveDeviceTokens(d ...*DeviceToken) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+	if uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveDeviceTokenIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := uuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uuo.mutation.BlockedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := uuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByTable,
+			Columns: []string{user.BlockedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if uuo.mutation.DailyTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DailyTasksTable,
+			Columns: []string{user.DailyTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Name(); ok {
-		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+	if nodes := uuo.mutation.RemovedDailyTasksIDs(); len(nodes) > 0 && !uuo.mutation.DailyTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DailyTasksTable,
+			Columns: []string{user.DailyTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
+	if nodes := uuo.mutation.DailyTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DailyTasksTable,
+			Columns: []string{user.DailyTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if uuo.mutation.DeviceTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceTokensTable,
+			Columns: []string{user.DeviceTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := uuo.mutation.RemovedDeviceTokensIDs(); len(nodes) > 0 && !uuo.mutation.DeviceTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceTokensTable,
+			Columns: []string{user.DeviceTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.DeviceTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DeviceTokensTable,
+			Columns: []string{user.DeviceTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.IndexCleared() {
-		_spec.ClearField(user.FieldIndex, field.TypeUint32)
+	if uuo.mutation.ReportsFiledCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsFiledTable,
+			Columns: []string{user.ReportsFiledColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedReportsFiledIDs(); len(nodes) > 0 && !uuo.mutation.ReportsFiledCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsFiledTable,
+			Columns: []string{user.ReportsFiledColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
+	if nodes := uuo.mutation.ReportsFiledIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsFiledTable,
+			Columns: []string{user.ReportsFiledColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Bio(); ok {
-		_spec.SetField(user.FieldBio, field.TypeString, value)
+	if uuo.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.StreakCount(); ok {
-		_spec.SetField(user.FieldStreakCount, field.TypeUint32, value)
+	if nodes := uuo.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !uuo.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.AddedStreakCount(); ok {
-		_spec.AddField(user.FieldStreakCount, field.TypeUint32, value)
+	if nodes := uuo.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.IconImageKey(); ok {
-		_spec.SetField(user.FieldIconImageKey, field.TypeString, value)
+	if uuo.mutation.ReportsResolvedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.IconImageKeyCleared() {
-		_spec.ClearField(user.FieldIconImageKey, field.TypeString)
+	if nodes := uuo.mutation.RemovedReportsResolvedIDs(); len(nodes) > 0 && !uuo.mutation.ReportsResolvedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.CreatedAt(); ok {
-		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	if nodes := uuo.mutation.ReportsResolvedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsResolvedTable,
+			Columns: []string{user.ReportsResolvedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.PostsCleared() {
+	if uuo.mutation.SuspensionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PostsTable,
-			Columns: []string{user.PostsColumn},
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedPostsIDs(); len(nodes) > 0 && !uuo.mutation.PostsCleared() {
+	if nodes := uuo.mutation.RemovedSuspensionsIDs(); len(nodes) > 0 && !uuo.mutation.SuspensionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PostsTable,
-			Columns: []string{user.PostsColumn},
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1652,15 +4334,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.PostsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.SuspensionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PostsTable,
-			Columns: []string{user.PostsColumn},
+			Table:   user.SuspensionsTable,
+			Columns: []string{user.SuspensionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1668,28 +4350,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.CommentsCleared() {
+	if uuo.mutation.SuspensionsIssuedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CommentsTable,
-			Columns: []string{user.CommentsColumn},
+			Table:   user.SuspensionsIssuedTable,
+			Columns: []string{user.SuspensionsIssuedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !uuo.mutation.CommentsCleared() {
+	if nodes := uuo.mutation.RemovedSuspensionsIssuedIDs(); len(nodes) > 0 && !uuo.mutation.SuspensionsIssuedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CommentsTable,
-			Columns: []string{user.CommentsColumn},
+			Table:   user.SuspensionsIssuedTable,
+			Columns: []string{user.SuspensionsIssuedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1697,15 +4379,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.CommentsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.SuspensionsIssuedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CommentsTable,
-			Columns: []string{user.CommentsColumn},
+			Table:   user.SuspensionsIssuedTable,
+			Columns: []string{user.SuspensionsIssuedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(suspension.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1713,28 +4395,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.LikesCleared() {
+	if uuo.mutation.MutingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LikesTable,
-			Columns: []string{user.LikesColumn},
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedLikesIDs(); len(nodes) > 0 && !uuo.mutation.LikesCleared() {
+	if nodes := uuo.mutation.RemovedMutingIDs(); len(nodes) > 0 && !uuo.mutation.MutingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LikesTable,
-			Columns: []string{user.LikesColumn},
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1742,15 +4424,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MutingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LikesTable,
-			Columns: []string{user.LikesColumn},
+			Table:   user.MutingTable,
+			Columns: []string{user.MutingColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1758,28 +4440,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.PetsCleared() {
+	if uuo.mutation.MutedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PetsTable,
-			Columns: []string{user.PetsColumn},
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedPetsIDs(); len(nodes) > 0 && !uuo.mutation.PetsCleared() {
+	if nodes := uuo.mutation.RemovedMutedByIDs(); len(nodes) > 0 && !uuo.mutation.MutedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PetsTable,
-			Columns: []string{user.PetsColumn},
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1787,15 +4469,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.PetsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MutedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PetsTable,
-			Columns: []string{user.PetsColumn},
+			Table:   user.MutedByTable,
+			Columns: []string{user.MutedByColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(muterelation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1803,28 +4485,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FollowingCleared() {
+	if uuo.mutation.MutedKeywordsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FollowingTable,
-			Columns: []string{user.FollowingColumn},
+			Table:   user.MutedKeywordsTable,
+			Columns: []string{user.MutedKeywordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !uuo.mutation.FollowingCleared() {
+	if nodes := uuo.mutation.RemovedMutedKeywordsIDs(); len(nodes) > 0 && !uuo.mutation.MutedKeywordsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FollowingTable,
-			Columns: []string{user.FollowingColumn},
+			Table:   user.MutedKeywordsTable,
+			Columns: []string{user.MutedKeywordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1832,15 +4514,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.FollowingIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MutedKeywordsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FollowingTable,
-			Columns: []string{user.FollowingColumn},
+			Table:   user.MutedKeywordsTable,
+			Columns: []string{user.MutedKeywordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(mutedkeyword.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1848,28 +4530,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FollowersCleared() {
+	if uuo.mutation.FollowRequestsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FollowersTable,
-			Columns: []string{user.FollowersColumn},
+			Table:   user.FollowRequestsSentTable,
+			Columns: []string{user.FollowRequestsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedFollowersIDs(); len(nodes) > 0 && !uuo.mutation.FollowersCleared() {
+	if nodes := uuo.mutation.RemovedFollowRequestsSentIDs(); len(nodes) > 0 && !uuo.mutation.FollowRequestsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FollowersTable,
-			Columns: []string{user.FollowersColumn},
+			Table:   user.FollowRequestsSentTable,
+			Columns: []string{user.FollowRequestsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1877,15 +4559,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.FollowersIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.FollowRequestsSentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FollowersTable,
-			Columns: []string{user.FollowersColumn},
+			Table:   user.FollowRequestsSentTable,
+			Columns: []string{user.FollowRequestsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(followrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1893,28 +4575,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.BlockingCleared() {
+	if uuo.mutation.FollowRequestsReceivedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockingTable,
-			Columns: []string{user.BlockingColumn},
+			Table:   user.FollowRequestsReceivedTable,
+			Columns: []string{user.FollowRequestsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedBlockingIDs(); len(nodes) > 0 && !uuo.mutation.BlockingCleared() {
+	if nodes := uuo.mutation.RemovedFollowRequestsReceivedIDs(); len(nodes) > 0 && !uuo.mutation.FollowRequestsReceivedCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockingTable,
-			Columns: []string{user.BlockingColumn},
+			Table:   user.FollowRequestsReceivedTable,
+			Columns: []string{user.FollowRequestsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1922,15 +4604,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.BlockingIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.FollowRequestsReceivedIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockingTable,
-			Columns: []string{user.BlockingColumn},
+			Table:   user.FollowRequestsReceivedTable,
+			Columns: []string{user.FollowRequestsReceivedColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(followrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1938,28 +4620,118 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.BlockedByCleared() {
+	if uuo.mutation.MentionedInPostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInPostsTable,
+			Columns: user.MentionedInPostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMentionedInPostsIDs(); len(nodes) > 0 && !uuo.mutation.MentionedInPostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInPostsTable,
+			Columns: user.MentionedInPostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MentionedInPostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInPostsTable,
+			Columns: user.MentionedInPostsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MentionedInCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInCommentsTable,
+			Columns: user.MentionedInCommentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMentionedInCommentsIDs(); len(nodes) > 0 && !uuo.mutation.MentionedInCommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInCommentsTable,
+			Columns: user.MentionedInCommentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MentionedInCommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.MentionedInCommentsTable,
+			Columns: user.MentionedInCommentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedByTable,
-			Columns: []string{user.BlockedByColumn},
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedBlockedByIDs(); len(nodes) > 0 && !uuo.mutation.BlockedByCleared() {
+	if nodes := uuo.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uuo.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedByTable,
-			Columns: []string{user.BlockedByColumn},
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1967,15 +4739,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.BlockedByIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.NotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedByTable,
-			Columns: []string{user.BlockedByColumn},
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(blockrelation.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1983,28 +4755,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.DailyTasksCleared() {
+	if uuo.mutation.NotificationsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DailyTasksTable,
-			Columns: []string{user.DailyTasksColumn},
+			Table:   user.NotificationsSentTable,
+			Columns: []string{user.NotificationsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedDailyTasksIDs(); len(nodes) > 0 && !uuo.mutation.DailyTasksCleared() {
+	if nodes := uuo.mutation.RemovedNotificationsSentIDs(); len(nodes) > 0 && !uuo.mutation.NotificationsSentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DailyTasksTable,
-			Columns: []string{user.DailyTasksColumn},
+			Table:   user.NotificationsSentTable,
+			Columns: []string{user.NotificationsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2012,15 +4784,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.DailyTasksIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.NotificationsSentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DailyTasksTable,
-			Columns: []string{user.DailyTasksColumn},
+			Table:   user.NotificationsSentTable,
+			Columns: []string{user.NotificationsSentColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(dailytask.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2028,28 +4800,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.DeviceTokensCleared() {
+	if uuo.mutation.CommentLikesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DeviceTokensTable,
-			Columns: []string{user.DeviceTokensColumn},
+			Table:   user.CommentLikesTable,
+			Columns: []string{user.CommentLikesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedDeviceTokensIDs(); len(nodes) > 0 && !uuo.mutation.DeviceTokensCleared() {
+	if nodes := uuo.mutation.RemovedCommentLikesIDs(); len(nodes) > 0 && !uuo.mutation.CommentLikesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DeviceTokensTable,
-			Columns: []string{user.DeviceTokensColumn},
+			Table:   user.CommentLikesTable,
+			Columns: []string{user.CommentLikesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2057,15 +4829,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.DeviceTokensIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.CommentLikesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DeviceTokensTable,
-			Columns: []string{user.DeviceTokensColumn},
+			Table:   user.CommentLikesTable,
+			Columns: []string{user.CommentLikesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(commentlike.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
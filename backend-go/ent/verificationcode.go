@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
+	"github.com/google/uuid"
+)
+
+// VerificationCode is the model entity for the VerificationCode schema.
+type VerificationCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose verificationcode.Purpose `json:"purpose,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VerificationCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case verificationcode.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case verificationcode.FieldEmail, verificationcode.FieldPurpose, verificationcode.FieldCodeHash:
+			values[i] = new(sql.NullString)
+		case verificationcode.FieldExpiresAt, verificationcode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case verificationcode.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VerificationCode fields.
+func (vc *VerificationCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case verificationcode.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				vc.ID = *value
+			}
+		case verificationcode.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				vc.Email = value.String
+			}
+		case verificationcode.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				vc.Purpose = verificationcode.Purpose(value.String)
+			}
+		case verificationcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				vc.CodeHash = value.String
+			}
+		case verificationcode.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				vc.Attempts = int(value.Int64)
+			}
+		case verificationcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				vc.ExpiresAt = value.Time
+			}
+		case verificationcode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vc.CreatedAt = value.Time
+			}
+		default:
+			vc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VerificationCode.
+// This includes values selected through modifiers, order, etc.
+func (vc *VerificationCode) Value(name string) (ent.Value, error) {
+	return vc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VerificationCode.
+// Note that you need to call VerificationCode.Unwrap() before calling this method if this VerificationCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vc *VerificationCode) Update() *VerificationCodeUpdateOne {
+	return NewVerificationCodeClient(vc.config).UpdateOne(vc)
+}
+
+// Unwrap unwraps the VerificationCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vc *VerificationCode) Unwrap() *VerificationCode {
+	_tx, ok := vc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VerificationCode is not a transactional entity")
+	}
+	vc.config.driver = _tx.drv
+	return vc
+}
+
+// String implements the fmt.Stringer.
+func (vc *VerificationCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("VerificationCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vc.ID))
+	builder.WriteString("email=")
+	builder.WriteString(vc.Email)
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", vc.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", vc.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(vc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(vc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VerificationCodes is a parsable slice of VerificationCode.
+type VerificationCodes []*VerificationCode
@@ -0,0 +1,833 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
+	"github.com/google/uuid"
+)
+
+// VerificationCodeCreate is the builder for creating a VerificationCode entity.
+type VerificationCodeCreate struct {
+	config
+	mutation *VerificationCodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmail sets the "email" field.
+func (vcc *VerificationCodeCreate) SetEmail(s string) *VerificationCodeCreate {
+	vcc.mutation.SetEmail(s)
+	return vcc
+}
+
+// SetPurpose sets the "purpose" field.
+func (vcc *VerificationCodeCreate) SetPurpose(v verificationcode.Purpose) *VerificationCodeCreate {
+	vcc.mutation.SetPurpose(v)
+	return vcc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (vcc *VerificationCodeCreate) SetCodeHash(s string) *VerificationCodeCreate {
+	vcc.mutation.SetCodeHash(s)
+	return vcc
+}
+
+// SetAttempts sets the "attempts" field.
+func (vcc *VerificationCodeCreate) SetAttempts(i int) *VerificationCodeCreate {
+	vcc.mutation.SetAttempts(i)
+	return vcc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableAttempts(i *int) *VerificationCodeCreate {
+	if i != nil {
+		vcc.SetAttempts(*i)
+	}
+	return vcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vcc *VerificationCodeCreate) SetExpiresAt(t time.Time) *VerificationCodeCreate {
+	vcc.mutation.SetExpiresAt(t)
+	return vcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vcc *VerificationCodeCreate) SetCreatedAt(t time.Time) *VerificationCodeCreate {
+	vcc.mutation.SetCreatedAt(t)
+	return vcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableCreatedAt(t *time.Time) *VerificationCodeCreate {
+	if t != nil {
+		vcc.SetCreatedAt(*t)
+	}
+	return vcc
+}
+
+// SetID sets the "id" field.
+func (vcc *VerificationCodeCreate) SetID(u uuid.UUID) *VerificationCodeCreate {
+	vcc.mutation.SetID(u)
+	return vcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (vcc *VerificationCodeCreate) SetNillableID(u *uuid.UUID) *VerificationCodeCreate {
+	if u != nil {
+		vcc.SetID(*u)
+	}
+	return vcc
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (vcc *VerificationCodeCreate) Mutation() *VerificationCodeMutation {
+	return vcc.mutation
+}
+
+// Save creates the VerificationCode in the database.
+func (vcc *VerificationCodeCreate) Save(ctx context.Context) (*VerificationCode, error) {
+	vcc.defaults()
+	return withHooks(ctx, vcc.sqlSave, vcc.mutation, vcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vcc *VerificationCodeCreate) SaveX(ctx context.Context) *VerificationCode {
+	v, err := vcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcc *VerificationCodeCreate) Exec(ctx context.Context) error {
+	_, err := vcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcc *VerificationCodeCreate) ExecX(ctx context.Context) {
+	if err := vcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcc *VerificationCodeCreate) defaults() {
+	if _, ok := vcc.mutation.Attempts(); !ok {
+		v := verificationcode.DefaultAttempts
+		vcc.mutation.SetAttempts(v)
+	}
+	if _, ok := vcc.mutation.CreatedAt(); !ok {
+		v := verificationcode.DefaultCreatedAt()
+		vcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := vcc.mutation.ID(); !ok {
+		v := verificationcode.DefaultID()
+		vcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcc *VerificationCodeCreate) check() error {
+	if _, ok := vcc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "VerificationCode.email"`)}
+	}
+	if v, ok := vcc.mutation.Email(); ok {
+		if err := verificationcode.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.email": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "VerificationCode.purpose"`)}
+	}
+	if v, ok := vcc.mutation.Purpose(); ok {
+		if err := verificationcode.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.purpose": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "VerificationCode.code_hash"`)}
+	}
+	if v, ok := vcc.mutation.CodeHash(); ok {
+		if err := verificationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "VerificationCode.attempts"`)}
+	}
+	if _, ok := vcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "VerificationCode.expires_at"`)}
+	}
+	if _, ok := vcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VerificationCode.created_at"`)}
+	}
+	return nil
+}
+
+func (vcc *VerificationCodeCreate) sqlSave(ctx context.Context) (*VerificationCode, error) {
+	if err := vcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	vcc.mutation.id = &_node.ID
+	vcc.mutation.done = true
+	return _node, nil
+}
+
+func (vcc *VerificationCodeCreate) createSpec() (*VerificationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VerificationCode{config: vcc.config}
+		_spec = sqlgraph.NewCreateSpec(verificationcode.Table, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = vcc.conflict
+	if id, ok := vcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := vcc.mutation.Email(); ok {
+		_spec.SetField(verificationcode.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := vcc.mutation.Purpose(); ok {
+		_spec.SetField(verificationcode.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := vcc.mutation.CodeHash(); ok {
+		_spec.SetField(verificationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := vcc.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := vcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(verificationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := vcc.mutation.CreatedAt(); ok {
+		_spec.SetField(verificationcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerificationCode.Create().
+//		SetEmail(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerificationCodeUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (vcc *VerificationCodeCreate) OnConflict(opts ...sql.ConflictOption) *VerificationCodeUpsertOne {
+	vcc.conflict = opts
+	return &VerificationCodeUpsertOne{
+		create: vcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vcc *VerificationCodeCreate) OnConflictColumns(columns ...string) *VerificationCodeUpsertOne {
+	vcc.conflict = append(vcc.conflict, sql.ConflictColumns(columns...))
+	return &VerificationCodeUpsertOne{
+		create: vcc,
+	}
+}
+
+type (
+	// VerificationCodeUpsertOne is the builder for "upsert"-ing
+	//  one VerificationCode node.
+	VerificationCodeUpsertOne struct {
+		create *VerificationCodeCreate
+	}
+
+	// VerificationCodeUpsert is the "OnConflict" setter.
+	VerificationCodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmail sets the "email" field.
+func (u *VerificationCodeUpsert) SetEmail(v string) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateEmail() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldEmail)
+	return u
+}
+
+// SetPurpose sets the "purpose" field.
+func (u *VerificationCodeUpsert) SetPurpose(v verificationcode.Purpose) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldPurpose, v)
+	return u
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdatePurpose() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldPurpose)
+	return u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (u *VerificationCodeUpsert) SetCodeHash(v string) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldCodeHash, v)
+	return u
+}
+
+// UpdateCodeHash sets the "code_hash" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateCodeHash() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldCodeHash)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *VerificationCodeUpsert) SetAttempts(v int) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateAttempts() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *VerificationCodeUpsert) AddAttempts(v int) *VerificationCodeUpsert {
+	u.Add(verificationcode.FieldAttempts, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *VerificationCodeUpsert) SetExpiresAt(v time.Time) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateExpiresAt() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldExpiresAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *VerificationCodeUpsert) SetCreatedAt(v time.Time) *VerificationCodeUpsert {
+	u.Set(verificationcode.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsert) UpdateCreatedAt() *VerificationCodeUpsert {
+	u.SetExcluded(verificationcode.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(verificationcode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VerificationCodeUpsertOne) UpdateNewValues() *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(verificationcode.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VerificationCodeUpsertOne) Ignore() *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerificationCodeUpsertOne) DoNothing() *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerificationCodeCreate.OnConflict
+// documentation for more info.
+func (u *VerificationCodeUpsertOne) Update(set func(*VerificationCodeUpsert)) *VerificationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerificationCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *VerificationCodeUpsertOne) SetEmail(v string) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateEmail() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPurpose sets the "purpose" field.
+func (u *VerificationCodeUpsertOne) SetPurpose(v verificationcode.Purpose) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetPurpose(v)
+	})
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdatePurpose() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdatePurpose()
+	})
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (u *VerificationCodeUpsertOne) SetCodeHash(v string) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetCodeHash(v)
+	})
+}
+
+// UpdateCodeHash sets the "code_hash" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateCodeHash() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateCodeHash()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *VerificationCodeUpsertOne) SetAttempts(v int) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *VerificationCodeUpsertOne) AddAttempts(v int) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateAttempts() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *VerificationCodeUpsertOne) SetExpiresAt(v time.Time) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateExpiresAt() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *VerificationCodeUpsertOne) SetCreatedAt(v time.Time) *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsertOne) UpdateCreatedAt() *VerificationCodeUpsertOne {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VerificationCodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerificationCodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerificationCodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VerificationCodeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VerificationCodeUpsertOne.ID is not supported by MySQL driver. Use VerificationCodeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VerificationCodeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VerificationCodeCreateBulk is the builder for creating many VerificationCode entities in bulk.
+type VerificationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*VerificationCodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VerificationCode entities in the database.
+func (vccb *VerificationCodeCreateBulk) Save(ctx context.Context) ([]*VerificationCode, error) {
+	if vccb.err != nil {
+		return nil, vccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vccb.builders))
+	nodes := make([]*VerificationCode, len(vccb.builders))
+	mutators := make([]Mutator, len(vccb.builders))
+	for i := range vccb.builders {
+		func(i int, root context.Context) {
+			builder := vccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VerificationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vccb *VerificationCodeCreateBulk) SaveX(ctx context.Context) []*VerificationCode {
+	v, err := vccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vccb *VerificationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := vccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vccb *VerificationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := vccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerificationCode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerificationCodeUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (vccb *VerificationCodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *VerificationCodeUpsertBulk {
+	vccb.conflict = opts
+	return &VerificationCodeUpsertBulk{
+		create: vccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vccb *VerificationCodeCreateBulk) OnConflictColumns(columns ...string) *VerificationCodeUpsertBulk {
+	vccb.conflict = append(vccb.conflict, sql.ConflictColumns(columns...))
+	return &VerificationCodeUpsertBulk{
+		create: vccb,
+	}
+}
+
+// VerificationCodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of VerificationCode nodes.
+type VerificationCodeUpsertBulk struct {
+	create *VerificationCodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(verificationcode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VerificationCodeUpsertBulk) UpdateNewValues() *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(verificationcode.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerificationCode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VerificationCodeUpsertBulk) Ignore() *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerificationCodeUpsertBulk) DoNothing() *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerificationCodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *VerificationCodeUpsertBulk) Update(set func(*VerificationCodeUpsert)) *VerificationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerificationCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *VerificationCodeUpsertBulk) SetEmail(v string) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateEmail() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPurpose sets the "purpose" field.
+func (u *VerificationCodeUpsertBulk) SetPurpose(v verificationcode.Purpose) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetPurpose(v)
+	})
+}
+
+// UpdatePurpose sets the "purpose" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdatePurpose() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdatePurpose()
+	})
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (u *VerificationCodeUpsertBulk) SetCodeHash(v string) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetCodeHash(v)
+	})
+}
+
+// UpdateCodeHash sets the "code_hash" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateCodeHash() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateCodeHash()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *VerificationCodeUpsertBulk) SetAttempts(v int) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *VerificationCodeUpsertBulk) AddAttempts(v int) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateAttempts() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *VerificationCodeUpsertBulk) SetExpiresAt(v time.Time) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateExpiresAt() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *VerificationCodeUpsertBulk) SetCreatedAt(v time.Time) *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *VerificationCodeUpsertBulk) UpdateCreatedAt() *VerificationCodeUpsertBulk {
+	return u.Update(func(s *VerificationCodeUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *VerificationCodeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VerificationCodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerificationCodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerificationCodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
+)
+
+// VerificationCodeUpdate is the builder for updating VerificationCode entities.
+type VerificationCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VerificationCodeMutation
+}
+
+// Where appends a list predicates to the VerificationCodeUpdate builder.
+func (vcu *VerificationCodeUpdate) Where(ps ...predicate.VerificationCode) *VerificationCodeUpdate {
+	vcu.mutation.Where(ps...)
+	return vcu
+}
+
+// SetEmail sets the "email" field.
+func (vcu *VerificationCodeUpdate) SetEmail(s string) *VerificationCodeUpdate {
+	vcu.mutation.SetEmail(s)
+	return vcu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillableEmail(s *string) *VerificationCodeUpdate {
+	if s != nil {
+		vcu.SetEmail(*s)
+	}
+	return vcu
+}
+
+// SetPurpose sets the "purpose" field.
+func (vcu *VerificationCodeUpdate) SetPurpose(v verificationcode.Purpose) *VerificationCodeUpdate {
+	vcu.mutation.SetPurpose(v)
+	return vcu
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillablePurpose(v *verificationcode.Purpose) *VerificationCodeUpdate {
+	if v != nil {
+		vcu.SetPurpose(*v)
+	}
+	return vcu
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (vcu *VerificationCodeUpdate) SetCodeHash(s string) *VerificationCodeUpdate {
+	vcu.mutation.SetCodeHash(s)
+	return vcu
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillableCodeHash(s *string) *VerificationCodeUpdate {
+	if s != nil {
+		vcu.SetCodeHash(*s)
+	}
+	return vcu
+}
+
+// SetAttempts sets the "attempts" field.
+func (vcu *VerificationCodeUpdate) SetAttempts(i int) *VerificationCodeUpdate {
+	vcu.mutation.ResetAttempts()
+	vcu.mutation.SetAttempts(i)
+	return vcu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillableAttempts(i *int) *VerificationCodeUpdate {
+	if i != nil {
+		vcu.SetAttempts(*i)
+	}
+	return vcu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (vcu *VerificationCodeUpdate) AddAttempts(i int) *VerificationCodeUpdate {
+	vcu.mutation.AddAttempts(i)
+	return vcu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vcu *VerificationCodeUpdate) SetExpiresAt(t time.Time) *VerificationCodeUpdate {
+	vcu.mutation.SetExpiresAt(t)
+	return vcu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillableExpiresAt(t *time.Time) *VerificationCodeUpdate {
+	if t != nil {
+		vcu.SetExpiresAt(*t)
+	}
+	return vcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vcu *VerificationCodeUpdate) SetCreatedAt(t time.Time) *VerificationCodeUpdate {
+	vcu.mutation.SetCreatedAt(t)
+	return vcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vcu *VerificationCodeUpdate) SetNillableCreatedAt(t *time.Time) *VerificationCodeUpdate {
+	if t != nil {
+		vcu.SetCreatedAt(*t)
+	}
+	return vcu
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (vcu *VerificationCodeUpdate) Mutation() *VerificationCodeMutation {
+	return vcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vcu *VerificationCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vcu.sqlSave, vcu.mutation, vcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcu *VerificationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := vcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vcu *VerificationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := vcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcu *VerificationCodeUpdate) ExecX(ctx context.Context) {
+	if err := vcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcu *VerificationCodeUpdate) check() error {
+	if v, ok := vcu.mutation.Email(); ok {
+		if err := verificationcode.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.email": %w`, err)}
+		}
+	}
+	if v, ok := vcu.mutation.Purpose(); ok {
+		if err := verificationcode.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.purpose": %w`, err)}
+		}
+	}
+	if v, ok := vcu.mutation.CodeHash(); ok {
+		if err := verificationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vcu *VerificationCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationcode.Table, verificationcode.Columns, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeUUID))
+	if ps := vcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcu.mutation.Email(); ok {
+		_spec.SetField(verificationcode.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := vcu.mutation.Purpose(); ok {
+		_spec.SetField(verificationcode.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := vcu.mutation.CodeHash(); ok {
+		_spec.SetField(verificationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := vcu.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.AddedAttempts(); ok {
+		_spec.AddField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.ExpiresAt(); ok {
+		_spec.SetField(verificationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := vcu.mutation.CreatedAt(); ok {
+		_spec.SetField(verificationcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vcu.mutation.done = true
+	return n, nil
+}
+
+// VerificationCodeUpdateOne is the builder for updating a single VerificationCode entity.
+type VerificationCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VerificationCodeMutation
+}
+
+// SetEmail sets the "email" field.
+func (vcuo *VerificationCodeUpdateOne) SetEmail(s string) *VerificationCodeUpdateOne {
+	vcuo.mutation.SetEmail(s)
+	return vcuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillableEmail(s *string) *VerificationCodeUpdateOne {
+	if s != nil {
+		vcuo.SetEmail(*s)
+	}
+	return vcuo
+}
+
+// SetPurpose sets the "purpose" field.
+func (vcuo *VerificationCodeUpdateOne) SetPurpose(v verificationcode.Purpose) *VerificationCodeUpdateOne {
+	vcuo.mutation.SetPurpose(v)
+	return vcuo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillablePurpose(v *verificationcode.Purpose) *VerificationCodeUpdateOne {
+	if v != nil {
+		vcuo.SetPurpose(*v)
+	}
+	return vcuo
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (vcuo *VerificationCodeUpdateOne) SetCodeHash(s string) *VerificationCodeUpdateOne {
+	vcuo.mutation.SetCodeHash(s)
+	return vcuo
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillableCodeHash(s *string) *VerificationCodeUpdateOne {
+	if s != nil {
+		vcuo.SetCodeHash(*s)
+	}
+	return vcuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (vcuo *VerificationCodeUpdateOne) SetAttempts(i int) *VerificationCodeUpdateOne {
+	vcuo.mutation.ResetAttempts()
+	vcuo.mutation.SetAttempts(i)
+	return vcuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillableAttempts(i *int) *VerificationCodeUpdateOne {
+	if i != nil {
+		vcuo.SetAttempts(*i)
+	}
+	return vcuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (vcuo *VerificationCodeUpdateOne) AddAttempts(i int) *VerificationCodeUpdateOne {
+	vcuo.mutation.AddAttempts(i)
+	return vcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vcuo *VerificationCodeUpdateOne) SetExpiresAt(t time.Time) *VerificationCodeUpdateOne {
+	vcuo.mutation.SetExpiresAt(t)
+	return vcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *VerificationCodeUpdateOne {
+	if t != nil {
+		vcuo.SetExpiresAt(*t)
+	}
+	return vcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vcuo *VerificationCodeUpdateOne) SetCreatedAt(t time.Time) *VerificationCodeUpdateOne {
+	vcuo.mutation.SetCreatedAt(t)
+	return vcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vcuo *VerificationCodeUpdateOne) SetNillableCreatedAt(t *time.Time) *VerificationCodeUpdateOne {
+	if t != nil {
+		vcuo.SetCreatedAt(*t)
+	}
+	return vcuo
+}
+
+// Mutation returns the VerificationCodeMutation object of the builder.
+func (vcuo *VerificationCodeUpdateOne) Mutation() *VerificationCodeMutation {
+	return vcuo.mutation
+}
+
+// Where appends a list predicates to the VerificationCodeUpdate builder.
+func (vcuo *VerificationCodeUpdateOne) Where(ps ...predicate.VerificationCode) *VerificationCodeUpdateOne {
+	vcuo.mutation.Where(ps...)
+	return vcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vcuo *VerificationCodeUpdateOne) Select(field string, fields ...string) *VerificationCodeUpdateOne {
+	vcuo.fields = append([]string{field}, fields...)
+	return vcuo
+}
+
+// Save executes the query and returns the updated VerificationCode entity.
+func (vcuo *VerificationCodeUpdateOne) Save(ctx context.Context) (*VerificationCode, error) {
+	return withHooks(ctx, vcuo.sqlSave, vcuo.mutation, vcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcuo *VerificationCodeUpdateOne) SaveX(ctx context.Context) *VerificationCode {
+	node, err := vcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vcuo *VerificationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := vcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcuo *VerificationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := vcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcuo *VerificationCodeUpdateOne) check() error {
+	if v, ok := vcuo.mutation.Email(); ok {
+		if err := verificationcode.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.email": %w`, err)}
+		}
+	}
+	if v, ok := vcuo.mutation.Purpose(); ok {
+		if err := verificationcode.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.purpose": %w`, err)}
+		}
+	}
+	if v, ok := vcuo.mutation.CodeHash(); ok {
+		if err := verificationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "VerificationCode.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vcuo *VerificationCodeUpdateOne) sqlSave(ctx context.Context) (_node *VerificationCode, err error) {
+	if err := vcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(verificationcode.Table, verificationcode.Columns, sqlgraph.NewFieldSpec(verificationcode.FieldID, field.TypeUUID))
+	id, ok := vcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VerificationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, verificationcode.FieldID)
+		for _, f := range fields {
+			if !verificationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != verificationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcuo.mutation.Email(); ok {
+		_spec.SetField(verificationcode.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := vcuo.mutation.Purpose(); ok {
+		_spec.SetField(verificationcode.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := vcuo.mutation.CodeHash(); ok {
+		_spec.SetField(verificationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := vcuo.mutation.Attempts(); ok {
+		_spec.SetField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(verificationcode.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(verificationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := vcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(verificationcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &VerificationCode{config: vcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{verificationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vcuo.mutation.done = true
+	return _node, nil
+}
@@ -4,18 +4,24 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 )
 
+// UserContextKey is the echo.Context key under which the authenticated *ent.User is stored.
+const UserContextKey = "user"
+
 type AuthMiddleware struct {
-	authUsecase usecase.AuthUsecase
+	authUsecase       usecase.AuthUsecase
+	suspensionUsecase usecase.SuspensionUsecase
 }
 
-func NewAuthMiddleware(authUsecase usecase.AuthUsecase) *AuthMiddleware {
+func NewAuthMiddleware(authUsecase usecase.AuthUsecase, suspensionUsecase usecase.SuspensionUsecase) *AuthMiddleware {
 	return &AuthMiddleware{
-		authUsecase: authUsecase,
+		authUsecase:       authUsecase,
+		suspensionUsecase: suspensionUsecase,
 	}
 }
 
@@ -37,16 +43,38 @@ func (m *AuthMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 			})
 		}
 
-		email, err := m.authUsecase.GetUserEmail(tokenString)
+		user, err := m.authUsecase.GetUserByAccessToken(tokenString)
 		if err != nil {
-			log.Errorf("Failed to get user email from token: %v", err)
+			log.Errorf("Failed to get user from token: %v", err)
 			return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 				"error": "無効なアクセストークンです",
 			})
 		}
 
-		c.Set("email", email)
+		suspension, err := m.suspensionUsecase.GetActive(user.ID)
+		if err != nil {
+			log.Errorf("Failed to get suspension: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+				"error": "アカウント状態の確認に失敗しました",
+			})
+		}
+		if suspension != nil {
+			return c.JSON(http.StatusForbidden, map[string]interface{}{
+				"error":          "アカウントが利用停止されています",
+				"reason":         suspension.Reason,
+				"suspendedUntil": suspension.EndsAt,
+			})
+		}
+
+		c.Set("email", user.Email)
+		c.Set(UserContextKey, user)
 
 		return next(c)
 	}
 }
+
+// CurrentUser returns the user resolved by AuthMiddleware for this request.
+func CurrentUser(c echo.Context) (*ent.User, bool) {
+	user, ok := c.Get(UserContextKey).(*ent.User)
+	return user, ok && user != nil
+}
@@ -6,16 +6,21 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 )
 
-// MockGetUserEmailFunc is a function type for mocking GetUserEmail
-type MockGetUserEmailFunc func(string) (string, error)
-
 // TestAuthMiddleware_Handler tests the auth middleware's Handler method
 func TestAuthMiddleware_Handler(t *testing.T) {
+	userID := uuid.New()
+
 	// Test cases
 	testCases := []struct {
 		name           string
@@ -23,6 +28,8 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		mockToken      string
 		mockEmail      string
 		mockError      error
+		mockUserError  error
+		suspension     *ent.Suspension
 		expectedStatus int
 		expectedEmail  string
 	}{
@@ -43,6 +50,22 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 			mockError:      errors.New("invalid token"),
 			expectedStatus: http.StatusUnauthorized,
 		},
+		{
+			name:           "Unknown user",
+			authHeader:     "Bearer valid-token",
+			mockToken:      "valid-token",
+			mockEmail:      "ghost@example.com",
+			mockUserError:  errors.New("user not found"),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Suspended user",
+			authHeader:     "Bearer valid-token",
+			mockToken:      "valid-token",
+			mockEmail:      "suspended@example.com",
+			suspension:     &ent.Suspension{Reason: "spam", EndsAt: time.Now().Add(24 * time.Hour)},
+			expectedStatus: http.StatusForbidden,
+		},
 		{
 			name:           "Valid token",
 			authHeader:     "Bearer valid-token",
@@ -67,73 +90,79 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 				req.Header.Set("Authorization", tc.authHeader)
 			}
 
-			// Create a mock GetUserEmail function
-			getUserEmailCalled := false
+			verifyCalled := false
 			var tokenPassed string
-			mockGetUserEmail := func(token string) (string, error) {
-				getUserEmailCalled = true
-				tokenPassed = token
-				if tc.mockToken != "" && token == tc.mockToken {
-					return tc.mockEmail, tc.mockError
-				}
-				return "", errors.New("unexpected token")
+			mockAuthRepo := &mock.MockAuthRepository{
+				VerifyAccessTokenFunc: func(token string) (*models.AccessTokenClaims, error) {
+					verifyCalled = true
+					tokenPassed = token
+					if tc.mockToken != "" && token == tc.mockToken {
+						if tc.mockError != nil {
+							return nil, tc.mockError
+						}
+						return &models.AccessTokenClaims{Subject: "sub-" + tc.mockEmail, Username: tc.mockEmail}, nil
+					}
+					return nil, errors.New("unexpected token")
+				},
+			}
+			mockUserRepo := &mock.MockUserRepository{
+				GetByAuthSubjectFunc: func(subject string) (*ent.User, error) {
+					assert.Equal(t, "sub-"+tc.mockEmail, subject)
+					if tc.mockUserError != nil {
+						return nil, tc.mockUserError
+					}
+					return &ent.User{ID: userID, Email: tc.mockEmail}, nil
+				},
+			}
+			mockSuspensionRepo := &mock.MockSuspensionRepository{
+				GetActiveFunc: func(id uuid.UUID) (*ent.Suspension, error) {
+					assert.Equal(t, userID, id)
+					if tc.suspension != nil {
+						return tc.suspension, nil
+					}
+					return nil, &ent.NotFoundError{}
+				},
 			}
+			middleware := NewAuthMiddleware(
+				*usecase.NewAuthUsecase(mockAuthRepo, mockUserRepo),
+				*usecase.NewSuspensionUsecase(mockSuspensionRepo, mockUserRepo),
+			)
 
 			// Create a handler function that will be called if the middleware passes
 			handlerCalled := false
 			var emailFromContext string
+			var userFromContext *ent.User
 			handler := func(c echo.Context) error {
 				handlerCalled = true
 				emailFromContext = c.Get("email").(string)
+				userFromContext, _ = CurrentUser(c)
 				return c.NoContent(http.StatusOK)
 			}
 
-			// Create and execute the middleware directly
-			middlewareFunc := func(next echo.HandlerFunc) echo.HandlerFunc {
-				return func(c echo.Context) error {
-					authHeader := c.Request().Header.Get("Authorization")
-					if authHeader == "" {
-						return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-							"error": "アクセストークンが必要です",
-						})
-					}
-
-					tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-					if tokenString == "" {
-						return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-							"error": "アクセストークンが必要です",
-						})
-					}
-
-					email, err := mockGetUserEmail(tokenString)
-					if err != nil {
-						return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-							"error": "無効なアクセストークンです",
-						})
-					}
-
-					c.Set("email", email)
-
-					return next(c)
-				}
-			}
-
 			// Execute middleware
-			err := middlewareFunc(handler)(c)
+			err := middleware.Handler(handler)(c)
 
 			// Assertions
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectedStatus, rec.Code)
 
 			if tc.authHeader != "" && tc.authHeader != "Bearer " {
-				assert.True(t, getUserEmailCalled, "GetUserEmail should have been called")
-				assert.Equal(t, strings.TrimPrefix(tc.authHeader, "Bearer "), tokenPassed, "Unexpected token passed to GetUserEmail")
+				assert.True(t, verifyCalled, "VerifyAccessToken should have been called")
+				assert.Equal(t, strings.TrimPrefix(tc.authHeader, "Bearer "), tokenPassed, "Unexpected token passed to VerifyAccessToken")
+			}
+
+			if tc.suspension != nil {
+				assert.Contains(t, rec.Body.String(), tc.suspension.Reason)
+				assert.Contains(t, rec.Body.String(), "suspendedUntil")
 			}
 
 			if tc.expectedStatus == http.StatusOK {
 				// If we expect the middleware to pass, the handler should have been called
 				assert.True(t, handlerCalled, "Handler should have been called")
 				assert.Equal(t, tc.expectedEmail, emailFromContext, "Unexpected email in context")
+				if assert.NotNil(t, userFromContext, "User should be stored in context") {
+					assert.Equal(t, userID, userFromContext.ID)
+				}
 			} else {
 				// If we expect the middleware to fail, the handler should not have been called
 				assert.False(t, handlerCalled, "Handler should not have been called")
@@ -141,3 +170,17 @@ func TestAuthMiddleware_Handler(t *testing.T) {
 		})
 	}
 }
+
+func TestCurrentUser(t *testing.T) {
+	e := echo.New()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	_, ok := CurrentUser(c)
+	assert.False(t, ok)
+
+	user := &ent.User{ID: uuid.New()}
+	c.Set(UserContextKey, user)
+	got, ok := CurrentUser(c)
+	assert.True(t, ok)
+	assert.Equal(t, user, got)
+}
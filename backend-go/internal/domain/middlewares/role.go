@@ -0,0 +1,34 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// RequireRole allows the request only when the user resolved by AuthMiddleware
+// has at least the given role. It must be registered after AuthMiddleware.Handler.
+func RequireRole(role enum.Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := CurrentUser(c)
+			if !ok {
+				log.Error("Failed to check role: user not found in context")
+				return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+					"error": "認証が必要です",
+				})
+			}
+
+			if !user.Role.AtLeast(role) {
+				log.Warnf("User %s with role %q tried to access %s", user.ID, user.Role, c.Path())
+				return c.JSON(http.StatusForbidden, map[string]interface{}{
+					"error": "この操作を行う権限がありません",
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
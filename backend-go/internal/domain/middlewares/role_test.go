@@ -0,0 +1,81 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireRole(t *testing.T) {
+	testCases := []struct {
+		name           string
+		user           *ent.User
+		required       enum.Role
+		expectedStatus int
+	}{
+		{
+			name:           "No user in context",
+			user:           nil,
+			required:       enum.RoleModerator,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "User cannot access moderator routes",
+			user:           &ent.User{ID: uuid.New(), Role: enum.RoleUser},
+			required:       enum.RoleModerator,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Moderator can access moderator routes",
+			user:           &ent.User{ID: uuid.New(), Role: enum.RoleModerator},
+			required:       enum.RoleModerator,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Moderator cannot access admin routes",
+			user:           &ent.User{ID: uuid.New(), Role: enum.RoleModerator},
+			required:       enum.RoleAdmin,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Admin can access moderator routes",
+			user:           &ent.User{ID: uuid.New(), Role: enum.RoleAdmin},
+			required:       enum.RoleModerator,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unknown role is rejected",
+			user:           &ent.User{ID: uuid.New(), Role: enum.Role("owner")},
+			required:       enum.RoleUser,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/admin", nil), rec)
+			if tc.user != nil {
+				c.Set(UserContextKey, tc.user)
+			}
+
+			handlerCalled := false
+			handler := RequireRole(tc.required)(func(c echo.Context) error {
+				handlerCalled = true
+				return c.NoContent(http.StatusOK)
+			})
+
+			err := handler(c)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, handlerCalled)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+)
+
+type AdminUserResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Email        string    `json:"email"`
+	Name         string    `json:"name"`
+	Bio          string    `json:"bio"`
+	IconImageUrl *string   `json:"iconImageUrl"`
+	Role         enum.Role `json:"role"`
+	StreakCount  uint32    `json:"streakCount"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type AdminPostResponse struct {
+	ID            uuid.UUID         `json:"id"`
+	Caption       string            `json:"caption"`
+	ImageURL      string            `json:"imageUrl"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	DeletedAt     *time.Time        `json:"deletedAt"`
+	HiddenAt      *time.Time        `json:"hiddenAt"`
+	Comments      []CommentResponse `json:"comments"`
+	CommentsCount int               `json:"commentsCount"`
+	LikesCount    int               `json:"likesCount"`
+}
+
+type DeviceTokenResponse struct {
+	ID        uuid.UUID `json:"id"`
+	DeviceID  string    `json:"deviceId"`
+	Token     string    `json:"token"`
+	Platform  string    `json:"platform"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func NewAdminUserResponse(user *ent.User, imageURL string) AdminUserResponse {
+	var iconURL *string
+	if imageURL != "" {
+		iconURL = &imageURL
+	}
+	return AdminUserResponse{
+		ID:           user.ID,
+		Email:        user.Email,
+		Name:         user.Name,
+		Bio:          user.Bio,
+		IconImageUrl: iconURL,
+		Role:         user.Role,
+		StreakCount:  user.StreakCount,
+		CreatedAt:    user.CreatedAt,
+	}
+}
+
+func NewAdminPostResponse(post *ent.Post, imageURL string, comments []CommentResponse) AdminPostResponse {
+	var deletedAt *time.Time
+	if !post.DeletedAt.IsZero() {
+		deletedAt = &post.DeletedAt
+	}
+	return AdminPostResponse{
+		ID:            post.ID,
+		Caption:       post.Caption,
+		ImageURL:      imageURL,
+		CreatedAt:     post.CreatedAt,
+		DeletedAt:     deletedAt,
+		HiddenAt:      post.HiddenAt,
+		Comments:      comments,
+		CommentsCount: len(comments),
+		LikesCount:    post.LikeCount,
+	}
+}
+
+func NewDeviceTokenResponse(token *ent.DeviceToken) DeviceTokenResponse {
+	return DeviceTokenResponse{
+		ID:        token.ID,
+		DeviceID:  token.DeviceID,
+		Token:     token.Token,
+		Platform:  token.Platform,
+		CreatedAt: token.CreatedAt,
+		UpdatedAt: token.UpdatedAt,
+	}
+}
@@ -1,7 +1,10 @@
 package models
 
 import (
+	"time"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
@@ -10,30 +13,44 @@ type RefreshTokenResponse struct {
 	IdToken     string
 }
 
+// AccessTokenClaims holds the verified claims of an access token.
+type AccessTokenClaims struct {
+	Subject   string
+	Username  string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// UserBaseResponse is the public part of a user shown to other users. It never contains the email.
 type UserBaseResponse struct {
 	ID           uuid.UUID `json:"id"`
-	Email        string    `json:"email"`
+	Handle       string    `json:"handle"`
 	Name         string    `json:"name"`
 	Bio          string    `json:"bio"`
 	IconImageUrl *string   `json:"iconImageUrl"`
 }
 
+// UserResponse is a profile. Email is only set when users see their own profile.
 type UserResponse struct {
-	ID             uuid.UUID          `json:"id"`
-	Email          string             `json:"email"`
-	Name           string             `json:"name"`
-	Bio            string             `json:"bio"`
-	IconImageUrl   string             `json:"iconImageUrl"`
-	Posts          []PostResponse     `json:"posts"`
-	Pets           []PetResponse      `json:"pets"`
-	Followers      []UserBaseResponse `json:"followers"`
-	Follows        []UserBaseResponse `json:"follows"`
-	FollowersCount int                `json:"followersCount"`
-	FollowsCount   int                `json:"followsCount"`
-	DailyTask      DailyTaskResponse  `json:"dailyTask"`
-	StreakCount    uint32             `json:"streakCount"`
-	BlockingUsers  []UserBaseResponse `json:"blockingUsers"`
-	BlockedByUsers []UserBaseResponse `json:"blockedByUsers"`
+	ID           uuid.UUID      `json:"id"`
+	Email        string         `json:"email,omitempty"`
+	Handle       string         `json:"handle"`
+	Name         string         `json:"name"`
+	Bio          string         `json:"bio"`
+	IconImageUrl string         `json:"iconImageUrl"`
+	Posts        []PostResponse `json:"posts"`
+	// PostsNextCursor fetches the posts after Posts from /users/:id/posts. It is empty when there are no more.
+	PostsNextCursor string            `json:"postsNextCursor"`
+	Pets            []PetResponse     `json:"pets"`
+	FollowersCount  int               `json:"followersCount"`
+	FollowsCount    int               `json:"followsCount"`
+	PostsCount      int               `json:"postsCount"`
+	DailyTask       DailyTaskResponse `json:"dailyTask"`
+	StreakCount     uint32            `json:"streakCount"`
+	Role            enum.Role         `json:"role"`
+	IsPrivate       bool              `json:"isPrivate"`
+	FollowStatus    FollowStatus      `json:"followStatus,omitempty"`
+	IsBlocking      bool              `json:"isBlocking"`
 }
 
 // NewPetResponse converts a Pet to a PetResponse
@@ -42,26 +59,22 @@ func NewUserResponse(
 	imageURL string,
 	posts []PostResponse,
 	pets []PetResponse,
-	followers []UserBaseResponse,
-	follows []UserBaseResponse,
-	blockingUsers []UserBaseResponse,
-	blockedByUsers []UserBaseResponse,
 	dailyTask DailyTaskResponse) UserResponse {
 	return UserResponse{
 		ID:             user.ID,
+		Handle:         user.Handle,
 		Name:           user.Name,
 		Bio:            user.Bio,
 		IconImageUrl:   imageURL,
 		Posts:          posts,
 		Pets:           pets,
-		Followers:      followers,
-		Follows:        follows,
-		FollowersCount: len(followers),
-		FollowsCount:   len(follows),
+		FollowersCount: user.FollowerCount,
+		FollowsCount:   user.FollowingCount,
+		PostsCount:     user.PostCount,
 		DailyTask:      dailyTask,
 		StreakCount:    user.StreakCount,
-		BlockingUsers:  blockingUsers,
-		BlockedByUsers: blockedByUsers,
+		Role:           user.Role,
+		IsPrivate:      user.IsPrivate,
 	}
 }
 
@@ -72,7 +85,7 @@ func NewUserBaseResponse(user *ent.User, imageURL string) UserBaseResponse {
 	}
 	return UserBaseResponse{
 		ID:           user.ID,
-		Email:        user.Email,
+		Handle:       user.Handle,
 		Name:         user.Name,
 		Bio:          user.Bio,
 		IconImageUrl: iconURL,
@@ -7,18 +7,41 @@ import (
 	"github.com/google/uuid"
 )
 
+// CommentResponse is a comment or, when ParentID is set, a reply to a comment. Replies are one
+// level deep and listed by GET /comments/:id/replies.
 type CommentResponse struct {
-	ID        uuid.UUID        `json:"id"`
-	Content   string           `json:"content"`
-	CreatedAt time.Time        `json:"createdAt"`
-	User      UserBaseResponse `json:"user"`
+	ID           uuid.UUID         `json:"id"`
+	ParentID     *uuid.UUID        `json:"parentId"`
+	Content      string            `json:"content"`
+	Mentions     []MentionResponse `json:"mentions"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	Edited       bool              `json:"edited"`
+	EditedAt     *time.Time        `json:"editedAt"`
+	User         UserBaseResponse  `json:"user"`
+	RepliesCount int               `json:"repliesCount"`
+	LikesCount   int               `json:"likesCount"`
+	LikedByMe    bool              `json:"likedByMe"`
 }
 
-func NewCommentResponse(comment *ent.Comment, user *ent.User, userImageURL string) CommentResponse {
+// CommentStats is what a comment shows of its replies and likes visible to the viewer.
+type CommentStats struct {
+	RepliesCount int
+	LikesCount   int
+	LikedByMe    bool
+}
+
+func NewCommentResponse(comment *ent.Comment, user *ent.User, userImageURL string, stats CommentStats) CommentResponse {
 	return CommentResponse{
-		ID:        comment.ID,
-		Content:   comment.Content,
-		CreatedAt: comment.CreatedAt,
-		User:      NewUserBaseResponse(user, userImageURL),
+		ID:           comment.ID,
+		ParentID:     comment.ParentID,
+		Content:      comment.Content,
+		Mentions:     NewMentionResponses(comment.Content, comment.Edges.Mentions),
+		CreatedAt:    comment.CreatedAt,
+		Edited:       comment.EditedAt != nil,
+		EditedAt:     comment.EditedAt,
+		User:         NewUserBaseResponse(user, userImageURL),
+		RepliesCount: stats.RepliesCount,
+		LikesCount:   stats.LikesCount,
+		LikedByMe:    stats.LikedByMe,
 	}
 }
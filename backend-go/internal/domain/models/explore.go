@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExploreCandidate is a recent post with the engagement used to rank the explore feed.
+type ExploreCandidate struct {
+	PostID        uuid.UUID
+	AuthorID      uuid.UUID
+	CreatedAt     time.Time
+	LikesCount    int
+	CommentsCount int
+	AuthorStreak  uint32
+}
+
+// ExploreScore is the score of a post in the explore feed. Scores are stored best first.
+type ExploreScore struct {
+	PostID uuid.UUID
+	Score  float64
+}
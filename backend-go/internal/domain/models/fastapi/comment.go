@@ -19,6 +19,7 @@ func NewCommentResponseFromFastAPI(comment FastAPIComment, userIconURL string) m
 	return models.CommentResponse{
 		ID:        comment.ID,
 		Content:   comment.Content,
+		Mentions:  []models.MentionResponse{},
 		CreatedAt: comment.CreatedAt,
 		User:      NewUserBaseResponseFromFastAPI(user, userIconURL),
 	}
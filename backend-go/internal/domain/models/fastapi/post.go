@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
@@ -42,21 +43,24 @@ func NewPostResponseFromFastAPI(
 	}
 
 	return models.PostResponse{
-		ID:       uuid.MustParse(post.ID),
-		Caption:  post.Caption,
-		ImageURL: imageURL,
+		ID:        uuid.MustParse(post.ID),
+		Caption:   post.Caption,
+		ImageURL:  imageURL,
+		MediaType: enum.MediaTypeImage,
+		Media:     []models.PostMediaResponse{{Type: enum.MediaTypeImage, URL: imageURL}},
 		User: models.UserBaseResponse{
 			ID:           UserID,
-			Email:        post.User.Email,
+			Handle:       post.User.Handle,
 			Name:         post.User.Name,
 			Bio:          post.User.Bio,
 			IconImageUrl: userIconURL,
 		},
-		Comments:      commentResponses,
-		CommentsCount: len(commentResponses),
-		Likes:         likeResponses,
-		LikesCount:    len(likeResponses),
-		CreatedAt:     CreatedAt,
-		DailyTask:     dailyTaskResponse,
+		RecentComments: commentResponses,
+		CommentsCount:  len(commentResponses),
+		LikesCount:     len(likeResponses),
+		// FastAPI のいいねにはリアクションがないので paw として数える
+		Reactions: models.NewReactionCounts(map[enum.ReactionType]int{enum.ReactionTypePaw: len(likeResponses)}),
+		CreatedAt: CreatedAt,
+		DailyTask: dailyTaskResponse,
 	}
 }
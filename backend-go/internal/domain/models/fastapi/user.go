@@ -10,7 +10,7 @@ import (
 type FastAPIUserBase struct {
 	ID           string `json:"id"`
 	Name         string `json:"name"`
-	Email        string `json:"email"`
+	Handle       string `json:"handle"`
 	Bio          string `json:"bio"`
 	IconImageKey string `json:"icon_image_key"`
 }
@@ -26,7 +26,7 @@ func NewUserBaseResponseFromFastAPI(user FastAPIUserBase, iconImageUrl string) m
 	}
 	return models.UserBaseResponse{
 		ID:           UserID,
-		Email:        user.Email,
+		Handle:       user.Handle,
 		Name:         user.Name,
 		Bio:          user.Bio,
 		IconImageUrl: iconURL,
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+// FollowStatus is the relation of the viewer to another user.
+type FollowStatus string
+
+const (
+	FollowStatusNone      FollowStatus = "none"
+	FollowStatusRequested FollowStatus = "requested"
+	FollowStatusFollowing FollowStatus = "following"
+)
+
+// FollowListUserResponse is a user in a follower, following or blocking list.
+type FollowListUserResponse struct {
+	UserBaseResponse
+	FollowedByMe bool `json:"followedByMe"`
+}
+
+// FollowRequestResponse is a pending request. User is the requester for incoming
+// requests and the requested user for outgoing ones.
+type FollowRequestResponse struct {
+	ID        uuid.UUID        `json:"id"`
+	User      UserBaseResponse `json:"user"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+func NewFollowRequestResponse(request *ent.FollowRequest, user UserBaseResponse) FollowRequestResponse {
+	return FollowRequestResponse{
+		ID:        request.ID,
+		User:      user,
+		CreatedAt: request.CreatedAt,
+	}
+}
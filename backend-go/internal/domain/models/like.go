@@ -4,12 +4,14 @@ import (
 	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 )
 
 type LikeResponse struct {
-	ID        string           `json:"id"`
-	User      UserBaseResponse `json:"user"`
-	CreatedAt time.Time        `json:"createdAt"`
+	ID        string            `json:"id"`
+	User      UserBaseResponse  `json:"user"`
+	Reaction  enum.ReactionType `json:"reaction"`
+	CreatedAt time.Time         `json:"createdAt"`
 }
 
 func NewLikeResponse(like *ent.Like, imageUrl string) LikeResponse {
@@ -17,6 +19,16 @@ func NewLikeResponse(like *ent.Like, imageUrl string) LikeResponse {
 	return LikeResponse{
 		ID:        like.ID.String(),
 		User:      NewUserBaseResponse(user, imageUrl),
+		Reaction:  like.Reaction,
 		CreatedAt: like.CreatedAt,
 	}
 }
+
+// NewReactionCounts returns the counts with every reaction type, so clients always get all keys.
+func NewReactionCounts(counts map[enum.ReactionType]int) map[enum.ReactionType]int {
+	reactions := make(map[enum.ReactionType]int, len(enum.ReactionType("").Values()))
+	for _, value := range enum.ReactionType("").Values() {
+		reactions[enum.ReactionType(value)] = counts[enum.ReactionType(value)]
+	}
+	return reactions
+}
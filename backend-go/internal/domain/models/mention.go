@@ -0,0 +1,102 @@
+package models
+
+import (
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+// ハンドルの長さの範囲。usecase の minHandleLength / maxHandleLength と同じ
+const (
+	minMentionHandleLength = 3
+	maxMentionHandleLength = 30
+)
+
+// Mention is an @handle found in a caption or a comment.
+type Mention struct {
+	// Handle is lower-cased and does not include the "@".
+	Handle string
+	// Start and End are offsets in UTF-16 code units, as strings are indexed in JavaScript.
+	// The range includes the "@".
+	Start int
+	End   int
+}
+
+// MentionResponse is the range of a caption or a comment that mentions a user.
+type MentionResponse struct {
+	UserID uuid.UUID `json:"userId"`
+	Handle string    `json:"handle"`
+	Start  int       `json:"start"`
+	End    int       `json:"end"`
+}
+
+// FindMentions returns the mentions of the text in order. A mention starts with "@" or "＠" that
+// does not follow a character of a handle, so email addresses are not mentions, and is followed by
+// a valid handle. Japanese text may come right before it, as in "ポチと@shiba".
+func FindMentions(text string) []Mention {
+	runes := []rune(text)
+	var mentions []Mention
+	offset := 0
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		if (r != '@' && r != '＠') || (i > 0 && isHandleRune(runes[i-1])) {
+			offset += utf16.RuneLen(r)
+			i++
+			continue
+		}
+		end := i + 1
+		for end < len(runes) && isHandleRune(runes[end]) {
+			end++
+		}
+		length := end - i - 1
+		if length >= minMentionHandleLength && length <= maxMentionHandleLength {
+			handle := make([]rune, length)
+			for j, h := range runes[i+1 : end] {
+				handle[j] = unicode.ToLower(h)
+			}
+			// ハンドルは ASCII のみなので "@" 以降は 1 文字 1 単位
+			mentions = append(mentions, Mention{
+				Handle: string(handle),
+				Start:  offset,
+				End:    offset + utf16.RuneLen(r) + length,
+			})
+		}
+		for _, s := range runes[i:end] {
+			offset += utf16.RuneLen(s)
+		}
+		i = end
+	}
+	return mentions
+}
+
+func isHandleRune(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// NewMentionResponses returns the ranges of the text that mention one of the users. Mentions of
+// handles that were not resolved to a user, e.g. of users blocking the author, are left out.
+func NewMentionResponses(text string, users []*ent.User) []MentionResponse {
+	responses := []MentionResponse{}
+	if len(users) == 0 {
+		return responses
+	}
+	byHandle := make(map[string]uuid.UUID, len(users))
+	for _, user := range users {
+		if user.Handle != "" {
+			byHandle[user.Handle] = user.ID
+		}
+	}
+	for _, mention := range FindMentions(text) {
+		if id, ok := byHandle[mention.Handle]; ok {
+			responses = append(responses, MentionResponse{
+				UserID: id,
+				Handle: mention.Handle,
+				Start:  mention.Start,
+				End:    mention.End,
+			})
+		}
+	}
+	return responses
+}
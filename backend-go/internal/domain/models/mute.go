@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+type MutedKeywordResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Keyword   string    `json:"keyword"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func NewMutedKeywordResponse(keyword *ent.MutedKeyword) MutedKeywordResponse {
+	return MutedKeywordResponse{
+		ID:        keyword.ID,
+		Keyword:   keyword.Keyword,
+		CreatedAt: keyword.CreatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+)
+
+// NotificationResponse is an in-app notification. For a mention, PostID is the post the user was
+// mentioned in, and CommentID the comment when the mention is not in the caption.
+type NotificationResponse struct {
+	ID        uuid.UUID             `json:"id"`
+	Type      enum.NotificationType `json:"type"`
+	Actor     UserBaseResponse      `json:"actor"`
+	PostID    *uuid.UUID            `json:"postId"`
+	CommentID *uuid.UUID            `json:"commentId"`
+	Read      bool                  `json:"read"`
+	CreatedAt time.Time             `json:"createdAt"`
+}
+
+func NewNotificationResponse(notification *ent.Notification, actorImageURL string) NotificationResponse {
+	return NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Actor:     NewUserBaseResponse(notification.Edges.Actor, actorImageURL),
+		PostID:    notification.PostID,
+		CommentID: notification.CommentID,
+		Read:      notification.ReadAt != nil,
+		CreatedAt: notification.CreatedAt,
+	}
+}
@@ -21,6 +21,69 @@ type PetResponse struct {
 	CreatedAt time.Time   `json:"createdAt"`
 }
 
+// PetTagResponse is a pet tagged in a post.
+type PetTagResponse struct {
+	ID       uuid.UUID   `json:"id"`
+	Name     string      `json:"name"`
+	Type     pet.Type    `json:"type"`
+	Species  pet.Species `json:"species"`
+	ImageURL string      `json:"imageUrl"`
+}
+
+// NewPetTagResponses pairs the pets with their image URLs in order.
+func NewPetTagResponses(pets []*ent.Pet, imageURLs []string) []PetTagResponse {
+	responses := make([]PetTagResponse, len(pets))
+	for i, p := range pets {
+		responses[i] = PetTagResponse{
+			ID:       p.ID,
+			Name:     p.Name,
+			Type:     p.Type,
+			Species:  p.Species,
+			ImageURL: imageURLs[i],
+		}
+	}
+	return responses
+}
+
+// PetProfile is a pet with the posts it is tagged in that a viewer can see.
+type PetProfile struct {
+	Pet        *ent.Pet
+	PostsCount int
+	// LatestPosts are the newest posts, newest first.
+	LatestPosts []*ent.Post
+}
+
+// PetProfileResponse is a pet with its owner, the number of posts it is tagged in that the
+// viewer can see, and the photos of the latest of them.
+type PetProfileResponse struct {
+	PetResponse
+	Owner        UserBaseResponse `json:"owner"`
+	PostsCount   int              `json:"postsCount"`
+	LatestPhotos []PetPhoto       `json:"latestPhotos"`
+}
+
+// PetPhoto is the cover image of a post a pet is tagged in.
+type PetPhoto struct {
+	PostID   uuid.UUID `json:"postId"`
+	ImageURL string    `json:"imageUrl"`
+}
+
+// NewPetProfileResponse builds the profile of a pet. photoURLs are the cover images of
+// profile.LatestPosts in order.
+func NewPetProfileResponse(profile PetProfile, imageURL, ownerImageURL string, photoURLs []string) PetProfileResponse {
+	response := PetProfileResponse{
+		PetResponse:  NewPetResponse(profile.Pet, imageURL),
+		Owner:        NewUserBaseResponse(profile.Pet.Edges.Owner, ownerImageURL),
+		PostsCount:   profile.PostsCount,
+		LatestPhotos: make([]PetPhoto, len(profile.LatestPosts)),
+	}
+	response.OwnerID = profile.Pet.Edges.Owner.ID
+	for i, post := range profile.LatestPosts {
+		response.LatestPhotos[i] = PetPhoto{PostID: post.ID, ImageURL: photoURLs[i]}
+	}
+	return response
+}
+
 // NewPetResponse converts a Pet to a PetResponse
 func NewPetResponse(pet *ent.Pet, imageURL string) PetResponse {
 	return PetResponse{
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
@@ -11,17 +12,48 @@ type PostBaseResponse struct {
 	ID uuid.UUID `json:"id"`
 }
 
+// PostResponse carries the counts of comments and likes and a preview of the newest comments.
+// The comments and likers themselves are paginated by GET /posts/:id/comments and /likes.
 type PostResponse struct {
-	ID            uuid.UUID              `json:"id"`
-	Caption       string                 `json:"caption"`
-	User          UserBaseResponse       `json:"user"`
-	ImageURL      string                 `json:"imageUrl"`
-	CreatedAt     time.Time              `json:"createdAt"`
-	Comments      []CommentResponse      `json:"comments"`
-	CommentsCount int                    `json:"commentsCount"`
-	Likes         []LikeResponse         `json:"likes"`
-	LikesCount    int                    `json:"likesCount"`
-	DailyTask     *DailyTaskBaseResponse `json:"dailyTask"`
+	ID        uuid.UUID           `json:"id"`
+	Caption   string              `json:"caption"`
+	User      UserBaseResponse    `json:"user"`
+	ImageURL  string              `json:"imageUrl"`
+	MediaType enum.MediaType      `json:"mediaType"`
+	Media     []PostMediaResponse `json:"media"`
+	// Pets are the pets of the author tagged in the post.
+	Pets []PetTagResponse `json:"pets"`
+	// Mentions are the ranges of the caption that mention a user.
+	Mentions []MentionResponse `json:"mentions"`
+	// Duration is the length of a video in seconds, and PosterURL the image shown before it plays.
+	Duration       *float64          `json:"duration"`
+	PosterURL      *string           `json:"posterUrl"`
+	CreatedAt      time.Time         `json:"createdAt"`
+	Edited         bool              `json:"edited"`
+	EditedAt       *time.Time        `json:"editedAt"`
+	RecentComments []CommentResponse `json:"recentComments"`
+	CommentsCount  int               `json:"commentsCount"`
+	// LikesCount is the total of Reactions, and LikedByMe whether MyReaction is set.
+	LikesCount int                       `json:"likesCount"`
+	LikedByMe  bool                      `json:"likedByMe"`
+	Reactions  map[enum.ReactionType]int `json:"reactions"`
+	MyReaction *enum.ReactionType        `json:"myReaction"`
+	DailyTask  *DailyTaskBaseResponse    `json:"dailyTask"`
+}
+
+// PostStats is what a feed shows of the comments and likes of a post visible to the viewer.
+type PostStats struct {
+	CommentsCount int
+	LikesCount    int
+	LikedByMe     bool
+	// Reactions counts the likes by reaction, and MyReaction is the viewer's, if they liked the post.
+	Reactions  map[enum.ReactionType]int
+	MyReaction *enum.ReactionType
+	// RecentComments are the newest comments, newest first, with their users. Replies are not
+	// previewed.
+	RecentComments []*ent.Comment
+	// CommentStats are the stats of RecentComments, keyed by comment ID.
+	CommentStats map[uuid.UUID]CommentStats
 }
 
 func NewPostBaseResponse(post *ent.Post) PostBaseResponse {
@@ -30,12 +62,78 @@ func NewPostBaseResponse(post *ent.Post) PostBaseResponse {
 	}
 }
 
+// PostMediaResponse is one item of a carousel. Width and height are null when they could not
+// be read from the file. Duration and PosterURL are only set for videos.
+type PostMediaResponse struct {
+	Type      enum.MediaType `json:"type"`
+	URL       string         `json:"url"`
+	Width     *int           `json:"width"`
+	Height    *int           `json:"height"`
+	AltText   string         `json:"altText"`
+	Duration  *float64       `json:"duration"`
+	PosterURL *string        `json:"posterUrl"`
+}
+
+// PostMediaInput is an uploaded media item of a new post.
+type PostMediaInput struct {
+	Type       enum.MediaType
+	Key        string
+	Width      *int
+	Height     *int
+	AltText    string
+	DurationMs *int
+	// PosterKey is the poster image of a video.
+	PosterKey string
+}
+
+// MediaURL is the signed URL of a media item and, for a video, of its poster image.
+type MediaURL struct {
+	URL       string
+	PosterURL string
+}
+
+// MediaOf returns the media of the post in order. A post created before carousels that has not
+// been migrated yet returns its image_key as the only item.
+func MediaOf(post *ent.Post) []*ent.PostMedia {
+	if len(post.Edges.Media) > 0 || post.ImageKey == "" {
+		return post.Edges.Media
+	}
+	return []*ent.PostMedia{{Type: enum.MediaTypeImage, Key: post.ImageKey}}
+}
+
+// NewPostMediaResponses pairs the media of the post with their URLs, in the order of MediaOf.
+func NewPostMediaResponses(post *ent.Post, mediaURLs []MediaURL) []PostMediaResponse {
+	media := MediaOf(post)
+	responses := make([]PostMediaResponse, len(media))
+	for i, m := range media {
+		responses[i] = PostMediaResponse{
+			Type:    m.Type,
+			URL:     mediaURLs[i].URL,
+			Width:   m.Width,
+			Height:  m.Height,
+			AltText: m.AltText,
+		}
+		if m.Type == enum.MediaTypeVideo {
+			if m.DurationMs != nil {
+				duration := float64(*m.DurationMs) / 1000
+				responses[i].Duration = &duration
+			}
+			posterURL := mediaURLs[i].PosterURL
+			responses[i].PosterURL = &posterURL
+		}
+	}
+	return responses
+}
+
+// NewPostResponse builds the response of a post. mediaURLs are the URLs of MediaOf(post) in order,
+// and petImageURLs the image URLs of post.Edges.Pets in order.
 func NewPostResponse(
 	post *ent.Post,
-	postImageURL string,
+	mediaURLs []MediaURL,
 	userImageURL string,
-	comments []CommentResponse,
-	likes []LikeResponse,
+	petImageURLs []string,
+	stats PostStats,
+	recentComments []CommentResponse,
 ) PostResponse {
 	var user *ent.User
 	if post.Edges.User != nil {
@@ -46,16 +144,77 @@ func NewPostResponse(
 		resp := NewDailyTaskBaseResponse(post.Edges.DailyTask)
 		dailyTaskResp = &resp
 	}
+	media := NewPostMediaResponses(post, mediaURLs)
+	// 古いアプリ向けの imageUrl は1枚目の画像か、動画ならポスター画像
+	var imageURL string
+	var duration *float64
+	var posterURL *string
+	if len(media) > 0 {
+		imageURL = media[0].URL
+		if media[0].Type == enum.MediaTypeVideo {
+			imageURL = *media[0].PosterURL
+			duration, posterURL = media[0].Duration, media[0].PosterURL
+		}
+	}
+	mediaType := post.MediaType
+	if mediaType == "" {
+		mediaType = enum.MediaTypeImage
+	}
 	return PostResponse{
-		ID:            post.ID,
-		Caption:       post.Caption,
-		User:          NewUserBaseResponse(user, userImageURL),
-		ImageURL:      postImageURL,
-		CreatedAt:     post.CreatedAt,
-		Comments:      comments,
-		CommentsCount: len(comments),
-		Likes:         likes,
-		LikesCount:    len(likes),
-		DailyTask:     dailyTaskResp,
+		ID:             post.ID,
+		Caption:        post.Caption,
+		Mentions:       NewMentionResponses(post.Caption, post.Edges.Mentions),
+		User:           NewUserBaseResponse(user, userImageURL),
+		ImageURL:       imageURL,
+		MediaType:      mediaType,
+		Media:          media,
+		Pets:           NewPetTagResponses(post.Edges.Pets, petImageURLs),
+		Duration:       duration,
+		PosterURL:      posterURL,
+		CreatedAt:      post.CreatedAt,
+		Edited:         post.EditedAt != nil,
+		EditedAt:       post.EditedAt,
+		RecentComments: recentComments,
+		CommentsCount:  stats.CommentsCount,
+		LikesCount:     stats.LikesCount,
+		LikedByMe:      stats.LikedByMe,
+		Reactions:      NewReactionCounts(stats.Reactions),
+		MyReaction:     stats.MyReaction,
+		DailyTask:      dailyTaskResp,
+	}
+}
+
+// PostRevisionResponse is a previous caption of a post, the pets tagged at that time, and when
+// they were replaced.
+type PostRevisionResponse struct {
+	ID        uuid.UUID   `json:"id"`
+	Caption   string      `json:"caption"`
+	PetIDs    []uuid.UUID `json:"petIds"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+func NewPostRevisionResponse(revision *ent.PostRevision) PostRevisionResponse {
+	// ペットのタグ付けより前の履歴は pet_ids を持たない
+	petIDs := revision.PetIds
+	if petIDs == nil {
+		petIDs = []uuid.UUID{}
+	}
+	return PostRevisionResponse{
+		ID:        revision.ID,
+		Caption:   revision.Caption,
+		PetIDs:    petIDs,
+		CreatedAt: revision.CreatedAt,
 	}
 }
+
+// TimelineSource tells which source served a recommended timeline.
+type TimelineSource string
+
+const (
+	// TimelineSourceAlgorithm is the ranking of the algorithm service.
+	TimelineSourceAlgorithm TimelineSource = "algorithm"
+	// TimelineSourceFallback is built from recent and popular posts while the algorithm service is unavailable.
+	TimelineSourceFallback TimelineSource = "fallback"
+	// TimelineSourceCache is a later page of a timeline fetched earlier.
+	TimelineSourceCache TimelineSource = "cache"
+)
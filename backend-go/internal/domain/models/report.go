@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+)
+
+// ReportFilter narrows down the reports listed in the review queue. Empty fields match everything.
+type ReportFilter struct {
+	Status     enum.ReportStatus
+	TargetType enum.ReportTargetType
+	Reason     enum.ReportReason
+}
+
+type ReportResponse struct {
+	ID             uuid.UUID             `json:"id"`
+	TargetType     enum.ReportTargetType `json:"targetType"`
+	TargetID       *uuid.UUID            `json:"targetId"`
+	Reason         enum.ReportReason     `json:"reason"`
+	Details        string                `json:"details"`
+	Status         enum.ReportStatus     `json:"status"`
+	Reporter       *UserBaseResponse     `json:"reporter"`
+	Resolver       *UserBaseResponse     `json:"resolver"`
+	ResolutionNote string                `json:"resolutionNote"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	ResolvedAt     *time.Time            `json:"resolvedAt"`
+}
+
+// ReportTargetID returns the ID of the reported post, comment or user.
+// It is nil when the target has been deleted since the report was filed.
+func ReportTargetID(report *ent.Report) *uuid.UUID {
+	switch report.TargetType {
+	case enum.ReportTargetPost:
+		return report.PostID
+	case enum.ReportTargetComment:
+		return report.CommentID
+	case enum.ReportTargetUser:
+		return report.TargetUserID
+	}
+	return nil
+}
+
+func NewReportResponse(report *ent.Report) ReportResponse {
+	var reporter, resolver *UserBaseResponse
+	if report.Edges.Reporter != nil {
+		resp := NewUserBaseResponse(report.Edges.Reporter, "")
+		reporter = &resp
+	}
+	if report.Edges.Resolver != nil {
+		resp := NewUserBaseResponse(report.Edges.Resolver, "")
+		resolver = &resp
+	}
+	return ReportResponse{
+		ID:             report.ID,
+		TargetType:     report.TargetType,
+		TargetID:       ReportTargetID(report),
+		Reason:         report.Reason,
+		Details:        report.Details,
+		Status:         report.Status,
+		Reporter:       reporter,
+		Resolver:       resolver,
+		ResolutionNote: report.ResolutionNote,
+		CreatedAt:      report.CreatedAt,
+		ResolvedAt:     report.ResolvedAt,
+	}
+}
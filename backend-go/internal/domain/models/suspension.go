@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+type SuspensionResponse struct {
+	ID        uuid.UUID         `json:"id"`
+	Reason    string            `json:"reason"`
+	StartsAt  time.Time         `json:"startsAt"`
+	EndsAt    time.Time         `json:"endsAt"`
+	LiftedAt  *time.Time        `json:"liftedAt"`
+	IssuedBy  *UserBaseResponse `json:"issuedBy"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+func NewSuspensionResponse(suspension *ent.Suspension) SuspensionResponse {
+	var issuedBy *UserBaseResponse
+	if suspension.Edges.IssuedBy != nil {
+		resp := NewUserBaseResponse(suspension.Edges.IssuedBy, "")
+		issuedBy = &resp
+	}
+	return SuspensionResponse{
+		ID:        suspension.ID,
+		Reason:    suspension.Reason,
+		StartsAt:  suspension.StartsAt,
+		EndsAt:    suspension.EndsAt,
+		LiftedAt:  suspension.LiftedAt,
+		IssuedBy:  issuedBy,
+		CreatedAt: suspension.CreatedAt,
+	}
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+// TagPage is one page of the posts with a hashtag.
+type TagPage struct {
+	// Name is the normalized name of the tag, without "#".
+	Name       string
+	PostsCount int
+	Posts      []*ent.Post
+	NextCursor string
+}
+
+// TagUsage is the number of posts a hashtag was added to in a period.
+type TagUsage struct {
+	TagID uuid.UUID
+	Name  string
+	Count int
+}
+
+// TrendingTag is a hashtag used more in the current window than in the one before.
+type TrendingTag struct {
+	Name string `json:"name"`
+	// PostsCount is the number of posts tagged in the current window, and PreviousCount in the window before.
+	PostsCount    int     `json:"postsCount"`
+	PreviousCount int     `json:"previousCount"`
+	Score         float64 `json:"score"`
+}
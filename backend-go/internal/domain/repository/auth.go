@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 )
 
@@ -12,6 +13,7 @@ type AuthRepository interface {
 	SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error)
 	RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error)
 	GetUserEmail(accessToken string) (string, error)
+	VerifyAccessToken(accessToken string) (*models.AccessTokenClaims, error)
 	SignOut(token string) error
 	Delete(accessToken string) error
 	RequestResetPassword(email string) error
@@ -1,10 +1,18 @@
 package repository
 
-import "github.com/aki-13627/animalia/backend-go/ent"
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
 
 type BlockRelationRepository interface {
 	Create(fromID, toID string) error
 	Delete(fromID, toID string) error
 	BlockingUsers(fromID string) ([]*ent.User, error)
 	BlockedByUsers(toID string) ([]*ent.User, error)
+	Exists(fromID, toID uuid.UUID) (bool, error)
+	IsBlockedBetween(userID, otherID uuid.UUID) (bool, error)
+	BlockedUserIDs(userID uuid.UUID) ([]uuid.UUID, error)
+	ListBlocking(fromID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.BlockRelation, error)
 }
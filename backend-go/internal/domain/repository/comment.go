@@ -2,10 +2,27 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
 type CommentRepository interface {
-	Create(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error)
+	// GetById returns the comment with its user and its post's user, including deleted comments.
+	GetById(commentId string) (*ent.Comment, error)
+	// Create stores the comment, or a reply to parentId, with the users it mentions.
+	Create(userId uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error)
+	// Update replaces the content and the mentioned users of the comment and marks it as edited.
+	Update(commentId uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error)
 	Delete(commentId string) error
+	SoftDelete(commentId uuid.UUID) error
+	SetHidden(commentId uuid.UUID, hidden bool) error
+	// ListByPost returns the top-level comments of the post visible to the viewer, newest first.
+	ListByPost(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error)
+	// ListReplies returns the replies to the comment visible to the viewer, oldest first.
+	ListReplies(parentId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error)
+	// Like likes the comment. Liking it again does nothing.
+	Like(commentId uuid.UUID, userId uuid.UUID) error
+	Unlike(commentId uuid.UUID, userId uuid.UUID) error
+	// GetStats counts the replies and likes of the comments visible to the viewer.
+	GetStats(viewerID uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID]models.CommentStats, error)
 }
@@ -1,5 +1,11 @@
 package repository
 
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
 type DeviceTokenRepository interface {
 	Upsert(userID string, deviceID string, token string, platform string) error
+	GetByUser(userID uuid.UUID) ([]*ent.DeviceToken, error)
 }
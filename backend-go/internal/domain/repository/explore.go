@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ExploreRepository interface {
+	// ListCandidates returns the visible posts created since the time with their like and comment counts.
+	ListCandidates(since time.Time) ([]models.ExploreCandidate, error)
+	// ReplaceRanking replaces the whole ranking with the scores, which are given best first.
+	ReplaceRanking(scores []models.ExploreScore) error
+	// GetRanked returns the rankings after the position with their posts, leaving out posts the viewer cannot see.
+	GetRanked(viewerID uuid.UUID, afterPosition int, limit int) ([]*ent.ExploreRanking, error)
+}
@@ -1,6 +1,18 @@
 package repository
 
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
 type FollowRelationRepository interface {
 	Follow(toId string, fromId string) error
 	Unfollow(toId string, fromId string) error
+	IsFollowing(fromID, toID uuid.UUID) (bool, error)
+	Followers(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error)
+	Following(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error)
+	FollowedIDs(fromID uuid.UUID, toIDs []uuid.UUID) ([]uuid.UUID, error)
+	CountFollowers(userID uuid.UUID) (int, error)
+	CountFollowing(userID uuid.UUID) (int, error)
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+type FollowRequestRepository interface {
+	Create(fromID, toID uuid.UUID) error
+	GetById(id uuid.UUID) (*ent.FollowRequest, error)
+	Exists(fromID, toID uuid.UUID) (bool, error)
+	Approve(id uuid.UUID) error
+	ApproveAll(toID uuid.UUID) (int, error)
+	Delete(id uuid.UUID) error
+	DeleteBetween(userID, otherID uuid.UUID) error
+	ListIncoming(toID uuid.UUID) ([]*ent.FollowRequest, error)
+	ListOutgoing(fromID uuid.UUID) ([]*ent.FollowRequest, error)
+}
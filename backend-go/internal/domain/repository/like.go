@@ -1,7 +1,17 @@
 package repository
 
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
 type LikeRepository interface {
-	Create(userId string, postId string) error
+	// Create likes the post with the reaction, or changes the reaction of the user's like.
+	Create(userId string, postId string, reaction enum.ReactionType) error
 	Delete(userId string, postId string) error
 	Count(petID string) (int, error)
+	// ListByPost returns the likes of the post by users visible to the viewer, newest first.
+	ListByPost(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Like, error)
 }
@@ -0,0 +1,74 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+)
+
+// MockAuthRepository is a mock implementation of the AuthRepository interface
+type MockAuthRepository struct {
+	GenerateHashFunc         func(username string) string
+	VerifyTokenFunc          func(email, token string) error
+	CreateUserFunc           func(name, email, password string) error
+	VerifyEmailFunc          func(email, code string) error
+	SignInFunc               func(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error)
+	RefreshTokenFunc         func(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error)
+	GetUserEmailFunc         func(accessToken string) (string, error)
+	VerifyAccessTokenFunc    func(accessToken string) (*models.AccessTokenClaims, error)
+	SignOutFunc              func(token string) error
+	DeleteFunc               func(accessToken string) error
+	RequestResetPasswordFunc func(email string) error
+	ConfirmResetPasswordFunc func(email, code, newPassword string) error
+}
+
+// Ensure MockAuthRepository implements AuthRepository interface
+var _ repository.AuthRepository = (*MockAuthRepository)(nil)
+
+func (m *MockAuthRepository) GenerateHash(username string) string {
+	return m.GenerateHashFunc(username)
+}
+
+func (m *MockAuthRepository) VerifyToken(email, token string) error {
+	return m.VerifyTokenFunc(email, token)
+}
+
+func (m *MockAuthRepository) CreateUser(name, email, password string) error {
+	return m.CreateUserFunc(name, email, password)
+}
+
+func (m *MockAuthRepository) VerifyEmail(email, code string) error {
+	return m.VerifyEmailFunc(email, code)
+}
+
+func (m *MockAuthRepository) SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	return m.SignInFunc(email, password)
+}
+
+func (m *MockAuthRepository) RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	return m.RefreshTokenFunc(refreshToken)
+}
+
+func (m *MockAuthRepository) GetUserEmail(accessToken string) (string, error) {
+	return m.GetUserEmailFunc(accessToken)
+}
+
+func (m *MockAuthRepository) VerifyAccessToken(accessToken string) (*models.AccessTokenClaims, error) {
+	return m.VerifyAccessTokenFunc(accessToken)
+}
+
+func (m *MockAuthRepository) SignOut(token string) error {
+	return m.SignOutFunc(token)
+}
+
+func (m *MockAuthRepository) Delete(accessToken string) error {
+	return m.DeleteFunc(accessToken)
+}
+
+func (m *MockAuthRepository) RequestResetPassword(email string) error {
+	return m.RequestResetPasswordFunc(email)
+}
+
+func (m *MockAuthRepository) ConfirmResetPassword(email, code, newPassword string) error {
+	return m.ConfirmResetPasswordFunc(email, code, newPassword)
+}
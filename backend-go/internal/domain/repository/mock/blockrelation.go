@@ -2,7 +2,9 @@ package mock
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
 type MockBlockRelationRepository struct {
@@ -10,6 +12,11 @@ type MockBlockRelationRepository struct {
 	DeleteFunc         func(fromID, toID string) error
 	BlockingUsersFunc  func(fromID string) ([]*ent.User, error)
 	BlockedByUsersFunc func(toID string) ([]*ent.User, error)
+
+	ExistsFunc           func(fromID, toID uuid.UUID) (bool, error)
+	IsBlockedBetweenFunc func(userID, otherID uuid.UUID) (bool, error)
+	BlockedUserIDsFunc   func(userID uuid.UUID) ([]uuid.UUID, error)
+	ListBlockingFunc     func(fromID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.BlockRelation, error)
 }
 
 var _ repository.BlockRelationRepository = (*MockBlockRelationRepository)(nil)
@@ -29,3 +36,19 @@ func (m *MockBlockRelationRepository) BlockingUsers(fromID string) ([]*ent.User,
 func (m *MockBlockRelationRepository) BlockedByUsers(toID string) ([]*ent.User, error) {
 	return m.BlockedByUsersFunc(toID)
 }
+
+func (m *MockBlockRelationRepository) Exists(fromID, toID uuid.UUID) (bool, error) {
+	return m.ExistsFunc(fromID, toID)
+}
+
+func (m *MockBlockRelationRepository) IsBlockedBetween(userID, otherID uuid.UUID) (bool, error) {
+	return m.IsBlockedBetweenFunc(userID, otherID)
+}
+
+func (m *MockBlockRelationRepository) BlockedUserIDs(userID uuid.UUID) ([]uuid.UUID, error) {
+	return m.BlockedUserIDsFunc(userID)
+}
+
+func (m *MockBlockRelationRepository) ListBlocking(fromID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.BlockRelation, error) {
+	return m.ListBlockingFunc(fromID, cursor, limit)
+}
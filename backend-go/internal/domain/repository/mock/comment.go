@@ -2,25 +2,80 @@ package mock
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
 )
 
 // MockCommentRepository is a mock implementation of the CommentRepository interface
 type MockCommentRepository struct {
-	CreateFunc func(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error)
-	DeleteFunc func(commentId string) error
+	GetByIdFunc     func(commentId string) (*ent.Comment, error)
+	CreateFunc      func(userId uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error)
+	UpdateFunc      func(commentId uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error)
+	DeleteFunc      func(commentId string) error
+	SoftDeleteFunc  func(commentId uuid.UUID) error
+	SetHiddenFunc   func(commentId uuid.UUID, hidden bool) error
+	ListByPostFunc  func(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error)
+	ListRepliesFunc func(parentId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error)
+	LikeFunc        func(commentId uuid.UUID, userId uuid.UUID) error
+	UnlikeFunc      func(commentId uuid.UUID, userId uuid.UUID) error
+	GetStatsFunc    func(viewerID uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID]models.CommentStats, error)
 }
 
 // Ensure MockCommentRepository implements CommentRepository interface
 var _ repository.CommentRepository = (*MockCommentRepository)(nil)
 
+// GetById calls the mocked GetByIdFunc
+func (m *MockCommentRepository) GetById(commentId string) (*ent.Comment, error) {
+	return m.GetByIdFunc(commentId)
+}
+
 // Create calls the mocked CreateFunc
-func (m *MockCommentRepository) Create(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error) {
-	return m.CreateFunc(userId, postId, content)
+func (m *MockCommentRepository) Create(userId uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
+	return m.CreateFunc(userId, postId, parentId, content, mentionIds)
+}
+
+// Update calls the mocked UpdateFunc
+func (m *MockCommentRepository) Update(commentId uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
+	return m.UpdateFunc(commentId, content, mentionIds)
 }
 
 // Delete calls the mocked DeleteFunc
 func (m *MockCommentRepository) Delete(commentId string) error {
 	return m.DeleteFunc(commentId)
 }
+
+// SoftDelete calls the mocked SoftDeleteFunc
+func (m *MockCommentRepository) SoftDelete(commentId uuid.UUID) error {
+	return m.SoftDeleteFunc(commentId)
+}
+
+// SetHidden calls the mocked SetHiddenFunc
+func (m *MockCommentRepository) SetHidden(commentId uuid.UUID, hidden bool) error {
+	return m.SetHiddenFunc(commentId, hidden)
+}
+
+// ListByPost calls the mocked ListByPostFunc
+func (m *MockCommentRepository) ListByPost(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error) {
+	return m.ListByPostFunc(postId, viewerID, cursor, limit)
+}
+
+// ListReplies calls the mocked ListRepliesFunc
+func (m *MockCommentRepository) ListReplies(parentId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error) {
+	return m.ListRepliesFunc(parentId, viewerID, cursor, limit)
+}
+
+// Like calls the mocked LikeFunc
+func (m *MockCommentRepository) Like(commentId uuid.UUID, userId uuid.UUID) error {
+	return m.LikeFunc(commentId, userId)
+}
+
+// Unlike calls the mocked UnlikeFunc
+func (m *MockCommentRepository) Unlike(commentId uuid.UUID, userId uuid.UUID) error {
+	return m.UnlikeFunc(commentId, userId)
+}
+
+// GetStats calls the mocked GetStatsFunc
+func (m *MockCommentRepository) GetStats(viewerID uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID]models.CommentStats, error) {
+	return m.GetStatsFunc(viewerID, commentIds)
+}
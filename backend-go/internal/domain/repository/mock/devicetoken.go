@@ -1,11 +1,14 @@
 package mock
 
 import (
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
 type MockDeviceTokenRepository struct {
-	UpsertFunc func(userID string, deviceID string, token string, platform string) error
+	UpsertFunc    func(userID string, deviceID string, token string, platform string) error
+	GetByUserFunc func(userID uuid.UUID) ([]*ent.DeviceToken, error)
 }
 
 var _ repository.DeviceTokenRepository = (*MockDeviceTokenRepository)(nil)
@@ -14,3 +17,8 @@ var _ repository.DeviceTokenRepository = (*MockDeviceTokenRepository)(nil)
 func (m *MockDeviceTokenRepository) Upsert(userId string, deviceID string, token string, platform string) error {
 	return m.UpsertFunc(userId, deviceID, token, platform)
 }
+
+// GetByUser calls the mocked GetByUserFunc
+func (m *MockDeviceTokenRepository) GetByUser(userID uuid.UUID) ([]*ent.DeviceToken, error) {
+	return m.GetByUserFunc(userID)
+}
@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockExploreRepository is a mock implementation of the ExploreRepository interface
+type MockExploreRepository struct {
+	ListCandidatesFunc func(since time.Time) ([]models.ExploreCandidate, error)
+	ReplaceRankingFunc func(scores []models.ExploreScore) error
+	GetRankedFunc      func(viewerID uuid.UUID, afterPosition int, limit int) ([]*ent.ExploreRanking, error)
+}
+
+// Ensure MockExploreRepository implements the ExploreRepository interface
+var _ repository.ExploreRepository = (*MockExploreRepository)(nil)
+
+func (m *MockExploreRepository) ListCandidates(since time.Time) ([]models.ExploreCandidate, error) {
+	return m.ListCandidatesFunc(since)
+}
+
+func (m *MockExploreRepository) ReplaceRanking(scores []models.ExploreScore) error {
+	return m.ReplaceRankingFunc(scores)
+}
+
+func (m *MockExploreRepository) GetRanked(viewerID uuid.UUID, afterPosition int, limit int) ([]*ent.ExploreRanking, error) {
+	return m.GetRankedFunc(viewerID, afterPosition, limit)
+}
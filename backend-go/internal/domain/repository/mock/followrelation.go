@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type MockFollowRelationRepository struct {
+	FollowFunc         func(toId string, fromId string) error
+	UnfollowFunc       func(toId string, fromId string) error
+	IsFollowingFunc    func(fromID, toID uuid.UUID) (bool, error)
+	FollowersFunc      func(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error)
+	FollowingFunc      func(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error)
+	FollowedIDsFunc    func(fromID uuid.UUID, toIDs []uuid.UUID) ([]uuid.UUID, error)
+	CountFollowersFunc func(userID uuid.UUID) (int, error)
+	CountFollowingFunc func(userID uuid.UUID) (int, error)
+}
+
+var _ repository.FollowRelationRepository = (*MockFollowRelationRepository)(nil)
+
+func (m *MockFollowRelationRepository) Follow(toId string, fromId string) error {
+	return m.FollowFunc(toId, fromId)
+}
+
+func (m *MockFollowRelationRepository) Unfollow(toId string, fromId string) error {
+	return m.UnfollowFunc(toId, fromId)
+}
+
+func (m *MockFollowRelationRepository) IsFollowing(fromID, toID uuid.UUID) (bool, error) {
+	return m.IsFollowingFunc(fromID, toID)
+}
+
+func (m *MockFollowRelationRepository) Followers(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
+	return m.FollowersFunc(userID, viewerID, cursor, limit)
+}
+
+func (m *MockFollowRelationRepository) Following(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
+	return m.FollowingFunc(userID, viewerID, cursor, limit)
+}
+
+func (m *MockFollowRelationRepository) FollowedIDs(fromID uuid.UUID, toIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return m.FollowedIDsFunc(fromID, toIDs)
+}
+
+func (m *MockFollowRelationRepository) CountFollowers(userID uuid.UUID) (int, error) {
+	return m.CountFollowersFunc(userID)
+}
+
+func (m *MockFollowRelationRepository) CountFollowing(userID uuid.UUID) (int, error) {
+	return m.CountFollowingFunc(userID)
+}
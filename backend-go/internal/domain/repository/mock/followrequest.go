@@ -0,0 +1,57 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type MockFollowRequestRepository struct {
+	CreateFunc        func(fromID, toID uuid.UUID) error
+	GetByIdFunc       func(id uuid.UUID) (*ent.FollowRequest, error)
+	ExistsFunc        func(fromID, toID uuid.UUID) (bool, error)
+	ApproveFunc       func(id uuid.UUID) error
+	ApproveAllFunc    func(toID uuid.UUID) (int, error)
+	DeleteFunc        func(id uuid.UUID) error
+	DeleteBetweenFunc func(userID, otherID uuid.UUID) error
+	ListIncomingFunc  func(toID uuid.UUID) ([]*ent.FollowRequest, error)
+	ListOutgoingFunc  func(fromID uuid.UUID) ([]*ent.FollowRequest, error)
+}
+
+var _ repository.FollowRequestRepository = (*MockFollowRequestRepository)(nil)
+
+func (m *MockFollowRequestRepository) Create(fromID, toID uuid.UUID) error {
+	return m.CreateFunc(fromID, toID)
+}
+
+func (m *MockFollowRequestRepository) GetById(id uuid.UUID) (*ent.FollowRequest, error) {
+	return m.GetByIdFunc(id)
+}
+
+func (m *MockFollowRequestRepository) Exists(fromID, toID uuid.UUID) (bool, error) {
+	return m.ExistsFunc(fromID, toID)
+}
+
+func (m *MockFollowRequestRepository) Approve(id uuid.UUID) error {
+	return m.ApproveFunc(id)
+}
+
+func (m *MockFollowRequestRepository) ApproveAll(toID uuid.UUID) (int, error) {
+	return m.ApproveAllFunc(toID)
+}
+
+func (m *MockFollowRequestRepository) Delete(id uuid.UUID) error {
+	return m.DeleteFunc(id)
+}
+
+func (m *MockFollowRequestRepository) DeleteBetween(userID, otherID uuid.UUID) error {
+	return m.DeleteBetweenFunc(userID, otherID)
+}
+
+func (m *MockFollowRequestRepository) ListIncoming(toID uuid.UUID) ([]*ent.FollowRequest, error) {
+	return m.ListIncomingFunc(toID)
+}
+
+func (m *MockFollowRequestRepository) ListOutgoing(fromID uuid.UUID) ([]*ent.FollowRequest, error) {
+	return m.ListOutgoingFunc(fromID)
+}
@@ -1,22 +1,27 @@
 package mock
 
 import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
 // MockLikeRepository is a mock implementation of the LikeRepository interface
 type MockLikeRepository struct {
-	CreateFunc func(userId string, postId string) error
-	DeleteFunc func(userId string, postId string) error
-	CountFunc  func(postId string) (int, error)
+	CreateFunc     func(userId string, postId string, reaction enum.ReactionType) error
+	DeleteFunc     func(userId string, postId string) error
+	CountFunc      func(postId string) (int, error)
+	ListByPostFunc func(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Like, error)
 }
 
 // Ensure MockLikeRepository implements LikeRepository interface
 var _ repository.LikeRepository = (*MockLikeRepository)(nil)
 
 // Create calls the mocked CreateFunc
-func (m *MockLikeRepository) Create(userId string, postId string) error {
-	return m.CreateFunc(userId, postId)
+func (m *MockLikeRepository) Create(userId string, postId string, reaction enum.ReactionType) error {
+	return m.CreateFunc(userId, postId, reaction)
 }
 
 // Delete calls the mocked DeleteFunc
@@ -28,3 +33,8 @@ func (m *MockLikeRepository) Delete(userId string, postId string) error {
 func (m *MockLikeRepository) Count(postId string) (int, error) {
 	return m.CountFunc(postId)
 }
+
+// ListByPost calls the mocked ListByPostFunc
+func (m *MockLikeRepository) ListByPost(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Like, error) {
+	return m.ListByPostFunc(postId, viewerID, cursor, limit)
+}
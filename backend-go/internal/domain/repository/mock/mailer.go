@@ -0,0 +1,19 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/aws/aws-sdk-go/service/ses"
+)
+
+// MockMailerRepository is a mock implementation of the MailerRepository interface
+type MockMailerRepository struct {
+	SendFunc func(email *ses.SendEmailInput) (*ses.SendEmailOutput, error)
+}
+
+// Ensure MockMailerRepository implements MailerRepository interface
+var _ repository.MailerRepository = (*MockMailerRepository)(nil)
+
+// Send calls the mocked SendFunc
+func (m *MockMailerRepository) Send(email *ses.SendEmailInput) (*ses.SendEmailOutput, error) {
+	return m.SendFunc(email)
+}
@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type MockMutedKeywordRepository struct {
+	CreateFunc      func(userID uuid.UUID, keyword string) (*ent.MutedKeyword, error)
+	DeleteFunc      func(userID uuid.UUID, id uuid.UUID) error
+	ListByUserFunc  func(userID uuid.UUID) ([]*ent.MutedKeyword, error)
+	CountByUserFunc func(userID uuid.UUID) (int, error)
+}
+
+var _ repository.MutedKeywordRepository = (*MockMutedKeywordRepository)(nil)
+
+func (m *MockMutedKeywordRepository) Create(userID uuid.UUID, keyword string) (*ent.MutedKeyword, error) {
+	return m.CreateFunc(userID, keyword)
+}
+
+func (m *MockMutedKeywordRepository) Delete(userID uuid.UUID, id uuid.UUID) error {
+	return m.DeleteFunc(userID, id)
+}
+
+func (m *MockMutedKeywordRepository) ListByUser(userID uuid.UUID) ([]*ent.MutedKeyword, error) {
+	return m.ListByUserFunc(userID)
+}
+
+func (m *MockMutedKeywordRepository) CountByUser(userID uuid.UUID) (int, error) {
+	return m.CountByUserFunc(userID)
+}
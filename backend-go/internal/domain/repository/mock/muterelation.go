@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type MockMuteRelationRepository struct {
+	CreateFunc       func(fromID, toID uuid.UUID) error
+	DeleteFunc       func(fromID, toID uuid.UUID) error
+	MutedUsersFunc   func(fromID uuid.UUID) ([]*ent.User, error)
+	MutedUserIDsFunc func(fromID uuid.UUID) ([]uuid.UUID, error)
+}
+
+var _ repository.MuteRelationRepository = (*MockMuteRelationRepository)(nil)
+
+func (m *MockMuteRelationRepository) Create(fromID, toID uuid.UUID) error {
+	return m.CreateFunc(fromID, toID)
+}
+
+func (m *MockMuteRelationRepository) Delete(fromID, toID uuid.UUID) error {
+	return m.DeleteFunc(fromID, toID)
+}
+
+func (m *MockMuteRelationRepository) MutedUsers(fromID uuid.UUID) ([]*ent.User, error) {
+	return m.MutedUsersFunc(fromID)
+}
+
+func (m *MockMuteRelationRepository) MutedUserIDs(fromID uuid.UUID) ([]uuid.UUID, error) {
+	return m.MutedUserIDsFunc(fromID)
+}
@@ -0,0 +1,51 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockNotificationRepository is a mock implementation of the NotificationRepository interface
+type MockNotificationRepository struct {
+	CreateMentionsFunc        func(actorID uuid.UUID, recipientIDs []uuid.UUID, postID uuid.UUID, commentID *uuid.UUID) error
+	DeletePostMentionsFunc    func(postID uuid.UUID, recipientIDs []uuid.UUID) error
+	DeleteCommentMentionsFunc func(commentID uuid.UUID, recipientIDs []uuid.UUID) error
+	ListByRecipientFunc       func(recipientID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Notification, error)
+	CountUnreadFunc           func(recipientID uuid.UUID) (int, error)
+	MarkAllReadFunc           func(recipientID uuid.UUID) error
+}
+
+// Ensure MockNotificationRepository implements NotificationRepository interface
+var _ repository.NotificationRepository = (*MockNotificationRepository)(nil)
+
+// CreateMentions calls the mocked CreateMentionsFunc
+func (m *MockNotificationRepository) CreateMentions(actorID uuid.UUID, recipientIDs []uuid.UUID, postID uuid.UUID, commentID *uuid.UUID) error {
+	return m.CreateMentionsFunc(actorID, recipientIDs, postID, commentID)
+}
+
+// DeletePostMentions calls the mocked DeletePostMentionsFunc
+func (m *MockNotificationRepository) DeletePostMentions(postID uuid.UUID, recipientIDs []uuid.UUID) error {
+	return m.DeletePostMentionsFunc(postID, recipientIDs)
+}
+
+// DeleteCommentMentions calls the mocked DeleteCommentMentionsFunc
+func (m *MockNotificationRepository) DeleteCommentMentions(commentID uuid.UUID, recipientIDs []uuid.UUID) error {
+	return m.DeleteCommentMentionsFunc(commentID, recipientIDs)
+}
+
+// ListByRecipient calls the mocked ListByRecipientFunc
+func (m *MockNotificationRepository) ListByRecipient(recipientID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Notification, error) {
+	return m.ListByRecipientFunc(recipientID, cursor, limit)
+}
+
+// CountUnread calls the mocked CountUnreadFunc
+func (m *MockNotificationRepository) CountUnread(recipientID uuid.UUID) (int, error) {
+	return m.CountUnreadFunc(recipientID)
+}
+
+// MarkAllRead calls the mocked MarkAllReadFunc
+func (m *MockNotificationRepository) MarkAllRead(recipientID uuid.UUID) error {
+	return m.MarkAllReadFunc(recipientID)
+}
@@ -3,14 +3,18 @@ package mock
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
 // MockPetRepository is a mock implementation of the PetRepository interface
 type MockPetRepository struct {
-	GetByOwnerFunc func(ownerID string) ([]*ent.Pet, error)
-	CreateFunc     func(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error)
-	UpdateFunc     func(petID, name, petType, species, birthDay string) error
-	DeleteFunc     func(petID string) error
+	GetByOwnerFunc     func(ownerID string) ([]*ent.Pet, error)
+	GetByIdFunc        func(petID string) (*ent.Pet, error)
+	GetByIdsFunc       func(petIDs []uuid.UUID) ([]*ent.Pet, error)
+	GetVisibleByIdFunc func(viewerID uuid.UUID, petID uuid.UUID) (*ent.Pet, error)
+	CreateFunc         func(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error)
+	UpdateFunc         func(petID, name, petType, species, birthDay string) error
+	DeleteFunc         func(petID string) error
 }
 
 // Ensure MockPetRepository implements PetRepository interface
@@ -21,6 +25,21 @@ func (m *MockPetRepository) GetByOwner(ownerID string) ([]*ent.Pet, error) {
 	return m.GetByOwnerFunc(ownerID)
 }
 
+// GetById calls the mocked GetByIdFunc
+func (m *MockPetRepository) GetById(petID string) (*ent.Pet, error) {
+	return m.GetByIdFunc(petID)
+}
+
+// GetByIds calls the mocked GetByIdsFunc
+func (m *MockPetRepository) GetByIds(petIDs []uuid.UUID) ([]*ent.Pet, error) {
+	return m.GetByIdsFunc(petIDs)
+}
+
+// GetVisibleById calls the mocked GetVisibleByIdFunc
+func (m *MockPetRepository) GetVisibleById(viewerID uuid.UUID, petID uuid.UUID) (*ent.Pet, error) {
+	return m.GetVisibleByIdFunc(viewerID, petID)
+}
+
 // Create calls the mocked CreateFunc
 func (m *MockPetRepository) Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error) {
 	return m.CreateFunc(name, petType, species, birthDay, fileKey, userID)
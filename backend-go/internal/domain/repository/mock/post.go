@@ -1,52 +1,95 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
 )
 
 // MockPostRepository is a mock implementation of the PostRepository interface
 type MockPostRepository struct {
-	GetAllPostsFunc     func() ([]*ent.Post, error)
-	GetFollowsPostsFunc func(userID uuid.UUID, cursor *uuid.UUID, limit int) ([]*ent.Post, error)
-	GetPostsByUserFunc  func(userId uuid.UUID) ([]*ent.Post, error)
-	GetLikedPostsFunc   func(userId uuid.UUID) ([]*ent.Post, error)
-	CreatePostFunc      func(caption string, userId string, fileKey string, dailyTaskId *string) (*ent.Post, error)
-	UpdatePostFunc      func(postId, caption string) error
+	GetAllPostsFunc     func(viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetFollowsPostsFunc func(userID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetPostsByUserFunc  func(userId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetPostsByPetFunc   func(petID uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	CountPostsByPetFunc func(petID uuid.UUID, viewerID uuid.UUID) (int, error)
+	GetPostsByTagFunc   func(name string, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	CountPostsByTagFunc func(name string, viewerID uuid.UUID) (int, error)
+	GetLikedPostsFunc   func(userId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetPopularPostsFunc func(viewerID uuid.UUID, since time.Time, limit int) ([]*ent.Post, error)
+	CreatePostFunc      func(caption string, userId string, media []models.PostMediaInput, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error)
+	UpdatePostFunc      func(postId uuid.UUID, caption string, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID) (*ent.Post, error)
+	ListRevisionsFunc   func(postId uuid.UUID) ([]*ent.PostRevision, error)
 	DeletePostFunc      func(postId string) error
 	GetByIdFunc         func(postId uuid.UUID) (*ent.Post, error)
-	GetByIdsFunc        func(postIds []uuid.UUID) ([]*ent.Post, error)
+	GetByIdsFunc        func(viewerID uuid.UUID, postIds []uuid.UUID) ([]*ent.Post, error)
+	GetVisibleByIdFunc  func(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error)
+	GetStatsFunc        func(viewerID uuid.UUID, postIds []uuid.UUID, previewLimit int) (map[uuid.UUID]models.PostStats, error)
+
+	GetPostsByUserIncludingDeletedFunc func(userId uuid.UUID) ([]*ent.Post, error)
+	ListDeletedBeforeFunc              func(before time.Time, limit int) ([]*ent.Post, error)
+	HardDeletePostFunc                 func(postId uuid.UUID) error
+	SetHiddenFunc                      func(postId uuid.UUID, hidden bool) error
+	MigrateLegacyMediaFunc             func() (int, error)
+	ListCaptionsFunc                   func(afterID uuid.UUID, limit int) ([]*ent.Post, error)
+	SetTagsFunc                        func(postID uuid.UUID, tags []string, taggedAt time.Time) error
 }
 
 // Ensure MockPostRepository implements the PostRepository interface
 var _ repository.PostRepository = (*MockPostRepository)(nil)
 
-func (m *MockPostRepository) GetAllPosts() ([]*ent.Post, error) {
-	return m.GetAllPostsFunc()
+func (m *MockPostRepository) GetAllPosts(viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	return m.GetAllPostsFunc(viewerID, cursor, limit)
 }
 
-func (m *MockPostRepository) GetFollowsPosts(userId uuid.UUID, cursor *uuid.UUID, limit int) ([]*ent.Post, error) {
+func (m *MockPostRepository) GetFollowsPosts(userId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
 	return m.GetFollowsPostsFunc(userId, cursor, limit)
 }
 
-func (m *MockPostRepository) GetPostsByUser(userId uuid.UUID) ([]*ent.Post, error) {
-	return m.GetPostsByUserFunc(userId)
+func (m *MockPostRepository) GetPostsByUser(userId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	return m.GetPostsByUserFunc(userId, viewerID, cursor, limit)
+}
+
+func (m *MockPostRepository) GetPostsByPet(petID uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	return m.GetPostsByPetFunc(petID, viewerID, cursor, limit)
+}
+
+func (m *MockPostRepository) CountPostsByPet(petID uuid.UUID, viewerID uuid.UUID) (int, error) {
+	return m.CountPostsByPetFunc(petID, viewerID)
+}
+
+func (m *MockPostRepository) GetPostsByTag(name string, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	return m.GetPostsByTagFunc(name, viewerID, cursor, limit)
 }
 
-func (m *MockPostRepository) GetLikedPosts(userId uuid.UUID) ([]*ent.Post, error) {
+func (m *MockPostRepository) CountPostsByTag(name string, viewerID uuid.UUID) (int, error) {
+	return m.CountPostsByTagFunc(name, viewerID)
+}
+
+func (m *MockPostRepository) GetLikedPosts(userId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
 	if m.GetLikedPostsFunc != nil {
-		return m.GetLikedPostsFunc(userId)
+		return m.GetLikedPostsFunc(userId, cursor, limit)
 	}
 	return nil, nil
 }
 
-func (m *MockPostRepository) CreatePost(caption string, userId string, fileKey string, dailyTaskId *string) (*ent.Post, error) {
-	return m.CreatePostFunc(caption, userId, fileKey, dailyTaskId)
+func (m *MockPostRepository) GetPopularPosts(viewerID uuid.UUID, since time.Time, limit int) ([]*ent.Post, error) {
+	return m.GetPopularPostsFunc(viewerID, since, limit)
+}
+
+func (m *MockPostRepository) CreatePost(caption string, userId string, media []models.PostMediaInput, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error) {
+	return m.CreatePostFunc(caption, userId, media, petIds, tags, mentionIds, dailyTaskId)
 }
 
-func (m *MockPostRepository) UpdatePost(postId, caption string) error {
-	return m.UpdatePostFunc(postId, caption)
+func (m *MockPostRepository) UpdatePost(postId uuid.UUID, caption string, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID) (*ent.Post, error) {
+	return m.UpdatePostFunc(postId, caption, petIds, tags, mentionIds)
+}
+
+func (m *MockPostRepository) ListRevisions(postId uuid.UUID) ([]*ent.PostRevision, error) {
+	return m.ListRevisionsFunc(postId)
 }
 
 func (m *MockPostRepository) DeletePost(postId string) error {
@@ -57,6 +100,42 @@ func (m *MockPostRepository) GetById(postId uuid.UUID) (*ent.Post, error) {
 	return m.GetByIdFunc(postId)
 }
 
-func (m *MockPostRepository) GetByIds(postIds []uuid.UUID) ([]*ent.Post, error) {
-	return m.GetByIdsFunc(postIds)
+func (m *MockPostRepository) GetByIds(viewerID uuid.UUID, postIds []uuid.UUID) ([]*ent.Post, error) {
+	return m.GetByIdsFunc(viewerID, postIds)
+}
+
+func (m *MockPostRepository) GetVisibleById(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+	return m.GetVisibleByIdFunc(viewerID, postId)
+}
+
+func (m *MockPostRepository) GetStats(viewerID uuid.UUID, postIds []uuid.UUID, previewLimit int) (map[uuid.UUID]models.PostStats, error) {
+	return m.GetStatsFunc(viewerID, postIds, previewLimit)
+}
+
+func (m *MockPostRepository) GetPostsByUserIncludingDeleted(userId uuid.UUID) ([]*ent.Post, error) {
+	return m.GetPostsByUserIncludingDeletedFunc(userId)
+}
+
+func (m *MockPostRepository) ListDeletedBefore(before time.Time, limit int) ([]*ent.Post, error) {
+	return m.ListDeletedBeforeFunc(before, limit)
+}
+
+func (m *MockPostRepository) HardDeletePost(postId uuid.UUID) error {
+	return m.HardDeletePostFunc(postId)
+}
+
+func (m *MockPostRepository) SetHidden(postId uuid.UUID, hidden bool) error {
+	return m.SetHiddenFunc(postId, hidden)
+}
+
+func (m *MockPostRepository) MigrateLegacyMedia() (int, error) {
+	return m.MigrateLegacyMediaFunc()
+}
+
+func (m *MockPostRepository) ListCaptions(afterID uuid.UUID, limit int) ([]*ent.Post, error) {
+	return m.ListCaptionsFunc(afterID, limit)
+}
+
+func (m *MockPostRepository) SetTags(postID uuid.UUID, tags []string, taggedAt time.Time) error {
+	return m.SetTagsFunc(postID, tags, taggedAt)
 }
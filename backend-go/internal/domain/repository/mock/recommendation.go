@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockRecommendationRepository is a mock implementation of the RecommendationRepository interface
+type MockRecommendationRepository struct {
+	TimelineFunc func(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Ensure MockRecommendationRepository implements the RecommendationRepository interface
+var _ repository.RecommendationRepository = (*MockRecommendationRepository)(nil)
+
+func (m *MockRecommendationRepository) Timeline(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return m.TimelineFunc(ctx, userID)
+}
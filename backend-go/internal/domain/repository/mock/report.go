@@ -0,0 +1,53 @@
+package mock
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockReportRepository is a mock implementation of the ReportRepository interface
+type MockReportRepository struct {
+	CreateFunc   func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error)
+	FindOpenFunc func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error)
+	GetByIdFunc  func(id uuid.UUID) (*ent.Report, error)
+	ListFunc     func(filter models.ReportFilter, limit, offset int) ([]*ent.Report, error)
+	ResolveFunc  func(id uuid.UUID, resolverID uuid.UUID, status enum.ReportStatus, note string) (*ent.Report, error)
+
+	CountOpenReportersFunc func(targetType enum.ReportTargetType, targetID uuid.UUID) (int, error)
+}
+
+// Ensure MockReportRepository implements ReportRepository interface
+var _ repository.ReportRepository = (*MockReportRepository)(nil)
+
+// Create calls the mocked CreateFunc
+func (m *MockReportRepository) Create(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error) {
+	return m.CreateFunc(reporterID, targetType, targetID, reason, details)
+}
+
+// FindOpen calls the mocked FindOpenFunc
+func (m *MockReportRepository) FindOpen(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error) {
+	return m.FindOpenFunc(reporterID, targetType, targetID)
+}
+
+// GetById calls the mocked GetByIdFunc
+func (m *MockReportRepository) GetById(id uuid.UUID) (*ent.Report, error) {
+	return m.GetByIdFunc(id)
+}
+
+// List calls the mocked ListFunc
+func (m *MockReportRepository) List(filter models.ReportFilter, limit, offset int) ([]*ent.Report, error) {
+	return m.ListFunc(filter, limit, offset)
+}
+
+// Resolve calls the mocked ResolveFunc
+func (m *MockReportRepository) Resolve(id uuid.UUID, resolverID uuid.UUID, status enum.ReportStatus, note string) (*ent.Report, error) {
+	return m.ResolveFunc(id, resolverID, status, note)
+}
+
+// CountOpenReporters calls the mocked CountOpenReportersFunc
+func (m *MockReportRepository) CountOpenReporters(targetType enum.ReportTargetType, targetID uuid.UUID) (int, error) {
+	return m.CountOpenReportersFunc(targetType, targetID)
+}
@@ -9,6 +9,7 @@ import (
 // MockStorageRepository is a mock implementation of the StorageRepository interface
 type MockStorageRepository struct {
 	UploadImageFunc func(file *multipart.FileHeader, directory string) (string, error)
+	UploadVideoFunc func(file *multipart.FileHeader, directory string, contentType string) (string, error)
 	GetUrlFunc      func(fileKey string) (string, error)
 	DeleteImageFunc func(fileKey string) error
 }
@@ -21,6 +22,11 @@ func (m *MockStorageRepository) UploadImage(file *multipart.FileHeader, director
 	return m.UploadImageFunc(file, directory)
 }
 
+// UploadVideo calls the mocked UploadVideoFunc
+func (m *MockStorageRepository) UploadVideo(file *multipart.FileHeader, directory string, contentType string) (string, error) {
+	return m.UploadVideoFunc(file, directory, contentType)
+}
+
 // GetUrl calls the mocked GetUrlFunc
 func (m *MockStorageRepository) GetUrl(fileKey string) (string, error) {
 	return m.GetUrlFunc(fileKey)
@@ -0,0 +1,40 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockSuspensionRepository is a mock implementation of the SuspensionRepository interface
+type MockSuspensionRepository struct {
+	CreateFunc     func(userID uuid.UUID, issuedByID uuid.UUID, reason string, endsAt time.Time) (*ent.Suspension, error)
+	GetActiveFunc  func(userID uuid.UUID) (*ent.Suspension, error)
+	ListByUserFunc func(userID uuid.UUID) ([]*ent.Suspension, error)
+	LiftFunc       func(userID uuid.UUID) (int, error)
+}
+
+// Ensure MockSuspensionRepository implements SuspensionRepository interface
+var _ repository.SuspensionRepository = (*MockSuspensionRepository)(nil)
+
+// Create calls the mocked CreateFunc
+func (m *MockSuspensionRepository) Create(userID uuid.UUID, issuedByID uuid.UUID, reason string, endsAt time.Time) (*ent.Suspension, error) {
+	return m.CreateFunc(userID, issuedByID, reason, endsAt)
+}
+
+// GetActive calls the mocked GetActiveFunc
+func (m *MockSuspensionRepository) GetActive(userID uuid.UUID) (*ent.Suspension, error) {
+	return m.GetActiveFunc(userID)
+}
+
+// ListByUser calls the mocked ListByUserFunc
+func (m *MockSuspensionRepository) ListByUser(userID uuid.UUID) ([]*ent.Suspension, error) {
+	return m.ListByUserFunc(userID)
+}
+
+// Lift calls the mocked LiftFunc
+func (m *MockSuspensionRepository) Lift(userID uuid.UUID) (int, error) {
+	return m.LiftFunc(userID)
+}
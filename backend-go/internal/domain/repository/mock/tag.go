@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+)
+
+// MockTagRepository is a mock implementation of the TagRepository interface
+type MockTagRepository struct {
+	CountUsesFunc func(from, to time.Time) ([]models.TagUsage, error)
+}
+
+// Ensure MockTagRepository implements the TagRepository interface
+var _ repository.TagRepository = (*MockTagRepository)(nil)
+
+func (m *MockTagRepository) CountUses(from, to time.Time) ([]models.TagUsage, error) {
+	return m.CountUsesFunc(from, to)
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+// MockTimelineCacheRepository is a mock implementation of the TimelineCacheRepository interface
+type MockTimelineCacheRepository struct {
+	StoreFunc  func(userID uuid.UUID, postIDs []uuid.UUID, ttl time.Duration) error
+	GetFunc    func(userID uuid.UUID) ([]uuid.UUID, error)
+	DeleteFunc func(userID uuid.UUID) error
+}
+
+// Ensure MockTimelineCacheRepository implements the TimelineCacheRepository interface
+var _ repository.TimelineCacheRepository = (*MockTimelineCacheRepository)(nil)
+
+func (m *MockTimelineCacheRepository) Store(userID uuid.UUID, postIDs []uuid.UUID, ttl time.Duration) error {
+	return m.StoreFunc(userID, postIDs, ttl)
+}
+
+func (m *MockTimelineCacheRepository) Get(userID uuid.UUID) ([]uuid.UUID, error) {
+	return m.GetFunc(userID)
+}
+
+func (m *MockTimelineCacheRepository) Delete(userID uuid.UUID) error {
+	return m.DeleteFunc(userID)
+}
@@ -2,19 +2,32 @@ package mock
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
 )
 
 // MockUserRepository is a mock implementation of the UserRepository interface
 type MockUserRepository struct {
-	CreateFunc            func(name, email string) (*ent.User, error)
+	CreateFunc            func(name, email, handle string) (*ent.User, error)
 	ExistsEmailFunc       func(email string) (bool, error)
+	ExistsHandleFunc      func(handle string) (bool, error)
 	FindByEmailFunc       func(email string) (*ent.User, error)
+	FindByIdFunc          func(id uuid.UUID) (*ent.User, error)
+	FindByHandleFunc      func(handle string) (*ent.User, error)
+	GetMentionableFunc    func(authorID uuid.UUID, handles []string) ([]*ent.User, error)
+	GetByEmailFunc        func(email string) (*ent.User, error)
+	GetByAuthSubjectFunc  func(subject string) (*ent.User, error)
+	SetAuthSubjectFunc    func(id uuid.UUID, subject string) error
 	GetAllFunc            func() ([]*ent.User, error)
 	GetByIdFunc           func(id uuid.UUID) (*ent.User, error)
 	UpdateFunc            func(id uuid.UUID, name string, description string, iconImageKey string) error
 	UpdateStreakCountFunc func(id uuid.UUID, streak uint32) error
+	SearchFunc            func(query string, limit, offset int) ([]*ent.User, error)
+	UpdateRoleFunc        func(id uuid.UUID, role enum.Role) error
+	SetPrivateFunc        func(id uuid.UUID, private bool) error
+	UpdateHandleFunc      func(id uuid.UUID, handle string) error
+	GetWithoutHandleFunc  func() ([]*ent.User, error)
 	DeleteFunc            func(id uuid.UUID) error
 	FollowFunc            func(toId string, fromId string) error
 	UnfollowFunc          func(toId string, fromId string) error
@@ -24,8 +37,8 @@ type MockUserRepository struct {
 var _ repository.UserRepository = (*MockUserRepository)(nil)
 
 // Create calls the mocked CreateFunc
-func (m *MockUserRepository) Create(name, email string) (*ent.User, error) {
-	return m.CreateFunc(name, email)
+func (m *MockUserRepository) Create(name, email, handle string) (*ent.User, error) {
+	return m.CreateFunc(name, email, handle)
 }
 
 // ExistsEmail calls the mocked ExistsEmailFunc
@@ -38,6 +51,21 @@ func (m *MockUserRepository) FindByEmail(email string) (*ent.User, error) {
 	return m.FindByEmailFunc(email)
 }
 
+// GetByEmail calls the mocked GetByEmailFunc
+func (m *MockUserRepository) GetByEmail(email string) (*ent.User, error) {
+	return m.GetByEmailFunc(email)
+}
+
+// GetByAuthSubject calls the mocked GetByAuthSubjectFunc
+func (m *MockUserRepository) GetByAuthSubject(subject string) (*ent.User, error) {
+	return m.GetByAuthSubjectFunc(subject)
+}
+
+// SetAuthSubject calls the mocked SetAuthSubjectFunc
+func (m *MockUserRepository) SetAuthSubject(id uuid.UUID, subject string) error {
+	return m.SetAuthSubjectFunc(id, subject)
+}
+
 // GetAll calls the mocked GetAllFunc
 func (m *MockUserRepository) GetAll() ([]*ent.User, error) {
 	return m.GetAllFunc()
@@ -72,3 +100,48 @@ func (m *MockUserRepository) Follow(toId string, fromId string) error {
 func (m *MockUserRepository) Unfollow(toId string, fromId string) error {
 	return m.UnfollowFunc(toId, fromId)
 }
+
+// Search calls the mocked SearchFunc
+func (m *MockUserRepository) Search(query string, limit, offset int) ([]*ent.User, error) {
+	return m.SearchFunc(query, limit, offset)
+}
+
+// UpdateRole calls the mocked UpdateRoleFunc
+func (m *MockUserRepository) UpdateRole(id uuid.UUID, role enum.Role) error {
+	return m.UpdateRoleFunc(id, role)
+}
+
+// SetPrivate calls the mocked SetPrivateFunc
+func (m *MockUserRepository) SetPrivate(id uuid.UUID, private bool) error {
+	return m.SetPrivateFunc(id, private)
+}
+
+// ExistsHandle calls the mocked ExistsHandleFunc
+func (m *MockUserRepository) ExistsHandle(handle string) (bool, error) {
+	return m.ExistsHandleFunc(handle)
+}
+
+// FindById calls the mocked FindByIdFunc
+func (m *MockUserRepository) FindById(id uuid.UUID) (*ent.User, error) {
+	return m.FindByIdFunc(id)
+}
+
+// FindByHandle calls the mocked FindByHandleFunc
+func (m *MockUserRepository) FindByHandle(handle string) (*ent.User, error) {
+	return m.FindByHandleFunc(handle)
+}
+
+// GetMentionable calls the mocked GetMentionableFunc
+func (m *MockUserRepository) GetMentionable(authorID uuid.UUID, handles []string) ([]*ent.User, error) {
+	return m.GetMentionableFunc(authorID, handles)
+}
+
+// UpdateHandle calls the mocked UpdateHandleFunc
+func (m *MockUserRepository) UpdateHandle(id uuid.UUID, handle string) error {
+	return m.UpdateHandleFunc(id, handle)
+}
+
+// GetWithoutHandle calls the mocked GetWithoutHandleFunc
+func (m *MockUserRepository) GetWithoutHandle() ([]*ent.User, error) {
+	return m.GetWithoutHandleFunc()
+}
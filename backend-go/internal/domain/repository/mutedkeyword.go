@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+type MutedKeywordRepository interface {
+	Create(userID uuid.UUID, keyword string) (*ent.MutedKeyword, error)
+	Delete(userID uuid.UUID, id uuid.UUID) error
+	ListByUser(userID uuid.UUID) ([]*ent.MutedKeyword, error)
+	CountByUser(userID uuid.UUID) (int, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+type MuteRelationRepository interface {
+	Create(fromID, toID uuid.UUID) error
+	Delete(fromID, toID uuid.UUID) error
+	MutedUsers(fromID uuid.UUID) ([]*ent.User, error)
+	MutedUserIDs(fromID uuid.UUID) ([]uuid.UUID, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	// CreateMentions notifies the recipients that the actor mentioned them in the caption of the
+	// post, or in the comment when commentID is not nil.
+	CreateMentions(actorID uuid.UUID, recipientIDs []uuid.UUID, postID uuid.UUID, commentID *uuid.UUID) error
+	// DeletePostMentions removes the notifications of caption mentions of the recipients, after
+	// an edit removed them from the caption.
+	DeletePostMentions(postID uuid.UUID, recipientIDs []uuid.UUID) error
+	// DeleteCommentMentions is DeletePostMentions for an edited comment.
+	DeleteCommentMentions(commentID uuid.UUID, recipientIDs []uuid.UUID) error
+	// ListByRecipient returns the notifications of the user, newest first. Notifications from
+	// users they block or are blocked by, and about content they cannot see, are left out.
+	ListByRecipient(recipientID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Notification, error)
+	// CountUnread counts the notifications ListByRecipient returns that are not read yet.
+	CountUnread(recipientID uuid.UUID) (int, error)
+	MarkAllRead(recipientID uuid.UUID) error
+}
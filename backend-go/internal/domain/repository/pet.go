@@ -2,10 +2,17 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
 )
 
 type PetRepository interface {
 	GetByOwner(ownerID string) ([]*ent.Pet, error)
+	GetById(petID string) (*ent.Pet, error)
+	// GetByIds returns the pets with the IDs, with their owners. Unknown IDs are skipped.
+	GetByIds(petIDs []uuid.UUID) ([]*ent.Pet, error)
+	// GetVisibleById returns the pet with its owner if the viewer can see the owner's profile,
+	// and a not found error otherwise.
+	GetVisibleById(viewerID uuid.UUID, petID uuid.UUID) (*ent.Pet, error)
 	Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error)
 	Update(petID, name, petType, species, birthDay string) error
 	Delete(petID string) error
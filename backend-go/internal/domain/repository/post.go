@@ -1,17 +1,45 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
 type PostRepository interface {
-	GetAllPosts() ([]*ent.Post, error)
-	GetFollowsPosts(userID uuid.UUID, cursor *uuid.UUID, limit int) ([]*ent.Post, error)
-	GetPostsByUser(userId uuid.UUID) ([]*ent.Post, error)
-	CreatePost(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error)
-	UpdatePost(postId, caption string) error
+	GetAllPosts(viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetFollowsPosts(userID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetPostsByUser(userId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	// GetPostsByPet returns the posts the pet is tagged in that the viewer can see.
+	GetPostsByPet(petID uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	CountPostsByPet(petID uuid.UUID, viewerID uuid.UUID) (int, error)
+	// GetPostsByTag returns the posts with the hashtag that the viewer can see.
+	GetPostsByTag(name string, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	CountPostsByTag(name string, viewerID uuid.UUID) (int, error)
+	GetLikedPosts(userId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error)
+	GetPopularPosts(viewerID uuid.UUID, since time.Time, limit int) ([]*ent.Post, error)
+	CreatePost(caption, userId string, media []models.PostMediaInput, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error)
+	// UpdatePost changes the caption with its hashtags and mentioned users and the tagged pets,
+	// unless petIds is nil, and records the previous ones as a revision.
+	UpdatePost(postId uuid.UUID, caption string, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID) (*ent.Post, error)
+	ListRevisions(postId uuid.UUID) ([]*ent.PostRevision, error)
 	DeletePost(postId string) error
 	GetById(postId uuid.UUID) (*ent.Post, error)
-	GetByIds(postIds []uuid.UUID) ([]*ent.Post, error)
+	GetByIds(viewerID uuid.UUID, postIds []uuid.UUID) ([]*ent.Post, error)
+	// GetVisibleById returns the post if the viewer can see it, and a not found error otherwise.
+	GetVisibleById(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error)
+	// GetStats returns the stats of the posts as seen by the viewer, with up to previewLimit recent comments each.
+	GetStats(viewerID uuid.UUID, postIds []uuid.UUID, previewLimit int) (map[uuid.UUID]models.PostStats, error)
+	GetPostsByUserIncludingDeleted(userId uuid.UUID) ([]*ent.Post, error)
+	// ListDeletedBefore returns the oldest posts soft-deleted before the given time, with their media.
+	ListDeletedBefore(before time.Time, limit int) ([]*ent.Post, error)
+	HardDeletePost(postId uuid.UUID) error
+	SetHidden(postId uuid.UUID, hidden bool) error
+	// MigrateLegacyMedia turns posts created before carousels into one-item carousels.
+	MigrateLegacyMedia() (int, error)
+	// ListCaptions returns batches of posts with only their id, caption and creation time.
+	ListCaptions(afterID uuid.UUID, limit int) ([]*ent.Post, error)
+	SetTags(postID uuid.UUID, tags []string, taggedAt time.Time) error
 }
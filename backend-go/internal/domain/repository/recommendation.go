@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type RecommendationRepository interface {
+	// Timeline returns the IDs of the posts recommended to the user, best first. It gives up
+	// when ctx is done.
+	Timeline(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
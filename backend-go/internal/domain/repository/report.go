@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ReportRepository interface {
+	Create(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error)
+	FindOpen(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error)
+	GetById(id uuid.UUID) (*ent.Report, error)
+	List(filter models.ReportFilter, limit, offset int) ([]*ent.Report, error)
+	Resolve(id uuid.UUID, resolverID uuid.UUID, status enum.ReportStatus, note string) (*ent.Report, error)
+	CountOpenReporters(targetType enum.ReportTargetType, targetID uuid.UUID) (int, error)
+}
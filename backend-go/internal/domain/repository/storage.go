@@ -4,6 +4,8 @@ import "mime/multipart"
 
 type StorageRepository interface {
 	UploadImage(file *multipart.FileHeader, directory string) (string, error)
+	// UploadVideo stores the video with the content type read from its headers, not the one sent by the client.
+	UploadVideo(file *multipart.FileHeader, directory string, contentType string) (string, error)
 	GetUrl(fileKey string) (string, error)
 	DeleteImage(fileKey string) error
 }
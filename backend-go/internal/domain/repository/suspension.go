@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/google/uuid"
+)
+
+type SuspensionRepository interface {
+	Create(userID uuid.UUID, issuedByID uuid.UUID, reason string, endsAt time.Time) (*ent.Suspension, error)
+	GetActive(userID uuid.UUID) (*ent.Suspension, error)
+	ListByUser(userID uuid.UUID) ([]*ent.Suspension, error)
+	Lift(userID uuid.UUID) (int, error)
+}
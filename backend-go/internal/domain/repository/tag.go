@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+type TagRepository interface {
+	// CountUses counts, for each hashtag, the visible posts of public users it was added to in [from, to).
+	CountUses(from, to time.Time) ([]models.TagUsage, error)
+}
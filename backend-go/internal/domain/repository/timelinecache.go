@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TimelineCacheRepository interface {
+	// Store replaces the cached timeline of the user. It expires after ttl.
+	Store(userID uuid.UUID, postIDs []uuid.UUID, ttl time.Duration) error
+	// Get returns the cached timeline of the user, or nil when there is none or it has expired.
+	Get(userID uuid.UUID) ([]uuid.UUID, error)
+	Delete(userID uuid.UUID) error
+}
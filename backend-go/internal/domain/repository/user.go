@@ -2,16 +2,31 @@ package repository
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/google/uuid"
 )
 
 type UserRepository interface {
-	Create(name, email string) (*ent.User, error)
+	Create(name, email, handle string) (*ent.User, error)
 	ExistsEmail(email string) (bool, error)
+	ExistsHandle(handle string) (bool, error)
 	FindByEmail(email string) (*ent.User, error)
+	FindById(id uuid.UUID) (*ent.User, error)
+	FindByHandle(handle string) (*ent.User, error)
+	// GetMentionable returns the users with the handles that the author can mention: users who are
+	// not suspended and neither block nor are blocked by the author.
+	GetMentionable(authorID uuid.UUID, handles []string) ([]*ent.User, error)
+	GetByEmail(email string) (*ent.User, error)
+	GetByAuthSubject(subject string) (*ent.User, error)
+	SetAuthSubject(id uuid.UUID, subject string) error
 	GetAll() ([]*ent.User, error)
 	GetById(id uuid.UUID) (*ent.User, error)
 	Update(id uuid.UUID, name string, description string, iconImageKey string) error
 	UpdateStreakCount(id uuid.UUID, streak uint32) error
+	Search(query string, limit, offset int) ([]*ent.User, error)
+	UpdateRole(id uuid.UUID, role enum.Role) error
+	SetPrivate(id uuid.UUID, private bool) error
+	UpdateHandle(id uuid.UUID, handle string) error
+	GetWithoutHandle() ([]*ent.User, error)
 	Delete(id uuid.UUID) error
 }
@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	defaultAdminListLimit = 50
+	maxAdminListLimit     = 200
+)
+
+type AdminHandler struct {
+	adminUsecase usecase.AdminUsecase
+}
+
+func NewAdminHandler(adminUsecase usecase.AdminUsecase) *AdminHandler {
+	return &AdminHandler{
+		adminUsecase: adminUsecase,
+	}
+}
+
+func (h *AdminHandler) ListUsers(c echo.Context) error {
+	limit := defaultAdminListLimit
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed <= 0 {
+			log.Errorf("Failed to parse limit: %s", limitStr)
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "limit が不正です",
+			})
+		}
+		limit = min(parsed, maxAdminListLimit)
+	}
+	offset := 0
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil || parsed < 0 {
+			log.Errorf("Failed to parse offset: %s", offsetStr)
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "offset が不正です",
+			})
+		}
+		offset = parsed
+	}
+
+	users, err := h.adminUsecase.SearchUsers(c.QueryParam("q"), limit, offset)
+	if err != nil {
+		log.Errorf("Failed to search users: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ユーザーの取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"users": users,
+	})
+}
+
+func (h *AdminHandler) GetUserPosts(c echo.Context) error {
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	posts, err := h.adminUsecase.GetUserPosts(userId)
+	if err != nil {
+		log.Errorf("Failed to get user posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts": posts,
+	})
+}
+
+func (h *AdminHandler) DeletePost(c echo.Context) error {
+	postId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse post id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "投稿IDが不正です",
+		})
+	}
+
+	if err := h.adminUsecase.ForceDeletePost(postId); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "投稿が見つかりません",
+			})
+		}
+		log.Errorf("Failed to force delete post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の削除に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "投稿を削除しました",
+	})
+}
+
+func (h *AdminHandler) DeleteComment(c echo.Context) error {
+	commentId := c.Param("id")
+	if _, err := uuid.Parse(commentId); err != nil {
+		log.Errorf("Failed to parse comment id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "コメントIDが不正です",
+		})
+	}
+
+	if err := h.adminUsecase.ForceDeleteComment(commentId); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "コメントが見つかりません",
+			})
+		}
+		log.Errorf("Failed to force delete comment: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "コメントの削除に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "コメントを削除しました",
+	})
+}
+
+func (h *AdminHandler) ResetStreak(c echo.Context) error {
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	if err := h.adminUsecase.ResetStreak(userId); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]interface{}{
+				"error": "ユーザーが見つかりません",
+			})
+		}
+		log.Errorf("Failed to reset streak: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ストリークのリセットに失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "ストリークをリセットしました",
+	})
+}
+
+func (h *AdminHandler) GetDeviceTokens(c echo.Context) error {
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	tokens, err := h.adminUsecase.GetDeviceTokens(userId)
+	if err != nil {
+		log.Errorf("Failed to get device tokens: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "デバイストークンの取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"deviceTokens": tokens,
+	})
+}
+
+func (h *AdminHandler) UpdateRole(c echo.Context) error {
+	actor, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to update role: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	err = h.adminUsecase.UpdateRole(actor, userId, enum.Role(req.Role))
+	if errors.Is(err, usecase.ErrInvalidRole) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ロールが不正です",
+		})
+	}
+	if errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "自分自身のロールは変更できません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "ユーザーが見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to update role: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ロールの更新に失敗しました",
+		})
+	}
+
+	log.Infof("User %s changed role of %s to %s", actor.ID, userId, req.Role)
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "ロールを更新しました",
+	})
+}
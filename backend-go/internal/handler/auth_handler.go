@@ -3,8 +3,8 @@ package handler
 import (
 	"fmt"
 	"net/http"
-	"strings"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -50,7 +50,7 @@ func (h *AuthHandler) SignIn(c echo.Context) error {
 	}
 
 	// ユーザー情報の取得
-	user, err := h.userUsecase.GetByEmail(req.Email)
+	user, err := h.userUsecase.GetMe(req.Email)
 
 	// IconImageKey が空の場合は URL を生成せずにレスポンスを返す
 	if err != nil {
@@ -183,26 +183,16 @@ func (h *AuthHandler) SignUp(c echo.Context) error {
 }
 
 func (h *AuthHandler) GetMe(c echo.Context) error {
-	// リクエストヘッダーから Authorization トークンを取得
-	authHeader := c.Request().Header.Get("Authorization")
-	if authHeader == "" {
-		log.Error("Failed to get user email: token is empty")
+	// AuthMiddleware で解決済みのユーザーを取得
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get user: user not found in context")
 		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 			"error": "アクセストークンが必要です",
 		})
 	}
 
-	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-
-	email, err := h.authUsecase.GetUserEmail(tokenString)
-	if err != nil {
-		log.Errorf("Failed to get user email: %v", err)
-		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
-			"error": "無効なアクセストークンです",
-		})
-	}
-
-	userResponse, err := h.userUsecase.GetByEmail(email)
+	userResponse, err := h.userUsecase.GetMe(user.Email)
 	if err != nil {
 		log.Errorf("Failed to get user: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -258,7 +248,13 @@ func (h *AuthHandler) GetSession(c echo.Context) error {
 }
 
 func (h *AuthHandler) Delete(c echo.Context) error {
-	id := c.QueryParam("id")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to delete user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "トークンがありません",
+		})
+	}
 	// Authorization ヘッダーからIDトークンを取得
 	authHeader := c.Request().Header.Get("Authorization")
 	if authHeader == "" || len(authHeader) < 8 || authHeader[:7] != "Bearer " {
@@ -277,7 +273,7 @@ func (h *AuthHandler) Delete(c echo.Context) error {
 		})
 	}
 
-	err = h.userUsecase.Delete(id)
+	err = h.userUsecase.Delete(user.ID.String())
 	if err != nil {
 		log.Errorf("Failed to delete dbUser%v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -24,10 +28,10 @@ func NewCommentHandler(commentUsecase usecase.CommentUsecase, userUsecase usecas
 func (h *CommentHandler) Create(c echo.Context) error {
 	postId := c.FormValue("postId")
 	content := c.FormValue("content")
-	user, err := h.userUsecase.FindByEmail(c.Get("email").(string))
-	if err != nil {
-		log.Errorf("Failed to find current user: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to find current user in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
 			"error": "Failed to find current user",
 		})
 	}
@@ -38,7 +42,44 @@ func (h *CommentHandler) Create(c echo.Context) error {
 			"error": "Failed to parse postId",
 		})
 	}
-	comment, err := h.commentUsecase.Create(user.ID, parsedPostId, content)
+	// parentId があれば返信
+	var parentId *uuid.UUID
+	if value := c.FormValue("parentId"); value != "" {
+		parsedParentId, err := uuid.Parse(value)
+		if err != nil {
+			log.Errorf("Failed to parse parentId: %v", err)
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "返信先のコメントIDが不正です",
+			})
+		}
+		parentId = &parsedParentId
+	}
+	comment, err := h.commentUsecase.Create(user.ID, parsedPostId, parentId, content)
+	if errors.Is(err, usecase.ErrPostNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "投稿が見つかりません",
+		})
+	}
+	if errors.Is(err, usecase.ErrBlocked) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "ブロック関係にあるユーザーのコメントには返信できません",
+		})
+	}
+	if errors.Is(err, usecase.ErrInvalidComment) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "コメントを入力してください",
+		})
+	}
+	if errors.Is(err, usecase.ErrInvalidReply) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "同じ投稿のコメントにのみ返信できます",
+		})
+	}
+	if errors.Is(err, usecase.ErrCommentNotFound) || ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "返信先のコメントが見つかりません",
+		})
+	}
 	if err != nil {
 		log.Errorf("Failed to create comment: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -52,8 +93,26 @@ func (h *CommentHandler) Create(c echo.Context) error {
 }
 
 func (h *CommentHandler) Delete(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to find current user in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "Failed to find current user",
+		})
+	}
 	commentId := c.QueryParam("commentId")
-	err := h.commentUsecase.Delete(commentId)
+	err := h.commentUsecase.Delete(user.ID, commentId)
+	if errors.Is(err, usecase.ErrForbidden) {
+		log.Errorf("Failed to delete comment: user %s is neither the author nor the post owner", user.ID)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "You can only delete your own comments or comments on your posts",
+		})
+	}
+	if errors.Is(err, usecase.ErrCommentNotFound) || ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "コメントが見つかりません",
+		})
+	}
 	if err != nil {
 		log.Errorf("Failed to delete comment: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -64,3 +123,121 @@ func (h *CommentHandler) Delete(c echo.Context) error {
 		"message": "Comment deleted successfully",
 	})
 }
+
+// Update edits the content of the user's own comment.
+func (h *CommentHandler) Update(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse comment id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "コメントIDが不正です"})
+	}
+	var req struct {
+		Content string `json:"content"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Error("Failed to update comment: invalid request body")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+
+	comment, err := h.commentUsecase.Update(user.ID, commentID, req.Content)
+	if errors.Is(err, usecase.ErrInvalidComment) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "コメントを入力してください"})
+	}
+	if errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "自分のコメントのみ編集できます"})
+	}
+	if errors.Is(err, usecase.ErrCommentNotFound) || ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "コメントが見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to update comment: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "コメントの編集に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "Comment updated successfully",
+		"comment": comment,
+	})
+}
+
+// ListReplies returns one page of the replies to a comment, oldest first.
+func (h *CommentHandler) ListReplies(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse comment id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "コメントIDが不正です"})
+	}
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
+	}
+
+	replies, nextCursor, err := h.commentUsecase.ListReplies(user.ID, commentID, cursor, limit)
+	if errors.Is(err, usecase.ErrCommentNotFound) || errors.Is(err, usecase.ErrBlocked) || ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "コメントが見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to list replies: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "返信の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"replies":    replies,
+		"nextCursor": nextCursor,
+	})
+}
+
+// Like likes a comment. Liking it again does nothing.
+func (h *CommentHandler) Like(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse comment id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "コメントIDが不正です"})
+	}
+
+	err = h.commentUsecase.Like(user.ID, commentID)
+	if errors.Is(err, usecase.ErrBlocked) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "ブロック関係にあるユーザーのコメントにはいいねできません"})
+	}
+	if errors.Is(err, usecase.ErrCommentNotFound) || ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "コメントが見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to like comment: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "いいねに失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"message": "Comment liked successfully"})
+}
+
+func (h *CommentHandler) Unlike(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	commentID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse comment id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "コメントIDが不正です"})
+	}
+
+	if err := h.commentUsecase.Unlike(user.ID, commentID); err != nil {
+		log.Errorf("Failed to unlike comment: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "いいねの取り消しに失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"message": "Comment unliked successfully"})
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -19,19 +20,25 @@ func NewDeviceTokenHandler(deviceTokenUsecase usecase.DeviceTokenUsecase) *Devic
 }
 
 func (h *DeviceTokenHandler) Create(c echo.Context) error {
-	userID := c.QueryParam("userId")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to upsert device token: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "Unauthorized",
+		})
+	}
 	deviceID := c.QueryParam("deviceId")
 	token := c.QueryParam("token")
 	platform := c.QueryParam("platform")
 
-	if userID == "" || deviceID == "" || token == "" || platform == "" {
-		log.Errorf("Params not enough: %v")
+	if deviceID == "" || token == "" || platform == "" {
+		log.Errorf("Params not enough: deviceId=%q platform=%q", deviceID, platform)
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error": "Params not enough",
 		})
 	}
 
-	err := h.deviceTokenUsecase.Upsert(userID, deviceID, token, platform)
+	err := h.deviceTokenUsecase.Upsert(user.ID.String(), deviceID, token, platform)
 
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type FollowRequestHandler struct {
+	followRequestUsecase usecase.FollowRequestUsecase
+}
+
+func NewFollowRequestHandler(followRequestUsecase usecase.FollowRequestUsecase) *FollowRequestHandler {
+	return &FollowRequestHandler{
+		followRequestUsecase: followRequestUsecase,
+	}
+}
+
+func (h *FollowRequestHandler) ListIncoming(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get follow requests: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	requests, err := h.followRequestUsecase.ListIncoming(user.ID)
+	if err != nil {
+		log.Errorf("Failed to get incoming follow requests: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "フォローリクエストの取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"requests": requests,
+	})
+}
+
+func (h *FollowRequestHandler) ListOutgoing(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get follow requests: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	requests, err := h.followRequestUsecase.ListOutgoing(user.ID)
+	if err != nil {
+		log.Errorf("Failed to get outgoing follow requests: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "フォローリクエストの取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"requests": requests,
+	})
+}
+
+func (h *FollowRequestHandler) Approve(c echo.Context) error {
+	return h.handle(c, h.followRequestUsecase.Approve, "フォローリクエストを承認しました", "フォローリクエストの承認に失敗しました")
+}
+
+func (h *FollowRequestHandler) Reject(c echo.Context) error {
+	return h.handle(c, h.followRequestUsecase.Reject, "フォローリクエストを拒否しました", "フォローリクエストの拒否に失敗しました")
+}
+
+func (h *FollowRequestHandler) Cancel(c echo.Context) error {
+	return h.handle(c, h.followRequestUsecase.Cancel, "フォローリクエストを取り消しました", "フォローリクエストの取り消しに失敗しました")
+}
+
+// handle runs an action on the request in the path and maps its errors.
+func (h *FollowRequestHandler) handle(c echo.Context, action func(userId, requestId uuid.UUID) error, successMessage, failureMessage string) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to handle follow request: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	requestId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse follow request id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "フォローリクエストIDが不正です",
+		})
+	}
+
+	err = action(user.ID, requestId)
+	if errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "このフォローリクエストを操作する権限がありません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "フォローリクエストが見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to handle follow request %s: %v", requestId, err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": failureMessage,
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": successMessage,
+	})
+}
@@ -1,14 +1,19 @@
 package handler
 
-import "github.com/aki-13627/animalia/backend-go/internal/usecase"
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/gommon/log"
+)
 
 type LambdaHandler struct {
 	dailyTaskUsecase usecase.DailyTaskUsecase
+	exploreUsecase   usecase.ExploreUsecase
 }
 
-func NewLambdaHandler(dailyTaskUsecase usecase.DailyTaskUsecase) *LambdaHandler {
+func NewLambdaHandler(dailyTaskUsecase usecase.DailyTaskUsecase, exploreUsecase usecase.ExploreUsecase) *LambdaHandler {
 	return &LambdaHandler{
 		dailyTaskUsecase: dailyTaskUsecase,
+		exploreUsecase:   exploreUsecase,
 	}
 }
 
@@ -23,3 +28,13 @@ func (h *LambdaHandler) HandleEveryDay() error {
 	}
 	return nil
 }
+
+// HandleExploreRanking recomputes the explore feed. It runs every 15 minutes.
+func (h *LambdaHandler) HandleExploreRanking() error {
+	count, err := h.exploreUsecase.RefreshRanking()
+	if err != nil {
+		return err
+	}
+	log.Infof("Ranked %d posts for explore", count)
+	return nil
+}
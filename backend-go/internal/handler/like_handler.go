@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
@@ -19,15 +23,37 @@ func NewLikeHandler(likeUsecase usecase.LikeUsecase) *LikeHandler {
 }
 
 func (h *LikeHandler) Create(c echo.Context) error {
-	userId := c.QueryParam("userId")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to create like: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
 	postId := c.QueryParam("postId")
-	if userId == "" || postId == "" {
-		log.Error("userId or postId is missing")
+	if postId == "" {
+		log.Error("postId is missing")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "postId が指定されていません",
+		})
+	}
+	// reaction がなければ paw
+	reaction := enum.ReactionType(c.QueryParam("reaction"))
+	if reaction == "" {
+		reaction = enum.ReactionTypePaw
+	}
+	err := h.likeUsecase.Create(user.ID.String(), postId, reaction)
+	if errors.Is(err, usecase.ErrInvalidReaction) {
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "userId または postId が指定されていません",
+			"error": "reaction は paw, heart, laugh, wow のいずれかです",
+		})
+	}
+	// 見えない投稿 (削除・非表示・ブロック関係・フォローしていない非公開アカウント) は見つからない扱い
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "投稿が見つかりません",
 		})
 	}
-	err := h.likeUsecase.Create(userId, postId)
 	if err != nil {
 		log.Errorf("Failed to create like: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -38,9 +64,15 @@ func (h *LikeHandler) Create(c echo.Context) error {
 }
 
 func (h *LikeHandler) Delete(c echo.Context) error {
-	userId := c.QueryParam("userId")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to delete like: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
 	postId := c.QueryParam("postId")
-	err := h.likeUsecase.Delete(userId, postId)
+	err := h.likeUsecase.Delete(user.ID.String(), postId)
 	if err != nil {
 		log.Errorf("Failed to delete like: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type MuteHandler struct {
+	muteUsecase usecase.MuteUsecase
+}
+
+func NewMuteHandler(muteUsecase usecase.MuteUsecase) *MuteHandler {
+	return &MuteHandler{
+		muteUsecase: muteUsecase,
+	}
+}
+
+func (h *MuteHandler) ListUsers(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get muted users: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	users, err := h.muteUsecase.ListMutedUsers(user.ID)
+	if err != nil {
+		log.Errorf("Failed to get muted users: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ミュート中のユーザーの取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"users": users,
+	})
+}
+
+func (h *MuteHandler) MuteUser(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to mute user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	targetId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	err = h.muteUsecase.MuteUser(user.ID, targetId)
+	if errors.Is(err, usecase.ErrInvalidMute) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "自分自身をミュートすることはできません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "ユーザーが見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to mute user: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ミュートに失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "ユーザーをミュートしました",
+	})
+}
+
+func (h *MuteHandler) UnmuteUser(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to unmute user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	targetId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	if err := h.muteUsecase.UnmuteUser(user.ID, targetId); err != nil {
+		log.Errorf("Failed to unmute user: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ミュートの解除に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "ミュートを解除しました",
+	})
+}
+
+func (h *MuteHandler) ListKeywords(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get muted keywords: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	keywords, err := h.muteUsecase.ListKeywords(user.ID)
+	if err != nil {
+		log.Errorf("Failed to get muted keywords: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ミュートワードの取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"keywords": keywords,
+	})
+}
+
+func (h *MuteHandler) AddKeyword(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to add muted keyword: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	var req struct {
+		Keyword string `json:"keyword"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	keyword, err := h.muteUsecase.AddKeyword(user.ID, req.Keyword)
+	if errors.Is(err, usecase.ErrInvalidMute) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ミュートワードは1〜50文字で指定してください",
+		})
+	}
+	if errors.Is(err, usecase.ErrTooManyMutedKeywords) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ミュートワードの上限に達しています",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to add muted keyword: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ミュートワードの追加に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"keyword": keyword,
+	})
+}
+
+func (h *MuteHandler) RemoveKeyword(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to remove muted keyword: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	keywordId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse keyword id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ミュートワードIDが不正です",
+		})
+	}
+
+	err = h.muteUsecase.RemoveKeyword(user.ID, keywordId)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "ミュートワードが見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to remove muted keyword: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ミュートワードの削除に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "ミュートワードを削除しました",
+	})
+}
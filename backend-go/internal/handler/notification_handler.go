@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type NotificationHandler struct {
+	notificationUsecase usecase.NotificationUsecase
+}
+
+func NewNotificationHandler(notificationUsecase usecase.NotificationUsecase) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUsecase: notificationUsecase,
+	}
+}
+
+// List returns a page of the notifications of the current user, newest first.
+func (h *NotificationHandler) List(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to list notifications: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
+	}
+
+	notifications, nextCursor, err := h.notificationUsecase.List(user.ID, cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to list notifications: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "通知の取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"notifications": notifications,
+		"nextCursor":    nextCursor,
+	})
+}
+
+// CountUnread returns the number of unread notifications of the current user.
+func (h *NotificationHandler) CountUnread(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to count unread notifications: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	count, err := h.notificationUsecase.CountUnread(user.ID)
+	if err != nil {
+		log.Errorf("Failed to count unread notifications: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "未読件数の取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"count": count,
+	})
+}
+
+// MarkAllRead marks every notification of the current user as read.
+func (h *NotificationHandler) MarkAllRead(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to mark notifications as read: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	if err := h.notificationUsecase.MarkAllRead(user.ID); err != nil {
+		log.Errorf("Failed to mark notifications as read: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "通知の既読に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "通知を既読にしました",
+	})
+}
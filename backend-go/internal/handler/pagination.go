@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+var errInvalidLimit = errors.New("invalid limit")
+
+// parsePage reads the cursor and limit query parameters of a cursor-paginated list.
+// A missing cursor means the first page and a missing limit means defaultPageLimit.
+func parsePage(c echo.Context) (*models.Cursor, int, error) {
+	cursor, err := models.DecodeCursor(c.QueryParam("cursor"))
+	if err != nil {
+		return nil, 0, err
+	}
+	limit, err := parseLimit(c)
+	if err != nil {
+		return nil, 0, err
+	}
+	return cursor, limit, nil
+}
+
+// parseRankPage is parsePage for ranked lists, whose cursor is a position in the ranking.
+func parseRankPage(c echo.Context) (int, int, error) {
+	position, err := models.DecodeRankCursor(c.QueryParam("cursor"))
+	if err != nil {
+		return 0, 0, err
+	}
+	limit, err := parseLimit(c)
+	if err != nil {
+		return 0, 0, err
+	}
+	return position, limit, nil
+}
+
+func parseLimit(c echo.Context) (int, error) {
+	limitStr := c.QueryParam("limit")
+	if limitStr == "" {
+		return defaultPageLimit, nil
+	}
+	parsed, err := strconv.Atoi(limitStr)
+	if err != nil || parsed <= 0 {
+		return 0, errInvalidLimit
+	}
+	return min(parsed, maxPageLimit), nil
+}
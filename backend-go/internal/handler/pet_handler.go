@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 )
@@ -54,7 +58,79 @@ func (h *PetHandler) GetByOwner(c echo.Context) error {
 	})
 }
 
+// GetProfile returns the pet with its owner, the number of posts it is tagged in and the photos
+// of the latest of them.
+func (h *PetHandler) GetProfile(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get pet profile: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "Unauthorized",
+		})
+	}
+	petID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to get pet profile: invalid pet id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid pet ID",
+		})
+	}
+
+	profile, err := h.petUsecase.GetProfile(user.ID, petID)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "Pet not found",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to get pet profile: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to get pet profile",
+		})
+	}
+
+	imageURL, err := h.storageUsecase.GetUrl(profile.Pet.ImageKey)
+	if err != nil {
+		log.Errorf("Failed to get pet image URL: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "Failed to get pet image URL",
+		})
+	}
+	var ownerImageURL string
+	if owner := profile.Pet.Edges.Owner; owner.IconImageKey != "" {
+		ownerImageURL, err = h.storageUsecase.GetUrl(owner.IconImageKey)
+		if err != nil {
+			log.Errorf("Failed to get owner image URL: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+				"error": "Failed to get owner image URL",
+			})
+		}
+	}
+	// 一覧には各投稿の1枚目の画像 (動画はポスター画像) を出す
+	photoURLs := make([]string, len(profile.LatestPosts))
+	for i, post := range profile.LatestPosts {
+		photoURLs[i], err = h.storageUsecase.GetUrl(post.ImageKey)
+		if err != nil {
+			log.Errorf("Failed to get post image URL: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+				"error": "Failed to get post image URL",
+			})
+		}
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"pet": models.NewPetProfileResponse(profile, imageURL, ownerImageURL, photoURLs),
+	})
+}
+
 func (h *PetHandler) Create(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to create pet: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "Unauthorized",
+		})
+	}
 	form, err := c.MultipartForm()
 	if err != nil {
 		log.Errorf("Failed to create pet: invalid form data: %v", err)
@@ -68,7 +144,6 @@ func (h *PetHandler) Create(c echo.Context) error {
 	petType := form.Value["type"][0]
 	species := form.Value["species"][0]
 	birthDay := form.Value["birthDay"][0]
-	userID := form.Value["userId"][0]
 
 	// Get the image file
 	file, err := c.FormFile("image")
@@ -80,7 +155,7 @@ func (h *PetHandler) Create(c echo.Context) error {
 	}
 
 	// Validate form values
-	if name == "" || petType == "" || birthDay == "" || userID == "" {
+	if name == "" || petType == "" || birthDay == "" {
 		log.Error("Failed to create pet: missing required fields")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error": "Missing required fields",
@@ -96,7 +171,7 @@ func (h *PetHandler) Create(c echo.Context) error {
 		})
 	}
 
-	_, err = h.petUsecase.Create(name, petType, species, birthDay, fileKey, userID)
+	_, err = h.petUsecase.Create(name, petType, species, birthDay, fileKey, user.ID.String())
 	if err != nil {
 		log.Errorf("Failed to create pet: failed to create pet: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -110,6 +185,13 @@ func (h *PetHandler) Create(c echo.Context) error {
 }
 
 func (h *PetHandler) Update(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to update pet: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "Unauthorized",
+		})
+	}
 	petId := c.QueryParam("petId")
 	if petId == "" {
 		log.Error("Failed to update pet: petId is empty")
@@ -131,7 +213,14 @@ func (h *PetHandler) Update(c echo.Context) error {
 	species := form.Value["species"][0]
 	birthDay := form.Value["birthDay"][0]
 
-	if err := h.petUsecase.Update(petId, name, petType, species, birthDay); err != nil {
+	err = h.petUsecase.Update(user.ID, petId, name, petType, species, birthDay)
+	if errors.Is(err, usecase.ErrForbidden) {
+		log.Errorf("Failed to update pet: user %s is not the owner", user.ID)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "You can only update your own pets",
+		})
+	}
+	if err != nil {
 		log.Errorf("Failed to update pet: failed to update pet: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Failed to update pet",
@@ -144,6 +233,13 @@ func (h *PetHandler) Update(c echo.Context) error {
 }
 
 func (h *PetHandler) Delete(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to delete pet: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "Unauthorized",
+		})
+	}
 	petId := c.QueryParam("petId")
 	if petId == "" {
 		log.Error("Failed to delete pet: petId is empty")
@@ -152,7 +248,14 @@ func (h *PetHandler) Delete(c echo.Context) error {
 		})
 	}
 
-	if err := h.petUsecase.Delete(petId); err != nil {
+	err := h.petUsecase.Delete(user.ID, petId)
+	if errors.Is(err, usecase.ErrForbidden) {
+		log.Errorf("Failed to delete pet: user %s is not the owner", user.ID)
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "You can only delete your own pets",
+		})
+	}
+	if err != nil {
 		log.Errorf("Failed to delete pet: failed to delete pet: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "Failed to delete pet",
@@ -1,16 +1,14 @@
 package handler
 
 import (
-	"bytes"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
-	"os"
-	"strconv"
 	"strings"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
 	"github.com/google/uuid"
@@ -20,368 +18,434 @@ import (
 
 type PostHandler struct {
 	postUsecase      usecase.PostUsecase
+	timelineUsecase  usecase.TimelineUsecase
+	exploreUsecase   usecase.ExploreUsecase
 	storageUsecase   usecase.StorageUsecase
 	dailyTaskUsecase usecase.DailyTaskUsecase
-	cacheUsecase     usecase.CacheUsecase
 }
 type TimelineRequest struct {
-	UserID uuid.UUID `json:"user_id"`
-	Cursor *string   `json:"cursor,omitempty"`
-	Limit  int       `json:"limit"`
+	Cursor *string `json:"cursor,omitempty"`
+	Limit  int     `json:"limit"`
 }
 
-func NewPostHandler(postUsecase usecase.PostUsecase, storageUsecase usecase.StorageUsecase, dailytaskUsecase usecase.DailyTaskUsecase, cacheUsecase usecase.CacheUsecase) *PostHandler {
+func NewPostHandler(postUsecase usecase.PostUsecase, timelineUsecase usecase.TimelineUsecase, exploreUsecase usecase.ExploreUsecase, storageUsecase usecase.StorageUsecase, dailytaskUsecase usecase.DailyTaskUsecase) *PostHandler {
 	return &PostHandler{
 		postUsecase:      postUsecase,
+		timelineUsecase:  timelineUsecase,
+		exploreUsecase:   exploreUsecase,
 		storageUsecase:   storageUsecase,
 		dailyTaskUsecase: dailytaskUsecase,
-		cacheUsecase:     cacheUsecase,
 	}
 }
 
-type PostIdsResponse struct {
-	ID string `json:"id"`
-}
-
 func (h *PostHandler) GetRecommended(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error": "unauthorized",
+		})
+	}
+
 	var reqBody TimelineRequest
 	if err := c.Bind(&reqBody); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{
 			"error": "invalid request body",
 		})
 	}
-	// reqBody は TimelineRequest 構造体
-	if reqBody.Cursor != nil {
-		all := h.cacheUsecase.GetPostResponses(reqBody.UserID)
-		log.Infof("Retrieved %d posts from cache for user %s", len(all), reqBody.UserID)
-
-		var filtered []models.PostResponse
-		limit := reqBody.Limit
-
-		started := reqBody.Cursor == nil
-		for _, p := range all {
-			if !started {
-				if p.ID.String() == *reqBody.Cursor {
-					started = true
-				}
-				continue
-			}
-			if len(filtered) >= limit {
-				break
-			}
-			filtered = append(filtered, p)
-		}
-
-		return c.JSON(http.StatusOK, map[string]interface{}{
-			"posts": filtered,
-		})
+	limit := reqBody.Limit
+	if limit <= 0 {
+		limit = defaultPageLimit
 	}
+	limit = min(limit, maxPageLimit)
 
-	// FastAPI に送る body は user_id のみ
-	fastapiReqBody := struct {
-		UserID string `json:"user_id"`
-	}{
-		UserID: reqBody.UserID.String(),
+	// カーソルがあればキャッシュ済みのタイムラインの続きを返す
+	if reqBody.Cursor != nil {
+		cursor, err := uuid.Parse(*reqBody.Cursor)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "invalid cursor",
+			})
+		}
+		posts, nextCursor, err := h.timelineUsecase.GetTimelinePage(user.ID, cursor, limit)
+		if err != nil {
+			log.Errorf("Failed to get timeline page: %v", err)
+			return c.JSON(http.StatusInternalServerError, map[string]string{
+				"error": "failed to get posts",
+			})
+		}
+		return h.timelineResponse(c, user.ID, posts, models.TimelineSourceCache, nextCursor)
 	}
 
-	jsonBodyForFastAPI, err := json.Marshal(fastapiReqBody)
+	posts, source, nextCursor, err := h.timelineUsecase.GetTimeline(c.Request().Context(), user.ID, limit)
 	if err != nil {
+		log.Errorf("Failed to get timeline: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to marshal request body",
+			"error": "failed to get posts",
 		})
 	}
+	return h.timelineResponse(c, user.ID, posts, source, nextCursor)
+}
 
-	algorithmApiURL, err := url.Parse(os.Getenv("ALGORITHM_API_URL"))
+func (h *PostHandler) timelineResponse(c echo.Context, userID uuid.UUID, posts []*ent.Post, source models.TimelineSource, nextCursor string) error {
+	postResponses, err := h.postResponses(userID, posts)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to parse algorithm API URL",
+			"error": "failed to get image URL",
 		})
 	}
-	requestUrl := algorithmApiURL.JoinPath("timeline")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts":      postResponses,
+		"source":     source,
+		"nextCursor": nextCursor,
+	})
+}
 
-	// POSTリクエストを作成
-	req, err := http.NewRequest(
-		"POST",
-		requestUrl.String(),
-		bytes.NewBuffer(jsonBodyForFastAPI),
-	)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to create HTTP request",
+func (h *PostHandler) GetAllPosts(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get all posts: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
 		})
 	}
+	return h.postPage(c, user.ID, h.postUsecase.GetAllPosts)
+}
 
-	// ヘッダー設定
-	req.Header.Set("Content-Type", "application/json")
+func (h *PostHandler) GetFollowsPosts(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, "Unauthorized")
+	}
+	return h.postPage(c, user.ID, h.postUsecase.GetFollowsPosts)
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to send request",
+// GetLikedPosts returns the posts liked by the current user.
+func (h *PostHandler) GetLikedPosts(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get liked posts: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
 		})
 	}
-	defer resp.Body.Close()
+	return h.postPage(c, user.ID, h.postUsecase.GetLikedPosts)
+}
 
-	// 以降の処理（デコード・変換）はこれまでと同様でOK
-	respBody, _ := io.ReadAll(resp.Body)
+// GetPetPosts returns a page of the posts the pet is tagged in.
+func (h *PostHandler) GetPetPosts(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get pet posts: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+	petID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse pet id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ペットIDが不正です"})
+	}
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
+	}
 
-	var result struct {
-		Posts []PostIdsResponse `json:"posts"`
+	posts, nextCursor, err := h.postUsecase.GetPetPosts(user.ID, petID, cursor, limit)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "ペットが見つかりません"})
 	}
+	return h.postPageResponse(c, user.ID, posts, nextCursor, err)
+}
 
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "invalid response from FastAPI",
+// GetTagPosts returns a page of the posts with the hashtag in the path, with the normalized name
+// of the tag and the number of its posts.
+func (h *PostHandler) GetTagPosts(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get tag posts: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
 		})
 	}
-	postIds := make([]uuid.UUID, len(result.Posts))
-	for i, p := range result.Posts {
-		u, err := uuid.Parse(p.ID)
-		if err != nil {
-			return c.JSON(http.StatusInternalServerError, map[string]string{
-				"error": "invalid post ID format",
-			})
-		}
-		postIds[i] = u
+	// 日本語のタグはパーセントエンコードされて届く
+	name, err := url.PathUnescape(c.Param("name"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "タグが不正です"})
+	}
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
 	}
 
-	posts, err := h.postUsecase.GetByIds(postIds)
+	page, err := h.postUsecase.GetTagPosts(user.ID, name, cursor, limit)
+	if errors.Is(err, usecase.ErrInvalidTag) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "タグが不正です"})
+	}
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to get posts",
+		log.Errorf("Failed to get tag posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の取得に失敗しました",
 		})
 	}
+	postResponses, err := h.postResponses(user.ID, page.Posts)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"tag": map[string]interface{}{
+			"name":       page.Name,
+			"postsCount": page.PostsCount,
+		},
+		"posts":      postResponses,
+		"nextCursor": page.NextCursor,
+	})
+}
 
-	postResponses := make([]models.PostResponse, len(posts))
-	for i, post := range posts {
-		imageURL, err := h.storageUsecase.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]string{
-				"error": "failed to get image URL",
-			})
-		}
-
-		var userImageURL string
-		if post.Edges.User.IconImageKey != "" {
-			var err error
-			userImageURL, err = h.storageUsecase.GetUrl(post.Edges.User.IconImageKey)
-			if err != nil {
-				log.Errorf("Failed to get user image URL: %v", err)
-				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-					"error": err.Error(),
-				})
-			}
-		}
-
-		// コメント
-		commentResponses := make([]models.CommentResponse, len(post.Edges.Comments))
-		for j, comment := range post.Edges.Comments {
-			var commentUserIconURL string
-			if comment.Edges.User.IconImageKey != "" {
-				url, err := h.storageUsecase.GetUrl(comment.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get comment user icon URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]string{
-						"error": "failed to get comment user icon URL",
-					})
-				}
-				commentUserIconURL = url
-			}
-
-			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, commentUserIconURL)
-		}
-
-		// いいね
-		likeResponses := make([]models.LikeResponse, len(post.Edges.Likes))
-		for j, like := range post.Edges.Likes {
-			var likeUserIconURL string
-			if like.Edges.User.IconImageKey != "" {
-				url, err := h.storageUsecase.GetUrl(like.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get like user icon URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]string{
-						"error": "failed to get like user icon URL",
-					})
-				}
-				likeUserIconURL = url
-			}
-			likeResponses[j] = models.NewLikeResponse(like, likeUserIconURL)
-		}
+// GetExplore returns a page of the explore feed, ranked by engagement on the server.
+func (h *PostHandler) GetExplore(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get explore posts: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+	position, limit, err := parseRankPage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
+	}
+	posts, nextCursor, err := h.exploreUsecase.GetExplore(user.ID, position, limit)
+	return h.postPageResponse(c, user.ID, posts, nextCursor, err)
+}
 
-		postResponses[i] = models.NewPostResponse(post, imageURL, userImageURL, commentResponses, likeResponses)
+// GetPost returns a single post, e.g. for a shared link.
+func (h *PostHandler) GetPost(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	postID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse post id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "投稿IDが不正です"})
 	}
 
-	h.cacheUsecase.ClearPostResponses(reqBody.UserID)
-	h.cacheUsecase.StorePostResponses(reqBody.UserID, postResponses)
+	post, err := h.postUsecase.GetPost(user.ID, postID)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "投稿が見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to get post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "投稿の取得に失敗しました"})
+	}
+	postResponses, err := h.postResponses(user.ID, []*ent.Post{post})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"post": postResponses[0]})
+}
 
-	// limit件だけ返すようにスライス
-	var firstPage []models.PostResponse
-	if reqBody.Limit < len(postResponses) {
-		firstPage = postResponses[:reqBody.Limit]
-	} else {
-		firstPage = postResponses
+// ListComments returns one page of the top-level comments of a post, newest first.
+func (h *PostHandler) ListComments(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	postID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse post id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "投稿IDが不正です"})
+	}
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
 	}
 
+	comments, stats, nextCursor, err := h.postUsecase.ListComments(user.ID, postID, cursor, limit)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "投稿が見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to list comments: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "コメントの取得に失敗しました"})
+	}
+	commentResponses, err := h.commentResponses(comments, stats)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"posts": firstPage,
+		"comments":   commentResponses,
+		"nextCursor": nextCursor,
 	})
 }
 
-func (h *PostHandler) GetAllPosts(c echo.Context) error {
-	log.Debug("GetAllPosts")
-	fmt.Println("GetAllPosts")
-	posts, err := h.postUsecase.GetAllPosts()
+// ListLikes returns one page of the likes of a post, newest first.
+func (h *PostHandler) ListLikes(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	postID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		log.Errorf("Failed to get all posts: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": err.Error(),
-		})
+		log.Errorf("Failed to parse post id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "投稿IDが不正です"})
 	}
-	log.Debug("GetAllPosts: posts", posts)
-	postResponses := make([]models.PostResponse, len(posts))
-	for i, post := range posts {
-		imageURL, err := h.storageUsecase.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": err.Error(),
-			})
-		}
-		var userImageURL string
-		if post.Edges.User.IconImageKey != "" {
-			var err error
-			userImageURL, err = h.storageUsecase.GetUrl(post.Edges.User.IconImageKey)
-			if err != nil {
-				log.Errorf("Failed to get user image URL: %v", err)
-				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-					"error": err.Error(),
-				})
-			}
-		}
-
-		commentResponses := make([]models.CommentResponse, len(post.Edges.Comments))
-		for j, comment := range post.Edges.Comments {
-			var commentUserImageURL string
-			if comment.Edges.User.IconImageKey != "" {
-				commentUserImageURL, err = h.storageUsecase.GetUrl(comment.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get comment user image URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-						"error": err.Error(),
-					})
-				}
-			}
-			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, commentUserImageURL)
-		}
-		likeResponses := make([]models.LikeResponse, len(post.Edges.Likes))
-		for j, like := range post.Edges.Likes {
-			var likeUserImageURL string
-			if like.Edges.User.IconImageKey != "" {
-				likeUserImageURL, err = h.storageUsecase.GetUrl(like.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get like user image URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-						"error": err.Error(),
-					})
-				}
-			}
-			likeResponses[j] = models.NewLikeResponse(like, likeUserImageURL)
-		}
-		postResponses[i] = models.NewPostResponse(post, imageURL, userImageURL, commentResponses, likeResponses)
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
 	}
 
+	likes, nextCursor, err := h.postUsecase.ListLikes(user.ID, postID, cursor, limit)
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "投稿が見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to list likes: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "いいねの取得に失敗しました"})
+	}
+	likeResponses, err := h.likeResponses(likes)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"posts": postResponses,
+		"likes":      likeResponses,
+		"nextCursor": nextCursor,
 	})
 }
 
-func (h *PostHandler) GetFollowsPosts(c echo.Context) error {
-	userId, err := uuid.Parse(c.QueryParam("userId"))
+type postPageFunc func(userID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, string, error)
+
+// postPage responds with one page of a feed and the cursor of the next page.
+func (h *PostHandler) postPage(c echo.Context, userID uuid.UUID, feed postPageFunc) error {
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, "Invalid userID")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
 	}
 
-	var cursor *uuid.UUID
-	cursorParam := c.QueryParam("cursor")
-	if cursorParam != "" {
-		cur, err := uuid.Parse(cursorParam)
-		if err != nil {
-			return c.JSON(http.StatusBadRequest, "Invalid cursor")
-		}
-		cursor = &cur
-	}
+	posts, nextCursor, err := feed(userID, cursor, limit)
+	return h.postPageResponse(c, userID, posts, nextCursor, err)
+}
 
-	limitStr := c.QueryParam("limit")
-	limit, err := strconv.Atoi(limitStr)
+func (h *PostHandler) postPageResponse(c echo.Context, userID uuid.UUID, posts []*ent.Post, nextCursor string, err error) error {
+	if err != nil {
+		log.Errorf("Failed to get posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "投稿の取得に失敗しました",
+		})
+	}
+	postResponses, err := h.postResponses(userID, posts)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, "Failed to parse limit")
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": err.Error(),
+		})
 	}
-	posts, err := h.postUsecase.GetFollowsPosts(userId, cursor, limit)
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts":      postResponses,
+		"nextCursor": nextCursor,
+	})
+}
+
+func (h *PostHandler) postResponses(userID uuid.UUID, posts []*ent.Post) ([]models.PostResponse, error) {
+	stats, err := h.postUsecase.GetStats(userID, posts)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, "failed to fetch Posts")
+		log.Errorf("Failed to get post stats: %v", err)
+		return nil, err
 	}
 	postResponses := make([]models.PostResponse, len(posts))
 	for i, post := range posts {
-		imageURL, err := h.storageUsecase.GetUrl(post.ImageKey)
+		imageURLs, err := h.storageUsecase.GetMediaUrls(post)
 		if err != nil {
 			log.Errorf("Failed to get image URL: %v", err)
-			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-				"error": err.Error(),
-			})
+			return nil, err
+		}
+		petImageURLs, err := h.storageUsecase.GetPetImageUrls(post)
+		if err != nil {
+			log.Errorf("Failed to get pet image URL: %v", err)
+			return nil, err
 		}
 		var userImageURL string
 		if post.Edges.User.IconImageKey != "" {
-			var err error
 			userImageURL, err = h.storageUsecase.GetUrl(post.Edges.User.IconImageKey)
 			if err != nil {
 				log.Errorf("Failed to get user image URL: %v", err)
-				return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-					"error": err.Error(),
-				})
+				return nil, err
 			}
 		}
 
-		commentResponses := make([]models.CommentResponse, len(post.Edges.Comments))
-		for j, comment := range post.Edges.Comments {
-			var commentUserImageURL string
-			if comment.Edges.User.IconImageKey != "" {
-				commentUserImageURL, err = h.storageUsecase.GetUrl(comment.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get comment user image URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-						"error": err.Error(),
-					})
-				}
+		commentResponses, err := h.commentResponses(stats[post.ID].RecentComments, stats[post.ID].CommentStats)
+		if err != nil {
+			return nil, err
+		}
+		postResponses[i] = models.NewPostResponse(post, imageURLs, userImageURL, petImageURLs, stats[post.ID], commentResponses)
+	}
+	return postResponses, nil
+}
+
+func (h *PostHandler) commentResponses(comments []*ent.Comment, stats map[uuid.UUID]models.CommentStats) ([]models.CommentResponse, error) {
+	commentResponses := make([]models.CommentResponse, len(comments))
+	for i, comment := range comments {
+		var commentUserImageURL string
+		if comment.Edges.User.IconImageKey != "" {
+			url, err := h.storageUsecase.GetUrl(comment.Edges.User.IconImageKey)
+			if err != nil {
+				log.Errorf("Failed to get comment user image URL: %v", err)
+				return nil, err
 			}
-			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, commentUserImageURL)
+			commentUserImageURL = url
 		}
-		likeResponses := make([]models.LikeResponse, len(post.Edges.Likes))
-		for j, like := range post.Edges.Likes {
-			var likeUserImageURL string
-			if like.Edges.User.IconImageKey != "" {
-				likeUserImageURL, err = h.storageUsecase.GetUrl(like.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get like user image URL: %v", err)
-					return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-						"error": err.Error(),
-					})
-				}
+		commentResponses[i] = models.NewCommentResponse(comment, comment.Edges.User, commentUserImageURL, stats[comment.ID])
+	}
+	return commentResponses, nil
+}
+
+func (h *PostHandler) likeResponses(likes []*ent.Like) ([]models.LikeResponse, error) {
+	likeResponses := make([]models.LikeResponse, len(likes))
+	for i, like := range likes {
+		var likeUserImageURL string
+		if like.Edges.User.IconImageKey != "" {
+			url, err := h.storageUsecase.GetUrl(like.Edges.User.IconImageKey)
+			if err != nil {
+				log.Errorf("Failed to get like user image URL: %v", err)
+				return nil, err
 			}
-			likeResponses[j] = models.NewLikeResponse(like, likeUserImageURL)
+			likeUserImageURL = url
 		}
-		postResponses[i] = models.NewPostResponse(post, imageURL, userImageURL, commentResponses, likeResponses)
+		likeResponses[i] = models.NewLikeResponse(like, likeUserImageURL)
 	}
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"posts": postResponses,
-	})
+	return likeResponses, nil
 }
 
 func (h *PostHandler) CreatePost(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to create post: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
 	var req struct {
 		Caption     string  `json:"caption,omitempty" form:"caption"`
-		UserId      string  `json:"userId,omitempty" form:"userId"`
 		DailyTaskId *string `json:"dailyTaskId,omitempty" form:"dailyTaskId"`
 	}
 	if err := c.Bind(&req); err != nil {
@@ -399,40 +463,105 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 		})
 	}
 
-	file, err := c.FormFile("image")
+	form, err := c.MultipartForm()
 	if err != nil {
-		log.Errorf("Failed to create post: image file is required: %v", err)
+		log.Errorf("Failed to create post: invalid multipart form: %v", err)
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
 			"error": "画像ファイルが必要です",
 		})
 	}
+	altTexts := form.Value["altTexts"]
+	// 写っている自分のペットを petIds で複数指定できる
+	petIDs, err := parsePetIDs(form.Value["petIds"])
+	if err != nil {
+		log.Errorf("Failed to create post: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ペットIDが不正です",
+		})
+	}
+	var post *ent.Post
+	if videos := form.File["video"]; len(videos) > 0 {
+		// 動画の投稿は動画1本と、アプリが切り出したポスター画像1枚
+		posters := form.File["poster"]
+		if len(videos) > 1 || len(posters) != 1 || len(form.File["images"])+len(form.File["image"]) > 0 {
+			log.Error("Failed to create post: a video post needs one video and one poster image")
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "動画1本とポスター画像1枚を送ってください",
+			})
+		}
+		log.Debugf("Received video: name=%s, size=%d, content-type=%s",
+			videos[0].Filename,
+			videos[0].Size,
+			videos[0].Header.Get("Content-Type"))
+
+		// 動画の形式は Content-Type ではなくファイルのヘッダーで検証する
+		upload := usecase.PostVideoUpload{File: videos[0], Poster: posters[0]}
+		if len(altTexts) > 0 {
+			upload.AltText = altTexts[0]
+		}
+		post, err = h.postUsecase.CreateVideoPost(req.Caption, user.ID.String(), upload, petIDs, req.DailyTaskId)
+	} else {
+		// images に表示順で最大10枚。旧バージョンのアプリは image で1枚だけ送ってくる
+		files := form.File["images"]
+		if len(files) == 0 {
+			files = form.File["image"]
+		}
+		if len(files) == 0 {
+			log.Error("Failed to create post: image file is required")
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "画像ファイルが必要です",
+			})
+		}
+		if len(files) > usecase.MaxPostMedia {
+			log.Errorf("Failed to create post: too many images: %d", len(files))
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": fmt.Sprintf("画像は%d枚までです", usecase.MaxPostMedia),
+			})
+		}
 
-	// ファイル情報のデバッグログ
-	log.Printf("Received file: name=%s, size=%d, content-type=%s",
-		file.Filename,
-		file.Size,
-		file.Header.Get("Content-Type"))
+		// altTexts は images と同じ順番。足りない分は空にする
+		uploads := make([]usecase.PostMediaUpload, len(files))
+		for i, file := range files {
+			log.Debugf("Received file: name=%s, size=%d, content-type=%s",
+				file.Filename,
+				file.Size,
+				file.Header.Get("Content-Type"))
+
+			// Content-Typeの検証
+			contentType := file.Header.Get("Content-Type")
+			if !strings.HasPrefix(contentType, "image/") {
+				log.Errorf("Invalid content type: %s", contentType)
+				return c.JSON(http.StatusBadRequest, map[string]interface{}{
+					"error": "画像ファイルのみアップロード可能です",
+				})
+			}
+
+			uploads[i] = usecase.PostMediaUpload{File: file}
+			if i < len(altTexts) {
+				uploads[i].AltText = altTexts[i]
+			}
+		}
 
-	// Content-Typeの検証
-	contentType := file.Header.Get("Content-Type")
-	if !strings.HasPrefix(contentType, "image/") {
-		log.Errorf("Invalid content type: %s", contentType)
+		// 画像のアップロードとPostの作成
+		post, err = h.postUsecase.CreatePost(req.Caption, user.ID.String(), uploads, petIDs, req.DailyTaskId)
+	}
+	if errors.Is(err, usecase.ErrInvalidVideo) {
+		log.Errorf("Failed to create post: %v", err)
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "画像ファイルのみアップロード可能です",
+			"error": fmt.Sprintf("動画は%d秒以内、%dMB以下の MP4 か MOV のみ投稿できます",
+				int(usecase.MaxVideoDuration.Seconds()), usecase.MaxVideoSize>>20),
 		})
 	}
-
-	// Upload the image
-	fileKey, err := h.storageUsecase.UploadImage(file, "posts")
-	if err != nil {
-		log.Errorf("Failed to create post: failed to upload image: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "画像のアップロードに失敗しました",
+	if errors.Is(err, usecase.ErrInvalidMedia) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "画像の枚数か形式、または代替テキストの長さが不正です",
+		})
+	}
+	if errors.Is(err, usecase.ErrInvalidPetTag) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": fmt.Sprintf("タグ付けできるのは自分のペット%d匹までです", usecase.MaxPostPets),
 		})
 	}
-
-	// Postの作成
-	post, err := h.postUsecase.CreatePost(req.Caption, req.UserId, fileKey, req.DailyTaskId)
 	if err != nil {
 		log.Errorf("Failed to create post: failed to create post: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -442,7 +571,7 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 
 	// DailyTaskIdがセットされていればストリークの更新
 	if req.DailyTaskId != nil {
-		err := h.dailyTaskUsecase.UpdateStreakCount(req.UserId)
+		err := h.dailyTaskUsecase.UpdateStreakCount(user.ID.String())
 		if err != nil {
 			log.Errorf("Failed to update streak: %v", err)
 			return c.JSON(http.StatusInternalServerError, map[string]interface{}{
@@ -457,13 +586,128 @@ func (h *PostHandler) CreatePost(c echo.Context) error {
 	})
 }
 
+// parsePetIDs parses the IDs of the pets tagged in a post.
+func parsePetIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(values))
+	for i, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// UpdatePost edits the caption and the tagged pets of the current user's post. Omitting petIds
+// keeps the tagged pets.
+func (h *PostHandler) UpdatePost(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to update post: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+	postID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse post id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "投稿IDが不正です"})
+	}
+
+	var req struct {
+		Caption string       `json:"caption"`
+		PetIDs  *[]uuid.UUID `json:"petIds"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Error("Failed to update post: invalid request body")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "Invalid request body",
+		})
+	}
+	if strings.TrimSpace(req.Caption) == "" {
+		log.Error("Failed to update post: caption is empty")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "情報が不足しています",
+		})
+	}
+
+	// petIds がなければタグはそのまま、[] ならすべて外す
+	var petIDs []uuid.UUID
+	if req.PetIDs != nil {
+		petIDs = *req.PetIDs
+	}
+
+	post, err := h.postUsecase.UpdatePost(user.ID, postID, req.Caption, petIDs)
+	if errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "自分の投稿のみ編集できます"})
+	}
+	if errors.Is(err, usecase.ErrInvalidPetTag) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": fmt.Sprintf("タグ付けできるのは自分のペット%d匹までです", usecase.MaxPostPets),
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "投稿が見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to update post: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "投稿の編集に失敗しました"})
+	}
+	postResponses, err := h.postResponses(user.ID, []*ent.Post{post})
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "投稿が編集されました",
+		"post":    postResponses[0],
+	})
+}
+
+// ListRevisions returns the previous captions and pets of the current user's post, newest first.
+func (h *PostHandler) ListRevisions(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	postID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse post id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "投稿IDが不正です"})
+	}
+
+	revisions, err := h.postUsecase.ListRevisions(user.ID, postID)
+	if errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "自分の投稿の編集履歴のみ閲覧できます"})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "投稿が見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to list post revisions: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "編集履歴の取得に失敗しました"})
+	}
+	revisionResponses := make([]models.PostRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		revisionResponses[i] = models.NewPostRevisionResponse(revision)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"revisions": revisionResponses})
+}
+
 func (h *PostHandler) DeletePost(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
 	postID := c.QueryParam("id")
 	if postID == "" {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Post ID is required"})
 	}
 
-	err := h.postUsecase.DeletePost(postID)
+	err := h.postUsecase.DeletePost(user.ID, postID)
+	if errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "You can only delete your own posts"})
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
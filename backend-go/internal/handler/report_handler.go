@@ -1,11 +1,18 @@
 package handler
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
+	"strconv"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
 )
 
 type ReportHandler struct {
@@ -18,26 +25,203 @@ func NewReportHandler(reportUsecase usecase.ReportUsecase) *ReportHandler {
 	}
 }
 
+type FileReportRequest struct {
+	TargetType string `json:"targetType"`
+	TargetID   string `json:"targetId"`
+	Reason     string `json:"reason"`
+	Details    string `json:"details"`
+}
+
 type SendReportRequest struct {
 	PostID string `json:"postId"`
 	UserID string `json:"userId"`
 	Reason string `json:"reason"`
 }
 
+func (h *ReportHandler) File(c echo.Context) error {
+	var req FileReportRequest
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+	targetID, err := uuid.Parse(req.TargetID)
+	if err != nil {
+		log.Errorf("Failed to parse targetId: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "通報対象のIDが不正です",
+		})
+	}
+
+	return h.file(c, enum.ReportTargetType(req.TargetType), targetID, enum.ReportReason(req.Reason), req.Details)
+}
+
+// SendReport accepts the request body of older app versions, which only report posts
+// with a free-text reason. Deprecated: use File.
 func (h *ReportHandler) SendReport(c echo.Context) error {
 	var req SendReportRequest
 	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	targetType := enum.ReportTargetPost
+	targetIDParam := req.PostID
+	if targetIDParam == "" {
+		targetType = enum.ReportTargetUser
+		targetIDParam = req.UserID
+	}
+	targetID, err := uuid.Parse(targetIDParam)
+	if err != nil {
+		log.Errorf("Failed to parse report target: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "通報対象のIDが不正です",
+		})
+	}
+
+	return h.file(c, targetType, targetID, enum.ReportReasonOther, req.Reason)
+}
+
+func (h *ReportHandler) file(c echo.Context, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to file report: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	report, created, err := h.reportUsecase.File(user, targetType, targetID, reason, details)
+	if errors.Is(err, usecase.ErrInvalidReport) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "通報の内容が不正です",
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "通報対象が見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to file report: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "通報に失敗しました",
+		})
+	}
+
+	message := "通報を受け付けました"
+	if !created {
+		message = "この内容は既に通報済みです"
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":  message,
+		"reportId": report.ID,
+	})
+}
+
+func (h *ReportHandler) List(c echo.Context) error {
+	filter := models.ReportFilter{
+		Status:     enum.ReportStatus(c.QueryParam("status")),
+		TargetType: enum.ReportTargetType(c.QueryParam("targetType")),
+		Reason:     enum.ReportReason(c.QueryParam("reason")),
+	}
+	if (filter.Status != "" && !filter.Status.Valid()) ||
+		(filter.TargetType != "" && !filter.TargetType.Valid()) ||
+		(filter.Reason != "" && !filter.Reason.Valid()) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "絞り込み条件が不正です",
+		})
 	}
 
-	from := "animalia0406@gmail.com"
-	to := "animalia0406@gmail.com"
-	subject := "【通報】ユーザーからの通報がありました"
-	body := fmt.Sprintf("Post ID: %s\nUser ID: %s\nReason: %s", req.PostID, req.UserID, req.Reason)
+	limit := defaultAdminListLimit
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed <= 0 {
+			log.Errorf("Failed to parse limit: %s", limitStr)
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "limit が不正です",
+			})
+		}
+		limit = min(parsed, maxAdminListLimit)
+	}
+	offset := 0
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil || parsed < 0 {
+			log.Errorf("Failed to parse offset: %s", offsetStr)
+			return c.JSON(http.StatusBadRequest, map[string]interface{}{
+				"error": "offset が不正です",
+			})
+		}
+		offset = parsed
+	}
 
-	if _, err := h.reportUsecase.SendReport(from, to, subject, body); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to send report email")
+	reports, err := h.reportUsecase.List(filter, limit, offset)
+	if err != nil {
+		log.Errorf("Failed to list reports: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "通報の取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"reports": reports,
+	})
+}
+
+func (h *ReportHandler) Resolve(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to resolve report: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	reportID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse report id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "通報IDが不正です",
+		})
+	}
+
+	var req struct {
+		Status string `json:"status"`
+		Note   string `json:"note"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	report, err := h.reportUsecase.Resolve(user, reportID, enum.ReportStatus(req.Status), req.Note)
+	if errors.Is(err, usecase.ErrInvalidReport) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "status は actioned か dismissed を指定してください",
+		})
+	}
+	if errors.Is(err, usecase.ErrAlreadyResolved) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"error": "この通報は既に対応済みです",
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "通報が見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to resolve report: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "通報の更新に失敗しました",
+		})
 	}
 
-	return c.JSON(http.StatusOK, map[string]string{"message": "Report sent successfully"})
+	return c.JSON(http.StatusOK, report)
 }
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type SuspensionHandler struct {
+	suspensionUsecase usecase.SuspensionUsecase
+}
+
+func NewSuspensionHandler(suspensionUsecase usecase.SuspensionUsecase) *SuspensionHandler {
+	return &SuspensionHandler{
+		suspensionUsecase: suspensionUsecase,
+	}
+}
+
+func (h *SuspensionHandler) Suspend(c echo.Context) error {
+	actor, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to suspend user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	var req struct {
+		Reason string    `json:"reason"`
+		EndsAt time.Time `json:"endsAt"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	suspension, err := h.suspensionUsecase.Suspend(actor, userId, req.Reason, req.EndsAt)
+	if errors.Is(err, usecase.ErrInvalidSuspension) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "理由と未来の終了日時を指定してください",
+		})
+	}
+	if errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "このユーザーを利用停止にする権限がありません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "ユーザーが見つかりません",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to suspend user: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "利用停止に失敗しました",
+		})
+	}
+
+	log.Infof("User %s suspended %s until %s", actor.ID, userId, req.EndsAt)
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"suspension": suspension,
+	})
+}
+
+func (h *SuspensionHandler) Lift(c echo.Context) error {
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	lifted, err := h.suspensionUsecase.Lift(userId)
+	if err != nil {
+		log.Errorf("Failed to lift suspension: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "利用停止の解除に失敗しました",
+		})
+	}
+	if !lifted {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "有効な利用停止がありません",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "利用停止を解除しました",
+	})
+}
+
+func (h *SuspensionHandler) List(c echo.Context) error {
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDが不正です",
+		})
+	}
+
+	suspensions, err := h.suspensionUsecase.ListByUser(userId)
+	if err != nil {
+		log.Errorf("Failed to get suspensions: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "利用停止履歴の取得に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"suspensions": suspensions,
+	})
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+type TagHandler struct {
+	tagUsecase usecase.TagUsecase
+}
+
+func NewTagHandler(tagUsecase usecase.TagUsecase) *TagHandler {
+	return &TagHandler{
+		tagUsecase: tagUsecase,
+	}
+}
+
+// GetTrending returns the hashtags whose use grew the most in the last day.
+func (h *TagHandler) GetTrending(c echo.Context) error {
+	if _, ok := middlewares.CurrentUser(c); !ok {
+		log.Error("Failed to get trending tags: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
+		})
+	}
+	limit, err := parseLimit(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
+	}
+
+	tags, err := h.tagUsecase.GetTrending(limit)
+	if err != nil {
+		log.Errorf("Failed to get trending tags: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "トレンドの取得に失敗しました",
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"tags": tags,
+	})
+}
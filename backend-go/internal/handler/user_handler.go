@@ -1,9 +1,15 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strings"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/usecase"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 )
@@ -21,11 +27,12 @@ func NewUserHandler(userUsecase usecase.UserUsecase, storageUsecase usecase.Stor
 }
 
 func (h *UserHandler) UpdateUser(c echo.Context) error {
-	// クエリからユーザーIDを取得
-	id := c.QueryParam("id")
-	if id == "" {
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{
-			"error": "userId is required",
+	// 認証済みユーザーを取得
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to update user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{
+			"error": "認証が必要です",
 		})
 	}
 
@@ -40,15 +47,6 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 	name := form.Value["name"][0]
 	bio := form.Value["bio"][0]
 
-	// ユーザー情報の取得（例: 現在の画像キーを取得するため）
-	user, err := h.userUsecase.GetById(id)
-	if err != nil {
-		log.Errorf("Failed to get user: %v", err)
-		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"error": "ユーザー情報の取得に失敗しました",
-		})
-	}
-
 	// 画像ファイルが存在するか確認
 	file, fileErr := c.FormFile("image")
 	var newImageKey string
@@ -76,7 +74,7 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 	}
 
 	// ユーザー情報を更新（画像キーは新しい画像があればその値、なければ既存のもの）
-	if err := h.userUsecase.Update(id, name, bio, newImageKey); err != nil {
+	if err := h.userUsecase.Update(user.ID.String(), name, bio, newImageKey); err != nil {
 		log.Errorf("Failed to update user: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "プロフィール更新に失敗しました",
@@ -90,31 +88,89 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 }
 
 func (h *UserHandler) Follow(c echo.Context) error {
-	toId, fromId := c.QueryParam("toId"), c.QueryParam("fromId")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to follow: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	toId := c.QueryParam("toId")
 
-	if toId == "" || fromId == "" {
-		log.Error("Failed to follow: followerId or followedId is empty")
+	if toId == "" {
+		log.Error("Failed to follow: toId is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
 	}
-	if err := h.userUsecase.Follow(toId, fromId); err != nil {
+	status, err := h.userUsecase.Follow(toId, user.ID.String())
+	if errors.Is(err, usecase.ErrBlocked) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{
+			"error": "ブロック関係にあるユーザーはフォローできません",
+		})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{
+			"error": "ユーザーが見つかりません",
+		})
+	}
+	if err != nil {
 		log.Errorf("Failed to follow: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "フォローに失敗しました",
 		})
 	}
+	if status == models.FollowStatusRequested {
+		return c.JSON(http.StatusAccepted, map[string]interface{}{
+			"message": "フォローリクエストを送信しました",
+			"status":  status,
+		})
+	}
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"message": "フォローしました",
+		"status":  status,
+	})
+}
+
+func (h *UserHandler) UpdatePrivacy(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to update privacy: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+
+	var req struct {
+		IsPrivate *bool `json:"isPrivate"`
+	}
+	if err := c.Bind(&req); err != nil || req.IsPrivate == nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	if err := h.userUsecase.SetPrivate(user.ID, *req.IsPrivate); err != nil {
+		log.Errorf("Failed to update privacy: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "公開設定の更新に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":   "公開設定を更新しました",
+		"isPrivate": *req.IsPrivate,
 	})
 }
 
 func (h *UserHandler) Unfollow(c echo.Context) error {
-	toId, fromId := c.QueryParam("toId"), c.QueryParam("fromId")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to unfollow: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	toId := c.QueryParam("toId")
 
-	if toId == "" || fromId == "" {
-		log.Error("Failed to unfollow: followerId or followedId is empty")
+	if toId == "" {
+		log.Error("Failed to unfollow: toId is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
 	}
-	if err := h.userUsecase.Unfollow(toId, fromId); err != nil {
+	if err := h.userUsecase.Unfollow(toId, user.ID.String()); err != nil {
 		log.Errorf("Failed to unfollow: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "フォロー解除に失敗しました",
@@ -127,12 +183,17 @@ func (h *UserHandler) Unfollow(c echo.Context) error {
 }
 
 func (h *UserHandler) Block(c echo.Context) error {
-	fromId, toId := c.QueryParam("fromId"), c.QueryParam("toId")
-	if fromId == "" || toId == "" {
-		log.Error("Failed to block: fromId or toId is empty")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to block: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	toId := c.QueryParam("toId")
+	if toId == "" {
+		log.Error("Failed to block: toId is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
 	}
-	if err := h.userUsecase.Block(fromId, toId); err != nil {
+	if err := h.userUsecase.Block(user.ID.String(), toId); err != nil {
 		log.Errorf("Failed to block: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "ブロックに失敗しました",
@@ -144,12 +205,17 @@ func (h *UserHandler) Block(c echo.Context) error {
 }
 
 func (h *UserHandler) Unblock(c echo.Context) error {
-	fromId, toId := c.QueryParam("fromId"), c.QueryParam("toId")
-	if fromId == "" || toId == "" {
-		log.Error("Failed to unblock: fromId or toId is empty")
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to unblock: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	toId := c.QueryParam("toId")
+	if toId == "" {
+		log.Error("Failed to unblock: toId is empty")
 		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "情報が不足しています"})
 	}
-	if err := h.userUsecase.Unblock(fromId, toId); err != nil {
+	if err := h.userUsecase.Unblock(user.ID.String(), toId); err != nil {
 		log.Errorf("Failed to unblock: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
 			"error": "ブロック解除に失敗しました",
@@ -160,13 +226,59 @@ func (h *UserHandler) Unblock(c echo.Context) error {
 	})
 }
 
+// GetUser returns the profile of the caller when email is their own, for older app versions.
+// Deprecated: use /auth/me for the own profile and GetProfile for others.
 func (h *UserHandler) GetUser(c echo.Context) error {
 	email := c.QueryParam("email")
 	if email == "" {
-		log.Error("Failed to get user: id is empty")
-		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが必要です"})
+		log.Error("Failed to get user: email is empty")
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "メールアドレスが必要です"})
+	}
+	viewer, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	// 他のユーザーのメールアドレスは登録の有無も分からないようにする
+	if !strings.EqualFold(email, viewer.Email) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "ユーザーが見つかりません"})
+	}
+	user, err := h.userUsecase.GetProfile(viewer.ID, viewer.ID)
+	return profileResponse(c, user, err)
+}
+
+func (h *UserHandler) GetProfile(c echo.Context) error {
+	viewer, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが不正です"})
+	}
+	user, err := h.userUsecase.GetProfile(viewer.ID, id)
+	return profileResponse(c, user, err)
+}
+
+func (h *UserHandler) GetProfileByHandle(c echo.Context) error {
+	viewer, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to get user: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	user, err := h.userUsecase.GetProfileByHandle(viewer.ID, c.Param("handle"))
+	return profileResponse(c, user, err)
+}
+
+func profileResponse(c echo.Context, user models.UserResponse, err error) error {
+	if errors.Is(err, usecase.ErrBlocked) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "このユーザーのプロフィールは表示できません"})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "ユーザーが見つかりません"})
 	}
-	user, err := h.userUsecase.GetByEmail(email)
 	if err != nil {
 		log.Errorf("Failed to get user: %v", err)
 		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "ユーザー情報の取得に失敗しました"})
@@ -175,3 +287,131 @@ func (h *UserHandler) GetUser(c echo.Context) error {
 		"user": user,
 	})
 }
+
+func (h *UserHandler) UpdateHandle(c echo.Context) error {
+	user, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to update handle: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+
+	var req struct {
+		Handle string `json:"handle"`
+	}
+	if err := c.Bind(&req); err != nil {
+		log.Errorf("Failed to parse request body: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "リクエストのパースに失敗しました",
+		})
+	}
+
+	handle, err := h.userUsecase.UpdateHandle(user.ID, req.Handle)
+	if errors.Is(err, usecase.ErrInvalidHandle) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{
+			"error": "ユーザーIDは3〜30文字の半角英小文字・数字・アンダースコアで指定してください",
+		})
+	}
+	if errors.Is(err, usecase.ErrHandleTaken) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"error": "このユーザーIDは既に使われています",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to update handle: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+			"error": "ユーザーIDの更新に失敗しました",
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "ユーザーIDを更新しました",
+		"handle":  handle,
+	})
+}
+
+func (h *UserHandler) ListFollowers(c echo.Context) error {
+	return h.listUsers(c, h.userUsecase.ListFollowers)
+}
+
+func (h *UserHandler) ListFollowing(c echo.Context) error {
+	return h.listUsers(c, h.userUsecase.ListFollowing)
+}
+
+func (h *UserHandler) ListBlocking(c echo.Context) error {
+	return h.listUsers(c, h.userUsecase.ListBlocking)
+}
+
+type userListFunc func(viewerID, userID uuid.UUID, cursor *models.Cursor, limit int) ([]models.FollowListUserResponse, string, error)
+
+func (h *UserHandler) listUsers(c echo.Context, list userListFunc) error {
+	viewer, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to list users: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが不正です"})
+	}
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
+	}
+
+	users, nextCursor, err := list(viewer.ID, id, cursor, limit)
+	if errors.Is(err, usecase.ErrBlocked) || errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "このユーザーの一覧は表示できません"})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "ユーザーが見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to list users: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "ユーザー一覧の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"users":      users,
+		"nextCursor": nextCursor,
+	})
+}
+
+// ListPosts returns the posts of a user after the first page included in the profile.
+func (h *UserHandler) ListPosts(c echo.Context) error {
+	viewer, ok := middlewares.CurrentUser(c)
+	if !ok {
+		log.Error("Failed to list posts: user not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]interface{}{"error": "認証が必要です"})
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		log.Errorf("Failed to parse user id: %v", err)
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "ユーザーIDが不正です"})
+	}
+	cursor, limit, err := parsePage(c)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "cursor が不正です"})
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]interface{}{"error": "limit が不正です"})
+	}
+
+	posts, nextCursor, err := h.userUsecase.ListPosts(viewer.ID, id, cursor, limit)
+	if errors.Is(err, usecase.ErrBlocked) || errors.Is(err, usecase.ErrForbidden) {
+		return c.JSON(http.StatusForbidden, map[string]interface{}{"error": "このユーザーの投稿は表示できません"})
+	}
+	if ent.IsNotFound(err) {
+		return c.JSON(http.StatusNotFound, map[string]interface{}{"error": "ユーザーが見つかりません"})
+	}
+	if err != nil {
+		log.Errorf("Failed to list posts: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]interface{}{"error": "投稿の取得に失敗しました"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"posts":      posts,
+		"nextCursor": nextCursor,
+	})
+}
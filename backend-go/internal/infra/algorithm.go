@@ -0,0 +1,168 @@
+package infra
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// AlgorithmOptions configures the client of the algorithm service.
+type AlgorithmOptions struct {
+	// Timeout is the limit of a single attempt.
+	Timeout time.Duration
+	// Budget is the limit of a call including the retries and the waits between them.
+	Budget time.Duration
+	// MaxRetries is the number of attempts after the first one.
+	MaxRetries int
+	// Backoff is the wait before the first retry. It doubles on every retry, with jitter.
+	Backoff time.Duration
+	// BreakerThreshold consecutive failed calls open the circuit breaker for BreakerCooldown.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+var DefaultAlgorithmOptions = AlgorithmOptions{
+	Timeout:          1500 * time.Millisecond,
+	Budget:           3500 * time.Millisecond,
+	MaxRetries:       2,
+	Backoff:          200 * time.Millisecond,
+	BreakerThreshold: 5,
+	BreakerCooldown:  30 * time.Second,
+}
+
+// AlgorithmRepository calls the recommendation API of the algorithm service (FastAPI).
+type AlgorithmRepository struct {
+	baseURL *url.URL
+	client  *http.Client
+	options AlgorithmOptions
+	breaker *circuitBreaker
+}
+
+func NewAlgorithmRepository(baseURL string, options AlgorithmOptions) (*AlgorithmRepository, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid algorithm API URL: %w", err)
+	}
+	return &AlgorithmRepository{
+		baseURL: parsed,
+		client:  &http.Client{Timeout: options.Timeout},
+		options: options,
+		breaker: newCircuitBreaker(options.BreakerThreshold, options.BreakerCooldown),
+	}, nil
+}
+
+type timelineRequest struct {
+	UserID string `json:"user_id"`
+}
+
+type timelineResponse struct {
+	Posts []struct {
+		ID uuid.UUID `json:"id"`
+	} `json:"posts"`
+}
+
+// errPermanent marks failures that retrying does not fix, such as a malformed response.
+type errPermanent struct{ err error }
+
+func (e errPermanent) Error() string { return e.err.Error() }
+func (e errPermanent) Unwrap() error { return e.err }
+
+func (r *AlgorithmRepository) Timeline(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	if !r.breaker.allow() {
+		return nil, ErrCircuitOpen
+	}
+
+	body, err := json.Marshal(timelineRequest{UserID: userID.String()})
+	if err != nil {
+		return nil, err
+	}
+	callCtx, cancel := context.WithTimeout(ctx, r.options.Budget)
+	defer cancel()
+	var result timelineResponse
+	err = r.withRetry(callCtx, func(ctx context.Context) error {
+		return r.post(ctx, "timeline", body, &result)
+	})
+	if err != nil {
+		// 呼び出し元がキャンセルした場合はサービスの障害として数えない
+		if ctx.Err() != nil {
+			r.breaker.abandon()
+		} else {
+			r.breaker.failure()
+		}
+		return nil, err
+	}
+	r.breaker.success()
+
+	ids := make([]uuid.UUID, len(result.Posts))
+	for i, post := range result.Posts {
+		ids[i] = post.ID
+	}
+	return ids, nil
+}
+
+// withRetry calls call until it succeeds, fails permanently, runs out of retries or ctx is done.
+func (r *AlgorithmRepository) withRetry(ctx context.Context, call func(ctx context.Context) error) error {
+	backoff := r.options.Backoff
+	var err error
+	for attempt := 0; attempt <= r.options.MaxRetries; attempt++ {
+		if attempt > 0 {
+			// 0.5倍から1.5倍のジッターを入れて、再試行が同時に集中しないようにする
+			timer := time.NewTimer(backoff/2 + rand.N(backoff+1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return fmt.Errorf("%w (last error: %v)", ctx.Err(), err)
+			case <-timer.C:
+			}
+			backoff *= 2
+		}
+		err = call(ctx)
+		var permanent errPermanent
+		if err == nil || errors.As(err, &permanent) {
+			return err
+		}
+		log.Warnf("Algorithm API call failed (attempt %d): %v", attempt+1, err)
+		if ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (r *AlgorithmRepository) post(ctx context.Context, path string, body []byte, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.baseURL.JoinPath(path).String(), bytes.NewReader(body))
+	if err != nil {
+		return errPermanent{err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("algorithm API returned %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errPermanent{fmt.Errorf("algorithm API returned %d", resp.StatusCode)}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return errPermanent{fmt.Errorf("invalid response from algorithm API: %w", err)}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAlgorithmOptions = AlgorithmOptions{
+	Timeout:          100 * time.Millisecond,
+	Budget:           time.Second,
+	MaxRetries:       2,
+	Backoff:          time.Millisecond,
+	BreakerThreshold: 2,
+	BreakerCooldown:  time.Minute,
+}
+
+func TestAlgorithmRepository_Timeline(t *testing.T) {
+	postID := uuid.New()
+	okBody := `{"posts":[{"id":"` + postID.String() + `"}]}`
+
+	testCases := []struct {
+		name          string
+		responses     []func(w http.ResponseWriter)
+		expectedIDs   []uuid.UUID
+		expectedCalls int32
+		expectError   bool
+	}{
+		{
+			name: "Success",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.Write([]byte(okBody)) },
+			},
+			expectedIDs:   []uuid.UUID{postID},
+			expectedCalls: 1,
+		},
+		{
+			name: "Retries server errors",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+				func(w http.ResponseWriter) { w.Write([]byte(okBody)) },
+			},
+			expectedIDs:   []uuid.UUID{postID},
+			expectedCalls: 3,
+		},
+		{
+			name: "Gives up after the retries",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusInternalServerError) },
+			},
+			expectedCalls: 3,
+			expectError:   true,
+		},
+		{
+			name: "Does not retry a malformed response",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.Write([]byte("<html>")) },
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		{
+			name: "Times out",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { time.Sleep(200 * time.Millisecond) },
+			},
+			expectedCalls: 3,
+			expectError:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/timeline", r.URL.Path)
+				var body timelineRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				n := int(calls.Add(1)) - 1
+				tc.responses[min(n, len(tc.responses)-1)](w)
+			}))
+			defer server.Close()
+
+			repo, err := NewAlgorithmRepository(server.URL, testAlgorithmOptions)
+			require.NoError(t, err)
+			ids, err := repo.Timeline(context.Background(), uuid.New())
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, tc.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestAlgorithmRepository_CircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	var healthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"posts":[]}`))
+	}))
+	defer server.Close()
+
+	options := testAlgorithmOptions
+	options.MaxRetries = 0
+	repo, err := NewAlgorithmRepository(server.URL, options)
+	require.NoError(t, err)
+	now := time.Now()
+	repo.breaker.now = func() time.Time { return now }
+
+	// Two failed calls open the breaker
+	for i := 0; i < 2; i++ {
+		_, err := repo.Timeline(context.Background(), uuid.New())
+		assert.Error(t, err)
+	}
+	_, err = repo.Timeline(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// After the cooldown a trial call goes through and closes the breaker
+	healthy.Store(true)
+	now = now.Add(options.BreakerCooldown)
+	_, err = repo.Timeline(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	_, err = repo.Timeline(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestAlgorithmRepository_Budget(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	// A hanging service is given up on after the budget, not after every retry
+	options := testAlgorithmOptions
+	options.Budget = 150 * time.Millisecond
+	repo, err := NewAlgorithmRepository(server.URL, options)
+	require.NoError(t, err)
+	start := time.Now()
+	_, err = repo.Timeline(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 190*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// A call canceled by the caller does not count against the breaker
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < options.BreakerThreshold; i++ {
+		_, err = repo.Timeline(ctx, uuid.New())
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	assert.True(t, repo.breaker.allow())
+}
@@ -11,6 +11,8 @@ import (
 	"os"
 	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
@@ -27,11 +29,13 @@ type CognitoRepository struct {
 	clientId      string
 	jwksClient    *jwk.AutoRefresh
 	cognitoClient *cognitoidentityprovider.Client
+	verifier      *AccessTokenVerifier
 }
 
-func NewCognitoRepository() *CognitoRepository {
+// NewCognitoRepository shares revocations of access tokens between instances through db, and
+// caches the lookups for revocationCacheTTL.
+func NewCognitoRepository(db *ent.Client) *CognitoRepository {
 	region := os.Getenv("AWS_REGION")
-	fmt.Println("region", region)
 	userPoolId := os.Getenv("AWS_COGNITO_POOL_ID")
 	jwksURL := fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s/.well-known/jwks.json", region, userPoolId)
 	secret := os.Getenv("AWS_COGNITO_CLIENT_SECRET")
@@ -51,6 +55,11 @@ func NewCognitoRepository() *CognitoRepository {
 		log.Fatalf("Failed to refresh JWK endpoint: %v", err)
 	}
 
+	issuer := fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s", region, userPoolId)
+	verifier := NewAccessTokenVerifier(issuer, clientId, func(ctx context.Context) (jwk.Set, error) {
+		return jwksClient.Fetch(ctx, jwksURL)
+	}, newCachedRevocationStore(NewTokenRevocationRepository(db), revocationCacheTTL))
+
 	return &CognitoRepository{
 		region:        region,
 		userPoolId:    userPoolId,
@@ -59,6 +68,7 @@ func NewCognitoRepository() *CognitoRepository {
 		clientId:      clientId,
 		jwksClient:    jwksClient,
 		cognitoClient: cognitoClient,
+		verifier:      verifier,
 	}
 }
 
@@ -242,7 +252,14 @@ func (r *CognitoRepository) GetUserEmail(accessToken string) (string, error) {
 	return email, nil
 }
 
+// VerifyAccessToken verifies the access token locally without calling Cognito.
+func (r *CognitoRepository) VerifyAccessToken(accessToken string) (*models.AccessTokenClaims, error) {
+	return r.verifier.Verify(accessToken)
+}
+
 func (r *CognitoRepository) SignOut(accessToken string) error {
+	claims, verifyErr := r.verifier.Verify(accessToken)
+
 	// Sign the user out of all devices
 	_, err := r.cognitoClient.GlobalSignOut(context.TODO(), &cognitoidentityprovider.GlobalSignOutInput{
 		AccessToken: aws.String(accessToken),
@@ -252,10 +269,19 @@ func (r *CognitoRepository) SignOut(accessToken string) error {
 		return fmt.Errorf("failed to sign user out: %w", err)
 	}
 
+	// ローカル検証ではトークンの失効を検知できないため、発行済みのトークンを拒否する
+	if verifyErr == nil {
+		if err := r.verifier.Revoke(claims.Subject); err != nil {
+			return fmt.Errorf("failed to revoke access tokens: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (r *CognitoRepository) Delete(accessToken string) error {
+	claims, verifyErr := r.verifier.Verify(accessToken)
+
 	_, err := r.cognitoClient.DeleteUser(context.TODO(), &cognitoidentityprovider.DeleteUserInput{
 		AccessToken: aws.String(accessToken),
 	})
@@ -264,6 +290,12 @@ func (r *CognitoRepository) Delete(accessToken string) error {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
+	if verifyErr == nil {
+		if err := r.verifier.Revoke(claims.Subject); err != nil {
+			return fmt.Errorf("failed to revoke access tokens: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -6,7 +6,9 @@ import (
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -74,6 +76,7 @@ func (r *BlockRelationRepository) BlockingUsers(fromID string) ([]*ent.User, err
 
 	blockingUsers, err := r.db.BlockRelation.Query().
 		Where(blockrelation.HasFromWith(user.ID(fromUUID))).
+		WithTo().
 		All(context.Background())
 	if err != nil {
 		return nil, err
@@ -92,6 +95,7 @@ func (r *BlockRelationRepository) BlockedByUsers(toID string) ([]*ent.User, erro
 	}
 	blockedByUsers, err := r.db.BlockRelation.Query().
 		Where(blockrelation.HasToWith(user.ID(toUUID))).
+		WithFrom().
 		All(context.Background())
 	if err != nil {
 		return nil, err
@@ -102,3 +106,53 @@ func (r *BlockRelationRepository) BlockedByUsers(toID string) ([]*ent.User, erro
 	}
 	return users, nil
 }
+
+// Exists reports whether fromID blocks toID.
+func (r *BlockRelationRepository) Exists(fromID, toID uuid.UUID) (bool, error) {
+	return r.db.BlockRelation.Query().
+		Where(
+			blockrelation.HasFromWith(user.ID(fromID)),
+			blockrelation.HasToWith(user.ID(toID)),
+		).
+		Exist(context.Background())
+}
+
+// IsBlockedBetween reports whether either user blocks the other.
+func (r *BlockRelationRepository) IsBlockedBetween(userID, otherID uuid.UUID) (bool, error) {
+	return r.db.BlockRelation.Query().
+		Where(blockrelation.Or(
+			blockrelation.And(
+				blockrelation.HasFromWith(user.ID(userID)),
+				blockrelation.HasToWith(user.ID(otherID)),
+			),
+			blockrelation.And(
+				blockrelation.HasFromWith(user.ID(otherID)),
+				blockrelation.HasToWith(user.ID(userID)),
+			),
+		)).
+		Exist(context.Background())
+}
+
+// BlockedUserIDs returns the users the user blocks or is blocked by.
+func (r *BlockRelationRepository) BlockedUserIDs(userID uuid.UUID) ([]uuid.UUID, error) {
+	return r.db.User.Query().
+		Where(user.Or(
+			user.HasBlockingWith(blockrelation.HasToWith(user.ID(userID))),
+			user.HasBlockedByWith(blockrelation.HasFromWith(user.ID(userID))),
+		)).
+		IDs(context.Background())
+}
+
+// ListBlocking returns a page of the users the user blocks, newest block first.
+func (r *BlockRelationRepository) ListBlocking(fromID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.BlockRelation, error) {
+	query := r.db.BlockRelation.Query().
+		Where(blockrelation.HasFromWith(user.ID(fromID))).
+		WithTo()
+	if cursor != nil {
+		query = query.Where(predicate.BlockRelation(afterCursor(cursor)))
+	}
+	return query.
+		Order(ent.Desc(blockrelation.FieldCreatedAt), ent.Desc(blockrelation.FieldID)).
+		Limit(limit).
+		All(context.Background())
+}
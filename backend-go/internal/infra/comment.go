@@ -2,10 +2,19 @@ package infra
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/commentlike"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
 )
 
 type CommentRepository struct {
@@ -18,14 +27,50 @@ func NewCommentRepository(db *ent.Client) *CommentRepository {
 	}
 }
 
-func (r *CommentRepository) Create(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error) {
+func (r *CommentRepository) GetById(commentId string) (*ent.Comment, error) {
+	parsedCommentId, err := uuid.Parse(commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := r.db.Comment.Query().
+		Where(comment.IDEQ(parsedCommentId)).
+		WithUser().
+		WithPost(func(q *ent.PostQuery) { q.WithUser() }).
+		WithMentions(mentionedUsers).
+		Only(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// Create stores the comment, or a reply to parentId, with the users it mentions, and counts it
+// in the comment_count of the post in the same transaction.
+func (r *CommentRepository) Create(userId uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
 	// ① コメント作成
-	created, err := r.db.Comment.Create().
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := tx.Comment.Create().
 		SetUserID(userId).
 		SetPostID(postId).
+		SetNillableParentID(parentId).
 		SetContent(content).
-		Save(context.Background())
+		AddMentionIDs(mentionIds...).
+		Save(ctx)
+	if err == nil {
+		err = tx.Post.UpdateOneID(postId).AddCommentCount(1).Exec(ctx)
+	}
 	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -33,6 +78,7 @@ func (r *CommentRepository) Create(userId uuid.UUID, postId uuid.UUID, content s
 	commentWithUser, err := r.db.Comment.Query().
 		Where(comment.IDEQ(created.ID)).
 		WithUser().
+		WithMentions(mentionedUsers).
 		Only(context.Background())
 	if err != nil {
 		return nil, err
@@ -41,16 +87,264 @@ func (r *CommentRepository) Create(userId uuid.UUID, postId uuid.UUID, content s
 	return commentWithUser, nil
 }
 
+// Update replaces the content and the mentioned users of the comment and marks it as edited.
+func (r *CommentRepository) Update(commentId uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
+	err := r.db.Comment.UpdateOneID(commentId).
+		SetContent(content).
+		SetEditedAt(time.Now()).
+		ClearMentions().
+		AddMentionIDs(mentionIds...).
+		Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return r.db.Comment.Query().
+		Where(comment.IDEQ(commentId)).
+		WithUser().
+		WithMentions(mentionedUsers).
+		Only(context.Background())
+}
+
+// SoftDelete sets deleted_at. The comment and its replies are no longer visible, but are kept
+// for reports and moderation. They are taken out of the comment_count of the post.
+func (r *CommentRepository) SoftDelete(commentId uuid.UUID) error {
+	return r.removeComment(commentId, func(ctx context.Context, tx *ent.Tx) error {
+		return tx.Comment.UpdateOneID(commentId).
+			SetDeletedAt(time.Now()).
+			Exec(ctx)
+	})
+}
+
+// Delete removes the comment row and its replies, e.g. by a moderator. Comments already taken out
+// of the comment_count by SoftDelete are not subtracted again.
 func (r *CommentRepository) Delete(commentId string) error {
 	parsedCommentId, err := uuid.Parse(commentId)
 	if err != nil {
 		return err
 	}
 
-	err = r.db.Comment.DeleteOneID(parsedCommentId).Exec(context.Background())
+	return r.removeComment(parsedCommentId, func(ctx context.Context, tx *ent.Tx) error {
+		return tx.Comment.DeleteOneID(parsedCommentId).Exec(ctx)
+	})
+}
+
+// removeComment runs remove and subtracts the comments it takes away from the comment_count of
+// the post in one transaction.
+func (r *CommentRepository) removeComment(commentId uuid.UUID, remove func(ctx context.Context, tx *ent.Tx) error) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
 	if err != nil {
 		return err
 	}
+	err = func() error {
+		target, err := tx.Comment.Query().
+			Where(comment.ID(commentId)).
+			WithPost(func(q *ent.PostQuery) { q.Select(post.FieldID) }).
+			WithParent().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		counted, err := countedComments(ctx, tx, target)
+		if err != nil {
+			return err
+		}
+		if err := remove(ctx, tx); err != nil {
+			return err
+		}
+		if counted == 0 {
+			return nil
+		}
+		return tx.Post.UpdateOneID(target.Edges.Post.ID).AddCommentCount(-counted).Exec(ctx)
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// countedComments returns how many comments of the comment_count go away with the comment: none
+// if it or its parent is already deleted, and otherwise the comment and its replies not deleted.
+func countedComments(ctx context.Context, tx *ent.Tx, target *ent.Comment) (int, error) {
+	if target.DeletedAt != nil {
+		return 0, nil
+	}
+	if parent := target.Edges.Parent; parent != nil {
+		if parent.DeletedAt != nil {
+			return 0, nil
+		}
+		return 1, nil
+	}
+	replies, err := tx.Comment.Query().
+		Where(comment.ParentID(target.ID), comment.DeletedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return 1 + replies, nil
+}
+
+// SetHidden hides the comment after reports, or shows it again after a review.
+func (r *CommentRepository) SetHidden(commentId uuid.UUID, hidden bool) error {
+	update := r.db.Comment.UpdateOneID(commentId)
+	if hidden {
+		update = update.SetHiddenAt(time.Now())
+	} else {
+		update = update.ClearHiddenAt()
+	}
+	return update.Exec(context.Background())
+}
+
+func (r *CommentRepository) ListByPost(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error) {
+	query := r.db.Comment.Query().
+		Where(comment.HasPostWith(post.ID(postId)), comment.ParentIDIsNil(), commentVisibleTo(viewerID, time.Now())).
+		WithUser().
+		WithMentions(mentionedUsers)
+	if cursor != nil {
+		query = query.Where(predicate.Comment(afterCursor(cursor)))
+	}
+	return query.
+		Order(ent.Desc(comment.FieldCreatedAt), ent.Desc(comment.FieldID)).
+		Limit(limit).
+		All(context.Background())
+}
+
+// ListReplies returns the replies to the comment visible to the viewer, oldest first.
+func (r *CommentRepository) ListReplies(parentId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error) {
+	query := r.db.Comment.Query().
+		Where(comment.ParentID(parentId), commentVisibleTo(viewerID, time.Now())).
+		WithUser().
+		WithMentions(mentionedUsers)
+	if cursor != nil {
+		query = query.Where(predicate.Comment(afterCursorAsc(cursor)))
+	}
+	return query.
+		Order(ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)).
+		Limit(limit).
+		All(context.Background())
+}
+
+// Like likes the comment. Liking it again does nothing.
+func (r *CommentRepository) Like(commentId uuid.UUID, userId uuid.UUID) error {
+	return r.db.CommentLike.Create().
+		SetCommentID(commentId).
+		SetUserID(userId).
+		OnConflictColumns(commentlike.FieldCommentID, commentlike.FieldUserID).
+		Ignore().
+		Exec(context.Background())
+}
+
+func (r *CommentRepository) Unlike(commentId uuid.UUID, userId uuid.UUID) error {
+	_, err := r.db.CommentLike.Delete().
+		Where(commentlike.CommentID(commentId), commentlike.UserID(userId)).
+		Exec(context.Background())
+	return err
+}
+
+func (r *CommentRepository) GetStats(viewerID uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID]models.CommentStats, error) {
+	return commentStats(context.Background(), r.db, viewerID, commentIds, time.Now())
+}
+
+// commentStats counts the replies and likes of the comments with one grouped query each, as
+// PostRepository.GetStats does for posts.
+func commentStats(ctx context.Context, db *ent.Client, viewerID uuid.UUID, commentIds []uuid.UUID, now time.Time) (map[uuid.UUID]models.CommentStats, error) {
+	stats := make(map[uuid.UUID]models.CommentStats, len(commentIds))
+	if len(commentIds) == 0 {
+		return stats, nil
+	}
+
+	var replyCounts []struct {
+		ParentID uuid.UUID `json:"parent_id"`
+		Count    int       `json:"count"`
+	}
+	err := db.Comment.Query().
+		Where(comment.ParentIDIn(commentIds...), commentVisibleTo(viewerID, now)).
+		GroupBy(comment.FieldParentID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &replyCounts)
+	if err != nil {
+		log.Errorf("Failed to count replies: %v", err)
+		return nil, err
+	}
+	var likeCounts []struct {
+		CommentID uuid.UUID `json:"comment_id"`
+		Count     int       `json:"count"`
+	}
+	err = db.CommentLike.Query().
+		Where(commentlike.CommentIDIn(commentIds...), commentlike.HasUserWith(activeUser(now), notBlockedWith(viewerID))).
+		GroupBy(commentlike.FieldCommentID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &likeCounts)
+	if err != nil {
+		log.Errorf("Failed to count comment likes: %v", err)
+		return nil, err
+	}
+	likedIDs, err := db.CommentLike.Query().
+		Where(commentlike.UserID(viewerID), commentlike.CommentIDIn(commentIds...)).
+		QueryComment().
+		IDs(ctx)
+	if err != nil {
+		log.Errorf("Failed to get liked comments: %v", err)
+		return nil, err
+	}
 
-	return nil
+	for _, id := range commentIds {
+		stats[id] = models.CommentStats{}
+	}
+	for _, count := range replyCounts {
+		s := stats[count.ParentID]
+		s.RepliesCount = count.Count
+		stats[count.ParentID] = s
+	}
+	for _, count := range likeCounts {
+		s := stats[count.CommentID]
+		s.LikesCount = count.Count
+		stats[count.CommentID] = s
+	}
+	for _, id := range likedIDs {
+		s := stats[id]
+		s.LikedByMe = true
+		stats[id] = s
+	}
+	return stats, nil
+}
+
+// recentComments loads the newest limit top-level comments of each post visible to the viewer,
+// newest first, with one query: a window function numbers the comments of each post.
+func recentComments(ctx context.Context, db *ent.Client, viewerID uuid.UUID, postIds []uuid.UUID, limit int, now time.Time) (map[uuid.UUID][]*ent.Comment, error) {
+	visible := comment.And(comment.HasPostWith(post.IDIn(postIds...)), comment.ParentIDIsNil(), commentVisibleTo(viewerID, now))
+	comments, err := db.Comment.Query().
+		Where(func(s *sql.Selector) {
+			builder := sql.Dialect(s.Dialect())
+			t := builder.Table(comment.Table).As("recent")
+			position := sql.RowNumber().
+				PartitionBy(t.C(comment.PostColumn)).
+				OrderExpr(sql.DescExpr(sql.Expr(t.C(comment.FieldCreatedAt))), sql.DescExpr(sql.Expr(t.C(comment.FieldID))))
+			ranked := builder.
+				Select(t.C(comment.FieldID)).
+				AppendSelectExprAs(position, "position").
+				From(t)
+			visible(ranked)
+			ranked.As("ranked")
+			s.Where(sql.In(s.C(comment.FieldID), builder.
+				Select(ranked.C(comment.FieldID)).
+				From(ranked).
+				Where(sql.LTE(ranked.C("position"), limit))))
+		}).
+		WithUser().
+		WithMentions(mentionedUsers).
+		WithPost(func(q *ent.PostQuery) { q.Select(post.FieldID) }).
+		Order(ent.Desc(comment.FieldCreatedAt), ent.Desc(comment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recent := make(map[uuid.UUID][]*ent.Comment)
+	for _, c := range comments {
+		recent[c.Edges.Post.ID] = append(recent[c.Edges.Post.ID], c)
+	}
+	return recent, nil
 }
@@ -0,0 +1,126 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commentIDs(comments []*ent.Comment) []uuid.UUID {
+	ids := make([]uuid.UUID, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.ID
+	}
+	return ids
+}
+
+func TestCommentRepository_Replies(t *testing.T) {
+	client := newTestClient(t)
+	commentRepo := NewCommentRepository(client)
+	postRepo := NewPostRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	post := createTestPost(t, client, alice)
+	parent, err := commentRepo.Create(alice.ID, post.ID, nil, "parent", nil)
+	require.NoError(t, err)
+	first, err := commentRepo.Create(bob.ID, post.ID, &parent.ID, "first", nil)
+	require.NoError(t, err)
+	second, err := commentRepo.Create(alice.ID, post.ID, &parent.ID, "second", nil)
+	require.NoError(t, err)
+	// 同じ時刻でも ID で順序が決まるように揃える
+	createdAt := time.Now().Add(-time.Minute)
+	for _, id := range []uuid.UUID{first.ID, second.ID} {
+		require.NoError(t, client.Comment.UpdateOneID(id).SetCreatedAt(createdAt).Exec(context.Background()))
+	}
+	oldest, newest := first.ID, second.ID
+	if newest.String() < oldest.String() {
+		oldest, newest = newest, oldest
+	}
+
+	// Only top-level comments are listed and previewed
+	comments, err := commentRepo.ListByPost(post.ID, bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{parent.ID}, commentIDs(comments))
+	stats, err := postRepo.GetStats(bob.ID, []uuid.UUID{post.ID}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats[post.ID].CommentsCount, "replies are counted")
+	assert.Equal(t, []uuid.UUID{parent.ID}, commentIDs(stats[post.ID].RecentComments))
+	assert.Equal(t, 2, stats[post.ID].CommentStats[parent.ID].RepliesCount)
+
+	// Replies are listed oldest first
+	replies, err := commentRepo.ListReplies(parent.ID, bob.ID, nil, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{oldest}, commentIDs(replies))
+	replies, err = commentRepo.ListReplies(parent.ID, bob.ID, &models.Cursor{CreatedAt: replies[0].CreatedAt, ID: replies[0].ID}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{newest}, commentIDs(replies))
+
+	// Replies disappear with their deleted parent
+	require.NoError(t, commentRepo.SoftDelete(parent.ID))
+	replies, err = commentRepo.ListReplies(parent.ID, bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, replies)
+	stats, err = postRepo.GetStats(bob.ID, []uuid.UUID{post.ID}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats[post.ID].CommentsCount)
+	deleted, err := commentRepo.GetById(parent.ID.String())
+	require.NoError(t, err)
+	assert.NotNil(t, deleted.DeletedAt)
+	assert.Equal(t, alice.ID, deleted.Edges.Post.Edges.User.ID)
+}
+
+func TestCommentRepository_UpdateAndLikes(t *testing.T) {
+	client := newTestClient(t)
+	commentRepo := NewCommentRepository(client)
+	blockRepo := NewBlockRelationRepository(client)
+	notificationRepo := NewNotificationRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+	post := createTestPost(t, client, alice)
+	comment, err := commentRepo.Create(alice.ID, post.ID, nil, "hello", []uuid.UUID{bob.ID})
+	require.NoError(t, err)
+	assert.Nil(t, comment.EditedAt)
+
+	updated, err := commentRepo.Update(comment.ID, "hello again", []uuid.UUID{carol.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "hello again", updated.Content)
+	assert.NotNil(t, updated.EditedAt)
+	assert.Equal(t, []uuid.UUID{carol.ID}, userIDs(updated.Edges.Mentions))
+
+	// Liking twice keeps one like
+	require.NoError(t, commentRepo.Like(comment.ID, bob.ID))
+	require.NoError(t, commentRepo.Like(comment.ID, bob.ID))
+	require.NoError(t, commentRepo.Like(comment.ID, carol.ID))
+	stats, err := commentRepo.GetStats(bob.ID, []uuid.UUID{comment.ID})
+	require.NoError(t, err)
+	assert.Equal(t, models.CommentStats{LikesCount: 2, LikedByMe: true}, stats[comment.ID])
+
+	// Likes of users with a block relation to the viewer are not counted
+	require.NoError(t, blockRepo.Create(alice.ID.String(), carol.ID.String()))
+	stats, err = commentRepo.GetStats(alice.ID, []uuid.UUID{comment.ID})
+	require.NoError(t, err)
+	assert.Equal(t, models.CommentStats{LikesCount: 1}, stats[comment.ID])
+
+	require.NoError(t, commentRepo.Unlike(comment.ID, bob.ID))
+	stats, err = commentRepo.GetStats(bob.ID, []uuid.UUID{comment.ID})
+	require.NoError(t, err)
+	assert.Equal(t, models.CommentStats{LikesCount: 1}, stats[comment.ID])
+
+	// Removing a mention from the comment keeps the mentions in the caption
+	require.NoError(t, notificationRepo.CreateMentions(alice.ID, []uuid.UUID{bob.ID}, post.ID, nil))
+	require.NoError(t, notificationRepo.CreateMentions(alice.ID, []uuid.UUID{bob.ID}, post.ID, &comment.ID))
+	require.NoError(t, notificationRepo.DeleteCommentMentions(comment.ID, []uuid.UUID{bob.ID}))
+	notifications, err := notificationRepo.ListByRecipient(bob.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Nil(t, notifications[0].CommentID)
+}
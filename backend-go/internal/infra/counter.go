@@ -0,0 +1,258 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/datamigration"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// counterBatchSize is the number of posts or users recounted per query.
+const counterBatchSize = 1000
+
+// counterBackfillMigration names the recount of the counter columns, which the schema migration
+// adds with 0, in the data_migrations table.
+const counterBackfillMigration = "backfill-counters"
+
+// CounterRepository recomputes the counter columns of posts and users from their edges.
+type CounterRepository struct {
+	db *ent.Client
+}
+
+func NewCounterRepository(db *ent.Client) *CounterRepository {
+	return &CounterRepository{
+		db: db,
+	}
+}
+
+// countedComment matches the comments in the comment_count of a post: not deleted, and not a
+// reply to a deleted comment.
+func countedComment() predicate.Comment {
+	return comment.And(
+		comment.DeletedAtIsNil(),
+		comment.Or(comment.ParentIDIsNil(), comment.HasParentWith(comment.DeletedAtIsNil())),
+	)
+}
+
+func likeCounts(ctx context.Context, likes *ent.LikeClient, postIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	var rows []struct {
+		PostID uuid.UUID `json:"post_likes"`
+		Count  int       `json:"count"`
+	}
+	err := likes.Query().
+		Where(like.HasPostWith(post.IDIn(postIDs...))).
+		GroupBy(like.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, err
+}
+
+func commentCounts(ctx context.Context, comments *ent.CommentClient, postIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	var rows []struct {
+		PostID uuid.UUID `json:"post_comments"`
+		Count  int       `json:"count"`
+	}
+	err := comments.Query().
+		Where(comment.HasPostWith(post.IDIn(postIDs...)), countedComment()).
+		GroupBy(comment.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, err
+}
+
+// Reconcile recounts every post and user and fixes the counters that drifted. It returns how
+// many posts and users were fixed. A counter changed while it is recounted is left to the next run.
+func (r *CounterRepository) Reconcile() (int, int, error) {
+	ctx := context.Background()
+	posts, err := r.reconcilePosts(ctx)
+	if err != nil {
+		return posts, 0, err
+	}
+	users, err := r.reconcileUsers(ctx)
+	return posts, users, err
+}
+
+// Backfill runs Reconcile once per database, so the counters of the rows created before the
+// counter columns are filled without a manual step. Later calls only look up the marker row.
+// Instances starting together may both recount, which Reconcile allows; a failed recount is
+// retried on the next start.
+func (r *CounterRepository) Backfill() error {
+	ctx := context.Background()
+	done, err := r.db.DataMigration.Query().
+		Where(datamigration.ID(counterBackfillMigration)).
+		Exist(ctx)
+	if err != nil || done {
+		return err
+	}
+	posts, users, err := r.Reconcile()
+	if err != nil {
+		return err
+	}
+	log.Infof("Backfilled counters of %d posts and %d users", posts, users)
+	return r.db.DataMigration.Create().
+		SetID(counterBackfillMigration).
+		SetAppliedAt(time.Now()).
+		OnConflictColumns(datamigration.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
+func (r *CounterRepository) reconcilePosts(ctx context.Context) (int, error) {
+	fixed := 0
+	var last *uuid.UUID
+	for {
+		// 件数より先にカウンタを読み、更新はカウンタが変わっていない場合だけにする
+		query := r.db.Post.Query().
+			Select(post.FieldID, post.FieldLikeCount, post.FieldCommentCount).
+			Order(ent.Asc(post.FieldID)).
+			Limit(counterBatchSize)
+		if last != nil {
+			query = query.Where(post.IDGT(*last))
+		}
+		posts, err := query.All(ctx)
+		if err != nil || len(posts) == 0 {
+			return fixed, err
+		}
+		ids := make([]uuid.UUID, len(posts))
+		for i, p := range posts {
+			ids[i] = p.ID
+		}
+		likes, err := likeCounts(ctx, r.db.Like, ids)
+		if err != nil {
+			return fixed, err
+		}
+		comments, err := commentCounts(ctx, r.db.Comment, ids)
+		if err != nil {
+			return fixed, err
+		}
+		for _, p := range posts {
+			if p.LikeCount == likes[p.ID] && p.CommentCount == comments[p.ID] {
+				continue
+			}
+			n, err := r.db.Post.Update().
+				Where(post.ID(p.ID), post.LikeCount(p.LikeCount), post.CommentCount(p.CommentCount)).
+				SetLikeCount(likes[p.ID]).
+				SetCommentCount(comments[p.ID]).
+				Save(ctx)
+			if err != nil {
+				return fixed, err
+			}
+			if n > 0 {
+				log.Infof("Fixed counters of post %s: likes %d -> %d, comments %d -> %d", p.ID, p.LikeCount, likes[p.ID], p.CommentCount, comments[p.ID])
+				fixed++
+			}
+		}
+		last = &posts[len(posts)-1].ID
+	}
+}
+
+func (r *CounterRepository) reconcileUsers(ctx context.Context) (int, error) {
+	fixed := 0
+	var last *uuid.UUID
+	for {
+		query := r.db.User.Query().
+			Select(user.FieldID, user.FieldFollowerCount, user.FieldFollowingCount, user.FieldPostCount).
+			Order(ent.Asc(user.FieldID)).
+			Limit(counterBatchSize)
+		if last != nil {
+			query = query.Where(user.IDGT(*last))
+		}
+		users, err := query.All(ctx)
+		if err != nil || len(users) == 0 {
+			return fixed, err
+		}
+		ids := make([]uuid.UUID, len(users))
+		for i, u := range users {
+			ids[i] = u.ID
+		}
+		var followerRows []struct {
+			UserID uuid.UUID `json:"user_followers"`
+			Count  int       `json:"count"`
+		}
+		err = r.db.FollowRelation.Query().
+			Where(followrelation.HasToWith(user.IDIn(ids...))).
+			GroupBy(followrelation.ToColumn).
+			Aggregate(ent.Count()).
+			Scan(ctx, &followerRows)
+		if err != nil {
+			return fixed, err
+		}
+		var followingRows []struct {
+			UserID uuid.UUID `json:"user_following"`
+			Count  int       `json:"count"`
+		}
+		err = r.db.FollowRelation.Query().
+			Where(followrelation.HasFromWith(user.IDIn(ids...))).
+			GroupBy(followrelation.FromColumn).
+			Aggregate(ent.Count()).
+			Scan(ctx, &followingRows)
+		if err != nil {
+			return fixed, err
+		}
+		var postRows []struct {
+			UserID uuid.UUID `json:"user_posts"`
+			Count  int       `json:"count"`
+		}
+		err = r.db.Post.Query().
+			Where(post.HasUserWith(user.IDIn(ids...)), post.DeletedAtIsNil()).
+			GroupBy(post.UserColumn).
+			Aggregate(ent.Count()).
+			Scan(ctx, &postRows)
+		if err != nil {
+			return fixed, err
+		}
+		followers, following, postCounts := map[uuid.UUID]int{}, map[uuid.UUID]int{}, map[uuid.UUID]int{}
+		for _, row := range followerRows {
+			followers[row.UserID] = row.Count
+		}
+		for _, row := range followingRows {
+			following[row.UserID] = row.Count
+		}
+		for _, row := range postRows {
+			postCounts[row.UserID] = row.Count
+		}
+
+		for _, u := range users {
+			if u.FollowerCount == followers[u.ID] && u.FollowingCount == following[u.ID] && u.PostCount == postCounts[u.ID] {
+				continue
+			}
+			n, err := r.db.User.Update().
+				Where(
+					user.ID(u.ID),
+					user.FollowerCount(u.FollowerCount),
+					user.FollowingCount(u.FollowingCount),
+					user.PostCount(u.PostCount),
+				).
+				SetFollowerCount(followers[u.ID]).
+				SetFollowingCount(following[u.ID]).
+				SetPostCount(postCounts[u.ID]).
+				Save(ctx)
+			if err != nil {
+				return fixed, err
+			}
+			if n > 0 {
+				log.Infof("Fixed counters of user %s: followers %d -> %d, following %d -> %d, posts %d -> %d",
+					u.ID, u.FollowerCount, followers[u.ID], u.FollowingCount, following[u.ID], u.PostCount, postCounts[u.ID])
+				fixed++
+			}
+		}
+		last = &users[len(users)-1].ID
+	}
+}
@@ -0,0 +1,188 @@
+package infra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postCounters(t *testing.T, client *ent.Client, id uuid.UUID) (int, int) {
+	t.Helper()
+	p, err := client.Post.Get(context.Background(), id)
+	require.NoError(t, err)
+	return p.LikeCount, p.CommentCount
+}
+
+func userCounters(t *testing.T, client *ent.Client, id uuid.UUID) [3]int {
+	t.Helper()
+	u, err := client.User.Get(context.Background(), id)
+	require.NoError(t, err)
+	return [3]int{u.FollowerCount, u.FollowingCount, u.PostCount}
+}
+
+func TestCounters_LikesAndComments(t *testing.T) {
+	client := newTestClient(t)
+	likeRepo := NewLikeRepository(client)
+	commentRepo := NewCommentRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	post := createTestPost(t, client, alice)
+
+	// Changing the reaction keeps one like
+	require.NoError(t, likeRepo.Create(bob.ID.String(), post.ID.String(), enum.ReactionTypePaw))
+	require.NoError(t, likeRepo.Create(bob.ID.String(), post.ID.String(), enum.ReactionTypeHeart))
+	require.NoError(t, likeRepo.Create(alice.ID.String(), post.ID.String(), enum.ReactionTypePaw))
+	likes, _ := postCounters(t, client, post.ID)
+	assert.Equal(t, 2, likes)
+	require.NoError(t, likeRepo.Delete(bob.ID.String(), post.ID.String()))
+	require.NoError(t, likeRepo.Delete(bob.ID.String(), post.ID.String()))
+	count, err := likeRepo.Count(post.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	parent, err := commentRepo.Create(alice.ID, post.ID, nil, "parent", nil)
+	require.NoError(t, err)
+	reply, err := commentRepo.Create(bob.ID, post.ID, &parent.ID, "reply", nil)
+	require.NoError(t, err)
+	_, err = commentRepo.Create(alice.ID, post.ID, &parent.ID, "reply", nil)
+	require.NoError(t, err)
+	other, err := commentRepo.Create(bob.ID, post.ID, nil, "other", nil)
+	require.NoError(t, err)
+	_, comments := postCounters(t, client, post.ID)
+	assert.Equal(t, 4, comments)
+
+	require.NoError(t, commentRepo.SoftDelete(reply.ID))
+	_, comments = postCounters(t, client, post.ID)
+	assert.Equal(t, 3, comments)
+
+	// The replies go away with their parent, and are not subtracted again
+	require.NoError(t, commentRepo.SoftDelete(parent.ID))
+	_, comments = postCounters(t, client, post.ID)
+	assert.Equal(t, 1, comments)
+	require.NoError(t, commentRepo.Delete(parent.ID.String()))
+	require.NoError(t, commentRepo.Delete(other.ID.String()))
+	_, comments = postCounters(t, client, post.ID)
+	assert.Equal(t, 0, comments)
+}
+
+func TestCounters_FollowsAndPosts(t *testing.T) {
+	client := newTestClient(t)
+	followRepo := NewFollowRelationRepository(client)
+	postRepo := NewPostRepository(client)
+	userRepo := NewUserRepository(client)
+	likeRepo := NewLikeRepository(client)
+	commentRepo := NewCommentRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+
+	require.NoError(t, followRepo.Follow(alice.ID.String(), bob.ID.String()))
+	require.NoError(t, followRepo.Follow(alice.ID.String(), carol.ID.String()))
+	require.NoError(t, followRepo.Follow(carol.ID.String(), bob.ID.String()))
+	assert.Equal(t, [3]int{2, 0, 0}, userCounters(t, client, alice.ID))
+	assert.Equal(t, [3]int{0, 2, 0}, userCounters(t, client, bob.ID))
+	require.NoError(t, followRepo.Unfollow(alice.ID.String(), carol.ID.String()))
+	require.NoError(t, followRepo.Unfollow(alice.ID.String(), carol.ID.String()))
+	assert.Equal(t, [3]int{1, 0, 0}, userCounters(t, client, alice.ID))
+	assert.Equal(t, [3]int{1, 0, 0}, userCounters(t, client, carol.ID))
+
+	media := []models.PostMediaInput{{Key: "posts/walk.jpg"}}
+	first, err := postRepo.CreatePost("walk", alice.ID.String(), media, nil, nil, nil, nil)
+	require.NoError(t, err)
+	second, err := postRepo.CreatePost("nap", alice.ID.String(), media, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, userCounters(t, client, alice.ID)[2])
+	require.NoError(t, postRepo.DeletePost(first.ID.String()))
+	require.NoError(t, postRepo.DeletePost(first.ID.String()))
+	require.NoError(t, postRepo.HardDeletePost(first.ID))
+	assert.Equal(t, 1, userCounters(t, client, alice.ID)[2])
+
+	// Deleting a user takes their follows, likes and comments out of the counters of others
+	require.NoError(t, likeRepo.Create(bob.ID.String(), second.ID.String(), enum.ReactionTypePaw))
+	parent, err := commentRepo.Create(bob.ID, second.ID, nil, "parent", nil)
+	require.NoError(t, err)
+	_, err = commentRepo.Create(carol.ID, second.ID, &parent.ID, "reply", nil)
+	require.NoError(t, err)
+	_, err = commentRepo.Create(carol.ID, second.ID, nil, "other", nil)
+	require.NoError(t, err)
+	require.NoError(t, userRepo.Delete(bob.ID))
+	assert.Equal(t, [3]int{0, 0, 1}, userCounters(t, client, alice.ID))
+	assert.Equal(t, [3]int{0, 0, 0}, userCounters(t, client, carol.ID))
+	likes, comments := postCounters(t, client, second.ID)
+	assert.Equal(t, 0, likes)
+	assert.Equal(t, 1, comments)
+}
+
+func TestCounterRepository_Reconcile(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	repo := NewCounterRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	// Rows written without the repositories leave the counters at zero, like rows created
+	// before the counters were introduced
+	post := createTestPost(t, client, alice)
+	deleted := createTestPost(t, client, alice)
+	require.NoError(t, deleted.Update().SetDeletedAt(deleted.CreatedAt).Exec(ctx))
+	_, err := client.Like.Create().SetPost(post).SetUser(bob).Save(ctx)
+	require.NoError(t, err)
+	parent, err := client.Comment.Create().SetContent("parent").SetPost(post).SetUser(bob).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Comment.Create().SetContent("reply").SetPost(post).SetUser(alice).SetParent(parent).Save(ctx)
+	require.NoError(t, err)
+	gone, err := client.Comment.Create().SetContent("gone").SetPost(post).SetUser(bob).SetDeletedAt(parent.CreatedAt).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Comment.Create().SetContent("reply").SetPost(post).SetUser(alice).SetParent(gone).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.FollowRelation.Create().SetFrom(bob).SetTo(alice).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, bob.Update().SetPostCount(7).Exec(ctx))
+
+	posts, users, err := repo.Reconcile()
+	require.NoError(t, err)
+	assert.Equal(t, 1, posts)
+	assert.Equal(t, 2, users)
+	likes, comments := postCounters(t, client, post.ID)
+	assert.Equal(t, 1, likes)
+	assert.Equal(t, 2, comments)
+	assert.Equal(t, [3]int{1, 0, 1}, userCounters(t, client, alice.ID))
+	assert.Equal(t, [3]int{0, 1, 0}, userCounters(t, client, bob.ID))
+
+	// Counters already right are left alone
+	posts, users, err = repo.Reconcile()
+	require.NoError(t, err)
+	assert.Equal(t, 0, posts)
+	assert.Equal(t, 0, users)
+}
+
+func TestCounterRepository_Backfill(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	repo := NewCounterRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	post := createTestPost(t, client, alice)
+	_, err := client.Like.Create().SetPost(post).SetUser(bob).Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Backfill())
+	likes, _ := postCounters(t, client, post.ID)
+	assert.Equal(t, 1, likes)
+	assert.Equal(t, [3]int{0, 0, 1}, userCounters(t, client, alice.ID))
+
+	// Later starts do not recount again
+	require.NoError(t, post.Update().SetLikeCount(5).Exec(ctx))
+	require.NoError(t, repo.Backfill())
+	likes, _ = postCounters(t, client, post.ID)
+	assert.Equal(t, 5, likes)
+}
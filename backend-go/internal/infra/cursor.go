@@ -0,0 +1,33 @@
+package infra
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+)
+
+// afterCursor matches rows that come after the cursor when ordered by (created_at, id) descending.
+// It works for every entity with created_at and id columns, e.g. predicate.FollowRelation(afterCursor(c)).
+func afterCursor(cursor *models.Cursor) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.LT(s.C("created_at"), cursor.CreatedAt),
+			sql.And(
+				sql.EQ(s.C("created_at"), cursor.CreatedAt),
+				sql.LT(s.C("id"), cursor.ID),
+			),
+		))
+	}
+}
+
+// afterCursorAsc is afterCursor for rows ordered by (created_at, id) ascending, e.g. replies.
+func afterCursorAsc(cursor *models.Cursor) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.GT(s.C("created_at"), cursor.CreatedAt),
+			sql.And(
+				sql.EQ(s.C("created_at"), cursor.CreatedAt),
+				sql.GT(s.C("id"), cursor.ID),
+			),
+		))
+	}
+}
@@ -59,3 +59,15 @@ func (r *DeviceTokenRepository) Upsert(userID string, deviceID string, token str
 	// その他のエラーはそのまま返す
 	return err
 }
+
+func (r *DeviceTokenRepository) GetByUser(userID uuid.UUID) ([]*ent.DeviceToken, error) {
+	tokens, err := r.db.DeviceToken.
+		Query().
+		Where(devicetoken.UserID(userID)).
+		Order(ent.Desc(devicetoken.FieldUpdatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
@@ -0,0 +1,164 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/exploreranking"
+	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+type ExploreRepository struct {
+	db *ent.Client
+}
+
+func NewExploreRepository(db *ent.Client) *ExploreRepository {
+	return &ExploreRepository{
+		db: db,
+	}
+}
+
+// ListCandidates leaves out posts of private users, which should not spread beyond their followers.
+func (r *ExploreRepository) ListCandidates(since time.Time) ([]models.ExploreCandidate, error) {
+	ctx := context.Background()
+	now := time.Now()
+	posts, err := r.db.Post.Query().
+		Where(visiblePost(now), post.CreatedAtGTE(since), post.HasUserWith(user.IsPrivate(false))).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldStreakCount)
+		}).
+		Select(post.FieldID, post.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		log.Errorf("Failed to get explore candidates: %v", err)
+		return nil, err
+	}
+
+	// 件数は投稿ごとに数えず、期間内の投稿についてまとめて集計する
+	var likeCounts []struct {
+		PostID uuid.UUID `json:"post_likes"`
+		Count  int       `json:"count"`
+	}
+	err = r.db.Like.Query().
+		Where(like.HasPostWith(post.CreatedAtGTE(since))).
+		GroupBy(like.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &likeCounts)
+	if err != nil {
+		log.Errorf("Failed to count likes: %v", err)
+		return nil, err
+	}
+	var commentCounts []struct {
+		PostID uuid.UUID `json:"post_comments"`
+		Count  int       `json:"count"`
+	}
+	err = r.db.Comment.Query().
+		Where(comment.HasPostWith(post.CreatedAtGTE(since)), visibleComment(now)).
+		GroupBy(comment.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &commentCounts)
+	if err != nil {
+		log.Errorf("Failed to count comments: %v", err)
+		return nil, err
+	}
+
+	likes := make(map[uuid.UUID]int, len(likeCounts))
+	for _, count := range likeCounts {
+		likes[count.PostID] = count.Count
+	}
+	comments := make(map[uuid.UUID]int, len(commentCounts))
+	for _, count := range commentCounts {
+		comments[count.PostID] = count.Count
+	}
+	candidates := make([]models.ExploreCandidate, len(posts))
+	for i, p := range posts {
+		candidates[i] = models.ExploreCandidate{
+			PostID:        p.ID,
+			AuthorID:      p.Edges.User.ID,
+			CreatedAt:     p.CreatedAt,
+			LikesCount:    likes[p.ID],
+			CommentsCount: comments[p.ID],
+			AuthorStreak:  p.Edges.User.StreakCount,
+		}
+	}
+	return candidates, nil
+}
+
+// ReplaceRanking deletes the previous ranking and inserts the new one in one transaction,
+// so readers see either the old or the new ranking and never a mix of both.
+func (r *ExploreRepository) ReplaceRanking(scores []models.ExploreScore) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := replaceRanking(ctx, tx, scores); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			log.Errorf("Failed to rollback explore ranking: %v", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// exploreRankingBatchSize keeps each insert well below the bind parameter limit of PostgreSQL.
+const exploreRankingBatchSize = 1000
+
+func replaceRanking(ctx context.Context, tx *ent.Tx, scores []models.ExploreScore) error {
+	if _, err := tx.ExploreRanking.Delete().Exec(ctx); err != nil {
+		return err
+	}
+	computedAt := time.Now()
+	for start := 0; start < len(scores); start += exploreRankingBatchSize {
+		batch := scores[start:min(start+exploreRankingBatchSize, len(scores))]
+		builders := make([]*ent.ExploreRankingCreate, len(batch))
+		for i, score := range batch {
+			builders[i] = tx.ExploreRanking.Create().
+				SetPostID(score.PostID).
+				SetPosition(start + i + 1).
+				SetScore(score.Score).
+				SetComputedAt(computedAt)
+		}
+		if err := tx.ExploreRanking.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ExploreRepository) GetRanked(viewerID uuid.UUID, afterPosition int, limit int) ([]*ent.ExploreRanking, error) {
+	ctx := context.Background()
+	now := time.Now()
+	notMuted, err := notMutedBy(ctx, r.db, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	rankings, err := r.db.ExploreRanking.Query().
+		Where(
+			exploreranking.PositionGT(afterPosition),
+			exploreranking.HasPostWith(postVisibleTo(viewerID, now), notMuted),
+		).
+		WithPost(func(q *ent.PostQuery) {
+			q.WithUser().
+				WithDailyTask().
+				WithMedia(orderedMedia).
+				WithPets(orderedPets).
+				WithMentions(mentionedUsers).
+				Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldMediaType)
+		}).
+		Order(ent.Asc(exploreranking.FieldPosition)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		log.Errorf("Failed to get explore ranking: %v", err)
+		return nil, err
+	}
+	return rankings, nil
+}
@@ -0,0 +1,113 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExploreRepository_ListCandidates(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	repo := NewExploreRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	private, err := client.User.Create().SetName("private").SetEmail("private@example.com").SetIsPrivate(true).Save(ctx)
+	require.NoError(t, err)
+	alice, err = alice.Update().SetStreakCount(5).Save(ctx)
+	require.NoError(t, err)
+
+	popular := createTestPost(t, client, alice)
+	quiet := createTestPost(t, client, bob)
+	createTestPost(t, client, private)
+	old, err := client.Post.Create().SetCaption("old").SetImageKey("posts/old").SetUser(bob).
+		SetCreatedAt(time.Now().Add(-30 * 24 * time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Like.Create().SetPost(old).SetUser(alice).Save(ctx)
+	require.NoError(t, err)
+	for _, u := range []*ent.User{alice, bob} {
+		_, err = client.Like.Create().SetPost(popular).SetUser(u).Save(ctx)
+		require.NoError(t, err)
+	}
+	_, err = client.Comment.Create().SetContent("かわいい").SetPost(popular).SetUser(bob).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Comment.Create().SetContent("hidden").SetPost(popular).SetUser(bob).SetHiddenAt(time.Now()).Save(ctx)
+	require.NoError(t, err)
+
+	candidates, err := repo.ListCandidates(time.Now().Add(-7 * 24 * time.Hour))
+	require.NoError(t, err)
+
+	// Old posts and posts of private users are not candidates
+	byID := make(map[uuid.UUID]models.ExploreCandidate)
+	for _, candidate := range candidates {
+		byID[candidate.PostID] = candidate
+	}
+	require.Len(t, byID, 2)
+	assert.Equal(t, alice.ID, byID[popular.ID].AuthorID)
+	assert.Equal(t, 2, byID[popular.ID].LikesCount)
+	assert.Equal(t, 1, byID[popular.ID].CommentsCount)
+	assert.Equal(t, uint32(5), byID[popular.ID].AuthorStreak)
+	assert.Equal(t, 0, byID[quiet.ID].LikesCount)
+	assert.Equal(t, 0, byID[quiet.ID].CommentsCount)
+}
+
+func TestExploreRepository_GetRanked(t *testing.T) {
+	client := newTestClient(t)
+	repo := NewExploreRepository(client)
+	blockRepo := NewBlockRelationRepository(client)
+	muteRepo := NewMuteRelationRepository(client)
+
+	viewer := createTestUser(t, client, "viewer")
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+	alices := createTestPost(t, client, alice)
+	bobs := createTestPost(t, client, bob)
+	carols := createTestPost(t, client, carol)
+	alices2 := createTestPost(t, client, alice)
+
+	// A stale ranking is replaced as a whole
+	require.NoError(t, repo.ReplaceRanking([]models.ExploreScore{{PostID: alices2.ID, Score: 9}}))
+	require.NoError(t, repo.ReplaceRanking([]models.ExploreScore{
+		{PostID: bobs.ID, Score: 4},
+		{PostID: alices.ID, Score: 3},
+		{PostID: carols.ID, Score: 2},
+		{PostID: alices2.ID, Score: 1},
+	}))
+
+	rankings, err := repo.GetRanked(viewer.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bobs.ID, alices.ID, carols.ID, alices2.ID}, rankedPostIDs(rankings))
+	assert.Equal(t, 1, rankings[0].Position)
+	assert.NotNil(t, rankings[0].Edges.Post.Edges.User)
+
+	rankings, err = repo.GetRanked(viewer.ID, 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{carols.ID, alices2.ID}, rankedPostIDs(rankings))
+
+	// Blocked and muted authors are left out for the viewer only
+	require.NoError(t, blockRepo.Create(bob.ID.String(), viewer.ID.String()))
+	require.NoError(t, muteRepo.Create(viewer.ID, carol.ID))
+	rankings, err = repo.GetRanked(viewer.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{alices.ID, alices2.ID}, rankedPostIDs(rankings))
+
+	rankings, err = repo.GetRanked(alice.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, rankings, 4)
+}
+
+func rankedPostIDs(rankings []*ent.ExploreRanking) []uuid.UUID {
+	ids := make([]uuid.UUID, len(rankings))
+	for i, ranking := range rankings {
+		ids[i] = ranking.Edges.Post.ID
+	}
+	return ids
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -31,15 +33,38 @@ func (r *FollowRelationRepository) Follow(toId string, fromId string) error {
 		return err
 	}
 
-	_, err = r.db.FollowRelation.Create().
-		SetFromID(fromUUID).
-		SetToID(toUUID).
-		Save(context.Background())
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
 	if err != nil {
+		return err
+	}
+	if err := createFollow(ctx, tx, fromUUID, toUUID); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("failed to create follow relation in database: %w: rollback failed: %v", err, rerr)
+		}
 		return fmt.Errorf("failed to create follow relation in database: %w", err)
 	}
 
-	return nil
+	return tx.Commit()
+}
+
+// createFollow creates the follow relation and counts it in follower_count and following_count.
+func createFollow(ctx context.Context, tx *ent.Tx, fromID, toID uuid.UUID) error {
+	_, err := tx.FollowRelation.Create().
+		SetFromID(fromID).
+		SetToID(toID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	return addFollowCounts(ctx, tx, fromID, toID, 1)
+}
+
+func addFollowCounts(ctx context.Context, tx *ent.Tx, fromID, toID uuid.UUID, n int) error {
+	if err := tx.User.UpdateOneID(toID).AddFollowerCount(n).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.User.UpdateOneID(fromID).AddFollowingCount(n).Exec(ctx)
 }
 
 func (r *FollowRelationRepository) Unfollow(toId string, fromId string) error {
@@ -53,17 +78,95 @@ func (r *FollowRelationRepository) Unfollow(toId string, fromId string) error {
 		return err
 	}
 
-	_, err = r.db.FollowRelation.
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	deleted, err := tx.FollowRelation.
 		Delete().
 		Where(
 			followrelation.HasFromWith(user.ID(fromUUID)),
 			followrelation.HasToWith(user.ID(toUUID)),
 		).
-		Exec(context.Background())
-
+		Exec(ctx)
+	if err == nil && deleted > 0 {
+		err = addFollowCounts(ctx, tx, fromUUID, toUUID, -deleted)
+	}
 	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("failed to unfollow: %w: rollback failed: %v", err, rerr)
+		}
 		return fmt.Errorf("failed to unfollow: %w", err)
 	}
 
-	return nil
+	return tx.Commit()
+}
+
+func (r *FollowRelationRepository) IsFollowing(fromID, toID uuid.UUID) (bool, error) {
+	return r.db.FollowRelation.Query().
+		Where(
+			followrelation.HasFromWith(user.ID(fromID)),
+			followrelation.HasToWith(user.ID(toID)),
+		).
+		Exist(context.Background())
+}
+
+// Followers returns a page of the user's followers, newest first, without users blocking
+// or blocked by the viewer.
+func (r *FollowRelationRepository) Followers(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
+	query := r.db.FollowRelation.Query().
+		Where(
+			followrelation.HasToWith(user.ID(userID)),
+			followrelation.HasFromWith(notBlockedWith(viewerID)),
+		).
+		WithFrom()
+	return r.page(query, cursor, limit)
+}
+
+// Following returns a page of the users the user follows, newest first, without users blocking
+// or blocked by the viewer.
+func (r *FollowRelationRepository) Following(userID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
+	query := r.db.FollowRelation.Query().
+		Where(
+			followrelation.HasFromWith(user.ID(userID)),
+			followrelation.HasToWith(notBlockedWith(viewerID)),
+		).
+		WithTo()
+	return r.page(query, cursor, limit)
+}
+
+func (r *FollowRelationRepository) page(query *ent.FollowRelationQuery, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
+	if cursor != nil {
+		query = query.Where(predicate.FollowRelation(afterCursor(cursor)))
+	}
+	return query.
+		Order(ent.Desc(followrelation.FieldCreatedAt), ent.Desc(followrelation.FieldID)).
+		Limit(limit).
+		All(context.Background())
+}
+
+// FollowedIDs returns the subset of toIDs followed by fromID.
+func (r *FollowRelationRepository) FollowedIDs(fromID uuid.UUID, toIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(toIDs) == 0 {
+		return nil, nil
+	}
+	return r.db.User.Query().
+		Where(
+			user.IDIn(toIDs...),
+			user.HasFollowersWith(followrelation.HasFromWith(user.ID(fromID))),
+		).
+		IDs(context.Background())
+}
+
+func (r *FollowRelationRepository) CountFollowers(userID uuid.UUID) (int, error) {
+	return r.db.FollowRelation.Query().
+		Where(followrelation.HasToWith(user.ID(userID))).
+		Count(context.Background())
+}
+
+func (r *FollowRelationRepository) CountFollowing(userID uuid.UUID) (int, error) {
+	return r.db.FollowRelation.Query().
+		Where(followrelation.HasFromWith(user.ID(userID))).
+		Count(context.Background())
 }
@@ -0,0 +1,95 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowRelationRepository_FollowersPagination(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	repo := NewFollowRelationRepository(client)
+
+	target := createTestUser(t, client, "target")
+	viewer := createTestUser(t, client, "viewer")
+
+	// Several follows share a timestamp so the id has to break the tie
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var followerIDs []uuid.UUID
+	for i := 0; i < 5; i++ {
+		follower := createTestUser(t, client, fmt.Sprintf("follower%d", i))
+		_, err := client.FollowRelation.Create().
+			SetFrom(follower).
+			SetTo(target).
+			SetCreatedAt(base.Add(time.Duration(i/3) * time.Minute)).
+			Save(ctx)
+		require.NoError(t, err)
+		followerIDs = append(followerIDs, follower.ID)
+	}
+
+	var seen []uuid.UUID
+	var cursor *models.Cursor
+	for page := 0; page < 5; page++ {
+		relations, err := repo.Followers(target.ID, viewer.ID, cursor, 2)
+		require.NoError(t, err)
+		if len(relations) == 0 {
+			break
+		}
+		for _, relation := range relations {
+			seen = append(seen, relation.Edges.From.ID)
+		}
+		last := relations[len(relations)-1]
+		// The cursor goes through the client as a string
+		cursor, err = models.DecodeCursor(models.NewCursor(last.CreatedAt, last.ID).Encode())
+		require.NoError(t, err)
+	}
+
+	assert.ElementsMatch(t, followerIDs, seen)
+	assert.Len(t, seen, len(followerIDs), "no follower is repeated or skipped")
+
+	count, err := repo.CountFollowers(target.ID)
+	require.NoError(t, err)
+	assert.Equal(t, len(followerIDs), count)
+}
+
+func TestFollowRelationRepository_FollowersHidesBlockedUsers(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	repo := NewFollowRelationRepository(client)
+
+	target := createTestUser(t, client, "target")
+	viewer := createTestUser(t, client, "viewer")
+	friend := createTestUser(t, client, "friend")
+	blocked := createTestUser(t, client, "blocked")
+	for _, follower := range []*ent.User{viewer, friend, blocked} {
+		_, err := client.FollowRelation.Create().SetFrom(follower).SetTo(target).Save(ctx)
+		require.NoError(t, err)
+	}
+	_, err := client.BlockRelation.Create().SetFrom(blocked).SetTo(viewer).Save(ctx)
+	require.NoError(t, err)
+
+	relations, err := repo.Followers(target.ID, viewer.ID, nil, 10)
+	require.NoError(t, err)
+	var ids []uuid.UUID
+	for _, relation := range relations {
+		ids = append(ids, relation.Edges.From.ID)
+	}
+	assert.ElementsMatch(t, []uuid.UUID{viewer.ID, friend.ID}, ids)
+
+	// Counts are not filtered per viewer
+	count, err := repo.CountFollowers(target.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	followed, err := repo.FollowedIDs(viewer.ID, []uuid.UUID{target.ID, friend.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{target.ID}, followed)
+}
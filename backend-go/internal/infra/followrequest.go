@@ -0,0 +1,150 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/followrequest"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+type FollowRequestRepository struct {
+	db *ent.Client
+}
+
+func NewFollowRequestRepository(db *ent.Client) *FollowRequestRepository {
+	return &FollowRequestRepository{db: db}
+}
+
+// Create stores a pending request. Requesting the same user twice is not an error.
+func (r *FollowRequestRepository) Create(fromID, toID uuid.UUID) error {
+	_, err := r.db.FollowRequest.Create().
+		SetFromID(fromID).
+		SetToID(toID).
+		Save(context.Background())
+	// 一意制約違反は既にリクエスト済みということなので成功扱いにする
+	if err != nil && !ent.IsConstraintError(err) {
+		return fmt.Errorf("failed to create follow request in database: %w", err)
+	}
+	return nil
+}
+
+func (r *FollowRequestRepository) GetById(id uuid.UUID) (*ent.FollowRequest, error) {
+	return r.db.FollowRequest.Query().
+		Where(followrequest.ID(id)).
+		WithFrom().
+		WithTo().
+		Only(context.Background())
+}
+
+func (r *FollowRequestRepository) Exists(fromID, toID uuid.UUID) (bool, error) {
+	return r.db.FollowRequest.Query().
+		Where(
+			followrequest.HasFromWith(user.ID(fromID)),
+			followrequest.HasToWith(user.ID(toID)),
+		).
+		Exist(context.Background())
+}
+
+// Approve replaces the request with a follow relation in one transaction.
+func (r *FollowRequestRepository) Approve(id uuid.UUID) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := approve(ctx, tx, id); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// ApproveAll approves every pending request to the user, e.g. when the account becomes public.
+func (r *FollowRequestRepository) ApproveAll(toID uuid.UUID) (int, error) {
+	ctx := context.Background()
+	ids, err := r.db.FollowRequest.Query().
+		Where(followrequest.HasToWith(user.ID(toID))).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		if err := r.Approve(id); err != nil {
+			return 0, err
+		}
+	}
+	return len(ids), nil
+}
+
+func approve(ctx context.Context, tx *ent.Tx, id uuid.UUID) error {
+	request, err := tx.FollowRequest.Query().
+		Where(followrequest.ID(id)).
+		WithFrom().
+		WithTo().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.FollowRequest.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+
+	fromID, toID := request.Edges.From.ID, request.Edges.To.ID
+	// 制約違反はトランザクションを壊すので、既にフォロー済みかを先に確認する
+	following, err := tx.FollowRelation.Query().
+		Where(
+			followrelation.HasFromWith(user.ID(fromID)),
+			followrelation.HasToWith(user.ID(toID)),
+		).
+		Exist(ctx)
+	if err != nil || following {
+		return err
+	}
+	return createFollow(ctx, tx, fromID, toID)
+}
+
+func (r *FollowRequestRepository) Delete(id uuid.UUID) error {
+	return r.db.FollowRequest.DeleteOneID(id).Exec(context.Background())
+}
+
+// DeleteBetween removes pending requests in both directions, e.g. when one user blocks the other.
+func (r *FollowRequestRepository) DeleteBetween(userID, otherID uuid.UUID) error {
+	_, err := r.db.FollowRequest.Delete().
+		Where(followrequest.Or(
+			followrequest.And(
+				followrequest.HasFromWith(user.ID(userID)),
+				followrequest.HasToWith(user.ID(otherID)),
+			),
+			followrequest.And(
+				followrequest.HasFromWith(user.ID(otherID)),
+				followrequest.HasToWith(user.ID(userID)),
+			),
+		)).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to delete follow requests: %w", err)
+	}
+	return nil
+}
+
+func (r *FollowRequestRepository) ListIncoming(toID uuid.UUID) ([]*ent.FollowRequest, error) {
+	return r.db.FollowRequest.Query().
+		Where(followrequest.HasToWith(user.ID(toID))).
+		WithFrom().
+		Order(ent.Desc(followrequest.FieldCreatedAt)).
+		All(context.Background())
+}
+
+func (r *FollowRequestRepository) ListOutgoing(fromID uuid.UUID) ([]*ent.FollowRequest, error) {
+	return r.db.FollowRequest.Query().
+		Where(followrequest.HasFromWith(user.ID(fromID))).
+		WithTo().
+		Order(ent.Desc(followrequest.FieldCreatedAt)).
+		All(context.Background())
+}
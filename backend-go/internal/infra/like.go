@@ -2,11 +2,16 @@ package infra
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 )
 
@@ -19,7 +24,10 @@ func NewLikeRepository(db *ent.Client) *LikeRepository {
 		db: db,
 	}
 }
-func (r *LikeRepository) Create(userID, postID string) error {
+
+// Create likes the post with the reaction and counts it in like_count in one transaction.
+// Liking it again changes the reaction and keeps the like, so a user has one reaction per post.
+func (r *LikeRepository) Create(userID, postID string, reaction enum.ReactionType) error {
 	parsedUserID, err := uuid.Parse(userID)
 	if err != nil {
 		return err
@@ -29,11 +37,42 @@ func (r *LikeRepository) Create(userID, postID string) error {
 		return err
 	}
 
-	_, err = r.db.Like.Create().
-		SetUserID(parsedUserID).
-		SetPostID(parsedPostID).
-		Save(context.Background())
-	return err
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := createLike(ctx, tx, parsedUserID, parsedPostID, reaction); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func createLike(ctx context.Context, tx *ent.Tx, userID, postID uuid.UUID, reaction enum.ReactionType) error {
+	// 同時に最初のいいねが来ても制約違反にならないよう upsert し、既存のいいねはリアクションだけを変える
+	id := uuid.New()
+	err := tx.Like.Create().
+		SetID(id).
+		SetUserID(userID).
+		SetPostID(postID).
+		SetReaction(reaction).
+		OnConflictColumns(like.UserColumn, like.PostColumn).
+		UpdateReaction().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	// ID が変わっていなければ新しく作られたいいね
+	storedID, err := tx.Like.Query().
+		Where(like.HasUserWith(user.ID(userID)), like.HasPostWith(post.ID(postID))).
+		OnlyID(ctx)
+	if err != nil || storedID != id {
+		return err
+	}
+	return tx.Post.UpdateOneID(postID).AddLikeCount(1).Exec(ctx)
 }
 
 func (r *LikeRepository) Delete(userID, postId string) error {
@@ -47,33 +86,58 @@ func (r *LikeRepository) Delete(userID, postId string) error {
 		return err
 	}
 
-	_, err = r.db.Like.Delete().
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	deleted, err := tx.Like.Delete().
 		Where(
 			like.And(
 				like.HasPostWith(post.ID(parsedPostId)),
 				like.HasUserWith(user.ID(parsedUserID)),
 			),
 		).
-		Exec(context.Background())
+		Exec(ctx)
+	if err == nil && deleted > 0 {
+		err = tx.Post.UpdateOneID(parsedPostId).AddLikeCount(-deleted).Exec(ctx)
+	}
 	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
 		return err
 	}
-
-	return nil
+	return tx.Commit()
 }
 
+// Count returns the like_count of the post, the number of likes of every reaction.
 func (r *LikeRepository) Count(postId string) (int, error) {
 	parsedPostId, err := uuid.Parse(postId)
 	if err != nil {
 		return 0, err
 	}
 
-	count, err := r.db.Like.Query().
-		Where(like.HasPostWith(post.ID(parsedPostId))).
-		Count(context.Background())
+	likedPost, err := r.db.Post.Query().
+		Where(post.ID(parsedPostId)).
+		Select(post.FieldLikeCount).
+		Only(context.Background())
 	if err != nil {
 		return 0, err
 	}
 
-	return count, nil
+	return likedPost.LikeCount, nil
+}
+
+func (r *LikeRepository) ListByPost(postId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Like, error) {
+	query := r.db.Like.Query().
+		Where(like.HasPostWith(post.ID(postId)), likeVisibleTo(viewerID, time.Now())).
+		WithUser()
+	if cursor != nil {
+		query = query.Where(predicate.Like(afterCursor(cursor)))
+	}
+	return query.
+		Order(ent.Desc(like.FieldCreatedAt), ent.Desc(like.FieldID)).
+		Limit(limit).
+		All(context.Background())
 }
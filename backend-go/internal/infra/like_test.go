@@ -0,0 +1,51 @@
+package infra
+
+import (
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikeRepository_Reactions(t *testing.T) {
+	client := newTestClient(t)
+	likeRepo := NewLikeRepository(client)
+	postRepo := NewPostRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+	post := createTestPost(t, client, alice)
+
+	require.NoError(t, likeRepo.Create(bob.ID.String(), post.ID.String(), enum.ReactionTypePaw))
+	require.NoError(t, likeRepo.Create(carol.ID.String(), post.ID.String(), enum.ReactionTypeHeart))
+	// Liking again changes the reaction instead of adding a like
+	require.NoError(t, likeRepo.Create(bob.ID.String(), post.ID.String(), enum.ReactionTypeWow))
+
+	count, err := likeRepo.Count(post.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	stats, err := postRepo.GetStats(bob.ID, []uuid.UUID{post.ID}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats[post.ID].LikesCount)
+	assert.Equal(t, map[enum.ReactionType]int{enum.ReactionTypeWow: 1, enum.ReactionTypeHeart: 1}, stats[post.ID].Reactions)
+	assert.True(t, stats[post.ID].LikedByMe)
+	require.NotNil(t, stats[post.ID].MyReaction)
+	assert.Equal(t, enum.ReactionTypeWow, *stats[post.ID].MyReaction)
+
+	stats, err = postRepo.GetStats(alice.ID, []uuid.UUID{post.ID}, 0)
+	require.NoError(t, err)
+	assert.False(t, stats[post.ID].LikedByMe)
+	assert.Nil(t, stats[post.ID].MyReaction)
+
+	likes, err := likeRepo.ListByPost(post.ID, alice.ID, nil, 10)
+	require.NoError(t, err)
+	reactions := map[uuid.UUID]enum.ReactionType{}
+	for _, like := range likes {
+		reactions[like.Edges.User.ID] = like.Reaction
+	}
+	assert.Equal(t, map[uuid.UUID]enum.ReactionType{bob.ID: enum.ReactionTypeWow, carol.ID: enum.ReactionTypeHeart}, reactions)
+}
@@ -0,0 +1,441 @@
+package infra
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/credential"
+	"github.com/aki-13627/animalia/backend-go/ent/verificationcode"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	sesv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	localIssuer          = "animalia-local"
+	accessTokenTTL       = time.Hour
+	refreshTokenTTL      = 30 * 24 * time.Hour
+	signUpCodeTTL        = 24 * time.Hour
+	resetPasswordCodeTTL = time.Hour
+	maxCodeAttempts      = 5
+	minPasswordLength    = 8
+)
+
+type localClaims struct {
+	jwt.RegisteredClaims
+	TokenUse     string `json:"token_use"`
+	Username     string `json:"username,omitempty"`
+	Email        string `json:"email,omitempty"`
+	TokenVersion int    `json:"ver"`
+}
+
+// LocalAuthRepository is an AuthRepository backed by Postgres and bcrypt.
+// It issues its own HS256 tokens so the API can run without Cognito.
+type LocalAuthRepository struct {
+	db       *ent.Client
+	mailer   repository.MailerRepository
+	secret   []byte
+	mailFrom string
+	now      func() time.Time
+}
+
+func NewLocalAuthRepository(db *ent.Client, mailer repository.MailerRepository, secret, mailFrom string) *LocalAuthRepository {
+	return &LocalAuthRepository{
+		db:       db,
+		mailer:   mailer,
+		secret:   []byte(secret),
+		mailFrom: mailFrom,
+		now:      time.Now,
+	}
+}
+
+func (r *LocalAuthRepository) GenerateHash(username string) string {
+	h := hmac.New(sha256.New, r.secret)
+	h.Write([]byte(username))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func (r *LocalAuthRepository) VerifyToken(email, token string) error {
+	claims, err := r.parse(token, "id")
+	if err != nil {
+		return err
+	}
+	if claims.Email != email {
+		return errors.New("email mismatch")
+	}
+	return nil
+}
+
+func (r *LocalAuthRepository) CreateUser(name, email, password string) error {
+	if len(password) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+
+	ctx := context.Background()
+	exists, err := r.db.Credential.Query().Where(credential.Email(email)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check existing user: %w", err)
+	}
+	if exists {
+		return errors.New("user already exists")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	_, err = r.db.Credential.Create().
+		SetEmail(email).
+		SetPasswordHash(string(hash)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+
+	return r.sendCode(ctx, email, verificationcode.PurposeSignUp, signUpCodeTTL,
+		"Animalia メールアドレスの確認",
+		"%s さん、Animalia へようこそ。確認コードは %s です。")
+}
+
+func (r *LocalAuthRepository) VerifyEmail(email, code string) error {
+	ctx := context.Background()
+	if err := r.consumeCode(ctx, email, verificationcode.PurposeSignUp, code); err != nil {
+		return err
+	}
+
+	n, err := r.db.Credential.Update().
+		Where(credential.Email(email)).
+		SetEmailVerified(true).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to confirm user's email address: %w", err)
+	}
+	if n == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+func (r *LocalAuthRepository) SignIn(email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	cred, err := r.db.Credential.Query().Where(credential.Email(email)).Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// ユーザーの存在有無を区別できないようにする
+			return nil, errors.New("incorrect email or password")
+		}
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(cred.PasswordHash), []byte(password)); err != nil {
+		return nil, errors.New("incorrect email or password")
+	}
+	if !cred.EmailVerified {
+		return nil, errors.New("user is not confirmed")
+	}
+
+	return r.issueTokens(cred, true)
+}
+
+func (r *LocalAuthRepository) RefreshToken(refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	claims, err := r.parse(refreshToken, "refresh")
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh tokens: %w", err)
+	}
+	cred, err := r.currentCredential(claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh tokens: %w", err)
+	}
+
+	// Cognito と同様にリフレッシュトークンは再発行しない
+	return r.issueTokens(cred, false)
+}
+
+func (r *LocalAuthRepository) GetUserEmail(accessToken string) (string, error) {
+	claims, err := r.parse(accessToken, "access")
+	if err != nil {
+		return "", err
+	}
+	cred, err := r.currentCredential(claims)
+	if err != nil {
+		return "", err
+	}
+	return cred.Email, nil
+}
+
+func (r *LocalAuthRepository) VerifyAccessToken(accessToken string) (*models.AccessTokenClaims, error) {
+	claims, err := r.parse(accessToken, "access")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.currentCredential(claims); err != nil {
+		return nil, err
+	}
+
+	return &models.AccessTokenClaims{
+		Subject:   claims.Subject,
+		Username:  claims.Username,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+func (r *LocalAuthRepository) SignOut(accessToken string) error {
+	claims, err := r.parse(accessToken, "access")
+	if err != nil {
+		return fmt.Errorf("failed to sign user out: %w", err)
+	}
+	cred, err := r.currentCredential(claims)
+	if err != nil {
+		return fmt.Errorf("failed to sign user out: %w", err)
+	}
+
+	// 全端末からサインアウトさせるため、発行済みのトークンをまとめて無効にする
+	if err := r.db.Credential.UpdateOne(cred).AddTokenVersion(1).Exec(context.Background()); err != nil {
+		return fmt.Errorf("failed to sign user out: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalAuthRepository) Delete(accessToken string) error {
+	claims, err := r.parse(accessToken, "access")
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	cred, err := r.currentCredential(claims)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	ctx := context.Background()
+	if _, err := r.db.VerificationCode.Delete().Where(verificationcode.Email(cred.Email)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if err := r.db.Credential.DeleteOne(cred).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalAuthRepository) RequestResetPassword(email string) error {
+	ctx := context.Background()
+	exists, err := r.db.Credential.Query().Where(credential.Email(email)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+	if !exists {
+		return errors.New("failed to reset password: user not found")
+	}
+
+	return r.sendCode(ctx, email, verificationcode.PurposeResetPassword, resetPasswordCodeTTL,
+		"Animalia パスワードのリセット",
+		"%s さん、パスワードリセットの確認コードは %s です。")
+}
+
+func (r *LocalAuthRepository) ConfirmResetPassword(email, code, newPassword string) error {
+	if len(newPassword) < minPasswordLength {
+		return fmt.Errorf("password must be at least %d characters", minPasswordLength)
+	}
+
+	ctx := context.Background()
+	if err := r.consumeCode(ctx, email, verificationcode.PurposeResetPassword, code); err != nil {
+		return err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	// パスワード変更前に発行されたトークンは無効にする
+	_, err = r.db.Credential.Update().
+		Where(credential.Email(email)).
+		SetPasswordHash(string(hash)).
+		AddTokenVersion(1).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to confirm reset password: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalAuthRepository) issueTokens(cred *ent.Credential, withRefresh bool) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	now := r.now()
+	accessToken, err := r.sign(cred, "access", now, accessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := r.sign(cred, "id", now, accessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.AuthenticationResultType{
+		AccessToken: aws.String(accessToken),
+		IdToken:     aws.String(idToken),
+		ExpiresIn:   int32(accessTokenTTL.Seconds()),
+		TokenType:   aws.String("Bearer"),
+	}
+	if withRefresh {
+		refreshToken, err := r.sign(cred, "refresh", now, refreshTokenTTL)
+		if err != nil {
+			return nil, err
+		}
+		result.RefreshToken = aws.String(refreshToken)
+	}
+
+	return &cognitoidentityprovider.InitiateAuthOutput{AuthenticationResult: result}, nil
+}
+
+func (r *LocalAuthRepository) sign(cred *ent.Credential, tokenUse string, now time.Time, ttl time.Duration) (string, error) {
+	claims := localClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    localIssuer,
+			Subject:   cred.ID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		TokenUse:     tokenUse,
+		Username:     cred.Email,
+		TokenVersion: cred.TokenVersion,
+	}
+	if tokenUse == "id" {
+		claims.Email = cred.Email
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(r.secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed, nil
+}
+
+func (r *LocalAuthRepository) parse(token, tokenUse string) (*localClaims, error) {
+	claims := &localClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return r.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(localIssuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(r.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse and verify token: %w", err)
+	}
+	if claims.TokenUse != tokenUse {
+		return nil, fmt.Errorf("unexpected token_use: %q", claims.TokenUse)
+	}
+	return claims, nil
+}
+
+// currentCredential loads the credential the token was issued for and rejects
+// tokens issued before the last sign-out or password reset.
+func (r *LocalAuthRepository) currentCredential(claims *localClaims) (*ent.Credential, error) {
+	id, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := r.db.Credential.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("user not found")
+		}
+		return nil, err
+	}
+	if cred.TokenVersion != claims.TokenVersion {
+		return nil, errors.New("token has been revoked")
+	}
+	return cred, nil
+}
+
+func (r *LocalAuthRepository) sendCode(ctx context.Context, email string, purpose verificationcode.Purpose, ttl time.Duration, subject, bodyFormat string) error {
+	code, err := generateCode()
+	if err != nil {
+		return err
+	}
+
+	// 同じ用途のコードは最新のもの一つだけを有効にする
+	err = r.db.VerificationCode.Create().
+		SetEmail(email).
+		SetPurpose(purpose).
+		SetCodeHash(hashCode(code)).
+		SetExpiresAt(r.now().Add(ttl)).
+		OnConflictColumns(verificationcode.FieldEmail, verificationcode.FieldPurpose).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to store verification code: %w", err)
+	}
+
+	_, err = r.mailer.Send(&ses.SendEmailInput{
+		Source: sesv1.String(r.mailFrom),
+		Destination: &ses.Destination{
+			ToAddresses: []*string{sesv1.String(email)},
+		},
+		Message: &ses.Message{
+			Body: &ses.Body{
+				Text: &ses.Content{
+					Data: sesv1.String(fmt.Sprintf(bodyFormat, email, code)),
+				},
+			},
+			Subject: &ses.Content{
+				Data: sesv1.String(subject),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification code: %w", err)
+	}
+	return nil
+}
+
+func (r *LocalAuthRepository) consumeCode(ctx context.Context, email string, purpose verificationcode.Purpose, code string) error {
+	vc, err := r.db.VerificationCode.Query().
+		Where(verificationcode.Email(email), verificationcode.PurposeEQ(purpose)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errors.New("invalid verification code")
+		}
+		return err
+	}
+	if vc.Attempts >= maxCodeAttempts || r.now().After(vc.ExpiresAt) {
+		return errors.New("verification code has expired")
+	}
+	if subtle.ConstantTimeCompare([]byte(vc.CodeHash), []byte(hashCode(code))) != 1 {
+		if err := r.db.VerificationCode.UpdateOne(vc).AddAttempts(1).Exec(ctx); err != nil {
+			return err
+		}
+		return errors.New("invalid verification code")
+	}
+
+	return r.db.VerificationCode.DeleteOne(vc).Exec(ctx)
+}
+
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
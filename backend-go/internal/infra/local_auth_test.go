@@ -0,0 +1,163 @@
+package infra
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var codePattern = regexp.MustCompile(`\d{6}`)
+
+// newTestLocalAuthRepository returns a repository backed by an in-memory SQLite database
+// and a function returning the code from the last email sent to the given address.
+func newTestLocalAuthRepository(t *testing.T) (*LocalAuthRepository, func(email string) string) {
+	t.Helper()
+	client := newTestClient(t)
+
+	codes := map[string]string{}
+	mailer := &mock.MockMailerRepository{
+		SendFunc: func(email *ses.SendEmailInput) (*ses.SendEmailOutput, error) {
+			to := aws.StringValue(email.Destination.ToAddresses[0])
+			codes[to] = codePattern.FindString(aws.StringValue(email.Message.Body.Text.Data))
+			return &ses.SendEmailOutput{}, nil
+		},
+	}
+	return NewLocalAuthRepository(client, mailer, "test-secret", "noreply@example.com"), func(email string) string {
+		return codes[email]
+	}
+}
+
+func TestLocalAuthRepository_SignUpAndSignIn(t *testing.T) {
+	repo, lastCode := newTestLocalAuthRepository(t)
+	email := "test@example.com"
+
+	require.NoError(t, repo.CreateUser("test", email, "password123"))
+	assert.Error(t, repo.CreateUser("test", email, "password123"), "duplicate email should be rejected")
+	code := lastCode(email)
+	require.Len(t, code, 6)
+
+	// Unverified users cannot sign in
+	_, err := repo.SignIn(email, "password123")
+	assert.Error(t, err)
+
+	assert.Error(t, repo.VerifyEmail(email, "not-the-code"))
+	require.NoError(t, repo.VerifyEmail(email, code))
+	assert.Error(t, repo.VerifyEmail(email, code), "codes are single use")
+
+	_, err = repo.SignIn(email, "wrong-password")
+	assert.Error(t, err)
+	result, err := repo.SignIn(email, "password123")
+	require.NoError(t, err)
+	require.NotNil(t, result.AuthenticationResult.RefreshToken)
+
+	accessToken := *result.AuthenticationResult.AccessToken
+	claims, err := repo.VerifyAccessToken(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, email, claims.Username)
+	assert.NotEmpty(t, claims.Subject)
+
+	gotEmail, err := repo.GetUserEmail(accessToken)
+	require.NoError(t, err)
+	assert.Equal(t, email, gotEmail)
+	assert.NoError(t, repo.VerifyToken(email, *result.AuthenticationResult.IdToken))
+
+	// Tokens cannot be used for a different purpose
+	_, err = repo.VerifyAccessToken(*result.AuthenticationResult.IdToken)
+	assert.Error(t, err)
+	_, err = repo.RefreshToken(accessToken)
+	assert.Error(t, err)
+
+	refreshed, err := repo.RefreshToken(*result.AuthenticationResult.RefreshToken)
+	require.NoError(t, err)
+	_, err = repo.VerifyAccessToken(*refreshed.AuthenticationResult.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestLocalAuthRepository_CreateUserRejectsShortPassword(t *testing.T) {
+	repo, _ := newTestLocalAuthRepository(t)
+	assert.Error(t, repo.CreateUser("test", "test@example.com", "short"))
+}
+
+func signedUpUser(t *testing.T, repo *LocalAuthRepository, lastCode func(string) string, email, password string) (accessToken, refreshToken string) {
+	t.Helper()
+	require.NoError(t, repo.CreateUser("test", email, password))
+	require.NoError(t, repo.VerifyEmail(email, lastCode(email)))
+	result, err := repo.SignIn(email, password)
+	require.NoError(t, err)
+	return *result.AuthenticationResult.AccessToken, *result.AuthenticationResult.RefreshToken
+}
+
+func TestLocalAuthRepository_SignOut(t *testing.T) {
+	repo, lastCode := newTestLocalAuthRepository(t)
+	accessToken, refreshToken := signedUpUser(t, repo, lastCode, "test@example.com", "password123")
+
+	require.NoError(t, repo.SignOut(accessToken))
+
+	_, err := repo.VerifyAccessToken(accessToken)
+	assert.Error(t, err)
+	_, err = repo.RefreshToken(refreshToken)
+	assert.Error(t, err)
+
+	// Signing in again issues usable tokens
+	result, err := repo.SignIn("test@example.com", "password123")
+	require.NoError(t, err)
+	_, err = repo.VerifyAccessToken(*result.AuthenticationResult.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestLocalAuthRepository_ResetPassword(t *testing.T) {
+	repo, lastCode := newTestLocalAuthRepository(t)
+	email := "test@example.com"
+	accessToken, _ := signedUpUser(t, repo, lastCode, email, "password123")
+
+	assert.Error(t, repo.RequestResetPassword("unknown@example.com"))
+	require.NoError(t, repo.RequestResetPassword(email))
+	code := lastCode(email)
+
+	assert.Error(t, repo.ConfirmResetPassword(email, code, "short"))
+	require.NoError(t, repo.ConfirmResetPassword(email, code, "newpassword123"))
+
+	_, err := repo.VerifyAccessToken(accessToken)
+	assert.Error(t, err, "tokens issued before the reset should be revoked")
+	_, err = repo.SignIn(email, "password123")
+	assert.Error(t, err)
+	_, err = repo.SignIn(email, "newpassword123")
+	assert.NoError(t, err)
+}
+
+func TestLocalAuthRepository_CodeExpiryAndAttempts(t *testing.T) {
+	repo, lastCode := newTestLocalAuthRepository(t)
+
+	require.NoError(t, repo.CreateUser("test", "expired@example.com", "password123"))
+	code := lastCode("expired@example.com")
+	repo.now = func() time.Time { return time.Now().Add(signUpCodeTTL + time.Minute) }
+	assert.Error(t, repo.VerifyEmail("expired@example.com", code))
+	repo.now = time.Now
+
+	require.NoError(t, repo.CreateUser("test", "locked@example.com", "password123"))
+	code = lastCode("locked@example.com")
+	for i := 0; i < maxCodeAttempts; i++ {
+		assert.Error(t, repo.VerifyEmail("locked@example.com", "000000x"))
+	}
+	assert.Error(t, repo.VerifyEmail("locked@example.com", code), "code should be locked after too many attempts")
+}
+
+func TestLocalAuthRepository_Delete(t *testing.T) {
+	repo, lastCode := newTestLocalAuthRepository(t)
+	accessToken, _ := signedUpUser(t, repo, lastCode, "test@example.com", "password123")
+
+	require.NoError(t, repo.Delete(accessToken))
+
+	_, err := repo.VerifyAccessToken(accessToken)
+	assert.Error(t, err)
+	_, err = repo.SignIn("test@example.com", "password123")
+	assert.Error(t, err)
+	// The email can be registered again
+	assert.NoError(t, repo.CreateUser("test", "test@example.com", "password123"))
+}
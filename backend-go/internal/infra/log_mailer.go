@@ -0,0 +1,33 @@
+package infra
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/labstack/gommon/log"
+)
+
+// LogMailerRepository writes emails to the log instead of sending them.
+// SES を使えないローカル環境で確認コードなどを確認するために使う。
+type LogMailerRepository struct{}
+
+func NewLogMailerRepository() *LogMailerRepository {
+	return &LogMailerRepository{}
+}
+
+func (s *LogMailerRepository) Send(email *ses.SendEmailInput) (*ses.SendEmailOutput, error) {
+	var to []string
+	if email.Destination != nil {
+		to = aws.StringValueSlice(email.Destination.ToAddresses)
+	}
+	var subject, body string
+	if email.Message != nil {
+		if email.Message.Subject != nil {
+			subject = aws.StringValue(email.Message.Subject.Data)
+		}
+		if email.Message.Body != nil && email.Message.Body.Text != nil {
+			body = aws.StringValue(email.Message.Body.Text.Data)
+		}
+	}
+	log.Infof("Mail to %v: %s\n%s", to, subject, body)
+	return &ses.SendEmailOutput{MessageId: aws.String("local")}, nil
+}
@@ -0,0 +1,83 @@
+package infra
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryTimelineCacheRepository keeps timelines in the memory of the process. It holds at most
+// capacity users and evicts the least recently used one when full. Each Lambda instance has its
+// own copy; use TimelineCacheRepository to share timelines between instances.
+type MemoryTimelineCacheRepository struct {
+	mu       sync.Mutex
+	capacity int
+	now      func() time.Time
+	order    *list.List // front is the most recently used
+	entries  map[uuid.UUID]*list.Element
+}
+
+type memoryTimelineEntry struct {
+	userID    uuid.UUID
+	postIDs   []uuid.UUID
+	expiresAt time.Time
+}
+
+func NewMemoryTimelineCacheRepository(capacity int) *MemoryTimelineCacheRepository {
+	return &MemoryTimelineCacheRepository{
+		capacity: capacity,
+		now:      time.Now,
+		order:    list.New(),
+		entries:  make(map[uuid.UUID]*list.Element),
+	}
+}
+
+func (r *MemoryTimelineCacheRepository) Store(userID uuid.UUID, postIDs []uuid.UUID, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry := &memoryTimelineEntry{userID: userID, postIDs: postIDs, expiresAt: r.now().Add(ttl)}
+	if element, ok := r.entries[userID]; ok {
+		element.Value = entry
+		r.order.MoveToFront(element)
+		return nil
+	}
+	r.entries[userID] = r.order.PushFront(entry)
+	for r.order.Len() > r.capacity {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.entries, oldest.Value.(*memoryTimelineEntry).userID)
+	}
+	return nil
+}
+
+func (r *MemoryTimelineCacheRepository) Get(userID uuid.UUID) ([]uuid.UUID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	element, ok := r.entries[userID]
+	if !ok {
+		return nil, nil
+	}
+	entry := element.Value.(*memoryTimelineEntry)
+	if !r.now().Before(entry.expiresAt) {
+		r.order.Remove(element)
+		delete(r.entries, userID)
+		return nil, nil
+	}
+	r.order.MoveToFront(element)
+	return entry.postIDs, nil
+}
+
+func (r *MemoryTimelineCacheRepository) Delete(userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, ok := r.entries[userID]; ok {
+		r.order.Remove(element)
+		delete(r.entries, userID)
+	}
+	return nil
+}
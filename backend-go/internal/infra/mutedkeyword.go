@@ -0,0 +1,69 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/google/uuid"
+)
+
+type MutedKeywordRepository struct {
+	db *ent.Client
+}
+
+func NewMutedKeywordRepository(db *ent.Client) *MutedKeywordRepository {
+	return &MutedKeywordRepository{db: db}
+}
+
+// Create adds the keyword, or returns the existing one if the user already muted it.
+func (r *MutedKeywordRepository) Create(userID uuid.UUID, keyword string) (*ent.MutedKeyword, error) {
+	ctx := context.Background()
+	existing, err := r.db.MutedKeyword.Query().
+		Where(
+			mutedkeyword.UserID(userID),
+			mutedkeyword.Keyword(keyword),
+		).
+		Only(ctx)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return r.db.MutedKeyword.Create().
+		SetUserID(userID).
+		SetKeyword(keyword).
+		Save(ctx)
+}
+
+// Delete removes the user's keyword. Keywords of other users are reported as not found.
+func (r *MutedKeywordRepository) Delete(userID uuid.UUID, id uuid.UUID) error {
+	n, err := r.db.MutedKeyword.Delete().
+		Where(
+			mutedkeyword.ID(id),
+			mutedkeyword.UserID(userID),
+		).
+		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return nil
+}
+
+func (r *MutedKeywordRepository) ListByUser(userID uuid.UUID) ([]*ent.MutedKeyword, error) {
+	return r.db.MutedKeyword.Query().
+		Where(mutedkeyword.UserID(userID)).
+		Order(ent.Asc(mutedkeyword.FieldCreatedAt)).
+		All(context.Background())
+}
+
+func (r *MutedKeywordRepository) CountByUser(userID uuid.UUID) (int, error) {
+	return r.db.MutedKeyword.Query().
+		Where(mutedkeyword.UserID(userID)).
+		Count(context.Background())
+}
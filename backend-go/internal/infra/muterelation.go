@@ -0,0 +1,59 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+type MuteRelationRepository struct {
+	db *ent.Client
+}
+
+func NewMuteRelationRepository(db *ent.Client) *MuteRelationRepository {
+	return &MuteRelationRepository{db: db}
+}
+
+// Create mutes toID for fromID. Muting someone twice is not an error.
+func (r *MuteRelationRepository) Create(fromID, toID uuid.UUID) error {
+	_, err := r.db.MuteRelation.Create().
+		SetFromID(fromID).
+		SetToID(toID).
+		Save(context.Background())
+	// 一意制約違反は既にミュート済みということなので成功扱いにする
+	if err != nil && !ent.IsConstraintError(err) {
+		return fmt.Errorf("failed to create mute relation in database: %w", err)
+	}
+	return nil
+}
+
+func (r *MuteRelationRepository) Delete(fromID, toID uuid.UUID) error {
+	_, err := r.db.MuteRelation.
+		Delete().
+		Where(
+			muterelation.HasFromWith(user.ID(fromID)),
+			muterelation.HasToWith(user.ID(toID)),
+		).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to delete mute relation in database: %w", err)
+	}
+	return nil
+}
+
+func (r *MuteRelationRepository) MutedUsers(fromID uuid.UUID) ([]*ent.User, error) {
+	return r.db.User.Query().
+		Where(user.HasMutedByWith(muterelation.HasFromWith(user.ID(fromID)))).
+		Order(ent.Asc(user.FieldName)).
+		All(context.Background())
+}
+
+func (r *MuteRelationRepository) MutedUserIDs(fromID uuid.UUID) ([]uuid.UUID, error) {
+	return r.db.User.Query().
+		Where(user.HasMutedByWith(muterelation.HasFromWith(user.ID(fromID)))).
+		IDs(context.Background())
+}
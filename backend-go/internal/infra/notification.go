@@ -0,0 +1,85 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type NotificationRepository struct {
+	db *ent.Client
+}
+
+func NewNotificationRepository(db *ent.Client) *NotificationRepository {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+func (r *NotificationRepository) CreateMentions(actorID uuid.UUID, recipientIDs []uuid.UUID, postID uuid.UUID, commentID *uuid.UUID) error {
+	builders := make([]*ent.NotificationCreate, len(recipientIDs))
+	for i, recipientID := range recipientIDs {
+		builders[i] = r.db.Notification.Create().
+			SetType(enum.NotificationTypeMention).
+			SetRecipientID(recipientID).
+			SetActorID(actorID).
+			SetPostID(postID).
+			SetNillableCommentID(commentID)
+	}
+	return r.db.Notification.CreateBulk(builders...).Exec(context.Background())
+}
+
+func (r *NotificationRepository) DeletePostMentions(postID uuid.UUID, recipientIDs []uuid.UUID) error {
+	_, err := r.db.Notification.Delete().
+		Where(
+			notification.TypeEQ(enum.NotificationTypeMention),
+			notification.PostID(postID),
+			notification.CommentIDIsNil(),
+			notification.RecipientIDIn(recipientIDs...),
+		).
+		Exec(context.Background())
+	return err
+}
+
+func (r *NotificationRepository) DeleteCommentMentions(commentID uuid.UUID, recipientIDs []uuid.UUID) error {
+	_, err := r.db.Notification.Delete().
+		Where(
+			notification.TypeEQ(enum.NotificationTypeMention),
+			notification.CommentID(commentID),
+			notification.RecipientIDIn(recipientIDs...),
+		).
+		Exec(context.Background())
+	return err
+}
+
+func (r *NotificationRepository) ListByRecipient(recipientID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Notification, error) {
+	query := r.db.Notification.Query().
+		Where(notificationVisibleTo(recipientID, time.Now())).
+		WithActor()
+	if cursor != nil {
+		query = query.Where(predicate.Notification(afterCursor(cursor)))
+	}
+	return query.
+		Order(ent.Desc(notification.FieldCreatedAt), ent.Desc(notification.FieldID)).
+		Limit(limit).
+		All(context.Background())
+}
+
+func (r *NotificationRepository) CountUnread(recipientID uuid.UUID) (int, error) {
+	return r.db.Notification.Query().
+		Where(notificationVisibleTo(recipientID, time.Now()), notification.ReadAtIsNil()).
+		Count(context.Background())
+}
+
+func (r *NotificationRepository) MarkAllRead(recipientID uuid.UUID) error {
+	return r.db.Notification.Update().
+		Where(notification.RecipientID(recipientID), notification.ReadAtIsNil()).
+		SetReadAt(time.Now()).
+		Exec(context.Background())
+}
@@ -0,0 +1,135 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestUserWithHandle(t *testing.T, client *ent.Client, handle string) *ent.User {
+	t.Helper()
+	user := createTestUser(t, client, handle)
+	require.NoError(t, NewUserRepository(client).UpdateHandle(user.ID, handle))
+	user.Handle = handle
+	return user
+}
+
+func userIDs(users []*ent.User) []uuid.UUID {
+	ids := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	return ids
+}
+
+func TestMentions(t *testing.T) {
+	client := newTestClient(t)
+	userRepo := NewUserRepository(client)
+	postRepo := NewPostRepository(client)
+	commentRepo := NewCommentRepository(client)
+	blockRepo := NewBlockRelationRepository(client)
+	suspensionRepo := NewSuspensionRepository(client)
+
+	alice := createTestUserWithHandle(t, client, "alice")
+	bob := createTestUserWithHandle(t, client, "bob")
+	carol := createTestUserWithHandle(t, client, "carol")
+	dave := createTestUserWithHandle(t, client, "dave")
+	require.NoError(t, blockRepo.Create(carol.ID.String(), alice.ID.String()))
+	_, err := suspensionRepo.Create(dave.ID, bob.ID, "spam", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	// Users blocking the author and suspended users cannot be mentioned
+	users, err := userRepo.GetMentionable(alice.ID, []string{"bob", "carol", "dave", "nobody"})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bob.ID}, userIDs(users))
+
+	media := []models.PostMediaInput{{Key: "posts/" + uuid.NewString()}}
+	created, err := postRepo.CreatePost("@bob @carol", alice.ID.String(), media, nil, nil, []uuid.UUID{bob.ID}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []models.MentionResponse{{UserID: bob.ID, Handle: "bob", Start: 0, End: 4}},
+		models.NewMentionResponses(created.Caption, created.Edges.Mentions))
+
+	posts, err := postRepo.GetAllPosts(bob.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	require.Len(t, posts[0].Edges.Mentions, 1)
+	assert.Equal(t, "bob", posts[0].Edges.Mentions[0].Handle)
+
+	// Editing the caption replaces the mentions
+	updated, err := postRepo.UpdatePost(created.ID, "no one", nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Edges.Mentions)
+
+	comment, err := commentRepo.Create(bob.ID, created.ID, nil, "thanks @alice", []uuid.UUID{alice.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{alice.ID}, userIDs(comment.Edges.Mentions))
+	comments, err := commentRepo.ListByPost(created.ID, alice.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, []uuid.UUID{alice.ID}, userIDs(comments[0].Edges.Mentions))
+}
+
+func TestNotificationRepository(t *testing.T) {
+	client := newTestClient(t)
+	postRepo := NewPostRepository(client)
+	blockRepo := NewBlockRelationRepository(client)
+	notificationRepo := NewNotificationRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+	post := createTestPost(t, client, alice)
+	comment, err := client.Comment.Create().SetContent("@bob").SetPost(post).SetUser(carol).Save(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, notificationRepo.CreateMentions(alice.ID, []uuid.UUID{bob.ID, carol.ID}, post.ID, nil))
+	require.NoError(t, notificationRepo.CreateMentions(carol.ID, []uuid.UUID{bob.ID}, post.ID, &comment.ID))
+
+	notifications, err := notificationRepo.ListByRecipient(bob.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 2)
+	assert.Equal(t, carol.ID, notifications[0].Edges.Actor.ID, "newest first")
+	assert.Equal(t, comment.ID, *notifications[0].CommentID)
+	count, err := notificationRepo.CountUnread(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Notifications from users the recipient blocks are left out
+	require.NoError(t, blockRepo.Create(bob.ID.String(), carol.ID.String()))
+	notifications, err = notificationRepo.ListByRecipient(bob.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, alice.ID, notifications[0].Edges.Actor.ID)
+
+	require.NoError(t, notificationRepo.MarkAllRead(bob.ID))
+	count, err = notificationRepo.CountUnread(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	notifications, err = notificationRepo.ListByRecipient(bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, notifications[0].ReadAt)
+
+	// Removing a mention from the caption keeps the mentions in comments
+	require.NoError(t, notificationRepo.DeletePostMentions(post.ID, []uuid.UUID{bob.ID}))
+	require.NoError(t, blockRepo.Delete(bob.ID.String(), carol.ID.String()))
+	notifications, err = notificationRepo.ListByRecipient(bob.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, carol.ID, notifications[0].Edges.Actor.ID)
+
+	// Notifications about deleted posts are left out, and removed with the post
+	require.NoError(t, postRepo.DeletePost(post.ID.String()))
+	notifications, err = notificationRepo.ListByRecipient(carol.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, notifications)
+	require.NoError(t, postRepo.HardDeletePost(post.ID))
+	total, err := client.Notification.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, total)
+}
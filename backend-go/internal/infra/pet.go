@@ -2,6 +2,7 @@ package infra
 
 import (
 	"context"
+	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/ent/pet"
@@ -32,6 +33,40 @@ func (r *PetRepository) GetByOwner(ownerID string) ([]*ent.Pet, error) {
 	return pets, nil
 }
 
+func (r *PetRepository) GetById(petID string) (*ent.Pet, error) {
+	petUUID, err := uuid.Parse(petID)
+	if err != nil {
+		return nil, err
+	}
+
+	pet, err := r.db.Pet.Query().
+		Where(pet.ID(petUUID)).
+		WithOwner().
+		Only(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return pet, nil
+}
+
+func (r *PetRepository) GetByIds(petIDs []uuid.UUID) ([]*ent.Pet, error) {
+	return r.db.Pet.Query().
+		Where(pet.IDIn(petIDs...)).
+		WithOwner().
+		All(context.Background())
+}
+
+// GetVisibleById applies the same blocks and private accounts as the posts of the owner.
+func (r *PetRepository) GetVisibleById(viewerID uuid.UUID, petID uuid.UUID) (*ent.Pet, error) {
+	return r.db.Pet.Query().
+		Where(
+			pet.ID(petID),
+			pet.HasOwnerWith(activeUser(time.Now()), notBlockedWith(viewerID), profileVisibleTo(viewerID)),
+		).
+		WithOwner().
+		Only(context.Background())
+}
+
 func (r *PetRepository) Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error) {
 	ownerID, err := uuid.Parse(userID)
 	if err != nil {
@@ -0,0 +1,43 @@
+package infra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetRepository_GetVisibleById(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	petRepo := NewPetRepository(client)
+	blockRepo := NewBlockRelationRepository(client)
+
+	owner := createTestUser(t, client, "owner")
+	viewer := createTestUser(t, client, "viewer")
+	pet, err := petRepo.Create("pochi", "dog", "shiba_inu", "2020-01-01", "pets/pochi.jpg", owner.ID.String())
+	require.NoError(t, err)
+
+	found, err := petRepo.GetVisibleById(viewer.ID, pet.ID)
+	require.NoError(t, err)
+	assert.Equal(t, owner.ID, found.Edges.Owner.ID)
+
+	// Private owners show their pets only to followers
+	_, err = client.User.UpdateOneID(owner.ID).SetIsPrivate(true).Save(ctx)
+	require.NoError(t, err)
+	_, err = petRepo.GetVisibleById(viewer.ID, pet.ID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = petRepo.GetVisibleById(owner.ID, pet.ID)
+	assert.NoError(t, err)
+	_, err = client.FollowRelation.Create().SetFrom(viewer).SetTo(owner).Save(ctx)
+	require.NoError(t, err)
+	_, err = petRepo.GetVisibleById(viewer.ID, pet.ID)
+	assert.NoError(t, err)
+
+	// Blocks hide the pet in both directions
+	require.NoError(t, blockRepo.Create(viewer.ID.String(), owner.ID.String()))
+	_, err = petRepo.GetVisibleById(viewer.ID, pet.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
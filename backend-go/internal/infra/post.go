@@ -2,14 +2,24 @@ package infra
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
 	"github.com/aki-13627/animalia/backend-go/ent/dailytask"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
 	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/pet"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/postmedia"
+	"github.com/aki-13627/animalia/backend-go/ent/postrevision"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
 )
@@ -24,19 +34,20 @@ func NewPostRepository(db *ent.Client) *PostRepository {
 	}
 }
 
-func (r *PostRepository) GetAllPosts() ([]*ent.Post, error) {
-	posts, err := r.db.Post.Query().
+func (r *PostRepository) GetAllPosts(viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	now := time.Now()
+	notMuted, err := notMutedBy(context.Background(), r.db, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	query := r.db.Post.Query().
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
-		Where(post.DeletedAtIsNil()).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
-		All(context.Background())
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(postVisibleTo(viewerID, now), notMuted)
+	posts, err := pagePosts(query, cursor, limit)
 	if err != nil {
 		log.Errorf("Failed to get all posts: %v", err)
 		return nil, err
@@ -44,7 +55,12 @@ func (r *PostRepository) GetAllPosts() ([]*ent.Post, error) {
 	return posts, nil
 }
 
-func (r *PostRepository) GetFollowsPosts(userID uuid.UUID, cursor *uuid.UUID, limit int) ([]*ent.Post, error) {
+func (r *PostRepository) GetFollowsPosts(userID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	now := time.Now()
+	notMuted, err := notMutedBy(context.Background(), r.db, userID)
+	if err != nil {
+		return nil, err
+	}
 	query := r.db.Post.Query().
 		Where(
 			post.HasUserWith(
@@ -54,88 +70,180 @@ func (r *PostRepository) GetFollowsPosts(userID uuid.UUID, cursor *uuid.UUID, li
 			),
 		).
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
-		Where(post.DeletedAtIsNil()).
-		Order(ent.Desc(post.FieldCreatedAt))
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(postVisibleTo(userID, now), notMuted)
+	return pagePosts(query, cursor, limit)
+}
 
-	// カーソルが指定されている場合、カーソル以降の投稿を取得
-	if cursor != nil {
-		query = query.Where(post.IDLT(*cursor))
+// GetPostsByUser returns the posts on the profile of the user. Like pet feeds, mutes are not applied.
+func (r *PostRepository) GetPostsByUser(userID uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	now := time.Now()
+	query := r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(post.HasUserWith(user.ID(userID))).
+		Where(postVisibleTo(viewerID, now))
+	posts, err := pagePosts(query, cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to get posts by user: %v", err)
+		return nil, err
 	}
+	return posts, nil
+}
 
-	// リミットを設定
-	posts, err := query.
-		Limit(limit).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
-		All(context.Background())
+// GetPostsByPet returns the posts the pet is tagged in. Like the profile of the owner, mutes
+// are not applied.
+func (r *PostRepository) GetPostsByPet(petID uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	now := time.Now()
+	query := r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(post.HasPetsWith(pet.ID(petID))).
+		Where(postVisibleTo(viewerID, now))
+	posts, err := pagePosts(query, cursor, limit)
 	if err != nil {
+		log.Errorf("Failed to get posts by pet: %v", err)
 		return nil, err
 	}
 	return posts, nil
 }
 
-func (r *PostRepository) GetPostsByUser(userID uuid.UUID) ([]*ent.Post, error) {
-	posts, err := r.db.Post.Query().
+// CountPostsByPet counts the posts the pet is tagged in that the viewer can see.
+func (r *PostRepository) CountPostsByPet(petID uuid.UUID, viewerID uuid.UUID) (int, error) {
+	return r.db.Post.Query().
+		Where(post.HasPetsWith(pet.ID(petID)), postVisibleTo(viewerID, time.Now())).
+		Count(context.Background())
+}
+
+// GetPostsByTag returns the posts with the hashtag, with the viewer's mutes applied.
+func (r *PostRepository) GetPostsByTag(name string, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	now := time.Now()
+	notMuted, err := notMutedBy(context.Background(), r.db, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	query := r.db.Post.Query().
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
-		Where(post.HasUserWith(user.ID(userID))).
-		Where(post.DeletedAtIsNil()).
-		Order(ent.Desc(post.FieldCreatedAt)).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
-		All(context.Background())
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(post.HasTagsWith(tag.Name(name))).
+		Where(postVisibleTo(viewerID, now), notMuted)
+	posts, err := pagePosts(query, cursor, limit)
 	if err != nil {
-		log.Errorf("Failed to get posts by user: %v", err)
+		log.Errorf("Failed to get posts by tag: %v", err)
 		return nil, err
 	}
 	return posts, nil
 }
 
-func (r *PostRepository) GetLikedPosts(userID uuid.UUID) ([]*ent.Post, error) {
-	posts, err := r.db.Post.Query().
+// CountPostsByTag counts the posts with the hashtag that the viewer can see. Mutes are not
+// applied, so the count matches the number shown by other apps.
+func (r *PostRepository) CountPostsByTag(name string, viewerID uuid.UUID) (int, error) {
+	return r.db.Post.Query().
+		Where(post.HasTagsWith(tag.Name(name)), postVisibleTo(viewerID, time.Now())).
+		Count(context.Background())
+}
+
+// GetLikedPosts returns the posts liked by the user. Like the other feeds it is ordered by
+// the creation of the post, not of the like.
+func (r *PostRepository) GetLikedPosts(userID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	now := time.Now()
+	query := r.db.Post.Query().
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
 		Where(post.HasLikesWith(like.HasUserWith(user.ID(userID)))).
-		Where(post.DeletedAtIsNil()).
-		Order(ent.Desc(post.FieldCreatedAt)).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		Where(postVisibleTo(userID, now))
+	posts, err := pagePosts(query, cursor, limit)
+	if err != nil {
+		log.Errorf("Failed to get liked posts: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+// GetPopularPosts returns the posts created since the time with the most likes.
+func (r *PostRepository) GetPopularPosts(viewerID uuid.UUID, since time.Time, limit int) ([]*ent.Post, error) {
+	now := time.Now()
+	notMuted, err := notMutedBy(context.Background(), r.db, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(postVisibleTo(viewerID, now), notMuted).
+		Where(post.CreatedAtGTE(since)).
+		Order(ent.Desc(post.FieldLikeCount), ent.Desc(post.FieldCreatedAt)).
+		Limit(limit).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldMediaType, post.FieldLikeCount, post.FieldCommentCount).
 		All(context.Background())
 	if err != nil {
-		log.Errorf("Failed to get posts by user: %v", err)
+		log.Errorf("Failed to get popular posts: %v", err)
 		return nil, err
 	}
 	return posts, nil
 }
 
-func (r *PostRepository) GetByIds(postIds []uuid.UUID) ([]*ent.Post, error) {
+// orderedMedia loads the media of posts in carousel order.
+func orderedMedia(q *ent.PostMediaQuery) {
+	q.Order(ent.Asc(postmedia.FieldPosition))
+}
+
+// orderedPets loads the tagged pets of posts by name.
+func orderedPets(q *ent.PetQuery) {
+	q.Order(ent.Asc(pet.FieldName), ent.Asc(pet.FieldID))
+}
+
+// mentionedUsers loads only what the mention ranges of a caption or a comment need.
+func mentionedUsers(q *ent.UserQuery) {
+	q.Select(user.FieldID, user.FieldHandle)
+}
+
+// pagePosts returns the posts after the cursor, newest first. The id breaks ties between
+// posts created at the same time so that no post is skipped or repeated across pages.
+func pagePosts(query *ent.PostQuery, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+	if cursor != nil {
+		query = query.Where(predicate.Post(afterCursor(cursor)))
+	}
+	return query.
+		Order(ent.Desc(post.FieldCreatedAt), ent.Desc(post.FieldID)).
+		Limit(limit).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldMediaType).
+		All(context.Background())
+}
+
+func (r *PostRepository) GetByIds(viewerID uuid.UUID, postIds []uuid.UUID) ([]*ent.Post, error) {
+	now := time.Now()
+	notMuted, err := notMutedBy(context.Background(), r.db, viewerID)
+	if err != nil {
+		return nil, err
+	}
 	posts, err := r.db.Post.Query().
 		WithUser().
-		WithComments(func(q *ent.CommentQuery) {
-			q.WithUser()
-		}).
-		WithLikes(func(q *ent.LikeQuery) {
-			q.WithUser()
-		}).
 		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
 		Where(post.IDIn(postIds...)).
-		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt).
+		Where(postVisibleTo(viewerID, now), notMuted).
+		Select(post.FieldID, post.FieldCaption, post.FieldImageKey, post.FieldCreatedAt, post.FieldEditedAt, post.FieldMediaType).
 		All(context.Background())
 	if err != nil {
 		return nil, err
@@ -143,79 +251,609 @@ func (r *PostRepository) GetByIds(postIds []uuid.UUID) ([]*ent.Post, error) {
 	return posts, nil
 }
 
-func (r *PostRepository) CreatePost(caption, userID, fileKey string, dailyTaskId *string) (*ent.Post, error) {
-	userUUID, err := uuid.Parse(userID)
+// GetVisibleById does not apply the viewer's mutes, since the viewer asked for this post.
+func (r *PostRepository) GetVisibleById(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+	return r.db.Post.Query().
+		WithUser().
+		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(post.ID(postId), postVisibleTo(viewerID, time.Now())).
+		Only(context.Background())
+}
+
+// GetStats counts what the viewer can see, like the comment and like lists do. The comments count
+// is the comment_count of the post minus the counted comments hidden from the viewer, which are
+// few, so the visible comments are not counted. The reactions have no counter and are counted
+// from the visible likes with one grouped query, and the likes count is their sum. Recent
+// comments of all the posts are loaded with one query, and their stats are counted together.
+func (r *PostRepository) GetStats(viewerID uuid.UUID, postIds []uuid.UUID, previewLimit int) (map[uuid.UUID]models.PostStats, error) {
+	ctx := context.Background()
+	now := time.Now()
+	stats := make(map[uuid.UUID]models.PostStats, len(postIds))
+	if len(postIds) == 0 {
+		return stats, nil
+	}
+
+	counters, err := r.db.Post.Query().
+		Where(post.IDIn(postIds...)).
+		Select(post.FieldID, post.FieldCommentCount).
+		All(ctx)
 	if err != nil {
+		log.Errorf("Failed to get counters: %v", err)
 		return nil, err
 	}
-
-	postCount, err := r.db.Post.Query().Count(context.Background())
+	// comment_count に含まれるが閲覧者には見えないコメント (非表示、凍結中や相互ブロックのユーザー)
+	var hiddenCommentCounts []struct {
+		PostID uuid.UUID `json:"post_comments"`
+		Count  int       `json:"count"`
+	}
+	err = r.db.Comment.Query().
+		Where(comment.HasPostWith(post.IDIn(postIds...)), countedComment(), comment.Not(commentVisibleTo(viewerID, now))).
+		GroupBy(comment.PostColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &hiddenCommentCounts)
+	if err != nil {
+		log.Errorf("Failed to count hidden comments: %v", err)
+		return nil, err
+	}
+	var reactionCounts []struct {
+		PostID   uuid.UUID         `json:"post_likes"`
+		Reaction enum.ReactionType `json:"reaction"`
+		Count    int               `json:"count"`
+	}
+	err = r.db.Like.Query().
+		Where(like.HasPostWith(post.IDIn(postIds...)), likeVisibleTo(viewerID, now)).
+		GroupBy(like.PostColumn, like.FieldReaction).
+		Aggregate(ent.Count()).
+		Scan(ctx, &reactionCounts)
 	if err != nil {
+		log.Errorf("Failed to count reactions: %v", err)
+		return nil, err
+	}
+	var myReactions []struct {
+		PostID   uuid.UUID         `json:"post_likes"`
+		Reaction enum.ReactionType `json:"reaction"`
+	}
+	err = r.db.Like.Query().
+		Where(like.HasUserWith(user.ID(viewerID)), like.HasPostWith(post.IDIn(postIds...))).
+		GroupBy(like.PostColumn, like.FieldReaction).
+		Scan(ctx, &myReactions)
+	if err != nil {
+		log.Errorf("Failed to get liked posts: %v", err)
 		return nil, err
 	}
 
-	postCreate := r.db.Post.Create().
-		SetCaption(caption).
-		SetImageKey(fileKey).
-		SetUserID(userUUID).
-		SetIndex(uint32(postCount))
+	for _, id := range postIds {
+		stats[id] = models.PostStats{}
+	}
+	for _, p := range counters {
+		s := stats[p.ID]
+		s.CommentsCount = p.CommentCount
+		stats[p.ID] = s
+	}
+	for _, count := range hiddenCommentCounts {
+		s := stats[count.PostID]
+		// カウンタがずれていても負の値は返さない
+		s.CommentsCount = max(s.CommentsCount-count.Count, 0)
+		stats[count.PostID] = s
+	}
+	for _, count := range reactionCounts {
+		s := stats[count.PostID]
+		if s.Reactions == nil {
+			s.Reactions = map[enum.ReactionType]int{}
+		}
+		s.Reactions[count.Reaction] = count.Count
+		s.LikesCount += count.Count
+		stats[count.PostID] = s
+	}
+	for _, liked := range myReactions {
+		s := stats[liked.PostID]
+		s.LikedByMe = true
+		s.MyReaction = &liked.Reaction
+		stats[liked.PostID] = s
+	}
+
+	var commented []uuid.UUID
+	for _, id := range postIds {
+		if stats[id].CommentsCount > 0 {
+			commented = append(commented, id)
+		}
+	}
+	if len(commented) == 0 || previewLimit <= 0 {
+		return stats, nil
+	}
+	recent, err := recentComments(ctx, r.db, viewerID, commented, previewLimit, now)
+	if err != nil {
+		log.Errorf("Failed to get recent comments: %v", err)
+		return nil, err
+	}
+	var commentIds []uuid.UUID
+	for _, comments := range recent {
+		for _, c := range comments {
+			commentIds = append(commentIds, c.ID)
+		}
+	}
+	allCommentStats, err := commentStats(ctx, r.db, viewerID, commentIds, now)
+	if err != nil {
+		return nil, err
+	}
+	for id, comments := range recent {
+		s := stats[id]
+		s.RecentComments = comments
+		s.CommentStats = make(map[uuid.UUID]models.CommentStats, len(comments))
+		for _, c := range comments {
+			s.CommentStats[c.ID] = allCommentStats[c.ID]
+		}
+		stats[id] = s
+	}
+	return stats, nil
+}
 
+// CreatePost creates the post with its media, tagged pets, hashtags and mentioned users in one
+// transaction. The first image, or the poster of a video, is also stored as image_key, which the
+// algorithm service reads.
+func (r *PostRepository) CreatePost(caption, userID string, media []models.PostMediaInput, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	var dailyTaskUUID *uuid.UUID
 	if dailyTaskId != nil {
-		dailyTaskUUID, err := uuid.Parse(*dailyTaskId)
+		id, err := uuid.Parse(*dailyTaskId)
 		if err != nil {
 			return nil, err
 		}
-		err = r.db.Post.
+		dailyTaskUUID = &id
+	}
+	if len(media) == 0 {
+		return nil, fmt.Errorf("post has no media")
+	}
+
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := createPost(ctx, tx, caption, userUUID, media, petIds, tags, mentionIds, dailyTaskUUID)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func createPost(ctx context.Context, tx *ent.Tx, caption string, userID uuid.UUID, media []models.PostMediaInput, petIDs []uuid.UUID, tags []string, mentionIDs []uuid.UUID, dailyTaskID *uuid.UUID) (*ent.Post, error) {
+	postCount, err := tx.Post.Query().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coverKey, mediaType := media[0].Key, enum.MediaTypeImage
+	if media[0].Type == enum.MediaTypeVideo {
+		coverKey, mediaType = media[0].PosterKey, enum.MediaTypeVideo
+	}
+	postCreate := tx.Post.Create().
+		SetCaption(caption).
+		SetImageKey(coverKey).
+		SetMediaType(mediaType).
+		SetUserID(userID).
+		AddPetIDs(petIDs...).
+		AddMentionIDs(mentionIDs...).
+		SetIndex(uint32(postCount))
+
+	if dailyTaskID != nil {
+		err = tx.Post.
 			Update().
 			Where(
-				post.HasDailyTaskWith(dailytask.ID(dailyTaskUUID)),
+				post.HasDailyTaskWith(dailytask.ID(*dailyTaskID)),
 				post.DeletedAtNotNil(), // 論理削除済みのみ対象
 			).
 			ClearDailyTask().
-			Exec(context.Background())
+			Exec(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		postCreate = postCreate.SetDailyTaskID(dailyTaskUUID)
+		postCreate = postCreate.SetDailyTaskID(*dailyTaskID)
 	}
 
-	post, err := postCreate.Save(context.Background())
+	created, err := postCreate.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if err := tx.User.UpdateOneID(userID).AddPostCount(1).Exec(ctx); err != nil {
+		return nil, err
+	}
 
-	return post, nil
+	builders := make([]*ent.PostMediaCreate, len(media))
+	for i, m := range media {
+		itemType := m.Type
+		if itemType == "" {
+			itemType = enum.MediaTypeImage
+		}
+		builders[i] = tx.PostMedia.Create().
+			SetPost(created).
+			SetPosition(i).
+			SetType(itemType).
+			SetKey(m.Key).
+			SetNillableWidth(m.Width).
+			SetNillableHeight(m.Height).
+			SetAltText(m.AltText).
+			SetNillableDurationMs(m.DurationMs).
+			SetPosterKey(m.PosterKey)
+	}
+	items, err := tx.PostMedia.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created.Edges.Media = items
+	if err := setPostTags(ctx, tx, created.ID, tags, created.CreatedAt); err != nil {
+		return nil, err
+	}
+	created.Edges.Pets, err = tx.Pet.Query().
+		Where(pet.IDIn(petIDs...)).
+		Order(ent.Asc(pet.FieldName), ent.Asc(pet.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created.Edges.Mentions, err = tx.User.Query().
+		Where(user.IDIn(mentionIDs...)).
+		Select(user.FieldID, user.FieldHandle).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
-func (r *PostRepository) UpdatePost(postID, caption string) error {
-	postUUID, err := uuid.Parse(postID)
+// UpdatePost replaces the caption with its hashtags and mentioned users and, unless petIds is nil,
+// the tagged pets, and keeps the previous caption and pets as a revision in one transaction.
+// Saving the same caption and pets again records nothing. Deleted posts return a not found error.
+func (r *PostRepository) UpdatePost(postId uuid.UUID, caption string, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID) (*ent.Post, error) {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := updatePost(ctx, tx, postId, caption, petIds, tags, mentionIds)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func updatePost(ctx context.Context, tx *ent.Tx, postId uuid.UUID, caption string, petIDs []uuid.UUID, tags []string, mentionIDs []uuid.UUID) (*ent.Post, error) {
+	current, err := tx.Post.Query().
+		Where(post.ID(postId), post.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentPetIDs, err := current.QueryPets().IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	petsChanged := petIDs != nil && !sameIDs(currentPetIDs, petIDs)
+	if current.Caption != caption || petsChanged {
+		err = tx.PostRevision.Create().
+			SetPostID(postId).
+			SetCaption(current.Caption).
+			SetPetIds(currentPetIDs).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		update := tx.Post.UpdateOneID(postId).
+			SetCaption(caption).
+			SetEditedAt(time.Now()).
+			ClearMentions().
+			AddMentionIDs(mentionIDs...)
+		if petsChanged {
+			update = update.ClearPets().AddPetIDs(petIDs...)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err := setPostTags(ctx, tx, postId, tags, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	return tx.Post.Query().
+		Where(post.ID(postId)).
+		WithUser().
+		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Only(ctx)
+}
+
+// setPostTags makes the hashtags of the post exactly the names. Tags the post already had keep
+// the time they were added, and new ones are added at taggedAt. Tags are created on first use.
+func setPostTags(ctx context.Context, tx *ent.Tx, postID uuid.UUID, names []string, taggedAt time.Time) error {
+	current, err := tx.PostTag.Query().
+		Where(posttag.PostID(postID)).
+		WithTag().
+		All(ctx)
 	if err != nil {
 		return err
 	}
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	kept := make(map[string]bool, len(current))
+	var removed []uuid.UUID
+	for _, pt := range current {
+		if wanted[pt.Edges.Tag.Name] {
+			kept[pt.Edges.Tag.Name] = true
+		} else {
+			removed = append(removed, pt.TagID)
+		}
+	}
+	if len(removed) > 0 {
+		_, err := tx.PostTag.Delete().
+			Where(posttag.PostID(postID), posttag.TagIDIn(removed...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
 
-	_, err = r.db.Post.UpdateOneID(postUUID).
-		SetCaption(caption).
-		Save(context.Background())
-	return err
+	var builders []*ent.PostTagCreate
+	for _, name := range names {
+		if kept[name] {
+			continue
+		}
+		// 同じタグが同時に作られても一意制約で1行にまとまる
+		tagID, err := tx.Tag.Create().
+			SetName(name).
+			OnConflictColumns(tag.FieldName).
+			Ignore().
+			ID(ctx)
+		if err != nil {
+			return err
+		}
+		builders = append(builders, tx.PostTag.Create().
+			SetPostID(postID).
+			SetTagID(tagID).
+			SetCreatedAt(taggedAt))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return tx.PostTag.CreateBulk(builders...).Exec(ctx)
+}
+
+// sameIDs reports whether a and b hold the same IDs, in any order. Neither has duplicates.
+func sameIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[uuid.UUID]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+	}
+	return true
 }
 
+// ListRevisions returns the previous captions and pets of the post, newest first.
+func (r *PostRepository) ListRevisions(postId uuid.UUID) ([]*ent.PostRevision, error) {
+	return r.db.PostRevision.Query().
+		Where(postrevision.HasPostWith(post.ID(postId), post.DeletedAtIsNil())).
+		Order(ent.Desc(postrevision.FieldCreatedAt), ent.Desc(postrevision.FieldID)).
+		All(context.Background())
+}
+
+// DeletePost soft-deletes the post and removes its media rows. The caller deletes the media
+// files from storage.
 func (r *PostRepository) DeletePost(postID string) error {
 	postUUID, err := uuid.Parse(postID)
 	if err != nil {
 		return err
 	}
 
-	return r.db.Post.UpdateOneID(postUUID).
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := deletePost(ctx, tx, postUUID); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// deletePost soft-deletes the post. Its media rows are kept so that moderators still see them
+// until the post is hard-deleted.
+func deletePost(ctx context.Context, tx *ent.Tx, postID uuid.UUID) error {
+	// 既に削除済みの投稿を消し直しても post_count は減らさない
+	deleted, err := tx.Post.Update().
+		Where(post.ID(postID), post.DeletedAtIsNil()).
 		SetDeletedAt(time.Now()).
-		Exec(context.Background())
+		Save(ctx)
+	if err != nil || deleted == 0 {
+		return err
+	}
+	return discountPost(ctx, tx, postID)
+}
+
+// discountPost takes the post out of the post_count of its author.
+func discountPost(ctx context.Context, tx *ent.Tx, postID uuid.UUID) error {
+	return tx.User.Update().
+		Where(user.HasPostsWith(post.ID(postID))).
+		AddPostCount(-1).
+		Exec(ctx)
 }
 
 func (r *PostRepository) GetById(postId uuid.UUID) (*ent.Post, error) {
-	post, err := r.db.Post.Get(context.Background(), postId)
+	post, err := r.db.Post.Query().
+		Where(post.ID(postId)).
+		WithUser().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Only(context.Background())
 	if err != nil {
 		log.Errorf("Failed to get post with id %s: %v", postId, err)
 		return nil, err
 	}
 	return post, nil
 }
+
+// GetPostsByUserIncludingDeleted returns every post of the user, soft-deleted ones included.
+func (r *PostRepository) GetPostsByUserIncludingDeleted(userID uuid.UUID) ([]*ent.Post, error) {
+	posts, err := r.db.Post.Query().
+		WithUser().
+		WithComments(func(q *ent.CommentQuery) {
+			q.WithUser().WithMentions(mentionedUsers)
+		}).
+		WithDailyTask().
+		WithMedia(orderedMedia).
+		WithPets(orderedPets).
+		WithMentions(mentionedUsers).
+		Where(post.HasUserWith(user.ID(userID))).
+		Order(ent.Desc(post.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		log.Errorf("Failed to get posts by user: %v", err)
+		return nil, err
+	}
+	return posts, nil
+}
+
+// ListDeletedBefore returns up to limit posts soft-deleted before the given time, with their media,
+// oldest deletion first.
+func (r *PostRepository) ListDeletedBefore(before time.Time, limit int) ([]*ent.Post, error) {
+	return r.db.Post.Query().
+		Where(post.DeletedAtLT(before)).
+		WithMedia(orderedMedia).
+		Order(ent.Asc(post.FieldDeletedAt), ent.Asc(post.FieldID)).
+		Limit(limit).
+		All(context.Background())
+}
+
+// HardDeletePost removes the post row together with its comments, likes and media rows, and takes it out of
+// the post_count of the author unless it was already soft-deleted.
+func (r *PostRepository) HardDeletePost(postId uuid.UUID) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	err = func() error {
+		counted, err := tx.Post.Query().Where(post.ID(postId), post.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if counted {
+			if err := discountPost(ctx, tx, postId); err != nil {
+				return err
+			}
+		}
+		return tx.Post.DeleteOneID(postId).Exec(ctx)
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// SetHidden hides the post from feeds after reports, or shows it again after a review.
+func (r *PostRepository) SetHidden(postId uuid.UUID, hidden bool) error {
+	update := r.db.Post.UpdateOneID(postId)
+	if hidden {
+		update = update.SetHiddenAt(time.Now())
+	} else {
+		update = update.ClearHiddenAt()
+	}
+	return update.Exec(context.Background())
+}
+
+// MigrateLegacyMedia gives every post created before carousels a one-item carousel made of its
+// image_key, and returns the number of posts migrated. Running it again migrates nothing.
+func (r *PostRepository) MigrateLegacyMedia() (int, error) {
+	ctx := context.Background()
+	migrated := 0
+	for {
+		posts, err := r.db.Post.Query().
+			// 以前は削除時にメディアを消していたので、削除済みの投稿は対象外
+			Where(post.Not(post.HasMedia()), post.DeletedAtIsNil()).
+			Order(ent.Asc(post.FieldID)).
+			Limit(legacyMediaBatchSize).
+			Select(post.FieldID, post.FieldImageKey).
+			All(ctx)
+		if err != nil {
+			return migrated, err
+		}
+		if len(posts) == 0 {
+			return migrated, nil
+		}
+		builders := make([]*ent.PostMediaCreate, len(posts))
+		for i, p := range posts {
+			builders[i] = r.db.PostMedia.Create().
+				SetPostID(p.ID).
+				SetPosition(0).
+				SetKey(p.ImageKey)
+		}
+		if err := r.db.PostMedia.CreateBulk(builders...).Exec(ctx); err != nil {
+			return migrated, err
+		}
+		migrated += len(posts)
+	}
+}
+
+// ListCaptions returns the captions of posts that are not deleted, ordered by id, starting after
+// afterID. Pass uuid.Nil for the first batch.
+func (r *PostRepository) ListCaptions(afterID uuid.UUID, limit int) ([]*ent.Post, error) {
+	return r.db.Post.Query().
+		Where(post.IDGT(afterID), post.DeletedAtIsNil()).
+		Order(ent.Asc(post.FieldID)).
+		Limit(limit).
+		Select(post.FieldID, post.FieldCaption, post.FieldCreatedAt).
+		All(context.Background())
+}
+
+// SetTags replaces the hashtags of the post. New tags are added at taggedAt.
+func (r *PostRepository) SetTags(postID uuid.UUID, tags []string, taggedAt time.Time) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := setPostTags(ctx, tx, postID, tags, taggedAt); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// legacyMediaBatchSize keeps each insert well below the bind parameter limit of PostgreSQL.
+const legacyMediaBatchSize = 1000
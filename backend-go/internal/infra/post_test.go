@@ -0,0 +1,438 @@
+package infra
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectPages walks a feed page by page, passing the cursor through its string form as clients do.
+func collectPages(t *testing.T, limit int, feed func(cursor *models.Cursor, limit int) ([]*ent.Post, error)) []uuid.UUID {
+	t.Helper()
+	var ids []uuid.UUID
+	var cursor *models.Cursor
+	for page := 0; page < 20; page++ {
+		posts, err := feed(cursor, limit)
+		require.NoError(t, err)
+		ids = append(ids, postIDs(posts)...)
+		if len(posts) < limit {
+			return ids
+		}
+		last := posts[len(posts)-1]
+		cursor, err = models.DecodeCursor(models.NewCursor(last.CreatedAt, last.ID).Encode())
+		require.NoError(t, err)
+	}
+	t.Fatal("feed did not end")
+	return nil
+}
+
+func TestPostRepository_PaginationWithIdenticalTimestamps(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+
+	viewer := createTestUser(t, client, "viewer")
+	author := createTestUser(t, client, "author")
+	_, err := client.FollowRelation.Create().SetFrom(viewer).SetTo(author).Save(ctx)
+	require.NoError(t, err)
+
+	// Seven posts in three timestamps, so most pages end in the middle of a tie
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var want []uuid.UUID
+	for i := 0; i < 7; i++ {
+		post, err := client.Post.Create().
+			SetCaption("caption").
+			SetImageKey("posts/" + uuid.NewString()).
+			SetUser(author).
+			SetCreatedAt(base.Add(time.Duration(i/3) * time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+		_, err = client.Like.Create().SetUser(viewer).SetPost(post).Save(ctx)
+		require.NoError(t, err)
+		want = append(want, post.ID)
+	}
+
+	feeds := map[string]func(cursor *models.Cursor, limit int) ([]*ent.Post, error){
+		"all": func(cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+			return postRepo.GetAllPosts(viewer.ID, cursor, limit)
+		},
+		"follows": func(cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+			return postRepo.GetFollowsPosts(viewer.ID, cursor, limit)
+		},
+		"user": func(cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+			return postRepo.GetPostsByUser(author.ID, viewer.ID, cursor, limit)
+		},
+		"liked": func(cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+			return postRepo.GetLikedPosts(viewer.ID, cursor, limit)
+		},
+	}
+	for name, feed := range feeds {
+		t.Run(name, func(t *testing.T) {
+			for _, limit := range []int{1, 2, 4} {
+				got := collectPages(t, limit, feed)
+				assert.Len(t, got, len(want), "limit %d: no post is repeated", limit)
+				assert.ElementsMatch(t, want, got, "limit %d: no post is skipped", limit)
+			}
+		})
+	}
+}
+
+func TestPostRepository_GetStats(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	popular := createTestPost(t, client, alice)
+	quiet := createTestPost(t, client, alice)
+	busy := createTestPost(t, client, bob)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var comments []*ent.Comment
+	for i := 0; i < 5; i++ {
+		comment, err := client.Comment.Create().SetContent("comment").SetPost(popular).SetUser(bob).
+			SetCreatedAt(createdAt.Add(time.Duration(i) * time.Minute)).Save(ctx)
+		require.NoError(t, err)
+		comments = append(comments, comment)
+	}
+	var busyComments []*ent.Comment
+	for i := 0; i < 4; i++ {
+		comment, err := client.Comment.Create().SetContent("busy").SetPost(busy).SetUser(alice).
+			SetCreatedAt(createdAt.Add(time.Duration(i) * time.Minute)).Save(ctx)
+		require.NoError(t, err)
+		busyComments = append(busyComments, comment)
+	}
+	_, err := client.Comment.Create().SetContent("reply").SetPost(busy).SetUser(bob).SetParent(busyComments[3]).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, busy.Update().SetCommentCount(5).Exec(ctx))
+	for _, u := range []*ent.User{alice, bob} {
+		_, err := client.Like.Create().SetPost(popular).SetUser(u).Save(ctx)
+		require.NoError(t, err)
+	}
+	// The comments count is read from the counter, which the rows above leave alone; the likes
+	// are counted with the reactions
+	require.NoError(t, popular.Update().SetCommentCount(7).SetLikeCount(3).Exec(ctx))
+
+	stats, err := postRepo.GetStats(bob.ID, []uuid.UUID{popular.ID, quiet.ID, busy.ID}, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, stats[popular.ID].CommentsCount)
+	assert.Equal(t, 2, stats[popular.ID].LikesCount)
+	assert.Equal(t, map[enum.ReactionType]int{enum.ReactionTypePaw: 2}, stats[popular.ID].Reactions)
+	assert.True(t, stats[popular.ID].LikedByMe)
+	// Newest comments first, limited to the preview size
+	require.Len(t, stats[popular.ID].RecentComments, 3)
+	assert.Equal(t, comments[4].ID, stats[popular.ID].RecentComments[0].ID)
+	assert.Equal(t, comments[2].ID, stats[popular.ID].RecentComments[2].ID)
+	assert.NotNil(t, stats[popular.ID].RecentComments[0].Edges.User)
+	// The preview size applies to each post
+	require.Len(t, stats[busy.ID].RecentComments, 3)
+	assert.Equal(t, busyComments[3].ID, stats[busy.ID].RecentComments[0].ID)
+	assert.Equal(t, busyComments[1].ID, stats[busy.ID].RecentComments[2].ID)
+	assert.Equal(t, 1, stats[busy.ID].CommentStats[busyComments[3].ID].RepliesCount)
+	assert.Len(t, stats[popular.ID].CommentStats, 3)
+
+	assert.Equal(t, models.PostStats{}, stats[quiet.ID])
+}
+
+func TestPostRepository_CommentsAndLikesOfPost(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+	commentRepo := NewCommentRepository(client)
+	likeRepo := NewLikeRepository(client)
+	blockRepo := NewBlockRelationRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	viewer := createTestUser(t, client, "viewer")
+	post := createTestPost(t, client, alice)
+
+	// Comments and likes created at the same time are paged without gaps or repeats
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var commentIDs, likeIDs []uuid.UUID
+	for i := 0; i < 5; i++ {
+		u := createTestUser(t, client, "user"+strconv.Itoa(i))
+		comment, err := client.Comment.Create().SetContent("comment").SetPost(post).SetUser(u).SetCreatedAt(createdAt).Save(ctx)
+		require.NoError(t, err)
+		commentIDs = append(commentIDs, comment.ID)
+		like, err := client.Like.Create().SetPost(post).SetUser(u).SetCreatedAt(createdAt).Save(ctx)
+		require.NoError(t, err)
+		likeIDs = append(likeIDs, like.ID)
+	}
+
+	var gotComments, gotLikes []uuid.UUID
+	var cursor *models.Cursor
+	for {
+		comments, err := commentRepo.ListByPost(post.ID, viewer.ID, cursor, 2)
+		require.NoError(t, err)
+		for _, comment := range comments {
+			gotComments = append(gotComments, comment.ID)
+		}
+		if len(comments) < 2 {
+			break
+		}
+		last := comments[len(comments)-1]
+		cursor = models.NewCursor(last.CreatedAt, last.ID)
+	}
+	cursor = nil
+	for {
+		likes, err := likeRepo.ListByPost(post.ID, viewer.ID, cursor, 2)
+		require.NoError(t, err)
+		for _, like := range likes {
+			gotLikes = append(gotLikes, like.ID)
+		}
+		if len(likes) < 2 {
+			break
+		}
+		last := likes[len(likes)-1]
+		cursor = models.NewCursor(last.CreatedAt, last.ID)
+	}
+	assert.ElementsMatch(t, commentIDs, gotComments)
+	assert.Len(t, gotComments, 5)
+	assert.ElementsMatch(t, likeIDs, gotLikes)
+	assert.Len(t, gotLikes, 5)
+
+	found, err := postRepo.GetVisibleById(viewer.ID, post.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, found.Edges.User.ID)
+
+	// A post of a user blocking the viewer is not found
+	require.NoError(t, blockRepo.Create(alice.ID.String(), viewer.ID.String()))
+	_, err = postRepo.GetVisibleById(viewer.ID, post.ID)
+	assert.True(t, ent.IsNotFound(err))
+}
+
+func TestPostRepository_UpdatePost(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	post := createTestPost(t, client, alice)
+	original := post.Caption
+
+	updated, err := postRepo.UpdatePost(post.ID, "first edit", nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "first edit", updated.Caption)
+	require.NotNil(t, updated.EditedAt)
+	assert.Equal(t, alice.ID, updated.Edges.User.ID)
+
+	_, err = postRepo.UpdatePost(post.ID, "second edit", nil, nil, nil)
+	require.NoError(t, err)
+
+	// Saving the same caption again does not add a revision
+	_, err = postRepo.UpdatePost(post.ID, "second edit", nil, nil, nil)
+	require.NoError(t, err)
+
+	revisions, err := postRepo.ListRevisions(post.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "first edit", revisions[0].Caption, "newest first")
+	assert.Equal(t, original, revisions[1].Caption)
+
+	// The edit shows up in feeds
+	posts, err := postRepo.GetAllPosts(alice.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "second edit", posts[0].Caption)
+	assert.NotNil(t, posts[0].EditedAt)
+
+	// Deleted posts can no longer be edited
+	require.NoError(t, postRepo.DeletePost(post.ID.String()))
+	_, err = postRepo.UpdatePost(post.ID, "third edit", nil, nil, nil)
+	assert.True(t, ent.IsNotFound(err))
+	count, err := client.PostRevision.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestPostRepository_PetTags(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+	petRepo := NewPetRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	viewer := createTestUser(t, client, "viewer")
+	pochi, err := petRepo.Create("pochi", "dog", "shiba_inu", "2020-01-01", "pets/pochi.jpg", alice.ID.String())
+	require.NoError(t, err)
+	tama, err := petRepo.Create("tama", "cat", "siamese", "2021-01-01", "pets/tama.jpg", alice.ID.String())
+	require.NoError(t, err)
+
+	created, err := postRepo.CreatePost("walk", alice.ID.String(), []models.PostMediaInput{{Key: "posts/walk.jpg"}}, []uuid.UUID{tama.ID, pochi.ID}, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, created.Edges.Pets, 2)
+	assert.Equal(t, "pochi", created.Edges.Pets[0].Name, "pets are ordered by name")
+	untagged := createTestPost(t, client, alice)
+
+	// Feeds load the tagged pets
+	posts, err := postRepo.GetAllPosts(viewer.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	for _, p := range posts {
+		if p.ID == created.ID {
+			require.Len(t, p.Edges.Pets, 2)
+			assert.Equal(t, []uuid.UUID{pochi.ID, tama.ID}, []uuid.UUID{p.Edges.Pets[0].ID, p.Edges.Pets[1].ID})
+		} else {
+			assert.Empty(t, p.Edges.Pets)
+		}
+	}
+
+	// The feed of a pet only has the posts it is tagged in
+	tamaPosts, err := postRepo.GetPostsByPet(tama.ID, viewer.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{created.ID}, postIDs(tamaPosts))
+
+	// Replacing the pets keeps the previous ones as a revision
+	updated, err := postRepo.UpdatePost(created.ID, "walk", []uuid.UUID{pochi.ID}, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, updated.Edges.Pets, 1)
+	assert.Equal(t, pochi.ID, updated.Edges.Pets[0].ID)
+	assert.NotNil(t, updated.EditedAt)
+	revisions, err := postRepo.ListRevisions(created.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.ElementsMatch(t, []uuid.UUID{pochi.ID, tama.ID}, revisions[0].PetIds)
+
+	// nil keeps the pets, and the same pets in another order record nothing
+	_, err = postRepo.UpdatePost(created.ID, "walk", nil, nil, nil)
+	require.NoError(t, err)
+	_, err = postRepo.UpdatePost(created.ID, "walk", []uuid.UUID{pochi.ID}, nil, nil)
+	require.NoError(t, err)
+	revisions, err = postRepo.ListRevisions(created.ID)
+	require.NoError(t, err)
+	assert.Len(t, revisions, 1)
+
+	count, err := postRepo.CountPostsByPet(tama.ID, viewer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	_, err = client.Post.UpdateOneID(untagged.ID).AddPetIDs(pochi.ID).Save(ctx)
+	require.NoError(t, err)
+	count, err = postRepo.CountPostsByPet(pochi.ID, viewer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Deleted posts and posts of a private owner are not counted
+	require.NoError(t, postRepo.DeletePost(untagged.ID.String()))
+	count, err = postRepo.CountPostsByPet(pochi.ID, viewer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	_, err = client.User.UpdateOneID(alice.ID).SetIsPrivate(true).Save(ctx)
+	require.NoError(t, err)
+	count, err = postRepo.CountPostsByPet(pochi.ID, viewer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	count, err = postRepo.CountPostsByPet(pochi.ID, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Deleting a pet removes its tags
+	require.NoError(t, petRepo.Delete(pochi.ID.String()))
+	found, err := postRepo.GetVisibleById(alice.ID, created.ID)
+	require.NoError(t, err)
+	assert.Empty(t, found.Edges.Pets)
+}
+
+func TestPostRepository_Media(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	width, height := 1080, 1350
+	created, err := postRepo.CreatePost("carousel", alice.ID.String(), []models.PostMediaInput{
+		{Key: "posts/first.jpg", Width: &width, Height: &height, AltText: "a dog"},
+		{Key: "posts/second.jpg"},
+		{Key: "posts/third.jpg"},
+	}, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "posts/first.jpg", created.ImageKey, "the first image is the cover")
+
+	mediaKeys := func(post *ent.Post) []string {
+		var keys []string
+		for _, m := range models.MediaOf(post) {
+			keys = append(keys, m.Key)
+		}
+		return keys
+	}
+
+	posts, err := postRepo.GetAllPosts(alice.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, []string{"posts/first.jpg", "posts/second.jpg", "posts/third.jpg"}, mediaKeys(posts[0]))
+	first := posts[0].Edges.Media[0]
+	assert.Equal(t, 1080, *first.Width)
+	assert.Equal(t, 1350, *first.Height)
+	assert.Equal(t, "a dog", first.AltText)
+	assert.Nil(t, posts[0].Edges.Media[1].Width)
+
+	// Posts created before carousels become one-item carousels
+	legacy := createTestPost(t, client, alice)
+	migrated, err := postRepo.MigrateLegacyMedia()
+	require.NoError(t, err)
+	assert.Equal(t, 1, migrated)
+	migrated, err = postRepo.MigrateLegacyMedia()
+	require.NoError(t, err)
+	assert.Equal(t, 0, migrated, "running the migration again changes nothing")
+	legacy, err = postRepo.GetById(legacy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{legacy.ImageKey}, mediaKeys(legacy))
+
+	// Soft-deleting a post keeps its media for moderation, and hard-deleting it removes them
+	require.NoError(t, postRepo.DeletePost(created.ID.String()))
+	_, err = postRepo.MigrateLegacyMedia()
+	require.NoError(t, err)
+	count, err := client.PostMedia.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+	deleted, err := postRepo.ListDeletedBefore(time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, []string{"posts/first.jpg", "posts/second.jpg", "posts/third.jpg"}, mediaKeys(deleted[0]))
+	deleted, err = postRepo.ListDeletedBefore(time.Now().Add(-time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	require.NoError(t, postRepo.HardDeletePost(created.ID))
+	count, err = client.PostMedia.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestPostRepository_VideoPost(t *testing.T) {
+	client := newTestClient(t)
+	postRepo := NewPostRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	width, height, durationMs := 720, 1280, 12000
+	created, err := postRepo.CreatePost("clip", alice.ID.String(), []models.PostMediaInput{{
+		Type:       enum.MediaTypeVideo,
+		Key:        "posts/clip.mp4",
+		Width:      &width,
+		Height:     &height,
+		DurationMs: &durationMs,
+		PosterKey:  "posts/poster.jpg",
+	}}, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "posts/poster.jpg", created.ImageKey, "the algorithm service reads the poster")
+
+	posts, err := postRepo.GetAllPosts(alice.ID, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, enum.MediaTypeVideo, posts[0].MediaType)
+	require.Len(t, posts[0].Edges.Media, 1)
+	video := posts[0].Edges.Media[0]
+	assert.Equal(t, enum.MediaTypeVideo, video.Type)
+	assert.Equal(t, "posts/clip.mp4", video.Key)
+	assert.Equal(t, "posts/poster.jpg", video.PosterKey)
+	assert.Equal(t, 12000, *video.DurationMs)
+}
@@ -0,0 +1,144 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/report"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ReportRepository struct {
+	db *ent.Client
+}
+
+func NewReportRepository(db *ent.Client) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+func (r *ReportRepository) Create(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error) {
+	create := r.db.Report.Create().
+		SetReporterID(reporterID).
+		SetTargetType(targetType).
+		SetReason(reason).
+		SetDetails(details)
+
+	switch targetType {
+	case enum.ReportTargetPost:
+		create = create.SetPostID(targetID)
+	case enum.ReportTargetComment:
+		create = create.SetCommentID(targetID)
+	case enum.ReportTargetUser:
+		create = create.SetTargetUserID(targetID)
+	default:
+		return nil, fmt.Errorf("unknown report target type: %s", targetType)
+	}
+
+	return create.Save(context.Background())
+}
+
+// FindOpen returns the open report the reporter already filed against the target.
+func (r *ReportRepository) FindOpen(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error) {
+	return r.db.Report.Query().
+		Where(
+			report.ReporterID(reporterID),
+			report.StatusEQ(enum.ReportStatusOpen),
+			report.TargetTypeEQ(targetType),
+			targetPredicate(targetType, targetID),
+		).
+		First(context.Background())
+}
+
+func (r *ReportRepository) GetById(id uuid.UUID) (*ent.Report, error) {
+	return r.db.Report.Query().
+		Where(report.ID(id)).
+		WithReporter().
+		WithResolver().
+		Only(context.Background())
+}
+
+func (r *ReportRepository) List(filter models.ReportFilter, limit, offset int) ([]*ent.Report, error) {
+	query := r.db.Report.Query()
+	if filter.Status != "" {
+		query = query.Where(report.StatusEQ(filter.Status))
+	}
+	if filter.TargetType != "" {
+		query = query.Where(report.TargetTypeEQ(filter.TargetType))
+	}
+	if filter.Reason != "" {
+		query = query.Where(report.ReasonEQ(filter.Reason))
+	}
+
+	return query.
+		WithReporter().
+		WithResolver().
+		Order(ent.Desc(report.FieldCreatedAt), ent.Desc(report.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(context.Background())
+}
+
+// Resolve closes the report together with the other open reports against the same target,
+// since the moderator reviewed the target itself.
+func (r *ReportRepository) Resolve(id uuid.UUID, resolverID uuid.UUID, status enum.ReportStatus, note string) (*ent.Report, error) {
+	ctx := context.Background()
+	target, err := r.db.Report.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := report.ID(id)
+	if targetID := models.ReportTargetID(target); targetID != nil {
+		reports = report.Or(
+			report.ID(id),
+			report.And(
+				report.StatusEQ(enum.ReportStatusOpen),
+				report.TargetTypeEQ(target.TargetType),
+				targetPredicate(target.TargetType, *targetID),
+			),
+		)
+	}
+
+	err = r.db.Report.Update().
+		Where(reports).
+		SetStatus(status).
+		SetResolverID(resolverID).
+		SetResolutionNote(note).
+		SetResolvedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetById(id)
+}
+
+// CountOpenReporters counts the distinct users with an open report against the target.
+func (r *ReportRepository) CountOpenReporters(targetType enum.ReportTargetType, targetID uuid.UUID) (int, error) {
+	return r.db.Report.Query().
+		Where(
+			report.StatusEQ(enum.ReportStatusOpen),
+			report.TargetTypeEQ(targetType),
+			targetPredicate(targetType, targetID),
+		).
+		Unique(true).
+		Select(report.FieldReporterID).
+		Count(context.Background())
+}
+
+func targetPredicate(targetType enum.ReportTargetType, targetID uuid.UUID) predicate.Report {
+	switch targetType {
+	case enum.ReportTargetPost:
+		return report.PostID(targetID)
+	case enum.ReportTargetComment:
+		return report.CommentID(targetID)
+	default:
+		return report.TargetUserID(targetID)
+	}
+}
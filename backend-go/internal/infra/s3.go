@@ -47,6 +47,18 @@ func NewS3Repository(bucketName string) *S3Repository {
 }
 
 func (r *S3Repository) UploadImage(file *multipart.FileHeader, directory string) (string, error) {
+	contentType := file.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	return r.upload(file, directory, contentType)
+}
+
+func (r *S3Repository) UploadVideo(file *multipart.FileHeader, directory string, contentType string) (string, error) {
+	return r.upload(file, directory, contentType)
+}
+
+func (r *S3Repository) upload(file *multipart.FileHeader, directory string, contentType string) (string, error) {
 	src, err := file.Open()
 	if err != nil {
 		return "", fmt.Errorf("failed to open file: %w", err)
@@ -76,11 +88,6 @@ func (r *S3Repository) UploadImage(file *multipart.FileHeader, directory string)
 
 	fileKey := fmt.Sprintf("%s/%s-%s", directory, uuid.New().String(), filepath.Base(file.Filename))
 
-	contentType := file.Header.Get("Content-Type")
-	if contentType == "" {
-		contentType = "application/octet-stream"
-	}
-
 	// Upload the file to S3
 	_, err = r.s3Client.PutObject(context.TODO(), &s3.PutObjectInput{
 		Bucket:      aws.String(r.bucketName),
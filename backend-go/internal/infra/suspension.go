@@ -0,0 +1,60 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/google/uuid"
+)
+
+type SuspensionRepository struct {
+	db *ent.Client
+}
+
+func NewSuspensionRepository(db *ent.Client) *SuspensionRepository {
+	return &SuspensionRepository{
+		db: db,
+	}
+}
+
+func (r *SuspensionRepository) Create(userID uuid.UUID, issuedByID uuid.UUID, reason string, endsAt time.Time) (*ent.Suspension, error) {
+	return r.db.Suspension.Create().
+		SetUserID(userID).
+		SetIssuedByID(issuedByID).
+		SetReason(reason).
+		SetEndsAt(endsAt).
+		Save(context.Background())
+}
+
+// GetActive returns the suspension in effect that ends last.
+func (r *SuspensionRepository) GetActive(userID uuid.UUID) (*ent.Suspension, error) {
+	return r.db.Suspension.Query().
+		Where(
+			suspension.UserID(userID),
+			activeSuspension(time.Now()),
+		).
+		Order(ent.Desc(suspension.FieldEndsAt)).
+		First(context.Background())
+}
+
+func (r *SuspensionRepository) ListByUser(userID uuid.UUID) ([]*ent.Suspension, error) {
+	return r.db.Suspension.Query().
+		Where(suspension.UserID(userID)).
+		WithIssuedBy().
+		Order(ent.Desc(suspension.FieldCreatedAt)).
+		All(context.Background())
+}
+
+// Lift ends every suspension of the user that is in effect now.
+func (r *SuspensionRepository) Lift(userID uuid.UUID) (int, error) {
+	now := time.Now()
+	return r.db.Suspension.Update().
+		Where(
+			suspension.UserID(userID),
+			activeSuspension(now),
+		).
+		SetLiftedAt(now).
+		Save(context.Background())
+}
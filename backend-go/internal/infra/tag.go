@@ -0,0 +1,72 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/ent/tag"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+type TagRepository struct {
+	db *ent.Client
+}
+
+func NewTagRepository(db *ent.Client) *TagRepository {
+	return &TagRepository{
+		db: db,
+	}
+}
+
+// CountUses leaves out posts of private users, like the explore feed, so trends only reflect
+// public posts.
+func (r *TagRepository) CountUses(from, to time.Time) ([]models.TagUsage, error) {
+	ctx := context.Background()
+	var counts []struct {
+		TagID uuid.UUID `json:"tag_id"`
+		Count int       `json:"count"`
+	}
+	err := r.db.PostTag.Query().
+		Where(
+			posttag.CreatedAtGTE(from),
+			posttag.CreatedAtLT(to),
+			posttag.HasPostWith(visiblePost(time.Now()), post.HasUserWith(user.IsPrivate(false))),
+		).
+		GroupBy(posttag.FieldTagID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		log.Errorf("Failed to count tag uses: %v", err)
+		return nil, err
+	}
+	if len(counts) == 0 {
+		return []models.TagUsage{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(counts))
+	for i, count := range counts {
+		ids[i] = count.TagID
+	}
+	tags, err := r.db.Tag.Query().
+		Where(tag.IDIn(ids...)).
+		Select(tag.FieldID, tag.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uuid.UUID]string, len(tags))
+	for _, t := range tags {
+		names[t.ID] = t.Name
+	}
+	usages := make([]models.TagUsage, len(counts))
+	for i, count := range counts {
+		usages[i] = models.TagUsage{TagID: count.TagID, Name: names[count.TagID], Count: count.Count}
+	}
+	return usages, nil
+}
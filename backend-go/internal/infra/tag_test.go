@@ -0,0 +1,115 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent/posttag"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostRepository_Tags(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	viewer := createTestUser(t, client, "viewer")
+	media := []models.PostMediaInput{{Key: "posts/" + uuid.NewString()}}
+
+	first, err := postRepo.CreatePost("#shiba #散歩", alice.ID.String(), media, nil, []string{"shiba", "散歩"}, nil, nil)
+	require.NoError(t, err)
+	second, err := postRepo.CreatePost("#shiba", alice.ID.String(), media, nil, []string{"shiba"}, nil, nil)
+	require.NoError(t, err)
+
+	posts, err := postRepo.GetPostsByTag("shiba", viewer.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{second.ID, first.ID}, postIDs(posts))
+	count, err := postRepo.CountPostsByTag("散歩", viewer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	tags, err := client.Tag.Query().All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, tags, 2, "a tag is created once")
+
+	// Editing the caption keeps the time kept tags were added
+	before, err := client.PostTag.Query().Where(posttag.PostID(first.ID)).WithTag().All(ctx)
+	require.NoError(t, err)
+	_, err = postRepo.UpdatePost(first.ID, "#散歩 #cat", nil, []string{"散歩", "cat"}, nil)
+	require.NoError(t, err)
+	after, err := client.PostTag.Query().Where(posttag.PostID(first.ID)).WithTag().All(ctx)
+	require.NoError(t, err)
+	addedAt := map[string]time.Time{}
+	for _, pt := range after {
+		addedAt[pt.Edges.Tag.Name] = pt.CreatedAt
+	}
+	require.Len(t, addedAt, 2)
+	for _, pt := range before {
+		if pt.Edges.Tag.Name == "散歩" {
+			assert.True(t, pt.CreatedAt.Equal(addedAt["散歩"]))
+		}
+	}
+	assert.True(t, addedAt["cat"].After(first.CreatedAt))
+	count, err = postRepo.CountPostsByTag("shiba", viewer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Posts of a private user are only counted for themselves and their followers
+	_, err = client.User.UpdateOneID(alice.ID).SetIsPrivate(true).Save(ctx)
+	require.NoError(t, err)
+	count, err = postRepo.CountPostsByTag("shiba", viewer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	count, err = postRepo.CountPostsByTag("shiba", alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Deleting the post removes its tags
+	require.NoError(t, postRepo.HardDeletePost(second.ID))
+	remaining, err := client.PostTag.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, remaining)
+}
+
+func TestTagRepository_CountUses(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+	tagRepo := NewTagRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	private := createTestUser(t, client, "private")
+	_, err := client.User.UpdateOneID(private.ID).SetIsPrivate(true).Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	old := createTestPost(t, client, alice)
+	recent := createTestPost(t, client, alice)
+	hidden := createTestPost(t, client, private)
+	require.NoError(t, postRepo.SetTags(old.ID, []string{"shiba"}, now.Add(-36*time.Hour)))
+	require.NoError(t, postRepo.SetTags(recent.ID, []string{"shiba", "cat"}, now.Add(-time.Hour)))
+	require.NoError(t, postRepo.SetTags(hidden.ID, []string{"shiba"}, now.Add(-time.Hour)))
+
+	usages, err := tagRepo.CountUses(now.Add(-24*time.Hour), now)
+	require.NoError(t, err)
+	counts := map[string]int{}
+	for _, usage := range usages {
+		counts[usage.Name] = usage.Count
+	}
+	assert.Equal(t, map[string]int{"shiba": 1, "cat": 1}, counts)
+
+	usages, err = tagRepo.CountUses(now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	assert.Equal(t, "shiba", usages[0].Name)
+
+	// Running the backfill again changes nothing
+	require.NoError(t, postRepo.SetTags(old.ID, []string{"shiba"}, now))
+	usages, err = tagRepo.CountUses(now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, usages, 1)
+}
@@ -0,0 +1,19 @@
+package infra
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestClient returns an ent client backed by an in-memory SQLite database private to the test.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared&_fk=1"
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
@@ -0,0 +1,63 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/timelinecache"
+	"github.com/google/uuid"
+)
+
+// TimelineCacheRepository keeps timelines in the timeline_caches table so that every API
+// instance sees the same timeline. There is one row per user; expired rows are ignored and
+// removed by DeleteExpired.
+type TimelineCacheRepository struct {
+	db *ent.Client
+}
+
+func NewTimelineCacheRepository(db *ent.Client) *TimelineCacheRepository {
+	return &TimelineCacheRepository{
+		db: db,
+	}
+}
+
+func (r *TimelineCacheRepository) Store(userID uuid.UUID, postIDs []uuid.UUID, ttl time.Duration) error {
+	return r.db.TimelineCache.Create().
+		SetUserID(userID).
+		SetPostIds(postIDs).
+		SetExpiresAt(time.Now().Add(ttl)).
+		OnConflictColumns(timelinecache.FieldUserID).
+		UpdateNewValues().
+		Exec(context.Background())
+}
+
+func (r *TimelineCacheRepository) Get(userID uuid.UUID) ([]uuid.UUID, error) {
+	cache, err := r.db.TimelineCache.Query().
+		Where(
+			timelinecache.UserID(userID),
+			timelinecache.ExpiresAtGT(time.Now()),
+		).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cache.PostIds, nil
+}
+
+func (r *TimelineCacheRepository) Delete(userID uuid.UUID) error {
+	_, err := r.db.TimelineCache.Delete().
+		Where(timelinecache.UserID(userID)).
+		Exec(context.Background())
+	return err
+}
+
+// DeleteExpired removes the timelines that have expired and returns how many were removed.
+func (r *TimelineCacheRepository) DeleteExpired() (int, error) {
+	return r.db.TimelineCache.Delete().
+		Where(timelinecache.ExpiresAtLTE(time.Now())).
+		Exec(context.Background())
+}
@@ -0,0 +1,85 @@
+package infra
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryTimelineCacheRepository_EvictsLeastRecentlyUsed(t *testing.T) {
+	repo := NewMemoryTimelineCacheRepository(2)
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	ids := []uuid.UUID{uuid.New()}
+
+	require.NoError(t, repo.Store(a, ids, time.Minute))
+	require.NoError(t, repo.Store(b, ids, time.Minute))
+	// a を参照すると b が最も古くなる
+	got, err := repo.Get(a)
+	require.NoError(t, err)
+	assert.Equal(t, ids, got)
+	require.NoError(t, repo.Store(c, ids, time.Minute))
+
+	got, err = repo.Get(b)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	got, err = repo.Get(a)
+	require.NoError(t, err)
+	assert.Equal(t, ids, got)
+	got, err = repo.Get(c)
+	require.NoError(t, err)
+	assert.Equal(t, ids, got)
+}
+
+func TestMemoryTimelineCacheRepository_Expires(t *testing.T) {
+	repo := NewMemoryTimelineCacheRepository(10)
+	now := time.Now()
+	repo.now = func() time.Time { return now }
+	userID := uuid.New()
+	ids := []uuid.UUID{uuid.New()}
+
+	require.NoError(t, repo.Store(userID, ids, time.Minute))
+	got, err := repo.Get(userID)
+	require.NoError(t, err)
+	assert.Equal(t, ids, got)
+
+	now = now.Add(time.Minute)
+	got, err = repo.Get(userID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestTimelineCacheRepository(t *testing.T) {
+	client := newTestClient(t)
+	repo := NewTimelineCacheRepository(client)
+	userID := uuid.New()
+	first := []uuid.UUID{uuid.New(), uuid.New()}
+	second := []uuid.UUID{uuid.New()}
+
+	got, err := repo.Get(userID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, repo.Store(userID, first, time.Minute))
+	require.NoError(t, repo.Store(userID, second, time.Minute))
+	got, err = repo.Get(userID)
+	require.NoError(t, err)
+	assert.Equal(t, second, got)
+
+	// 期限切れの行は返さず、DeleteExpired で削除される
+	expiredUserID := uuid.New()
+	require.NoError(t, repo.Store(expiredUserID, first, -time.Minute))
+	got, err = repo.Get(expiredUserID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	deleted, err := repo.DeleteExpired()
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	require.NoError(t, repo.Delete(userID))
+	got, err = repo.Get(userID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,161 @@
+package infra
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/tokenrevocation"
+)
+
+// TokenRevocationRepository keeps revocations in the token_revocations table so that a sign-out
+// on one API instance is seen by every other instance. There is one row per subject, looked up
+// by primary key; expired rows are ignored and removed by the next Revoke.
+type TokenRevocationRepository struct {
+	db *ent.Client
+}
+
+func NewTokenRevocationRepository(db *ent.Client) *TokenRevocationRepository {
+	return &TokenRevocationRepository{
+		db: db,
+	}
+}
+
+func (r *TokenRevocationRepository) Revoke(subject string, revokedAt, expiresAt time.Time) error {
+	ctx := context.Background()
+	// サインアウトは認証に比べて少ないので、期限切れの記録はここで消す
+	if _, err := r.db.TokenRevocation.Delete().
+		Where(tokenrevocation.ExpiresAtLTE(revokedAt)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return r.db.TokenRevocation.Create().
+		SetID(subject).
+		SetRevokedAt(revokedAt).
+		SetExpiresAt(expiresAt).
+		OnConflictColumns(tokenrevocation.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
+func (r *TokenRevocationRepository) RevokedAt(subject string, now time.Time) (time.Time, bool, error) {
+	revocation, err := r.db.TokenRevocation.Get(context.Background(), subject)
+	if ent.IsNotFound(err) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if !revocation.ExpiresAt.After(now) {
+		return time.Time{}, false, nil
+	}
+	return revocation.RevokedAt, true, nil
+}
+
+// revocationCacheTTL は他のインスタンスでのサインアウトが反映されるまでの最大の遅れ。
+const revocationCacheTTL = 5 * time.Second
+
+// revocationCacheSize is the number of subjects above which expired cache entries are swept.
+const revocationCacheSize = 10000
+
+// cachedRevocationStore remembers the lookups of store for ttl, so that an authenticated request
+// does not read the store on every call. A sign-out on another instance is seen after at most ttl;
+// one on this instance is seen at once.
+type cachedRevocationStore struct {
+	store   RevocationStore
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]cachedRevocation
+}
+
+type cachedRevocation struct {
+	revokedAt   time.Time
+	revoked     bool
+	cachedUntil time.Time
+}
+
+func newCachedRevocationStore(store RevocationStore, ttl time.Duration) *cachedRevocationStore {
+	return &cachedRevocationStore{
+		store:   store,
+		ttl:     ttl,
+		entries: make(map[string]cachedRevocation),
+	}
+}
+
+func (s *cachedRevocationStore) Revoke(subject string, revokedAt, expiresAt time.Time) error {
+	if err := s.store.Revoke(subject, revokedAt, expiresAt); err != nil {
+		return err
+	}
+	s.put(subject, cachedRevocation{revokedAt: revokedAt, revoked: true, cachedUntil: revokedAt.Add(s.ttl)}, revokedAt)
+	return nil
+}
+
+func (s *cachedRevocationStore) RevokedAt(subject string, now time.Time) (time.Time, bool, error) {
+	s.mu.Lock()
+	entry, ok := s.entries[subject]
+	s.mu.Unlock()
+	if ok && now.Before(entry.cachedUntil) {
+		return entry.revokedAt, entry.revoked, nil
+	}
+
+	revokedAt, revoked, err := s.store.RevokedAt(subject, now)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	s.put(subject, cachedRevocation{revokedAt: revokedAt, revoked: revoked, cachedUntil: now.Add(s.ttl)}, now)
+	return revokedAt, revoked, nil
+}
+
+func (s *cachedRevocationStore) put(subject string, entry cachedRevocation, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.entries) >= revocationCacheSize {
+		for cachedSubject, cached := range s.entries {
+			if !now.Before(cached.cachedUntil) {
+				delete(s.entries, cachedSubject)
+			}
+		}
+	}
+	s.entries[subject] = entry
+}
+
+// memoryRevocationStore keeps revocations in the process. A sign-out only takes effect on the
+// instance that handled it, so it is only suitable for a single long-running server and tests.
+type memoryRevocationStore struct {
+	mu      sync.Mutex
+	revoked map[string]memoryRevocation
+}
+
+type memoryRevocation struct {
+	revokedAt time.Time
+	expiresAt time.Time
+}
+
+func newMemoryRevocationStore() *memoryRevocationStore {
+	return &memoryRevocationStore{
+		revoked: make(map[string]memoryRevocation),
+	}
+}
+
+func (s *memoryRevocationStore) Revoke(subject string, revokedAt, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for revokedSubject, revocation := range s.revoked {
+		if !revocation.expiresAt.After(revokedAt) {
+			delete(s.revoked, revokedSubject)
+		}
+	}
+	s.revoked[subject] = memoryRevocation{revokedAt: revokedAt, expiresAt: expiresAt}
+	return nil
+}
+
+func (s *memoryRevocationStore) RevokedAt(subject string, now time.Time) (time.Time, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	revocation, ok := s.revoked[subject]
+	if !ok || !revocation.expiresAt.After(now) {
+		return time.Time{}, false, nil
+	}
+	return revocation.revokedAt, true, nil
+}
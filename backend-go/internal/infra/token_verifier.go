@@ -0,0 +1,136 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/jwk"
+)
+
+// revocationTTL は SignOut 後にアクセストークンを拒否し続ける期間。
+// Cognito のアクセストークンの有効期限 (既定 1 時間) を超えていれば十分。
+const revocationTTL = time.Hour
+
+// RevocationStore records when the access tokens of a subject were revoked.
+type RevocationStore interface {
+	// Revoke rejects the tokens of the subject issued up to revokedAt, until expiresAt.
+	Revoke(subject string, revokedAt, expiresAt time.Time) error
+	// RevokedAt returns when the tokens of the subject were revoked, if the revocation has not expired.
+	RevokedAt(subject string, now time.Time) (time.Time, bool, error)
+}
+
+// AccessTokenVerifier verifies Cognito access tokens locally with the user pool's JWKS,
+// so that authenticated requests do not need a round trip to Cognito.
+type AccessTokenVerifier struct {
+	issuer      string
+	clientId    string
+	keySet      func(ctx context.Context) (jwk.Set, error)
+	revocations RevocationStore
+	now         func() time.Time
+}
+
+// NewAccessTokenVerifier keeps revocations in revocations. A nil store keeps them in the process,
+// so a sign-out is only seen by this instance.
+func NewAccessTokenVerifier(issuer, clientId string, keySet func(ctx context.Context) (jwk.Set, error), revocations RevocationStore) *AccessTokenVerifier {
+	if revocations == nil {
+		revocations = newMemoryRevocationStore()
+	}
+	return &AccessTokenVerifier{
+		issuer:      issuer,
+		clientId:    clientId,
+		keySet:      keySet,
+		revocations: revocations,
+		now:         time.Now,
+	}
+}
+
+// Verify checks the signature, exp, iss, token_use and client_id claims of an access token.
+func (v *AccessTokenVerifier) Verify(accessToken string) (*models.AccessTokenClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, v.lookupKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(v.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify access token: %w", err)
+	}
+
+	if tokenUse, _ := claims["token_use"].(string); tokenUse != "access" {
+		return nil, fmt.Errorf("unexpected token_use: %q", tokenUse)
+	}
+	if clientId, _ := claims["client_id"].(string); clientId != v.clientId {
+		return nil, errors.New("client_id mismatch")
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, errors.New("sub not found in token claims")
+	}
+	username, _ := claims["username"].(string)
+
+	result := &models.AccessTokenClaims{
+		Subject:  subject,
+		Username: username,
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		result.ExpiresAt = exp.Time
+	}
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		result.IssuedAt = iat.Time
+	}
+
+	revoked, err := v.isRevoked(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check revocation: %w", err)
+	}
+	if revoked {
+		return nil, errors.New("access token has been revoked")
+	}
+
+	return result, nil
+}
+
+// Revoke rejects every access token of the subject issued up to now.
+// The entry is kept only for revocationTTL, after which those tokens have expired anyway.
+func (v *AccessTokenVerifier) Revoke(subject string) error {
+	now := v.now()
+	return v.revocations.Revoke(subject, now, now.Add(revocationTTL))
+}
+
+func (v *AccessTokenVerifier) isRevoked(claims *models.AccessTokenClaims) (bool, error) {
+	revokedAt, ok, err := v.revocations.RevokedAt(claims.Subject, v.now())
+	if err != nil || !ok {
+		return false, err
+	}
+	// iat は秒精度なので、失効と同じ秒に発行されたトークンも拒否する
+	return !claims.IssuedAt.After(revokedAt.Truncate(time.Second)), nil
+}
+
+func (v *AccessTokenVerifier) lookupKey(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return nil, errors.New("token header does not contain kid")
+	}
+
+	keySet, err := v.keySet(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWK set: %w", err)
+	}
+
+	key, found := keySet.LookupKeyID(kid)
+	if !found {
+		return nil, errors.New("matching key not found in JWK set")
+	}
+
+	var publicKey interface{}
+	if err := key.Raw(&publicKey); err != nil {
+		return nil, fmt.Errorf("failed to get public key: %w", err)
+	}
+	return publicKey, nil
+}
@@ -0,0 +1,290 @@
+package infra
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://cognito-idp.ap-northeast-1.amazonaws.com/ap-northeast-1_test"
+	testClientId = "test-client-id"
+	testKeyID    = "test-kid"
+)
+
+// newTestVerifier returns a verifier that trusts only the public half of the returned key.
+// Revocations are kept in the process.
+func newTestVerifier(t *testing.T, now time.Time) (*AccessTokenVerifier, *rsa.PrivateKey) {
+	return newTestVerifierWithStore(t, now, nil)
+}
+
+func newTestVerifierWithStore(t *testing.T, now time.Time, revocations RevocationStore) (*AccessTokenVerifier, *rsa.PrivateKey) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	publicJWK, err := jwk.New(&privateKey.PublicKey)
+	require.NoError(t, err)
+	require.NoError(t, publicJWK.Set(jwk.KeyIDKey, testKeyID))
+	keySet := jwk.NewSet()
+	keySet.Add(publicJWK)
+
+	verifier := NewAccessTokenVerifier(testIssuer, testClientId, func(ctx context.Context) (jwk.Set, error) {
+		return keySet, nil
+	}, revocations)
+	verifier.now = func() time.Time { return now }
+	return verifier, privateKey
+}
+
+func validClaims(now time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":       "8c1d5a2e-0000-4000-8000-000000000001",
+		"username":  "test@example.com",
+		"iss":       testIssuer,
+		"client_id": testClientId,
+		"token_use": "access",
+		"iat":       now.Add(-time.Minute).Unix(),
+		"exp":       now.Add(time.Hour).Unix(),
+	}
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestAccessTokenVerifier_Verify(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		modify      func(claims jwt.MapClaims)
+		signingKey  *rsa.PrivateKey
+		kid         string
+		expectError bool
+	}{
+		{
+			name: "Valid token",
+		},
+		{
+			name:        "Expired token",
+			modify:      func(claims jwt.MapClaims) { claims["exp"] = now.Add(-time.Second).Unix() },
+			expectError: true,
+		},
+		{
+			name:        "Missing exp",
+			modify:      func(claims jwt.MapClaims) { delete(claims, "exp") },
+			expectError: true,
+		},
+		{
+			name:        "Wrong issuer",
+			modify:      func(claims jwt.MapClaims) { claims["iss"] = "https://example.com" },
+			expectError: true,
+		},
+		{
+			name:        "ID token instead of access token",
+			modify:      func(claims jwt.MapClaims) { claims["token_use"] = "id" },
+			expectError: true,
+		},
+		{
+			name:        "Wrong client_id",
+			modify:      func(claims jwt.MapClaims) { claims["client_id"] = "another-client" },
+			expectError: true,
+		},
+		{
+			name:        "Missing sub",
+			modify:      func(claims jwt.MapClaims) { delete(claims, "sub") },
+			expectError: true,
+		},
+		{
+			name:        "Signed with an unknown key",
+			signingKey:  otherKey,
+			expectError: true,
+		},
+		{
+			name:        "Unknown kid",
+			kid:         "unknown-kid",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier, privateKey := newTestVerifier(t, now)
+
+			claims := validClaims(now)
+			if tc.modify != nil {
+				tc.modify(claims)
+			}
+			signingKey := privateKey
+			if tc.signingKey != nil {
+				signingKey = tc.signingKey
+			}
+			kid := testKeyID
+			if tc.kid != "" {
+				kid = tc.kid
+			}
+
+			result, err := verifier.Verify(signToken(t, signingKey, kid, claims))
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, claims["sub"], result.Subject)
+			assert.Equal(t, claims["username"], result.Username)
+			assert.Equal(t, now.Add(time.Hour).Unix(), result.ExpiresAt.Unix())
+		})
+	}
+}
+
+func TestAccessTokenVerifier_RejectsHS256(t *testing.T) {
+	now := time.Now()
+	verifier, _ := newTestVerifier(t, now)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims(now))
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(signed)
+	assert.Error(t, err)
+}
+
+func TestAccessTokenVerifier_Revoke(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	verifier, privateKey := newTestVerifier(t, now)
+
+	claims := validClaims(now)
+	token := signToken(t, privateKey, testKeyID, claims)
+
+	_, err := verifier.Verify(token)
+	require.NoError(t, err)
+
+	require.NoError(t, verifier.Revoke(claims["sub"].(string)))
+
+	// サインアウト前に発行されたトークンは拒否される
+	_, err = verifier.Verify(token)
+	assert.Error(t, err)
+
+	// サインアウト後に発行されたトークンは受け付ける
+	later := now.Add(5 * time.Minute)
+	verifier.now = func() time.Time { return later }
+	newClaims := validClaims(later)
+	_, err = verifier.Verify(signToken(t, privateKey, testKeyID, newClaims))
+	assert.NoError(t, err)
+
+	// 別のユーザーには影響しない
+	otherClaims := validClaims(now)
+	otherClaims["sub"] = "8c1d5a2e-0000-4000-8000-000000000002"
+	_, err = verifier.Verify(signToken(t, privateKey, testKeyID, otherClaims))
+	assert.NoError(t, err)
+}
+
+func TestAccessTokenVerifier_RevocationExpires(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	verifier, privateKey := newTestVerifier(t, now)
+
+	claims := validClaims(now)
+	claims["exp"] = now.Add(3 * time.Hour).Unix()
+	token := signToken(t, privateKey, testKeyID, claims)
+
+	require.NoError(t, verifier.Revoke(claims["sub"].(string)))
+	_, err := verifier.Verify(token)
+	assert.Error(t, err)
+
+	later := now.Add(revocationTTL + time.Minute)
+	verifier.now = func() time.Time { return later }
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+
+	// Expired revocations are pruned by the next Revoke, not while verifying
+	store := verifier.revocations.(*memoryRevocationStore)
+	assert.Len(t, store.revoked, 1)
+	require.NoError(t, verifier.Revoke("8c1d5a2e-0000-4000-8000-000000000002"))
+	assert.Len(t, store.revoked, 1)
+	assert.Contains(t, store.revoked, "8c1d5a2e-0000-4000-8000-000000000002")
+}
+
+func TestAccessTokenVerifier_SharedRevocations(t *testing.T) {
+	client := newTestClient(t)
+	now := time.Now().Truncate(time.Second)
+	// Two API instances share revocations through the database
+	first, privateKey := newTestVerifierWithStore(t, now, NewTokenRevocationRepository(client))
+	second := *first
+	second.revocations = NewTokenRevocationRepository(client)
+
+	claims := validClaims(now)
+	claims["exp"] = now.Add(3 * time.Hour).Unix()
+	token := signToken(t, privateKey, testKeyID, claims)
+	require.NoError(t, first.Revoke(claims["sub"].(string)))
+	_, err := second.Verify(token)
+	assert.Error(t, err)
+
+	// Expired rows are ignored, and removed by the next Revoke
+	later := now.Add(revocationTTL + time.Minute)
+	second.now = func() time.Time { return later }
+	_, err = second.Verify(token)
+	assert.NoError(t, err)
+	require.NoError(t, second.Revoke("8c1d5a2e-0000-4000-8000-000000000002"))
+	count, err := client.TokenRevocation.Query().Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+type countingRevocationStore struct {
+	RevocationStore
+	lookups int
+}
+
+func (s *countingRevocationStore) RevokedAt(subject string, now time.Time) (time.Time, bool, error) {
+	s.lookups++
+	return s.RevocationStore.RevokedAt(subject, now)
+}
+
+func TestAccessTokenVerifier_CachedRevocations(t *testing.T) {
+	client := newTestClient(t)
+	now := time.Now().Truncate(time.Second)
+	store := &countingRevocationStore{RevocationStore: NewTokenRevocationRepository(client)}
+	first, privateKey := newTestVerifierWithStore(t, now, newCachedRevocationStore(NewTokenRevocationRepository(client), revocationCacheTTL))
+	second := *first
+	second.revocations = newCachedRevocationStore(store, revocationCacheTTL)
+
+	claims := validClaims(now)
+	token := signToken(t, privateKey, testKeyID, claims)
+	for i := 0; i < 3; i++ {
+		_, err := second.Verify(token)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, store.lookups)
+
+	// The instance that revoked sees it at once, the other one after the cache expires
+	require.NoError(t, first.Revoke(claims["sub"].(string)))
+	_, err := first.Verify(token)
+	assert.Error(t, err)
+	_, err = second.Verify(token)
+	assert.NoError(t, err)
+
+	later := now.Add(revocationCacheTTL)
+	second.now = func() time.Time { return later }
+	_, err = second.Verify(token)
+	assert.Error(t, err)
+	assert.Equal(t, 2, store.lookups)
+}
@@ -3,9 +3,15 @@ package infra
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/like"
 	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
 	"github.com/aki-13627/animalia/backend-go/ent/user"
 	"github.com/google/uuid"
 )
@@ -24,7 +30,7 @@ func (r *UserRepository) GetDB() *ent.Client {
 	return r.db
 }
 
-func (r *UserRepository) Create(name, email string) (*ent.User, error) {
+func (r *UserRepository) Create(name, email, handle string) (*ent.User, error) {
 	exists, err := r.ExistsEmail(email)
 	if err != nil {
 		return nil, err
@@ -41,6 +47,7 @@ func (r *UserRepository) Create(name, email string) (*ent.User, error) {
 	user, err := r.db.User.Create().
 		SetName(name).
 		SetEmail(email).
+		SetHandle(handle).
 		SetIndex(uint32(userCount)).
 		Save(context.Background())
 	if err != nil {
@@ -58,21 +65,33 @@ func (r *UserRepository) ExistsEmail(email string) (bool, error) {
 	return exists, nil
 }
 
+func (r *UserRepository) ExistsHandle(handle string) (bool, error) {
+	return r.db.User.Query().Where(user.Handle(handle)).Exist(context.Background())
+}
+
 func (r *UserRepository) FindByEmail(email string) (*ent.User, error) {
-	user, err := r.db.User.Query().Where(user.Email(email)).
-		WithFollowing(func(q *ent.FollowRelationQuery) {
-			q.WithTo()
-		}).
-		WithFollowers(
-			func(q *ent.FollowRelationQuery) {
-				q.WithFrom()
-			}).
-		WithBlocking(func(q *ent.BlockRelationQuery) {
-			q.WithTo()
-		}).
-		WithBlockedBy(func(q *ent.BlockRelationQuery) {
-			q.WithFrom()
-		}).
+	return r.findProfile(user.Email(email))
+}
+
+func (r *UserRepository) FindById(id uuid.UUID) (*ent.User, error) {
+	return r.findProfile(user.ID(id))
+}
+
+func (r *UserRepository) FindByHandle(handle string) (*ent.User, error) {
+	return r.findProfile(user.Handle(handle))
+}
+
+func (r *UserRepository) GetMentionable(authorID uuid.UUID, handles []string) ([]*ent.User, error) {
+	return r.db.User.Query().
+		Where(user.HandleIn(handles...), activeUser(time.Now()), notBlockedWith(authorID)).
+		Select(user.FieldID, user.FieldHandle).
+		All(context.Background())
+}
+
+// findProfile loads a user with the latest daily task. Follow and block lists are
+// paginated separately, so they are not loaded here.
+func (r *UserRepository) findProfile(where predicate.User) (*ent.User, error) {
+	user, err := r.db.User.Query().Where(where).
 		WithDailyTasks(func(q *ent.DailyTaskQuery) {
 			q.WithPost(func(pq *ent.PostQuery) {
 				pq.Where(post.DeletedAtIsNil()).
@@ -89,6 +108,29 @@ func (r *UserRepository) FindByEmail(email string) (*ent.User, error) {
 	return user, nil
 }
 
+func (r *UserRepository) GetByEmail(email string) (*ent.User, error) {
+	user, err := r.db.User.Query().Where(user.Email(email)).Only(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *UserRepository) GetByAuthSubject(subject string) (*ent.User, error) {
+	user, err := r.db.User.Query().Where(user.AuthSubject(subject)).Only(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *UserRepository) SetAuthSubject(id uuid.UUID, subject string) error {
+	_, err := r.db.User.UpdateOneID(id).
+		SetAuthSubject(subject).
+		Save(context.Background())
+	return err
+}
+
 func (r *UserRepository) GetAll() ([]*ent.User, error) {
 	users, err := r.db.User.Query().All(context.Background())
 	if err != nil {
@@ -120,7 +162,129 @@ func (r *UserRepository) UpdateStreakCount(id uuid.UUID, streak uint32) error {
 		Save(context.Background())
 	return err
 }
-func (r *UserRepository) Delete(id uuid.UUID) error {
-	err := r.db.User.DeleteOneID(id).Exec(context.Background())
+
+func (r *UserRepository) Search(query string, limit, offset int) ([]*ent.User, error) {
+	q := r.db.User.Query()
+	if query != "" {
+		q = q.Where(user.Or(
+			user.NameContainsFold(query),
+			user.EmailContainsFold(query),
+		))
+	}
+	users, err := q.
+		Order(ent.Desc(user.FieldCreatedAt), ent.Desc(user.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *UserRepository) UpdateRole(id uuid.UUID, role enum.Role) error {
+	_, err := r.db.User.UpdateOneID(id).
+		SetRole(role).
+		Save(context.Background())
+	return err
+}
+
+func (r *UserRepository) SetPrivate(id uuid.UUID, private bool) error {
+	_, err := r.db.User.UpdateOneID(id).
+		SetIsPrivate(private).
+		Save(context.Background())
+	return err
+}
+
+// UpdateHandle returns a constraint error when the handle is taken.
+func (r *UserRepository) UpdateHandle(id uuid.UUID, handle string) error {
+	_, err := r.db.User.UpdateOneID(id).
+		SetHandle(handle).
+		Save(context.Background())
 	return err
 }
+
+// GetWithoutHandle returns users created before handles were introduced.
+func (r *UserRepository) GetWithoutHandle() ([]*ent.User, error) {
+	return r.db.User.Query().
+		Where(user.Or(user.HandleIsNil(), user.Handle(""))).
+		Order(ent.Asc(user.FieldCreatedAt)).
+		All(context.Background())
+}
+
+// Delete removes the user with everything they own, and takes their follows, likes and comments
+// out of the counters of other users and posts in the same transaction.
+func (r *UserRepository) Delete(id uuid.UUID) error {
+	ctx := context.Background()
+	tx, err := r.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := deleteUser(ctx, tx, id); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func deleteUser(ctx context.Context, tx *ent.Tx, id uuid.UUID) error {
+	followingIDs, err := tx.FollowRelation.Query().
+		Where(followrelation.HasFromWith(user.ID(id))).
+		QueryTo().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	followerIDs, err := tx.FollowRelation.Query().
+		Where(followrelation.HasToWith(user.ID(id))).
+		QueryFrom().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	likedIDs, err := tx.Like.Query().
+		Where(like.HasUserWith(user.ID(id))).
+		QueryPost().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	commentedIDs, err := tx.Comment.Query().
+		Where(comment.HasUserWith(user.ID(id))).
+		QueryPost().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.User.Update().Where(user.IDIn(followingIDs...)).AddFollowerCount(-1).Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.User.Update().Where(user.IDIn(followerIDs...)).AddFollowingCount(-1).Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.Post.Update().Where(post.IDIn(likedIDs...)).AddLikeCount(-1).Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.User.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+
+	// 返信ごと消えるコメントもあるので、コメント数は削除後に数え直す
+	counts, err := commentCounts(ctx, tx.Comment, commentedIDs)
+	if err != nil {
+		return err
+	}
+	for _, postID := range commentedIDs {
+		err := tx.Post.Update().
+			Where(post.ID(postID)).
+			SetCommentCount(counts[postID]).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package infra
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/blockrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/comment"
+	"github.com/aki-13627/animalia/backend-go/ent/followrelation"
+	"github.com/aki-13627/animalia/backend-go/ent/like"
+	"github.com/aki-13627/animalia/backend-go/ent/mutedkeyword"
+	"github.com/aki-13627/animalia/backend-go/ent/muterelation"
+	"github.com/aki-13627/animalia/backend-go/ent/notification"
+	"github.com/aki-13627/animalia/backend-go/ent/post"
+	"github.com/aki-13627/animalia/backend-go/ent/predicate"
+	"github.com/aki-13627/animalia/backend-go/ent/suspension"
+	"github.com/aki-13627/animalia/backend-go/ent/user"
+	"github.com/google/uuid"
+)
+
+// フィードに表示してよいコンテンツの条件。フィード系のクエリはすべてこれを通す。
+
+// activeUser matches users who are not suspended at the given time.
+func activeUser(now time.Time) predicate.User {
+	return user.Not(user.HasSuspensionsWith(activeSuspension(now)))
+}
+
+// activeSuspension matches suspensions in effect at the given time.
+func activeSuspension(now time.Time) predicate.Suspension {
+	return suspension.And(
+		suspension.StartsAtLTE(now),
+		suspension.EndsAtGT(now),
+		suspension.LiftedAtIsNil(),
+	)
+}
+
+// visiblePost matches posts that are neither deleted, hidden after reports,
+// nor written by a suspended user.
+func visiblePost(now time.Time) predicate.Post {
+	return post.And(
+		post.DeletedAtIsNil(),
+		post.HiddenAtIsNil(),
+		post.HasUserWith(activeUser(now)),
+	)
+}
+
+// visibleComment matches comments that are not deleted, not hidden after reports and not written
+// by a suspended user. Replies also disappear with a deleted or hidden parent.
+func visibleComment(now time.Time) predicate.Comment {
+	return comment.And(
+		comment.DeletedAtIsNil(),
+		comment.HiddenAtIsNil(),
+		comment.HasUserWith(activeUser(now)),
+		comment.Or(
+			comment.ParentIDIsNil(),
+			comment.HasParentWith(comment.DeletedAtIsNil(), comment.HiddenAtIsNil()),
+		),
+	)
+}
+
+// notBlockedWith matches users who neither block the viewer nor are blocked by the viewer.
+func notBlockedWith(viewerID uuid.UUID) predicate.User {
+	return user.Not(user.Or(
+		user.HasBlockingWith(blockrelation.HasToWith(user.ID(viewerID))),
+		user.HasBlockedByWith(blockrelation.HasFromWith(user.ID(viewerID))),
+	))
+}
+
+// profileVisibleTo matches public users, the viewer and private users the viewer follows.
+func profileVisibleTo(viewerID uuid.UUID) predicate.User {
+	return user.Or(
+		user.IsPrivate(false),
+		user.ID(viewerID),
+		user.HasFollowersWith(followrelation.HasFromWith(user.ID(viewerID))),
+	)
+}
+
+// postVisibleTo is visiblePost with the blocks of the viewer and private accounts applied.
+func postVisibleTo(viewerID uuid.UUID, now time.Time) predicate.Post {
+	return post.And(
+		visiblePost(now),
+		post.HasUserWith(notBlockedWith(viewerID), profileVisibleTo(viewerID)),
+	)
+}
+
+// commentVisibleTo is visibleComment with the blocks of the viewer applied.
+func commentVisibleTo(viewerID uuid.UUID, now time.Time) predicate.Comment {
+	return comment.And(
+		visibleComment(now),
+		comment.HasUserWith(notBlockedWith(viewerID)),
+	)
+}
+
+// notificationVisibleTo matches the notifications of the recipient from users without a block
+// relation to them, about posts and comments they can still see.
+func notificationVisibleTo(recipientID uuid.UUID, now time.Time) predicate.Notification {
+	return notification.And(
+		notification.RecipientID(recipientID),
+		notification.HasActorWith(activeUser(now), notBlockedWith(recipientID)),
+		notification.Or(notification.PostIDIsNil(), notification.HasPostWith(postVisibleTo(recipientID, now))),
+		notification.Or(notification.CommentIDIsNil(), notification.HasCommentWith(commentVisibleTo(recipientID, now))),
+	)
+}
+
+// likeVisibleTo matches likes by users who are not suspended and have no block relation to the viewer.
+func likeVisibleTo(viewerID uuid.UUID, now time.Time) predicate.Like {
+	return like.HasUserWith(activeUser(now), notBlockedWith(viewerID))
+}
+
+// notMutedBy matches posts whose author is not muted by the viewer and whose caption
+// contains none of the viewer's muted keywords. The keywords are loaded first since
+// each one becomes its own condition.
+func notMutedBy(ctx context.Context, db *ent.Client, viewerID uuid.UUID) (predicate.Post, error) {
+	keywords, err := db.MutedKeyword.Query().
+		Where(mutedkeyword.UserID(viewerID)).
+		Select(mutedkeyword.FieldKeyword).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	predicates := []predicate.Post{
+		post.HasUserWith(user.Not(user.HasMutedByWith(muterelation.HasFromWith(user.ID(viewerID))))),
+	}
+	for _, keyword := range keywords {
+		predicates = append(predicates, post.Not(post.CaptionContainsFold(keyword)))
+	}
+	return post.And(predicates...), nil
+}
@@ -0,0 +1,314 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestUser(t *testing.T, client *ent.Client, name string) *ent.User {
+	t.Helper()
+	user, err := client.User.Create().
+		SetName(name).
+		SetEmail(name + "@example.com").
+		Save(context.Background())
+	require.NoError(t, err)
+	return user
+}
+
+func createTestPost(t *testing.T, client *ent.Client, user *ent.User) *ent.Post {
+	t.Helper()
+	post, err := client.Post.Create().
+		SetCaption("caption").
+		SetImageKey("posts/" + uuid.NewString()).
+		SetUser(user).
+		Save(context.Background())
+	require.NoError(t, err)
+	return post
+}
+
+func postIDs(posts []*ent.Post) []uuid.UUID {
+	ids := make([]uuid.UUID, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	return ids
+}
+
+func TestPostRepository_HidesHiddenAndSuspendedContent(t *testing.T) {
+	client := newTestClient(t)
+	postRepo := NewPostRepository(client)
+	suspensionRepo := NewSuspensionRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	moderator := createTestUser(t, client, "moderator")
+
+	visible := createTestPost(t, client, alice)
+	hidden := createTestPost(t, client, alice)
+	bobs := createTestPost(t, client, bob)
+	require.NoError(t, postRepo.SetHidden(hidden.ID, true))
+
+	commentRepo := NewCommentRepository(client)
+	_, err := commentRepo.Create(alice.ID, visible.ID, nil, "ok", nil)
+	require.NoError(t, err)
+	_, err = commentRepo.Create(bob.ID, visible.ID, nil, "from bob", nil)
+	require.NoError(t, err)
+
+	posts, err := postRepo.GetAllPosts(moderator.ID, nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{visible.ID, bobs.ID}, postIDs(posts))
+	posts, err = postRepo.GetPostsByUser(alice.ID, moderator.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{visible.ID}, postIDs(posts), "hidden posts are not on the profile")
+
+	// A suspended user's posts and comments disappear while the suspension lasts
+	_, err = suspensionRepo.Create(bob.ID, moderator.ID, "spam", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	posts, err = postRepo.GetPostsByUser(bob.ID, moderator.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+
+	posts, err = postRepo.GetByIds(moderator.ID, []uuid.UUID{visible.ID, hidden.ID, bobs.ID})
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{visible.ID}, postIDs(posts))
+	stats, err := postRepo.GetStats(moderator.ID, []uuid.UUID{visible.ID}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats[visible.ID].CommentsCount, "comments of suspended users are not counted")
+	require.Len(t, stats[visible.ID].RecentComments, 1)
+	assert.Equal(t, alice.ID, stats[visible.ID].RecentComments[0].Edges.User.ID)
+
+	active, err := suspensionRepo.GetActive(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "spam", active.Reason)
+
+	// Lifting the suspension and unhiding the post restores everything
+	lifted, err := suspensionRepo.Lift(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, lifted)
+	_, err = suspensionRepo.GetActive(bob.ID)
+	assert.True(t, ent.IsNotFound(err))
+	require.NoError(t, postRepo.SetHidden(hidden.ID, false))
+
+	posts, err = postRepo.GetAllPosts(moderator.ID, nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{visible.ID, hidden.ID, bobs.ID}, postIDs(posts))
+}
+
+func TestPostRepository_HidesBlockedUsers(t *testing.T) {
+	client := newTestClient(t)
+	postRepo := NewPostRepository(client)
+	blockRepo := NewBlockRelationRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+
+	alices := createTestPost(t, client, alice)
+	bobs := createTestPost(t, client, bob)
+	_, err := NewCommentRepository(client).Create(bob.ID, alices.ID, nil, "from bob", nil)
+	require.NoError(t, err)
+	require.NoError(t, NewLikeRepository(client).Create(bob.ID.String(), alices.ID.String(), enum.ReactionTypePaw))
+
+	// alice blocks bob: neither sees the other's posts, comments or likes, nor counts them
+	require.NoError(t, blockRepo.Create(alice.ID.String(), bob.ID.String()))
+
+	blocked, err := blockRepo.IsBlockedBetween(bob.ID, alice.ID)
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	ids, err := blockRepo.BlockedUserIDs(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{alice.ID}, ids)
+
+	posts, err := postRepo.GetAllPosts(alice.ID, nil, 10)
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{alices.ID}, postIDs(posts))
+	stats, err := postRepo.GetStats(alice.ID, []uuid.UUID{alices.ID}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats[alices.ID].CommentsCount)
+	assert.Equal(t, 0, stats[alices.ID].LikesCount)
+	assert.Empty(t, stats[alices.ID].Reactions)
+	assert.Empty(t, stats[alices.ID].RecentComments)
+	stats, err = postRepo.GetStats(carol.ID, []uuid.UUID{alices.ID}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats[alices.ID].CommentsCount)
+	assert.Equal(t, 1, stats[alices.ID].LikesCount)
+
+	posts, err = postRepo.GetAllPosts(bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bobs.ID}, postIDs(posts))
+
+	// Other users still see everything
+	posts, err = postRepo.GetByIds(carol.ID, []uuid.UUID{alices.ID, bobs.ID})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{alices.ID, bobs.ID}, postIDs(posts))
+	stats, err = postRepo.GetStats(carol.ID, []uuid.UUID{alices.ID}, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats[alices.ID].CommentsCount)
+	assert.Equal(t, 1, stats[alices.ID].LikesCount)
+	assert.Len(t, stats[alices.ID].RecentComments, 1)
+}
+
+func TestPostRepository_HidesMutedUsersAndKeywords(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+	muteRepo := NewMuteRelationRepository(client)
+	keywordRepo := NewMutedKeywordRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+
+	bobs := createTestPost(t, client, bob)
+	cat, err := client.Post.Create().SetCaption("うちのネコです").SetImageKey("posts/cat").SetUser(carol).Save(ctx)
+	require.NoError(t, err)
+	spoiler, err := client.Post.Create().SetCaption("SPOILER alert").SetImageKey("posts/spoiler").SetUser(carol).Save(ctx)
+	require.NoError(t, err)
+	carols := createTestPost(t, client, carol)
+
+	for _, to := range []*ent.User{bob, carol} {
+		_, err := client.FollowRelation.Create().SetFrom(alice).SetTo(to).Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// alice mutes bob and two keywords; muting twice is not an error
+	require.NoError(t, muteRepo.Create(alice.ID, bob.ID))
+	require.NoError(t, muteRepo.Create(alice.ID, bob.ID))
+	_, err = keywordRepo.Create(alice.ID, "ネコ")
+	require.NoError(t, err)
+	_, err = keywordRepo.Create(alice.ID, "spoiler")
+	require.NoError(t, err)
+
+	posts, err := postRepo.GetAllPosts(alice.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{carols.ID}, postIDs(posts))
+
+	posts, err = postRepo.GetFollowsPosts(alice.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{carols.ID}, postIDs(posts))
+
+	// The follow relation is kept
+	following, err := client.FollowRelation.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, following)
+
+	// Other users are not affected
+	posts, err = postRepo.GetAllPosts(carol.ID, nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{bobs.ID, cat.ID, spoiler.ID, carols.ID}, postIDs(posts))
+
+	// Unmuting shows bob's posts again
+	require.NoError(t, muteRepo.Delete(alice.ID, bob.ID))
+	posts, err = postRepo.GetAllPosts(alice.ID, nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{bobs.ID, carols.ID}, postIDs(posts))
+}
+
+func TestPostRepository_HidesPrivateAccounts(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	postRepo := NewPostRepository(client)
+	userRepo := NewUserRepository(client)
+	requestRepo := NewFollowRequestRepository(client)
+
+	alice := createTestUser(t, client, "alice")
+	bob := createTestUser(t, client, "bob")
+	carol := createTestUser(t, client, "carol")
+	require.NoError(t, userRepo.SetPrivate(alice.ID, true))
+
+	alices := createTestPost(t, client, alice)
+	bobs := createTestPost(t, client, bob)
+
+	// Nobody but alice sees alice's posts until a request is approved
+	posts, err := postRepo.GetAllPosts(bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bobs.ID}, postIDs(posts))
+	posts, err = postRepo.GetPostsByUser(alice.ID, bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+	posts, err = postRepo.GetPostsByUser(alice.ID, alice.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{alices.ID}, postIDs(posts))
+
+	// Requesting twice keeps a single request
+	require.NoError(t, requestRepo.Create(bob.ID, alice.ID))
+	require.NoError(t, requestRepo.Create(bob.ID, alice.ID))
+	require.NoError(t, requestRepo.Create(carol.ID, alice.ID))
+	incoming, err := requestRepo.ListIncoming(alice.ID)
+	require.NoError(t, err)
+	require.Len(t, incoming, 2)
+
+	var bobsRequest *ent.FollowRequest
+	for _, request := range incoming {
+		if request.Edges.From.ID == bob.ID {
+			bobsRequest = request
+		}
+	}
+	require.NotNil(t, bobsRequest)
+	require.NoError(t, requestRepo.Approve(bobsRequest.ID))
+
+	posts, err = postRepo.GetAllPosts(bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{alices.ID, bobs.ID}, postIDs(posts))
+	posts, err = postRepo.GetFollowsPosts(bob.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{alices.ID}, postIDs(posts))
+	posts, err = postRepo.GetAllPosts(carol.ID, nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bobs.ID}, postIDs(posts))
+
+	// Becoming public approves the remaining requests
+	require.NoError(t, userRepo.SetPrivate(alice.ID, false))
+	approved, err := requestRepo.ApproveAll(alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, approved)
+	followers, err := client.FollowRelation.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, followers)
+	outgoing, err := requestRepo.ListOutgoing(carol.ID)
+	require.NoError(t, err)
+	assert.Empty(t, outgoing)
+}
+
+func TestReportRepository_CountOpenReporters(t *testing.T) {
+	client := newTestClient(t)
+	repo := NewReportRepository(client)
+
+	author := createTestUser(t, client, "author")
+	first := createTestUser(t, client, "first")
+	second := createTestUser(t, client, "second")
+	moderator := createTestUser(t, client, "moderator")
+	post := createTestPost(t, client, author)
+
+	report, err := repo.Create(first.ID, enum.ReportTargetPost, post.ID, enum.ReportReasonSpam, "")
+	require.NoError(t, err)
+	// The same reporter has one open report per target, even when requests race
+	_, err = repo.Create(first.ID, enum.ReportTargetPost, post.ID, enum.ReportReasonHarassment, "")
+	assert.True(t, ent.IsConstraintError(err))
+	_, err = repo.Create(first.ID, enum.ReportTargetUser, author.ID, enum.ReportReasonHarassment, "")
+	require.NoError(t, err)
+	_, err = repo.Create(second.ID, enum.ReportTargetPost, post.ID, enum.ReportReasonSpam, "")
+	require.NoError(t, err)
+
+	count, err := repo.CountOpenReporters(enum.ReportTargetPost, post.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// Resolving one report closes every open report on the target
+	_, err = repo.Resolve(report.ID, moderator.ID, enum.ReportStatusDismissed, "")
+	require.NoError(t, err)
+	count, err = repo.CountOpenReporters(enum.ReportTargetPost, post.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// After the report is resolved the target can be reported again
+	_, err = repo.Create(first.ID, enum.ReportTargetPost, post.ID, enum.ReportReasonSpam, "")
+	require.NoError(t, err)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
@@ -18,6 +21,8 @@ import (
 
 var client *ent.Client
 
+const defaultReportHideThreshold = 3
+
 func InjectDB() *ent.Client {
 	if client == nil {
 		var err error
@@ -30,6 +35,10 @@ func InjectDB() *ent.Client {
 		if err := client.Schema.Create(context.Background()); err != nil {
 			log.Fatalf("failed creating schema resources: %v", err)
 		}
+		// カウンタが埋まっていなくても API は動くので、失敗しても起動は続ける
+		if err := infra.NewCounterRepository(client).Backfill(); err != nil {
+			log.Printf("failed backfilling counters: %v", err)
+		}
 	}
 	return client
 }
@@ -46,9 +55,22 @@ func InjectSES() *ses.SES {
 	return sesClient
 }
 
-func InjectCognitoRepository() repository.AuthRepository {
-	authRepository := infra.NewCognitoRepository()
-	return authRepository
+// InjectAuthRepository selects the auth backend by AUTH_PROVIDER.
+// "local" keeps credentials in Postgres so the API can run without Cognito.
+func InjectAuthRepository() repository.AuthRepository {
+	switch os.Getenv("AUTH_PROVIDER") {
+	case "", "cognito":
+		return infra.NewCognitoRepository(InjectDB())
+	case "local":
+		secret := os.Getenv("JWT_SECRET")
+		if secret == "" {
+			log.Fatal("JWT_SECRET environment variable is not set")
+		}
+		return infra.NewLocalAuthRepository(InjectDB(), InjectMailerRepository(), secret, os.Getenv("MAIL_FROM"))
+	default:
+		log.Fatalf("unknown AUTH_PROVIDER: %s", os.Getenv("AUTH_PROVIDER"))
+		return nil
+	}
 }
 
 func InjectUserRepository() repository.UserRepository {
@@ -96,7 +118,12 @@ func InjectDeviceTokenRepository() repository.DeviceTokenRepository {
 	return deviceTokenRepostory
 }
 
+// InjectMailerRepository selects the mailer by MAIL_PROVIDER.
+// "log" writes emails to the log instead of sending them through SES.
 func InjectMailerRepository() repository.MailerRepository {
+	if os.Getenv("MAIL_PROVIDER") == "log" {
+		return infra.NewLogMailerRepository()
+	}
 	mailerRepository := infra.NewMailerRepository(InjectSES())
 	return mailerRepository
 }
@@ -106,18 +133,48 @@ func InjectBlockRelationRepository() repository.BlockRelationRepository {
 	return blockRelationRepository
 }
 
+func InjectFollowRequestRepository() repository.FollowRequestRepository {
+	followRequestRepository := infra.NewFollowRequestRepository(InjectDB())
+	return followRequestRepository
+}
+
+func InjectMuteRelationRepository() repository.MuteRelationRepository {
+	muteRelationRepository := infra.NewMuteRelationRepository(InjectDB())
+	return muteRelationRepository
+}
+
+func InjectMutedKeywordRepository() repository.MutedKeywordRepository {
+	mutedKeywordRepository := infra.NewMutedKeywordRepository(InjectDB())
+	return mutedKeywordRepository
+}
+
+var recommendationRepository *infra.AlgorithmRepository
+
+// InjectRecommendationRepository returns the client of the algorithm service at ALGORITHM_API_URL.
+// It is shared so that the circuit breaker sees every call.
+func InjectRecommendationRepository() repository.RecommendationRepository {
+	if recommendationRepository == nil {
+		var err error
+		recommendationRepository, err = infra.NewAlgorithmRepository(os.Getenv("ALGORITHM_API_URL"), infra.DefaultAlgorithmOptions)
+		if err != nil {
+			log.Fatalf("Failed to configure algorithm API: %v", err)
+		}
+	}
+	return recommendationRepository
+}
+
 func InjectAuthUsecase() usecase.AuthUsecase {
-	authUsecase := usecase.NewAuthUsecase(InjectCognitoRepository(), InjectUserRepository())
+	authUsecase := usecase.NewAuthUsecase(InjectAuthRepository(), InjectUserRepository())
 	return *authUsecase
 }
 
 func InjectPostUsecase() usecase.PostUsecase {
-	postUsecase := usecase.NewPostUsecase(InjectPostRepository())
+	postUsecase := usecase.NewPostUsecase(InjectPostRepository(), InjectCommentRepository(), InjectLikeRepository(), InjectStorageRepository(), InjectPetRepository(), InjectUserRepository(), InjectNotificationRepository())
 	return *postUsecase
 }
 
 func InjectPetUsecase() usecase.PetUsecase {
-	petUsecase := usecase.NewPetUsecase(InjectPetRepository())
+	petUsecase := usecase.NewPetUsecase(InjectPetRepository(), InjectPostRepository())
 	return *petUsecase
 }
 
@@ -127,17 +184,17 @@ func InjectStorageUsecase() usecase.StorageUsecase {
 }
 
 func InjectUserUsecase() usecase.UserUsecase {
-	userUsecase := usecase.NewUserUsecase(InjectUserRepository(), InjectStorageRepository(), InjectPostRepository(), InjectPetRepository(), InjectFollowRelationRepository(), InjectBlockRelationRepository())
+	userUsecase := usecase.NewUserUsecase(InjectUserRepository(), InjectStorageRepository(), InjectPostRepository(), InjectPetRepository(), InjectFollowRelationRepository(), InjectBlockRelationRepository(), InjectFollowRequestRepository())
 	return *userUsecase
 }
 
 func InjectLikeUsecase() usecase.LikeUsecase {
-	likeUsecase := usecase.NewLikeUsecase(InjectLikeRepository())
+	likeUsecase := usecase.NewLikeUsecase(InjectLikeRepository(), InjectPostRepository())
 	return *likeUsecase
 }
 
 func InjectCommentUsecase() usecase.CommentUsecase {
-	commentUsecase := usecase.NewCommentUsecase(InjectCommentRepository(), InjectPostRepository(), InjectStorageRepository())
+	commentUsecase := usecase.NewCommentUsecase(InjectCommentRepository(), InjectPostRepository(), InjectStorageRepository(), InjectBlockRelationRepository(), InjectUserRepository(), InjectNotificationRepository())
 	return *commentUsecase
 }
 
@@ -146,8 +203,86 @@ func InjectDailyTaskUsecase() usecase.DailyTaskUsecase {
 	return *dailytaskUsecase
 }
 
+func InjectTimelineUsecase() usecase.TimelineUsecase {
+	timelineUsecase := usecase.NewTimelineUsecase(InjectRecommendationRepository(), InjectPostRepository(), InjectCacheUsecase())
+	return *timelineUsecase
+}
+
+func InjectExploreRepository() repository.ExploreRepository {
+	exploreRepository := infra.NewExploreRepository(InjectDB())
+	return exploreRepository
+}
+
+func InjectTagRepository() repository.TagRepository {
+	tagRepository := infra.NewTagRepository(InjectDB())
+	return tagRepository
+}
+
+func InjectNotificationRepository() repository.NotificationRepository {
+	notificationRepository := infra.NewNotificationRepository(InjectDB())
+	return notificationRepository
+}
+
+func InjectNotificationUsecase() usecase.NotificationUsecase {
+	notificationUsecase := usecase.NewNotificationUsecase(InjectNotificationRepository(), InjectStorageRepository())
+	return *notificationUsecase
+}
+
+func InjectTagUsecase() usecase.TagUsecase {
+	tagUsecase := usecase.NewTagUsecase(InjectTagRepository(), InjectPostRepository())
+	return *tagUsecase
+}
+
+func InjectExploreUsecase() usecase.ExploreUsecase {
+	exploreUsecase := usecase.NewExploreUsecase(InjectExploreRepository())
+	return *exploreUsecase
+}
+
+const defaultTimelineCacheSize = 10000
+
+var memoryTimelineCacheRepository *infra.MemoryTimelineCacheRepository
+
+// InjectTimelineCacheRepository selects where timelines are cached by TIMELINE_CACHE.
+// "postgres" shares them between Lambda instances; the default "memory" keeps up to
+// TIMELINE_CACHE_SIZE users (default 10000) in the process.
+func InjectTimelineCacheRepository() repository.TimelineCacheRepository {
+	switch os.Getenv("TIMELINE_CACHE") {
+	case "postgres":
+		return infra.NewTimelineCacheRepository(InjectDB())
+	case "", "memory":
+		if memoryTimelineCacheRepository == nil {
+			memoryTimelineCacheRepository = infra.NewMemoryTimelineCacheRepository(positiveIntEnv("TIMELINE_CACHE_SIZE", defaultTimelineCacheSize))
+		}
+		return memoryTimelineCacheRepository
+	default:
+		log.Fatalf("unknown TIMELINE_CACHE: %s", os.Getenv("TIMELINE_CACHE"))
+		return nil
+	}
+}
+
+// InjectCacheUsecase caches timelines for TIMELINE_CACHE_TTL (a Go duration, default 30m).
 func InjectCacheUsecase() usecase.CacheUsecase {
-	return usecase.NewCacheUsecase()
+	ttl := usecase.DefaultTimelineCacheTTL
+	if value := os.Getenv("TIMELINE_CACHE_TTL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("Invalid TIMELINE_CACHE_TTL: %q", value)
+		}
+		ttl = parsed
+	}
+	return usecase.NewCacheUsecase(InjectTimelineCacheRepository(), ttl)
+}
+
+func positiveIntEnv(name string, defaultValue int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed <= 0 {
+		log.Fatalf("Invalid %s: %q", name, value)
+	}
+	return parsed
 }
 
 func InjectDeviceTokenUsecase() usecase.DeviceTokenUsecase {
@@ -155,11 +290,84 @@ func InjectDeviceTokenUsecase() usecase.DeviceTokenUsecase {
 	return *deviceTokenUsecase
 }
 
+func InjectReportRepository() repository.ReportRepository {
+	reportRepository := infra.NewReportRepository(InjectDB())
+	return reportRepository
+}
+
+// InjectReportNotification builds the email sent to moderators for new reports.
+// Notifications are disabled unless REPORT_NOTIFY_TO (comma separated) is set.
+func InjectReportNotification() *usecase.ReportNotification {
+	var to []string
+	for _, addr := range strings.Split(os.Getenv("REPORT_NOTIFY_TO"), ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			to = append(to, addr)
+		}
+	}
+	from := os.Getenv("REPORT_NOTIFY_FROM")
+	if from == "" {
+		from = os.Getenv("MAIL_FROM")
+	}
+
+	notification, err := usecase.NewReportNotification(from, to, os.Getenv("REPORT_NOTIFY_SUBJECT"), os.Getenv("REPORT_NOTIFY_BODY"))
+	if err != nil {
+		log.Fatalf("Failed to configure report notification: %v", err)
+	}
+	return notification
+}
+
 func InjectReportUsecase() usecase.ReportUsecase {
-	reportUsecase := usecase.NewReportUsecase(InjectMailerRepository())
+	reportUsecase := usecase.NewReportUsecase(
+		InjectReportRepository(),
+		InjectPostRepository(),
+		InjectCommentRepository(),
+		InjectUserRepository(),
+		InjectMailerRepository(),
+		InjectReportNotification(),
+		reportHideThreshold(),
+	)
 	return *reportUsecase
 }
 
+// reportHideThreshold reads REPORT_HIDE_THRESHOLD, the number of distinct reporters
+// that hides a post or comment until review (default 3, 0 disables).
+func reportHideThreshold() int {
+	value := os.Getenv("REPORT_HIDE_THRESHOLD")
+	if value == "" {
+		return defaultReportHideThreshold
+	}
+	threshold, err := strconv.Atoi(value)
+	if err != nil || threshold < 0 {
+		log.Fatalf("Invalid REPORT_HIDE_THRESHOLD: %q", value)
+	}
+	return threshold
+}
+
+func InjectSuspensionRepository() repository.SuspensionRepository {
+	suspensionRepository := infra.NewSuspensionRepository(InjectDB())
+	return suspensionRepository
+}
+
+func InjectSuspensionUsecase() usecase.SuspensionUsecase {
+	suspensionUsecase := usecase.NewSuspensionUsecase(InjectSuspensionRepository(), InjectUserRepository())
+	return *suspensionUsecase
+}
+
+func InjectFollowRequestUsecase() usecase.FollowRequestUsecase {
+	followRequestUsecase := usecase.NewFollowRequestUsecase(InjectFollowRequestRepository(), InjectStorageRepository())
+	return *followRequestUsecase
+}
+
+func InjectMuteUsecase() usecase.MuteUsecase {
+	muteUsecase := usecase.NewMuteUsecase(InjectMuteRelationRepository(), InjectMutedKeywordRepository(), InjectUserRepository(), InjectStorageRepository())
+	return *muteUsecase
+}
+
+func InjectAdminUsecase() usecase.AdminUsecase {
+	adminUsecase := usecase.NewAdminUsecase(InjectUserRepository(), InjectPostRepository(), InjectCommentRepository(), InjectDeviceTokenRepository(), InjectStorageRepository())
+	return *adminUsecase
+}
+
 func InjectAuthHandler() handler.AuthHandler {
 	authHandler := handler.NewAuthHandler(InjectAuthUsecase(), InjectUserUsecase(), InjectStorageUsecase(), InjectDailyTaskUsecase())
 	return *authHandler
@@ -168,9 +376,10 @@ func InjectAuthHandler() handler.AuthHandler {
 func InjectPostHandler() *handler.PostHandler {
 	return handler.NewPostHandler(
 		InjectPostUsecase(),
+		InjectTimelineUsecase(),
+		InjectExploreUsecase(),
 		InjectStorageUsecase(),
 		InjectDailyTaskUsecase(),
-		InjectCacheUsecase(),
 	)
 }
 
@@ -179,6 +388,16 @@ func InjectPetHandler() handler.PetHandler {
 	return *petHandler
 }
 
+func InjectTagHandler() handler.TagHandler {
+	tagHandler := handler.NewTagHandler(InjectTagUsecase())
+	return *tagHandler
+}
+
+func InjectNotificationHandler() handler.NotificationHandler {
+	notificationHandler := handler.NewNotificationHandler(InjectNotificationUsecase())
+	return *notificationHandler
+}
+
 func InjectUserHandler() handler.UserHandler {
 	userHandler := handler.NewUserHandler(InjectUserUsecase(), InjectStorageUsecase())
 	return *userHandler
@@ -195,7 +414,7 @@ func InjectCommentHandler() handler.CommentHandler {
 }
 
 func InjectLambdaHandler() handler.LambdaHandler {
-	lambdaHandler := handler.NewLambdaHandler(InjectDailyTaskUsecase())
+	lambdaHandler := handler.NewLambdaHandler(InjectDailyTaskUsecase(), InjectExploreUsecase())
 	return *lambdaHandler
 }
 func InjectDeviceTokenHandler() handler.DeviceTokenHandler {
@@ -208,7 +427,27 @@ func InjectReportHandler() handler.ReportHandler {
 	return *reportHandler
 }
 
+func InjectAdminHandler() handler.AdminHandler {
+	adminHandler := handler.NewAdminHandler(InjectAdminUsecase())
+	return *adminHandler
+}
+
+func InjectSuspensionHandler() handler.SuspensionHandler {
+	suspensionHandler := handler.NewSuspensionHandler(InjectSuspensionUsecase())
+	return *suspensionHandler
+}
+
+func InjectFollowRequestHandler() handler.FollowRequestHandler {
+	followRequestHandler := handler.NewFollowRequestHandler(InjectFollowRequestUsecase())
+	return *followRequestHandler
+}
+
+func InjectMuteHandler() handler.MuteHandler {
+	muteHandler := handler.NewMuteHandler(InjectMuteUsecase())
+	return *muteHandler
+}
+
 func InjectAuthMiddleware() middlewares.AuthMiddleware {
-	authMiddleware := middlewares.NewAuthMiddleware(InjectAuthUsecase())
+	authMiddleware := middlewares.NewAuthMiddleware(InjectAuthUsecase(), InjectSuspensionUsecase())
 	return *authMiddleware
 }
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/middlewares"
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupAdminRoutes sets up the routes used by the support team
+func SetupAdminRoutes(app *echo.Echo) {
+	adminHandler := injector.InjectAdminHandler()
+	reportHandler := injector.InjectReportHandler()
+	suspensionHandler := injector.InjectSuspensionHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	adminGroup := app.Group("/admin", authMiddleware.Handler, middlewares.RequireRole(enum.RoleModerator))
+
+	// List and search users
+	adminGroup.GET("/users", adminHandler.ListUsers)
+
+	// Get a user's posts including soft-deleted ones
+	adminGroup.GET("/users/:id/posts", adminHandler.GetUserPosts)
+
+	// Reset a user's streak
+	adminGroup.POST("/users/:id/reset-streak", adminHandler.ResetStreak)
+
+	// Force delete a post
+	adminGroup.DELETE("/posts/:id", adminHandler.DeletePost)
+
+	// Force delete a comment
+	adminGroup.DELETE("/comments/:id", adminHandler.DeleteComment)
+
+	// List reports in the review queue
+	adminGroup.GET("/reports", reportHandler.List)
+
+	// Resolve a report as actioned or dismissed
+	adminGroup.PUT("/reports/:id/resolve", reportHandler.Resolve)
+
+	// List a user's suspensions
+	adminGroup.GET("/users/:id/suspensions", suspensionHandler.List)
+
+	// Suspend a user until the given time
+	adminGroup.POST("/users/:id/suspensions", suspensionHandler.Suspend)
+
+	// Lift a user's suspension
+	adminGroup.DELETE("/users/:id/suspensions", suspensionHandler.Lift)
+
+	// Admin only
+	adminOnly := middlewares.RequireRole(enum.RoleAdmin)
+
+	// Get a user's device tokens
+	adminGroup.GET("/users/:id/device-tokens", adminHandler.GetDeviceTokens, adminOnly)
+
+	// Change a user's role
+	adminGroup.PUT("/users/:id/role", adminHandler.UpdateRole, adminOnly)
+}
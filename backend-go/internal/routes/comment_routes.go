@@ -15,4 +15,14 @@ func SetupCommentRoutes(app *echo.Echo) {
 
 	// Delete a comment
 	commentGroup.DELETE("", commentHandler.Delete)
+
+	// Edit a comment
+	commentGroup.PUT("/:id", commentHandler.Update)
+
+	// Replies to a comment, oldest first
+	commentGroup.GET("/:id/replies", commentHandler.ListReplies)
+
+	// Like and unlike a comment
+	commentGroup.POST("/:id/like", commentHandler.Like)
+	commentGroup.DELETE("/:id/like", commentHandler.Unlike)
 }
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+func SetupFollowRequestRoutes(app *echo.Echo) {
+	followRequestHandler := injector.InjectFollowRequestHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	followRequestGroup := app.Group("/follow-requests", authMiddleware.Handler)
+
+	// Requests are created by POST /users/follow when the user is private
+	followRequestGroup.GET("/incoming", followRequestHandler.ListIncoming)
+
+	followRequestGroup.GET("/outgoing", followRequestHandler.ListOutgoing)
+
+	followRequestGroup.POST("/:id/approve", followRequestHandler.Approve)
+
+	followRequestGroup.POST("/:id/reject", followRequestHandler.Reject)
+
+	followRequestGroup.DELETE("/:id", followRequestHandler.Cancel)
+}
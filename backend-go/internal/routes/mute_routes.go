@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+func SetupMuteRoutes(app *echo.Echo) {
+	muteHandler := injector.InjectMuteHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	muteGroup := app.Group("/mutes", authMiddleware.Handler)
+
+	// Muted users are hidden from feeds without unfollowing them
+	muteGroup.GET("/users", muteHandler.ListUsers)
+
+	muteGroup.POST("/users/:id", muteHandler.MuteUser)
+
+	muteGroup.DELETE("/users/:id", muteHandler.UnmuteUser)
+
+	// Posts whose caption contains a muted keyword are hidden from feeds
+	muteGroup.GET("/keywords", muteHandler.ListKeywords)
+
+	muteGroup.POST("/keywords", muteHandler.AddKeyword)
+
+	muteGroup.DELETE("/keywords/:id", muteHandler.RemoveKeyword)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupNotificationRoutes sets up the notification routes
+func SetupNotificationRoutes(app *echo.Echo) {
+	notificationHandler := injector.InjectNotificationHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	notificationGroup := app.Group("/notifications", authMiddleware.Handler)
+
+	// notifications of the current user, newest first
+	notificationGroup.GET("", notificationHandler.List)
+	notificationGroup.GET("/unread-count", notificationHandler.CountUnread)
+	notificationGroup.POST("/read", notificationHandler.MarkAllRead)
+}
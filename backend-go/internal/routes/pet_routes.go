@@ -8,6 +8,7 @@ import (
 // SetupPetRoutes sets up the pet routes
 func SetupPetRoutes(app *echo.Echo) {
 	petHandler := injector.InjectPetHandler()
+	postHandler := injector.InjectPostHandler()
 	authMiddleware := injector.InjectAuthMiddleware()
 	petGroup := app.Group("/pets", authMiddleware.Handler)
 
@@ -20,4 +21,8 @@ func SetupPetRoutes(app *echo.Echo) {
 	petGroup.PUT("/update", petHandler.Update)
 
 	petGroup.DELETE("/delete", petHandler.Delete)
+
+	// profile of a pet and the posts it is tagged in
+	petGroup.GET("/:id", petHandler.GetProfile)
+	petGroup.GET("/:id/posts", postHandler.GetPetPosts)
 }
@@ -20,6 +20,21 @@ func SetupPostRoutes(app *echo.Echo) {
 	// get all posts
 	postGroup.GET("/all", postHandler.GetAllPosts)
 
+	// get posts ranked by engagement
+	postGroup.GET("/explore", postHandler.GetExplore)
+
+	// get posts liked by the current user
+	postGroup.GET("/liked", postHandler.GetLikedPosts)
+
+	// get a single post, its comments and its likes
+	postGroup.GET("/:id", postHandler.GetPost)
+	postGroup.GET("/:id/comments", postHandler.ListComments)
+	postGroup.GET("/:id/likes", postHandler.ListLikes)
+
+	// edit a post and view its edit history (owner only)
+	postGroup.PUT("/:id", postHandler.UpdatePost)
+	postGroup.GET("/:id/revisions", postHandler.ListRevisions)
+
 	// Create a new post
 	postGroup.POST("", postHandler.CreatePost)
 
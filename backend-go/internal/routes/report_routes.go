@@ -8,7 +8,11 @@ import (
 func SetupReportRoutes(app *echo.Echo) {
 	reportHandler := injector.InjectReportHandler()
 	authMiddleware := injector.InjectAuthMiddleware()
-	reportGrout := app.Group("/reports", authMiddleware.Handler)
+	reportGroup := app.Group("/reports", authMiddleware.Handler)
 
-	reportGrout.POST("/send", reportHandler.SendReport, authMiddleware.Handler)
+	// File a report against a post, comment or user
+	reportGroup.POST("", reportHandler.File)
+
+	// Report a post (request body of older app versions)
+	reportGroup.POST("/send", reportHandler.SendReport)
 }
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/aki-13627/animalia/backend-go/internal/injector"
+	"github.com/labstack/echo/v4"
+)
+
+// SetupTagRoutes sets up the hashtag routes
+func SetupTagRoutes(app *echo.Echo) {
+	tagHandler := injector.InjectTagHandler()
+	postHandler := injector.InjectPostHandler()
+	authMiddleware := injector.InjectAuthMiddleware()
+	tagGroup := app.Group("/tags", authMiddleware.Handler)
+
+	// hashtags used more in the last day than the day before
+	tagGroup.GET("/trending", tagHandler.GetTrending)
+
+	// posts with a hashtag, given without "#"
+	tagGroup.GET("/:name/posts", postHandler.GetTagPosts)
+}
@@ -10,14 +10,31 @@ func SetupUserRoutes(app *echo.Echo) {
 	authMiddleware := injector.InjectAuthMiddleware()
 	userGroup := app.Group("/users", authMiddleware.Handler)
 
+	// Deprecated: only returns the caller's own profile, for older app versions. Use /auth/me.
 	userGroup.GET("", userHandler.GetUser)
 
+	userGroup.GET("/:id", userHandler.GetProfile)
+
+	userGroup.GET("/by-handle/:handle", userHandler.GetProfileByHandle)
+
+	userGroup.GET("/:id/posts", userHandler.ListPosts)
+
+	userGroup.GET("/:id/followers", userHandler.ListFollowers)
+
+	userGroup.GET("/:id/following", userHandler.ListFollowing)
+
+	userGroup.GET("/:id/blocking", userHandler.ListBlocking)
+
 	userGroup.PUT("/update", userHandler.UpdateUser)
 
 	userGroup.POST("/follow", userHandler.Follow)
 
 	userGroup.DELETE("/unfollow", userHandler.Unfollow)
 
+	userGroup.PUT("/privacy", userHandler.UpdatePrivacy)
+
+	userGroup.PUT("/handle", userHandler.UpdateHandle)
+
 	userGroup.POST("/block", userHandler.Block)
 
 	userGroup.DELETE("/unblock", userHandler.Unblock)
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// AdminUsecase holds the operations of the /admin API used by the support team.
+type AdminUsecase struct {
+	userRepository        repository.UserRepository
+	postRepository        repository.PostRepository
+	commentRepository     repository.CommentRepository
+	deviceTokenRepository repository.DeviceTokenRepository
+	storageRepository     repository.StorageRepository
+}
+
+func NewAdminUsecase(
+	userRepository repository.UserRepository,
+	postRepository repository.PostRepository,
+	commentRepository repository.CommentRepository,
+	deviceTokenRepository repository.DeviceTokenRepository,
+	storageRepository repository.StorageRepository) *AdminUsecase {
+	return &AdminUsecase{
+		userRepository:        userRepository,
+		postRepository:        postRepository,
+		commentRepository:     commentRepository,
+		deviceTokenRepository: deviceTokenRepository,
+		storageRepository:     storageRepository,
+	}
+}
+
+func (u *AdminUsecase) SearchUsers(query string, limit, offset int) ([]models.AdminUserResponse, error) {
+	users, err := u.userRepository.Search(query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.AdminUserResponse, len(users))
+	for i, user := range users {
+		iconURL := ""
+		if user.IconImageKey != "" {
+			iconURL, err = u.storageRepository.GetUrl(user.IconImageKey)
+			if err != nil {
+				log.Errorf("Failed to get url: %v", err)
+				return nil, err
+			}
+		}
+		responses[i] = models.NewAdminUserResponse(user, iconURL)
+	}
+	return responses, nil
+}
+
+// GetUserPosts returns all posts of the user, including soft-deleted ones.
+func (u *AdminUsecase) GetUserPosts(userId uuid.UUID) ([]models.AdminPostResponse, error) {
+	posts, err := u.postRepository.GetPostsByUserIncludingDeleted(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.AdminPostResponse, len(posts))
+	for i, post := range posts {
+		imageURL, err := u.storageRepository.GetUrl(post.ImageKey)
+		if err != nil {
+			log.Errorf("Failed to get url: %v", err)
+			return nil, err
+		}
+
+		comments := make([]models.CommentResponse, len(post.Edges.Comments))
+		for j, comment := range post.Edges.Comments {
+			userImageURL := ""
+			if comment.Edges.User.IconImageKey != "" {
+				userImageURL, err = u.storageRepository.GetUrl(comment.Edges.User.IconImageKey)
+				if err != nil {
+					log.Errorf("Failed to get comment user url: %v", err)
+					return nil, err
+				}
+			}
+			comments[j] = models.NewCommentResponse(comment, comment.Edges.User, userImageURL, models.CommentStats{})
+		}
+		responses[i] = models.NewAdminPostResponse(post, imageURL, comments)
+	}
+	return responses, nil
+}
+
+// ForceDeletePost permanently deletes the post and its images regardless of the author.
+func (u *AdminUsecase) ForceDeletePost(postId uuid.UUID) error {
+	post, err := u.postRepository.GetById(postId)
+	if err != nil {
+		return err
+	}
+	if err := u.postRepository.HardDeletePost(postId); err != nil {
+		return err
+	}
+	// 画像の削除に失敗しても投稿の削除は完了しているため、ログのみ残す
+	deletePostMedia(u.storageRepository, post)
+	return nil
+}
+
+// ForceDeleteComment deletes the comment regardless of the author.
+func (u *AdminUsecase) ForceDeleteComment(commentId string) error {
+	if _, err := u.commentRepository.GetById(commentId); err != nil {
+		return err
+	}
+	return u.commentRepository.Delete(commentId)
+}
+
+func (u *AdminUsecase) ResetStreak(userId uuid.UUID) error {
+	if _, err := u.userRepository.GetById(userId); err != nil {
+		return err
+	}
+	return u.userRepository.UpdateStreakCount(userId, 0)
+}
+
+func (u *AdminUsecase) GetDeviceTokens(userId uuid.UUID) ([]models.DeviceTokenResponse, error) {
+	tokens, err := u.deviceTokenRepository.GetByUser(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.DeviceTokenResponse, len(tokens))
+	for i, token := range tokens {
+		responses[i] = models.NewDeviceTokenResponse(token)
+	}
+	return responses, nil
+}
+
+// UpdateRole changes the role of a user. Admins cannot change their own role,
+// so that the last admin cannot lock everyone out by mistake.
+func (u *AdminUsecase) UpdateRole(actor *ent.User, userId uuid.UUID, role enum.Role) error {
+	if !role.Valid() {
+		return ErrInvalidRole
+	}
+	if actor.ID == userId {
+		return ErrForbidden
+	}
+	if _, err := u.userRepository.GetById(userId); err != nil {
+		return err
+	}
+	return u.userRepository.UpdateRole(userId, role)
+}
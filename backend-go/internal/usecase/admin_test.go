@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminUsecase_GetUserPosts(t *testing.T) {
+	userID := uuid.New()
+	deletedAt := time.Now()
+	posts := []*ent.Post{
+		{ID: uuid.New(), Caption: "visible", ImageKey: "posts/1.jpg"},
+		{ID: uuid.New(), Caption: "deleted", ImageKey: "posts/2.jpg", DeletedAt: deletedAt},
+	}
+
+	mockPostRepo := &mock.MockPostRepository{
+		GetPostsByUserIncludingDeletedFunc: func(userId uuid.UUID) ([]*ent.Post, error) {
+			assert.Equal(t, userID, userId)
+			return posts, nil
+		},
+	}
+	mockStorageRepo := &mock.MockStorageRepository{
+		GetUrlFunc: func(fileKey string) (string, error) {
+			return "https://example.com/" + fileKey, nil
+		},
+	}
+
+	usecase := NewAdminUsecase(&mock.MockUserRepository{}, mockPostRepo, &mock.MockCommentRepository{}, &mock.MockDeviceTokenRepository{}, mockStorageRepo)
+	responses, err := usecase.GetUserPosts(userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, responses, 2)
+	assert.Nil(t, responses[0].DeletedAt)
+	assert.NotNil(t, responses[1].DeletedAt)
+	assert.Equal(t, deletedAt, *responses[1].DeletedAt)
+	assert.Equal(t, "https://example.com/posts/2.jpg", responses[1].ImageURL)
+}
+
+func TestAdminUsecase_ForceDeletePost(t *testing.T) {
+	// Test cases
+	testCases := []struct {
+		name           string
+		getError       error
+		deleteError    error
+		imageError     error
+		expectDeleted  bool
+		expectImageDel bool
+		expectedError  bool
+	}{
+		{
+			name:           "Success",
+			expectDeleted:  true,
+			expectImageDel: true,
+		},
+		{
+			name:           "Image deletion failure is ignored",
+			imageError:     errors.New("s3 error"),
+			expectDeleted:  true,
+			expectImageDel: true,
+		},
+		{
+			name:          "Post not found",
+			getError:      &ent.NotFoundError{},
+			expectedError: true,
+		},
+		{
+			name:          "Delete error",
+			deleteError:   errors.New("database error"),
+			expectDeleted: true,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			postID := uuid.New()
+			deleted := false
+			imageDeleted := false
+
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, postID, postId)
+					if tc.getError != nil {
+						return nil, tc.getError
+					}
+					return &ent.Post{ID: postId, ImageKey: "posts/image.jpg"}, nil
+				},
+				HardDeletePostFunc: func(postId uuid.UUID) error {
+					deleted = true
+					return tc.deleteError
+				},
+			}
+			mockStorageRepo := &mock.MockStorageRepository{
+				DeleteImageFunc: func(fileKey string) error {
+					imageDeleted = true
+					assert.Equal(t, "posts/image.jpg", fileKey)
+					return tc.imageError
+				},
+			}
+
+			usecase := NewAdminUsecase(&mock.MockUserRepository{}, mockPostRepo, &mock.MockCommentRepository{}, &mock.MockDeviceTokenRepository{}, mockStorageRepo)
+			err := usecase.ForceDeletePost(postID)
+
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectDeleted, deleted)
+			assert.Equal(t, tc.expectImageDel, imageDeleted)
+		})
+	}
+}
+
+func TestAdminUsecase_ResetStreak(t *testing.T) {
+	userID := uuid.New()
+	var updatedStreak *uint32
+
+	mockUserRepo := &mock.MockUserRepository{
+		GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+			return &ent.User{ID: id, StreakCount: 10}, nil
+		},
+		UpdateStreakCountFunc: func(id uuid.UUID, streak uint32) error {
+			assert.Equal(t, userID, id)
+			updatedStreak = &streak
+			return nil
+		},
+	}
+
+	usecase := NewAdminUsecase(mockUserRepo, &mock.MockPostRepository{}, &mock.MockCommentRepository{}, &mock.MockDeviceTokenRepository{}, &mock.MockStorageRepository{})
+	err := usecase.ResetStreak(userID)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, updatedStreak) {
+		assert.Equal(t, uint32(0), *updatedStreak)
+	}
+}
+
+func TestAdminUsecase_UpdateRole(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		userID        uuid.UUID
+		role          enum.Role
+		expectUpdate  bool
+		expectedError error
+	}{
+		{
+			name:         "Promote to moderator",
+			userID:       targetID,
+			role:         enum.RoleModerator,
+			expectUpdate: true,
+		},
+		{
+			name:          "Invalid role",
+			userID:        targetID,
+			role:          enum.Role("owner"),
+			expectedError: ErrInvalidRole,
+		},
+		{
+			name:          "Cannot change own role",
+			userID:        adminID,
+			role:          enum.RoleUser,
+			expectedError: ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := false
+			mockUserRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					return &ent.User{ID: id}, nil
+				},
+				UpdateRoleFunc: func(id uuid.UUID, role enum.Role) error {
+					updated = true
+					assert.Equal(t, tc.userID, id)
+					assert.Equal(t, tc.role, role)
+					return nil
+				},
+			}
+
+			usecase := NewAdminUsecase(mockUserRepo, &mock.MockPostRepository{}, &mock.MockCommentRepository{}, &mock.MockDeviceTokenRepository{}, &mock.MockStorageRepository{})
+			err := usecase.UpdateRole(&ent.User{ID: adminID, Role: enum.RoleAdmin}, tc.userID, tc.role)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectUpdate, updated)
+		})
+	}
+}
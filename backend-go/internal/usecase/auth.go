@@ -1,8 +1,12 @@
 package usecase
 
 import (
+	"strings"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/labstack/gommon/log"
 )
 
 type AuthUsecase struct {
@@ -37,6 +41,41 @@ func (u *AuthUsecase) GetUserEmail(accessToken string) (string, error) {
 	return u.authRepository.GetUserEmail(accessToken)
 }
 
+// GetUserByAccessToken verifies the access token locally and resolves the user who owns it.
+// The token's sub is linked to the user on first use, so later requests need no call to the auth provider.
+func (u *AuthUsecase) GetUserByAccessToken(accessToken string) (*ent.User, error) {
+	claims, err := u.authRepository.VerifyAccessToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepository.GetByAuthSubject(claims.Subject)
+	if err == nil {
+		return user, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// sub が未登録のユーザー: username がメールアドレスでなければプロバイダに問い合わせる
+	email := claims.Username
+	if !strings.Contains(email, "@") {
+		email, err = u.authRepository.GetUserEmail(accessToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user, err = u.userRepository.GetByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.userRepository.SetAuthSubject(user.ID, claims.Subject); err != nil {
+		log.Warnf("Failed to link auth subject to user %s: %v", user.ID, err)
+	}
+	return user, nil
+}
+
 func (u *AuthUsecase) SignOut(accessToken string) error {
 	return u.authRepository.SignOut(accessToken)
 }
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthUsecase_GetUserByAccessToken(t *testing.T) {
+	userID := uuid.New()
+	notFound := &ent.NotFoundError{}
+
+	testCases := []struct {
+		name              string
+		claims            *models.AccessTokenClaims
+		verifyError       error
+		subjectError      error
+		providerEmail     string
+		expectedEmail     string
+		expectProviderHit bool
+		expectLink        bool
+		expectError       bool
+	}{
+		{
+			name:          "Subject already linked",
+			claims:        &models.AccessTokenClaims{Subject: "sub-1", Username: "test@example.com"},
+			expectedEmail: "test@example.com",
+		},
+		{
+			name:          "First login links subject by username",
+			claims:        &models.AccessTokenClaims{Subject: "sub-1", Username: "test@example.com"},
+			subjectError:  notFound,
+			expectedEmail: "test@example.com",
+			expectLink:    true,
+		},
+		{
+			name:              "First login falls back to provider when username is not an email",
+			claims:            &models.AccessTokenClaims{Subject: "sub-1", Username: "sub-1"},
+			subjectError:      notFound,
+			providerEmail:     "test@example.com",
+			expectedEmail:     "test@example.com",
+			expectProviderHit: true,
+			expectLink:        true,
+		},
+		{
+			name:        "Invalid token",
+			verifyError: errors.New("invalid token"),
+			expectError: true,
+		},
+		{
+			name:         "Database error",
+			claims:       &models.AccessTokenClaims{Subject: "sub-1", Username: "test@example.com"},
+			subjectError: errors.New("database error"),
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerHit := false
+			linked := false
+
+			mockAuthRepo := &mock.MockAuthRepository{
+				VerifyAccessTokenFunc: func(accessToken string) (*models.AccessTokenClaims, error) {
+					assert.Equal(t, "token", accessToken)
+					return tc.claims, tc.verifyError
+				},
+				GetUserEmailFunc: func(accessToken string) (string, error) {
+					providerHit = true
+					return tc.providerEmail, nil
+				},
+			}
+			mockUserRepo := &mock.MockUserRepository{
+				GetByAuthSubjectFunc: func(subject string) (*ent.User, error) {
+					assert.Equal(t, tc.claims.Subject, subject)
+					if tc.subjectError != nil {
+						return nil, tc.subjectError
+					}
+					return &ent.User{ID: userID, Email: tc.expectedEmail, AuthSubject: subject}, nil
+				},
+				GetByEmailFunc: func(email string) (*ent.User, error) {
+					assert.Equal(t, tc.expectedEmail, email)
+					return &ent.User{ID: userID, Email: email}, nil
+				},
+				SetAuthSubjectFunc: func(id uuid.UUID, subject string) error {
+					linked = true
+					assert.Equal(t, userID, id)
+					assert.Equal(t, tc.claims.Subject, subject)
+					return nil
+				},
+			}
+
+			usecase := NewAuthUsecase(mockAuthRepo, mockUserRepo)
+			user, err := usecase.GetUserByAccessToken("token")
+
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, user)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, userID, user.ID)
+			assert.Equal(t, tc.expectedEmail, user.Email)
+			assert.Equal(t, tc.expectProviderHit, providerHit)
+			assert.Equal(t, tc.expectLink, linked)
+		})
+	}
+}
@@ -1,43 +1,43 @@
 package usecase
 
 import (
-	"sync"
+	"time"
 
-	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
 )
 
+// DefaultTimelineCacheTTL is how long a recommended timeline can be paged through before it has to be fetched again.
+const DefaultTimelineCacheTTL = 30 * time.Minute
+
 type cacheUsecase struct {
-	mu    sync.RWMutex
-	cache map[uuid.UUID][]models.PostResponse
+	timelineCacheRepository repository.TimelineCacheRepository
+	ttl                     time.Duration
 }
 
+// CacheUsecase keeps the post IDs of recommended timelines between page requests. Only IDs are
+// cached so that posts and their presigned URLs are loaded fresh for every page.
 type CacheUsecase interface {
-	StorePostResponses(userID uuid.UUID, posts []models.PostResponse)
-	GetPostResponses(userID uuid.UUID) []models.PostResponse
-	ClearPostResponses(userID uuid.UUID)
+	StorePostIDs(userID uuid.UUID, postIDs []uuid.UUID) error
+	GetPostIDs(userID uuid.UUID) ([]uuid.UUID, error)
+	ClearPostIDs(userID uuid.UUID) error
 }
 
-func NewCacheUsecase() CacheUsecase {
+func NewCacheUsecase(timelineCacheRepository repository.TimelineCacheRepository, ttl time.Duration) CacheUsecase {
 	return &cacheUsecase{
-		cache: make(map[uuid.UUID][]models.PostResponse),
+		timelineCacheRepository: timelineCacheRepository,
+		ttl:                     ttl,
 	}
 }
 
-func (c *cacheUsecase) StorePostResponses(userID uuid.UUID, posts []models.PostResponse) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.cache[userID] = posts
+func (c *cacheUsecase) StorePostIDs(userID uuid.UUID, postIDs []uuid.UUID) error {
+	return c.timelineCacheRepository.Store(userID, postIDs, c.ttl)
 }
 
-func (c *cacheUsecase) GetPostResponses(userID uuid.UUID) []models.PostResponse {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	return c.cache[userID]
+func (c *cacheUsecase) GetPostIDs(userID uuid.UUID) ([]uuid.UUID, error) {
+	return c.timelineCacheRepository.Get(userID)
 }
 
-func (c *cacheUsecase) ClearPostResponses(userID uuid.UUID) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	delete(c.cache, userID)
+func (c *cacheUsecase) ClearPostIDs(userID uuid.UUID) error {
+	return c.timelineCacheRepository.Delete(userID)
 }
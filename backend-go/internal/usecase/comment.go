@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
@@ -10,30 +13,208 @@ import (
 )
 
 type CommentUsecase struct {
-	commentRepository repository.CommentRepository
-	postRepository    repository.PostRepository
-	storageRepository repository.StorageRepository
+	commentRepository       repository.CommentRepository
+	postRepository          repository.PostRepository
+	storageRepository       repository.StorageRepository
+	blockRelationRepository repository.BlockRelationRepository
+	userRepository          repository.UserRepository
+	notificationRepository  repository.NotificationRepository
 }
 
-func NewCommentUsecase(commentRepository repository.CommentRepository, postRepository repository.PostRepository, storageRepository repository.StorageRepository) *CommentUsecase {
+func NewCommentUsecase(
+	commentRepository repository.CommentRepository,
+	postRepository repository.PostRepository,
+	storageRepository repository.StorageRepository,
+	blockRelationRepository repository.BlockRelationRepository,
+	userRepository repository.UserRepository,
+	notificationRepository repository.NotificationRepository) *CommentUsecase {
 	return &CommentUsecase{
-		commentRepository: commentRepository,
-		postRepository:    postRepository,
-		storageRepository: storageRepository,
+		commentRepository:       commentRepository,
+		postRepository:          postRepository,
+		storageRepository:       storageRepository,
+		blockRelationRepository: blockRelationRepository,
+		userRepository:          userRepository,
+		notificationRepository:  notificationRepository,
 	}
 }
 
-func (u *CommentUsecase) Create(userID uuid.UUID, postId uuid.UUID, content string) (*models.CommentResponse, error) {
-	post, err := u.postRepository.GetById(postId)
+// Create stores the comment and notifies the users mentioned in it. With a parentId it is a
+// reply; a reply to a reply is attached to the comment that reply belongs to, so threads stay
+// one level deep.
+func (u *CommentUsecase) Create(userID uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string) (*models.CommentResponse, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrInvalidComment
+	}
+	// 削除・非表示の投稿、投稿者とブロック関係にある投稿、フォローしていない非公開アカウントの投稿にはコメントできない
+	post, err := u.postRepository.GetVisibleById(userID, postId)
+	if ent.IsNotFound(err) {
+		return nil, ErrPostNotFound
+	}
 	if err != nil {
 		log.Errorf("Failed to find post with id %s: %v", postId, err)
-		return nil, fmt.Errorf("post not found")
+		return nil, err
+	}
+	if parentId != nil {
+		parent, err := u.getVisible(userID, parentId.String())
+		if err != nil {
+			return nil, err
+		}
+		if parent.Edges.Post == nil || parent.Edges.Post.ID != post.ID {
+			return nil, ErrInvalidReply
+		}
+		// 返信への返信は元のコメントへの返信にする
+		if parent.ParentID != nil {
+			parentId = parent.ParentID
+		}
+	}
+	mentionIds, err := resolveMentions(u.userRepository, userID, content)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := u.commentRepository.Create(userID, post.ID, parentId, content, mentionIds)
+	if err != nil {
+		return nil, err
+	}
+	notifyMentions(u.notificationRepository, userID, mentionIds, post.ID, &comment.ID)
+
+	return u.commentResponse(comment, models.CommentStats{})
+}
+
+// Update edits the content of the user's own comment. Users newly mentioned are notified, and the
+// notifications of users no longer mentioned are removed.
+func (u *CommentUsecase) Update(userID uuid.UUID, commentId uuid.UUID, content string) (*models.CommentResponse, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrInvalidComment
+	}
+	current, err := u.commentRepository.GetById(commentId.String())
+	if err != nil {
+		return nil, err
+	}
+	if current.DeletedAt != nil {
+		return nil, ErrCommentNotFound
+	}
+	if current.Edges.User == nil || current.Edges.User.ID != userID {
+		return nil, ErrForbidden
+	}
+	mentionIds, err := resolveMentions(u.userRepository, userID, content)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := u.commentRepository.Update(commentId, content, mentionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	added, removed := diffMentions(current.Edges.Mentions, updated.Edges.Mentions)
+	if current.Edges.Post != nil {
+		notifyMentions(u.notificationRepository, userID, added, current.Edges.Post.ID, &commentId)
+	}
+	if len(removed) > 0 {
+		if err := u.notificationRepository.DeleteCommentMentions(commentId, removed); err != nil {
+			log.Warnf("Failed to delete mention notifications: %v", err)
+		}
+	}
+
+	stats, err := u.commentRepository.GetStats(userID, []uuid.UUID{commentId})
+	if err != nil {
+		return nil, err
+	}
+	return u.commentResponse(updated, stats[commentId])
+}
+
+// Delete removes the comment, which its author and the owner of the post can do. The comment is
+// only marked as deleted, and its replies are hidden with it.
+func (u *CommentUsecase) Delete(userID uuid.UUID, commentId string) error {
+	comment, err := u.commentRepository.GetById(commentId)
+	if err != nil {
+		return err
+	}
+	if comment.DeletedAt != nil {
+		return ErrCommentNotFound
+	}
+	isAuthor := comment.Edges.User != nil && comment.Edges.User.ID == userID
+	isPostOwner := comment.Edges.Post != nil && comment.Edges.Post.Edges.User != nil && comment.Edges.Post.Edges.User.ID == userID
+	if !isAuthor && !isPostOwner {
+		return ErrForbidden
 	}
-	comment, err := u.commentRepository.Create(userID, post.ID, content)
+
+	err = u.commentRepository.SoftDelete(comment.ID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Like likes the comment. Comments the user cannot see get ErrCommentNotFound or a not found
+// error, and comments by users with a block relation to the user get ErrBlocked.
+func (u *CommentUsecase) Like(userID uuid.UUID, commentId uuid.UUID) error {
+	if _, err := u.getVisible(userID, commentId.String()); err != nil {
+		return err
+	}
+	return u.commentRepository.Like(commentId, userID)
+}
+
+func (u *CommentUsecase) Unlike(userID uuid.UUID, commentId uuid.UUID) error {
+	return u.commentRepository.Unlike(commentId, userID)
+}
+
+// ListReplies returns one page of the replies to the comment, oldest first, and the cursor of the
+// next page.
+func (u *CommentUsecase) ListReplies(userID uuid.UUID, commentId uuid.UUID, cursor *models.Cursor, limit int) ([]models.CommentResponse, string, error) {
+	if _, err := u.getVisible(userID, commentId.String()); err != nil {
+		return nil, "", err
+	}
+	replies, err := u.commentRepository.ListReplies(commentId, userID, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	replies, next := trimPage(replies, limit, func(comment *ent.Comment) (time.Time, uuid.UUID) {
+		return comment.CreatedAt, comment.ID
+	})
+	ids := make([]uuid.UUID, len(replies))
+	for i, reply := range replies {
+		ids[i] = reply.ID
+	}
+	stats, err := u.commentRepository.GetStats(userID, ids)
+	if err != nil {
+		return nil, "", err
+	}
+	responses := make([]models.CommentResponse, len(replies))
+	for i, reply := range replies {
+		response, err := u.commentResponse(reply, stats[reply.ID])
+		if err != nil {
+			return nil, "", err
+		}
+		responses[i] = *response
+	}
+	return responses, next, nil
+}
+
+// getVisible returns the comment if the user can see it and its post.
+func (u *CommentUsecase) getVisible(userID uuid.UUID, commentId string) (*ent.Comment, error) {
+	comment, err := u.commentRepository.GetById(commentId)
 	if err != nil {
 		return nil, err
 	}
+	if comment.DeletedAt != nil || comment.HiddenAt != nil || comment.Edges.Post == nil {
+		return nil, ErrCommentNotFound
+	}
+	if _, err := u.postRepository.GetVisibleById(userID, comment.Edges.Post.ID); err != nil {
+		return nil, err
+	}
+	if comment.Edges.User != nil && comment.Edges.User.ID != userID {
+		blocked, err := u.blockRelationRepository.IsBlockedBetween(userID, comment.Edges.User.ID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, ErrBlocked
+		}
+	}
+	return comment, nil
+}
 
+func (u *CommentUsecase) commentResponse(comment *ent.Comment, stats models.CommentStats) (*models.CommentResponse, error) {
 	user := comment.Edges.User
 	if user == nil {
 		return nil, fmt.Errorf("user edge not loaded")
@@ -48,14 +229,6 @@ func (u *CommentUsecase) Create(userID uuid.UUID, postId uuid.UUID, content stri
 		}
 	}
 
-	commentResponse := models.NewCommentResponse(comment, user, iconURL)
+	commentResponse := models.NewCommentResponse(comment, user, iconURL, stats)
 	return &commentResponse, nil
 }
-
-func (u *CommentUsecase) Delete(commentId string) error {
-	err := u.commentRepository.Delete(commentId)
-	if err != nil {
-		return err
-	}
-	return nil
-}
@@ -10,6 +10,7 @@ import (
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCommentUsecase_Create(t *testing.T) {
@@ -33,7 +34,7 @@ func TestCommentUsecase_Create(t *testing.T) {
 			postID:      uuid.New().String(),
 			content:     "Test comment",
 			mockComment: createMockComment(uuid.New(), "Test comment", time.Now()),
-			mockUser:    createMockUser(uuid.New(), "test@example.com", "Test User", "Bio", "icon-key"),
+			mockUser:    createMockUser(uuid.New(), "test_user", "Test User", "Bio", "icon-key"),
 			mockIconURL: "https://example.com/icon.jpg",
 			mockError:   nil,
 			expectedResult: &models.CommentResponse{
@@ -42,7 +43,7 @@ func TestCommentUsecase_Create(t *testing.T) {
 				CreatedAt: time.Now(),
 				User: models.UserBaseResponse{
 					ID:           uuid.New(),
-					Email:        "test@example.com",
+					Handle:       "test_user",
 					Name:         "Test User",
 					Bio:          "Bio",
 					IconImageUrl: stringPtr("https://example.com/icon.jpg"),
@@ -80,7 +81,7 @@ func TestCommentUsecase_Create(t *testing.T) {
 			postID:         uuid.New().String(),
 			content:        "Test comment",
 			mockComment:    createMockComment(uuid.New(), "Test comment", time.Now()),
-			mockUser:       createMockUser(uuid.New(), "test@example.com", "Test User", "Bio", "icon-key"),
+			mockUser:       createMockUser(uuid.New(), "test_user", "Test User", "Bio", "icon-key"),
 			mockIconURL:    "",
 			mockError:      nil,
 			mockStorageErr: errors.New("storage error"),
@@ -98,7 +99,8 @@ func TestCommentUsecase_Create(t *testing.T) {
 			tc.postID = postUUID.String()
 
 			mockCommentRepo := &mock.MockCommentRepository{
-				CreateFunc: func(userId uuid.UUID, postId uuid.UUID, content string) (*ent.Comment, error) {
+				CreateFunc: func(userId uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
+					assert.Nil(t, parentId)
 					expectedUserID, _ := uuid.Parse(tc.userID)
 					expectedPostID, _ := uuid.Parse(tc.postID)
 
@@ -111,7 +113,8 @@ func TestCommentUsecase_Create(t *testing.T) {
 			}
 
 			mockPostRepo := &mock.MockPostRepository{
-				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+				GetVisibleByIdFunc: func(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, userUUID, viewerID)
 					return &ent.Post{ID: postId}, nil
 				},
 			}
@@ -125,9 +128,9 @@ func TestCommentUsecase_Create(t *testing.T) {
 				},
 			}
 
-			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo)
+			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo, &mock.MockBlockRelationRepository{}, nil, nil)
 
-			result, err := usecase.Create(userUUID, postUUID, tc.content)
+			result, err := usecase.Create(userUUID, postUUID, nil, tc.content)
 
 			if tc.expectedError {
 				assert.Error(t, err)
@@ -136,7 +139,7 @@ func TestCommentUsecase_Create(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, result)
 				assert.Equal(t, tc.mockComment.Content, result.Content)
-				assert.Equal(t, tc.mockUser.Email, result.User.Email)
+				assert.Equal(t, tc.mockUser.Handle, result.User.Handle)
 				assert.Equal(t, tc.mockUser.Name, result.User.Name)
 				assert.Equal(t, tc.mockUser.Bio, result.User.Bio)
 				assert.Equal(t, tc.mockIconURL, *result.User.IconImageUrl)
@@ -145,25 +148,57 @@ func TestCommentUsecase_Create(t *testing.T) {
 	}
 }
 
+func TestCommentUsecase_CreatePostNotVisible(t *testing.T) {
+	userID := uuid.New()
+	postID := uuid.New()
+
+	// CreateFunc is nil: the comment must not be stored. Deleted, hidden and blocked posts and
+	// private posts of users not followed are not found by GetVisibleById
+	mockPostRepo := &mock.MockPostRepository{
+		GetVisibleByIdFunc: func(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+			assert.Equal(t, userID, viewerID)
+			assert.Equal(t, postID, postId)
+			return nil, &ent.NotFoundError{}
+		},
+	}
+
+	usecase := NewCommentUsecase(&mock.MockCommentRepository{}, mockPostRepo, &mock.MockStorageRepository{}, nil, nil, nil)
+	result, err := usecase.Create(userID, postID, nil, "hello")
+
+	assert.ErrorIs(t, err, ErrPostNotFound)
+	assert.Nil(t, result)
+}
+
 func TestCommentUsecase_Delete(t *testing.T) {
 	// Test cases
+	authorID := uuid.New()
 	testCases := []struct {
 		name          string
+		userID        uuid.UUID
 		commentID     string
 		mockError     error
-		expectedError bool
+		expectedError error
 	}{
 		{
 			name:          "Success",
+			userID:        authorID,
 			commentID:     uuid.New().String(),
 			mockError:     nil,
-			expectedError: false,
+			expectedError: nil,
 		},
 		{
 			name:          "Error",
+			userID:        authorID,
 			commentID:     uuid.New().String(),
 			mockError:     errors.New("database error"),
-			expectedError: true,
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:          "Not the author",
+			userID:        uuid.New(),
+			commentID:     uuid.New().String(),
+			mockError:     nil,
+			expectedError: ErrForbidden,
 		},
 	}
 
@@ -171,9 +206,16 @@ func TestCommentUsecase_Delete(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockCommentRepo := &mock.MockCommentRepository{
-				DeleteFunc: func(commentId string) error {
-					// Verify input parameters
+				GetByIdFunc: func(commentId string) (*ent.Comment, error) {
 					assert.Equal(t, tc.commentID, commentId)
+					return &ent.Comment{
+						ID:    uuid.MustParse(commentId),
+						Edges: ent.CommentEdges{User: &ent.User{ID: authorID}},
+					}, nil
+				},
+				SoftDeleteFunc: func(commentId uuid.UUID) error {
+					// Verify input parameters
+					assert.Equal(t, tc.commentID, commentId.String())
 					return tc.mockError
 				},
 			}
@@ -183,14 +225,15 @@ func TestCommentUsecase_Delete(t *testing.T) {
 			mockStorageRepo := &mock.MockStorageRepository{}
 
 			// Create usecase with mock repositories
-			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo)
+			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, mockStorageRepo, nil, nil, nil)
 
 			// Call the method
-			err := usecase.Delete(tc.commentID)
+			err := usecase.Delete(tc.userID, tc.commentID)
 
 			// Check error
-			if tc.expectedError {
+			if tc.expectedError != nil {
 				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
 			} else {
 				assert.NoError(t, err)
 			}
@@ -198,6 +241,278 @@ func TestCommentUsecase_Delete(t *testing.T) {
 	}
 }
 
+func TestCommentUsecase_CreateReply(t *testing.T) {
+	userID := uuid.New()
+	postID := uuid.New()
+	topID := uuid.New()
+	replyID := uuid.New()
+	post := &ent.Post{ID: postID}
+	comments := map[uuid.UUID]*ent.Comment{
+		topID:   {ID: topID, Edges: ent.CommentEdges{Post: post}},
+		replyID: {ID: replyID, ParentID: &topID, Edges: ent.CommentEdges{Post: post}},
+	}
+
+	testCases := []struct {
+		name           string
+		postID         uuid.UUID
+		parentID       uuid.UUID
+		expectedParent uuid.UUID
+		expectedError  error
+	}{
+		{
+			name:           "Reply to a comment",
+			postID:         postID,
+			parentID:       topID,
+			expectedParent: topID,
+		},
+		{
+			name:           "Reply to a reply is attached to its comment",
+			postID:         postID,
+			parentID:       replyID,
+			expectedParent: topID,
+		},
+		{
+			name:          "Comment on another post",
+			postID:        uuid.New(),
+			parentID:      topID,
+			expectedError: ErrInvalidReply,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCommentRepo := &mock.MockCommentRepository{
+				GetByIdFunc: func(commentId string) (*ent.Comment, error) {
+					return comments[uuid.MustParse(commentId)], nil
+				},
+				CreateFunc: func(userId uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
+					require.NotNil(t, parentId)
+					assert.Equal(t, tc.expectedParent, *parentId)
+					return &ent.Comment{ID: uuid.New(), ParentID: parentId, Content: content, Edges: ent.CommentEdges{User: &ent.User{ID: userId}}}, nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: id}, nil
+				},
+				GetVisibleByIdFunc: func(viewerID uuid.UUID, id uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: id}, nil
+				},
+			}
+
+			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, &mock.MockStorageRepository{}, nil, nil, nil)
+			result, err := usecase.Create(userID, tc.postID, &tc.parentID, "reply")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedParent, *result.ParentID)
+		})
+	}
+}
+
+func TestCommentUsecase_Update(t *testing.T) {
+	authorID := uuid.New()
+	commentID := uuid.New()
+	postID := uuid.New()
+	kept := &ent.User{ID: uuid.New(), Handle: "kept"}
+	added := &ent.User{ID: uuid.New(), Handle: "added"}
+	removed := &ent.User{ID: uuid.New(), Handle: "removed"}
+	deletedAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		userID        uuid.UUID
+		content       string
+		deletedAt     *time.Time
+		expectedError error
+	}{
+		{
+			name:    "Success",
+			userID:  authorID,
+			content: "@kept @added",
+		},
+		{
+			name:          "Blank content",
+			userID:        authorID,
+			content:       "  ",
+			expectedError: ErrInvalidComment,
+		},
+		{
+			name:          "Not the author",
+			userID:        uuid.New(),
+			content:       "@kept @added",
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Deleted comment",
+			userID:        authorID,
+			content:       "@kept @added",
+			deletedAt:     &deletedAt,
+			expectedError: ErrCommentNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			editedAt := time.Now()
+			mockCommentRepo := &mock.MockCommentRepository{
+				GetByIdFunc: func(commentId string) (*ent.Comment, error) {
+					return &ent.Comment{ID: commentID, DeletedAt: tc.deletedAt, Edges: ent.CommentEdges{
+						User:     &ent.User{ID: authorID},
+						Post:     &ent.Post{ID: postID},
+						Mentions: []*ent.User{kept, removed},
+					}}, nil
+				},
+				UpdateFunc: func(commentId uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
+					assert.Equal(t, []uuid.UUID{kept.ID, added.ID}, mentionIds)
+					return &ent.Comment{ID: commentId, Content: content, EditedAt: &editedAt, Edges: ent.CommentEdges{
+						User:     &ent.User{ID: authorID},
+						Mentions: []*ent.User{kept, added},
+					}}, nil
+				},
+				GetStatsFunc: func(viewerID uuid.UUID, commentIds []uuid.UUID) (map[uuid.UUID]models.CommentStats, error) {
+					return map[uuid.UUID]models.CommentStats{commentID: {RepliesCount: 2}}, nil
+				},
+			}
+			mockUserRepo := &mock.MockUserRepository{
+				GetMentionableFunc: func(authorID uuid.UUID, handles []string) ([]*ent.User, error) {
+					return []*ent.User{kept, added}, nil
+				},
+			}
+			var notified, unnotified []uuid.UUID
+			mockNotificationRepo := &mock.MockNotificationRepository{
+				CreateMentionsFunc: func(actorID uuid.UUID, recipientIDs []uuid.UUID, postId uuid.UUID, commentId *uuid.UUID) error {
+					assert.Equal(t, postID, postId)
+					assert.Equal(t, commentID, *commentId)
+					notified = recipientIDs
+					return nil
+				},
+				DeleteCommentMentionsFunc: func(commentId uuid.UUID, recipientIDs []uuid.UUID) error {
+					unnotified = recipientIDs
+					return nil
+				},
+			}
+
+			usecase := NewCommentUsecase(mockCommentRepo, nil, &mock.MockStorageRepository{}, nil, mockUserRepo, mockNotificationRepo)
+			result, err := usecase.Update(tc.userID, commentID, tc.content)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, result.Edited)
+			assert.Equal(t, 2, result.RepliesCount)
+			assert.Equal(t, []uuid.UUID{added.ID}, notified, "only newly mentioned users are notified")
+			assert.Equal(t, []uuid.UUID{removed.ID}, unnotified)
+		})
+	}
+}
+
+func TestCommentUsecase_DeleteByPostOwner(t *testing.T) {
+	ownerID := uuid.New()
+	commentID := uuid.New()
+	var deleted uuid.UUID
+	mockCommentRepo := &mock.MockCommentRepository{
+		GetByIdFunc: func(commentId string) (*ent.Comment, error) {
+			return &ent.Comment{ID: commentID, Edges: ent.CommentEdges{
+				User: &ent.User{ID: uuid.New()},
+				Post: &ent.Post{Edges: ent.PostEdges{User: &ent.User{ID: ownerID}}},
+			}}, nil
+		},
+		SoftDeleteFunc: func(commentId uuid.UUID) error {
+			deleted = commentId
+			return nil
+		},
+	}
+
+	usecase := NewCommentUsecase(mockCommentRepo, nil, nil, nil, nil, nil)
+	require.NoError(t, usecase.Delete(ownerID, commentID.String()))
+	assert.Equal(t, commentID, deleted)
+}
+
+func TestCommentUsecase_Like(t *testing.T) {
+	userID := uuid.New()
+	authorID := uuid.New()
+	hiddenAt := time.Now()
+
+	testCases := []struct {
+		name          string
+		hiddenAt      *time.Time
+		postError     error
+		blocked       bool
+		expectedError error
+		expectLiked   bool
+	}{
+		{
+			name:        "Success",
+			expectLiked: true,
+		},
+		{
+			name:          "Hidden comment",
+			hiddenAt:      &hiddenAt,
+			expectedError: ErrCommentNotFound,
+		},
+		{
+			name:      "Post not visible",
+			postError: &ent.NotFoundError{},
+		},
+		{
+			name:          "Blocked author",
+			blocked:       true,
+			expectedError: ErrBlocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commentID := uuid.New()
+			liked := false
+			mockCommentRepo := &mock.MockCommentRepository{
+				GetByIdFunc: func(commentId string) (*ent.Comment, error) {
+					return &ent.Comment{ID: commentID, HiddenAt: tc.hiddenAt, Edges: ent.CommentEdges{
+						User: &ent.User{ID: authorID},
+						Post: &ent.Post{ID: uuid.New()},
+					}}, nil
+				},
+				LikeFunc: func(commentId uuid.UUID, userId uuid.UUID) error {
+					assert.Equal(t, commentID, commentId)
+					assert.Equal(t, userID, userId)
+					liked = true
+					return nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetVisibleByIdFunc: func(viewerID uuid.UUID, id uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: id}, tc.postError
+				},
+			}
+			mockBlockRepo := &mock.MockBlockRelationRepository{
+				IsBlockedBetweenFunc: func(id, otherID uuid.UUID) (bool, error) {
+					return tc.blocked, nil
+				},
+			}
+
+			usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, nil, mockBlockRepo, nil, nil)
+			err := usecase.Like(userID, commentID)
+
+			switch {
+			case tc.expectedError != nil:
+				assert.ErrorIs(t, err, tc.expectedError)
+			case tc.postError != nil:
+				assert.True(t, ent.IsNotFound(err))
+			default:
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectLiked, liked)
+		})
+	}
+}
+
 // Helper functions to create mock objects
 
 func createMockComment(id uuid.UUID, content string, createdAt time.Time) *ent.Comment {
@@ -206,7 +521,7 @@ func createMockComment(id uuid.UUID, content string, createdAt time.Time) *ent.C
 		Content:   content,
 		CreatedAt: createdAt,
 		Edges: ent.CommentEdges{
-			User: createMockUser(uuid.New(), "test@example.com", "Test User", "Bio", "icon-key"),
+			User: createMockUser(uuid.New(), "test_user", "Test User", "Bio", "icon-key"),
 		},
 	}
 }
@@ -220,10 +535,10 @@ func createMockCommentWithoutUser(id uuid.UUID, content string, createdAt time.T
 	}
 }
 
-func createMockUser(id uuid.UUID, email, name, bio, iconKey string) *ent.User {
+func createMockUser(id uuid.UUID, handle, name, bio, iconKey string) *ent.User {
 	return &ent.User{
 		ID:           id,
-		Email:        email,
+		Handle:       handle,
 		Name:         name,
 		Bio:          bio,
 		IconImageKey: iconKey,
@@ -0,0 +1,57 @@
+package usecase
+
+import "errors"
+
+// ErrForbidden is returned when the authenticated user is not allowed to act on the resource.
+var ErrForbidden = errors.New("forbidden")
+
+// ErrInvalidRole is returned when a role name is not one of enum.Role.
+var ErrInvalidRole = errors.New("invalid role")
+
+// ErrInvalidReport is returned when a report has an unknown category or target.
+var ErrInvalidReport = errors.New("invalid report")
+
+// ErrAlreadyResolved is returned when resolving a report that is no longer open.
+var ErrAlreadyResolved = errors.New("report already resolved")
+
+// ErrInvalidSuspension is returned when a suspension has no reason or does not end in the future.
+var ErrInvalidSuspension = errors.New("invalid suspension")
+
+// ErrBlocked is returned when one of the users involved blocks the other.
+var ErrBlocked = errors.New("blocked")
+
+// ErrInvalidMute is returned when muting yourself or an empty or too long keyword.
+var ErrInvalidMute = errors.New("invalid mute")
+
+// ErrTooManyMutedKeywords is returned when the user already has the maximum number of muted keywords.
+var ErrTooManyMutedKeywords = errors.New("too many muted keywords")
+
+// ErrInvalidHandle is returned when a handle is not 3 to 30 lower-case letters, digits or underscores.
+var ErrInvalidHandle = errors.New("invalid handle")
+
+// ErrHandleTaken is returned when another user already has the handle.
+var ErrHandleTaken = errors.New("handle is already taken")
+
+// ErrInvalidMedia is returned when a post has no image, more than MaxPostMedia images, or a too long alt text.
+var ErrInvalidMedia = errors.New("invalid media")
+
+// ErrInvalidPetTag is returned when a post tags a pet that does not belong to its author, or more than MaxPostPets pets.
+var ErrInvalidPetTag = errors.New("invalid pet tag")
+
+// ErrInvalidTag is returned when a tag name is not a valid hashtag.
+var ErrInvalidTag = errors.New("invalid tag")
+
+// ErrCommentNotFound is returned when the comment is deleted, hidden or not visible to the user.
+var ErrCommentNotFound = errors.New("comment not found")
+
+// ErrInvalidComment is returned when a comment is blank.
+var ErrInvalidComment = errors.New("invalid comment")
+
+// ErrInvalidReply is returned when a reply is to a comment on another post.
+var ErrInvalidReply = errors.New("invalid reply")
+
+// ErrInvalidReaction is returned when a reaction is not one of enum.ReactionType.
+var ErrInvalidReaction = errors.New("invalid reaction")
+
+// ErrPostNotFound is returned when the post is deleted, hidden or not visible to the user.
+var ErrPostNotFound = errors.New("post not found")
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// exploreWindow is how old a post can be to appear in the explore feed.
+	exploreWindow = 7 * 24 * time.Hour
+	// exploreRankingSize is the number of posts kept in the ranking.
+	exploreRankingSize = 1000
+
+	exploreLikeWeight    = 1.0
+	exploreCommentWeight = 2.0
+	// exploreGravity controls how fast the score decays with the age of the post in hours.
+	exploreGravity = 1.5
+	// exploreStreakBoost is the largest boost an author's daily task streak gives, reached at exploreStreakCap days.
+	exploreStreakBoost = 0.5
+	exploreStreakCap   = 30
+	// Each further post by the same author has its score multiplied by exploreAuthorPenalty,
+	// and at most exploreMaxPostsPerAuthor of them are ranked.
+	exploreAuthorPenalty     = 0.5
+	exploreMaxPostsPerAuthor = 3
+)
+
+type ExploreUsecase struct {
+	exploreRepository repository.ExploreRepository
+	now               func() time.Time
+}
+
+func NewExploreUsecase(exploreRepository repository.ExploreRepository) *ExploreUsecase {
+	return &ExploreUsecase{
+		exploreRepository: exploreRepository,
+		now:               time.Now,
+	}
+}
+
+// GetExplore returns one page of the explore feed after the position and the cursor of the next
+// page, which is empty on the last page. Posts by users the viewer blocks, is blocked by or mutes
+// are left out when reading, since the ranking is shared by every user.
+func (u *ExploreUsecase) GetExplore(viewerID uuid.UUID, afterPosition int, limit int) ([]*ent.Post, string, error) {
+	rankings, err := u.exploreRepository.GetRanked(viewerID, afterPosition, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	next := ""
+	if len(rankings) > limit {
+		rankings = rankings[:limit]
+		next = models.EncodeRankCursor(rankings[limit-1].Position)
+	}
+	posts := make([]*ent.Post, len(rankings))
+	for i, ranking := range rankings {
+		posts[i] = ranking.Edges.Post
+	}
+	return posts, next, nil
+}
+
+// RefreshRanking scores the posts of the last week and replaces the ranking read by GetExplore.
+// It is run by a scheduled job and returns the number of ranked posts.
+func (u *ExploreUsecase) RefreshRanking() (int, error) {
+	now := u.now()
+	candidates, err := u.exploreRepository.ListCandidates(now.Add(-exploreWindow))
+	if err != nil {
+		return 0, err
+	}
+	scores := rankExplore(candidates, now)
+	if err := u.exploreRepository.ReplaceRanking(scores); err != nil {
+		return 0, err
+	}
+	return len(scores), nil
+}
+
+// rankExplore scores the candidates and returns them best first. The score is the engagement of
+// the post, boosted by the streak of its author and decayed by its age:
+//
+//	(likes + 2 * comments + 1) * (1 + 0.5 * min(streak, 30) / 30) / (hours + 2) ^ 1.5
+//
+// To keep one author from filling the feed, the second best post of an author counts half, the
+// third a quarter, and later ones are dropped.
+func rankExplore(candidates []models.ExploreCandidate, now time.Time) []models.ExploreScore {
+	type scored struct {
+		candidate models.ExploreCandidate
+		score     float64
+	}
+	items := make([]scored, len(candidates))
+	for i, candidate := range candidates {
+		engagement := exploreLikeWeight*float64(candidate.LikesCount) + exploreCommentWeight*float64(candidate.CommentsCount) + 1
+		streak := float64(min(candidate.AuthorStreak, exploreStreakCap)) / exploreStreakCap
+		hours := max(now.Sub(candidate.CreatedAt).Hours(), 0)
+		items[i] = scored{
+			candidate: candidate,
+			score:     engagement * (1 + exploreStreakBoost*streak) / math.Pow(hours+2, exploreGravity),
+		}
+	}
+	sortScored := func(items []scored) {
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].score != items[j].score {
+				return items[i].score > items[j].score
+			}
+			if !items[i].candidate.CreatedAt.Equal(items[j].candidate.CreatedAt) {
+				return items[i].candidate.CreatedAt.After(items[j].candidate.CreatedAt)
+			}
+			return items[i].candidate.PostID.String() > items[j].candidate.PostID.String()
+		})
+	}
+	sortScored(items)
+
+	// 同じ投稿者の2件目以降は減点し、上限を超えた分は外す
+	perAuthor := make(map[uuid.UUID]int)
+	diversified := make([]scored, 0, len(items))
+	for _, item := range items {
+		n := perAuthor[item.candidate.AuthorID]
+		if n >= exploreMaxPostsPerAuthor {
+			continue
+		}
+		perAuthor[item.candidate.AuthorID] = n + 1
+		item.score *= math.Pow(exploreAuthorPenalty, float64(n))
+		diversified = append(diversified, item)
+	}
+	sortScored(diversified)
+
+	scores := make([]models.ExploreScore, min(len(diversified), exploreRankingSize))
+	for i := range scores {
+		scores[i] = models.ExploreScore{PostID: diversified[i].candidate.PostID, Score: diversified[i].score}
+	}
+	return scores
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankExplore(t *testing.T) {
+	now := time.Now()
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	candidate := func(author uuid.UUID, age time.Duration, likes, comments int, streak uint32) models.ExploreCandidate {
+		return models.ExploreCandidate{
+			PostID:        uuid.New(),
+			AuthorID:      author,
+			CreatedAt:     now.Add(-age),
+			LikesCount:    likes,
+			CommentsCount: comments,
+			AuthorStreak:  streak,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		candidates []models.ExploreCandidate
+		// expected is the order of the candidates by index
+		expected []int
+	}{
+		{
+			name: "More engagement ranks higher",
+			candidates: []models.ExploreCandidate{
+				candidate(alice, time.Hour, 1, 0, 0),
+				candidate(bob, time.Hour, 10, 0, 0),
+				candidate(carol, time.Hour, 0, 3, 0),
+			},
+			expected: []int{1, 2, 0},
+		},
+		{
+			name: "Older posts decay",
+			candidates: []models.ExploreCandidate{
+				candidate(alice, 72*time.Hour, 10, 0, 0),
+				candidate(bob, time.Hour, 3, 0, 0),
+			},
+			expected: []int{1, 0},
+		},
+		{
+			name: "Author streak breaks ties",
+			candidates: []models.ExploreCandidate{
+				candidate(alice, time.Hour, 5, 0, 0),
+				candidate(bob, time.Hour, 5, 0, 30),
+			},
+			expected: []int{1, 0},
+		},
+		{
+			name: "One author cannot dominate",
+			candidates: []models.ExploreCandidate{
+				candidate(alice, time.Hour, 10, 0, 0),
+				candidate(alice, time.Hour, 9, 0, 0),
+				candidate(alice, time.Hour, 8, 0, 0),
+				candidate(alice, time.Hour, 7, 0, 0),
+				candidate(bob, time.Hour, 6, 0, 0),
+			},
+			// alice の2件目は半分、3件目は4分の1になり、4件目は外れる
+			expected: []int{0, 4, 1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scores := rankExplore(tc.candidates, now)
+
+			require.Len(t, scores, len(tc.expected))
+			for i, index := range tc.expected {
+				assert.Equal(t, tc.candidates[index].PostID, scores[i].PostID)
+			}
+			for i := 1; i < len(scores); i++ {
+				assert.GreaterOrEqual(t, scores[i-1].Score, scores[i].Score)
+			}
+		})
+	}
+}
+
+func TestExploreUsecase_GetExplore(t *testing.T) {
+	viewerID := uuid.New()
+	rankings := make([]*ent.ExploreRanking, 3)
+	for i := range rankings {
+		rankings[i] = &ent.ExploreRanking{
+			Position: i + 5,
+			Edges:    ent.ExploreRankingEdges{Post: &ent.Post{ID: uuid.New()}},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		limit          int
+		expectedPosts  int
+		expectedCursor string
+	}{
+		{
+			name:           "Next page after the last position",
+			limit:          2,
+			expectedPosts:  2,
+			expectedCursor: models.EncodeRankCursor(6),
+		},
+		{
+			name:           "Last page",
+			limit:          3,
+			expectedPosts:  3,
+			expectedCursor: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := &mock.MockExploreRepository{
+				GetRankedFunc: func(id uuid.UUID, afterPosition int, limit int) ([]*ent.ExploreRanking, error) {
+					assert.Equal(t, viewerID, id)
+					assert.Equal(t, 4, afterPosition)
+					assert.Equal(t, tc.limit+1, limit)
+					return rankings[:min(limit, len(rankings))], nil
+				},
+			}
+
+			usecase := NewExploreUsecase(mockRepo)
+			posts, nextCursor, err := usecase.GetExplore(viewerID, 4, tc.limit)
+
+			assert.NoError(t, err)
+			assert.Len(t, posts, tc.expectedPosts)
+			assert.Equal(t, rankings[0].Edges.Post, posts[0])
+			assert.Equal(t, tc.expectedCursor, nextCursor)
+		})
+	}
+}
+
+func TestExploreUsecase_RefreshRanking(t *testing.T) {
+	now := time.Now()
+	candidates := []models.ExploreCandidate{
+		{PostID: uuid.New(), AuthorID: uuid.New(), CreatedAt: now.Add(-time.Hour), LikesCount: 1},
+		{PostID: uuid.New(), AuthorID: uuid.New(), CreatedAt: now.Add(-time.Hour), LikesCount: 5},
+	}
+	var stored []models.ExploreScore
+	mockRepo := &mock.MockExploreRepository{
+		ListCandidatesFunc: func(since time.Time) ([]models.ExploreCandidate, error) {
+			assert.Equal(t, now.Add(-exploreWindow), since)
+			return candidates, nil
+		},
+		ReplaceRankingFunc: func(scores []models.ExploreScore) error {
+			stored = scores
+			return nil
+		},
+	}
+
+	usecase := NewExploreUsecase(mockRepo)
+	usecase.now = func() time.Time { return now }
+	count, err := usecase.RefreshRanking()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, stored, 2)
+	assert.Equal(t, candidates[1].PostID, stored[0].PostID)
+}
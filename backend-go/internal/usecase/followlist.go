@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// ListFollowers returns one page of the followers of the user, newest first, and the cursor of
+// the next page, which is empty on the last page. Users blocking the viewer or blocked by the
+// viewer are left out. Followers of a private user are shown only to approved followers.
+func (u *UserUsecase) ListFollowers(viewerID, userID uuid.UUID, cursor *models.Cursor, limit int) ([]models.FollowListUserResponse, string, error) {
+	if err := u.checkProfileAccess(viewerID, userID); err != nil {
+		return nil, "", err
+	}
+	relations, err := u.followRelationRepository.Followers(userID, viewerID, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	relations, next := trimPage(relations, limit, followRelationKey)
+	users := make([]*ent.User, len(relations))
+	for i, relation := range relations {
+		users[i] = relation.Edges.From
+	}
+	responses, err := u.followListResponses(viewerID, users)
+	if err != nil {
+		return nil, "", err
+	}
+	return responses, next, nil
+}
+
+// ListFollowing returns one page of the users followed by the user. See ListFollowers.
+func (u *UserUsecase) ListFollowing(viewerID, userID uuid.UUID, cursor *models.Cursor, limit int) ([]models.FollowListUserResponse, string, error) {
+	if err := u.checkProfileAccess(viewerID, userID); err != nil {
+		return nil, "", err
+	}
+	relations, err := u.followRelationRepository.Following(userID, viewerID, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	relations, next := trimPage(relations, limit, followRelationKey)
+	users := make([]*ent.User, len(relations))
+	for i, relation := range relations {
+		users[i] = relation.Edges.To
+	}
+	responses, err := u.followListResponses(viewerID, users)
+	if err != nil {
+		return nil, "", err
+	}
+	return responses, next, nil
+}
+
+// ListBlocking returns one page of the users blocked by the user. Only the user can see it.
+func (u *UserUsecase) ListBlocking(viewerID, userID uuid.UUID, cursor *models.Cursor, limit int) ([]models.FollowListUserResponse, string, error) {
+	if viewerID != userID {
+		return nil, "", ErrForbidden
+	}
+	relations, err := u.blockRelationRepository.ListBlocking(userID, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	relations, next := trimPage(relations, limit, func(relation *ent.BlockRelation) (time.Time, uuid.UUID) {
+		return relation.CreatedAt, relation.ID
+	})
+	users := make([]*ent.User, len(relations))
+	for i, relation := range relations {
+		users[i] = relation.Edges.To
+	}
+	responses, err := u.followListResponses(viewerID, users)
+	if err != nil {
+		return nil, "", err
+	}
+	return responses, next, nil
+}
+
+// checkProfileAccess returns ErrBlocked when the user blocks the viewer and ErrForbidden when the
+// user is private and the viewer is not an approved follower.
+func (u *UserUsecase) checkProfileAccess(viewerID, userID uuid.UUID) error {
+	user, err := u.userRepository.GetById(userID)
+	if err != nil {
+		return err
+	}
+	if viewerID == userID {
+		return nil
+	}
+	blockedBy, err := u.blockRelationRepository.Exists(userID, viewerID)
+	if err != nil {
+		return err
+	}
+	if blockedBy {
+		return ErrBlocked
+	}
+	if !user.IsPrivate {
+		return nil
+	}
+	following, err := u.followRelationRepository.IsFollowing(viewerID, userID)
+	if err != nil {
+		return err
+	}
+	if !following {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func followRelationKey(relation *ent.FollowRelation) (time.Time, uuid.UUID) {
+	return relation.CreatedAt, relation.ID
+}
+
+func (u *UserUsecase) followListResponses(viewerID uuid.UUID, users []*ent.User) ([]models.FollowListUserResponse, error) {
+	ids := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	followedIDs, err := u.followRelationRepository.FollowedIDs(viewerID, ids)
+	if err != nil {
+		return nil, err
+	}
+	followed := make(map[uuid.UUID]bool, len(followedIDs))
+	for _, id := range followedIDs {
+		followed[id] = true
+	}
+
+	responses := make([]models.FollowListUserResponse, len(users))
+	for i, user := range users {
+		iconURL := ""
+		if user.IconImageKey != "" {
+			iconURL, err = u.storageRepository.GetUrl(user.IconImageKey)
+			if err != nil {
+				log.Errorf("Failed to get url: %v", err)
+				return nil, err
+			}
+		}
+		responses[i] = models.FollowListUserResponse{
+			UserBaseResponse: models.NewUserBaseResponse(user, iconURL),
+			FollowedByMe:     followed[user.ID],
+		}
+	}
+	return responses, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserUsecase_ListFollowers(t *testing.T) {
+	viewerID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	followers := []*ent.User{
+		{ID: uuid.New(), Name: "a", IconImageKey: "profile/a"},
+		{ID: uuid.New(), Name: "b"},
+		{ID: uuid.New(), Name: "c"},
+	}
+	relations := make([]*ent.FollowRelation, len(followers))
+	for i, follower := range followers {
+		relations[i] = &ent.FollowRelation{ID: uuid.New(), CreatedAt: createdAt, Edges: ent.FollowRelationEdges{From: follower}}
+	}
+
+	testCases := []struct {
+		name          string
+		private       bool
+		blockedBy     bool
+		viewerFollows bool
+		limit         int
+		expectedError error
+		expectedCount int
+		expectNext    bool
+	}{
+		{
+			name:          "Public user, next page",
+			limit:         2,
+			expectedCount: 2,
+			expectNext:    true,
+		},
+		{
+			name:          "Public user, last page",
+			limit:         3,
+			expectedCount: 3,
+		},
+		{
+			name:          "Blocked by the user",
+			blockedBy:     true,
+			limit:         2,
+			expectedError: ErrBlocked,
+		},
+		{
+			name:          "Private user, not following",
+			private:       true,
+			limit:         2,
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Private user, following",
+			private:       true,
+			viewerFollows: true,
+			limit:         3,
+			expectedCount: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			target := &ent.User{ID: uuid.New(), IsPrivate: tc.private}
+			mockUserRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					return target, nil
+				},
+			}
+			mockBlockRepo := &mock.MockBlockRelationRepository{
+				ExistsFunc: func(fromID, toID uuid.UUID) (bool, error) {
+					return tc.blockedBy && fromID == target.ID && toID == viewerID, nil
+				},
+			}
+			mockFollowRepo := &mock.MockFollowRelationRepository{
+				IsFollowingFunc: func(fromID, toID uuid.UUID) (bool, error) {
+					return tc.viewerFollows, nil
+				},
+				FollowersFunc: func(userID, viewer uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.FollowRelation, error) {
+					assert.Equal(t, target.ID, userID)
+					assert.Equal(t, viewerID, viewer)
+					return relations[:min(limit, len(relations))], nil
+				},
+				FollowedIDsFunc: func(fromID uuid.UUID, toIDs []uuid.UUID) ([]uuid.UUID, error) {
+					return []uuid.UUID{followers[0].ID}, nil
+				},
+			}
+			mockStorageRepo := &mock.MockStorageRepository{
+				GetUrlFunc: func(fileKey string) (string, error) {
+					return "https://example.com/" + fileKey, nil
+				},
+			}
+
+			usecase := NewUserUsecase(mockUserRepo, mockStorageRepo, nil, nil, mockFollowRepo, mockBlockRepo, nil)
+			users, next, err := usecase.ListFollowers(viewerID, target.ID, nil, tc.limit)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, users, tc.expectedCount)
+			assert.True(t, users[0].FollowedByMe)
+			assert.False(t, users[1].FollowedByMe)
+			assert.Equal(t, "https://example.com/profile/a", *users[0].IconImageUrl)
+			if tc.expectNext {
+				last := relations[tc.limit-1]
+				assert.Equal(t, models.NewCursor(last.CreatedAt, last.ID).Encode(), next)
+			} else {
+				assert.Empty(t, next)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_ListBlockingOnlyOwner(t *testing.T) {
+	usecase := NewUserUsecase(nil, nil, nil, nil, nil, &mock.MockBlockRelationRepository{}, nil)
+	_, _, err := usecase.ListBlocking(uuid.New(), uuid.New(), nil, 20)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
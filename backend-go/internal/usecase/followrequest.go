@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+type FollowRequestUsecase struct {
+	followRequestRepository repository.FollowRequestRepository
+	storageRepository       repository.StorageRepository
+}
+
+func NewFollowRequestUsecase(
+	followRequestRepository repository.FollowRequestRepository,
+	storageRepository repository.StorageRepository) *FollowRequestUsecase {
+	return &FollowRequestUsecase{
+		followRequestRepository: followRequestRepository,
+		storageRepository:       storageRepository,
+	}
+}
+
+// Approve lets the requested user accept a request, which makes the requester a follower.
+func (u *FollowRequestUsecase) Approve(userId, requestId uuid.UUID) error {
+	if _, err := u.getReceived(userId, requestId); err != nil {
+		return err
+	}
+	return u.followRequestRepository.Approve(requestId)
+}
+
+// Reject lets the requested user decline a request.
+func (u *FollowRequestUsecase) Reject(userId, requestId uuid.UUID) error {
+	if _, err := u.getReceived(userId, requestId); err != nil {
+		return err
+	}
+	return u.followRequestRepository.Delete(requestId)
+}
+
+// Cancel lets the requester withdraw a request.
+func (u *FollowRequestUsecase) Cancel(userId, requestId uuid.UUID) error {
+	request, err := u.followRequestRepository.GetById(requestId)
+	if err != nil {
+		return err
+	}
+	if request.Edges.From.ID != userId {
+		return ErrForbidden
+	}
+	return u.followRequestRepository.Delete(requestId)
+}
+
+func (u *FollowRequestUsecase) getReceived(userId, requestId uuid.UUID) (*ent.FollowRequest, error) {
+	request, err := u.followRequestRepository.GetById(requestId)
+	if err != nil {
+		return nil, err
+	}
+	if request.Edges.To.ID != userId {
+		return nil, ErrForbidden
+	}
+	return request, nil
+}
+
+func (u *FollowRequestUsecase) ListIncoming(userId uuid.UUID) ([]models.FollowRequestResponse, error) {
+	requests, err := u.followRequestRepository.ListIncoming(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.FollowRequestResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = models.NewFollowRequestResponse(request, u.userBaseResponse(request.Edges.From))
+	}
+	return responses, nil
+}
+
+func (u *FollowRequestUsecase) ListOutgoing(userId uuid.UUID) ([]models.FollowRequestResponse, error) {
+	requests, err := u.followRequestRepository.ListOutgoing(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.FollowRequestResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = models.NewFollowRequestResponse(request, u.userBaseResponse(request.Edges.To))
+	}
+	return responses, nil
+}
+
+func (u *FollowRequestUsecase) userBaseResponse(user *ent.User) models.UserBaseResponse {
+	imageUrl := ""
+	if user.IconImageKey != "" {
+		url, err := u.storageRepository.GetUrl(user.IconImageKey)
+		if err != nil {
+			log.Warnf("Failed to get icon URL for user %s: %v", user.Name, err)
+		} else {
+			imageUrl = url
+		}
+	}
+	return models.NewUserBaseResponse(user, imageUrl)
+}
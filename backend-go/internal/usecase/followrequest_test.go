@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowRequestUsecase(t *testing.T) {
+	requester := &ent.User{ID: uuid.New()}
+	target := &ent.User{ID: uuid.New()}
+	other := uuid.New()
+	request := &ent.FollowRequest{ID: uuid.New(), Edges: ent.FollowRequestEdges{From: requester, To: target}}
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		call          func(u *FollowRequestUsecase) error
+		expectApprove bool
+		expectDelete  bool
+		expectedError error
+	}{
+		{
+			name:          "Approve by target",
+			call:          func(u *FollowRequestUsecase) error { return u.Approve(target.ID, request.ID) },
+			expectApprove: true,
+		},
+		{
+			name:          "Approve by requester",
+			call:          func(u *FollowRequestUsecase) error { return u.Approve(requester.ID, request.ID) },
+			expectedError: ErrForbidden,
+		},
+		{
+			name:         "Reject by target",
+			call:         func(u *FollowRequestUsecase) error { return u.Reject(target.ID, request.ID) },
+			expectDelete: true,
+		},
+		{
+			name:          "Reject by other user",
+			call:          func(u *FollowRequestUsecase) error { return u.Reject(other, request.ID) },
+			expectedError: ErrForbidden,
+		},
+		{
+			name:         "Cancel by requester",
+			call:         func(u *FollowRequestUsecase) error { return u.Cancel(requester.ID, request.ID) },
+			expectDelete: true,
+		},
+		{
+			name:          "Cancel by target",
+			call:          func(u *FollowRequestUsecase) error { return u.Cancel(target.ID, request.ID) },
+			expectedError: ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			approved, deleted := false, false
+
+			mockRepo := &mock.MockFollowRequestRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.FollowRequest, error) {
+					assert.Equal(t, request.ID, id)
+					return request, nil
+				},
+				ApproveFunc: func(id uuid.UUID) error {
+					approved = true
+					return nil
+				},
+				DeleteFunc: func(id uuid.UUID) error {
+					deleted = true
+					return nil
+				},
+			}
+
+			err := tc.call(NewFollowRequestUsecase(mockRepo, nil))
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectApprove, approved)
+			assert.Equal(t, tc.expectDelete, deleted)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+)
+
+const (
+	minHandleLength = 3
+	maxHandleLength = 30
+	// maxHandleBaseLength leaves room for the "_1234" suffix added on collisions.
+	maxHandleBaseLength = maxHandleLength - 5
+	handleAttempts      = 10
+)
+
+var handlePattern = regexp.MustCompile(fmt.Sprintf(`^[a-z0-9_]{%d,%d}$`, minHandleLength, maxHandleLength))
+
+var errHandleUnavailable = errors.New("failed to find an unused handle")
+
+// normalizeHandle lower-cases the handle and drops a leading "@".
+func normalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+func validHandle(handle string) bool {
+	return handlePattern.MatchString(handle)
+}
+
+// handleBase derives a handle from the display name. Names without enough ASCII
+// letters (e.g. Japanese names) fall back to "user". The email is never used so the
+// handle does not reveal it.
+func handleBase(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if b.Len() >= maxHandleBaseLength {
+			break
+		}
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case r == ' ' || r == '.' || r == '-':
+			b.WriteRune('_')
+		}
+	}
+	base := strings.Trim(b.String(), "_")
+	if len(base) < minHandleLength {
+		return "user"
+	}
+	return base
+}
+
+// newHandle returns an unused handle for a user with the name.
+func (u *UserUsecase) newHandle(name string) (string, error) {
+	base := handleBase(name)
+	candidate := base
+	for i := 0; i < handleAttempts; i++ {
+		exists, err := u.userRepository.ExistsHandle(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s_%04d", base, rand.Intn(10000))
+	}
+	return "", errHandleUnavailable
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxPostTags is the largest number of hashtags stored for one post. Later ones are ignored.
+	MaxPostTags = 30
+	// maxTagLength is the longest hashtag, in characters. Longer ones are ignored.
+	maxTagLength = 100
+)
+
+// extractHashtags returns the normalized hashtags of the caption in order of appearance, without
+// duplicates. A hashtag starts with "#" or "＃" that does not follow a letter or digit, and runs
+// over letters, digits, marks and "_", so Japanese hashtags like #柴犬の日 are included. Tags made
+// only of digits (e.g. "#1") are not hashtags.
+func extractHashtags(caption string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+	var prev rune
+	for i := 0; i < len(caption); {
+		r, size := utf8.DecodeRuneInString(caption[i:])
+		if (r != '#' && r != '＃') || isTagRune(prev) {
+			prev = r
+			i += size
+			continue
+		}
+		end := i + size
+		for end < len(caption) {
+			next, nextSize := utf8.DecodeRuneInString(caption[end:])
+			if !isTagRune(next) {
+				break
+			}
+			end += nextSize
+		}
+		if tag, ok := normalizeTag(caption[i+size : end]); ok && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+			if len(tags) == MaxPostTags {
+				break
+			}
+		}
+		// "#a#b" の b はタグにしないが、"##a" の a はタグにする
+		prev = r
+		if end > i+size {
+			prev, _ = utf8.DecodeLastRuneInString(caption[:end])
+		}
+		i = end
+	}
+	return tags
+}
+
+func isTagRune(r rune) bool {
+	return r == '_' || r == '＿' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// normalizeTag turns full-width ASCII letters and digits into half-width ones and lower-cases the
+// name, so #Shiba, #shiba and #ｓｈｉｂａ are the same tag. It reports false for names that are not
+// valid hashtags.
+func normalizeTag(name string) (string, bool) {
+	var b strings.Builder
+	count := 0
+	allDigits := true
+	for _, r := range name {
+		if r >= '！' && r <= '～' {
+			r -= '！' - '!'
+		}
+		if !isTagRune(r) {
+			return "", false
+		}
+		if !unicode.IsDigit(r) {
+			allDigits = false
+		}
+		b.WriteRune(unicode.ToLower(r))
+		count++
+	}
+	if count == 0 || count > maxTagLength || allDigits {
+		return "", false
+	}
+	return b.String(), true
+}
+
+// normalizeTagName normalizes a tag name given by a client, with or without a leading "#", and
+// returns ErrInvalidTag if it is not a valid hashtag.
+func normalizeTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	name = strings.TrimPrefix(strings.TrimPrefix(name, "#"), "＃")
+	tag, ok := normalizeTag(name)
+	if !ok {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	testCases := []struct {
+		name     string
+		caption  string
+		expected []string
+	}{
+		{
+			name:     "No hashtags",
+			caption:  "今日は公園へ",
+			expected: []string{},
+		},
+		{
+			name:     "Japanese hashtags",
+			caption:  "散歩した！#柴犬の日 #しばいぬ　#散歩",
+			expected: []string{"柴犬の日", "しばいぬ", "散歩"},
+		},
+		{
+			name:     "Full-width sign and letters",
+			caption:  "＃ｓｈｉｂａ #Shiba #shiba",
+			expected: []string{"shiba"},
+		},
+		{
+			name:     "Ends at punctuation",
+			caption:  "#dog, #cat. (#bird) #long_name!",
+			expected: []string{"dog", "cat", "bird", "long_name"},
+		},
+		{
+			name:     "Katakana with prolonged sound mark",
+			caption:  "#トイプードル #ポメラニアン2匹",
+			expected: []string{"トイプードル", "ポメラニアン2匹"},
+		},
+		{
+			name:     "Not after a letter or digit",
+			caption:  "abc#def 1#2 #a#b ##ok",
+			expected: []string{"a", "ok"},
+		},
+		{
+			name:     "Digits only and empty",
+			caption:  "#1 #2024 # #",
+			expected: []string{},
+		},
+		{
+			name:     "Digits with letters",
+			caption:  "#2024年",
+			expected: []string{"2024年"},
+		},
+		{
+			name:     "Too long",
+			caption:  "#" + strings.Repeat("a", maxTagLength+1) + " #ok",
+			expected: []string{"ok"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, extractHashtags(tc.caption))
+		})
+	}
+
+	t.Run("At most MaxPostTags", func(t *testing.T) {
+		var caption strings.Builder
+		for i := 0; i < MaxPostTags+5; i++ {
+			caption.WriteString("#tag" + strings.Repeat("x", i) + " ")
+		}
+		assert.Len(t, extractHashtags(caption.String()), MaxPostTags)
+	})
+}
+
+func TestNormalizeTagName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "Plain", input: "柴犬の日", expected: "柴犬の日"},
+		{name: "With sign", input: "#Shiba", expected: "shiba"},
+		{name: "Full-width sign", input: "＃ＤＯＧ", expected: "dog"},
+		{name: "Space", input: "shiba inu", wantErr: true},
+		{name: "Empty", input: "#", wantErr: true},
+		{name: "Digits only", input: "123", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, err := normalizeTagName(tc.input)
+			if tc.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidTag))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, name)
+		})
+	}
+}
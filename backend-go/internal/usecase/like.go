@@ -1,19 +1,42 @@
 package usecase
 
-import "github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+import (
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
 
 type LikeUsecase struct {
 	likeRepository repository.LikeRepository
+	postRepository repository.PostRepository
 }
 
-func NewLikeUsecase(likeRepository repository.LikeRepository) *LikeUsecase {
+func NewLikeUsecase(likeRepository repository.LikeRepository, postRepository repository.PostRepository) *LikeUsecase {
 	return &LikeUsecase{
 		likeRepository: likeRepository,
+		postRepository: postRepository,
 	}
 }
 
-func (u *LikeUsecase) Create(userID, postID string) error {
-	err := u.likeRepository.Create(userID, postID)
+// Create likes the post with the reaction, or changes the reaction of the user's like. Posts the
+// user cannot see, including posts by users with a block relation to the user, are not found.
+func (u *LikeUsecase) Create(userID, postID string, reaction enum.ReactionType) error {
+	if !reaction.Valid() {
+		return ErrInvalidReaction
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+	postUUID, err := uuid.Parse(postID)
+	if err != nil {
+		return err
+	}
+	if _, err := u.postRepository.GetVisibleById(userUUID, postUUID); err != nil {
+		return err
+	}
+
+	err = u.likeRepository.Create(userID, postID, reaction)
 	return err
 }
 
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -15,42 +17,79 @@ func TestLikeUsecase_Create(t *testing.T) {
 		name          string
 		userID        string
 		postID        string
+		reaction      enum.ReactionType
+		postError     error
 		mockError     error
+		expectCreate  bool
 		expectedError error
 	}{
 		{
 			name:          "Success",
 			userID:        uuid.New().String(),
 			postID:        uuid.New().String(),
+			reaction:      enum.ReactionTypeHeart,
 			mockError:     nil,
+			expectCreate:  true,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
 			userID:        uuid.New().String(),
 			postID:        uuid.New().String(),
+			reaction:      enum.ReactionTypePaw,
 			mockError:     errors.New("database error"),
+			expectCreate:  true,
 			expectedError: errors.New("database error"),
 		},
+		{
+			// Deleted, hidden or blocked posts and private posts of users not followed
+			name:          "Post not visible",
+			userID:        uuid.New().String(),
+			postID:        uuid.New().String(),
+			reaction:      enum.ReactionTypePaw,
+			postError:     &ent.NotFoundError{},
+			expectedError: &ent.NotFoundError{},
+		},
+		{
+			name:          "Invalid reaction",
+			userID:        uuid.New().String(),
+			postID:        uuid.New().String(),
+			reaction:      enum.ReactionType("angry"),
+			expectedError: ErrInvalidReaction,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			created := false
+
 			// Create mock repository
 			mockRepo := &mock.MockLikeRepository{
-				CreateFunc: func(userId, postId string) error {
+				CreateFunc: func(userId, postId string, reaction enum.ReactionType) error {
+					created = true
 					// Verify input parameters
 					assert.Equal(t, tc.userID, userId)
 					assert.Equal(t, tc.postID, postId)
+					assert.Equal(t, tc.reaction, reaction)
 					return tc.mockError
 				},
 			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetVisibleByIdFunc: func(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, tc.userID, viewerID.String())
+					assert.Equal(t, tc.postID, postId.String())
+					if tc.postError != nil {
+						return nil, tc.postError
+					}
+					return &ent.Post{ID: postId}, nil
+				},
+			}
 
 			// Create usecase with mock repository
-			usecase := NewLikeUsecase(mockRepo)
+			usecase := NewLikeUsecase(mockRepo, mockPostRepo)
 
 			// Call the method
-			err := usecase.Create(tc.userID, tc.postID)
+			err := usecase.Create(tc.userID, tc.postID, tc.reaction)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -59,6 +98,7 @@ func TestLikeUsecase_Create(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, tc.expectCreate, created)
 		})
 	}
 }
@@ -101,7 +141,7 @@ func TestLikeUsecase_Delete(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewLikeUsecase(mockRepo)
+			usecase := NewLikeUsecase(mockRepo, nil)
 
 			// Call the method
 			err := usecase.Delete(tc.userID, tc.postID)
@@ -157,7 +197,7 @@ func TestLikeUsecase_Count(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewLikeUsecase(mockRepo)
+			usecase := NewLikeUsecase(mockRepo, nil)
 
 			// Call the method
 			count, err := usecase.Count(tc.postID)
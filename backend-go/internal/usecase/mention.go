@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+// MaxMentions is the largest number of users mentioned in one caption or comment. Later
+// mentions are not linked and not notified.
+const MaxMentions = 20
+
+// extractMentions returns the handles mentioned in the text in order of appearance, without
+// duplicates.
+func extractMentions(text string) []string {
+	handles := []string{}
+	seen := map[string]bool{}
+	for _, mention := range models.FindMentions(text) {
+		if seen[mention.Handle] {
+			continue
+		}
+		seen[mention.Handle] = true
+		handles = append(handles, mention.Handle)
+		if len(handles) == MaxMentions {
+			break
+		}
+	}
+	return handles
+}
+
+// resolveMentions returns the IDs of the users the author mentions in the text. Handles of no
+// user, of suspended users and of users with a block relation to the author are ignored.
+func resolveMentions(userRepository repository.UserRepository, authorID uuid.UUID, text string) ([]uuid.UUID, error) {
+	handles := extractMentions(text)
+	if len(handles) == 0 {
+		return nil, nil
+	}
+	users, err := userRepository.GetMentionable(authorID, handles)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	return ids, nil
+}
+
+// diffMentions returns the users mentioned after an edit but not before, and the other way around.
+func diffMentions(before, after []*ent.User) (added []uuid.UUID, removed []uuid.UUID) {
+	remaining := make(map[uuid.UUID]bool, len(before))
+	for _, user := range before {
+		remaining[user.ID] = true
+	}
+	for _, user := range after {
+		if !remaining[user.ID] {
+			added = append(added, user.ID)
+		}
+		delete(remaining, user.ID)
+	}
+	removed = make([]uuid.UUID, 0, len(remaining))
+	for id := range remaining {
+		removed = append(removed, id)
+	}
+	return added, removed
+}
+
+// notifyMentions notifies the mentioned users other than the author. A failure is only logged
+// since the post or the comment is already stored.
+func notifyMentions(notificationRepository repository.NotificationRepository, authorID uuid.UUID, mentionedIDs []uuid.UUID, postID uuid.UUID, commentID *uuid.UUID) {
+	recipients := make([]uuid.UUID, 0, len(mentionedIDs))
+	for _, id := range mentionedIDs {
+		if id != authorID {
+			recipients = append(recipients, id)
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	if err := notificationRepository.CreateMentions(authorID, recipients, postID, commentID); err != nil {
+		log.Warnf("Failed to create mention notifications: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractMentions(t *testing.T) {
+	many := make([]string, MaxMentions+5)
+	for i := range many {
+		many[i] = fmt.Sprintf("@user%02d", i)
+	}
+
+	testCases := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{name: "Plain", text: "@pochi_mama と散歩", expected: []string{"pochi_mama"}},
+		{name: "Lower-cased without duplicates", text: "@Shiba @shiba @tama", expected: []string{"shiba", "tama"}},
+		{name: "Full-width at sign", text: "ありがとう＠shiba！", expected: []string{"shiba"}},
+		{name: "Email addresses are not mentions", text: "mail me at shiba@example.com", expected: []string{}},
+		{name: "Too short", text: "@ab", expected: []string{}},
+		{name: "Too long", text: "@" + strings.Repeat("a", 31), expected: []string{}},
+		{name: "Capped", text: strings.Join(many, " "), expected: many2handles(many[:MaxMentions])},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, extractMentions(tc.text))
+		})
+	}
+}
+
+func many2handles(mentions []string) []string {
+	handles := make([]string, len(mentions))
+	for i, mention := range mentions {
+		handles[i] = strings.TrimPrefix(mention, "@")
+	}
+	return handles
+}
+
+func TestNewMentionResponses(t *testing.T) {
+	shiba := &ent.User{ID: uuid.New(), Handle: "shiba"}
+	tama := &ent.User{ID: uuid.New(), Handle: "tama"}
+
+	// 🐕 は UTF-16 で 2 単位
+	text := "🐕と@Shiba、@blocked と＠tama"
+	mentions := models.NewMentionResponses(text, []*ent.User{shiba, tama})
+	assert.Equal(t, []models.MentionResponse{
+		{UserID: shiba.ID, Handle: "shiba", Start: 3, End: 9},
+		{UserID: tama.ID, Handle: "tama", Start: 20, End: 25},
+	}, mentions)
+
+	assert.Equal(t, []models.MentionResponse{}, models.NewMentionResponses(text, nil))
+}
+
+func TestPostUsecase_UpdatePost_Mentions(t *testing.T) {
+	ownerID := uuid.New()
+	postID := uuid.New()
+	kept := &ent.User{ID: uuid.New(), Handle: "kept"}
+	removed := &ent.User{ID: uuid.New(), Handle: "removed"}
+	added := &ent.User{ID: uuid.New(), Handle: "added"}
+
+	mockPostRepo := &mock.MockPostRepository{
+		GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+			return &ent.Post{ID: postId, Edges: ent.PostEdges{
+				User:     &ent.User{ID: ownerID},
+				Mentions: []*ent.User{kept, removed},
+			}}, nil
+		},
+		UpdatePostFunc: func(postId uuid.UUID, caption string, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID) (*ent.Post, error) {
+			assert.Equal(t, []uuid.UUID{kept.ID, added.ID}, mentionIds)
+			return &ent.Post{ID: postId, Caption: caption, Edges: ent.PostEdges{Mentions: []*ent.User{kept, added}}}, nil
+		},
+	}
+	mockUserRepo := &mock.MockUserRepository{
+		GetMentionableFunc: func(authorID uuid.UUID, handles []string) ([]*ent.User, error) {
+			assert.Equal(t, ownerID, authorID)
+			assert.Equal(t, []string{"kept", "added", "blocked"}, handles)
+			return []*ent.User{kept, added}, nil
+		},
+	}
+	var notified, unnotified []uuid.UUID
+	mockNotificationRepo := &mock.MockNotificationRepository{
+		CreateMentionsFunc: func(actorID uuid.UUID, recipientIDs []uuid.UUID, postId uuid.UUID, commentID *uuid.UUID) error {
+			assert.Equal(t, ownerID, actorID)
+			assert.Equal(t, postID, postId)
+			assert.Nil(t, commentID)
+			notified = recipientIDs
+			return nil
+		},
+		DeletePostMentionsFunc: func(postId uuid.UUID, recipientIDs []uuid.UUID) error {
+			unnotified = recipientIDs
+			return nil
+		},
+	}
+	usecase := NewPostUsecase(mockPostRepo, nil, nil, nil, nil, mockUserRepo, mockNotificationRepo)
+
+	post, err := usecase.UpdatePost(ownerID, postID, "@kept @added @blocked", nil)
+	require.NoError(t, err)
+	assert.Len(t, post.Edges.Mentions, 2)
+	assert.Equal(t, []uuid.UUID{added.ID}, notified, "only newly mentioned users are notified")
+	assert.Equal(t, []uuid.UUID{removed.ID}, unnotified)
+}
+
+func TestCommentUsecase_Create_Mentions(t *testing.T) {
+	authorID := uuid.New()
+	postID := uuid.New()
+	commentID := uuid.New()
+	friend := &ent.User{ID: uuid.New(), Handle: "friend"}
+	author := &ent.User{ID: authorID, Handle: "myself"}
+
+	mockCommentRepo := &mock.MockCommentRepository{
+		CreateFunc: func(userId uuid.UUID, postId uuid.UUID, parentId *uuid.UUID, content string, mentionIds []uuid.UUID) (*ent.Comment, error) {
+			assert.Equal(t, []uuid.UUID{friend.ID, authorID}, mentionIds)
+			return &ent.Comment{ID: commentID, Content: content, Edges: ent.CommentEdges{
+				User:     author,
+				Mentions: []*ent.User{friend, author},
+			}}, nil
+		},
+	}
+	mockPostRepo := &mock.MockPostRepository{
+		GetVisibleByIdFunc: func(viewerID uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+			return &ent.Post{ID: postId, Edges: ent.PostEdges{User: author}}, nil
+		},
+	}
+	mockUserRepo := &mock.MockUserRepository{
+		GetMentionableFunc: func(authorID uuid.UUID, handles []string) ([]*ent.User, error) {
+			return []*ent.User{friend, author}, nil
+		},
+	}
+	var notified []uuid.UUID
+	mockNotificationRepo := &mock.MockNotificationRepository{
+		CreateMentionsFunc: func(actorID uuid.UUID, recipientIDs []uuid.UUID, postId uuid.UUID, commentId *uuid.UUID) error {
+			assert.Equal(t, postID, postId)
+			require.NotNil(t, commentId)
+			assert.Equal(t, commentID, *commentId)
+			notified = recipientIDs
+			return nil
+		},
+	}
+	usecase := NewCommentUsecase(mockCommentRepo, mockPostRepo, &mock.MockStorageRepository{}, nil, mockUserRepo, mockNotificationRepo)
+
+	response, err := usecase.Create(authorID, postID, nil, "@friend @myself")
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{friend.ID}, notified, "authors are not notified of their own mentions")
+	require.Len(t, response.Mentions, 2)
+	assert.Equal(t, models.MentionResponse{UserID: friend.ID, Handle: "friend", Start: 0, End: 7}, response.Mentions[0])
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	maxMutedKeywordLength = 50
+	maxMutedKeywords      = 100
+)
+
+type MuteUsecase struct {
+	muteRelationRepository repository.MuteRelationRepository
+	mutedKeywordRepository repository.MutedKeywordRepository
+	userRepository         repository.UserRepository
+	storageRepository      repository.StorageRepository
+}
+
+func NewMuteUsecase(
+	muteRelationRepository repository.MuteRelationRepository,
+	mutedKeywordRepository repository.MutedKeywordRepository,
+	userRepository repository.UserRepository,
+	storageRepository repository.StorageRepository) *MuteUsecase {
+	return &MuteUsecase{
+		muteRelationRepository: muteRelationRepository,
+		mutedKeywordRepository: mutedKeywordRepository,
+		userRepository:         userRepository,
+		storageRepository:      storageRepository,
+	}
+}
+
+// MuteUser hides the target's posts from the user's feeds. Follow relations are kept.
+func (u *MuteUsecase) MuteUser(userId, targetId uuid.UUID) error {
+	if userId == targetId {
+		return ErrInvalidMute
+	}
+	if _, err := u.userRepository.GetById(targetId); err != nil {
+		return err
+	}
+	return u.muteRelationRepository.Create(userId, targetId)
+}
+
+func (u *MuteUsecase) UnmuteUser(userId, targetId uuid.UUID) error {
+	return u.muteRelationRepository.Delete(userId, targetId)
+}
+
+func (u *MuteUsecase) ListMutedUsers(userId uuid.UUID) ([]models.UserBaseResponse, error) {
+	users, err := u.muteRelationRepository.MutedUsers(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.UserBaseResponse, len(users))
+	for i, user := range users {
+		imageUrl := ""
+		if user.IconImageKey != "" {
+			url, err := u.storageRepository.GetUrl(user.IconImageKey)
+			if err != nil {
+				log.Warnf("Failed to get icon URL for muted user %s: %v", user.Name, err)
+			} else {
+				imageUrl = url
+			}
+		}
+		responses[i] = models.NewUserBaseResponse(user, imageUrl)
+	}
+	return responses, nil
+}
+
+// AddKeyword mutes posts whose caption contains the keyword, ignoring case.
+// Adding a keyword that is already muted returns the existing one.
+func (u *MuteUsecase) AddKeyword(userId uuid.UUID, keyword string) (*models.MutedKeywordResponse, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" || utf8.RuneCountInString(keyword) > maxMutedKeywordLength {
+		return nil, ErrInvalidMute
+	}
+
+	count, err := u.mutedKeywordRepository.CountByUser(userId)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxMutedKeywords {
+		return nil, ErrTooManyMutedKeywords
+	}
+
+	mutedKeyword, err := u.mutedKeywordRepository.Create(userId, keyword)
+	if err != nil {
+		return nil, err
+	}
+	response := models.NewMutedKeywordResponse(mutedKeyword)
+	return &response, nil
+}
+
+func (u *MuteUsecase) RemoveKeyword(userId, keywordId uuid.UUID) error {
+	return u.mutedKeywordRepository.Delete(userId, keywordId)
+}
+
+func (u *MuteUsecase) ListKeywords(userId uuid.UUID) ([]models.MutedKeywordResponse, error) {
+	keywords, err := u.mutedKeywordRepository.ListByUser(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.MutedKeywordResponse, len(keywords))
+	for i, keyword := range keywords {
+		responses[i] = models.NewMutedKeywordResponse(keyword)
+	}
+	return responses, nil
+}
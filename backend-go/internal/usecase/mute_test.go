@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuteUsecase_MuteUser(t *testing.T) {
+	userID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		targetID      uuid.UUID
+		userError     error
+		expectCreate  bool
+		expectedError error
+	}{
+		{
+			name:         "Success",
+			targetID:     uuid.New(),
+			expectCreate: true,
+		},
+		{
+			name:          "Self",
+			targetID:      userID,
+			expectedError: ErrInvalidMute,
+		},
+		{
+			name:          "Target not found",
+			targetID:      uuid.New(),
+			userError:     &ent.NotFoundError{},
+			expectedError: &ent.NotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			created := false
+
+			mockUserRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					assert.Equal(t, tc.targetID, id)
+					if tc.userError != nil {
+						return nil, tc.userError
+					}
+					return &ent.User{ID: id}, nil
+				},
+			}
+			mockMuteRepo := &mock.MockMuteRelationRepository{
+				CreateFunc: func(fromID, toID uuid.UUID) error {
+					created = true
+					assert.Equal(t, userID, fromID)
+					assert.Equal(t, tc.targetID, toID)
+					return nil
+				},
+			}
+
+			usecase := NewMuteUsecase(mockMuteRepo, nil, mockUserRepo, nil)
+
+			err := usecase.MuteUser(userID, tc.targetID)
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectCreate, created)
+		})
+	}
+}
+
+func TestMuteUsecase_AddKeyword(t *testing.T) {
+	userID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name            string
+		keyword         string
+		count           int
+		expectedKeyword string
+		expectedError   error
+	}{
+		{
+			name:            "Success",
+			keyword:         "  ネタバレ ",
+			expectedKeyword: "ネタバレ",
+		},
+		{
+			name:          "Empty",
+			keyword:       "   ",
+			expectedError: ErrInvalidMute,
+		},
+		{
+			name:          "Too long",
+			keyword:       strings.Repeat("猫", maxMutedKeywordLength+1),
+			expectedError: ErrInvalidMute,
+		},
+		{
+			name:          "Limit reached",
+			keyword:       "spoiler",
+			count:         maxMutedKeywords,
+			expectedError: ErrTooManyMutedKeywords,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockKeywordRepo := &mock.MockMutedKeywordRepository{
+				CountByUserFunc: func(id uuid.UUID) (int, error) {
+					assert.Equal(t, userID, id)
+					return tc.count, nil
+				},
+				CreateFunc: func(id uuid.UUID, keyword string) (*ent.MutedKeyword, error) {
+					assert.Equal(t, userID, id)
+					return &ent.MutedKeyword{ID: uuid.New(), Keyword: keyword}, nil
+				},
+			}
+
+			usecase := NewMuteUsecase(nil, mockKeywordRepo, nil, nil)
+
+			keyword, err := usecase.AddKeyword(userID, tc.keyword)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, keyword)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKeyword, keyword.Keyword)
+			}
+		})
+	}
+}
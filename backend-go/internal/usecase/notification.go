@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+type NotificationUsecase struct {
+	notificationRepository repository.NotificationRepository
+	storageRepository      repository.StorageRepository
+}
+
+func NewNotificationUsecase(
+	notificationRepository repository.NotificationRepository,
+	storageRepository repository.StorageRepository) *NotificationUsecase {
+	return &NotificationUsecase{
+		notificationRepository: notificationRepository,
+		storageRepository:      storageRepository,
+	}
+}
+
+// List returns one page of the notifications of the user, newest first, and the cursor of the
+// next page, which is empty on the last page.
+func (u *NotificationUsecase) List(userId uuid.UUID, cursor *models.Cursor, limit int) ([]models.NotificationResponse, string, error) {
+	notifications, err := u.notificationRepository.ListByRecipient(userId, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	notifications, next := trimPage(notifications, limit, func(notification *ent.Notification) (time.Time, uuid.UUID) {
+		return notification.CreatedAt, notification.ID
+	})
+
+	responses := make([]models.NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		imageUrl := ""
+		if actor := notification.Edges.Actor; actor.IconImageKey != "" {
+			url, err := u.storageRepository.GetUrl(actor.IconImageKey)
+			if err != nil {
+				log.Warnf("Failed to get icon URL for user %s: %v", actor.Name, err)
+			} else {
+				imageUrl = url
+			}
+		}
+		responses[i] = models.NewNotificationResponse(notification, imageUrl)
+	}
+	return responses, next, nil
+}
+
+func (u *NotificationUsecase) CountUnread(userId uuid.UUID) (int, error) {
+	return u.notificationRepository.CountUnread(userId)
+}
+
+func (u *NotificationUsecase) MarkAllRead(userId uuid.UUID) error {
+	return u.notificationRepository.MarkAllRead(userId)
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// trimPage cuts a page fetched with limit+1 rows back to limit and returns the cursor of the
+// next page, or an empty string when the extra row was not there and this is the last page.
+func trimPage[T any](items []T, limit int, key func(T) (time.Time, uuid.UUID)) ([]T, string) {
+	if len(items) <= limit {
+		return items, ""
+	}
+	items = items[:limit]
+	createdAt, id := key(items[limit-1])
+	return items, models.NewCursor(createdAt, id).Encode()
+}
+
+func postKey(post *ent.Post) (time.Time, uuid.UUID) {
+	return post.CreatedAt, post.ID
+}
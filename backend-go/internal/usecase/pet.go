@@ -2,16 +2,23 @@ package usecase
 
 import (
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
 )
 
+// petProfilePhotosLimit is the number of latest photos shown on the profile of a pet.
+const petProfilePhotosLimit = 9
+
 type PetUsecase struct {
-	petRepository repository.PetRepository
+	petRepository  repository.PetRepository
+	postRepository repository.PostRepository
 }
 
-func NewPetUsecase(petRepository repository.PetRepository) *PetUsecase {
+func NewPetUsecase(petRepository repository.PetRepository, postRepository repository.PostRepository) *PetUsecase {
 	return &PetUsecase{
-		petRepository: petRepository,
+		petRepository:  petRepository,
+		postRepository: postRepository,
 	}
 }
 
@@ -19,14 +26,51 @@ func (u *PetUsecase) GetByOwner(ownerID string) ([]*ent.Pet, error) {
 	return u.petRepository.GetByOwner(ownerID)
 }
 
+// GetProfile returns the pet with its owner, the number of posts it is tagged in and the latest
+// of them, as seen by the viewer. Pets of users who block the viewer or are private to them
+// return a not found error.
+func (u *PetUsecase) GetProfile(viewerId uuid.UUID, petId uuid.UUID) (models.PetProfile, error) {
+	pet, err := u.petRepository.GetVisibleById(viewerId, petId)
+	if err != nil {
+		return models.PetProfile{}, err
+	}
+	count, err := u.postRepository.CountPostsByPet(petId, viewerId)
+	if err != nil {
+		return models.PetProfile{}, err
+	}
+	posts, err := u.postRepository.GetPostsByPet(petId, viewerId, nil, petProfilePhotosLimit)
+	if err != nil {
+		return models.PetProfile{}, err
+	}
+	return models.PetProfile{Pet: pet, PostsCount: count, LatestPosts: posts}, nil
+}
+
 func (u *PetUsecase) Create(name, petType, species, birthDay, fileKey, userID string) (*ent.Pet, error) {
 	return u.petRepository.Create(name, petType, species, birthDay, fileKey, userID)
 }
 
-func (u *PetUsecase) Update(petId, name, petType, species, birthDay string) error {
+func (u *PetUsecase) Update(userId uuid.UUID, petId, name, petType, species, birthDay string) error {
+	if err := u.checkOwner(userId, petId); err != nil {
+		return err
+	}
 	return u.petRepository.Update(petId, name, petType, species, birthDay)
 }
 
-func (u *PetUsecase) Delete(petId string) error {
+func (u *PetUsecase) Delete(userId uuid.UUID, petId string) error {
+	if err := u.checkOwner(userId, petId); err != nil {
+		return err
+	}
 	return u.petRepository.Delete(petId)
 }
+
+// checkOwner returns ErrForbidden unless the pet belongs to the user.
+func (u *PetUsecase) checkOwner(userId uuid.UUID, petId string) error {
+	pet, err := u.petRepository.GetById(petId)
+	if err != nil {
+		return err
+	}
+	if pet.Edges.Owner == nil || pet.Edges.Owner.ID != userId {
+		return ErrForbidden
+	}
+	return nil
+}
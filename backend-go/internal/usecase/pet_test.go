@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -65,7 +66,7 @@ func TestPetUsecase_GetByOwner(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewPetUsecase(mockRepo)
+			usecase := NewPetUsecase(mockRepo, nil)
 
 			// Call the method
 			pets, err := usecase.GetByOwner(tc.ownerID)
@@ -159,7 +160,7 @@ func TestPetUsecase_Create(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewPetUsecase(mockRepo)
+			usecase := NewPetUsecase(mockRepo, nil)
 
 			// Call the method
 			pet, err := usecase.Create(tc.petName, tc.petType, tc.species, tc.birthDay, tc.fileKey, tc.userID)
@@ -186,9 +187,11 @@ func TestPetUsecase_Create(t *testing.T) {
 }
 
 func TestPetUsecase_Update(t *testing.T) {
+	ownerID := uuid.New()
 	// Test cases
 	testCases := []struct {
 		name          string
+		userID        uuid.UUID
 		petID         string
 		petName       string
 		petType       string
@@ -199,6 +202,7 @@ func TestPetUsecase_Update(t *testing.T) {
 	}{
 		{
 			name:          "Success",
+			userID:        ownerID,
 			petID:         uuid.New().String(),
 			petName:       "Fluffy",
 			petType:       "Dog",
@@ -209,6 +213,7 @@ func TestPetUsecase_Update(t *testing.T) {
 		},
 		{
 			name:          "Error",
+			userID:        ownerID,
 			petID:         uuid.New().String(),
 			petName:       "Fluffy",
 			petType:       "Dog",
@@ -217,12 +222,27 @@ func TestPetUsecase_Update(t *testing.T) {
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Not the owner",
+			userID:        uuid.New(),
+			petID:         uuid.New().String(),
+			petName:       "Fluffy",
+			petType:       "Dog",
+			species:       "Golden Retriever",
+			birthDay:      "2020-01-01",
+			mockError:     nil,
+			expectedError: ErrForbidden,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPetRepository{
+				GetByIdFunc: func(petID string) (*ent.Pet, error) {
+					assert.Equal(t, tc.petID, petID)
+					return &ent.Pet{Edges: ent.PetEdges{Owner: &ent.User{ID: ownerID}}}, nil
+				},
 				UpdateFunc: func(petID, name, petType, species, birthDay string) error {
 					// Verify input parameters
 					assert.Equal(t, tc.petID, petID)
@@ -235,10 +255,10 @@ func TestPetUsecase_Update(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewPetUsecase(mockRepo)
+			usecase := NewPetUsecase(mockRepo, nil)
 
 			// Call the method
-			err := usecase.Update(tc.petID, tc.petName, tc.petType, tc.species, tc.birthDay)
+			err := usecase.Update(tc.userID, tc.petID, tc.petName, tc.petType, tc.species, tc.birthDay)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -252,31 +272,46 @@ func TestPetUsecase_Update(t *testing.T) {
 }
 
 func TestPetUsecase_Delete(t *testing.T) {
+	ownerID := uuid.New()
 	// Test cases
 	testCases := []struct {
 		name          string
+		userID        uuid.UUID
 		petID         string
 		mockError     error
 		expectedError error
 	}{
 		{
 			name:          "Success",
+			userID:        ownerID,
 			petID:         uuid.New().String(),
 			mockError:     nil,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
+			userID:        ownerID,
 			petID:         uuid.New().String(),
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Not the owner",
+			userID:        uuid.New(),
+			petID:         uuid.New().String(),
+			mockError:     nil,
+			expectedError: ErrForbidden,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPetRepository{
+				GetByIdFunc: func(petID string) (*ent.Pet, error) {
+					assert.Equal(t, tc.petID, petID)
+					return &ent.Pet{Edges: ent.PetEdges{Owner: &ent.User{ID: ownerID}}}, nil
+				},
 				DeleteFunc: func(petID string) error {
 					// Verify input parameters
 					assert.Equal(t, tc.petID, petID)
@@ -285,10 +320,10 @@ func TestPetUsecase_Delete(t *testing.T) {
 			}
 
 			// Create usecase with mock repository
-			usecase := NewPetUsecase(mockRepo)
+			usecase := NewPetUsecase(mockRepo, nil)
 
 			// Call the method
-			err := usecase.Delete(tc.petID)
+			err := usecase.Delete(tc.userID, tc.petID)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -300,3 +335,71 @@ func TestPetUsecase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestPetUsecase_GetProfile(t *testing.T) {
+	viewerID := uuid.New()
+	petID := uuid.New()
+	latest := []*ent.Post{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		petError      error
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name:          "Success",
+			petError:      nil,
+			expectedCount: 12,
+			expectedError: false,
+		},
+		{
+			name:          "Not visible",
+			petError:      &ent.NotFoundError{},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create mock repositories
+			mockRepo := &mock.MockPetRepository{
+				GetVisibleByIdFunc: func(viewerId uuid.UUID, petId uuid.UUID) (*ent.Pet, error) {
+					assert.Equal(t, viewerID, viewerId)
+					assert.Equal(t, petID, petId)
+					if tc.petError != nil {
+						return nil, tc.petError
+					}
+					return &ent.Pet{ID: petId, Name: "Pochi"}, nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				CountPostsByPetFunc: func(petId uuid.UUID, viewerId uuid.UUID) (int, error) {
+					return tc.expectedCount, nil
+				},
+				GetPostsByPetFunc: func(petId uuid.UUID, viewerId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+					assert.Nil(t, cursor)
+					assert.Equal(t, petProfilePhotosLimit, limit)
+					return latest, nil
+				},
+			}
+
+			// Create usecase with mock repositories
+			usecase := NewPetUsecase(mockRepo, mockPostRepo)
+
+			// Call the method
+			profile, err := usecase.GetProfile(viewerID, petID)
+
+			// Check error
+			if tc.expectedError {
+				assert.True(t, ent.IsNotFound(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Pochi", profile.Pet.Name)
+			assert.Equal(t, tc.expectedCount, profile.PostsCount)
+			assert.Equal(t, latest, profile.LatestPosts)
+		})
+	}
+}
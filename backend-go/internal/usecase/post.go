@@ -1,41 +1,500 @@
 package usecase
 
 import (
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"mime/multipart"
+	"time"
+	"unicode/utf8"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
 )
 
+// recentCommentsLimit is the number of comments previewed under a post in feeds.
+const recentCommentsLimit = 3
+
+// MaxPostMedia is the largest number of images in one post.
+const MaxPostMedia = 10
+
+// MaxPostPets is the largest number of pets tagged in one post.
+const MaxPostPets = 10
+
+// maxAltTextLength is the longest alt text of an image, in characters.
+const maxAltTextLength = 1000
+
+// purgeBatchSize is the number of soft-deleted posts loaded at a time by PurgeDeletedPosts.
+const purgeBatchSize = 100
+
+// PostMediaUpload is an image of a new post with its alt text.
+type PostMediaUpload struct {
+	File    *multipart.FileHeader
+	AltText string
+}
+
+// PostVideoUpload is the video of a new post with the poster image shown before it plays.
+type PostVideoUpload struct {
+	File    *multipart.FileHeader
+	Poster  *multipart.FileHeader
+	AltText string
+}
+
 type PostUsecase struct {
-	postRepository repository.PostRepository
+	postRepository         repository.PostRepository
+	commentRepository      repository.CommentRepository
+	likeRepository         repository.LikeRepository
+	storageRepository      repository.StorageRepository
+	petRepository          repository.PetRepository
+	userRepository         repository.UserRepository
+	notificationRepository repository.NotificationRepository
 }
 
-func NewPostUsecase(postRepository repository.PostRepository) *PostUsecase {
+func NewPostUsecase(postRepository repository.PostRepository, commentRepository repository.CommentRepository, likeRepository repository.LikeRepository, storageRepository repository.StorageRepository, petRepository repository.PetRepository, userRepository repository.UserRepository, notificationRepository repository.NotificationRepository) *PostUsecase {
 	return &PostUsecase{
-		postRepository: postRepository,
+		postRepository:         postRepository,
+		commentRepository:      commentRepository,
+		likeRepository:         likeRepository,
+		storageRepository:      storageRepository,
+		petRepository:          petRepository,
+		userRepository:         userRepository,
+		notificationRepository: notificationRepository,
+	}
+}
+
+// GetAllPosts returns one page of every post visible to the viewer, newest first, and the
+// cursor of the next page, which is empty on the last page.
+func (u *PostUsecase) GetAllPosts(viewerId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, string, error) {
+	posts, err := u.postRepository.GetAllPosts(viewerId, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	posts, next := trimPage(posts, limit, postKey)
+	return posts, next, nil
+}
+
+// GetFollowsPosts returns one page of the posts by users the user follows. See GetAllPosts.
+func (u *PostUsecase) GetFollowsPosts(userId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, string, error) {
+	posts, err := u.postRepository.GetFollowsPosts(userId, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	posts, next := trimPage(posts, limit, postKey)
+	return posts, next, nil
+}
+
+// GetLikedPosts returns one page of the posts liked by the user. See GetAllPosts.
+func (u *PostUsecase) GetLikedPosts(userId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, string, error) {
+	posts, err := u.postRepository.GetLikedPosts(userId, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	posts, next := trimPage(posts, limit, postKey)
+	return posts, next, nil
+}
+
+// GetPetPosts returns one page of the posts the pet is tagged in. It returns a not found error
+// when the viewer cannot see the profile of the owner of the pet. See GetAllPosts.
+func (u *PostUsecase) GetPetPosts(viewerId uuid.UUID, petId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, string, error) {
+	if _, err := u.petRepository.GetVisibleById(viewerId, petId); err != nil {
+		return nil, "", err
+	}
+	posts, err := u.postRepository.GetPostsByPet(petId, viewerId, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	posts, next := trimPage(posts, limit, postKey)
+	return posts, next, nil
+}
+
+// GetTagPosts returns one page of the posts with the hashtag, which may be given with or without
+// "#". Invalid names return ErrInvalidTag. See GetAllPosts.
+func (u *PostUsecase) GetTagPosts(viewerId uuid.UUID, name string, cursor *models.Cursor, limit int) (models.TagPage, error) {
+	name, err := normalizeTagName(name)
+	if err != nil {
+		return models.TagPage{}, err
+	}
+	count, err := u.postRepository.CountPostsByTag(name, viewerId)
+	if err != nil {
+		return models.TagPage{}, err
+	}
+	posts, err := u.postRepository.GetPostsByTag(name, viewerId, cursor, limit+1)
+	if err != nil {
+		return models.TagPage{}, err
+	}
+	posts, next := trimPage(posts, limit, postKey)
+	return models.TagPage{Name: name, PostsCount: count, Posts: posts, NextCursor: next}, nil
+}
+
+// GetStats returns the comment and like counts, whether the viewer liked each post, and a
+// preview of its newest comments, keyed by post ID.
+func (u *PostUsecase) GetStats(viewerId uuid.UUID, posts []*ent.Post) (map[uuid.UUID]models.PostStats, error) {
+	ids := make([]uuid.UUID, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	return u.postRepository.GetStats(viewerId, ids, recentCommentsLimit)
+}
+
+// GetPost returns the post if the viewer can see it. Deleted and hidden posts, and posts of
+// users who block the viewer or are private to them, return a not found error.
+func (u *PostUsecase) GetPost(viewerId uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+	return u.postRepository.GetVisibleById(viewerId, postId)
+}
+
+// ListComments returns one page of the top-level comments of the post, newest first, with their
+// stats and the cursor of the next page. Like GetPost it returns a not found error when the viewer
+// cannot see the post.
+func (u *PostUsecase) ListComments(viewerId uuid.UUID, postId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, map[uuid.UUID]models.CommentStats, string, error) {
+	if _, err := u.postRepository.GetVisibleById(viewerId, postId); err != nil {
+		return nil, nil, "", err
+	}
+	comments, err := u.commentRepository.ListByPost(postId, viewerId, cursor, limit+1)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	comments, next := trimPage(comments, limit, func(comment *ent.Comment) (time.Time, uuid.UUID) {
+		return comment.CreatedAt, comment.ID
+	})
+	ids := make([]uuid.UUID, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.ID
+	}
+	stats, err := u.commentRepository.GetStats(viewerId, ids)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return comments, stats, next, nil
+}
+
+// ListLikes returns one page of the likes of the post, newest first. See ListComments.
+func (u *PostUsecase) ListLikes(viewerId uuid.UUID, postId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Like, string, error) {
+	if _, err := u.postRepository.GetVisibleById(viewerId, postId); err != nil {
+		return nil, "", err
+	}
+	likes, err := u.likeRepository.ListByPost(postId, viewerId, cursor, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	likes, next := trimPage(likes, limit, func(like *ent.Like) (time.Time, uuid.UUID) {
+		return like.CreatedAt, like.ID
+	})
+	return likes, next, nil
+}
+
+// CreatePost uploads the images in order and creates the post with the pets tagged, then notifies
+// the users mentioned in the caption. If anything fails, the images uploaded so far are deleted again.
+func (u *PostUsecase) CreatePost(caption, userId string, uploads []PostMediaUpload, petIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error) {
+	if len(uploads) == 0 || len(uploads) > MaxPostMedia {
+		return nil, ErrInvalidMedia
+	}
+	for _, upload := range uploads {
+		if utf8.RuneCountInString(upload.AltText) > maxAltTextLength {
+			return nil, ErrInvalidMedia
+		}
+	}
+	petIds, err := u.checkPetTags(userId, petIds)
+	if err != nil {
+		return nil, err
+	}
+	authorId, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+	mentionIds, err := resolveMentions(u.userRepository, authorId, caption)
+	if err != nil {
+		return nil, err
+	}
+
+	media := make([]models.PostMediaInput, 0, len(uploads))
+	for _, upload := range uploads {
+		key, err := u.storageRepository.UploadImage(upload.File, "posts")
+		if err != nil {
+			u.deleteMedia(media)
+			return nil, err
+		}
+		input := models.PostMediaInput{Type: enum.MediaTypeImage, Key: key, AltText: upload.AltText}
+		if config, ok := imageConfig(upload.File); ok {
+			input.Width = &config.Width
+			input.Height = &config.Height
+		}
+		media = append(media, input)
 	}
+
+	post, err := u.postRepository.CreatePost(caption, userId, media, petIds, extractHashtags(caption), mentionIds, dailyTaskId)
+	if err != nil {
+		u.deleteMedia(media)
+		return nil, err
+	}
+	notifyMentions(u.notificationRepository, authorId, mentionIds, post.ID, nil)
+	return post, nil
+}
+
+// CreateVideoPost checks the headers of the video, uploads it with its poster image and creates
+// the post like CreatePost. If anything fails, the files uploaded so far are deleted again.
+func (u *PostUsecase) CreateVideoPost(caption, userId string, upload PostVideoUpload, petIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error) {
+	if utf8.RuneCountInString(upload.AltText) > maxAltTextLength {
+		return nil, ErrInvalidMedia
+	}
+	petIds, err := u.checkPetTags(userId, petIds)
+	if err != nil {
+		return nil, err
+	}
+	authorId, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, err
+	}
+	mentionIds, err := resolveMentions(u.userRepository, authorId, caption)
+	if err != nil {
+		return nil, err
+	}
+	info, err := inspectVideoFile(upload.File)
+	if err != nil {
+		return nil, err
+	}
+	// ポスター画像はアプリが動画から切り出して送ってくる
+	if _, ok := imageConfig(upload.Poster); !ok {
+		return nil, ErrInvalidMedia
+	}
+
+	posterKey, err := u.storageRepository.UploadImage(upload.Poster, "posts")
+	if err != nil {
+		return nil, err
+	}
+	videoKey, err := u.storageRepository.UploadVideo(upload.File, "posts", info.ContentType)
+	if err != nil {
+		u.deleteMedia([]models.PostMediaInput{{Key: posterKey}})
+		return nil, err
+	}
+	durationMs := int(info.Duration.Milliseconds())
+	media := []models.PostMediaInput{{
+		Type:       enum.MediaTypeVideo,
+		Key:        videoKey,
+		Width:      &info.Width,
+		Height:     &info.Height,
+		AltText:    upload.AltText,
+		DurationMs: &durationMs,
+		PosterKey:  posterKey,
+	}}
+
+	post, err := u.postRepository.CreatePost(caption, userId, media, petIds, extractHashtags(caption), mentionIds, dailyTaskId)
+	if err != nil {
+		u.deleteMedia(media)
+		return nil, err
+	}
+	notifyMentions(u.notificationRepository, authorId, mentionIds, post.ID, nil)
+	return post, nil
 }
 
-func (u *PostUsecase) GetAllPosts() ([]*ent.Post, error) {
-	return u.postRepository.GetAllPosts()
+// checkPetTags returns the pet IDs without duplicates, or ErrInvalidPetTag unless every pet
+// belongs to the user.
+func (u *PostUsecase) checkPetTags(userId string, petIds []uuid.UUID) ([]uuid.UUID, error) {
+	if len(petIds) == 0 {
+		return petIds, nil
+	}
+	unique := make([]uuid.UUID, 0, len(petIds))
+	seen := make(map[uuid.UUID]bool, len(petIds))
+	for _, id := range petIds {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) > MaxPostPets {
+		return nil, ErrInvalidPetTag
+	}
+	pets, err := u.petRepository.GetByIds(unique)
+	if err != nil {
+		return nil, err
+	}
+	if len(pets) != len(unique) {
+		return nil, ErrInvalidPetTag
+	}
+	for _, pet := range pets {
+		if pet.Edges.Owner == nil || pet.Edges.Owner.ID.String() != userId {
+			return nil, ErrInvalidPetTag
+		}
+	}
+	return unique, nil
+}
+
+// deleteMedia deletes uploaded files of a post that was not created.
+func (u *PostUsecase) deleteMedia(media []models.PostMediaInput) {
+	for _, m := range media {
+		for _, key := range []string{m.Key, m.PosterKey} {
+			if key == "" {
+				continue
+			}
+			if err := u.storageRepository.DeleteImage(key); err != nil {
+				log.Warnf("Failed to delete media %s: %v", key, err)
+			}
+		}
+	}
 }
 
-func (u *PostUsecase) GetFollowsPosts(userId uuid.UUID, cursor *uuid.UUID, limit int) ([]*ent.Post, error) {
-	return u.postRepository.GetFollowsPosts(userId, cursor, limit)
+// UpdatePost edits the caption and the tagged pets of the user's own post, and updates the
+// hashtags and mentions to those of the new caption. A nil petIds keeps the pets, and an empty one
+// removes them all. The previous caption and pets are kept as a revision that only the owner can
+// see. Users newly mentioned are notified, and the notifications of users no longer mentioned are
+// removed.
+func (u *PostUsecase) UpdatePost(userId uuid.UUID, postId uuid.UUID, caption string, petIds []uuid.UUID) (*ent.Post, error) {
+	current, err := u.checkOwner(userId, postId)
+	if err != nil {
+		return nil, err
+	}
+	if petIds != nil {
+		checked, err := u.checkPetTags(userId.String(), petIds)
+		if err != nil {
+			return nil, err
+		}
+		petIds = checked
+	}
+	mentionIds, err := resolveMentions(u.userRepository, userId, caption)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := u.postRepository.UpdatePost(postId, caption, petIds, extractHashtags(caption), mentionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// キャプションが変わらなければメンションも変わらないので、保存された結果と比べる
+	added, removed := diffMentions(current.Edges.Mentions, updated.Edges.Mentions)
+	notifyMentions(u.notificationRepository, userId, added, postId, nil)
+	if len(removed) > 0 {
+		if err := u.notificationRepository.DeletePostMentions(postId, removed); err != nil {
+			log.Warnf("Failed to delete mention notifications: %v", err)
+		}
+	}
+	return updated, nil
 }
 
-func (u *PostUsecase) CreatePost(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error) {
-	return u.postRepository.CreatePost(caption, userId, fileKey, dailyTaskId)
+// ListRevisions returns the previous captions and pets of the user's own post, newest first.
+func (u *PostUsecase) ListRevisions(userId uuid.UUID, postId uuid.UUID) ([]*ent.PostRevision, error) {
+	if _, err := u.checkOwner(userId, postId); err != nil {
+		return nil, err
+	}
+	return u.postRepository.ListRevisions(postId)
 }
 
-func (u *PostUsecase) UpdatePost(postId, caption string) error {
-	return u.postRepository.UpdatePost(postId, caption)
+// checkOwner returns the post, or ErrForbidden unless the user wrote it.
+func (u *PostUsecase) checkOwner(userId uuid.UUID, postId uuid.UUID) (*ent.Post, error) {
+	post, err := u.postRepository.GetById(postId)
+	if err != nil {
+		return nil, err
+	}
+	if post.Edges.User == nil || post.Edges.User.ID != userId {
+		return nil, ErrForbidden
+	}
+	return post, nil
 }
 
-func (u *PostUsecase) DeletePost(postId string) error {
+// DeletePost soft-deletes the post of the user. Its media stay in storage for moderation until
+// PurgeDeletedPosts removes them.
+func (u *PostUsecase) DeletePost(userId uuid.UUID, postId string) error {
+	postUUID, err := uuid.Parse(postId)
+	if err != nil {
+		return err
+	}
+	post, err := u.postRepository.GetById(postUUID)
+	if err != nil {
+		return err
+	}
+	if post.Edges.User == nil || post.Edges.User.ID != userId {
+		return ErrForbidden
+	}
 	return u.postRepository.DeletePost(postId)
 }
 
-func (u *PostUsecase) GetByIds(postIds []uuid.UUID) ([]*ent.Post, error) {
-	return u.postRepository.GetByIds(postIds)
+// PurgeDeletedPosts hard-deletes the posts soft-deleted more than retention ago, with their media
+// files, and returns how many were purged.
+func (u *PostUsecase) PurgeDeletedPosts(retention time.Duration) (int, error) {
+	before := time.Now().Add(-retention)
+	purged := 0
+	for {
+		posts, err := u.postRepository.ListDeletedBefore(before, purgeBatchSize)
+		if err != nil || len(posts) == 0 {
+			return purged, err
+		}
+		for _, post := range posts {
+			if err := u.postRepository.HardDeletePost(post.ID); err != nil {
+				return purged, err
+			}
+			// 投稿の削除は完了しているので、ファイルの削除に失敗してもログのみ残す
+			deletePostMedia(u.storageRepository, post)
+			purged++
+		}
+	}
+}
+
+func (u *PostUsecase) GetByIds(viewerId uuid.UUID, postIds []uuid.UUID) ([]*ent.Post, error) {
+	return u.postRepository.GetByIds(viewerId, postIds)
+}
+
+// imageConfig reads the dimensions from the header of a JPEG, PNG or GIF image. Other formats
+// are stored without dimensions.
+func imageConfig(file *multipart.FileHeader) (image.Config, bool) {
+	src, err := file.Open()
+	if err != nil {
+		return image.Config{}, false
+	}
+	defer src.Close()
+	config, _, err := image.DecodeConfig(src)
+	if err != nil {
+		return image.Config{}, false
+	}
+	return config, true
+}
+
+// mediaURLs returns the URLs of the media of the post in carousel order.
+func mediaURLs(storageRepository repository.StorageRepository, post *ent.Post) ([]models.MediaURL, error) {
+	media := models.MediaOf(post)
+	urls := make([]models.MediaURL, len(media))
+	for i, m := range media {
+		url, err := storageRepository.GetUrl(m.Key)
+		if err != nil {
+			return nil, err
+		}
+		urls[i].URL = url
+		if m.PosterKey != "" {
+			urls[i].PosterURL, err = storageRepository.GetUrl(m.PosterKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return urls, nil
+}
+
+// petImageURLs returns the image URLs of the pets tagged in the post, in the order of post.Edges.Pets.
+func petImageURLs(storageRepository repository.StorageRepository, post *ent.Post) ([]string, error) {
+	urls := make([]string, len(post.Edges.Pets))
+	for i, pet := range post.Edges.Pets {
+		url, err := storageRepository.GetUrl(pet.ImageKey)
+		if err != nil {
+			return nil, err
+		}
+		urls[i] = url
+	}
+	return urls, nil
+}
+
+// deletePostMedia deletes the media files of a deleted post. The post is already gone, so
+// failures are only logged.
+func deletePostMedia(storageRepository repository.StorageRepository, post *ent.Post) {
+	for _, m := range models.MediaOf(post) {
+		for _, key := range []string{m.Key, m.PosterKey} {
+			if key == "" {
+				continue
+			}
+			if err := storageRepository.DeleteImage(key); err != nil {
+				log.Warnf("Failed to delete media of post %s: %v", post.ID, err)
+			}
+		}
+	}
 }
@@ -1,13 +1,22 @@
 package usecase
 
 import (
+	"bytes"
 	"errors"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPostUsecase_GetAllPosts(t *testing.T) {
@@ -39,16 +48,16 @@ func TestPostUsecase_GetAllPosts(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPostRepository{
-				GetAllPostsFunc: func() ([]*ent.Post, error) {
+				GetAllPostsFunc: func(viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
 					return tc.mockPosts, tc.mockError
 				},
 			}
 
 			// Create usecase with mock repository
-			usecase := NewPostUsecase(mockRepo)
+			usecase := NewPostUsecase(mockRepo, nil, nil, nil, nil, nil, nil)
 
 			// Call the method
-			posts, err := usecase.GetAllPosts()
+			posts, _, err := usecase.GetAllPosts(uuid.New(), nil, 10)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -68,35 +77,41 @@ func TestPostUsecase_GetAllPosts(t *testing.T) {
 }
 
 func TestPostUsecase_GetFollowsPosts(t *testing.T) {
-	postID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := &ent.Post{ID: uuid.New(), Caption: "Test post", CreatedAt: createdAt}
+	second := &ent.Post{ID: uuid.New(), Caption: "Another post", CreatedAt: createdAt}
 	testCases := []struct {
 		name          string
 		userId        uuid.UUID
-		cursor        uuid.UUID
+		cursor        *models.Cursor
 		limit         int
 		mockPosts     []*ent.Post
 		mockError     error
 		expectedPosts []*ent.Post
+		expectedNext  string
 		expectedError error
 	}{
 		{
-			name:   "Success",
-			userId: uuid.MustParse("977b7e40-1149-4a5d-955b-28d467c40fc7"),
-			cursor: uuid.MustParse("9feef8eb-6967-4bd9-a8f1-8345d6a08717"),
-			limit:  10,
-			mockPosts: []*ent.Post{
-				{ID: postID, Caption: "Test post"},
-			},
-			mockError: nil,
-			expectedPosts: []*ent.Post{
-				{ID: postID, Caption: "Test post"},
-			},
-			expectedError: nil,
+			name:          "Last page",
+			userId:        uuid.MustParse("977b7e40-1149-4a5d-955b-28d467c40fc7"),
+			cursor:        models.NewCursor(createdAt, uuid.MustParse("9feef8eb-6967-4bd9-a8f1-8345d6a08717")),
+			limit:         10,
+			mockPosts:     []*ent.Post{first},
+			expectedPosts: []*ent.Post{first},
+			expectedNext:  "",
+		},
+		{
+			// The repository is asked for one post more than the limit to find out if there is a next page
+			name:          "Next page",
+			userId:        uuid.MustParse("977b7e40-1149-4a5d-955b-28d467c40fc7"),
+			limit:         1,
+			mockPosts:     []*ent.Post{first, second},
+			expectedPosts: []*ent.Post{first},
+			expectedNext:  models.NewCursor(first.CreatedAt, first.ID).Encode(),
 		},
 		{
 			name:          "Error",
 			userId:        uuid.MustParse("38d85a73-bac6-4bc0-923b-b5aec5ed9075"),
-			cursor:        uuid.MustParse("9feef8eb-6967-4bd9-a8f1-8345d6a08717"),
 			limit:         10,
 			mockPosts:     nil,
 			mockError:     errors.New("database error"),
@@ -108,188 +123,649 @@ func TestPostUsecase_GetFollowsPosts(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			mockRepo := &mock.MockPostRepository{
-				GetFollowsPostsFunc: func(userID uuid.UUID, cursor *uuid.UUID, limit int) ([]*ent.Post, error) {
+				GetFollowsPostsFunc: func(userID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+					assert.Equal(t, tc.userId, userID)
+					assert.Equal(t, tc.cursor, cursor)
+					assert.Equal(t, tc.limit+1, limit)
 					return tc.mockPosts, tc.mockError
 				},
 			}
 
-			usecase := NewPostUsecase(mockRepo)
+			usecase := NewPostUsecase(mockRepo, nil, nil, nil, nil, nil, nil)
 
-			posts, err := usecase.GetFollowsPosts(tc.userId, &tc.cursor, tc.limit)
+			posts, next, err := usecase.GetFollowsPosts(tc.userId, tc.cursor, tc.limit)
 
 			assert.Equal(t, tc.expectedPosts, posts)
+			assert.Equal(t, tc.expectedNext, next)
 			assert.Equal(t, tc.expectedError, err)
 		})
 	}
 }
 
+// newTestUploads builds multipart files as the handler receives them. Files named *.png hold a
+// PNG of the given size, others hold bytes that are not an image.
+func newTestUploads(t *testing.T, names []string, width, height int) []PostMediaUpload {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, name := range names {
+		part, err := writer.CreateFormFile("images", name)
+		require.NoError(t, err)
+		if strings.HasSuffix(name, ".png") {
+			require.NoError(t, png.Encode(part, image.NewRGBA(image.Rect(0, 0, width, height))))
+		} else {
+			_, err = part.Write([]byte("not an image"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = form.RemoveAll() })
+	uploads := make([]PostMediaUpload, len(form.File["images"]))
+	for i, file := range form.File["images"] {
+		uploads[i] = PostMediaUpload{File: file, AltText: "alt " + file.Filename}
+	}
+	return uploads
+}
+
 func TestPostUsecase_CreatePost(t *testing.T) {
+	userID := uuid.New().String()
 	// Test cases
 	testCases := []struct {
 		name          string
-		caption       string
-		userId        string
-		fileKey       string
+		files         []string
+		altText       string
 		dailyTaskId   *string
-		mockPost      *ent.Post
+		uploadError   error
 		mockError     error
-		expectedPost  *ent.Post
+		expectCreate  bool
+		expectedKeys  []string
+		expectDeleted []string
 		expectedError error
 	}{
 		{
-			name:          "Success",
-			caption:       "Test caption",
-			userId:        uuid.New().String(),
-			fileKey:       "test-file-key",
-			dailyTaskId:   nil,
-			mockPost:      &ent.Post{ID: uuid.New(), Caption: "Test caption"},
-			mockError:     nil,
-			expectedPost:  &ent.Post{ID: uuid.New(), Caption: "Test caption"},
-			expectedError: nil,
+			name:         "Success",
+			files:        []string{"first.png", "second.heic"},
+			expectCreate: true,
+			expectedKeys: []string{"posts/first.png", "posts/second.heic"},
 		},
 		{
-			name:          "Success with dailyTaskId",
-			caption:       "Test caption",
-			userId:        uuid.New().String(),
-			fileKey:       "test-file-key",
-			dailyTaskId:   func() *string { s := "task-id"; return &s }(),
-			mockPost:      &ent.Post{ID: uuid.New(), Caption: "Test caption"},
-			mockError:     nil,
-			expectedPost:  &ent.Post{ID: uuid.New(), Caption: "Test caption"},
-			expectedError: nil,
+			name:         "Success with dailyTaskId",
+			files:        []string{"first.png"},
+			dailyTaskId:  func() *string { s := "task-id"; return &s }(),
+			expectCreate: true,
+			expectedKeys: []string{"posts/first.png"},
 		},
 		{
-			name:          "Error",
-			caption:       "Test caption",
-			userId:        uuid.New().String(),
-			fileKey:       "test-file-key",
-			dailyTaskId:   nil,
-			mockPost:      nil,
+			name:          "Error deletes the uploaded images",
+			files:         []string{"first.png", "second.png"},
 			mockError:     errors.New("database error"),
-			expectedPost:  nil,
+			expectCreate:  true,
+			expectedKeys:  []string{"posts/first.png", "posts/second.png"},
+			expectDeleted: []string{"posts/first.png", "posts/second.png"},
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Upload error deletes the images uploaded before",
+			files:         []string{"first.png", "second.png"},
+			uploadError:   errors.New("s3 error"),
+			expectDeleted: []string{"posts/first.png"},
+			expectedError: errors.New("s3 error"),
+		},
+		{
+			name:          "No images",
+			files:         nil,
+			expectedError: ErrInvalidMedia,
+		},
+		{
+			name:          "Too many images",
+			files:         []string{"1.png", "2.png", "3.png", "4.png", "5.png", "6.png", "7.png", "8.png", "9.png", "10.png", "11.png"},
+			expectedError: ErrInvalidMedia,
+		},
+		{
+			name:          "Alt text too long",
+			files:         []string{"first.png"},
+			altText:       strings.Repeat("あ", maxAltTextLength+1),
+			expectedError: ErrInvalidMedia,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			// Create mock repository
+			uploads := newTestUploads(t, tc.files, 1080, 720)
+			if tc.altText != "" {
+				uploads[0].AltText = tc.altText
+			}
+			created := false
+			var deleted []string
+
+			// Create mock repositories
 			mockRepo := &mock.MockPostRepository{
-				CreatePostFunc: func(caption, userId, fileKey string, dailyTaskId *string) (*ent.Post, error) {
+				CreatePostFunc: func(caption, userId string, media []models.PostMediaInput, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error) {
 					// Verify input parameters
-					assert.Equal(t, tc.caption, caption)
-					assert.Equal(t, tc.userId, userId)
-					assert.Equal(t, tc.fileKey, fileKey)
+					created = true
+					assert.Equal(t, "Test caption", caption)
+					assert.Equal(t, userID, userId)
 					assert.Equal(t, tc.dailyTaskId, dailyTaskId)
-					return tc.mockPost, tc.mockError
+					keys := make([]string, len(media))
+					for i, m := range media {
+						keys[i] = m.Key
+						assert.Equal(t, "alt "+tc.files[i], m.AltText)
+						if strings.HasSuffix(m.Key, ".png") {
+							assert.Equal(t, 1080, *m.Width)
+							assert.Equal(t, 720, *m.Height)
+						} else {
+							assert.Nil(t, m.Width, "dimensions of unknown formats are left empty")
+						}
+					}
+					assert.Equal(t, tc.expectedKeys, keys)
+					if tc.mockError != nil {
+						return nil, tc.mockError
+					}
+					return &ent.Post{ID: uuid.New(), Caption: caption}, nil
+				},
+			}
+			mockStorageRepo := &mock.MockStorageRepository{
+				UploadImageFunc: func(file *multipart.FileHeader, directory string) (string, error) {
+					assert.Equal(t, "posts", directory)
+					if tc.uploadError != nil && file.Filename != tc.files[0] {
+						return "", tc.uploadError
+					}
+					return directory + "/" + file.Filename, nil
+				},
+				DeleteImageFunc: func(fileKey string) error {
+					deleted = append(deleted, fileKey)
+					return nil
 				},
 			}
 
-			// Create usecase with mock repository
-			usecase := NewPostUsecase(mockRepo)
+			// Create usecase with mock repositories
+			usecase := NewPostUsecase(mockRepo, nil, nil, mockStorageRepo, nil, nil, nil)
 
 			// Call the method
-			post, err := usecase.CreatePost(tc.caption, tc.userId, tc.fileKey, tc.dailyTaskId)
+			post, err := usecase.CreatePost("Test caption", userID, uploads, nil, tc.dailyTaskId)
 
+			assert.Equal(t, tc.expectCreate, created)
+			assert.Equal(t, tc.expectDeleted, deleted)
 			// Check error
 			if tc.expectedError != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				assert.Nil(t, post)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, "Test caption", post.Caption)
 			}
+		})
+	}
+}
 
-			// Check result
-			if tc.expectedPost != nil {
-				assert.NotNil(t, post)
-				assert.Equal(t, tc.expectedPost.Caption, post.Caption)
-			} else {
+// newTestFileHeader builds a single multipart file as the handler receives it.
+func newTestFileHeader(t *testing.T, name string, content []byte) *multipart.FileHeader {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", name)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = form.RemoveAll() })
+	return form.File["file"][0]
+}
+
+func TestPostUsecase_CreateVideoPost(t *testing.T) {
+	userID := uuid.New().String()
+	ftyp := box("ftyp", []byte("mp42"), u32(0), []byte("mp42isom"))
+	validVideo := bytes.Join([][]byte{ftyp, box("moov", mvhd(600, 7200), trak("vide", 720, 1280, false))}, nil)
+	longVideo := bytes.Join([][]byte{ftyp, box("moov", mvhd(600, 90*600), trak("vide", 720, 1280, false))}, nil)
+	poster := &bytes.Buffer{}
+	require.NoError(t, png.Encode(poster, image.NewRGBA(image.Rect(0, 0, 72, 128))))
+
+	testCases := []struct {
+		name          string
+		video         []byte
+		poster        []byte
+		mockError     error
+		expectCreate  bool
+		expectDeleted []string
+		expectedError error
+	}{
+		{
+			name:         "Success",
+			video:        validVideo,
+			poster:       poster.Bytes(),
+			expectCreate: true,
+		},
+		{
+			name:          "Too long",
+			video:         longVideo,
+			poster:        poster.Bytes(),
+			expectedError: ErrInvalidVideo,
+		},
+		{
+			name:          "Not a video",
+			video:         poster.Bytes(),
+			poster:        poster.Bytes(),
+			expectedError: ErrInvalidVideo,
+		},
+		{
+			name:          "Poster is not an image",
+			video:         validVideo,
+			poster:        []byte("not an image"),
+			expectedError: ErrInvalidMedia,
+		},
+		{
+			name:          "Error deletes the video and the poster",
+			video:         validVideo,
+			poster:        poster.Bytes(),
+			mockError:     errors.New("database error"),
+			expectCreate:  true,
+			expectDeleted: []string{"posts/clip.mp4", "posts/poster.png"},
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			upload := PostVideoUpload{
+				File:    newTestFileHeader(t, "clip.mp4", tc.video),
+				Poster:  newTestFileHeader(t, "poster.png", tc.poster),
+				AltText: "a cat chasing a toy",
+			}
+			created := false
+			var deleted []string
+
+			mockRepo := &mock.MockPostRepository{
+				CreatePostFunc: func(caption, userId string, media []models.PostMediaInput, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID, dailyTaskId *string) (*ent.Post, error) {
+					created = true
+					require.Len(t, media, 1)
+					assert.Equal(t, enum.MediaTypeVideo, media[0].Type)
+					assert.Equal(t, "posts/clip.mp4", media[0].Key)
+					assert.Equal(t, "posts/poster.png", media[0].PosterKey)
+					assert.Equal(t, 12000, *media[0].DurationMs)
+					assert.Equal(t, 720, *media[0].Width)
+					assert.Equal(t, 1280, *media[0].Height)
+					assert.Equal(t, "a cat chasing a toy", media[0].AltText)
+					if tc.mockError != nil {
+						return nil, tc.mockError
+					}
+					return &ent.Post{ID: uuid.New(), Caption: caption, MediaType: enum.MediaTypeVideo}, nil
+				},
+			}
+			mockStorageRepo := &mock.MockStorageRepository{
+				UploadImageFunc: func(file *multipart.FileHeader, directory string) (string, error) {
+					return directory + "/" + file.Filename, nil
+				},
+				UploadVideoFunc: func(file *multipart.FileHeader, directory string, contentType string) (string, error) {
+					assert.Equal(t, "video/mp4", contentType, "the content type comes from the headers")
+					return directory + "/" + file.Filename, nil
+				},
+				DeleteImageFunc: func(fileKey string) error {
+					deleted = append(deleted, fileKey)
+					return nil
+				},
+			}
+			usecase := NewPostUsecase(mockRepo, nil, nil, mockStorageRepo, nil, nil, nil)
+
+			post, err := usecase.CreateVideoPost("Test caption", userID, upload, nil, nil)
+
+			assert.Equal(t, tc.expectCreate, created)
+			assert.Equal(t, tc.expectDeleted, deleted)
+			if tc.expectedError != nil {
+				assert.ErrorContains(t, err, tc.expectedError.Error())
 				assert.Nil(t, post)
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, enum.MediaTypeVideo, post.MediaType)
 		})
 	}
 }
 
 func TestPostUsecase_UpdatePost(t *testing.T) {
+	ownerID := uuid.New()
 	// Test cases
 	testCases := []struct {
 		name          string
-		postId        string
+		userId        uuid.UUID
 		caption       string
 		mockError     error
+		expectUpdate  bool
 		expectedError error
 	}{
 		{
 			name:          "Success",
-			postId:        uuid.New().String(),
+			userId:        ownerID,
 			caption:       "Updated caption",
 			mockError:     nil,
+			expectUpdate:  true,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
-			postId:        uuid.New().String(),
+			userId:        ownerID,
 			caption:       "Updated caption",
 			mockError:     errors.New("database error"),
+			expectUpdate:  true,
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Not the owner",
+			userId:        uuid.New(),
+			caption:       "Updated caption",
+			mockError:     nil,
+			expectUpdate:  false,
+			expectedError: ErrForbidden,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			postID := uuid.New()
+			updated := false
 			// Create mock repository
 			mockRepo := &mock.MockPostRepository{
-				UpdatePostFunc: func(postId, caption string) error {
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, postID, postId)
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: ownerID}}}, nil
+				},
+				UpdatePostFunc: func(postId uuid.UUID, caption string, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID) (*ent.Post, error) {
 					// Verify input parameters
-					assert.Equal(t, tc.postId, postId)
+					assert.Equal(t, postID, postId)
 					assert.Equal(t, tc.caption, caption)
-					return tc.mockError
+					updated = true
+					if tc.mockError != nil {
+						return nil, tc.mockError
+					}
+					editedAt := time.Now()
+					return &ent.Post{ID: postId, Caption: caption, EditedAt: &editedAt}, nil
 				},
 			}
 
 			// Create usecase with mock repository
-			usecase := NewPostUsecase(mockRepo)
+			usecase := NewPostUsecase(mockRepo, nil, nil, nil, nil, nil, nil)
 
 			// Call the method
-			err := usecase.UpdatePost(tc.postId, tc.caption)
+			post, err := usecase.UpdatePost(tc.userId, postID, tc.caption, nil)
 
+			assert.Equal(t, tc.expectUpdate, updated)
 			// Check error
 			if tc.expectedError != nil {
 				assert.Error(t, err)
 				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				assert.Nil(t, post)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.caption, post.Caption)
+				assert.NotNil(t, post.EditedAt)
 			}
 		})
 	}
 }
 
+func TestPostUsecase_UpdatePost_PetTags(t *testing.T) {
+	ownerID := uuid.New()
+	ownPet := &ent.Pet{ID: uuid.New(), Edges: ent.PetEdges{Owner: &ent.User{ID: ownerID}}}
+	otherPet := &ent.Pet{ID: uuid.New(), Edges: ent.PetEdges{Owner: &ent.User{ID: uuid.New()}}}
+	pets := map[uuid.UUID]*ent.Pet{ownPet.ID: ownPet, otherPet.ID: otherPet}
+
+	tooMany := make([]uuid.UUID, MaxPostPets+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.New()
+	}
+
+	testCases := []struct {
+		name          string
+		petIds        []uuid.UUID
+		expectedIds   []uuid.UUID
+		expectedError error
+	}{
+		{
+			name:        "Keep the pets",
+			petIds:      nil,
+			expectedIds: nil,
+		},
+		{
+			name:        "Remove all pets",
+			petIds:      []uuid.UUID{},
+			expectedIds: []uuid.UUID{},
+		},
+		{
+			name:        "Own pet, duplicates removed",
+			petIds:      []uuid.UUID{ownPet.ID, ownPet.ID},
+			expectedIds: []uuid.UUID{ownPet.ID},
+		},
+		{
+			name:          "Pet of another user",
+			petIds:        []uuid.UUID{ownPet.ID, otherPet.ID},
+			expectedError: ErrInvalidPetTag,
+		},
+		{
+			name:          "Unknown pet",
+			petIds:        []uuid.UUID{uuid.New()},
+			expectedError: ErrInvalidPetTag,
+		},
+		{
+			name:          "Too many pets",
+			petIds:        tooMany,
+			expectedError: ErrInvalidPetTag,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var updatedIds []uuid.UUID
+			updated := false
+			mockRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: ownerID}}}, nil
+				},
+				UpdatePostFunc: func(postId uuid.UUID, caption string, petIds []uuid.UUID, tags []string, mentionIds []uuid.UUID) (*ent.Post, error) {
+					updated = true
+					updatedIds = petIds
+					return &ent.Post{ID: postId, Caption: caption}, nil
+				},
+			}
+			mockPetRepo := &mock.MockPetRepository{
+				GetByIdsFunc: func(petIDs []uuid.UUID) ([]*ent.Pet, error) {
+					found := []*ent.Pet{}
+					for _, id := range petIDs {
+						if pet, ok := pets[id]; ok {
+							found = append(found, pet)
+						}
+					}
+					return found, nil
+				},
+			}
+			usecase := NewPostUsecase(mockRepo, nil, nil, nil, mockPetRepo, nil, nil)
+
+			_, err := usecase.UpdatePost(ownerID, uuid.New(), "caption", tc.petIds)
+
+			if tc.expectedError != nil {
+				assert.True(t, errors.Is(err, tc.expectedError), "got %v", err)
+				assert.False(t, updated)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedIds, updatedIds)
+		})
+	}
+}
+
+func TestPostUsecase_GetPetPosts(t *testing.T) {
+	viewerID := uuid.New()
+	petID := uuid.New()
+	now := time.Now()
+	posts := []*ent.Post{
+		{ID: uuid.New(), CreatedAt: now},
+		{ID: uuid.New(), CreatedAt: now.Add(-time.Minute)},
+		{ID: uuid.New(), CreatedAt: now.Add(-2 * time.Minute)},
+	}
+
+	testCases := []struct {
+		name          string
+		petError      error
+		expectedCount int
+		expectNext    bool
+		expectedError bool
+	}{
+		{
+			name:          "Success",
+			expectedCount: 2,
+			expectNext:    true,
+		},
+		{
+			name:          "Pet not visible",
+			petError:      &ent.NotFoundError{},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := &mock.MockPostRepository{
+				GetPostsByPetFunc: func(petId uuid.UUID, viewerId uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+					assert.Equal(t, petID, petId)
+					assert.Equal(t, viewerID, viewerId)
+					assert.Equal(t, 3, limit, "one more than the page to find the next cursor")
+					return posts, nil
+				},
+			}
+			mockPetRepo := &mock.MockPetRepository{
+				GetVisibleByIdFunc: func(viewerId uuid.UUID, petId uuid.UUID) (*ent.Pet, error) {
+					if tc.petError != nil {
+						return nil, tc.petError
+					}
+					return &ent.Pet{ID: petId}, nil
+				},
+			}
+			usecase := NewPostUsecase(mockRepo, nil, nil, nil, mockPetRepo, nil, nil)
+
+			result, next, err := usecase.GetPetPosts(viewerID, petID, nil, 2)
+
+			if tc.expectedError {
+				assert.True(t, ent.IsNotFound(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, result, tc.expectedCount)
+			assert.Equal(t, tc.expectNext, next != "")
+		})
+	}
+}
+
+func TestPostUsecase_ListRevisions(t *testing.T) {
+	ownerID := uuid.New()
+	postID := uuid.New()
+	revisions := []*ent.PostRevision{
+		{ID: uuid.New(), Caption: "second"},
+		{ID: uuid.New(), Caption: "first"},
+	}
+
+	testCases := []struct {
+		name          string
+		userId        uuid.UUID
+		getError      error
+		expected      []*ent.PostRevision
+		expectedError error
+	}{
+		{
+			name:     "Owner",
+			userId:   ownerID,
+			expected: revisions,
+		},
+		{
+			name:          "Not the owner",
+			userId:        uuid.New(),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Post not found",
+			userId:        ownerID,
+			getError:      &ent.NotFoundError{},
+			expectedError: &ent.NotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					if tc.getError != nil {
+						return nil, tc.getError
+					}
+					return &ent.Post{ID: postId, Edges: ent.PostEdges{User: &ent.User{ID: ownerID}}}, nil
+				},
+				ListRevisionsFunc: func(postId uuid.UUID) ([]*ent.PostRevision, error) {
+					assert.Equal(t, postID, postId)
+					return revisions, nil
+				},
+			}
+			usecase := NewPostUsecase(mockRepo, nil, nil, nil, nil, nil, nil)
+
+			result, err := usecase.ListRevisions(tc.userId, postID)
+
+			if tc.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedError.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
 func TestPostUsecase_DeletePost(t *testing.T) {
+	ownerID := uuid.New()
 	// Test cases
 	testCases := []struct {
 		name          string
+		userId        uuid.UUID
 		postId        string
 		mockError     error
 		expectedError error
 	}{
 		{
 			name:          "Success",
+			userId:        ownerID,
 			postId:        uuid.New().String(),
 			mockError:     nil,
 			expectedError: nil,
 		},
 		{
 			name:          "Error",
+			userId:        ownerID,
 			postId:        uuid.New().String(),
 			mockError:     errors.New("database error"),
 			expectedError: errors.New("database error"),
 		},
+		{
+			name:          "Not the owner",
+			userId:        uuid.New(),
+			postId:        uuid.New().String(),
+			mockError:     nil,
+			expectedError: ErrForbidden,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			// Create mock repository
 			mockRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, tc.postId, postId.String())
+					return &ent.Post{ID: postId, ImageKey: "posts/first.jpg", Edges: ent.PostEdges{
+						User:  &ent.User{ID: ownerID},
+						Media: []*ent.PostMedia{{Key: "posts/first.jpg"}, {Key: "posts/second.jpg"}},
+					}}, nil
+				},
 				DeletePostFunc: func(postId string) error {
 					// Verify input parameters
 					assert.Equal(t, tc.postId, postId)
@@ -297,11 +773,22 @@ func TestPostUsecase_DeletePost(t *testing.T) {
 				},
 			}
 
+			var deletedKeys []string
+			mockStorageRepo := &mock.MockStorageRepository{
+				DeleteImageFunc: func(fileKey string) error {
+					deletedKeys = append(deletedKeys, fileKey)
+					return nil
+				},
+			}
+
 			// Create usecase with mock repository
-			usecase := NewPostUsecase(mockRepo)
+			usecase := NewPostUsecase(mockRepo, nil, nil, mockStorageRepo, nil, nil, nil)
 
 			// Call the method
-			err := usecase.DeletePost(tc.postId)
+			err := usecase.DeletePost(tc.userId, tc.postId)
+
+			// The images are kept for moderation until the post is purged
+			assert.Empty(t, deletedKeys)
 
 			// Check error
 			if tc.expectedError != nil {
@@ -313,3 +800,144 @@ func TestPostUsecase_DeletePost(t *testing.T) {
 		})
 	}
 }
+
+func TestPostUsecase_PurgeDeletedPosts(t *testing.T) {
+	deleted := []*ent.Post{
+		{ID: uuid.New(), Edges: ent.PostEdges{Media: []*ent.PostMedia{{Key: "posts/first.jpg"}, {Key: "posts/second.jpg"}}}},
+		{ID: uuid.New(), Edges: ent.PostEdges{Media: []*ent.PostMedia{{Key: "posts/video.mp4", PosterKey: "posts/poster.jpg"}}}},
+	}
+	var hardDeleted []uuid.UUID
+	mockRepo := &mock.MockPostRepository{
+		ListDeletedBeforeFunc: func(before time.Time, limit int) ([]*ent.Post, error) {
+			assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), before, time.Minute)
+			// Purged posts are no longer listed
+			return deleted[len(hardDeleted):], nil
+		},
+		HardDeletePostFunc: func(postId uuid.UUID) error {
+			hardDeleted = append(hardDeleted, postId)
+			return nil
+		},
+	}
+	var deletedKeys []string
+	mockStorageRepo := &mock.MockStorageRepository{
+		DeleteImageFunc: func(fileKey string) error {
+			deletedKeys = append(deletedKeys, fileKey)
+			return nil
+		},
+	}
+
+	usecase := NewPostUsecase(mockRepo, nil, nil, mockStorageRepo, nil, nil, nil)
+	count, err := usecase.PurgeDeletedPosts(30 * 24 * time.Hour)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []uuid.UUID{deleted[0].ID, deleted[1].ID}, hardDeleted)
+	assert.Equal(t, []string{"posts/first.jpg", "posts/second.jpg", "posts/video.mp4", "posts/poster.jpg"}, deletedKeys)
+}
+
+func TestPostUsecase_ListComments(t *testing.T) {
+	viewerID := uuid.New()
+	postID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	comments := []*ent.Comment{
+		{ID: uuid.New(), CreatedAt: createdAt},
+		{ID: uuid.New(), CreatedAt: createdAt},
+		{ID: uuid.New(), CreatedAt: createdAt},
+	}
+
+	testCases := []struct {
+		name             string
+		postError        error
+		limit            int
+		expectedComments []*ent.Comment
+		expectedCursor   string
+		expectNotFound   bool
+	}{
+		{
+			name:             "Next page",
+			limit:            2,
+			expectedComments: comments[:2],
+			expectedCursor:   models.NewCursor(createdAt, comments[1].ID).Encode(),
+		},
+		{
+			name:             "Last page",
+			limit:            3,
+			expectedComments: comments,
+			expectedCursor:   "",
+		},
+		{
+			name:           "Post not visible",
+			postError:      &ent.NotFoundError{},
+			limit:          2,
+			expectNotFound: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockPostRepo := &mock.MockPostRepository{
+				GetVisibleByIdFunc: func(viewer uuid.UUID, id uuid.UUID) (*ent.Post, error) {
+					assert.Equal(t, viewerID, viewer)
+					assert.Equal(t, postID, id)
+					if tc.postError != nil {
+						return nil, tc.postError
+					}
+					return &ent.Post{ID: id}, nil
+				},
+			}
+			mockCommentRepo := &mock.MockCommentRepository{
+				ListByPostFunc: func(id uuid.UUID, viewer uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Comment, error) {
+					assert.Equal(t, tc.limit+1, limit)
+					return comments[:min(limit, len(comments))], nil
+				},
+				GetStatsFunc: func(viewer uuid.UUID, ids []uuid.UUID) (map[uuid.UUID]models.CommentStats, error) {
+					// 次のページの先頭は集計しない
+					assert.Len(t, ids, len(tc.expectedComments))
+					return map[uuid.UUID]models.CommentStats{ids[0]: {LikesCount: 1}}, nil
+				},
+			}
+
+			usecase := NewPostUsecase(mockPostRepo, mockCommentRepo, nil, nil, nil, nil, nil)
+			got, stats, nextCursor, err := usecase.ListComments(viewerID, postID, nil, tc.limit)
+
+			if tc.expectNotFound {
+				assert.True(t, ent.IsNotFound(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedComments, got)
+			assert.Equal(t, 1, stats[comments[0].ID].LikesCount)
+			assert.Equal(t, tc.expectedCursor, nextCursor)
+		})
+	}
+}
+
+func TestPostUsecase_ListLikes(t *testing.T) {
+	viewerID := uuid.New()
+	postID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	likes := []*ent.Like{
+		{ID: uuid.New(), CreatedAt: createdAt},
+		{ID: uuid.New(), CreatedAt: createdAt.Add(-time.Minute)},
+	}
+
+	mockPostRepo := &mock.MockPostRepository{
+		GetVisibleByIdFunc: func(viewer uuid.UUID, id uuid.UUID) (*ent.Post, error) {
+			return &ent.Post{ID: id}, nil
+		},
+	}
+	mockLikeRepo := &mock.MockLikeRepository{
+		ListByPostFunc: func(id uuid.UUID, viewer uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Like, error) {
+			assert.Equal(t, postID, id)
+			assert.Equal(t, viewerID, viewer)
+			return likes[:min(limit, len(likes))], nil
+		},
+	}
+
+	usecase := NewPostUsecase(mockPostRepo, nil, mockLikeRepo, nil, nil, nil, nil)
+	got, nextCursor, err := usecase.ListLikes(viewerID, postID, nil, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, likes[:1], got)
+	assert.Equal(t, models.NewCursor(createdAt, likes[0].ID).Encode(), nextCursor)
+}
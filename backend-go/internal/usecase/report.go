@@ -1,37 +1,287 @@
 package usecase
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
 )
 
-type ReportUsecase struct {
-	mailerRepository repository.MailerRepository
+const (
+	defaultReportSubjectTemplate = "【通報】{{.TargetType}} が通報されました ({{.Reason}})"
+	defaultReportBodyTemplate    = `通報ID: {{.ReportID}}
+対象: {{.TargetType}} {{.TargetID}}
+理由: {{.Reason}}
+通報者: {{.ReporterName}} ({{.ReporterID}})
+日時: {{.CreatedAt.Format "2006-01-02 15:04:05"}}
+
+{{.Details}}
+`
+)
+
+// ReportNotification is the optional email sent to moderators when a new report is filed.
+type ReportNotification struct {
+	from    string
+	to      []string
+	subject *template.Template
+	body    *template.Template
 }
 
-func NewReportUsecase(mailerRepository repository.MailerRepository) *ReportUsecase {
-	return &ReportUsecase{
-		mailerRepository: mailerRepository,
+// ReportNotificationData is the data available to the subject and body templates.
+type ReportNotificationData struct {
+	ReportID     uuid.UUID
+	TargetType   enum.ReportTargetType
+	TargetID     uuid.UUID
+	Reason       enum.ReportReason
+	Details      string
+	ReporterID   uuid.UUID
+	ReporterName string
+	CreatedAt    time.Time
+}
+
+// NewReportNotification parses the templates (text/template, defaults are used when empty).
+// It returns nil when there are no recipients, which disables notifications.
+func NewReportNotification(from string, to []string, subjectTemplate, bodyTemplate string) (*ReportNotification, error) {
+	if len(to) == 0 {
+		return nil, nil
+	}
+	if subjectTemplate == "" {
+		subjectTemplate = defaultReportSubjectTemplate
 	}
+	if bodyTemplate == "" {
+		bodyTemplate = defaultReportBodyTemplate
+	}
+
+	subject, err := template.New("subject").Parse(subjectTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse report subject template: %w", err)
+	}
+	body, err := template.New("body").Parse(bodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse report body template: %w", err)
+	}
+
+	return &ReportNotification{
+		from:    from,
+		to:      to,
+		subject: subject,
+		body:    body,
+	}, nil
 }
 
-func (u *ReportUsecase) SendReport(from, to, subject, body string) (*ses.SendEmailOutput, error) {
-	email := &ses.SendEmailInput{
-		Source: aws.String(from),
+func (n *ReportNotification) build(data ReportNotificationData) (*ses.SendEmailInput, error) {
+	var subject, body bytes.Buffer
+	if err := n.subject.Execute(&subject, data); err != nil {
+		return nil, err
+	}
+	if err := n.body.Execute(&body, data); err != nil {
+		return nil, err
+	}
+
+	return &ses.SendEmailInput{
+		Source: aws.String(n.from),
 		Destination: &ses.Destination{
-			ToAddresses: []*string{aws.String(to)},
+			ToAddresses: aws.StringSlice(n.to),
 		},
 		Message: &ses.Message{
 			Body: &ses.Body{
 				Text: &ses.Content{
-					Data: aws.String(body),
+					Data: aws.String(body.String()),
 				},
 			},
 			Subject: &ses.Content{
-				Data: aws.String(subject),
+				// 件名に改行が含まれるとメールヘッダが壊れるため除去する
+				Data: aws.String(strings.ReplaceAll(subject.String(), "\n", " ")),
 			},
 		},
+	}, nil
+}
+
+type ReportUsecase struct {
+	reportRepository  repository.ReportRepository
+	postRepository    repository.PostRepository
+	commentRepository repository.CommentRepository
+	userRepository    repository.UserRepository
+	mailerRepository  repository.MailerRepository
+	notification      *ReportNotification
+	// hideThreshold is the number of distinct reporters after which a post or comment
+	// is hidden until a moderator reviews it. Zero disables auto-hiding.
+	hideThreshold int
+}
+
+func NewReportUsecase(
+	reportRepository repository.ReportRepository,
+	postRepository repository.PostRepository,
+	commentRepository repository.CommentRepository,
+	userRepository repository.UserRepository,
+	mailerRepository repository.MailerRepository,
+	notification *ReportNotification,
+	hideThreshold int) *ReportUsecase {
+	return &ReportUsecase{
+		reportRepository:  reportRepository,
+		postRepository:    postRepository,
+		commentRepository: commentRepository,
+		userRepository:    userRepository,
+		mailerRepository:  mailerRepository,
+		notification:      notification,
+		hideThreshold:     hideThreshold,
+	}
+}
+
+// File stores a report from the reporter. If the reporter already has an open report
+// against the same target, that report is returned instead and created is false.
+func (u *ReportUsecase) File(reporter *ent.User, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (report *ent.Report, created bool, err error) {
+	if !targetType.Valid() || !reason.Valid() {
+		return nil, false, ErrInvalidReport
+	}
+	if targetType == enum.ReportTargetUser && targetID == reporter.ID {
+		return nil, false, ErrInvalidReport
+	}
+	if err := u.checkTarget(targetType, targetID); err != nil {
+		return nil, false, err
+	}
+
+	existing, err := u.reportRepository.FindOpen(reporter.ID, targetType, targetID)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	report, err = u.reportRepository.Create(reporter.ID, targetType, targetID, reason, details)
+	if ent.IsConstraintError(err) {
+		// 同時に送られた同じ通報が先に保存された
+		existing, err := u.reportRepository.FindOpen(reporter.ID, targetType, targetID)
+		if err != nil {
+			return nil, false, err
+		}
+		return existing, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	u.hideIfReported(targetType, targetID)
+	u.notify(report, reporter, targetID)
+	return report, true, nil
+}
+
+// hideIfReported hides the post or comment once enough distinct users have reported it.
+// Failures are only logged since the report itself is already stored.
+func (u *ReportUsecase) hideIfReported(targetType enum.ReportTargetType, targetID uuid.UUID) {
+	if u.hideThreshold <= 0 || targetType == enum.ReportTargetUser {
+		return
+	}
+
+	count, err := u.reportRepository.CountOpenReporters(targetType, targetID)
+	if err != nil {
+		log.Warnf("Failed to count reporters: %v", err)
+		return
+	}
+	if count < u.hideThreshold {
+		return
+	}
+	if err := u.setHidden(targetType, targetID, true); err != nil {
+		log.Warnf("Failed to hide reported %s %s: %v", targetType, targetID, err)
+	}
+}
+
+func (u *ReportUsecase) setHidden(targetType enum.ReportTargetType, targetID uuid.UUID, hidden bool) error {
+	switch targetType {
+	case enum.ReportTargetPost:
+		return u.postRepository.SetHidden(targetID, hidden)
+	case enum.ReportTargetComment:
+		return u.commentRepository.SetHidden(targetID, hidden)
+	}
+	return nil
+}
+
+func (u *ReportUsecase) checkTarget(targetType enum.ReportTargetType, targetID uuid.UUID) error {
+	var err error
+	switch targetType {
+	case enum.ReportTargetPost:
+		_, err = u.postRepository.GetById(targetID)
+	case enum.ReportTargetComment:
+		_, err = u.commentRepository.GetById(targetID.String())
+	case enum.ReportTargetUser:
+		_, err = u.userRepository.GetById(targetID)
+	}
+	return err
+}
+
+// notify sends the notification email. A failure is only logged since the report is already stored.
+func (u *ReportUsecase) notify(report *ent.Report, reporter *ent.User, targetID uuid.UUID) {
+	if u.notification == nil {
+		return
+	}
+
+	email, err := u.notification.build(ReportNotificationData{
+		ReportID:     report.ID,
+		TargetType:   report.TargetType,
+		TargetID:     targetID,
+		Reason:       report.Reason,
+		Details:      report.Details,
+		ReporterID:   reporter.ID,
+		ReporterName: reporter.Name,
+		CreatedAt:    report.CreatedAt,
+	})
+	if err != nil {
+		log.Warnf("Failed to build report notification: %v", err)
+		return
+	}
+	if _, err := u.mailerRepository.Send(email); err != nil {
+		log.Warnf("Failed to send report notification: %v", err)
+	}
+}
+
+func (u *ReportUsecase) List(filter models.ReportFilter, limit, offset int) ([]models.ReportResponse, error) {
+	reports, err := u.reportRepository.List(filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.ReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = models.NewReportResponse(report)
+	}
+	return responses, nil
+}
+
+// Resolve closes an open report, and every other open report on the same target, as actioned
+// or dismissed. Dismissing makes auto-hidden content visible again.
+func (u *ReportUsecase) Resolve(resolver *ent.User, reportID uuid.UUID, status enum.ReportStatus, note string) (*models.ReportResponse, error) {
+	if status != enum.ReportStatusActioned && status != enum.ReportStatusDismissed {
+		return nil, ErrInvalidReport
+	}
+
+	report, err := u.reportRepository.GetById(reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != enum.ReportStatusOpen {
+		return nil, ErrAlreadyResolved
+	}
+
+	report, err = u.reportRepository.Resolve(reportID, resolver.ID, status, note)
+	if err != nil {
+		return nil, err
+	}
+	if targetID := models.ReportTargetID(report); status == enum.ReportStatusDismissed && targetID != nil {
+		if err := u.setHidden(report.TargetType, *targetID, false); err != nil {
+			return nil, err
+		}
 	}
-	return u.mailerRepository.Send(email)
+	response := models.NewReportResponse(report)
+	return &response, nil
 }
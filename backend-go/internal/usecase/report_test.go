@@ -0,0 +1,487 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportUsecase_File(t *testing.T) {
+	reporter := &ent.User{ID: uuid.New(), Name: "reporter"}
+	postID := uuid.New()
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		targetType    enum.ReportTargetType
+		targetID      uuid.UUID
+		reason        enum.ReportReason
+		targetError   error
+		existing      *ent.Report
+		expectCreate  bool
+		expectCreated bool
+		expectedError error
+	}{
+		{
+			name:          "New report",
+			targetType:    enum.ReportTargetPost,
+			targetID:      postID,
+			reason:        enum.ReportReasonSpam,
+			expectCreate:  true,
+			expectCreated: true,
+		},
+		{
+			name:          "Repeat report returns the open one",
+			targetType:    enum.ReportTargetPost,
+			targetID:      postID,
+			reason:        enum.ReportReasonSpam,
+			existing:      &ent.Report{ID: uuid.New(), Status: enum.ReportStatusOpen},
+			expectCreated: false,
+		},
+		{
+			name:          "Unknown reason",
+			targetType:    enum.ReportTargetPost,
+			targetID:      postID,
+			reason:        enum.ReportReason("boring"),
+			expectedError: ErrInvalidReport,
+		},
+		{
+			name:          "Unknown target type",
+			targetType:    enum.ReportTargetType("pet"),
+			targetID:      postID,
+			reason:        enum.ReportReasonSpam,
+			expectedError: ErrInvalidReport,
+		},
+		{
+			name:          "Reporting yourself",
+			targetType:    enum.ReportTargetUser,
+			targetID:      reporter.ID,
+			reason:        enum.ReportReasonSpam,
+			expectedError: ErrInvalidReport,
+		},
+		{
+			name:        "Target not found",
+			targetType:  enum.ReportTargetPost,
+			targetID:    postID,
+			reason:      enum.ReportReasonSpam,
+			targetError: &ent.NotFoundError{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			created := false
+			var sent *ses.SendEmailInput
+
+			mockReportRepo := &mock.MockReportRepository{
+				FindOpenFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error) {
+					assert.Equal(t, reporter.ID, reporterID)
+					assert.Equal(t, tc.targetType, targetType)
+					assert.Equal(t, tc.targetID, targetID)
+					if tc.existing != nil {
+						return tc.existing, nil
+					}
+					return nil, &ent.NotFoundError{}
+				},
+				CreateFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error) {
+					created = true
+					return &ent.Report{
+						ID:         uuid.New(),
+						TargetType: targetType,
+						PostID:     &targetID,
+						Reason:     reason,
+						Details:    details,
+						Status:     enum.ReportStatusOpen,
+						CreatedAt:  time.Now(),
+					}, nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					if tc.targetError != nil {
+						return nil, tc.targetError
+					}
+					return &ent.Post{ID: postId}, nil
+				},
+			}
+			mockUserRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					return &ent.User{ID: id}, nil
+				},
+			}
+			mockMailer := &mock.MockMailerRepository{
+				SendFunc: func(email *ses.SendEmailInput) (*ses.SendEmailOutput, error) {
+					sent = email
+					return &ses.SendEmailOutput{}, nil
+				},
+			}
+			notification, err := NewReportNotification("noreply@example.com", []string{"mod@example.com"}, "", "")
+			require.NoError(t, err)
+
+			usecase := NewReportUsecase(mockReportRepo, mockPostRepo, &mock.MockCommentRepository{}, mockUserRepo, mockMailer, notification, 0)
+			report, isNew, err := usecase.File(reporter, tc.targetType, tc.targetID, tc.reason, "details")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.False(t, created)
+				return
+			}
+			if tc.targetError != nil {
+				assert.True(t, ent.IsNotFound(err))
+				assert.False(t, created)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, report)
+			assert.Equal(t, tc.expectCreate, created)
+			assert.Equal(t, tc.expectCreated, isNew)
+			// Only new reports are notified
+			assert.Equal(t, tc.expectCreated, sent != nil)
+		})
+	}
+}
+
+func TestReportUsecase_FileConcurrentRepeat(t *testing.T) {
+	reporter := &ent.User{ID: uuid.New(), Name: "reporter"}
+	existing := &ent.Report{ID: uuid.New(), Status: enum.ReportStatusOpen}
+	finds := 0
+
+	// Another request stores the same report between FindOpen and Create
+	mockReportRepo := &mock.MockReportRepository{
+		FindOpenFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error) {
+			finds++
+			if finds == 1 {
+				return nil, &ent.NotFoundError{}
+			}
+			return existing, nil
+		},
+		CreateFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error) {
+			return nil, &ent.ConstraintError{}
+		},
+	}
+	mockPostRepo := &mock.MockPostRepository{
+		GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+			return &ent.Post{ID: postId}, nil
+		},
+	}
+
+	// The mailer is nil: the merged report must not be notified
+	usecase := NewReportUsecase(mockReportRepo, mockPostRepo, &mock.MockCommentRepository{}, &mock.MockUserRepository{}, nil, nil, 0)
+	report, isNew, err := usecase.File(reporter, enum.ReportTargetPost, uuid.New(), enum.ReportReasonSpam, "")
+
+	assert.NoError(t, err)
+	assert.False(t, isNew)
+	assert.Equal(t, existing.ID, report.ID)
+}
+
+func TestReportUsecase_FileWithoutNotification(t *testing.T) {
+	mockReportRepo := &mock.MockReportRepository{
+		FindOpenFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error) {
+			return nil, &ent.NotFoundError{}
+		},
+		CreateFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error) {
+			return &ent.Report{ID: uuid.New()}, nil
+		},
+	}
+	mockUserRepo := &mock.MockUserRepository{
+		GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+			return &ent.User{ID: id}, nil
+		},
+	}
+	// SendFunc is nil: the mailer must not be called when notifications are disabled
+	notification, err := NewReportNotification("", nil, "", "")
+	require.NoError(t, err)
+	assert.Nil(t, notification)
+
+	usecase := NewReportUsecase(mockReportRepo, &mock.MockPostRepository{}, &mock.MockCommentRepository{}, mockUserRepo, &mock.MockMailerRepository{}, notification, 0)
+	_, created, err := usecase.File(&ent.User{ID: uuid.New()}, enum.ReportTargetUser, uuid.New(), enum.ReportReasonHarassment, "")
+
+	assert.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestReportUsecase_FileNotificationFailureIsIgnored(t *testing.T) {
+	mockReportRepo := &mock.MockReportRepository{
+		FindOpenFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID) (*ent.Report, error) {
+			return nil, &ent.NotFoundError{}
+		},
+		CreateFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, targetID uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error) {
+			return &ent.Report{ID: uuid.New()}, nil
+		},
+	}
+	mockUserRepo := &mock.MockUserRepository{
+		GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+			return &ent.User{ID: id}, nil
+		},
+	}
+	mockMailer := &mock.MockMailerRepository{
+		SendFunc: func(email *ses.SendEmailInput) (*ses.SendEmailOutput, error) {
+			return nil, errors.New("ses error")
+		},
+	}
+	notification, err := NewReportNotification("noreply@example.com", []string{"mod@example.com"}, "", "")
+	require.NoError(t, err)
+
+	usecase := NewReportUsecase(mockReportRepo, &mock.MockPostRepository{}, &mock.MockCommentRepository{}, mockUserRepo, mockMailer, notification, 0)
+	_, created, err := usecase.File(&ent.User{ID: uuid.New()}, enum.ReportTargetUser, uuid.New(), enum.ReportReasonSpam, "")
+
+	assert.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestReportUsecase_FileHidesReportedContent(t *testing.T) {
+	// Test cases
+	testCases := []struct {
+		name         string
+		targetType   enum.ReportTargetType
+		threshold    int
+		reporters    int
+		expectHidden bool
+	}{
+		{
+			name:         "Post below threshold",
+			targetType:   enum.ReportTargetPost,
+			threshold:    3,
+			reporters:    2,
+			expectHidden: false,
+		},
+		{
+			name:         "Post at threshold",
+			targetType:   enum.ReportTargetPost,
+			threshold:    3,
+			reporters:    3,
+			expectHidden: true,
+		},
+		{
+			name:         "Comment at threshold",
+			targetType:   enum.ReportTargetComment,
+			threshold:    2,
+			reporters:    2,
+			expectHidden: true,
+		},
+		{
+			name:         "Disabled",
+			targetType:   enum.ReportTargetPost,
+			threshold:    0,
+			reporters:    10,
+			expectHidden: false,
+		},
+		{
+			name:         "Users are never hidden",
+			targetType:   enum.ReportTargetUser,
+			threshold:    1,
+			reporters:    5,
+			expectHidden: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			targetID := uuid.New()
+			var hiddenPost, hiddenComment *uuid.UUID
+
+			mockReportRepo := &mock.MockReportRepository{
+				FindOpenFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, id uuid.UUID) (*ent.Report, error) {
+					return nil, &ent.NotFoundError{}
+				},
+				CreateFunc: func(reporterID uuid.UUID, targetType enum.ReportTargetType, id uuid.UUID, reason enum.ReportReason, details string) (*ent.Report, error) {
+					return &ent.Report{ID: uuid.New(), TargetType: targetType}, nil
+				},
+				CountOpenReportersFunc: func(targetType enum.ReportTargetType, id uuid.UUID) (int, error) {
+					assert.Equal(t, tc.targetType, targetType)
+					assert.Equal(t, targetID, id)
+					return tc.reporters, nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdFunc: func(postId uuid.UUID) (*ent.Post, error) {
+					return &ent.Post{ID: postId}, nil
+				},
+				SetHiddenFunc: func(postId uuid.UUID, hidden bool) error {
+					assert.True(t, hidden)
+					hiddenPost = &postId
+					return nil
+				},
+			}
+			mockCommentRepo := &mock.MockCommentRepository{
+				GetByIdFunc: func(commentId string) (*ent.Comment, error) {
+					return &ent.Comment{ID: uuid.MustParse(commentId)}, nil
+				},
+				SetHiddenFunc: func(commentId uuid.UUID, hidden bool) error {
+					assert.True(t, hidden)
+					hiddenComment = &commentId
+					return nil
+				},
+			}
+			mockUserRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					return &ent.User{ID: id}, nil
+				},
+			}
+
+			usecase := NewReportUsecase(mockReportRepo, mockPostRepo, mockCommentRepo, mockUserRepo, &mock.MockMailerRepository{}, nil, tc.threshold)
+			_, created, err := usecase.File(&ent.User{ID: uuid.New()}, tc.targetType, targetID, enum.ReportReasonSpam, "")
+
+			assert.NoError(t, err)
+			assert.True(t, created)
+			hidden := hiddenPost != nil || hiddenComment != nil
+			assert.Equal(t, tc.expectHidden, hidden)
+			if tc.expectHidden && tc.targetType == enum.ReportTargetPost {
+				assert.Equal(t, targetID, *hiddenPost)
+			}
+			if tc.expectHidden && tc.targetType == enum.ReportTargetComment {
+				assert.Equal(t, targetID, *hiddenComment)
+			}
+		})
+	}
+}
+
+func TestNewReportNotification(t *testing.T) {
+	reportID := uuid.New()
+	targetID := uuid.New()
+
+	notification, err := NewReportNotification("noreply@example.com", []string{"a@example.com", "b@example.com"},
+		"[{{.Reason}}]\n{{.TargetType}}", "{{.ReporterName}} reported {{.TargetID}}: {{.Details}}")
+	require.NoError(t, err)
+
+	email, err := notification.build(ReportNotificationData{
+		ReportID:     reportID,
+		TargetType:   enum.ReportTargetPost,
+		TargetID:     targetID,
+		Reason:       enum.ReportReasonSpam,
+		Details:      "buy now",
+		ReporterName: "taro",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "noreply@example.com", aws.StringValue(email.Source))
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, aws.StringValueSlice(email.Destination.ToAddresses))
+	assert.Equal(t, "[spam] post", aws.StringValue(email.Message.Subject.Data))
+	assert.Equal(t, "taro reported "+targetID.String()+": buy now", aws.StringValue(email.Message.Body.Text.Data))
+
+	// The default templates render every field
+	notification, err = NewReportNotification("noreply@example.com", []string{"a@example.com"}, "", "")
+	require.NoError(t, err)
+	email, err = notification.build(ReportNotificationData{ReportID: reportID, TargetID: targetID, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	assert.True(t, strings.Contains(aws.StringValue(email.Message.Body.Text.Data), reportID.String()))
+
+	_, err = NewReportNotification("noreply@example.com", []string{"a@example.com"}, "{{.Broken", "")
+	assert.Error(t, err)
+}
+
+func TestReportUsecase_Resolve(t *testing.T) {
+	resolver := &ent.User{ID: uuid.New(), Role: enum.RoleModerator}
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		status        enum.ReportStatus
+		currentStatus enum.ReportStatus
+		expectResolve bool
+		expectedError error
+	}{
+		{
+			name:          "Actioned",
+			status:        enum.ReportStatusActioned,
+			currentStatus: enum.ReportStatusOpen,
+			expectResolve: true,
+		},
+		{
+			name:          "Dismissed",
+			status:        enum.ReportStatusDismissed,
+			currentStatus: enum.ReportStatusOpen,
+			expectResolve: true,
+		},
+		{
+			name:          "Cannot reopen",
+			status:        enum.ReportStatusOpen,
+			currentStatus: enum.ReportStatusOpen,
+			expectedError: ErrInvalidReport,
+		},
+		{
+			name:          "Already resolved",
+			status:        enum.ReportStatusDismissed,
+			currentStatus: enum.ReportStatusActioned,
+			expectedError: ErrAlreadyResolved,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reportID := uuid.New()
+			resolved := false
+
+			mockReportRepo := &mock.MockReportRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.Report, error) {
+					return &ent.Report{ID: id, Status: tc.currentStatus}, nil
+				},
+				ResolveFunc: func(id uuid.UUID, resolverID uuid.UUID, status enum.ReportStatus, note string) (*ent.Report, error) {
+					resolved = true
+					assert.Equal(t, reportID, id)
+					assert.Equal(t, resolver.ID, resolverID)
+					assert.Equal(t, "note", note)
+					return &ent.Report{ID: id, Status: status, Edges: ent.ReportEdges{Resolver: resolver}}, nil
+				},
+			}
+
+			usecase := NewReportUsecase(mockReportRepo, &mock.MockPostRepository{}, &mock.MockCommentRepository{}, &mock.MockUserRepository{}, &mock.MockMailerRepository{}, nil, 0)
+			report, err := usecase.Resolve(resolver, reportID, tc.status, "note")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, report)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.status, report.Status)
+				assert.Equal(t, resolver.ID, report.Resolver.ID)
+			}
+			assert.Equal(t, tc.expectResolve, resolved)
+		})
+	}
+}
+
+func TestReportUsecase_ResolveUnhidesDismissedContent(t *testing.T) {
+	resolver := &ent.User{ID: uuid.New(), Role: enum.RoleModerator}
+
+	for _, status := range []enum.ReportStatus{enum.ReportStatusActioned, enum.ReportStatusDismissed} {
+		t.Run(string(status), func(t *testing.T) {
+			postID := uuid.New()
+			unhidden := false
+
+			mockReportRepo := &mock.MockReportRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.Report, error) {
+					return &ent.Report{ID: id, Status: enum.ReportStatusOpen}, nil
+				},
+				ResolveFunc: func(id uuid.UUID, resolverID uuid.UUID, status enum.ReportStatus, note string) (*ent.Report, error) {
+					return &ent.Report{ID: id, Status: status, TargetType: enum.ReportTargetPost, PostID: &postID}, nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				SetHiddenFunc: func(postId uuid.UUID, hidden bool) error {
+					assert.Equal(t, postID, postId)
+					assert.False(t, hidden)
+					unhidden = true
+					return nil
+				},
+			}
+
+			usecase := NewReportUsecase(mockReportRepo, mockPostRepo, &mock.MockCommentRepository{}, &mock.MockUserRepository{}, &mock.MockMailerRepository{}, nil, 3)
+			_, err := usecase.Resolve(resolver, uuid.New(), status, "")
+
+			assert.NoError(t, err)
+			// Actioned content stays hidden, dismissed content is shown again
+			assert.Equal(t, status == enum.ReportStatusDismissed, unhidden)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"mime/multipart"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
 )
 
@@ -22,6 +24,16 @@ func (u *StorageUsecase) GetUrl(fileKey string) (string, error) {
 	return u.storageRepository.GetUrl(fileKey)
 }
 
+// GetMediaUrls returns the URLs of the media of the post, and of video posters, in carousel order.
+func (u *StorageUsecase) GetMediaUrls(post *ent.Post) ([]models.MediaURL, error) {
+	return mediaURLs(u.storageRepository, post)
+}
+
+// GetPetImageUrls returns the image URLs of the pets tagged in the post, in order.
+func (u *StorageUsecase) GetPetImageUrls(post *ent.Post) ([]string, error) {
+	return petImageURLs(u.storageRepository, post)
+}
+
 func (u *StorageUsecase) DeleteImage(fileKey string) error {
 	return u.storageRepository.DeleteImage(fileKey)
 }
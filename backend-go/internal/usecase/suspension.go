@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+type SuspensionUsecase struct {
+	suspensionRepository repository.SuspensionRepository
+	userRepository       repository.UserRepository
+}
+
+func NewSuspensionUsecase(suspensionRepository repository.SuspensionRepository, userRepository repository.UserRepository) *SuspensionUsecase {
+	return &SuspensionUsecase{
+		suspensionRepository: suspensionRepository,
+		userRepository:       userRepository,
+	}
+}
+
+// Suspend suspends the user until endsAt. Staff can only suspend users with a lower role,
+// so moderators cannot suspend each other or admins.
+func (u *SuspensionUsecase) Suspend(actor *ent.User, userId uuid.UUID, reason string, endsAt time.Time) (*models.SuspensionResponse, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" || !endsAt.After(time.Now()) {
+		return nil, ErrInvalidSuspension
+	}
+
+	target, err := u.userRepository.GetById(userId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role.AtLeast(actor.Role) {
+		return nil, ErrForbidden
+	}
+
+	suspension, err := u.suspensionRepository.Create(userId, actor.ID, reason, endsAt)
+	if err != nil {
+		return nil, err
+	}
+	response := models.NewSuspensionResponse(suspension)
+	return &response, nil
+}
+
+// Lift ends the user's suspension early. It reports whether a suspension was in effect.
+func (u *SuspensionUsecase) Lift(userId uuid.UUID) (bool, error) {
+	n, err := u.suspensionRepository.Lift(userId)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (u *SuspensionUsecase) ListByUser(userId uuid.UUID) ([]models.SuspensionResponse, error) {
+	suspensions, err := u.suspensionRepository.ListByUser(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]models.SuspensionResponse, len(suspensions))
+	for i, suspension := range suspensions {
+		responses[i] = models.NewSuspensionResponse(suspension)
+	}
+	return responses, nil
+}
+
+// GetActive returns the suspension in effect for the user, or nil when the user is not suspended.
+func (u *SuspensionUsecase) GetActive(userId uuid.UUID) (*ent.Suspension, error) {
+	suspension, err := u.suspensionRepository.GetActive(userId)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return suspension, nil
+}
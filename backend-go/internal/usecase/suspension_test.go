@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/ent/enum"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuspensionUsecase_Suspend(t *testing.T) {
+	moderator := &ent.User{ID: uuid.New(), Role: enum.RoleModerator}
+
+	// Test cases
+	testCases := []struct {
+		name          string
+		targetRole    enum.Role
+		reason        string
+		endsAt        time.Time
+		expectCreate  bool
+		expectedError error
+	}{
+		{
+			name:         "Suspend a user",
+			targetRole:   enum.RoleUser,
+			reason:       "spam",
+			endsAt:       time.Now().Add(24 * time.Hour),
+			expectCreate: true,
+		},
+		{
+			name:          "Empty reason",
+			targetRole:    enum.RoleUser,
+			reason:        "  ",
+			endsAt:        time.Now().Add(24 * time.Hour),
+			expectedError: ErrInvalidSuspension,
+		},
+		{
+			name:          "Ends in the past",
+			targetRole:    enum.RoleUser,
+			reason:        "spam",
+			endsAt:        time.Now().Add(-time.Hour),
+			expectedError: ErrInvalidSuspension,
+		},
+		{
+			name:          "Moderators cannot suspend each other",
+			targetRole:    enum.RoleModerator,
+			reason:        "spam",
+			endsAt:        time.Now().Add(24 * time.Hour),
+			expectedError: ErrForbidden,
+		},
+		{
+			name:          "Moderators cannot suspend admins",
+			targetRole:    enum.RoleAdmin,
+			reason:        "spam",
+			endsAt:        time.Now().Add(24 * time.Hour),
+			expectedError: ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			targetID := uuid.New()
+			created := false
+
+			mockSuspensionRepo := &mock.MockSuspensionRepository{
+				CreateFunc: func(userID uuid.UUID, issuedByID uuid.UUID, reason string, endsAt time.Time) (*ent.Suspension, error) {
+					created = true
+					assert.Equal(t, targetID, userID)
+					assert.Equal(t, moderator.ID, issuedByID)
+					assert.Equal(t, tc.reason, reason)
+					return &ent.Suspension{ID: uuid.New(), Reason: reason, EndsAt: endsAt}, nil
+				},
+			}
+			mockUserRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					return &ent.User{ID: id, Role: tc.targetRole}, nil
+				},
+			}
+
+			usecase := NewSuspensionUsecase(mockSuspensionRepo, mockUserRepo)
+			suspension, err := usecase.Suspend(moderator, targetID, tc.reason, tc.endsAt)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, suspension)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.reason, suspension.Reason)
+			}
+			assert.Equal(t, tc.expectCreate, created)
+		})
+	}
+}
+
+func TestSuspensionUsecase_GetActive(t *testing.T) {
+	userID := uuid.New()
+	active := &ent.Suspension{ID: uuid.New(), Reason: "spam"}
+
+	mockSuspensionRepo := &mock.MockSuspensionRepository{
+		GetActiveFunc: func(id uuid.UUID) (*ent.Suspension, error) {
+			if id == userID {
+				return active, nil
+			}
+			return nil, &ent.NotFoundError{}
+		},
+	}
+	usecase := NewSuspensionUsecase(mockSuspensionRepo, &mock.MockUserRepository{})
+
+	suspension, err := usecase.GetActive(userID)
+	assert.NoError(t, err)
+	assert.Equal(t, active, suspension)
+
+	// Users without a suspension are not an error
+	suspension, err = usecase.GetActive(uuid.New())
+	assert.NoError(t, err)
+	assert.Nil(t, suspension)
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// TrendingWindow is the length of the sliding window in which tag usage is compared with the
+	// window before it.
+	TrendingWindow = 24 * time.Hour
+	// minTrendingPosts is the number of posts a tag needs in the current window to trend, so that
+	// a tag going from zero to one post does not top the list.
+	minTrendingPosts = 3
+	// tagBackfillBatchSize is the number of posts read at once by BackfillTags.
+	tagBackfillBatchSize = 500
+)
+
+type TagUsecase struct {
+	tagRepository  repository.TagRepository
+	postRepository repository.PostRepository
+	now            func() time.Time
+}
+
+func NewTagUsecase(tagRepository repository.TagRepository, postRepository repository.PostRepository) *TagUsecase {
+	return &TagUsecase{
+		tagRepository:  tagRepository,
+		postRepository: postRepository,
+		now:            time.Now,
+	}
+}
+
+// GetTrending returns up to limit hashtags whose use grew the most in the last TrendingWindow
+// compared with the window before it, best first.
+func (u *TagUsecase) GetTrending(limit int) ([]models.TrendingTag, error) {
+	now := u.now()
+	current, err := u.tagRepository.CountUses(now.Add(-TrendingWindow), now)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := u.tagRepository.CountUses(now.Add(-2*TrendingWindow), now.Add(-TrendingWindow))
+	if err != nil {
+		return nil, err
+	}
+	return rankTrendingTags(current, previous, limit), nil
+}
+
+// rankTrendingTags scores each tag by its growth, (current - previous) / sqrt(previous + 1).
+// Dividing by the square root rather than by previous itself lets a big tag that keeps growing
+// compete with a small one that just appeared. Tags that did not grow are left out.
+func rankTrendingTags(current, previous []models.TagUsage, limit int) []models.TrendingTag {
+	previousCounts := make(map[uuid.UUID]int, len(previous))
+	for _, usage := range previous {
+		previousCounts[usage.TagID] = usage.Count
+	}
+
+	trending := []models.TrendingTag{}
+	for _, usage := range current {
+		before := previousCounts[usage.TagID]
+		if usage.Count < minTrendingPosts || usage.Count <= before {
+			continue
+		}
+		trending = append(trending, models.TrendingTag{
+			Name:          usage.Name,
+			PostsCount:    usage.Count,
+			PreviousCount: before,
+			Score:         float64(usage.Count-before) / math.Sqrt(float64(before+1)),
+		})
+	}
+	sort.Slice(trending, func(i, j int) bool {
+		if trending[i].Score != trending[j].Score {
+			return trending[i].Score > trending[j].Score
+		}
+		if trending[i].PostsCount != trending[j].PostsCount {
+			return trending[i].PostsCount > trending[j].PostsCount
+		}
+		return trending[i].Name < trending[j].Name
+	})
+	if len(trending) > limit {
+		trending = trending[:limit]
+	}
+	return trending
+}
+
+// BackfillTags stores the hashtags of every post created before hashtags were parsed, and returns
+// the number of posts read. Tags are dated at the creation of the post so that the backfill does
+// not show up as a trend. Running it again changes nothing.
+func (u *TagUsecase) BackfillTags() (int, error) {
+	count := 0
+	after := uuid.Nil
+	for {
+		posts, err := u.postRepository.ListCaptions(after, tagBackfillBatchSize)
+		if err != nil {
+			return count, err
+		}
+		if len(posts) == 0 {
+			return count, nil
+		}
+		for _, post := range posts {
+			if err := u.postRepository.SetTags(post.ID, extractHashtags(post.Caption), post.CreatedAt); err != nil {
+				return count, fmt.Errorf("failed to set tags of post %s: %w", post.ID, err)
+			}
+			count++
+		}
+		after = posts[len(posts)-1].ID
+	}
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankTrendingTags(t *testing.T) {
+	ids := map[string]uuid.UUID{}
+	usage := func(name string, count int) models.TagUsage {
+		if _, ok := ids[name]; !ok {
+			ids[name] = uuid.New()
+		}
+		return models.TagUsage{TagID: ids[name], Name: name, Count: count}
+	}
+
+	testCases := []struct {
+		name     string
+		current  []models.TagUsage
+		previous []models.TagUsage
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "New tag beats a steady big one",
+			current:  []models.TagUsage{usage("dog", 100), usage("柴犬の日", 20)},
+			previous: []models.TagUsage{usage("dog", 100)},
+			limit:    10,
+			expected: []string{"柴犬の日"},
+		},
+		{
+			name:     "Big growth of a big tag beats a small new one",
+			current:  []models.TagUsage{usage("cat", 300), usage("new", 5)},
+			previous: []models.TagUsage{usage("cat", 100)},
+			limit:    10,
+			expected: []string{"cat", "new"},
+		},
+		{
+			name:     "Too few posts",
+			current:  []models.TagUsage{usage("rare", minTrendingPosts-1)},
+			limit:    10,
+			expected: []string{},
+		},
+		{
+			name:     "Shrinking tags are left out",
+			current:  []models.TagUsage{usage("old", 10)},
+			previous: []models.TagUsage{usage("old", 30)},
+			limit:    10,
+			expected: []string{},
+		},
+		{
+			name:     "Ties by count, then by name",
+			current:  []models.TagUsage{usage("b", 5), usage("a", 5), usage("c", 4)},
+			limit:    2,
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trending := rankTrendingTags(tc.current, tc.previous, tc.limit)
+			names := make([]string, len(trending))
+			for i, tag := range trending {
+				names[i] = tag.Name
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestTagUsecase_GetTrending(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tagID := uuid.New()
+	var windows [][2]time.Time
+	mockRepo := &mock.MockTagRepository{
+		CountUsesFunc: func(from, to time.Time) ([]models.TagUsage, error) {
+			windows = append(windows, [2]time.Time{from, to})
+			if to.Equal(now) {
+				return []models.TagUsage{{TagID: tagID, Name: "柴犬の日", Count: 8}}, nil
+			}
+			return []models.TagUsage{{TagID: tagID, Name: "柴犬の日", Count: 3}}, nil
+		},
+	}
+	usecase := NewTagUsecase(mockRepo, nil)
+	usecase.now = func() time.Time { return now }
+
+	trending, err := usecase.GetTrending(10)
+	require.NoError(t, err)
+	require.Len(t, trending, 1)
+	assert.Equal(t, models.TrendingTag{Name: "柴犬の日", PostsCount: 8, PreviousCount: 3, Score: 2.5}, trending[0])
+	assert.Equal(t, [][2]time.Time{
+		{now.Add(-TrendingWindow), now},
+		{now.Add(-2 * TrendingWindow), now.Add(-TrendingWindow)},
+	}, windows)
+}
+
+func TestTagUsecase_BackfillTags(t *testing.T) {
+	createdAt := time.Now().Add(-30 * 24 * time.Hour)
+	posts := make([]*ent.Post, tagBackfillBatchSize+1)
+	for i := range posts {
+		posts[i] = &ent.Post{ID: uuid.New(), Caption: "caption", CreatedAt: createdAt}
+	}
+	posts[0].Caption = "#Shiba と散歩 #柴犬の日"
+
+	t.Run("Success", func(t *testing.T) {
+		tagged := map[uuid.UUID][]string{}
+		mockRepo := &mock.MockPostRepository{
+			ListCaptionsFunc: func(afterID uuid.UUID, limit int) ([]*ent.Post, error) {
+				start := 0
+				for i, post := range posts {
+					if post.ID == afterID {
+						start = i + 1
+					}
+				}
+				end := min(start+limit, len(posts))
+				return posts[start:end], nil
+			},
+			SetTagsFunc: func(postID uuid.UUID, tags []string, taggedAt time.Time) error {
+				assert.Equal(t, createdAt, taggedAt, "tags are dated at the creation of the post")
+				tagged[postID] = tags
+				return nil
+			},
+		}
+		usecase := NewTagUsecase(nil, mockRepo)
+
+		count, err := usecase.BackfillTags()
+		require.NoError(t, err)
+		assert.Equal(t, len(posts), count)
+		assert.Len(t, tagged, len(posts))
+		assert.Equal(t, []string{"shiba", "柴犬の日"}, tagged[posts[0].ID])
+		assert.Equal(t, []string{}, tagged[posts[1].ID])
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockRepo := &mock.MockPostRepository{
+			ListCaptionsFunc: func(afterID uuid.UUID, limit int) ([]*ent.Post, error) {
+				return posts[:2], nil
+			},
+			SetTagsFunc: func(postID uuid.UUID, tags []string, taggedAt time.Time) error {
+				if postID == posts[1].ID {
+					return errors.New("database error")
+				}
+				return nil
+			},
+		}
+		usecase := NewTagUsecase(nil, mockRepo)
+
+		count, err := usecase.BackfillTags()
+		assert.Error(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	fallbackFollowsLimit = 30
+	fallbackPopularLimit = 30
+	fallbackPopularSince = 7 * 24 * time.Hour
+)
+
+type TimelineUsecase struct {
+	recommendationRepository repository.RecommendationRepository
+	postRepository           repository.PostRepository
+	cacheUsecase             CacheUsecase
+}
+
+func NewTimelineUsecase(recommendationRepository repository.RecommendationRepository, postRepository repository.PostRepository, cacheUsecase CacheUsecase) *TimelineUsecase {
+	return &TimelineUsecase{
+		recommendationRepository: recommendationRepository,
+		postRepository:           postRepository,
+		cacheUsecase:             cacheUsecase,
+	}
+}
+
+// GetTimeline ranks a new recommended timeline for the user and returns its first page and the
+// cursor of the next one, which is empty on the last page. The ranking comes from the algorithm
+// service. When the service is unavailable it falls back to recent posts by followed users mixed
+// with popular posts, so the timeline keeps working. The ranked IDs are cached for GetTimelinePage.
+// ctx bounds the call to the algorithm service, which has its own time budget as well.
+func (u *TimelineUsecase) GetTimeline(ctx context.Context, userID uuid.UUID, limit int) ([]*ent.Post, models.TimelineSource, string, error) {
+	source := models.TimelineSourceAlgorithm
+	ids, err := u.recommendationRepository.Timeline(ctx, userID)
+	if err != nil {
+		log.Warnf("Algorithm service unavailable, using fallback timeline: %v", err)
+		source = models.TimelineSourceFallback
+		posts, err := u.fallbackTimeline(userID)
+		if err != nil {
+			return nil, "", "", err
+		}
+		ids = make([]uuid.UUID, len(posts))
+		for i, post := range posts {
+			ids[i] = post.ID
+		}
+	}
+
+	// キャッシュに失敗しても最初のページは返せるので、ログだけ残す
+	if err := u.cacheUsecase.StorePostIDs(userID, ids); err != nil {
+		log.Errorf("Failed to cache timeline for user %s: %v", userID, err)
+	}
+	posts, next, err := u.loadPage(userID, ids, 0, limit)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return posts, source, next, nil
+}
+
+// GetTimelinePage returns the page after the cursor of the timeline ranked by GetTimeline.
+// An expired timeline returns no posts; the client then fetches a new one.
+func (u *TimelineUsecase) GetTimelinePage(userID uuid.UUID, cursor uuid.UUID, limit int) ([]*ent.Post, string, error) {
+	ids, err := u.cacheUsecase.GetPostIDs(userID)
+	if err != nil {
+		return nil, "", err
+	}
+	for i, id := range ids {
+		if id == cursor {
+			return u.loadPage(userID, ids, i+1, limit)
+		}
+	}
+	return []*ent.Post{}, "", nil
+}
+
+// loadPage loads up to limit posts from ids[start:] in ranking order. Posts the user can no
+// longer see, e.g. after a block, are skipped and replaced by later ones. The cursor is the
+// last ID looked at, so skipped posts do not end the timeline early.
+func (u *TimelineUsecase) loadPage(userID uuid.UUID, ids []uuid.UUID, start, limit int) ([]*ent.Post, string, error) {
+	posts := make([]*ent.Post, 0, limit)
+	for start < len(ids) && len(posts) < limit {
+		end := min(start+limit-len(posts), len(ids))
+		chunk := ids[start:end]
+		found, err := u.postRepository.GetByIds(userID, chunk)
+		if err != nil {
+			return nil, "", err
+		}
+		posts = append(posts, orderByIDs(found, chunk)...)
+		start = end
+	}
+	if start >= len(ids) {
+		return posts, "", nil
+	}
+	return posts, ids[start-1].String(), nil
+}
+
+func (u *TimelineUsecase) fallbackTimeline(userID uuid.UUID) ([]*ent.Post, error) {
+	follows, err := u.postRepository.GetFollowsPosts(userID, nil, fallbackFollowsLimit)
+	if err != nil {
+		return nil, err
+	}
+	popular, err := u.postRepository.GetPopularPosts(userID, time.Now().Add(-fallbackPopularSince), fallbackPopularLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// フォロー中の投稿と人気の投稿を交互に並べ、重複は除く
+	seen := make(map[uuid.UUID]bool, len(follows)+len(popular))
+	posts := make([]*ent.Post, 0, len(follows)+len(popular))
+	for i := 0; i < max(len(follows), len(popular)); i++ {
+		for _, list := range [][]*ent.Post{follows, popular} {
+			if i < len(list) && !seen[list[i].ID] {
+				seen[list[i].ID] = true
+				posts = append(posts, list[i])
+			}
+		}
+	}
+	return posts, nil
+}
+
+// orderByIDs sorts the posts in the order of ids. IDs without a post, e.g. deleted ones, are skipped.
+func orderByIDs(posts []*ent.Post, ids []uuid.UUID) []*ent.Post {
+	byID := make(map[uuid.UUID]*ent.Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+	ordered := make([]*ent.Post, 0, len(posts))
+	for _, id := range ids {
+		if post, ok := byID[id]; ok {
+			ordered = append(ordered, post)
+			delete(byID, id)
+		}
+	}
+	return ordered
+}
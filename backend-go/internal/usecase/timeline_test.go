@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineUsecase_GetTimeline(t *testing.T) {
+	userID := uuid.New()
+	a := &ent.Post{ID: uuid.New()}
+	b := &ent.Post{ID: uuid.New()}
+	c := &ent.Post{ID: uuid.New()}
+	d := &ent.Post{ID: uuid.New()}
+
+	testCases := []struct {
+		name           string
+		recommended    []uuid.UUID
+		algorithmError error
+		byIds          []*ent.Post
+		follows        []*ent.Post
+		popular        []*ent.Post
+		expectedPosts  []*ent.Post
+		expectedSource models.TimelineSource
+	}{
+		{
+			name:        "Algorithm ranking is kept",
+			recommended: []uuid.UUID{c.ID, a.ID, uuid.New(), b.ID},
+			// The database returns them in its own order and without deleted posts
+			byIds:          []*ent.Post{a, b, c},
+			expectedPosts:  []*ent.Post{c, a, b},
+			expectedSource: models.TimelineSourceAlgorithm,
+		},
+		{
+			name:           "Fallback mixes follows and popular posts",
+			algorithmError: errors.New("circuit breaker is open"),
+			follows:        []*ent.Post{a, b, c},
+			byIds:          []*ent.Post{a, b, c, d},
+			popular:        []*ent.Post{b, d},
+			expectedPosts:  []*ent.Post{a, b, d, c},
+			expectedSource: models.TimelineSourceFallback,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRecommendationRepo := &mock.MockRecommendationRepository{
+				TimelineFunc: func(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+					assert.Equal(t, userID, id)
+					return tc.recommended, tc.algorithmError
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdsFunc: func(viewerID uuid.UUID, postIds []uuid.UUID) ([]*ent.Post, error) {
+					if tc.algorithmError == nil {
+						assert.Equal(t, tc.recommended, postIds)
+					}
+					return tc.byIds, nil
+				},
+				GetFollowsPostsFunc: func(id uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+					assert.Nil(t, cursor)
+					return tc.follows, nil
+				},
+				GetPopularPostsFunc: func(viewerID uuid.UUID, since time.Time, limit int) ([]*ent.Post, error) {
+					assert.True(t, since.Before(time.Now()))
+					return tc.popular, nil
+				},
+			}
+
+			var cached []uuid.UUID
+			mockCacheRepo := &mock.MockTimelineCacheRepository{
+				StoreFunc: func(id uuid.UUID, postIDs []uuid.UUID, ttl time.Duration) error {
+					cached = postIDs
+					return nil
+				},
+			}
+
+			usecase := NewTimelineUsecase(mockRecommendationRepo, mockPostRepo, NewCacheUsecase(mockCacheRepo, DefaultTimelineCacheTTL))
+			posts, source, nextCursor, err := usecase.GetTimeline(context.Background(), userID, 10)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPosts, posts)
+			assert.Equal(t, tc.expectedSource, source)
+			assert.Empty(t, nextCursor)
+			assert.Len(t, cached, max(len(tc.recommended), len(tc.expectedPosts)))
+		})
+	}
+}
+
+func TestTimelineUsecase_GetTimelinePage(t *testing.T) {
+	userID := uuid.New()
+	posts := make([]*ent.Post, 6)
+	ids := make([]uuid.UUID, len(posts))
+	for i := range posts {
+		posts[i] = &ent.Post{ID: uuid.New()}
+		ids[i] = posts[i].ID
+	}
+
+	testCases := []struct {
+		name           string
+		cached         []uuid.UUID
+		hidden         map[uuid.UUID]bool
+		cursor         uuid.UUID
+		expectedPosts  []*ent.Post
+		expectedCursor string
+	}{
+		{
+			name:           "Returns the page after the cursor",
+			cached:         ids,
+			cursor:         ids[0],
+			expectedPosts:  posts[1:3],
+			expectedCursor: ids[2].String(),
+		},
+		{
+			name:           "Fills the page when posts are no longer visible",
+			cached:         ids,
+			hidden:         map[uuid.UUID]bool{ids[2]: true, ids[3]: true},
+			cursor:         ids[0],
+			expectedPosts:  []*ent.Post{posts[1], posts[4]},
+			expectedCursor: ids[4].String(),
+		},
+		{
+			name:           "Last page has no cursor",
+			cached:         ids,
+			cursor:         ids[3],
+			expectedPosts:  posts[4:],
+			expectedCursor: "",
+		},
+		{
+			name:           "Expired timeline returns no posts",
+			cached:         nil,
+			cursor:         ids[0],
+			expectedPosts:  []*ent.Post{},
+			expectedCursor: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCacheRepo := &mock.MockTimelineCacheRepository{
+				GetFunc: func(id uuid.UUID) ([]uuid.UUID, error) {
+					assert.Equal(t, userID, id)
+					return tc.cached, nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetByIdsFunc: func(viewerID uuid.UUID, postIds []uuid.UUID) ([]*ent.Post, error) {
+					found := []*ent.Post{}
+					for _, post := range posts {
+						for _, id := range postIds {
+							if post.ID == id && !tc.hidden[id] {
+								found = append(found, post)
+							}
+						}
+					}
+					return found, nil
+				},
+			}
+
+			usecase := NewTimelineUsecase(&mock.MockRecommendationRepository{}, mockPostRepo, NewCacheUsecase(mockCacheRepo, DefaultTimelineCacheTTL))
+			page, nextCursor, err := usecase.GetTimelinePage(userID, tc.cursor, 2)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPosts, page)
+			assert.Equal(t, tc.expectedCursor, nextCursor)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"fmt"
+
 	"github.com/aki-13627/animalia/backend-go/ent"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository"
@@ -8,6 +10,9 @@ import (
 	"github.com/labstack/gommon/log"
 )
 
+// profilePostsLimit is the number of posts included in a profile.
+const profilePostsLimit = 30
+
 type UserUsecase struct {
 	userRepository           repository.UserRepository
 	storageRepository        repository.StorageRepository
@@ -15,6 +20,7 @@ type UserUsecase struct {
 	petRepository            repository.PetRepository
 	followRelationRepository repository.FollowRelationRepository
 	blockRelationRepository  repository.BlockRelationRepository
+	followRequestRepository  repository.FollowRequestRepository
 }
 
 func NewUserUsecase(
@@ -23,7 +29,8 @@ func NewUserUsecase(
 	postRepository repository.PostRepository,
 	petRepository repository.PetRepository,
 	followRelationRepository repository.FollowRelationRepository,
-	blockRelationRepository repository.BlockRelationRepository) *UserUsecase {
+	blockRelationRepository repository.BlockRelationRepository,
+	followRequestRepository repository.FollowRequestRepository) *UserUsecase {
 	return &UserUsecase{
 		userRepository:           userRepository,
 		storageRepository:        storageRepository,
@@ -31,11 +38,50 @@ func NewUserUsecase(
 		petRepository:            petRepository,
 		followRelationRepository: followRelationRepository,
 		blockRelationRepository:  blockRelationRepository,
+		followRequestRepository:  followRequestRepository,
 	}
 }
 
 func (u *UserUsecase) CreateUser(name, email string) (*ent.User, error) {
-	return u.userRepository.Create(name, email)
+	handle, err := u.newHandle(name)
+	if err != nil {
+		return nil, err
+	}
+	return u.userRepository.Create(name, email, handle)
+}
+
+// UpdateHandle changes the handle of the user. Handles are case-insensitive and stored in lower case.
+func (u *UserUsecase) UpdateHandle(id uuid.UUID, handle string) (string, error) {
+	handle = normalizeHandle(handle)
+	if !validHandle(handle) {
+		return "", ErrInvalidHandle
+	}
+	err := u.userRepository.UpdateHandle(id, handle)
+	if ent.IsConstraintError(err) {
+		return "", ErrHandleTaken
+	}
+	if err != nil {
+		return "", err
+	}
+	return handle, nil
+}
+
+// BackfillHandles gives a handle to every user created before handles existed.
+func (u *UserUsecase) BackfillHandles() (int, error) {
+	users, err := u.userRepository.GetWithoutHandle()
+	if err != nil {
+		return 0, err
+	}
+	for i, user := range users {
+		handle, err := u.newHandle(user.Name)
+		if err != nil {
+			return i, err
+		}
+		if err := u.userRepository.UpdateHandle(user.ID, handle); err != nil {
+			return i, fmt.Errorf("failed to set handle of user %s: %w", user.ID, err)
+		}
+	}
+	return len(users), nil
 }
 
 func (u *UserUsecase) Update(id string, name string, description string, newImageKey string) error {
@@ -70,12 +116,131 @@ func (u *UserUsecase) FindByEmail(email string) (*ent.User, error) {
 	return u.userRepository.FindByEmail(email)
 }
 
-func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
+// GetMe returns the profile of the user with the email as seen by that user.
+func (u *UserUsecase) GetMe(email string) (models.UserResponse, error) {
 	user, err := u.userRepository.FindByEmail(email)
 	if err != nil {
 		return models.UserResponse{}, err
 	}
+	response, err := u.newUserResponse(user, user.ID)
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+	response.Email = user.Email
+	return response, nil
+}
 
+// GetProfile returns the profile of the user with the ID as seen by the viewer.
+// Viewers blocked by the user get ErrBlocked. Viewers blocking the user still get the
+// profile so they can unblock, but without posts. Private profiles show posts, pets and
+// follow lists only to approved followers; the counts are always shown.
+func (u *UserUsecase) GetProfile(viewerID uuid.UUID, id uuid.UUID) (models.UserResponse, error) {
+	user, err := u.userRepository.FindById(id)
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+	return u.profileFor(viewerID, user)
+}
+
+// GetProfileByHandle returns the profile of the user with the handle as seen by the viewer. See GetProfile.
+func (u *UserUsecase) GetProfileByHandle(viewerID uuid.UUID, handle string) (models.UserResponse, error) {
+	user, err := u.userRepository.FindByHandle(normalizeHandle(handle))
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+	return u.profileFor(viewerID, user)
+}
+
+func (u *UserUsecase) profileFor(viewerID uuid.UUID, user *ent.User) (models.UserResponse, error) {
+	if user.ID == viewerID {
+		return u.newUserResponse(user, viewerID)
+	}
+
+	blockedBy, err := u.blockRelationRepository.Exists(user.ID, viewerID)
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+	if blockedBy {
+		return models.UserResponse{}, ErrBlocked
+	}
+	response, err := u.newUserResponse(user, viewerID)
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+	blocking, err := u.blockRelationRepository.Exists(viewerID, user.ID)
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+	response.IsBlocking = blocking
+	if blocking {
+		response.Posts = []models.PostResponse{}
+	}
+
+	response.FollowStatus, err = u.followStatus(user, viewerID)
+	if err != nil {
+		return models.UserResponse{}, err
+	}
+	if user.IsPrivate && response.FollowStatus != models.FollowStatusFollowing {
+		response.Posts = []models.PostResponse{}
+		response.Pets = []models.PetResponse{}
+	}
+	return response, nil
+}
+
+// ListPosts returns one page of the posts of the user after the first page included in the
+// profile, and the cursor of the next page. Access is checked as for the profile.
+func (u *UserUsecase) ListPosts(viewerID, userID uuid.UUID, cursor *models.Cursor, limit int) ([]models.PostResponse, string, error) {
+	if err := u.checkProfileAccess(viewerID, userID); err != nil {
+		return nil, "", err
+	}
+	blocking, err := u.blockRelationRepository.Exists(viewerID, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if blocking {
+		return []models.PostResponse{}, "", nil
+	}
+
+	posts, err := u.postRepository.GetPostsByUser(userID, viewerID, cursor, limit+1)
+	if err != nil {
+		log.Errorf("Failed to get posts by user: %v", err)
+		return nil, "", err
+	}
+	posts, next := trimPage(posts, limit, postKey)
+	iconURL := ""
+	if len(posts) > 0 && posts[0].Edges.User != nil && posts[0].Edges.User.IconImageKey != "" {
+		iconURL, err = u.storageRepository.GetUrl(posts[0].Edges.User.IconImageKey)
+		if err != nil {
+			log.Errorf("Failed to get url: %v", err)
+			return nil, "", err
+		}
+	}
+	responses, err := u.postResponses(viewerID, posts, iconURL)
+	if err != nil {
+		return nil, "", err
+	}
+	return responses, next, nil
+}
+
+func (u *UserUsecase) followStatus(user *ent.User, viewerID uuid.UUID) (models.FollowStatus, error) {
+	following, err := u.followRelationRepository.IsFollowing(viewerID, user.ID)
+	if err != nil {
+		return "", err
+	}
+	if following {
+		return models.FollowStatusFollowing, nil
+	}
+	requested, err := u.followRequestRepository.Exists(viewerID, user.ID)
+	if err != nil {
+		return "", err
+	}
+	if requested {
+		return models.FollowStatusRequested, nil
+	}
+	return models.FollowStatusNone, nil
+}
+
+func (u *UserUsecase) newUserResponse(user *ent.User, viewerID uuid.UUID) (models.UserResponse, error) {
 	iconURL := ""
 	if user.IconImageKey != "" {
 		url, err := u.storageRepository.GetUrl(user.IconImageKey)
@@ -86,44 +251,16 @@ func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
 		iconURL = url
 	}
 
-	posts, err := u.postRepository.GetPostsByUser(user.ID)
+	// 最初のページだけをプロフィールに含め、続きは /users/:id/posts で取得する
+	posts, err := u.postRepository.GetPostsByUser(user.ID, viewerID, nil, profilePostsLimit+1)
 	if err != nil {
 		log.Errorf("Failed to get posts by user: %v", err)
 		return models.UserResponse{}, err
 	}
-	postResponses := make([]models.PostResponse, len(posts))
-	for i, post := range posts {
-		imageURL, err := u.storageRepository.GetUrl(post.ImageKey)
-		if err != nil {
-			log.Errorf("Failed to get url: %v", err)
-			return models.UserResponse{}, err
-		}
-
-		commentResponses := make([]models.CommentResponse, len(post.Edges.Comments))
-		for j, comment := range post.Edges.Comments {
-			commentUserImageURL := ""
-			if comment.Edges.User.IconImageKey != "" {
-				commentUserImageURL, err = u.storageRepository.GetUrl(comment.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get comment user url: %v", err)
-					return models.UserResponse{}, err
-				}
-			}
-			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, commentUserImageURL)
-		}
-		likeResponses := make([]models.LikeResponse, len(post.Edges.Likes))
-		for j, like := range post.Edges.Likes {
-			likeUserImageURL := ""
-			if like.Edges.User.IconImageKey != "" {
-				likeUserImageURL, err = u.storageRepository.GetUrl(like.Edges.User.IconImageKey)
-				if err != nil {
-					log.Errorf("Failed to get like user url: %v", err)
-					return models.UserResponse{}, err
-				}
-			}
-			likeResponses[j] = models.NewLikeResponse(like, likeUserImageURL)
-		}
-		postResponses[i] = models.NewPostResponse(post, imageURL, iconURL, commentResponses, likeResponses)
+	posts, postsNextCursor := trimPage(posts, profilePostsLimit, postKey)
+	postResponses, err := u.postResponses(viewerID, posts, iconURL)
+	if err != nil {
+		return models.UserResponse{}, err
 	}
 
 	pets, err := u.petRepository.GetByOwner(user.ID.String())
@@ -140,75 +277,54 @@ func (u *UserUsecase) GetByEmail(email string) (models.UserResponse, error) {
 		petResponses[i] = models.NewPetResponse(pet, imageURL)
 	}
 
-	followers := make([]models.UserBaseResponse, 0)
-	for _, followersRelation := range user.Edges.Followers {
-		follower := followersRelation.Edges.From
+	dailyTask := user.Edges.DailyTasks[0]
+	dailyTaskResoponse := models.NewDailyTaskResponse(dailyTask)
 
-		imageUrl := ""
-		if follower.IconImageKey != "" {
-			url, err := u.storageRepository.GetUrl(follower.IconImageKey)
-			if err != nil {
-				log.Warnf("Failed to get icon URL for follower %s: %v", follower.Name, err)
-			} else {
-				imageUrl = url
-			}
-		}
+	userResponse := models.NewUserResponse(user, iconURL, postResponses, petResponses, dailyTaskResoponse)
+	userResponse.PostsNextCursor = postsNextCursor
+	return userResponse, nil
+}
 
-		followers = append(followers, models.NewUserBaseResponse(follower, imageUrl))
+// postResponses builds the responses of posts by one user whose icon URL is already known.
+func (u *UserUsecase) postResponses(viewerID uuid.UUID, posts []*ent.Post, iconURL string) ([]models.PostResponse, error) {
+	ids := make([]uuid.UUID, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
 	}
-
-	follows := make([]models.UserBaseResponse, 0)
-	for _, followsRelation := range user.Edges.Following {
-		follow := followsRelation.Edges.To
-
-		imageUrl := ""
-		if follow.IconImageKey != "" {
-			url, err := u.storageRepository.GetUrl(follow.IconImageKey)
-			if err != nil {
-				log.Warnf("Failed to get icon URL for follow %s: %v", follow.Name, err)
-			} else {
-				imageUrl = url
-			}
-		}
-
-		follows = append(follows, models.NewUserBaseResponse(follow, imageUrl))
+	stats, err := u.postRepository.GetStats(viewerID, ids, recentCommentsLimit)
+	if err != nil {
+		return nil, err
 	}
 
-	blockingUsers := make([]models.UserBaseResponse, 0)
-	for _, blockingRelation := range user.Edges.Blocking {
-		blockingUser := blockingRelation.Edges.To
-		imageUrl := ""
-		if blockingUser.IconImageKey != "" {
-			url, err := u.storageRepository.GetUrl(blockingUser.IconImageKey)
-			if err != nil {
-				log.Warnf("Failed to get icon URL for blocking user %s: %v", blockingUser.Name, err)
-			} else {
-				imageUrl = url
-			}
+	postResponses := make([]models.PostResponse, len(posts))
+	for i, post := range posts {
+		imageURLs, err := mediaURLs(u.storageRepository, post)
+		if err != nil {
+			log.Errorf("Failed to get url: %v", err)
+			return nil, err
+		}
+		petImageURLs, err := petImageURLs(u.storageRepository, post)
+		if err != nil {
+			log.Errorf("Failed to get pet url: %v", err)
+			return nil, err
 		}
-		blockingUsers = append(blockingUsers, models.NewUserBaseResponse(blockingUser, imageUrl))
-	}
-
-	blockedByUsers := make([]models.UserBaseResponse, 0)
-	for _, blockedByRelation := range user.Edges.BlockedBy {
-		blockedByUser := blockedByRelation.Edges.From
-		imageUrl := ""
-		if blockedByUser.IconImageKey != "" {
-			url, err := u.storageRepository.GetUrl(blockedByUser.IconImageKey)
-			if err != nil {
-				log.Warnf("Failed to get icon URL for blocked by user %s: %v", blockedByUser.Name, err)
-			} else {
-				imageUrl = url
+
+		recentComments := stats[post.ID].RecentComments
+		commentResponses := make([]models.CommentResponse, len(recentComments))
+		for j, comment := range recentComments {
+			commentUserImageURL := ""
+			if comment.Edges.User.IconImageKey != "" {
+				commentUserImageURL, err = u.storageRepository.GetUrl(comment.Edges.User.IconImageKey)
+				if err != nil {
+					log.Errorf("Failed to get comment user url: %v", err)
+					return nil, err
+				}
 			}
+			commentResponses[j] = models.NewCommentResponse(comment, comment.Edges.User, commentUserImageURL, stats[post.ID].CommentStats[comment.ID])
 		}
-		blockedByUsers = append(blockedByUsers, models.NewUserBaseResponse(blockedByUser, imageUrl))
+		postResponses[i] = models.NewPostResponse(post, imageURLs, iconURL, petImageURLs, stats[post.ID], commentResponses)
 	}
-
-	dailyTask := user.Edges.DailyTasks[0]
-	dailyTaskResoponse := models.NewDailyTaskResponse(dailyTask)
-
-	userResponse := models.NewUserResponse(user, iconURL, postResponses, petResponses, followers, follows, blockingUsers, blockedByUsers, dailyTaskResoponse)
-	return userResponse, nil
+	return postResponses, nil
 }
 
 func (u *UserUsecase) Delete(id string) error {
@@ -219,8 +335,63 @@ func (u *UserUsecase) Delete(id string) error {
 	return u.userRepository.Delete(userUUID)
 }
 
-func (u *UserUsecase) Follow(toId string, fromId string) error {
-	return u.followRelationRepository.Follow(toId, fromId)
+// Follow follows a public user right away. For a private user a follow request is
+// created instead, which the user has to approve.
+func (u *UserUsecase) Follow(toId string, fromId string) (models.FollowStatus, error) {
+	toUUID, err := uuid.Parse(toId)
+	if err != nil {
+		return "", err
+	}
+	fromUUID, err := uuid.Parse(fromId)
+	if err != nil {
+		return "", err
+	}
+	blocked, err := u.blockRelationRepository.IsBlockedBetween(fromUUID, toUUID)
+	if err != nil {
+		return "", err
+	}
+	if blocked {
+		return "", ErrBlocked
+	}
+
+	target, err := u.userRepository.GetById(toUUID)
+	if err != nil {
+		return "", err
+	}
+	if target.IsPrivate {
+		following, err := u.followRelationRepository.IsFollowing(fromUUID, toUUID)
+		if err != nil {
+			return "", err
+		}
+		if following {
+			return models.FollowStatusFollowing, nil
+		}
+		if err := u.followRequestRepository.Create(fromUUID, toUUID); err != nil {
+			return "", err
+		}
+		return models.FollowStatusRequested, nil
+	}
+
+	if err := u.followRelationRepository.Follow(toId, fromId); err != nil {
+		return "", err
+	}
+	return models.FollowStatusFollowing, nil
+}
+
+// SetPrivate changes the privacy of the account. Making it public approves every pending request.
+func (u *UserUsecase) SetPrivate(id uuid.UUID, private bool) error {
+	if err := u.userRepository.SetPrivate(id, private); err != nil {
+		return err
+	}
+	if private {
+		return nil
+	}
+	approved, err := u.followRequestRepository.ApproveAll(id)
+	if err != nil {
+		return err
+	}
+	log.Infof("Approved %d pending follow requests for user %s", approved, id)
+	return nil
 }
 
 func (u *UserUsecase) Unfollow(toId string, fromId string) error {
@@ -240,7 +411,20 @@ func (u *UserUsecase) Block(fromId, toId string) error {
 		return err
 	}
 
-	// 3. ブロック作成
+	// 3. 保留中のフォローリクエストも双方向で削除
+	fromUUID, err := uuid.Parse(fromId)
+	if err != nil {
+		return err
+	}
+	toUUID, err := uuid.Parse(toId)
+	if err != nil {
+		return err
+	}
+	if err := u.followRequestRepository.DeleteBetween(fromUUID, toUUID); err != nil {
+		return err
+	}
+
+	// 4. ブロック作成
 	return u.blockRelationRepository.Create(fromId, toId)
 }
 
@@ -3,7 +3,10 @@ package usecase
 import (
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/aki-13627/animalia/backend-go/ent"
+	"github.com/aki-13627/animalia/backend-go/internal/domain/models"
 	"github.com/aki-13627/animalia/backend-go/internal/domain/repository/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -41,7 +44,7 @@ func TestUserUsecase_Delete(t *testing.T) {
 			mockPostRepo := &mock.MockPostRepository{}
 			mockPetRepo := &mock.MockPetRepository{}
 
-			usecase := NewUserUsecase(mockUserRepo, mockStorageRepo, mockPostRepo, mockPetRepo, nil, nil)
+			usecase := NewUserUsecase(mockUserRepo, mockStorageRepo, mockPostRepo, mockPetRepo, nil, nil, nil)
 			err := usecase.Delete(tc.id)
 			if tc.expectedError != nil {
 				assert.Equal(t, tc.expectedError, err)
@@ -51,3 +54,435 @@ func TestUserUsecase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestUserUsecase_Follow(t *testing.T) {
+	testCases := []struct {
+		name           string
+		blocked        bool
+		private        bool
+		following      bool
+		expectFollow   bool
+		expectRequest  bool
+		expectedStatus models.FollowStatus
+		expectedError  error
+	}{
+		{
+			name:           "Success",
+			blocked:        false,
+			expectFollow:   true,
+			expectedStatus: models.FollowStatusFollowing,
+		},
+		{
+			name:          "Blocked",
+			blocked:       true,
+			expectFollow:  false,
+			expectedError: ErrBlocked,
+		},
+		{
+			name:           "Private account",
+			private:        true,
+			expectRequest:  true,
+			expectedStatus: models.FollowStatusRequested,
+		},
+		{
+			name:           "Private account already followed",
+			private:        true,
+			following:      true,
+			expectedStatus: models.FollowStatusFollowing,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fromID := uuid.New()
+			toID := uuid.New()
+			followed := false
+			requested := false
+
+			mockUserRepo := &mock.MockUserRepository{
+				GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					assert.Equal(t, toID, id)
+					return &ent.User{ID: id, IsPrivate: tc.private}, nil
+				},
+			}
+			mockFollowRepo := &mock.MockFollowRelationRepository{
+				FollowFunc: func(toId string, fromId string) error {
+					followed = true
+					assert.Equal(t, toID.String(), toId)
+					assert.Equal(t, fromID.String(), fromId)
+					return nil
+				},
+				IsFollowingFunc: func(from, to uuid.UUID) (bool, error) {
+					assert.Equal(t, fromID, from)
+					assert.Equal(t, toID, to)
+					return tc.following, nil
+				},
+			}
+			mockRequestRepo := &mock.MockFollowRequestRepository{
+				CreateFunc: func(from, to uuid.UUID) error {
+					requested = true
+					assert.Equal(t, fromID, from)
+					assert.Equal(t, toID, to)
+					return nil
+				},
+			}
+			mockBlockRepo := &mock.MockBlockRelationRepository{
+				IsBlockedBetweenFunc: func(userID, otherID uuid.UUID) (bool, error) {
+					assert.Equal(t, fromID, userID)
+					assert.Equal(t, toID, otherID)
+					return tc.blocked, nil
+				},
+			}
+
+			usecase := NewUserUsecase(mockUserRepo, &mock.MockStorageRepository{}, &mock.MockPostRepository{}, &mock.MockPetRepository{}, mockFollowRepo, mockBlockRepo, mockRequestRepo)
+			status, err := usecase.Follow(toID.String(), fromID.String())
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectFollow, followed)
+			assert.Equal(t, tc.expectRequest, requested)
+		})
+	}
+}
+
+func TestUserUsecase_GetProfileBlocked(t *testing.T) {
+	viewerID := uuid.New()
+	target := &ent.User{ID: uuid.New(), Email: "target@example.com"}
+
+	// GetPostsByUserFunc is nil: nothing about the profile is loaded
+	mockUserRepo := &mock.MockUserRepository{
+		FindByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+			assert.Equal(t, target.ID, id)
+			return target, nil
+		},
+	}
+	mockBlockRepo := &mock.MockBlockRelationRepository{
+		ExistsFunc: func(fromID, toID uuid.UUID) (bool, error) {
+			// The target blocks the viewer
+			return fromID == target.ID && toID == viewerID, nil
+		},
+	}
+
+	usecase := NewUserUsecase(mockUserRepo, &mock.MockStorageRepository{}, &mock.MockPostRepository{}, &mock.MockPetRepository{}, nil, mockBlockRepo, nil)
+	_, err := usecase.GetProfile(viewerID, target.ID)
+
+	assert.ErrorIs(t, err, ErrBlocked)
+}
+
+func TestUserUsecase_GetProfilePrivate(t *testing.T) {
+	viewerID := uuid.New()
+
+	testCases := []struct {
+		name           string
+		viewerFollows  bool
+		requested      bool
+		expectedStatus models.FollowStatus
+		expectProfile  bool
+	}{
+		{
+			name:           "Not following",
+			expectedStatus: models.FollowStatusNone,
+		},
+		{
+			name:           "Requested",
+			requested:      true,
+			expectedStatus: models.FollowStatusRequested,
+		},
+		{
+			name:           "Approved follower",
+			viewerFollows:  true,
+			expectedStatus: models.FollowStatusFollowing,
+			expectProfile:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			followersCount := 1
+			if tc.viewerFollows {
+				followersCount++
+			}
+			target := &ent.User{
+				ID:            uuid.New(),
+				Email:         "private@example.com",
+				IsPrivate:     true,
+				FollowerCount: followersCount,
+				Edges: ent.UserEdges{
+					DailyTasks: []*ent.DailyTask{{ID: uuid.New()}},
+				},
+			}
+
+			mockUserRepo := &mock.MockUserRepository{
+				FindByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+					return target, nil
+				},
+			}
+			mockPostRepo := &mock.MockPostRepository{
+				GetPostsByUserFunc: func(userId uuid.UUID, viewer uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+					assert.Equal(t, viewerID, viewer)
+					return []*ent.Post{}, nil
+				},
+				GetStatsFunc: func(viewer uuid.UUID, postIds []uuid.UUID, previewLimit int) (map[uuid.UUID]models.PostStats, error) {
+					return map[uuid.UUID]models.PostStats{}, nil
+				},
+			}
+			mockPetRepo := &mock.MockPetRepository{
+				GetByOwnerFunc: func(ownerID string) ([]*ent.Pet, error) {
+					return []*ent.Pet{{ID: uuid.New(), ImageKey: "pets/1"}}, nil
+				},
+			}
+			mockStorageRepo := &mock.MockStorageRepository{
+				GetUrlFunc: func(fileKey string) (string, error) {
+					return "https://example.com/" + fileKey, nil
+				},
+			}
+			mockBlockRepo := &mock.MockBlockRelationRepository{
+				ExistsFunc: func(fromID, toID uuid.UUID) (bool, error) {
+					return false, nil
+				},
+				BlockedUserIDsFunc: func(userID uuid.UUID) ([]uuid.UUID, error) {
+					return nil, nil
+				},
+			}
+			mockRequestRepo := &mock.MockFollowRequestRepository{
+				ExistsFunc: func(fromID, toID uuid.UUID) (bool, error) {
+					assert.Equal(t, viewerID, fromID)
+					assert.Equal(t, target.ID, toID)
+					return tc.requested, nil
+				},
+			}
+
+			mockFollowRepo := &mock.MockFollowRelationRepository{
+				IsFollowingFunc: func(fromID, toID uuid.UUID) (bool, error) {
+					return fromID == viewerID && toID == target.ID && tc.viewerFollows, nil
+				},
+			}
+
+			usecase := NewUserUsecase(mockUserRepo, mockStorageRepo, mockPostRepo, mockPetRepo, mockFollowRepo, mockBlockRepo, mockRequestRepo)
+			response, err := usecase.GetProfile(viewerID, target.ID)
+
+			assert.NoError(t, err)
+			assert.True(t, response.IsPrivate)
+			assert.Equal(t, tc.expectedStatus, response.FollowStatus)
+			assert.Equal(t, followersCount, response.FollowersCount)
+			if tc.expectProfile {
+				assert.Len(t, response.Pets, 1)
+			} else {
+				assert.Empty(t, response.Pets)
+			}
+		})
+	}
+}
+
+func TestHandleBase(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Taro Yamada", expected: "taro_yamada"},
+		{name: "mike.the-cat", expected: "mike_the_cat"},
+		{name: "たろう", expected: "user"},
+		{name: "ポチ99", expected: "user"},
+		{name: "ポチ123", expected: "123"},
+		{name: "A very long display name that goes on", expected: "a_very_long_display_name"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handle := handleBase(tc.name)
+			assert.Equal(t, tc.expected, handle)
+			assert.True(t, validHandle(handle))
+		})
+	}
+}
+
+func TestUserUsecase_CreateUserPicksUnusedHandle(t *testing.T) {
+	checked := []string{}
+	mockUserRepo := &mock.MockUserRepository{
+		ExistsHandleFunc: func(handle string) (bool, error) {
+			checked = append(checked, handle)
+			return handle == "taro", nil
+		},
+		CreateFunc: func(name, email, handle string) (*ent.User, error) {
+			return &ent.User{ID: uuid.New(), Name: name, Email: email, Handle: handle}, nil
+		},
+	}
+
+	usecase := NewUserUsecase(mockUserRepo, nil, nil, nil, nil, nil, nil)
+	user, err := usecase.CreateUser("Taro", "taro@example.com")
+
+	assert.NoError(t, err)
+	assert.Len(t, checked, 2)
+	assert.Regexp(t, `^taro_\d{4}$`, user.Handle)
+}
+
+func TestUserUsecase_UpdateHandle(t *testing.T) {
+	userID := uuid.New()
+
+	testCases := []struct {
+		name           string
+		handle         string
+		mockError      error
+		expectUpdate   bool
+		expectedHandle string
+		expectedError  error
+	}{
+		{
+			name:           "Success",
+			handle:         "@Mike_The_Cat",
+			expectUpdate:   true,
+			expectedHandle: "mike_the_cat",
+		},
+		{
+			name:          "Too short",
+			handle:        "ab",
+			expectedError: ErrInvalidHandle,
+		},
+		{
+			name:          "Invalid characters",
+			handle:        "みけ",
+			expectedError: ErrInvalidHandle,
+		},
+		{
+			name:          "Taken",
+			handle:        "mike",
+			mockError:     &ent.ConstraintError{},
+			expectUpdate:  true,
+			expectedError: ErrHandleTaken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := false
+			mockUserRepo := &mock.MockUserRepository{
+				UpdateHandleFunc: func(id uuid.UUID, handle string) error {
+					updated = true
+					assert.Equal(t, userID, id)
+					return tc.mockError
+				},
+			}
+
+			usecase := NewUserUsecase(mockUserRepo, nil, nil, nil, nil, nil, nil)
+			handle, err := usecase.UpdateHandle(userID, tc.handle)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedHandle, handle)
+			assert.Equal(t, tc.expectUpdate, updated)
+		})
+	}
+}
+
+func TestUserUsecase_EmailOnlyInOwnProfile(t *testing.T) {
+	user := &ent.User{
+		ID:             uuid.New(),
+		Email:          "me@example.com",
+		Handle:         "me_and_my_cat",
+		FollowerCount:  3,
+		FollowingCount: 5,
+		PostCount:      2,
+		Edges:          ent.UserEdges{DailyTasks: []*ent.DailyTask{{ID: uuid.New()}}},
+	}
+	mockUserRepo := &mock.MockUserRepository{
+		FindByEmailFunc: func(email string) (*ent.User, error) {
+			return user, nil
+		},
+		FindByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+			return user, nil
+		},
+	}
+	mockPostRepo := &mock.MockPostRepository{
+		GetPostsByUserFunc: func(userId uuid.UUID, viewerID uuid.UUID, cursor *models.Cursor, limit int) ([]*ent.Post, error) {
+			return []*ent.Post{}, nil
+		},
+		GetStatsFunc: func(viewerID uuid.UUID, postIds []uuid.UUID, previewLimit int) (map[uuid.UUID]models.PostStats, error) {
+			return map[uuid.UUID]models.PostStats{}, nil
+		},
+	}
+	mockPetRepo := &mock.MockPetRepository{
+		GetByOwnerFunc: func(ownerID string) ([]*ent.Pet, error) {
+			return []*ent.Pet{}, nil
+		},
+	}
+
+	usecase := NewUserUsecase(mockUserRepo, &mock.MockStorageRepository{}, mockPostRepo, mockPetRepo, &mock.MockFollowRelationRepository{}, nil, nil)
+
+	me, err := usecase.GetMe(user.Email)
+	assert.NoError(t, err)
+	assert.Equal(t, user.Email, me.Email)
+	assert.Equal(t, user.Handle, me.Handle)
+	assert.Equal(t, 3, me.FollowersCount)
+	assert.Equal(t, 5, me.FollowsCount)
+	assert.Equal(t, 2, me.PostsCount)
+
+	profile, err := usecase.GetProfile(user.ID, user.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, profile.Email)
+	assert.Equal(t, user.Handle, profile.Handle)
+}
+
+func TestUserUsecase_ListPosts(t *testing.T) {
+	viewerID := uuid.New()
+	author := &ent.User{ID: uuid.New(), IconImageKey: "profile/author"}
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	posts := []*ent.Post{
+		{ID: uuid.New(), ImageKey: "posts/1", CreatedAt: createdAt, Edges: ent.PostEdges{User: author}},
+		{ID: uuid.New(), ImageKey: "posts/2", CreatedAt: createdAt, Edges: ent.PostEdges{User: author}},
+	}
+	cursor := models.NewCursor(createdAt.Add(time.Hour), uuid.New())
+
+	mockUserRepo := &mock.MockUserRepository{
+		GetByIdFunc: func(id uuid.UUID) (*ent.User, error) {
+			return author, nil
+		},
+	}
+	mockBlockRepo := &mock.MockBlockRelationRepository{
+		ExistsFunc: func(fromID, toID uuid.UUID) (bool, error) {
+			return false, nil
+		},
+	}
+	mockPostRepo := &mock.MockPostRepository{
+		GetPostsByUserFunc: func(userId uuid.UUID, viewer uuid.UUID, c *models.Cursor, limit int) ([]*ent.Post, error) {
+			assert.Equal(t, author.ID, userId)
+			assert.Equal(t, cursor, c)
+			return posts[:min(limit, len(posts))], nil
+		},
+		GetStatsFunc: func(viewer uuid.UUID, postIds []uuid.UUID, previewLimit int) (map[uuid.UUID]models.PostStats, error) {
+			assert.Equal(t, viewerID, viewer)
+			assert.Equal(t, []uuid.UUID{posts[0].ID}, postIds)
+			return map[uuid.UUID]models.PostStats{
+				posts[0].ID: {
+					CommentsCount:  4,
+					LikesCount:     2,
+					LikedByMe:      true,
+					RecentComments: []*ent.Comment{{ID: uuid.New(), Content: "かわいい", Edges: ent.CommentEdges{User: author}}},
+				},
+			}, nil
+		},
+	}
+	mockStorageRepo := &mock.MockStorageRepository{
+		GetUrlFunc: func(fileKey string) (string, error) {
+			return "https://example.com/" + fileKey, nil
+		},
+	}
+
+	usecase := NewUserUsecase(mockUserRepo, mockStorageRepo, mockPostRepo, nil, nil, mockBlockRepo, nil)
+	responses, next, err := usecase.ListPosts(viewerID, author.ID, cursor, 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, responses, 1)
+	assert.Equal(t, posts[0].ID, responses[0].ID)
+	assert.Equal(t, 4, responses[0].CommentsCount)
+	assert.Equal(t, 2, responses[0].LikesCount)
+	assert.True(t, responses[0].LikedByMe)
+	assert.Len(t, responses[0].RecentComments, 1)
+	assert.Equal(t, models.NewCursor(posts[0].CreatedAt, posts[0].ID).Encode(), next)
+}
@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"time"
+)
+
+// MaxVideoSize is the largest video file accepted for a post. Lambda accepts request payloads of
+// 6 MB, and API Gateway passes the multipart body to it base64-encoded, which adds a third, so a
+// larger video would be rejected with 413 before it reaches the server.
+const MaxVideoSize = 4 << 20
+
+// MaxVideoDuration is the longest video accepted for a post.
+const MaxVideoDuration = 60 * time.Second
+
+// maxMovieBoxSize bounds the metadata read into memory. The moov box of a short clip is far smaller.
+const maxMovieBoxSize = 8 << 20
+
+// ErrInvalidVideo is returned when a video is not an MP4 or QuickTime file with a video track,
+// or is longer than MaxVideoDuration or larger than MaxVideoSize.
+var ErrInvalidVideo = errors.New("invalid video")
+
+// VideoInfo is what is read from the headers of a video file.
+type VideoInfo struct {
+	ContentType string
+	Duration    time.Duration
+	// Width and Height are the displayed dimensions, after the rotation of the track is applied.
+	Width  int
+	Height int
+}
+
+// mp4Brands maps the brands of ISO base media files we accept to their content type.
+var mp4Brands = map[string]string{
+	"isom": "video/mp4",
+	"iso2": "video/mp4",
+	"iso4": "video/mp4",
+	"iso5": "video/mp4",
+	"iso6": "video/mp4",
+	"mp41": "video/mp4",
+	"mp42": "video/mp4",
+	"avc1": "video/mp4",
+	"M4V ": "video/mp4",
+	"qt  ": "video/quicktime",
+}
+
+// inspectVideoFile checks an uploaded video. See inspectVideo.
+func inspectVideoFile(file *multipart.FileHeader) (VideoInfo, error) {
+	if file.Size > MaxVideoSize {
+		return VideoInfo{}, fmt.Errorf("%w: %d bytes exceeds %d", ErrInvalidVideo, file.Size, MaxVideoSize)
+	}
+	src, err := file.Open()
+	if err != nil {
+		return VideoInfo{}, err
+	}
+	defer src.Close()
+	return inspectVideo(src, file.Size)
+}
+
+// inspectVideo parses the ISO base media (MP4 / QuickTime) boxes of the file. It only reads the
+// ftyp and moov boxes, so the Content-Type sent by the client is never trusted.
+func inspectVideo(r io.ReaderAt, size int64) (VideoInfo, error) {
+	if size <= 0 {
+		return VideoInfo{}, fmt.Errorf("%w: empty file", ErrInvalidVideo)
+	}
+	if size > MaxVideoSize {
+		return VideoInfo{}, fmt.Errorf("%w: %d bytes exceeds %d", ErrInvalidVideo, size, MaxVideoSize)
+	}
+
+	var info VideoInfo
+	var moov []byte
+	first := true
+	for offset := int64(0); offset < size; {
+		boxType, headerSize, boxSize, err := readBoxHeader(r, offset, size)
+		if err != nil {
+			return VideoInfo{}, err
+		}
+		if first {
+			// ftyp は先頭になければならない
+			if boxType != "ftyp" {
+				return VideoInfo{}, fmt.Errorf("%w: not an MP4 or QuickTime file", ErrInvalidVideo)
+			}
+			ftyp := make([]byte, boxSize-headerSize)
+			if _, err := r.ReadAt(ftyp, offset+headerSize); err != nil {
+				return VideoInfo{}, fmt.Errorf("%w: %v", ErrInvalidVideo, err)
+			}
+			info.ContentType = contentTypeOfBrands(ftyp)
+			if info.ContentType == "" {
+				return VideoInfo{}, fmt.Errorf("%w: unsupported brand", ErrInvalidVideo)
+			}
+			first = false
+		}
+		if boxType == "moov" {
+			if boxSize-headerSize > maxMovieBoxSize {
+				return VideoInfo{}, fmt.Errorf("%w: moov box too large", ErrInvalidVideo)
+			}
+			moov = make([]byte, boxSize-headerSize)
+			if _, err := r.ReadAt(moov, offset+headerSize); err != nil {
+				return VideoInfo{}, fmt.Errorf("%w: %v", ErrInvalidVideo, err)
+			}
+			break
+		}
+		offset += boxSize
+	}
+	if moov == nil {
+		return VideoInfo{}, fmt.Errorf("%w: no moov box", ErrInvalidVideo)
+	}
+
+	if err := parseMovie(moov, &info); err != nil {
+		return VideoInfo{}, err
+	}
+	if info.Duration <= 0 || info.Duration > MaxVideoDuration {
+		return VideoInfo{}, fmt.Errorf("%w: duration %s is not within %s", ErrInvalidVideo, info.Duration, MaxVideoDuration)
+	}
+	return info, nil
+}
+
+// readBoxHeader returns the type, the header size and the total size of the box at offset.
+func readBoxHeader(r io.ReaderAt, offset, fileSize int64) (string, int64, int64, error) {
+	header := make([]byte, 16)
+	n, err := r.ReadAt(header[:8], offset)
+	if n < 8 {
+		return "", 0, 0, fmt.Errorf("%w: truncated box header: %v", ErrInvalidVideo, err)
+	}
+	boxSize := int64(binary.BigEndian.Uint32(header[0:4]))
+	boxType := string(header[4:8])
+	headerSize := int64(8)
+	switch boxSize {
+	case 0:
+		// サイズ 0 はファイルの終わりまで
+		boxSize = fileSize - offset
+	case 1:
+		if n, err := r.ReadAt(header[8:16], offset+8); n < 8 {
+			return "", 0, 0, fmt.Errorf("%w: truncated box header: %v", ErrInvalidVideo, err)
+		}
+		boxSize = int64(binary.BigEndian.Uint64(header[8:16]))
+		headerSize = 16
+	}
+	if boxSize < headerSize || offset+boxSize > fileSize {
+		return "", 0, 0, fmt.Errorf("%w: invalid size of %q box", ErrInvalidVideo, boxType)
+	}
+	return boxType, headerSize, boxSize, nil
+}
+
+// contentTypeOfBrands checks the major brand, then the compatible brands of an ftyp box.
+func contentTypeOfBrands(ftyp []byte) string {
+	if len(ftyp) < 8 {
+		return ""
+	}
+	if contentType, ok := mp4Brands[string(ftyp[0:4])]; ok {
+		return contentType
+	}
+	for i := 8; i+4 <= len(ftyp); i += 4 {
+		if contentType, ok := mp4Brands[string(ftyp[i:i+4])]; ok {
+			return contentType
+		}
+	}
+	return ""
+}
+
+// parseMovie reads the duration from mvhd and the dimensions of the first video track.
+func parseMovie(moov []byte, info *VideoInfo) error {
+	foundHeader, foundVideo := false, false
+	err := eachBox(moov, func(boxType string, body []byte) error {
+		switch boxType {
+		case "mvhd":
+			duration, err := parseMovieHeader(body)
+			if err != nil {
+				return err
+			}
+			info.Duration = duration
+			foundHeader = true
+		case "trak":
+			if foundVideo {
+				return nil
+			}
+			width, height, isVideo, err := parseTrack(body)
+			if err != nil {
+				return err
+			}
+			if isVideo {
+				info.Width, info.Height = width, height
+				foundVideo = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !foundHeader {
+		return fmt.Errorf("%w: no mvhd box", ErrInvalidVideo)
+	}
+	if !foundVideo {
+		return fmt.Errorf("%w: no video track", ErrInvalidVideo)
+	}
+	return nil
+}
+
+func parseMovieHeader(body []byte) (time.Duration, error) {
+	if len(body) < 4 {
+		return 0, fmt.Errorf("%w: truncated mvhd box", ErrInvalidVideo)
+	}
+	var timescale uint32
+	var duration uint64
+	switch version := body[0]; version {
+	case 0:
+		if len(body) < 20 {
+			return 0, fmt.Errorf("%w: truncated mvhd box", ErrInvalidVideo)
+		}
+		timescale = binary.BigEndian.Uint32(body[12:16])
+		duration = uint64(binary.BigEndian.Uint32(body[16:20]))
+	case 1:
+		if len(body) < 32 {
+			return 0, fmt.Errorf("%w: truncated mvhd box", ErrInvalidVideo)
+		}
+		timescale = binary.BigEndian.Uint32(body[20:24])
+		duration = binary.BigEndian.Uint64(body[24:32])
+	default:
+		return 0, fmt.Errorf("%w: unknown mvhd version %d", ErrInvalidVideo, version)
+	}
+	if timescale == 0 {
+		return 0, fmt.Errorf("%w: zero timescale", ErrInvalidVideo)
+	}
+	// 長すぎる値でオーバーフローしないよう秒単位で上限を確認してから変換する
+	if duration/uint64(timescale) > uint64(MaxVideoDuration/time.Second) {
+		return MaxVideoDuration + time.Second, nil
+	}
+	return time.Duration(duration) * time.Second / time.Duration(timescale), nil
+}
+
+// parseTrack returns the dimensions from tkhd and whether the handler in mdia/hdlr is "vide".
+func parseTrack(trak []byte) (int, int, bool, error) {
+	var width, height int
+	isVideo := false
+	err := eachBox(trak, func(boxType string, body []byte) error {
+		switch boxType {
+		case "tkhd":
+			w, h, err := parseTrackHeader(body)
+			if err != nil {
+				return err
+			}
+			width, height = w, h
+		case "mdia":
+			return eachBox(body, func(boxType string, body []byte) error {
+				// hdlr: version/flags(4) pre_defined(4) handler_type(4)
+				if boxType == "hdlr" && len(body) >= 12 && string(body[8:12]) == "vide" {
+					isVideo = true
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	return width, height, isVideo, err
+}
+
+func parseTrackHeader(body []byte) (int, int, error) {
+	if len(body) < 4 {
+		return 0, 0, fmt.Errorf("%w: truncated tkhd box", ErrInvalidVideo)
+	}
+	// version 0 は時刻と長さが 32bit、version 1 は 64bit
+	matrixOffset := 40
+	if body[0] == 1 {
+		matrixOffset = 52
+	}
+	if len(body) < matrixOffset+44 {
+		return 0, 0, fmt.Errorf("%w: truncated tkhd box", ErrInvalidVideo)
+	}
+	// 幅と高さは 16.16 の固定小数点
+	width := int(binary.BigEndian.Uint32(body[matrixOffset+36:]) >> 16)
+	height := int(binary.BigEndian.Uint32(body[matrixOffset+40:]) >> 16)
+	// 縦向きで撮った動画は 90 度回転の行列 (a = 0, b = ±1) を持つので幅と高さを入れ替える
+	a := int32(binary.BigEndian.Uint32(body[matrixOffset:]))
+	b := int32(binary.BigEndian.Uint32(body[matrixOffset+4:]))
+	if a == 0 && b != 0 {
+		width, height = height, width
+	}
+	return width, height, nil
+}
+
+// eachBox calls fn with the type and body of every box directly inside data.
+func eachBox(data []byte, fn func(boxType string, body []byte) error) error {
+	for offset := 0; offset+8 <= len(data); {
+		size := int(binary.BigEndian.Uint32(data[offset:]))
+		boxType := string(data[offset+4 : offset+8])
+		headerSize := 8
+		switch size {
+		case 0:
+			size = len(data) - offset
+		case 1:
+			if offset+16 > len(data) {
+				return fmt.Errorf("%w: truncated %q box", ErrInvalidVideo, boxType)
+			}
+			size64 := binary.BigEndian.Uint64(data[offset+8:])
+			if size64 > uint64(len(data)-offset) {
+				return fmt.Errorf("%w: invalid size of %q box", ErrInvalidVideo, boxType)
+			}
+			size = int(size64)
+			headerSize = 16
+		}
+		if size < headerSize || offset+size > len(data) {
+			return fmt.Errorf("%w: invalid size of %q box", ErrInvalidVideo, boxType)
+		}
+		if err := fn(boxType, data[offset+headerSize:offset+size]); err != nil {
+			return err
+		}
+		offset += size
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// box builds an ISO base media box from its type and body.
+func box(boxType string, body ...[]byte) []byte {
+	content := bytes.Join(body, nil)
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header, uint32(8+len(content)))
+	copy(header[4:], boxType)
+	return append(header, content...)
+}
+
+func u32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func mvhd(timescale, duration uint32) []byte {
+	body := make([]byte, 100)
+	binary.BigEndian.PutUint32(body[12:], timescale)
+	binary.BigEndian.PutUint32(body[16:], duration)
+	return box("mvhd", body)
+}
+
+// tkhd builds a version 0 track header. rotated sets the matrix of a 90 degree rotation.
+func tkhd(width, height uint32, rotated bool) []byte {
+	body := make([]byte, 84)
+	a, b := uint32(0x00010000), uint32(0)
+	if rotated {
+		a, b = 0, 0x00010000
+	}
+	binary.BigEndian.PutUint32(body[40:], a)
+	binary.BigEndian.PutUint32(body[44:], b)
+	binary.BigEndian.PutUint32(body[76:], width<<16)
+	binary.BigEndian.PutUint32(body[80:], height<<16)
+	return box("tkhd", body)
+}
+
+func trak(handler string, width, height uint32, rotated bool) []byte {
+	hdlr := box("hdlr", u32(0), u32(0), []byte(handler), make([]byte, 12))
+	return box("trak", tkhd(width, height, rotated), box("mdia", hdlr))
+}
+
+func TestInspectVideo(t *testing.T) {
+	ftypMP4 := box("ftyp", []byte("isom"), u32(512), []byte("isomiso2avc1mp41"))
+	ftypMOV := box("ftyp", []byte("qt  "), u32(0), []byte("qt  "))
+	audio := trak("soun", 0, 0, false)
+	video := trak("vide", 1920, 1080, false)
+
+	testCases := []struct {
+		name     string
+		file     []byte
+		expected VideoInfo
+		wantErr  bool
+	}{
+		{
+			name:     "MP4 with moov at the end",
+			file:     bytes.Join([][]byte{ftypMP4, box("mdat", make([]byte, 64)), box("moov", mvhd(1000, 15500), audio, video)}, nil),
+			expected: VideoInfo{ContentType: "video/mp4", Duration: 15500 * time.Millisecond, Width: 1920, Height: 1080},
+		},
+		{
+			name:     "Portrait QuickTime",
+			file:     bytes.Join([][]byte{ftypMOV, box("moov", mvhd(600, 6000), trak("vide", 1920, 1080, true))}, nil),
+			expected: VideoInfo{ContentType: "video/quicktime", Duration: 10 * time.Second, Width: 1080, Height: 1920},
+		},
+		{
+			name:    "Too long",
+			file:    bytes.Join([][]byte{ftypMP4, box("moov", mvhd(1000, 61000), video)}, nil),
+			wantErr: true,
+		},
+		{
+			name:    "Audio only",
+			file:    bytes.Join([][]byte{ftypMP4, box("moov", mvhd(1000, 5000), audio)}, nil),
+			wantErr: true,
+		},
+		{
+			name:    "Unsupported brand",
+			file:    bytes.Join([][]byte{box("ftyp", []byte("3gp4"), u32(0), []byte("3gp4")), box("moov", mvhd(1000, 5000), video)}, nil),
+			wantErr: true,
+		},
+		{
+			name:    "Not a video",
+			file:    []byte("\x89PNG\r\n\x1a\n not really a png"),
+			wantErr: true,
+		},
+		{
+			name:    "No moov",
+			file:    bytes.Join([][]byte{ftypMP4, box("mdat", make([]byte, 64))}, nil),
+			wantErr: true,
+		},
+		{
+			name:    "Box larger than the file",
+			file:    append(ftypMP4, 0, 0, 1, 0, 'm', 'o', 'o', 'v'),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info, err := inspectVideo(bytes.NewReader(tc.file), int64(len(tc.file)))
+			if tc.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidVideo), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, info)
+		})
+	}
+
+	t.Run("Too large", func(t *testing.T) {
+		file := bytes.Join([][]byte{ftypMP4, box("moov", mvhd(1000, 5000), video)}, nil)
+		_, err := inspectVideo(bytes.NewReader(file), MaxVideoSize+1)
+		assert.True(t, errors.Is(err, ErrInvalidVideo))
+	})
+}
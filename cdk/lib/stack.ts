@@ -225,6 +225,33 @@ export class AnimoraStack extends cdk.Stack {
       }
     );
 
+    const exploreRankingFn = new lambda.Function(this, "ExploreRanking", {
+      runtime: lambda.Runtime.PROVIDED_AL2023,
+      handler: "bootstrap",
+      timeout: cdk.Duration.minutes(2),
+      code: lambda.Code.fromAsset(
+        path.join(__dirname, "../../backend-go/bin/explore-ranking")
+      ),
+      environment: {
+        ...env,
+      },
+      role: new Role(this, "ExploreRankingRole", {
+        assumedBy: new ServicePrincipal("lambda.amazonaws.com"),
+        description: "Role for ExploreRanking Lambda function",
+        managedPolicies: [
+          ManagedPolicy.fromAwsManagedPolicyName(
+            "service-role/AWSLambdaBasicExecutionRole"
+          ),
+        ],
+      }),
+    });
+
+    // 探索フィードの順位を15分ごとに作り直す
+    new events.Rule(this, "ExploreRankingRule", {
+      schedule: events.Schedule.rate(cdk.Duration.minutes(15)),
+      targets: [new targets.LambdaFunction(exploreRankingFn)],
+    });
+
     // 毎日同じ時間に通知を送るための EventBridge ルール
     new events.Rule(this, "DailyTaskPushNotificationRule", {
       schedule: events.Schedule.cron({ minute: "0", hour: "3", day: "*" }),